@@ -2,18 +2,42 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
+	"fmt"
 	"log/slog"
-	_ "net/http/pprof"
+	"net/http"
+	"net/url"
 	"os"
+	"os/signal"
+	"path/filepath"
 	"runtime"
 	"runtime/trace"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
 
+	"github.com/Khan/genqlient/graphql"
+	"github.com/wandb/wandb/core/internal/auth"
+	"github.com/wandb/wandb/core/internal/doctor"
+	"github.com/wandb/wandb/core/internal/filetransfer"
+	"github.com/wandb/wandb/core/internal/hpcjob"
+	"github.com/wandb/wandb/core/internal/launchagent"
+	"github.com/wandb/wandb/core/internal/leet"
+	"github.com/wandb/wandb/core/internal/localsweep"
+	"github.com/wandb/wandb/core/internal/mlflowimport"
 	"github.com/wandb/wandb/core/internal/processlib"
+	"github.com/wandb/wandb/core/internal/runview"
 	"github.com/wandb/wandb/core/internal/sentry_ext"
+	"github.com/wandb/wandb/core/internal/settings"
 	"github.com/wandb/wandb/core/internal/version"
+	"github.com/wandb/wandb/core/pkg/artifacts"
 	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/publicapi"
 	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 const (
@@ -30,6 +54,34 @@ func init() {
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "repair" {
+		os.Exit(repair(os.Args[2:]))
+	}
+	if len(os.Args) > 1 && os.Args[1] == "flush" {
+		os.Exit(flush(os.Args[2:]))
+	}
+	if len(os.Args) > 1 && os.Args[1] == "import" {
+		os.Exit(importRuns(os.Args[2:]))
+	}
+	if len(os.Args) > 1 && os.Args[1] == "agent" {
+		os.Exit(launchAgent(os.Args[2:]))
+	}
+	if len(os.Args) > 1 && os.Args[1] == "sweep" {
+		os.Exit(sweep(os.Args[2:]))
+	}
+	if len(os.Args) > 1 && os.Args[1] == "telemetry" {
+		os.Exit(telemetry(os.Args[2:]))
+	}
+	if len(os.Args) > 1 && os.Args[1] == "doctor" {
+		os.Exit(runDoctor(os.Args[2:]))
+	}
+	if len(os.Args) > 1 && os.Args[1] == "artifact" {
+		os.Exit(artifact(os.Args[2:]))
+	}
+	if len(os.Args) > 1 && os.Args[1] == "leet" {
+		os.Exit(leetView(os.Args[2:]))
+	}
+
 	// Flags to control the server
 	portFilename := flag.String("port-filename", "port_file.txt", "filename for port to communicate with client")
 	pid := flag.Int("pid", 0, "pid of the process to communicate with")
@@ -37,6 +89,18 @@ func main() {
 	disableAnalytics := flag.Bool("no-observability", false, "turn off observability")
 	enableOsPidShutdown := flag.Bool("os-pid-shutdown", false, "enable OS pid shutdown")
 	traceFile := flag.String("trace", "", "file name to write trace output to")
+	pipeName := flag.String("pipe-name", "",
+		"listen on the Windows named pipe with this name instead of on localhost TCP")
+	unixSocket := flag.String("unix-socket", "",
+		"listen on a Unix domain socket at this path instead of on localhost TCP")
+	requireAuth := flag.Bool("require-auth", false,
+		"require clients to authenticate with a token written to the port file")
+	shutdownTimeout := flag.Duration("shutdown-timeout", 60*time.Second,
+		"time to let runs finish uploading on SIGINT or SIGTERM")
+	statusPort := flag.Int("status-port", 0,
+		"serve resource usage statistics at http://127.0.0.1:PORT/debug/vars")
+	pprofPort := flag.Int("pprof-port", 0,
+		"serve Go profiles at http://127.0.0.1:PORT/debug/pprof/")
 	// TODO: remove these flags, they are here for backward compatibility
 	_ = flag.Bool("serve-sock", false, "use sockets")
 
@@ -55,6 +119,7 @@ func main() {
 		Release:          version.Version,
 		Commit:           commit,
 		Environment:      version.Environment,
+		Policy:           sentry_ext.PolicyFromEnv(),
 	}
 	if *disableAnalytics {
 		params.DSN = ""
@@ -118,6 +183,9 @@ func main() {
 			PortFilename:    *portFilename,
 			ParentPid:       *pid,
 			SentryClient:    sentryClient,
+			PipeName:        *pipeName,
+			UnixSocket:      *unixSocket,
+			RequireAuth:     *requireAuth,
 		},
 	)
 	if err != nil {
@@ -125,7 +193,881 @@ func main() {
 		return
 	}
 	srv.SetDefaultLoggerPath(loggerPath)
+	if *statusPort != 0 {
+		if addr, err := server.ServeStatus(*statusPort); err != nil {
+			slog.Error("failed to serve status", "error", err)
+		} else {
+			slog.Info("serving status", "addr", addr.String())
+		}
+	}
+	if *pprofPort != 0 {
+		if addr, err := server.ServeProfiling(*pprofPort); err != nil {
+			slog.Error("failed to serve profiles", "error", err)
+		} else {
+			slog.Info("serving profiles", "addr", addr.String())
+		}
+	}
 	srv.Start()
+	go shutdownOnSignal(srv, *shutdownTimeout)
+	go reloadSettingsOnSignal(srv)
+	go writeProfilesOnSignal(profileDir(loggerPath))
 	srv.Wait()
 	srv.Close()
 }
+
+// shutdownOnSignal shuts down the server gracefully on SIGINT or SIGTERM,
+// or immediately on a second signal.
+func shutdownOnSignal(srv *server.Server, timeout time.Duration) {
+	signals := make(chan os.Signal, 2)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+
+	sig := <-signals
+	slog.Info("received signal, shutting down", "signal", sig)
+
+	// Runs are marked as killed by the signal, like a process would be.
+	exitCode := int32(1)
+	if sig, ok := sig.(syscall.Signal); ok {
+		exitCode = 128 + int32(sig)
+	}
+
+	go func() {
+		sig := <-signals
+		slog.Error("received second signal, exiting now", "signal", sig)
+		os.Exit(1)
+	}()
+
+	// HPC schedulers preempt jobs with SIGTERM and kill them soon after,
+	// so runs save what they can within their grace periods.
+	if job := hpcjob.Detect(os.Environ()); job != nil && sig == syscall.SIGTERM {
+		slog.Info("job preempted",
+			"scheduler", job.Scheduler,
+			"job_id", job.ID)
+		timeout = srv.Preempt(timeout)
+	}
+
+	if err := srv.Shutdown(exitCode, timeout); err != nil {
+		slog.Error("graceful shutdown failed", "error", err)
+		os.Exit(1)
+	}
+}
+
+// reloadSettingsOnSignal re-reads the runs' settings files on SIGHUP.
+func reloadSettingsOnSignal(srv *server.Server) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGHUP)
+
+	for sig := range signals {
+		slog.Info("received signal, reloading settings", "signal", sig)
+		srv.ReloadSettings()
+	}
+}
+
+// profileDir returns the directory in which to write profiles: next to
+// the log file if there is one.
+func profileDir(loggerPath string) string {
+	if loggerPath == "" {
+		return os.TempDir()
+	}
+	return filepath.Dir(loggerPath)
+}
+
+// repair implements the "repair" subcommand, which salvages the readable
+// records of damaged transaction logs.
+func repair(args []string) int {
+	flags := flag.NewFlagSet("repair", flag.ExitOnError)
+	output := flags.String("o", "", "write the repaired log to this file instead of replacing the original")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s repair [-o OUTPUT] FILE.wandb\n", os.Args[0])
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return 2
+	}
+	path := flags.Arg(0)
+
+	var report server.RepairReport
+	var err error
+	if *output != "" {
+		report, err = server.Repair(path, *output)
+	} else {
+		report, err = server.RepairInPlace(path)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to repair %s: %v\n", path, err)
+		return 1
+	}
+
+	if !report.Damaged() {
+		fmt.Printf("%s is not damaged (%d records)\n", path, report.Records)
+		return 0
+	}
+
+	fmt.Printf("recovered %d records from %s\n", report.Records, path)
+	for _, err := range report.Errors {
+		fmt.Printf("  lost records: %v\n", err)
+	}
+	if report.Truncated {
+		fmt.Println("  the log ends in damaged data, likely from a crash")
+	}
+	if *output == "" {
+		fmt.Printf("the original log was kept as %s.corrupt\n", path)
+	}
+	return 0
+}
+
+// runDoctor checks the connection to W&B and prints a report.
+func runDoctor(args []string) int {
+	flags := flag.NewFlagSet("doctor", flag.ExitOnError)
+	jsonOutput := flags.Bool("json", false, "print the report as JSON")
+	uploadRun := flags.String("run", "",
+		"test uploads by adding a small file to this run, given as ENTITY/PROJECT/RUN_ID")
+	wandbDir := flags.String("dir", "wandb", "the wandb directory to check")
+	timeout := flags.Duration("timeout", 10*time.Second, "timeout for each network check")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(),
+			"Usage: %s doctor [-json] [-run ENTITY/PROJECT/RUN_ID] [-dir WANDB_DIR]\n",
+			os.Args[0])
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+
+	if flags.NArg() != 0 {
+		flags.Usage()
+		return 2
+	}
+
+	baseURLString := os.Getenv("WANDB_BASE_URL")
+	if baseURLString == "" {
+		baseURLString = publicapi.DefaultBaseURL
+	}
+	baseURL, err := url.Parse(baseURLString)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "invalid WANDB_BASE_URL: %v\n", err)
+		return 1
+	}
+
+	// The API check reports a missing key.
+	var apiKey string
+	if baseSettings, err := serverSettings(); err == nil {
+		apiKey = baseSettings.GetAPIKey()
+	}
+
+	report := doctor.Run(context.Background(), doctor.Params{
+		BaseURL:   baseURL,
+		APIKey:    apiKey,
+		Proxy:     server.ProxyFn("", "", ""),
+		WandbDir:  *wandbDir,
+		UploadRun: *uploadRun,
+		Timeout:   *timeout,
+	})
+
+	if *jsonOutput {
+		if err := report.WriteJSON(os.Stdout); err != nil {
+			fmt.Fprintf(os.Stderr, "failed to write report: %v\n", err)
+			return 1
+		}
+	} else {
+		report.WriteText(os.Stdout)
+	}
+
+	if report.Failed() {
+		return 1
+	}
+	return 0
+}
+
+// telemetry lists, sends or deletes the error reports queued locally
+// because WANDB_TELEMETRY_QUEUE_DIR is set.
+func telemetry(args []string) int {
+	flags := flag.NewFlagSet("telemetry", flag.ExitOnError)
+	dir := flags.String("dir", os.Getenv(sentry_ext.QueueDirEnv), "the queue directory")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s telemetry [-dir DIR] list|send|clear\n", os.Args[0])
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+
+	if flags.NArg() != 1 || *dir == "" {
+		flags.Usage()
+		return 2
+	}
+
+	paths, err := sentry_ext.QueuedEvents(*dir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to read %s: %v\n", *dir, err)
+		return 1
+	}
+
+	switch flags.Arg(0) {
+	case "list":
+		for _, path := range paths {
+			fmt.Println(path)
+		}
+		fmt.Printf("%d queued events\n", len(paths))
+
+	case "send":
+		sent, err := sentry_ext.SendQueued(*dir, SentryDSN)
+		fmt.Printf("sent %d events\n", sent)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "failed to send queued events: %v\n", err)
+			return 1
+		}
+
+	case "clear":
+		for _, path := range paths {
+			if err := os.Remove(path); err != nil {
+				fmt.Fprintf(os.Stderr, "failed to delete %s: %v\n", path, err)
+				return 1
+			}
+		}
+		fmt.Printf("deleted %d events\n", len(paths))
+
+	default:
+		flags.Usage()
+		return 2
+	}
+	return 0
+}
+
+// artifact implements the "artifact verify" subcommand, which checks a
+// downloaded artifact's files against its manifest.
+func artifact(args []string) int {
+	usage := fmt.Sprintf(
+		"Usage: %s artifact verify [flags] DIR|ENTITY/PROJECT/NAME[:ALIAS]\n\n"+
+			"Hashes the files of a downloaded artifact and reports those that\n"+
+			"are missing or don't match its manifest. Given a directory, the\n"+
+			"manifest is read from -manifest or fetched for -artifact. Given an\n"+
+			"artifact, its files are looked for in -root.\n",
+		os.Args[0])
+	if len(args) == 0 || args[0] != "verify" {
+		fmt.Fprint(os.Stderr, usage)
+		return 2
+	}
+
+	flags := flag.NewFlagSet("artifact verify", flag.ExitOnError)
+	manifestPath := flags.String("manifest", "", "read the manifest from this file instead of fetching it")
+	artifactPath := flags.String("artifact", "", "the artifact downloaded to DIR, as ENTITY/PROJECT/NAME[:ALIAS]")
+	root := flags.String("root", "", "the directory the artifact was downloaded to (default: ./artifacts/NAME:ALIAS)")
+	repairFiles := flags.Bool("repair", false, "re-download files that are missing or don't match")
+	parallelism := flags.Int("parallel", runtime.NumCPU(), "number of files to hash at once")
+	jsonOutput := flags.Bool("json", false, "print the report as JSON")
+	flags.Usage = func() {
+		fmt.Fprint(flags.Output(), usage)
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args[1:])
+
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return 2
+	}
+	if info, err := os.Stat(flags.Arg(0)); err == nil && info.IsDir() {
+		*root = flags.Arg(0)
+	} else {
+		*artifactPath = flags.Arg(0)
+		if *root == "" {
+			_, name, _ := strings.Cut(*artifactPath, "/")
+			_, name, _ = strings.Cut(name, "/")
+			if !strings.Contains(name, ":") {
+				name += ":latest"
+			}
+			*root = filepath.Join("artifacts", name)
+		}
+	}
+	if *artifactPath == "" && (*manifestPath == "" || *repairFiles) {
+		fmt.Fprintln(os.Stderr, "pass -artifact to fetch the manifest or to repair files")
+		return 2
+	}
+
+	ctx := context.Background()
+	var report *artifacts.VerifyReport
+	var downloader *artifacts.ArtifactDownloader
+	if *artifactPath != "" {
+		baseSettings, err := serverSettings()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "no API key: %v\n", err)
+			return 1
+		}
+
+		logger := observability.NewNoOpLogger()
+		backend := server.NewBackend(logger, baseSettings)
+		graphqlClient := server.NewGraphQLClient(
+			backend, logger, baseSettings, &observability.Peeker{})
+		fileTransferManager := server.NewFileTransferManager(
+			filetransfer.NewFileTransferStats(),
+			logger,
+			observability.NewPrinter(),
+			baseSettings,
+		)
+		defer fileTransferManager.Close()
+
+		artifactID, err := artifacts.ResolveArtifactID(ctx, graphqlClient, *artifactPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			return 1
+		}
+		downloader = artifacts.NewArtifactDownloader(
+			ctx, graphqlClient, fileTransferManager,
+			artifactID, *root, false, false, "", nil)
+	}
+
+	if *manifestPath != "" {
+		manifest, err := artifacts.OpenManifest(*manifestPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "failed to read %s: %v\n", *manifestPath, err)
+			return 1
+		}
+		report, err = artifacts.VerifyTree(ctx, manifest, *root, *parallelism)
+		_ = manifest.Close()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "failed to verify %s: %v\n", *root, err)
+			return 1
+		}
+	} else {
+		var err error
+		if report, err = downloader.Verify(*parallelism); err != nil {
+			fmt.Fprintf(os.Stderr, "failed to verify %s: %v\n", *root, err)
+			return 1
+		}
+	}
+
+	if *repairFiles && report.Failed() {
+		var err error
+		if report, err = downloader.Repair(report, *parallelism); err != nil {
+			fmt.Fprintf(os.Stderr, "failed to repair %s: %v\n", *root, err)
+			return 1
+		}
+	}
+
+	if *jsonOutput {
+		if err := report.WriteJSON(os.Stdout); err != nil {
+			fmt.Fprintf(os.Stderr, "failed to write report: %v\n", err)
+			return 1
+		}
+	} else {
+		report.WriteText(os.Stdout)
+	}
+
+	if report.Failed() {
+		return 1
+	}
+	return 0
+}
+
+// flush implements the "flush" subcommand, which re-sends runs whose
+// upload was interrupted by a crash, and uploads runs that were logged
+// without an API key.
+func flush(args []string) int {
+	flags := flag.NewFlagSet("flush", flag.ExitOnError)
+	force := flags.Bool("force", false, "also re-send runs whose process appears to be running")
+	anonymous := flags.Bool("anonymous", false,
+		"if there is no API key, upload the runs to a new anonymous account")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(),
+			"Usage: %s flush [-force] [-anonymous] [WANDB_DIR]\n", os.Args[0])
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+
+	wandbDir := "wandb"
+	switch flags.NArg() {
+	case 0:
+	case 1:
+		wandbDir = flags.Arg(0)
+	default:
+		flags.Usage()
+		return 2
+	}
+
+	runs, err := server.PendingRuns(wandbDir, *force)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to read %s: %v\n", wandbDir, err)
+		return 1
+	}
+	if len(runs) == 0 {
+		fmt.Println("no runs to re-send")
+		return 0
+	}
+
+	baseSettings, err := serverSettings()
+	var anonymousKey string
+	if err != nil && *anonymous {
+		baseSettings, anonymousKey, err = anonymousServerSettings()
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "no API key: %v\n", err)
+		if !*anonymous {
+			fmt.Fprintln(os.Stderr,
+				"log in with `wandb login`, or use -anonymous to upload without an account")
+		}
+		return 1
+	}
+
+	// Errors are reported to the user rather than to Sentry.
+	sentryClient := sentry_ext.New(sentry_ext.Params{})
+
+	failed := 0
+	for _, syncFile := range runs {
+		if server.IsDeferredRun(syncFile) {
+			fmt.Printf("uploading %s\n", syncFile)
+		} else {
+			fmt.Printf("re-sending %s\n", syncFile)
+		}
+
+		runURL, err := server.ResendRun(baseSettings.Proto, syncFile, sentryClient)
+		switch {
+		case errors.Is(err, server.ErrRunClaimed):
+			fmt.Println("  skipped: another process is re-sending it")
+		case err != nil:
+			fmt.Fprintf(os.Stderr, "  %v\n", err)
+			failed++
+		case runURL != "" && anonymousKey != "":
+			fmt.Printf("  claim this run at %s?apiKey=%s\n", runURL, anonymousKey)
+		case runURL != "":
+			fmt.Printf("  %s\n", runURL)
+		}
+	}
+
+	if anonymousKey != "" {
+		fmt.Println("anyone with the links above can view the runs; " +
+			"don't share them until the runs are claimed")
+	}
+	if failed > 0 {
+		fmt.Fprintf(os.Stderr, "failed to re-send %d of %d runs\n", failed, len(runs))
+		return 1
+	}
+	return 0
+}
+
+// anonymousLoginTimeout is how long to wait for the server to create an
+// anonymous account.
+const anonymousLoginTimeout = 30 * time.Second
+
+// anonymousServerSettings creates an anonymous account on the W&B server
+// configured in the environment, and returns the settings for sending runs
+// to it along with its API key.
+//
+// The key is saved to .netrc so that later runs use the same account.
+func anonymousServerSettings() (*settings.Settings, string, error) {
+	baseURL := os.Getenv("WANDB_BASE_URL")
+	if baseURL == "" {
+		baseURL = publicapi.DefaultBaseURL
+	}
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to parse base URL: %v", err)
+	}
+
+	client := graphql.NewClient(
+		u.JoinPath("graphql").String(),
+		&http.Client{Timeout: anonymousLoginTimeout},
+	)
+	apiKey, err := auth.CreateAnonymousAPIKey(context.Background(), client)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if err := auth.AddNetrcLogin(u.Hostname(), "user", apiKey); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to save the anonymous API key: %v\n", err)
+	} else {
+		fmt.Println("created an anonymous account and saved its API key to .netrc")
+	}
+
+	return settings.From(&service.Settings{
+		BaseUrl: wrapperspb.String(baseURL),
+		ApiKey:  wrapperspb.String(apiKey),
+	}), apiKey, nil
+}
+
+// serverSettings returns the settings for sending runs to the W&B server
+// configured in the environment.
+func serverSettings() (*settings.Settings, error) {
+	baseURL := os.Getenv("WANDB_BASE_URL")
+	if baseURL == "" {
+		baseURL = publicapi.DefaultBaseURL
+	}
+	baseSettings := settings.From(&service.Settings{
+		BaseUrl: wrapperspb.String(baseURL),
+		ApiKey:  wrapperspb.String(os.Getenv("WANDB_API_KEY")),
+	})
+	if err := baseSettings.EnsureAPIKey(); err != nil {
+		return nil, err
+	}
+	return baseSettings, nil
+}
+
+// importRuns implements the "import" subcommand, which copies runs from
+// other experiment trackers to W&B.
+func importRuns(args []string) int {
+	if len(args) == 0 || args[0] != "mlflow" {
+		fmt.Fprintf(os.Stderr, "Usage: %s import mlflow [flags]\n", os.Args[0])
+		return 2
+	}
+
+	flags := flag.NewFlagSet("import mlflow", flag.ExitOnError)
+	trackingURI := flags.String("tracking-uri", os.Getenv("MLFLOW_TRACKING_URI"),
+		"URL of the MLflow tracking server")
+	experiment := flags.String("experiment", "", "name of the MLflow experiment to import")
+	entity := flags.String("entity", "", "W&B entity to create the runs in")
+	project := flags.String("project", "", "W&B project to create the runs in (default: the experiment name)")
+	wandbDir := flags.String("dir", "wandb", "directory to write the runs' transaction logs to")
+	offline := flags.Bool("offline", false, "only write the runs to -dir, for uploading later with `wandb sync`")
+	skipArtifacts := flags.Bool("skip-artifacts", false, "don't copy the runs' artifacts")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(),
+			"Usage: %s import mlflow -tracking-uri URI -experiment NAME [flags]\n", os.Args[0])
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args[1:])
+
+	if *trackingURI == "" || *experiment == "" || flags.NArg() != 0 {
+		flags.Usage()
+		return 2
+	}
+	if *project == "" {
+		*project = mlflowimport.ProjectName(*experiment)
+	}
+
+	var baseSettings *settings.Settings
+	if !*offline {
+		var err error
+		if baseSettings, err = serverSettings(); err != nil {
+			fmt.Fprintf(os.Stderr, "no API key: %v\n", err)
+			return 1
+		}
+	}
+
+	importer := mlflowimport.New(mlflowimport.Params{
+		Client: mlflowimport.NewClient(mlflowimport.ClientParams{
+			TrackingURI: *trackingURI,
+			Token:       os.Getenv("MLFLOW_TRACKING_TOKEN"),
+			Username:    os.Getenv("MLFLOW_TRACKING_USERNAME"),
+			Password:    os.Getenv("MLFLOW_TRACKING_PASSWORD"),
+		}),
+		Entity:        *entity,
+		Project:       *project,
+		SkipArtifacts: *skipArtifacts,
+	})
+
+	ctx := context.Background()
+	runs, err := importer.Runs(ctx, *experiment)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to list runs: %v\n", err)
+		return 1
+	}
+
+	// Errors are reported to the user rather than to Sentry.
+	sentryClient := sentry_ext.New(sentry_ext.Params{})
+
+	failed := 0
+	for i := range runs {
+		run := &runs[i]
+		syncDir := filepath.Join(*wandbDir, fmt.Sprintf("run-%s-%s",
+			time.UnixMilli(int64(run.Info.StartTime)).Format("20060102_150405"),
+			run.Info.RunID))
+		syncFile := filepath.Join(syncDir, fmt.Sprintf("run-%s.wandb", run.Info.RunID))
+
+		if _, err := os.Stat(syncFile); err == nil {
+			fmt.Printf("skipping %s, already imported to %s\n", run.Info.RunID, syncDir)
+			continue
+		}
+
+		fmt.Printf("importing %s (%s)\n", run.Info.RunID, run.Info.RunName)
+		if err := importRun(ctx, importer, run, syncDir, syncFile); err != nil {
+			fmt.Fprintf(os.Stderr, "  %v\n", err)
+			_ = os.RemoveAll(syncDir)
+			failed++
+			continue
+		}
+
+		if !*offline {
+			_, err := server.ResendRun(baseSettings.Proto, syncFile, sentryClient)
+			if err != nil {
+				fmt.Fprintf(os.Stderr,
+					"  %v\n  retry with: %s flush %s\n", err, os.Args[0], *wandbDir)
+				failed++
+			}
+		}
+	}
+
+	if failed > 0 {
+		fmt.Fprintf(os.Stderr, "failed to import %d of %d runs\n", failed, len(runs))
+		return 1
+	}
+	return 0
+}
+
+// importRun writes an MLflow run as a W&B transaction log.
+func importRun(
+	ctx context.Context,
+	importer *mlflowimport.Importer,
+	run *mlflowimport.Run,
+	syncDir, syncFile string,
+) error {
+	filesDir := filepath.Join(syncDir, "files")
+	if err := os.MkdirAll(filesDir, os.ModePerm); err != nil {
+		return err
+	}
+
+	store := server.NewStore(ctx, syncFile)
+	if err := store.Open(os.O_WRONLY); err != nil {
+		return err
+	}
+	err := importer.ImportRun(ctx, run, filesDir, store.Write)
+	if closeErr := store.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// leetView implements the "leet" subcommand, which shows runs in the
+// terminal.
+func leetView(args []string) int {
+	if len(args) > 0 && args[0] == "snapshot" {
+		return leetSnapshot(args[1:])
+	}
+
+	flags := flag.NewFlagSet("leet", flag.ExitOnError)
+	defaultConfigPath, _ := runview.DefaultViewConfigPath()
+	configPath := flags.String("config", defaultConfigPath, "the view configuration file")
+	styleName := flags.String("style", "",
+		"how to draw charts: braille, blocks or ascii (default $"+runview.ChartStyleEnv+" or braille)")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(),
+			"Usage: %s leet [flags] FILE.wandb|WANDB_DIR|wandb://ENTITY/PROJECT/RUN_ID\n"+
+				"       %s leet snapshot -o OUTPUT [flags] FILE.wandb\n",
+			os.Args[0], os.Args[0])
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return 2
+	}
+
+	style := runview.ChartStyleFromEnv()
+	if *styleName != "" {
+		var err error
+		if style, err = runview.ParseChartStyle(*styleName); err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			return 2
+		}
+	}
+
+	config, err := runview.LoadViewConfig(*configPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return 1
+	}
+
+	// The viewer works offline, but needs the server for runs on it and
+	// for annotating runs.
+	var client graphql.Client
+	if api, err := publicapi.NewFromEnvironment(); err == nil {
+		client = api.GraphQL()
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGTERM)
+	defer cancel()
+
+	err = leet.Run(ctx, leet.Params{
+		Target:     flags.Arg(0),
+		Config:     config,
+		ConfigPath: *configPath,
+		Style:      style,
+		Client:     client,
+		Logger:     observability.NewNoOpLogger(),
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// leetSnapshot implements the "leet snapshot" subcommand, which renders
+// the charts of a run's .wandb file to an image:
+//
+//	wandb-core leet snapshot -o charts.png -filter 'train/.*loss' run-abc123.wandb
+//
+// The format is chosen by the output file's extension, .png or .svg.
+func leetSnapshot(args []string) int {
+	defaults := runview.DefaultImageOptions()
+
+	flags := flag.NewFlagSet("leet snapshot", flag.ExitOnError)
+	output := flags.String("o", "", "image file to write, ending in .png or .svg")
+	filter := flags.String("filter", "", "regex or glob selecting the metrics to chart")
+	system := flags.Bool("system", false, "chart system metrics instead of history")
+	columns := flags.Int("columns", defaults.Columns, "number of charts per row")
+	width := flags.Int("width", defaults.ChartWidth, "width of each chart in pixels")
+	height := flags.Int("height", defaults.ChartHeight, "height of each chart in pixels")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(),
+			"Usage: %s leet snapshot -o OUTPUT [flags] FILE.wandb\n", os.Args[0])
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+
+	if *output == "" || flags.NArg() != 1 {
+		flags.Usage()
+		return 2
+	}
+
+	err := leet.Snapshot(leet.SnapshotParams{
+		RunPath:    flags.Arg(0),
+		OutputPath: *output,
+		Filter:     *filter,
+		System:     *system,
+		Image: runview.ImageOptions{
+			ChartWidth:  *width,
+			ChartHeight: *height,
+			Columns:     *columns,
+		},
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// launchAgent implements the "agent" subcommand, which runs jobs from
+// W&B Launch run queues.
+func launchAgent(args []string) int {
+	flags := flag.NewFlagSet("agent", flag.ExitOnError)
+	var queues []string
+	flags.Func("queue", "run queue to poll; may be repeated", func(queue string) error {
+		queues = append(queues, queue)
+		return nil
+	})
+	entity := flags.String("entity", os.Getenv("WANDB_ENTITY"), "entity that owns the run queues")
+	project := flags.String("project", launchagent.DefaultProject, "project of the run queues")
+	maxJobs := flags.Int("max-jobs", 1, "number of jobs to run at once")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(),
+			"Usage: %s agent -entity ENTITY -queue QUEUE [flags]\n", os.Args[0])
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+
+	if len(queues) == 0 || *entity == "" || flags.NArg() != 0 {
+		flags.Usage()
+		return 2
+	}
+
+	baseSettings, err := serverSettings()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "no API key: %v\n", err)
+		return 1
+	}
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	api, err := publicapi.NewFromSettings(baseSettings.Proto)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return 1
+	}
+
+	agent := launchagent.New(launchagent.Params{
+		Client:  api.GraphQL(),
+		Logger:  logger,
+		Entity:  *entity,
+		Project: *project,
+		Queues:  queues,
+		MaxJobs: *maxJobs,
+		BaseURL: baseSettings.GetBaseURL(),
+		APIKey:  baseSettings.GetAPIKey(),
+	})
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	fmt.Printf("polling %s in %s/%s\n", strings.Join(queues, ", "), *entity, *project)
+	if err := agent.Run(ctx); err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// sweep implements the "sweep local" subcommand, which runs a
+// hyperparameter sweep without the W&B server.
+func sweep(args []string) int {
+	usage := fmt.Sprintf(
+		"Usage: %s sweep local [flags] SWEEP.yaml [-- COMMAND...]\n\n"+
+			"Runs COMMAND, or the config's program, once per set of parameters.\n"+
+			"Parameters are passed to wandb.init() and replace the arguments\n"+
+			"'${args}' and '${args_no_hyphens}' in COMMAND. Running the same\n"+
+			"sweep again resumes it.\n",
+		os.Args[0])
+	if len(args) == 0 || args[0] != "local" {
+		fmt.Fprint(os.Stderr, usage)
+		return 2
+	}
+
+	flags := flag.NewFlagSet("sweep local", flag.ExitOnError)
+	count := flags.Int("count", 0, "maximum number of runs to start (default: until the sweep is complete)")
+	rootDir := flags.String("dir", os.Getenv("WANDB_DIR"), "directory containing the wandb directory (default: the current directory)")
+	flags.Usage = func() {
+		fmt.Fprint(flags.Output(), usage)
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args[1:])
+
+	var command []string
+	rest := flags.Args()
+	if i := slices.Index(rest, "--"); i >= 0 {
+		command = rest[i+1:]
+		rest = rest[:i]
+	}
+	if len(rest) != 1 {
+		flags.Usage()
+		return 2
+	}
+
+	configData, err := os.ReadFile(rest[0])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return 1
+	}
+	config, err := localsweep.ParseConfig(configData)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return 1
+	}
+	if len(command) == 0 && config.Program == "" {
+		fmt.Fprintln(os.Stderr, "no command given and the config has no program")
+		return 2
+	}
+
+	// Like the Python SDK, use a hidden ".wandb" directory if it exists.
+	wandbDir := filepath.Join(*rootDir, ".wandb")
+	if _, err := os.Stat(wandbDir); err != nil {
+		wandbDir = filepath.Join(*rootDir, "wandb")
+	}
+
+	runner := localsweep.NewRunner(localsweep.Params{
+		Config:   config,
+		Command:  command,
+		WandbDir: wandbDir,
+		SweepID:  localsweep.SweepID(configData),
+		Count:    *count,
+	})
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	if err := runner.Run(ctx); err != nil {
+		if ctx.Err() != nil {
+			fmt.Fprintln(os.Stderr, "sweep interrupted; run the same command to resume it")
+		} else {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+		}
+		return 1
+	}
+	return 0
+}
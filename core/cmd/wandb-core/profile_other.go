@@ -0,0 +1,29 @@
+//go:build !windows
+
+package main
+
+import (
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/wandb/wandb/core/pkg/server"
+)
+
+// writeProfilesOnSignal writes heap and goroutine profiles to a directory
+// on SIGUSR1, for diagnosing services that use unexpected resources.
+func writeProfilesOnSignal(dir string) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGUSR1)
+
+	for sig := range signals {
+		paths, err := server.WriteProfiles(dir)
+		if err != nil {
+			slog.Error("failed to write profiles", "signal", sig, "error", err)
+		}
+		if len(paths) > 0 {
+			slog.Info("wrote profiles", "signal", sig, "paths", paths)
+		}
+	}
+}
@@ -0,0 +1,6 @@
+package main
+
+// writeProfilesOnSignal does nothing since Windows has no SIGUSR1.
+//
+// Use the -pprof-port flag to get profiles instead.
+func writeProfilesOnSignal(dir string) {}
@@ -2,6 +2,8 @@
 package api
 
 import (
+	"context"
+	"crypto/tls"
 	"fmt"
 	"log/slog"
 	"net/http"
@@ -47,6 +49,15 @@ type Backend struct {
 
 	// API key for backend requests.
 	apiKey string
+
+	// Optional signer for requests to the backend.
+	signer RequestSigner
+
+	// Optional circuit breaker for requests to the backend.
+	breaker *CircuitBreaker
+
+	// Rate limit shared by all clients.
+	rateLimiter *RateLimiter
 }
 
 // An HTTP client for interacting with the W&B backend.
@@ -88,6 +99,9 @@ type clientImpl struct {
 
 	// Headers to pass in every request.
 	extraHeaders map[string]string
+
+	// The transport for streams opened with OpenStream.
+	streamTransport http.RoundTripper
 }
 
 // An HTTP request to the W&B backend.
@@ -133,6 +147,15 @@ type BackendOptions struct {
 
 	// W&B API key.
 	APIKey string
+
+	// Optional hook that signs every request sent to the backend.
+	//
+	// This is for deployments where traffic passes through a gateway that
+	// requires request signing.
+	Signer RequestSigner
+
+	// Optional circuit breaker shared by all clients of the backend.
+	CircuitBreaker *CircuitBreaker
 }
 
 // Creates a [Backend].
@@ -141,9 +164,40 @@ type BackendOptions struct {
 // including a final slash. Example "http://localhost:8080".
 func New(opts BackendOptions) *Backend {
 	return &Backend{
-		baseURL: opts.BaseURL,
-		logger:  opts.Logger,
-		apiKey:  opts.APIKey,
+		baseURL:     opts.BaseURL,
+		logger:      opts.Logger,
+		apiKey:      opts.APIKey,
+		signer:      opts.Signer,
+		breaker:     opts.CircuitBreaker,
+		rateLimiter: NewRateLimiter(),
+	}
+}
+
+// pauseRemaining returns how much longer requests to the backend are
+// paused, either because the server sent a Retry-After header or because
+// the circuit breaker is open.
+func (backend *Backend) pauseRemaining() time.Duration {
+	return max(
+		backend.rateLimiter.PauseRemaining(),
+		backend.breaker.Remaining(),
+	)
+}
+
+// waitForPause blocks while requests to the backend are paused.
+func (backend *Backend) waitForPause(ctx context.Context) error {
+	for {
+		remaining := backend.pauseRemaining()
+		if remaining <= 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(remaining)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
 	}
 }
 
@@ -194,6 +248,11 @@ type ClientOptions struct {
 	//
 	// If Proxy is nil or returns a nil *URL, no proxy will be used.
 	Proxy func(*http.Request) (*url.URL, error)
+
+	// TLS configuration for connections to the backend and other servers.
+	//
+	// If nil, the default configuration is used. See [NewTLSConfig].
+	TLSConfig *tls.Config
 }
 
 // Creates a new [Client] for making requests to the [Backend].
@@ -225,7 +284,8 @@ func (backend *Backend) NewClient(opts ClientOptions) Client {
 
 	// Set the Proxy function on the HTTP client.
 	transport := &http.Transport{
-		Proxy: opts.Proxy,
+		Proxy:           opts.Proxy,
+		TLSClientConfig: opts.TLSConfig,
 	}
 	// Set the "Proxy-Authorization" header for the CONNECT requests
 	// to the proxy server if the header is present in the extra headers.
@@ -238,15 +298,43 @@ func (backend *Backend) NewClient(opts ClientOptions) Client {
 		}
 	}
 
+	// Streams need HTTP/2 to send and receive at the same time, which the
+	// transport only attempts by default without custom TLS settings.
+	streamTransport := transport.Clone()
+	streamTransport.ForceAttemptHTTP2 = true
+
+	var delegate http.RoundTripper = transport
+	if backend.signer != nil {
+		delegate = NewSigningTransport(backend.signer, backend.baseURL, delegate)
+	}
+	if backend.breaker != nil {
+		delegate = NewCircuitBreakerTransport(backend.breaker, delegate)
+	}
+
+	// Wait out pauses requested by the server or by the circuit breaker
+	// between retries.
+	backoff := retryableHTTP.Backoff
+	retryableHTTP.Backoff = func(
+		waitMin, waitMax time.Duration,
+		attemptNum int,
+		resp *http.Response,
+	) time.Duration {
+		return max(
+			backoff(waitMin, waitMax, attemptNum, resp),
+			backend.pauseRemaining(),
+		)
+	}
+
 	retryableHTTP.HTTPClient.Transport =
 		NewPeekingTransport(
 			opts.NetworkPeeker,
-			NewRateLimitedTransport(transport),
+			NewRateLimitedTransport(backend.rateLimiter, delegate),
 		)
 
 	return &clientImpl{
-		backend:       backend,
-		retryableHTTP: retryableHTTP,
-		extraHeaders:  opts.ExtraHeaders,
+		backend:         backend,
+		retryableHTTP:   retryableHTTP,
+		extraHeaders:    opts.ExtraHeaders,
+		streamTransport: streamTransport,
 	}
 }
@@ -0,0 +1,188 @@
+package api
+
+import (
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// Default number of consecutive failures that opens a circuit breaker.
+	DefaultCircuitBreakerThreshold = 10
+
+	// Default time for which an open circuit breaker pauses requests.
+	DefaultCircuitBreakerCooldown = 30 * time.Second
+)
+
+// CircuitBreaker pauses requests to the W&B backend while it appears to
+// be down.
+//
+// After a number of consecutive failed requests, the breaker "opens" and
+// requests wait for a cooldown period instead of being sent. After the
+// cooldown, requests are sent again: the breaker closes on the first
+// success, and reopens on the first failure.
+//
+// Pausing requests rather than retrying them independently avoids
+// flooding a struggling server, and avoids giving up on requests that would
+// succeed once the server recovers. Data keeps being buffered locally in
+// the meantime, for example by the filestream, which batches updates while
+// waiting to send them.
+//
+// A CircuitBreaker is shared by all clients of a [Backend].
+type CircuitBreaker struct {
+	mu sync.Mutex
+
+	// Number of consecutive failures that opens the breaker.
+	threshold int
+
+	// Time for which the breaker pauses requests when it opens.
+	cooldown time.Duration
+
+	// Logger for state changes, or nil.
+	logger *slog.Logger
+
+	// Number of consecutive failed requests.
+	failures int
+
+	// Time until which requests are paused.
+	openUntil time.Time
+
+	// Returns the current time; replaced in tests.
+	now func() time.Time
+}
+
+type CircuitBreakerParams struct {
+	// Number of consecutive failed requests after which requests are paused.
+	//
+	// If zero or negative, NewCircuitBreaker returns nil.
+	Threshold int
+
+	// How long to pause requests.
+	Cooldown time.Duration
+
+	// Logger for when the breaker opens and closes.
+	Logger *slog.Logger
+
+	// Returns the current time. Defaults to time.Now.
+	Now func() time.Time
+}
+
+// NewCircuitBreaker returns a circuit breaker, or nil if it's disabled.
+//
+// A nil *CircuitBreaker is valid and never pauses requests.
+func NewCircuitBreaker(params CircuitBreakerParams) *CircuitBreaker {
+	if params.Threshold <= 0 {
+		return nil
+	}
+
+	now := params.Now
+	if now == nil {
+		now = time.Now
+	}
+
+	return &CircuitBreaker{
+		threshold: params.Threshold,
+		cooldown:  params.Cooldown,
+		logger:    params.Logger,
+		now:       now,
+	}
+}
+
+// IsOpen returns whether requests are currently paused.
+func (cb *CircuitBreaker) IsOpen() bool {
+	return cb.Remaining() > 0
+}
+
+// Remaining returns how much longer requests are paused.
+func (cb *CircuitBreaker) Remaining() time.Duration {
+	if cb == nil {
+		return 0
+	}
+
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	return max(0, cb.openUntil.Sub(cb.now()))
+}
+
+// Record updates the breaker with the outcome of a request.
+//
+// Connection errors and server errors count as failures. Requests that
+// were cancelled by the client are ignored.
+func (cb *CircuitBreaker) Record(
+	req *http.Request,
+	resp *http.Response,
+	err error,
+) {
+	if cb == nil || req.Context().Err() != nil {
+		return
+	}
+
+	if err != nil || isServerFailure(resp) {
+		cb.recordFailure()
+	} else {
+		cb.recordSuccess()
+	}
+}
+
+func (cb *CircuitBreaker) recordFailure() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	cb.failures++
+	if cb.failures < cb.threshold {
+		return
+	}
+
+	now := cb.now()
+	if cb.logger != nil && !cb.openUntil.After(now) {
+		cb.logger.Warn(
+			"api: too many failed requests, pausing requests",
+			"failures", cb.failures,
+			"cooldown", cb.cooldown,
+		)
+	}
+	cb.openUntil = now.Add(cb.cooldown)
+}
+
+func (cb *CircuitBreaker) recordSuccess() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if cb.logger != nil && cb.failures >= cb.threshold {
+		cb.logger.Info("api: requests succeeding again, resuming")
+	}
+	cb.failures = 0
+	cb.openUntil = time.Time{}
+}
+
+// isServerFailure returns whether a response indicates that the server
+// is failing, rather than that the request was bad.
+func isServerFailure(resp *http.Response) bool {
+	return resp.StatusCode >= 500 &&
+		resp.StatusCode != http.StatusNotImplemented
+}
+
+// CircuitBreakerTransport records the outcome of each request in
+// a [CircuitBreaker].
+//
+// Implements [http.RoundTripper] for use as a transport for an HTTP client.
+type CircuitBreakerTransport struct {
+	delegate http.RoundTripper
+	breaker  *CircuitBreaker
+}
+
+func NewCircuitBreakerTransport(
+	breaker *CircuitBreaker,
+	delegate http.RoundTripper,
+) *CircuitBreakerTransport {
+	return &CircuitBreakerTransport{delegate: delegate, breaker: breaker}
+}
+
+func (transport *CircuitBreakerTransport) RoundTrip(
+	req *http.Request,
+) (*http.Response, error) {
+	resp, err := transport.delegate.RoundTrip(req)
+	transport.breaker.Record(req, resp, err)
+	return resp, err
+}
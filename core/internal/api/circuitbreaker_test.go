@@ -0,0 +1,128 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/api"
+)
+
+// fakeClock is a manually advanced clock.
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func newTestBreaker(threshold int, clock *fakeClock) *api.CircuitBreaker {
+	return api.NewCircuitBreaker(api.CircuitBreakerParams{
+		Threshold: threshold,
+		Cooldown:  time.Minute,
+		Now:       clock.Now,
+	})
+}
+
+func withStatus(status int) *http.Response {
+	return &http.Response{StatusCode: status}
+}
+
+func TestCircuitBreaker_OpensAfterThreshold(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	breaker := newTestBreaker(3, clock)
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+
+	breaker.Record(req, withStatus(500), nil)
+	breaker.Record(req, nil, errors.New("connection refused"))
+	assert.False(t, breaker.IsOpen())
+	breaker.Record(req, withStatus(503), nil)
+
+	assert.True(t, breaker.IsOpen())
+	assert.Equal(t, time.Minute, breaker.Remaining())
+}
+
+func TestCircuitBreaker_IgnoresClientErrors(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	breaker := newTestBreaker(2, clock)
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	breaker.Record(req, withStatus(500), nil)
+	breaker.Record(req.WithContext(cancelled), nil, context.Canceled)
+	breaker.Record(req, withStatus(http.StatusNotImplemented), nil)
+	assert.False(t, breaker.IsOpen())
+
+	breaker.Record(req, withStatus(400), nil)
+	breaker.Record(req, withStatus(500), nil)
+	assert.False(t, breaker.IsOpen())
+}
+
+func TestCircuitBreaker_ReopensOrCloses(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	breaker := newTestBreaker(2, clock)
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	breaker.Record(req, withStatus(500), nil)
+	breaker.Record(req, withStatus(500), nil)
+
+	clock.now = clock.now.Add(time.Minute)
+	assert.False(t, breaker.IsOpen())
+	breaker.Record(req, withStatus(500), nil)
+	assert.True(t, breaker.IsOpen())
+
+	clock.now = clock.now.Add(time.Minute)
+	breaker.Record(req, withStatus(200), nil)
+	breaker.Record(req, withStatus(500), nil)
+	assert.False(t, breaker.IsOpen())
+}
+
+func TestCircuitBreaker_Disabled(t *testing.T) {
+	breaker := api.NewCircuitBreaker(api.CircuitBreakerParams{Threshold: 0})
+
+	assert.Nil(t, breaker)
+	assert.False(t, breaker.IsOpen())
+	assert.Zero(t, breaker.Remaining())
+}
+
+func TestCircuitBreaker_PausesRetries(t *testing.T) {
+	cooldown := 50 * time.Millisecond
+	requests := 0
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			if requests == 1 {
+				w.WriteHeader(http.StatusInternalServerError)
+			} else {
+				w.WriteHeader(http.StatusOK)
+			}
+		}))
+	defer server.Close()
+	baseURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	backend := api.New(api.BackendOptions{
+		BaseURL: baseURL,
+		CircuitBreaker: api.NewCircuitBreaker(api.CircuitBreakerParams{
+			Threshold: 1,
+			Cooldown:  cooldown,
+		}),
+	})
+	client := backend.NewClient(api.ClientOptions{
+		RetryMax:     1,
+		RetryWaitMin: time.Millisecond,
+		RetryWaitMax: time.Millisecond,
+	})
+
+	start := time.Now()
+	resp, err := client.Send(&api.Request{Method: http.MethodGet, Path: "x"})
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 2, requests)
+	assert.GreaterOrEqual(t, time.Since(start), cooldown/2)
+}
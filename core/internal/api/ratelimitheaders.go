@@ -3,6 +3,7 @@ package api
 import (
 	"net/http"
 	"strconv"
+	"time"
 )
 
 // Values of the RateLimit headers defined in
@@ -36,3 +37,33 @@ func ParseRateLimitHeaders(header http.Header) (RateLimitHeaders, bool) {
 		Reset:     rlReset,
 	}, true
 }
+
+// Parses the Retry-After header of a response that asks the client to
+// slow down, returning how long to wait from now.
+//
+// Only 429 Too Many Requests and 503 Service Unavailable responses are
+// considered. The header may be a number of seconds or an HTTP date.
+func ParseRetryAfter(resp *http.Response, now time.Time) (time.Duration, bool) {
+	if resp.StatusCode != http.StatusTooManyRequests &&
+		resp.StatusCode != http.StatusServiceUnavailable {
+		return 0, false
+	}
+
+	header := resp.Header.Get("Retry-After")
+	if header == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.ParseFloat(header, 64); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds * float64(time.Second)), true
+	}
+
+	if date, err := http.ParseTime(header); err == nil {
+		return max(0, date.Sub(now)), true
+	}
+
+	return 0, false
+}
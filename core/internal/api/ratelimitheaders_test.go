@@ -3,6 +3,7 @@ package api_test
 import (
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/wandb/wandb/core/internal/api"
@@ -59,3 +60,44 @@ func Test_ResetNegative(t *testing.T) {
 
 	assert.False(t, ok)
 }
+
+func retryAfterResponse(status int, retryAfter string) *http.Response {
+	header := http.Header{}
+	header.Set("Retry-After", retryAfter)
+	return &http.Response{StatusCode: status, Header: header}
+}
+
+func Test_RetryAfterSeconds(t *testing.T) {
+	resp := retryAfterResponse(http.StatusTooManyRequests, "1.5")
+
+	wait, ok := api.ParseRetryAfter(resp, time.Now())
+
+	assert.True(t, ok)
+	assert.Equal(t, 1500*time.Millisecond, wait)
+}
+
+func Test_RetryAfterDate(t *testing.T) {
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	resp := retryAfterResponse(
+		http.StatusServiceUnavailable,
+		now.Add(time.Minute).Format(http.TimeFormat),
+	)
+
+	wait, ok := api.ParseRetryAfter(resp, now)
+
+	assert.True(t, ok)
+	assert.Equal(t, time.Minute, wait)
+}
+
+func Test_RetryAfterIgnored(t *testing.T) {
+	for _, resp := range []*http.Response{
+		retryAfterResponse(http.StatusOK, "10"),
+		retryAfterResponse(http.StatusTooManyRequests, "soon"),
+		retryAfterResponse(http.StatusTooManyRequests, "-1"),
+		{StatusCode: http.StatusTooManyRequests, Header: http.Header{}},
+	} {
+		_, ok := api.ParseRetryAfter(resp, time.Now())
+
+		assert.False(t, ok)
+	}
+}
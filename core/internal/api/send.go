@@ -22,8 +22,8 @@ func (client *clientImpl) Send(req *Request) (*http.Response, error) {
 	for headerKey, headerValue := range req.Headers {
 		retryableReq.Header.Set(headerKey, headerValue)
 	}
-	client.setClientHeaders(retryableReq)
-	client.setAuthHeaders(retryableReq)
+	client.setClientHeaders(retryableReq.Header)
+	client.setAuthHeaders(retryableReq.Header)
 
 	// Prevent the connection from being re-used in case of an error.
 	// TODO: There is an unlikely scenario when an attempt to reuse the connection
@@ -70,8 +70,12 @@ func (client *clientImpl) isToWandb(req *http.Request) bool {
 func (client *clientImpl) sendToWandbBackend(
 	req *retryablehttp.Request,
 ) (*http.Response, error) {
-	client.setClientHeaders(req)
-	client.setAuthHeaders(req)
+	client.setClientHeaders(req.Header)
+	client.setAuthHeaders(req.Header)
+
+	if err := client.backend.waitForPause(req.Context()); err != nil {
+		return nil, fmt.Errorf("api: failed sending: %v", err)
+	}
 
 	resp, err := client.send(req)
 
@@ -100,15 +104,15 @@ func (client *clientImpl) send(
 	return resp, nil
 }
 
-func (client *clientImpl) setClientHeaders(req *retryablehttp.Request) {
+func (client *clientImpl) setClientHeaders(header http.Header) {
 	for headerKey, headerValue := range client.extraHeaders {
-		req.Header.Set(headerKey, headerValue)
+		header.Set(headerKey, headerValue)
 	}
 }
 
-func (client *clientImpl) setAuthHeaders(req *retryablehttp.Request) {
-	req.Header.Set("User-Agent", "wandb-core")
-	req.Header.Set(
+func (client *clientImpl) setAuthHeaders(header http.Header) {
+	header.Set("User-Agent", "wandb-core")
+	header.Set(
 		"Authorization",
 		"Basic "+base64.StdEncoding.EncodeToString(
 			[]byte("api:"+client.backend.apiKey)),
@@ -0,0 +1,187 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// Default time allowed for an external signing command to respond.
+const DefaultSigningTimeout = 10 * time.Second
+
+// A RequestSigner computes headers that authenticate an outgoing request.
+//
+// Signing happens right before each attempt is sent, including retries,
+// so that signatures that embed a timestamp remain fresh.
+type RequestSigner interface {
+	// Sign returns the headers to add to the request.
+	//
+	// The request must not be modified. The body is the full request body,
+	// which is also available to later stages.
+	Sign(req *http.Request, body []byte) (map[string]string, error)
+}
+
+// SigningInput is the information about a request that is passed to an
+// external signing command.
+//
+// It leaves out the request's credentials: the Authorization,
+// Proxy-Authorization and Cookie headers, and any user info in the URL.
+type SigningInput struct {
+	Method     string              `json:"method"`
+	URL        string              `json:"url"`
+	Path       string              `json:"path"`
+	Query      string              `json:"query"`
+	Headers    map[string][]string `json:"headers"`
+	BodySHA256 string              `json:"body_sha256"`
+}
+
+// credentialHeaders are headers that are not passed to signing commands,
+// which only need to know what is sent and not how it's authenticated.
+var credentialHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie"}
+
+// ExecSigner signs requests by running an external command.
+//
+// The command receives a JSON-encoded [SigningInput] on stdin and must print
+// a JSON object mapping header names to values on stdout, for example
+//
+//	{"X-Gateway-Signature": "...", "X-Gateway-Timestamp": "..."}
+//
+// A non-zero exit code fails the request.
+type ExecSigner struct {
+	// Command is the executable followed by its arguments.
+	Command []string
+
+	// Timeout is the maximum time to wait for the command.
+	Timeout time.Duration
+}
+
+// NewExecSigner returns an ExecSigner for the command, or nil if the command
+// is empty.
+func NewExecSigner(command []string) *ExecSigner {
+	if len(command) == 0 {
+		return nil
+	}
+
+	return &ExecSigner{
+		Command: command,
+		Timeout: DefaultSigningTimeout,
+	}
+}
+
+func (s *ExecSigner) Sign(
+	req *http.Request,
+	body []byte,
+) (map[string]string, error) {
+	digest := sha256.Sum256(body)
+
+	requestURL := *req.URL
+	requestURL.User = nil
+
+	headers := req.Header.Clone()
+	for _, name := range credentialHeaders {
+		headers.Del(name)
+	}
+
+	input, err := json.Marshal(SigningInput{
+		Method:     req.Method,
+		URL:        requestURL.String(),
+		Path:       req.URL.EscapedPath(),
+		Query:      req.URL.RawQuery,
+		Headers:    headers,
+		BodySHA256: hex.EncodeToString(digest[:]),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("api: failed to encode signing input: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), s.Timeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, s.Command[0], s.Command[1:]...)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf(
+			"api: signing command failed: %v: %s",
+			err,
+			strings.TrimSpace(stderr.String()),
+		)
+	}
+
+	signature := make(map[string]string)
+	if err := json.Unmarshal(stdout.Bytes(), &signature); err != nil {
+		return nil, fmt.Errorf("api: invalid signing command output: %v", err)
+	}
+
+	return signature, nil
+}
+
+// An HTTP transport wrapper that signs requests to the W&B backend.
+type SigningTransport struct {
+	// The underlying transport to use for making requests.
+	delegate http.RoundTripper
+
+	// The signer to use.
+	signer RequestSigner
+
+	// The W&B backend URL; requests to other hosts are not signed.
+	baseURL *url.URL
+}
+
+func NewSigningTransport(
+	signer RequestSigner,
+	baseURL *url.URL,
+	delegate http.RoundTripper,
+) *SigningTransport {
+	return &SigningTransport{
+		delegate: delegate,
+		signer:   signer,
+		baseURL:  baseURL,
+	}
+}
+
+func (transport *SigningTransport) RoundTrip(
+	req *http.Request,
+) (*http.Response, error) {
+	if transport.baseURL != nil && req.URL.Host != transport.baseURL.Host {
+		return transport.delegate.RoundTrip(req)
+	}
+
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("api: failed to read body for signing: %v", err)
+		}
+	}
+
+	headers, err := transport.signer.Sign(req, body)
+	if err != nil {
+		return nil, err
+	}
+
+	// A RoundTripper must not modify the original request.
+	signedReq := req.Clone(req.Context())
+	if req.Body != nil {
+		signedReq.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	for key, value := range headers {
+		signedReq.Header.Set(key, value)
+	}
+
+	return transport.delegate.RoundTrip(signedReq)
+}
@@ -0,0 +1,156 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// A StreamClient opens long-lived requests to the W&B backend whose body
+// is sent while the response is being read.
+//
+// The [Client] returned by [Backend.NewClient] implements StreamClient.
+type StreamClient interface {
+	// OpenStream starts a streaming POST request to the backend.
+	//
+	// The request is sent with the same headers, proxy and TLS settings as
+	// Send, and counts toward the backend's rate limit and circuit breaker.
+	// It is not retried.
+	OpenStream(ctx context.Context, req *StreamRequest) (*Stream, error)
+}
+
+// A streaming request to the W&B backend.
+type StreamRequest struct {
+	// The request path, not including the scheme or hostname.
+	Path string
+
+	// Additional HTTP headers to include in the request.
+	//
+	// As in [Request], the client headers take precedence.
+	Headers map[string]string
+}
+
+// Stream is an open streaming request.
+//
+// Sending and receiving at the same time requires HTTP/2, which is only
+// used for https URLs.
+type Stream struct {
+	body   *io.PipeWriter
+	cancel context.CancelFunc
+
+	// done is closed once the response headers are received or the request
+	// fails, after which resp and err are set.
+	done chan struct{}
+	resp *http.Response
+	err  error
+
+	closeOnce sync.Once
+}
+
+// Write sends data in the request body.
+//
+// It blocks until the data is handed to the connection.
+func (s *Stream) Write(p []byte) (int, error) {
+	return s.body.Write(p)
+}
+
+// CloseWrite ends the request body.
+func (s *Stream) CloseWrite() error {
+	return s.body.Close()
+}
+
+// Response blocks until the response headers are received.
+//
+// Servers may wait for data before responding, so this should only be
+// called after writing to the stream.
+func (s *Stream) Response() (*http.Response, error) {
+	<-s.done
+	return s.resp, s.err
+}
+
+// Close cancels the request and releases its connection.
+func (s *Stream) Close() error {
+	s.closeOnce.Do(func() {
+		s.cancel()
+		_ = s.body.Close()
+		<-s.done
+		if s.resp != nil {
+			_ = s.resp.Body.Close()
+		}
+	})
+	return nil
+}
+
+func (client *clientImpl) OpenStream(
+	ctx context.Context,
+	req *StreamRequest,
+) (*Stream, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	bodyReader, bodyWriter := io.Pipe()
+
+	httpReq, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		client.backend.baseURL.JoinPath(req.Path).String(),
+		bodyReader,
+	)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("api: failed to create stream request: %v", err)
+	}
+
+	for headerKey, headerValue := range req.Headers {
+		httpReq.Header.Set(headerKey, headerValue)
+	}
+	client.setClientHeaders(httpReq.Header)
+	client.setAuthHeaders(httpReq.Header)
+
+	if err := client.backend.waitForPause(ctx); err != nil {
+		cancel()
+		return nil, fmt.Errorf("api: failed opening stream: %w", err)
+	}
+	if err := client.backend.rateLimiter.Wait(ctx); err != nil {
+		cancel()
+		return nil, fmt.Errorf("api: failed opening stream: %w", err)
+	}
+
+	// The body isn't known when the stream opens, so only the request
+	// line and headers are signed.
+	if client.backend.signer != nil {
+		headers, err := client.backend.signer.Sign(httpReq, nil)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		for key, value := range headers {
+			httpReq.Header.Set(key, value)
+		}
+	}
+
+	stream := &Stream{
+		body:   bodyWriter,
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+
+	go func() {
+		defer close(stream.done)
+
+		resp, err := client.streamTransport.RoundTrip(httpReq)
+		client.backend.breaker.Record(httpReq, resp, err)
+		if resp != nil {
+			client.backend.rateLimiter.Update(resp)
+		}
+
+		if err != nil {
+			stream.err = fmt.Errorf("api: failed opening stream: %v", err)
+			_ = bodyReader.CloseWithError(stream.err)
+			return
+		}
+		stream.resp = resp
+	}()
+
+	return stream, nil
+}
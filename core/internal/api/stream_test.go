@@ -0,0 +1,138 @@
+package api_test
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/api"
+)
+
+// newStreamServer starts an HTTP/2 server that echoes each line of the
+// request body as soon as it's received.
+func newStreamServer(t *testing.T, headers chan<- http.Header) *httptest.Server {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if headers != nil {
+				headers <- r.Header.Clone()
+			}
+
+			w.WriteHeader(http.StatusOK)
+			lines := bufio.NewScanner(r.Body)
+			for lines.Scan() {
+				_, _ = fmt.Fprintf(w, "echo %s\n", lines.Text())
+				w.(http.Flusher).Flush()
+			}
+		}))
+	server.EnableHTTP2 = true
+	server.StartTLS()
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newStreamClient(
+	t *testing.T,
+	server *httptest.Server,
+	opts api.BackendOptions,
+) api.StreamClient {
+	baseURL, err := url.Parse(server.URL + "/wandb")
+	require.NoError(t, err)
+	opts.BaseURL = baseURL
+
+	client := api.New(opts).NewClient(api.ClientOptions{
+		ExtraHeaders: map[string]string{"X-Extra": "extra"},
+		TLSConfig:    server.Client().Transport.(*http.Transport).TLSClientConfig,
+	})
+	return client.(api.StreamClient)
+}
+
+func TestOpenStream_SendsAndReceivesAtOnce(t *testing.T) {
+	headers := make(chan http.Header, 1)
+	server := newStreamServer(t, headers)
+	signer := &fakeSigner{}
+	client := newStreamClient(t, server, api.BackendOptions{
+		APIKey: "test-key",
+		Signer: signer,
+	})
+
+	stream, err := client.OpenStream(context.Background(), &api.StreamRequest{
+		Path:    "stream",
+		Headers: map[string]string{"Content-Type": "text/plain"},
+	})
+	require.NoError(t, err)
+	defer stream.Close()
+
+	_, err = stream.Write([]byte("one\n"))
+	require.NoError(t, err)
+	resp, err := stream.Response()
+	require.NoError(t, err)
+	assert.Equal(t, 2, resp.ProtoMajor)
+	lines := bufio.NewReader(resp.Body)
+
+	// Each line is answered before the next is sent.
+	for _, line := range []string{"one", "two"} {
+		if line != "one" {
+			_, err = stream.Write([]byte(line + "\n"))
+			require.NoError(t, err)
+		}
+		echo, err := lines.ReadString('\n')
+		require.NoError(t, err)
+		assert.Equal(t, "echo "+line+"\n", echo)
+	}
+
+	header := <-headers
+	assert.Equal(t, "Basic YXBpOnRlc3Qta2V5", header.Get("Authorization"))
+	assert.Equal(t, "extra", header.Get("X-Extra"))
+	assert.Equal(t, "text/plain", header.Get("Content-Type"))
+	assert.Equal(t, "POST /wandb/stream", header.Get("X-Signature"))
+	assert.Equal(t, [][]byte{nil}, signer.bodies)
+}
+
+func TestOpenStream_RecordsFailures(t *testing.T) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+	server.EnableHTTP2 = true
+	server.StartTLS()
+	defer server.Close()
+	breaker := newTestBreaker(1, &fakeClock{now: time.Now()})
+	client := newStreamClient(t, server, api.BackendOptions{
+		CircuitBreaker: breaker,
+	})
+
+	stream, err := client.OpenStream(context.Background(), &api.StreamRequest{})
+	require.NoError(t, err)
+	defer stream.Close()
+	resp, err := stream.Response()
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.True(t, breaker.IsOpen())
+}
+
+func TestOpenStream_WaitsForPause(t *testing.T) {
+	server := newStreamServer(t, nil)
+	breaker := newTestBreaker(1, &fakeClock{now: time.Now()})
+	breaker.Record(
+		httptest.NewRequest(http.MethodGet, "/", nil),
+		withStatus(http.StatusInternalServerError),
+		nil,
+	)
+	client := newStreamClient(t, server, api.BackendOptions{
+		CircuitBreaker: breaker,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := client.OpenStream(ctx, &api.StreamRequest{})
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -0,0 +1,56 @@
+package api
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// NewTLSConfig returns the TLS configuration for talking to a W&B server
+// that uses a private certificate authority or requires client
+// certificates, such as one behind an mTLS-terminating gateway.
+//
+// caFile is a PEM file with root certificates to trust in addition to the
+// system's. certFile and keyFile are PEM files with a client certificate
+// and its private key, and must be given together. Empty paths are
+// ignored; if all are empty, NewTLSConfig returns nil, meaning the
+// default configuration.
+func NewTLSConfig(caFile, certFile, keyFile string) (*tls.Config, error) {
+	if caFile == "" && certFile == "" && keyFile == "" {
+		return nil, nil
+	}
+
+	config := &tls.Config{}
+
+	if caFile != "" {
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("api: failed to read CA file: %v", err)
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("api: no certificates found in %s", caFile)
+		}
+		config.RootCAs = pool
+	}
+
+	switch {
+	case certFile != "" && keyFile != "":
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("api: failed to load client certificate: %v", err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	case certFile != "" || keyFile != "":
+		return nil, errors.New(
+			"api: client certificate and key must be set together")
+	}
+
+	return config, nil
+}
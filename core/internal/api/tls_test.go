@@ -0,0 +1,174 @@
+package api_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/api"
+)
+
+// testCert is a certificate and its private key.
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	der  []byte
+}
+
+// newTestCert creates a certificate signed by parent, or a self-signed CA
+// certificate if parent is nil.
+func newTestCert(t *testing.T, name string, parent *testCert) *testCert {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+		ExtKeyUsage: []x509.ExtKeyUsage{
+			x509.ExtKeyUsageServerAuth,
+			x509.ExtKeyUsageClientAuth,
+		},
+	}
+
+	signer, signerKey := template, key
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage = x509.KeyUsageCertSign
+	} else {
+		signer, signerKey = parent.cert, parent.key
+	}
+
+	der, err := x509.CreateCertificate(
+		rand.Reader, template, signer, &key.PublicKey, signerKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &testCert{cert: cert, key: key, der: der}
+}
+
+// writePEM writes the certificate and key to PEM files.
+func (c *testCert) writePEM(t *testing.T, dir string) (certFile, keyFile string) {
+	t.Helper()
+	keyDER, err := x509.MarshalECPrivateKey(c.key)
+	require.NoError(t, err)
+
+	certFile = filepath.Join(dir, c.cert.Subject.CommonName+".crt")
+	keyFile = filepath.Join(dir, c.cert.Subject.CommonName+".key")
+	require.NoError(t, os.WriteFile(certFile,
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.der}),
+		0600))
+	require.NoError(t, os.WriteFile(keyFile,
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+		0600))
+	return certFile, keyFile
+}
+
+// newMTLSServer starts a server whose certificate is signed by ca and
+// that requires a client certificate signed by ca.
+func newMTLSServer(t *testing.T, ca *testCert) *httptest.Server {
+	t.Helper()
+	serverCert := newTestCert(t, "server", ca)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(ca.cert)
+
+	server := httptest.NewUnstartedServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+	server.TLS = &tls.Config{
+		Certificates: []tls.Certificate{{
+			Certificate: [][]byte{serverCert.der},
+			PrivateKey:  serverCert.key,
+		}},
+		ClientCAs:  clientCAs,
+		ClientAuth: tls.RequireAndVerifyClientCert,
+	}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestNewTLSConfig_MutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCert(t, "ca", nil)
+	caFile, _ := ca.writePEM(t, dir)
+	certFile, keyFile := newTestCert(t, "client", ca).writePEM(t, dir)
+	server := newMTLSServer(t, ca)
+
+	config, err := api.NewTLSConfig(caFile, certFile, keyFile)
+	require.NoError(t, err)
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/wandb/xyz", nil)
+	resp, err := newClient(t, server.URL+"/wandb", api.ClientOptions{
+		TLSConfig: config,
+	}).Do(req)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestNewTLSConfig_NoClientCert_Fails(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCert(t, "ca", nil)
+	caFile, _ := ca.writePEM(t, dir)
+	server := newMTLSServer(t, ca)
+
+	config, err := api.NewTLSConfig(caFile, "", "")
+	require.NoError(t, err)
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/wandb/xyz", nil)
+	_, err = newClient(t, server.URL+"/wandb", api.ClientOptions{
+		TLSConfig: config,
+	}).Do(req)
+
+	assert.Error(t, err)
+}
+
+func TestNewTLSConfig_Empty(t *testing.T) {
+	config, err := api.NewTLSConfig("", "", "")
+
+	assert.NoError(t, err)
+	assert.Nil(t, config)
+}
+
+func TestNewTLSConfig_Errors(t *testing.T) {
+	dir := t.TempDir()
+	notPEM := filepath.Join(dir, "not.pem")
+	require.NoError(t, os.WriteFile(notPEM, []byte("not a cert"), 0600))
+	certFile, _ := newTestCert(t, "client", nil).writePEM(t, dir)
+
+	testCases := []struct {
+		name                      string
+		caFile, certFile, keyFile string
+	}{
+		{"missing CA file", filepath.Join(dir, "missing.pem"), "", ""},
+		{"no certificates in CA file", notPEM, "", ""},
+		{"certificate without key", "", certFile, ""},
+		{"invalid key", "", certFile, notPEM},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := api.NewTLSConfig(tc.caFile, tc.certFile, tc.keyFile)
+
+			assert.Error(t, err)
+		})
+	}
+}
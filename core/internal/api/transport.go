@@ -1,31 +1,41 @@
 package api
 
 import (
+	"context"
 	"net/http"
+	"sync"
 	"time"
 
 	"golang.org/x/time/rate"
 )
 
-// A rate-limited HTTP transport for requests to the W&B backend.
+// maxRetryAfter is the longest a Retry-After header can pause requests.
 //
-// Implements [http.RoundTripper] for use as a transport for an HTTP client.
-type RateLimitedTransport struct {
-	delegate http.RoundTripper
+// This protects against a misconfigured server or proxy stopping all
+// uploads indefinitely.
+const maxRetryAfter = 5 * time.Minute
 
+// A rate limit for all requests to the W&B backend.
+//
+// The server's quota applies to all of a user's requests, so a single
+// RateLimiter is shared by all clients of a [Backend]. This way, clients
+// for different APIs slow down together when the server pushes back,
+// rather than each retrying on its own and adding to the load.
+type RateLimiter struct {
 	// Rate limit for all outgoing requests.
 	rateLimiter *rate.Limiter
 
 	// Dynamic adjustments to the rate-limit based on server backpressure.
 	rlTracker *RateLimitTracker
+
+	mu sync.Mutex
+
+	// Time until which the server asked us not to send requests.
+	pausedUntil time.Time
 }
 
-// Rate-limits an HTTP transport for the W&B backend.
-func NewRateLimitedTransport(
-	delegate http.RoundTripper,
-) *RateLimitedTransport {
-	return &RateLimitedTransport{
-		delegate:    delegate,
+func NewRateLimiter() *RateLimiter {
+	return &RateLimiter{
 		rateLimiter: rate.NewLimiter(maxRequestsPerSecond, maxBurst),
 		rlTracker: NewRateLimitTracker(RateLimitTrackerParams{
 			MinPerSecond: minRequestsPerSecond,
@@ -38,6 +48,68 @@ func NewRateLimitedTransport(
 	}
 }
 
+// Wait blocks until the rate limit allows making a request.
+//
+// Returns an error if the context is cancelled or if the rate limit
+// exceeds the context's deadline.
+func (rl *RateLimiter) Wait(ctx context.Context) error {
+	if err := rl.rateLimiter.Wait(ctx); err != nil {
+		return err
+	}
+
+	rl.rlTracker.TrackRequest()
+	return nil
+}
+
+// PauseRemaining returns how much longer the server asked us to wait
+// before sending more requests, based on the last Retry-After header.
+func (rl *RateLimiter) PauseRemaining() time.Duration {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	return max(0, time.Until(rl.pausedUntil))
+}
+
+// Update adjusts the rate limit based on the headers of a response.
+func (rl *RateLimiter) Update(resp *http.Response) {
+	if retryAfter, ok := ParseRetryAfter(resp, time.Now()); ok {
+		rl.mu.Lock()
+		pausedUntil := time.Now().Add(min(retryAfter, maxRetryAfter))
+		if pausedUntil.After(rl.pausedUntil) {
+			rl.pausedUntil = pausedUntil
+		}
+		rl.mu.Unlock()
+	}
+
+	rateLimit, ok := ParseRateLimitHeaders(resp.Header)
+	if !ok {
+		return
+	}
+
+	rl.rlTracker.UpdateEstimates(time.Now(), rateLimit)
+	rl.rateLimiter.SetLimit(rate.Limit(rl.rlTracker.TargetRateLimit()))
+}
+
+// A rate-limited HTTP transport for requests to the W&B backend.
+//
+// Implements [http.RoundTripper] for use as a transport for an HTTP client.
+type RateLimitedTransport struct {
+	delegate http.RoundTripper
+
+	// Rate limit shared with other transports.
+	rateLimiter *RateLimiter
+}
+
+// Rate-limits an HTTP transport for the W&B backend.
+func NewRateLimitedTransport(
+	rateLimiter *RateLimiter,
+	delegate http.RoundTripper,
+) *RateLimitedTransport {
+	return &RateLimitedTransport{
+		delegate:    delegate,
+		rateLimiter: rateLimiter,
+	}
+}
+
 func (transport *RateLimitedTransport) RoundTrip(
 	req *http.Request,
 ) (*http.Response, error) {
@@ -48,25 +120,11 @@ func (transport *RateLimitedTransport) RoundTrip(
 		return nil, err
 	}
 
-	transport.rlTracker.TrackRequest()
 	resp, err := transport.delegate.RoundTrip(req)
 
 	if resp != nil {
-		transport.processRateLimitHeaders(resp)
+		transport.rateLimiter.Update(resp)
 	}
 
 	return resp, err
 }
-
-func (transport *RateLimitedTransport) processRateLimitHeaders(
-	resp *http.Response,
-) {
-	rateLimit, ok := ParseRateLimitHeaders(resp.Header)
-	if !ok {
-		return
-	}
-
-	transport.rlTracker.UpdateEstimates(time.Now(), rateLimit)
-	transport.rateLimiter.SetLimit(rate.Limit(
-		transport.rlTracker.TargetRateLimit()))
-}
@@ -0,0 +1,49 @@
+package api_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/api"
+)
+
+func TestRetryAfter_PausesAllClients(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if requests.Add(1) == 1 {
+				w.Header().Set("Retry-After", "0.2")
+				w.WriteHeader(http.StatusTooManyRequests)
+			} else {
+				w.WriteHeader(http.StatusOK)
+			}
+		}))
+	defer server.Close()
+	baseURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	backend := api.New(api.BackendOptions{BaseURL: baseURL})
+	noRetries := api.ClientOptions{
+		RetryPolicy: func(context.Context, *http.Response, error) (bool, error) {
+			return false, nil
+		},
+	}
+	client1 := backend.NewClient(noRetries)
+	client2 := backend.NewClient(noRetries)
+
+	resp1, err1 := client1.Send(&api.Request{Method: http.MethodGet, Path: "a"})
+	start := time.Now()
+	resp2, err2 := client2.Send(&api.Request{Method: http.MethodGet, Path: "b"})
+
+	require.NoError(t, err1)
+	require.NoError(t, err2)
+	assert.Equal(t, http.StatusTooManyRequests, resp1.StatusCode)
+	assert.Equal(t, http.StatusOK, resp2.StatusCode)
+	assert.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+}
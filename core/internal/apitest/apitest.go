@@ -29,8 +29,9 @@ type FakeClient struct {
 	baseURL  *url.URL
 	requests []RequestCopy
 
-	response    *http.Response
-	responseErr error
+	response     *http.Response
+	responseBody string
+	responseErr  error
 }
 
 type RequestCopy struct {
@@ -60,6 +61,9 @@ func NewFakeClient(baseURLString string) *FakeClient {
 // TestResponse is an easy-to-use [http.Response] for tests.
 type TestResponse struct {
 	StatusCode int
+
+	// Body is the body of every response.
+	Body string
 }
 
 // SetResponse configures the client's response to all requests.
@@ -74,7 +78,7 @@ func (c *FakeClient) SetResponse(resp *TestResponse, err error) {
 		c.response = &http.Response{}
 		c.response.StatusCode = resp.StatusCode
 		c.response.Status = fmt.Sprintf("%d TEST", resp.StatusCode)
-		c.response.Body = io.NopCloser(strings.NewReader(""))
+		c.responseBody = resp.Body
 	}
 }
 
@@ -143,5 +147,12 @@ func (c *FakeClient) Do(req *http.Request) (*http.Response, error) {
 	})
 	c.requestCountCond.Broadcast()
 
-	return c.response, c.responseErr
+	if c.response == nil {
+		return nil, c.responseErr
+	}
+
+	// Each response needs its own body, since the caller consumes it.
+	resp := *c.response
+	resp.Body = io.NopCloser(strings.NewReader(c.responseBody))
+	return &resp, c.responseErr
 }
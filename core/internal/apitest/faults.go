@@ -0,0 +1,237 @@
+package apitest
+
+import (
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// FaultAdminPath is the path at which a FaultInjector's handler serves its
+// configuration.
+//
+// GET returns the configuration as YAML, and PUT replaces it with the
+// YAML or JSON request body.
+const FaultAdminPath = "/_faults"
+
+// FaultConfig describes failures to inject into requests.
+//
+// Rates are probabilities from 0 to 1, applied to each request
+// independently.
+type FaultConfig struct {
+	// Only requests whose path starts with this are affected.
+	//
+	// If empty, all requests are affected.
+	PathPrefix string `yaml:"path_prefix,omitempty"`
+
+	// Random delay added before handling each request, chosen uniformly
+	// between the minimum and maximum.
+	LatencyMin time.Duration `yaml:"latency_min,omitempty"`
+	LatencyMax time.Duration `yaml:"latency_max,omitempty"`
+
+	// Rate at which the connection is closed without a response.
+	DropRate float64 `yaml:"drop_rate,omitempty"`
+
+	// Error responses to return instead of handling a request.
+	Errors []FaultError `yaml:"errors,omitempty"`
+
+	// Rate at which a response body is cut off halfway and the connection
+	// closed, as if the network failed during the transfer.
+	TruncateRate float64 `yaml:"truncate_rate,omitempty"`
+}
+
+// FaultError is an error response to inject.
+type FaultError struct {
+	Status int     `yaml:"status"`
+	Rate   float64 `yaml:"rate"`
+
+	// If set, the value of the Retry-After header.
+	RetryAfter string `yaml:"retry_after,omitempty"`
+}
+
+// LoadFaultConfig reads a FaultConfig from a YAML file.
+func LoadFaultConfig(path string) (FaultConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return FaultConfig{}, fmt.Errorf("apitest: failed to read fault config: %v", err)
+	}
+	return parseFaultConfig(data)
+}
+
+func parseFaultConfig(data []byte) (FaultConfig, error) {
+	config := FaultConfig{}
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return FaultConfig{}, fmt.Errorf("apitest: invalid fault config: %v", err)
+	}
+	if config.LatencyMax < config.LatencyMin {
+		return FaultConfig{}, fmt.Errorf(
+			"apitest: invalid fault config: latency_max less than latency_min")
+	}
+	return config, nil
+}
+
+// FaultInjector injects latency, dropped connections, error responses and
+// truncated responses into an HTTP handler, for testing how clients
+// handle unreliable servers.
+//
+// It can wrap a replay handler or recording proxy, see NewReplayHandler
+// and NewRecordingProxy.
+type FaultInjector struct {
+	mu     sync.Mutex
+	config FaultConfig
+	rand   *rand.Rand
+}
+
+// NewFaultInjector returns a FaultInjector whose random choices are
+// determined by the seed.
+func NewFaultInjector(config FaultConfig, seed int64) *FaultInjector {
+	return &FaultInjector{
+		config: config,
+		rand:   rand.New(rand.NewSource(seed)),
+	}
+}
+
+// Config returns the current configuration.
+func (f *FaultInjector) Config() FaultConfig {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.config
+}
+
+// SetConfig replaces the configuration for subsequent requests.
+func (f *FaultInjector) SetConfig(config FaultConfig) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.config = config
+}
+
+// faults is what to do to a single request.
+type faults struct {
+	latency  time.Duration
+	drop     bool
+	err      *FaultError
+	truncate bool
+}
+
+// choose decides which faults to inject into a request.
+func (f *FaultInjector) choose(path string) faults {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	config := f.config
+	if !strings.HasPrefix(path, config.PathPrefix) {
+		return faults{}
+	}
+
+	chosen := faults{latency: config.LatencyMin}
+	if spread := config.LatencyMax - config.LatencyMin; spread > 0 {
+		chosen.latency += time.Duration(f.rand.Int63n(int64(spread)))
+	}
+
+	if f.rand.Float64() < config.DropRate {
+		chosen.drop = true
+		return chosen
+	}
+
+	for i := range config.Errors {
+		if f.rand.Float64() < config.Errors[i].Rate {
+			chosen.err = &config.Errors[i]
+			return chosen
+		}
+	}
+
+	chosen.truncate = f.rand.Float64() < config.TruncateRate
+	return chosen
+}
+
+// Wrap returns a handler that injects faults into requests to next.
+//
+// It also serves the configuration at FaultAdminPath.
+func (f *FaultInjector) Wrap(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == FaultAdminPath {
+			f.serveAdmin(w, r)
+			return
+		}
+
+		chosen := f.choose(r.URL.Path)
+
+		if chosen.latency > 0 {
+			select {
+			case <-time.After(chosen.latency):
+			case <-r.Context().Done():
+				return
+			}
+		}
+
+		switch {
+		case chosen.drop:
+			// Makes the server close the connection without responding.
+			panic(http.ErrAbortHandler)
+
+		case chosen.err != nil:
+			if chosen.err.RetryAfter != "" {
+				w.Header().Set("Retry-After", chosen.err.RetryAfter)
+			}
+			http.Error(w, "apitest: injected fault", chosen.err.Status)
+
+		case chosen.truncate:
+			recorder := httptest.NewRecorder()
+			next.ServeHTTP(recorder, r)
+			body := recorder.Body.Bytes()
+
+			for key, values := range recorder.Header() {
+				w.Header()[key] = values
+			}
+			w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+			w.WriteHeader(recorder.Code)
+			_, _ = w.Write(body[:len(body)/2])
+			if flusher, ok := w.(http.Flusher); ok {
+				flusher.Flush()
+			}
+			panic(http.ErrAbortHandler)
+
+		default:
+			next.ServeHTTP(w, r)
+		}
+	})
+}
+
+func (f *FaultInjector) serveAdmin(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		data, err := yaml.Marshal(f.Config())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/yaml")
+		_, _ = w.Write(data)
+
+	case http.MethodPut:
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		config, err := parseFaultConfig(data)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		f.SetConfig(config)
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		w.Header().Set("Allow", "GET, PUT")
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
@@ -0,0 +1,108 @@
+package apitest_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/apitest"
+)
+
+func newFaultServer(t *testing.T, config apitest.FaultConfig) (*httptest.Server, *apitest.FaultInjector) {
+	t.Helper()
+	injector := apitest.NewFaultInjector(config, 1)
+	server := httptest.NewServer(injector.Wrap(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte("0123456789"))
+		})))
+	t.Cleanup(server.Close)
+	return server, injector
+}
+
+func TestFaults_Errors(t *testing.T) {
+	server, _ := newFaultServer(t, apitest.FaultConfig{
+		PathPrefix: "/graphql",
+		Errors: []apitest.FaultError{
+			{Status: http.StatusTooManyRequests, Rate: 1, RetryAfter: "3"},
+		},
+	})
+
+	faulty, err := http.Get(server.URL + "/graphql")
+	require.NoError(t, err)
+	ok, err := http.Get(server.URL + "/files")
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusTooManyRequests, faulty.StatusCode)
+	assert.Equal(t, "3", faulty.Header.Get("Retry-After"))
+	assert.Equal(t, http.StatusOK, ok.StatusCode)
+}
+
+func TestFaults_Drop(t *testing.T) {
+	server, _ := newFaultServer(t, apitest.FaultConfig{DropRate: 1})
+
+	_, err := http.Get(server.URL)
+
+	assert.Error(t, err)
+}
+
+func TestFaults_Truncate(t *testing.T) {
+	server, _ := newFaultServer(t, apitest.FaultConfig{TruncateRate: 1})
+
+	resp, err := http.Get(server.URL)
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	assert.Equal(t, "01234", string(body))
+}
+
+func TestFaults_Latency(t *testing.T) {
+	server, _ := newFaultServer(t, apitest.FaultConfig{
+		LatencyMin: 50 * time.Millisecond,
+		LatencyMax: 60 * time.Millisecond,
+	})
+
+	start := time.Now()
+	resp, err := http.Get(server.URL)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+}
+
+func TestFaults_AdminEndpoint(t *testing.T) {
+	server, injector := newFaultServer(t, apitest.FaultConfig{})
+	req, _ := http.NewRequest(
+		http.MethodPut,
+		server.URL+apitest.FaultAdminPath,
+		strings.NewReader("latency_max: 1s\nerrors:\n  - {status: 500, rate: 0.5}\n"),
+	)
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	get, err := http.Get(server.URL + apitest.FaultAdminPath)
+	require.NoError(t, err)
+	body, _ := io.ReadAll(get.Body)
+
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	assert.Equal(t, time.Second, injector.Config().LatencyMax)
+	assert.Equal(t, 500, injector.Config().Errors[0].Status)
+	assert.Contains(t, string(body), "latency_max: 1s")
+}
+
+func TestLoadFaultConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "faults.yaml")
+	require.NoError(t, os.WriteFile(path,
+		[]byte("drop_rate: 0.1\nlatency_min: 2s\nlatency_max: 1s\n"), 0644))
+
+	_, err := apitest.LoadFaultConfig(path)
+
+	assert.ErrorContains(t, err, "latency_max")
+}
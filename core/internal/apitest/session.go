@@ -0,0 +1,259 @@
+package apitest
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"sync"
+	"unicode/utf8"
+)
+
+// Session is a recording of HTTP traffic to the W&B backend, such as
+// GraphQL requests and file uploads, for replaying in hermetic tests.
+//
+// A session is recorded against a real server with a RecordingTransport
+// or NewRecordingProxy and saved to a JSON file. Tests then load it and
+// serve it with NewReplayHandler in place of the server.
+type Session struct {
+	mu sync.Mutex
+
+	Exchanges []*Exchange `json:"exchanges"`
+
+	// Which exchanges have been replayed.
+	replayed []bool
+}
+
+// Exchange is a request and the response to it.
+type Exchange struct {
+	Method string `json:"method"`
+
+	// The path and query of the request URL.
+	//
+	// The scheme and host are not recorded so that a session can be
+	// replayed at a different address.
+	URL string `json:"url"`
+
+	RequestBody SessionBody `json:"request_body,omitempty"`
+
+	// The cloud storage API call, if any. See StorageOperation.
+	//
+	// This is informational and isn't used for replaying.
+	Operation string `json:"operation,omitempty"`
+
+	Status         int         `json:"status"`
+	ResponseHeader http.Header `json:"response_header,omitempty"`
+	ResponseBody   SessionBody `json:"response_body,omitempty"`
+}
+
+// SessionBody is a request or response body.
+//
+// It is saved as a JSON string if it's valid UTF-8, which keeps GraphQL
+// bodies readable and editable, and as {"base64": "..."} otherwise.
+type SessionBody []byte
+
+func (b SessionBody) MarshalJSON() ([]byte, error) {
+	if utf8.Valid(b) {
+		return json.Marshal(string(b))
+	}
+	return json.Marshal(map[string]string{
+		"base64": base64.StdEncoding.EncodeToString(b),
+	})
+}
+
+func (b *SessionBody) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		*b = SessionBody(text)
+		return nil
+	}
+
+	var encoded struct {
+		Base64 string `json:"base64"`
+	}
+	if err := json.Unmarshal(data, &encoded); err != nil {
+		return err
+	}
+	decoded, err := base64.StdEncoding.DecodeString(encoded.Base64)
+	if err != nil {
+		return err
+	}
+	*b = decoded
+	return nil
+}
+
+// LoadSession reads a session saved with Save.
+func LoadSession(path string) (*Session, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("apitest: failed to read session: %v", err)
+	}
+
+	session := &Session{}
+	if err := json.Unmarshal(data, session); err != nil {
+		return nil, fmt.Errorf("apitest: failed to parse session: %v", err)
+	}
+	return session, nil
+}
+
+// Save writes the session to a JSON file.
+func (s *Session) Save(path string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("apitest: failed to encode session: %v", err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("apitest: failed to write session: %v", err)
+	}
+	return nil
+}
+
+// Add appends an exchange to the session.
+func (s *Session) Add(exchange *Exchange) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.Exchanges = append(s.Exchanges, exchange)
+}
+
+// Next returns the recorded response to a request, or nil if there is
+// none.
+//
+// Each exchange is replayed at most once, in the order recorded. An
+// exchange with the same method, URL and body is preferred. Otherwise, the
+// first exchange with the same method and URL is used, since request
+// bodies often contain timestamps or other values that change between
+// runs.
+func (s *Session) Next(method, url string, body []byte) *Exchange {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.replayed) < len(s.Exchanges) {
+		s.replayed = append(s.replayed,
+			make([]bool, len(s.Exchanges)-len(s.replayed))...)
+	}
+
+	fallback := -1
+	for i, exchange := range s.Exchanges {
+		if s.replayed[i] || exchange.Method != method || exchange.URL != url {
+			continue
+		}
+
+		if bytes.Equal(exchange.RequestBody, body) {
+			s.replayed[i] = true
+			return exchange
+		}
+		if fallback < 0 {
+			fallback = i
+		}
+	}
+
+	if fallback < 0 {
+		return nil
+	}
+	s.replayed[fallback] = true
+	return s.Exchanges[fallback]
+}
+
+// RecordingTransport is an [http.RoundTripper] that records all requests
+// and responses into a Session.
+type RecordingTransport struct {
+	Session  *Session
+	Delegate http.RoundTripper
+}
+
+func (t *RecordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var reqBody []byte
+	if req.Body != nil {
+		var err error
+		reqBody, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(reqBody))
+	}
+
+	resp, err := t.Delegate.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	t.Session.Add(&Exchange{
+		Method:         req.Method,
+		URL:            req.URL.RequestURI(),
+		RequestBody:    reqBody,
+		Operation:      StorageOperation(req),
+		Status:         resp.StatusCode,
+		ResponseHeader: resp.Header.Clone(),
+		ResponseBody:   respBody,
+	})
+	return resp, nil
+}
+
+// NewRecordingProxy returns a handler that forwards requests to target,
+// recording them into the session.
+//
+// Pointing a client's base URL at the proxy records its traffic with
+// the server.
+func NewRecordingProxy(target *url.URL, session *Session) http.Handler {
+	proxy := httputil.NewSingleHostReverseProxy(target)
+	proxy.Transport = &RecordingTransport{
+		Session:  session,
+		Delegate: http.DefaultTransport,
+	}
+
+	director := proxy.Director
+	proxy.Director = func(req *http.Request) {
+		director(req)
+		req.Host = target.Host
+	}
+	return proxy
+}
+
+// NewReplayHandler returns a handler that serves the responses recorded
+// in a session, without contacting any server.
+//
+// Requests that weren't recorded get a 404 Not Found response.
+func NewReplayHandler(session *Session) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		exchange := session.Next(r.Method, r.URL.RequestURI(), body)
+		if exchange == nil {
+			http.Error(w,
+				fmt.Sprintf("apitest: no recorded response for %s %s",
+					r.Method, r.URL.RequestURI()),
+				http.StatusNotFound)
+			return
+		}
+
+		for key, values := range exchange.ResponseHeader {
+			// The length may differ if the body was edited.
+			if key == "Content-Length" {
+				continue
+			}
+			w.Header()[key] = values
+		}
+		w.WriteHeader(exchange.Status)
+		_, _ = w.Write(exchange.ResponseBody)
+	})
+}
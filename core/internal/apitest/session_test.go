@@ -0,0 +1,62 @@
+package apitest_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/apitest"
+)
+
+func post(t *testing.T, url, body string) (int, string) {
+	t.Helper()
+	resp, err := http.Post(url, "application/json", strings.NewReader(body))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp.StatusCode, string(respBody)
+}
+
+func TestSession_RecordAndReplay(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			body, _ := io.ReadAll(r.Body)
+			_, _ = w.Write(append([]byte("echo "), body...))
+		}))
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	session := &apitest.Session{}
+	proxy := httptest.NewServer(apitest.NewRecordingProxy(serverURL, session))
+	path := filepath.Join(t.TempDir(), "session.json")
+
+	post(t, proxy.URL+"/graphql", `{"query": "a"}`)
+	post(t, proxy.URL+"/graphql", `{"query": "b"}`)
+	post(t, proxy.URL+"/files/x", "\xff\xfe")
+	proxy.Close()
+	server.Close()
+	require.NoError(t, session.Save(path))
+	loaded, err := apitest.LoadSession(path)
+	require.NoError(t, err)
+	replay := httptest.NewServer(apitest.NewReplayHandler(loaded))
+	defer replay.Close()
+
+	_, b := post(t, replay.URL+"/graphql", `{"query": "b"}`)
+	_, a := post(t, replay.URL+"/graphql", `{"query": "changed"}`)
+	_, x := post(t, replay.URL+"/files/x", "")
+	status, _ := post(t, replay.URL+"/graphql", `{"query": "a"}`)
+
+	assert.Equal(t, 3, requests)
+	assert.Equal(t, `echo {"query": "b"}`, b)
+	assert.Equal(t, `echo {"query": "a"}`, a)
+	assert.Equal(t, "echo \xff\xfe", x)
+	assert.Equal(t, http.StatusNotFound, status)
+}
@@ -0,0 +1,136 @@
+package apitest
+
+import (
+	"net/http"
+	"strings"
+)
+
+// StorageOperation names the cloud storage API call made by a request,
+// such as "s3:UploadPart", or returns "" if it's not a storage request.
+//
+// This labels the file uploads and downloads in a recorded Session, which
+// makes it easier to follow large artifact uploads that are split into
+// many requests. It recognizes the S3 API including multipart uploads,
+// the GCS JSON and XML APIs, and the Azure Blob API.
+func StorageOperation(req *http.Request) string {
+	switch {
+	case isAzureRequest(req):
+		return "azure:" + azureOperation(req)
+	case isGCSRequest(req):
+		return "gcs:" + gcsOperation(req)
+	case isS3Request(req):
+		return "s3:" + s3Operation(req)
+	default:
+		return ""
+	}
+}
+
+func isS3Request(req *http.Request) bool {
+	if strings.Contains(req.URL.Host, "amazonaws.com") {
+		return true
+	}
+
+	query := req.URL.Query()
+	for _, key := range []string{"X-Amz-Signature", "X-Amz-Credential"} {
+		if query.Has(key) {
+			return true
+		}
+	}
+	return req.Header.Get("X-Amz-Content-Sha256") != ""
+}
+
+func s3Operation(req *http.Request) string {
+	query := req.URL.Query()
+
+	switch {
+	case req.Method == http.MethodPost && query.Has("uploads"):
+		return "CreateMultipartUpload"
+	case req.Method == http.MethodPut && query.Has("uploadId"):
+		return "UploadPart"
+	case req.Method == http.MethodPost && query.Has("uploadId"):
+		return "CompleteMultipartUpload"
+	case req.Method == http.MethodDelete && query.Has("uploadId"):
+		return "AbortMultipartUpload"
+	case req.Method == http.MethodGet && query.Has("uploadId"):
+		return "ListParts"
+	}
+	return objectOperation(req)
+}
+
+func isGCSRequest(req *http.Request) bool {
+	return req.URL.Host == "storage.googleapis.com" ||
+		strings.HasSuffix(req.URL.Host, ".storage.googleapis.com") ||
+		req.URL.Query().Has("X-Goog-Signature")
+}
+
+func gcsOperation(req *http.Request) string {
+	query := req.URL.Query()
+
+	switch {
+	case strings.HasPrefix(req.URL.Path, "/upload/storage/"):
+		switch uploadType := query.Get("uploadType"); uploadType {
+		case "resumable":
+			if query.Has("upload_id") {
+				return "ResumableUploadChunk"
+			}
+			return "StartResumableUpload"
+		case "multipart", "media":
+			return "Upload"
+		default:
+			return "Upload:" + uploadType
+		}
+	case strings.HasPrefix(req.URL.Path, "/storage/"):
+		return "JSON:" + req.Method
+	case req.Header.Get("X-Goog-Resumable") == "start":
+		return "StartResumableUpload"
+	case query.Has("upload_id"):
+		return "ResumableUploadChunk"
+	}
+	return objectOperation(req)
+}
+
+func isAzureRequest(req *http.Request) bool {
+	return strings.HasSuffix(req.URL.Host, ".blob.core.windows.net") ||
+		req.Header.Get("X-Ms-Version") != ""
+}
+
+func azureOperation(req *http.Request) string {
+	switch req.URL.Query().Get("comp") {
+	case "block":
+		return "PutBlock"
+	case "blocklist":
+		if req.Method == http.MethodGet {
+			return "GetBlockList"
+		}
+		return "PutBlockList"
+	}
+
+	switch req.Method {
+	case http.MethodPut:
+		return "PutBlob"
+	case http.MethodGet:
+		return "GetBlob"
+	case http.MethodHead:
+		return "GetBlobProperties"
+	case http.MethodDelete:
+		return "DeleteBlob"
+	default:
+		return req.Method
+	}
+}
+
+// objectOperation names simple object requests in S3's terms.
+func objectOperation(req *http.Request) string {
+	switch req.Method {
+	case http.MethodGet:
+		return "GetObject"
+	case http.MethodPut:
+		return "PutObject"
+	case http.MethodHead:
+		return "HeadObject"
+	case http.MethodDelete:
+		return "DeleteObject"
+	default:
+		return req.Method
+	}
+}
@@ -0,0 +1,46 @@
+package apitest_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wandb/wandb/core/internal/apitest"
+)
+
+func TestStorageOperation(t *testing.T) {
+	testCases := []struct {
+		method, url string
+		header      map[string]string
+		expected    string
+	}{
+		{"POST", "https://b.s3.amazonaws.com/key?uploads", nil, "s3:CreateMultipartUpload"},
+		{"PUT", "https://b.s3.amazonaws.com/key?partNumber=2&uploadId=u", nil, "s3:UploadPart"},
+		{"POST", "https://b.s3.amazonaws.com/key?uploadId=u", nil, "s3:CompleteMultipartUpload"},
+		{"DELETE", "https://b.s3.amazonaws.com/key?uploadId=u", nil, "s3:AbortMultipartUpload"},
+		{"PUT", "http://minio:9000/b/key?X-Amz-Signature=s", nil, "s3:PutObject"},
+		{"HEAD", "http://minio:9000/b/key", map[string]string{"X-Amz-Content-Sha256": "x"}, "s3:HeadObject"},
+		{"POST", "https://storage.googleapis.com/upload/storage/v1/b/b/o?uploadType=resumable", nil, "gcs:StartResumableUpload"},
+		{"PUT", "https://storage.googleapis.com/upload/storage/v1/b/b/o?uploadType=resumable&upload_id=u", nil, "gcs:ResumableUploadChunk"},
+		{"POST", "https://storage.googleapis.com/upload/storage/v1/b/b/o?uploadType=multipart", nil, "gcs:Upload"},
+		{"GET", "https://storage.googleapis.com/storage/v1/b/b/o/key", nil, "gcs:JSON:GET"},
+		{"POST", "https://storage.googleapis.com/b/key", map[string]string{"X-Goog-Resumable": "start"}, "gcs:StartResumableUpload"},
+		{"PUT", "https://storage.googleapis.com/b/key?X-Goog-Signature=s", nil, "gcs:PutObject"},
+		{"PUT", "https://a.blob.core.windows.net/c/key?comp=block&blockid=b", nil, "azure:PutBlock"},
+		{"PUT", "https://a.blob.core.windows.net/c/key?comp=blocklist", nil, "azure:PutBlockList"},
+		{"PUT", "https://a.blob.core.windows.net/c/key", nil, "azure:PutBlob"},
+		{"HEAD", "http://azurite:10000/a/c/key", map[string]string{"X-Ms-Version": "2021-08-06"}, "azure:GetBlobProperties"},
+		{"POST", "https://api.wandb.ai/graphql", nil, ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.expected+" "+tc.url, func(t *testing.T) {
+			req := httptest.NewRequest(tc.method, tc.url, http.NoBody)
+			for key, value := range tc.header {
+				req.Header.Set(key, value)
+			}
+
+			assert.Equal(t, tc.expected, apitest.StorageOperation(req))
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/wandb/wandb/core/internal/gql"
+)
+
+// CreateAnonymousAPIKey creates an anonymous W&B account and returns its
+// API key.
+//
+// Runs logged with the key can be viewed by anyone who has it, and are
+// claimed by an account by opening them while logged in with the key in
+// the URL. The client must not send credentials.
+func CreateAnonymousAPIKey(ctx context.Context, client graphql.Client) (string, error) {
+	response, err := gql.CreateAnonymousApiKey(ctx, client)
+	if err != nil {
+		return "", fmt.Errorf("auth: failed to create anonymous API key: %v", err)
+	}
+
+	if response.CreateAnonymousEntity == nil ||
+		response.CreateAnonymousEntity.ApiKey == nil ||
+		response.CreateAnonymousEntity.ApiKey.Name == "" {
+		return "", fmt.Errorf("auth: server returned no anonymous API key")
+	}
+	return response.CreateAnonymousEntity.ApiKey.Name, nil
+}
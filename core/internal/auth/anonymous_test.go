@@ -0,0 +1,33 @@
+package auth_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/auth"
+	"github.com/wandb/wandb/core/internal/gqlmock"
+)
+
+func TestCreateAnonymousAPIKey(t *testing.T) {
+	client := gqlmock.NewMockClient()
+	client.StubMatchOnce(
+		gqlmock.WithOpName("CreateAnonymousApiKey"),
+		`{"createAnonymousEntity": {"apiKey": {"name": "anon-key"}}}`,
+	)
+
+	key, err := auth.CreateAnonymousAPIKey(context.Background(), client)
+
+	require.NoError(t, err)
+	assert.Equal(t, "anon-key", key)
+}
+
+func TestCreateAnonymousAPIKey_NoKey(t *testing.T) {
+	client := gqlmock.NewMockClient()
+	client.StubAnyOnce(`{"createAnonymousEntity": null}`)
+
+	_, err := auth.CreateAnonymousAPIKey(context.Background(), client)
+
+	assert.ErrorContains(t, err, "no anonymous API key")
+}
@@ -134,3 +134,28 @@ func GetNetrcLogin(machine string) (string, string, error) {
 	}
 	return "", "", fmt.Errorf("no entry for %s in %s", machine, netrcLines)
 }
+
+// AddNetrcLogin appends a machine's credentials to the user's .netrc file,
+// creating it if needed.
+//
+// Earlier entries take precedence, so this has no effect if the file
+// already has an entry for the machine.
+func AddNetrcLogin(machine, login, password string) error {
+	path, err := NetrcPath()
+	if err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if len(data) > 0 && !strings.HasSuffix(string(data), "\n") {
+		data = append(data, '\n')
+	}
+	data = append(data, fmt.Sprintf(
+		"machine %s\n  login %s\n  password %s\n", machine, login, password)...)
+
+	// The file holds secrets, so only the user may read it.
+	return os.WriteFile(path, data, 0600)
+}
@@ -99,3 +99,43 @@ func TestNetrcPath(t *testing.T) {
 		}
 	})
 }
+
+func TestAddNetrcLogin(t *testing.T) {
+	netrcPath := filepath.Join(t.TempDir(), ".netrc")
+	t.Setenv("NETRC", netrcPath)
+	if err := os.WriteFile(netrcPath, []byte("machine example.com login a password b"), 0600); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	if err := auth.AddNetrcLogin("api.wandb.ai", "user", "key"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	login, password, err := auth.GetNetrcLogin("api.wandb.ai")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if login != "user" || password != "key" {
+		t.Errorf("Expected user/key, got %s/%s", login, password)
+	}
+	if _, password, _ := auth.GetNetrcLogin("example.com"); password != "b" {
+		t.Errorf("Expected existing entry to be kept, got password %s", password)
+	}
+}
+
+func TestAddNetrcLogin_NoFile(t *testing.T) {
+	netrcPath := filepath.Join(t.TempDir(), ".netrc")
+	t.Setenv("NETRC", netrcPath)
+
+	if err := auth.AddNetrcLogin("api.wandb.ai", "user", "key"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	info, err := os.Stat(netrcPath)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if runtime.GOOS != "windows" && info.Mode().Perm() != 0600 {
+		t.Errorf("Expected mode 0600, got %v", info.Mode().Perm())
+	}
+}
@@ -0,0 +1,385 @@
+// Package doctor diagnoses problems with connecting to W&B.
+//
+// It runs the checks support usually asks users to do by hand with curl:
+// whether the server's name resolves, which proxy is used, whether the
+// API accepts the API key, whether the clock is right, whether files can
+// be uploaded to storage and whether the wandb directory is writable.
+package doctor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/wandb/wandb/core/internal/gql"
+)
+
+// Status is the outcome of a check.
+type Status string
+
+const (
+	StatusOK   Status = "ok"
+	StatusWarn Status = "warn"
+	StatusFail Status = "fail"
+	StatusSkip Status = "skip"
+)
+
+// Result is the outcome of one check.
+type Result struct {
+	// Check is the check's name.
+	Check string `json:"check"`
+
+	Status Status `json:"status"`
+
+	// Detail explains the status.
+	Detail string `json:"detail"`
+
+	// DurationMs is how long the check took in milliseconds.
+	DurationMs int64 `json:"duration_ms"`
+}
+
+// Report is the outcome of all checks.
+type Report struct {
+	BaseURL string   `json:"base_url"`
+	Results []Result `json:"results"`
+}
+
+// Failed returns whether any check failed.
+func (r *Report) Failed() bool {
+	for _, result := range r.Results {
+		if result.Status == StatusFail {
+			return true
+		}
+	}
+	return false
+}
+
+// WriteText writes the report as a table for people to read.
+func (r *Report) WriteText(w io.Writer) {
+	fmt.Fprintf(w, "W&B server: %s\n\n", r.BaseURL)
+	for _, result := range r.Results {
+		fmt.Fprintf(w, "[%-4s] %-12s %s\n", result.Status, result.Check, result.Detail)
+	}
+}
+
+// WriteJSON writes the report as indented JSON.
+func (r *Report) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r)
+}
+
+// Params configures the checks.
+type Params struct {
+	// BaseURL is the W&B server's URL.
+	BaseURL *url.URL
+
+	// APIKey is the user's API key, or empty if there is none.
+	APIKey string
+
+	// Proxy returns the proxy for a request, as in http.Transport.
+	Proxy func(*http.Request) (*url.URL, error)
+
+	// WandbDir is the directory runs are written to.
+	WandbDir string
+
+	// UploadRun is the run to upload a test file to, as
+	// "entity/project/run_id", or empty to skip the storage check.
+	UploadRun string
+
+	// Timeout limits each network check.
+	Timeout time.Duration
+
+	// Resolver resolves host names, or nil for the default resolver.
+	Resolver *net.Resolver
+}
+
+// MaxClockSkew is how far the local clock may be from the server's before
+// it's reported; larger differences make storage reject signed URLs.
+const MaxClockSkew = time.Minute
+
+// doctor holds the state shared between checks.
+type doctor struct {
+	params Params
+	client *http.Client
+
+	// serverTime is the time reported by the server, if it responded
+	serverTime time.Time
+
+	// localTime is the local time when the server responded
+	localTime time.Time
+
+	// apiOK is whether the API accepted the API key
+	apiOK bool
+}
+
+// Run runs the checks and returns the report.
+func Run(ctx context.Context, params Params) *Report {
+	if params.Timeout <= 0 {
+		params.Timeout = 10 * time.Second
+	}
+	if params.Resolver == nil {
+		params.Resolver = net.DefaultResolver
+	}
+
+	d := &doctor{
+		params: params,
+		client: &http.Client{
+			Timeout: params.Timeout,
+			Transport: &http.Transport{
+				Proxy: params.Proxy,
+			},
+		},
+	}
+
+	report := &Report{BaseURL: params.BaseURL.String()}
+	checks := []struct {
+		name string
+		run  func(context.Context) (Status, string)
+	}{
+		{"proxy", d.checkProxy},
+		{"dns", d.checkDNS},
+		{"api", d.checkAPI},
+		{"clock", d.checkClock},
+		{"storage", d.checkStorage},
+		{"filesystem", d.checkFilesystem},
+	}
+	for _, check := range checks {
+		start := time.Now()
+		status, detail := check.run(ctx)
+		report.Results = append(report.Results, Result{
+			Check:      check.name,
+			Status:     status,
+			Detail:     detail,
+			DurationMs: time.Since(start).Milliseconds(),
+		})
+	}
+	return report
+}
+
+// proxyFor returns the proxy used to reach the server, or nil.
+func (d *doctor) proxyFor() (*url.URL, error) {
+	if d.params.Proxy == nil {
+		return nil, nil
+	}
+	return d.params.Proxy(&http.Request{URL: d.params.BaseURL})
+}
+
+func (d *doctor) checkProxy(context.Context) (Status, string) {
+	proxy, err := d.proxyFor()
+	switch {
+	case err != nil:
+		return StatusFail, fmt.Sprintf("invalid proxy configuration: %v", err)
+	case proxy == nil:
+		return StatusOK, "no proxy"
+	default:
+		proxy.User = nil // don't print passwords
+		return StatusOK, fmt.Sprintf("using %s", proxy)
+	}
+}
+
+func (d *doctor) checkDNS(ctx context.Context) (Status, string) {
+	host := d.params.BaseURL.Hostname()
+	if net.ParseIP(host) != nil {
+		return StatusSkip, fmt.Sprintf("%s is an IP address", host)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.params.Timeout)
+	defer cancel()
+	addrs, err := d.params.Resolver.LookupHost(ctx, host)
+	if err != nil {
+		// The proxy may resolve names that aren't resolvable locally.
+		if proxy, _ := d.proxyFor(); proxy != nil {
+			return StatusWarn, fmt.Sprintf(
+				"can't resolve %s, but the proxy may: %v", host, err)
+		}
+		return StatusFail, fmt.Sprintf("can't resolve %s: %v", host, err)
+	}
+	return StatusOK, fmt.Sprintf("%s is %s", host, strings.Join(addrs, ", "))
+}
+
+const viewerQuery = `query DoctorViewer { viewer { username } }`
+
+func (d *doctor) checkAPI(ctx context.Context) (Status, string) {
+	body, _ := json.Marshal(map[string]string{"query": viewerQuery})
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		d.params.BaseURL.JoinPath("graphql").String(), bytes.NewReader(body))
+	if err != nil {
+		return StatusFail, err.Error()
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if d.params.APIKey != "" {
+		req.SetBasicAuth("api", d.params.APIKey)
+	}
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return StatusFail, fmt.Sprintf("can't reach the API: %v", err)
+	}
+	defer resp.Body.Close()
+
+	d.localTime = time.Now()
+	if date, err := http.ParseTime(resp.Header.Get("Date")); err == nil {
+		d.serverTime = date
+	}
+
+	var data struct {
+		Data struct {
+			Viewer *struct {
+				Username string `json:"username"`
+			} `json:"viewer"`
+		} `json:"data"`
+	}
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized:
+		return StatusFail, "the API key was rejected (HTTP 401)"
+	case resp.StatusCode != http.StatusOK:
+		return StatusFail, fmt.Sprintf("the API responded with HTTP %d", resp.StatusCode)
+	case json.NewDecoder(resp.Body).Decode(&data) != nil:
+		return StatusFail, "the API's response is not JSON; " +
+			"is the base URL a W&B server?"
+	case d.params.APIKey == "":
+		return StatusWarn, "the API is reachable, but there is no API key"
+	case data.Data.Viewer == nil:
+		return StatusFail, "the API key was not accepted"
+	default:
+		d.apiOK = true
+		return StatusOK, fmt.Sprintf("logged in as %s", data.Data.Viewer.Username)
+	}
+}
+
+func (d *doctor) checkClock(context.Context) (Status, string) {
+	if d.serverTime.IsZero() {
+		return StatusSkip, "the server's time is unknown"
+	}
+
+	// The Date header has a resolution of one second.
+	skew := d.localTime.Sub(d.serverTime).Truncate(time.Second)
+	if skew.Abs() > MaxClockSkew {
+		return StatusWarn, fmt.Sprintf(
+			"the local clock is off by %v; uploads may be rejected", skew)
+	}
+	return StatusOK, fmt.Sprintf("the local clock is off by %v", skew)
+}
+
+// doctorFileName is the file uploaded by the storage check.
+const doctorFileName = "wandb-doctor.txt"
+
+func (d *doctor) checkStorage(ctx context.Context) (Status, string) {
+	if d.params.UploadRun == "" {
+		return StatusSkip, "pass a run to upload a test file to it"
+	}
+	if !d.apiOK {
+		return StatusSkip, "the API check failed"
+	}
+
+	parts := strings.Split(d.params.UploadRun, "/")
+	if len(parts) != 3 {
+		return StatusFail, fmt.Sprintf(
+			"%q is not a run path like entity/project/run_id", d.params.UploadRun)
+	}
+
+	client := graphql.NewClient(
+		d.params.BaseURL.JoinPath("graphql").String(),
+		&http.Client{
+			Timeout: d.params.Timeout,
+			Transport: &basicAuthTransport{
+				apiKey: d.params.APIKey,
+				base:   d.client.Transport,
+			},
+		},
+	)
+	data, err := gql.CreateRunFiles(ctx, client,
+		parts[0], parts[1], parts[2], []string{doctorFileName})
+	if err != nil {
+		return StatusFail, fmt.Sprintf("can't get an upload URL: %v", err)
+	}
+	files := data.GetCreateRunFiles().GetFiles()
+	if len(files) == 0 || files[0].GetUploadUrl() == nil {
+		return StatusFail, "the server returned no upload URL"
+	}
+	uploadURL := *files[0].GetUploadUrl()
+
+	content := fmt.Sprintf("uploaded by wandb-core doctor at %s\n",
+		time.Now().UTC().Format(time.RFC3339))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut,
+		uploadURL, strings.NewReader(content))
+	if err != nil {
+		return StatusFail, err.Error()
+	}
+	for _, header := range data.GetCreateRunFiles().GetUploadHeaders() {
+		key, value, _ := strings.Cut(header, ":")
+		req.Header.Set(key, value)
+	}
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return StatusFail, fmt.Sprintf("can't reach storage at %s: %v",
+			redactQuery(uploadURL), err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return StatusFail, fmt.Sprintf("storage at %s responded with HTTP %d: %s",
+			redactQuery(uploadURL), resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return StatusOK, fmt.Sprintf("uploaded %s to %s",
+		doctorFileName, redactQuery(uploadURL))
+}
+
+func (d *doctor) checkFilesystem(context.Context) (Status, string) {
+	dir, err := filepath.Abs(d.params.WandbDir)
+	if err != nil {
+		return StatusFail, err.Error()
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return StatusFail, fmt.Sprintf("can't create %s: %v", dir, err)
+	}
+
+	file, err := os.CreateTemp(dir, ".wandb-doctor-*")
+	if err != nil {
+		return StatusFail, fmt.Sprintf("can't write to %s: %v", dir, err)
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+
+	if _, err := file.WriteString("test"); err != nil {
+		return StatusFail, fmt.Sprintf("can't write to %s: %v", dir, err)
+	}
+	if err := file.Sync(); err != nil {
+		return StatusWarn, fmt.Sprintf("can't sync files in %s: %v", dir, err)
+	}
+	return StatusOK, fmt.Sprintf("%s is writable", dir)
+}
+
+// basicAuthTransport adds the API key to requests.
+type basicAuthTransport struct {
+	apiKey string
+	base   http.RoundTripper
+}
+
+func (t *basicAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.SetBasicAuth("api", t.apiKey)
+	return t.base.RoundTrip(req)
+}
+
+// redactQuery removes the query, which holds the signature, from a URL.
+func redactQuery(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "the upload URL"
+	}
+	u.RawQuery = ""
+	return u.String()
+}
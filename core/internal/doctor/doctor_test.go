@@ -0,0 +1,121 @@
+package doctor_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/doctor"
+)
+
+// fakeServer serves just enough of the W&B API for the checks.
+func fakeServer(t *testing.T, apiKey string) (*httptest.Server, *bytes.Buffer) {
+	t.Helper()
+	uploaded := &bytes.Buffer{}
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/graphql":
+				if _, key, _ := r.BasicAuth(); key != apiKey {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				body, _ := io.ReadAll(r.Body)
+				if strings.Contains(string(body), "CreateRunFiles") {
+					_, _ = io.WriteString(w, `{"data": {"createRunFiles": {
+						"uploadHeaders": ["X-Test:1"],
+						"files": [{"name": "wandb-doctor.txt",
+							"uploadUrl": "`+server.URL+`/upload?sig=secret"}]
+					}}}`)
+				} else {
+					_, _ = io.WriteString(w, `{"data": {"viewer": {"username": "alice"}}}`)
+				}
+			case "/upload":
+				assert.Equal(t, "1", r.Header.Get("X-Test"))
+				_, _ = io.Copy(uploaded, r.Body)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+	t.Cleanup(server.Close)
+	return server, uploaded
+}
+
+func statuses(report *doctor.Report) map[string]doctor.Status {
+	result := make(map[string]doctor.Status)
+	for _, r := range report.Results {
+		result[r.Check] = r.Status
+	}
+	return result
+}
+
+func TestRun_AllOK(t *testing.T) {
+	server, uploaded := fakeServer(t, "key")
+	baseURL, _ := url.Parse(server.URL)
+
+	report := doctor.Run(context.Background(), doctor.Params{
+		BaseURL:   baseURL,
+		APIKey:    "key",
+		WandbDir:  filepath.Join(t.TempDir(), "wandb"),
+		UploadRun: "entity/project/run",
+	})
+
+	assert.False(t, report.Failed())
+	assert.Equal(t,
+		map[string]doctor.Status{
+			"proxy":      doctor.StatusOK,
+			"dns":        doctor.StatusSkip,
+			"api":        doctor.StatusOK,
+			"clock":      doctor.StatusOK,
+			"storage":    doctor.StatusOK,
+			"filesystem": doctor.StatusOK,
+		},
+		statuses(report))
+	assert.Contains(t, uploaded.String(), "wandb-core doctor")
+
+	var text bytes.Buffer
+	report.WriteText(&text)
+	assert.Contains(t, text.String(), "logged in as alice")
+	assert.NotContains(t, text.String(), "secret", "signatures are not printed")
+}
+
+func TestRun_BadAPIKey(t *testing.T) {
+	server, _ := fakeServer(t, "key")
+	baseURL, _ := url.Parse(server.URL)
+
+	report := doctor.Run(context.Background(), doctor.Params{
+		BaseURL:   baseURL,
+		APIKey:    "wrong",
+		WandbDir:  t.TempDir(),
+		UploadRun: "entity/project/run",
+	})
+
+	assert.True(t, report.Failed())
+	assert.Equal(t, doctor.StatusFail, statuses(report)["api"])
+	assert.Equal(t, doctor.StatusSkip, statuses(report)["storage"])
+}
+
+func TestRun_InvalidProxy(t *testing.T) {
+	baseURL, _ := url.Parse("http://127.0.0.1:1")
+
+	report := doctor.Run(context.Background(), doctor.Params{
+		BaseURL: baseURL,
+		Proxy: func(*http.Request) (*url.URL, error) {
+			return nil, assert.AnError
+		},
+		WandbDir: t.TempDir(),
+	})
+
+	require.True(t, report.Failed())
+	assert.Equal(t, doctor.StatusFail, statuses(report)["proxy"])
+}
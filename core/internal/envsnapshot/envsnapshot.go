@@ -0,0 +1,157 @@
+// Package envsnapshot records the packages installed in a run's environment.
+//
+// Snapshots come from the package managers themselves, such as "pip freeze",
+// so that they're captured even when the run isn't driven from Python.
+// Each snapshot is compared to the previous one taken in the same project on
+// this machine, so that changes to the environment between runs are easy to
+// spot.
+package envsnapshot
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"time"
+)
+
+// DirName is the directory in the run's files where snapshots are saved.
+const DirName = "environment"
+
+// DefaultTimeout is how long a package manager has to list its packages.
+const DefaultTimeout = 30 * time.Second
+
+// Tool is a package manager whose packages can be snapshotted.
+type Tool string
+
+const (
+	Pip   Tool = "pip"
+	Conda Tool = "conda"
+	Apt   Tool = "apt"
+)
+
+// FileName is the name of the tool's snapshot file in DirName.
+func (t Tool) FileName() string {
+	switch t {
+	case Conda:
+		return "conda.yaml"
+	default:
+		return string(t) + ".txt"
+	}
+}
+
+// DiffFileName is the name of the file in DirName with the changes since
+// the previous snapshot.
+func (t Tool) DiffFileName() string {
+	return string(t) + ".diff"
+}
+
+// ErrUnavailable is returned for tools that aren't installed.
+var ErrUnavailable = errors.New("envsnapshot: package manager not found")
+
+// Snapshotter lists the packages installed by package managers.
+type Snapshotter struct {
+	// Python is the Python executable whose pip to use, or empty to use
+	// "pip" from the PATH.
+	Python string
+
+	// Timeout limits how long each package manager may run.
+	//
+	// DefaultTimeout is used if it's zero.
+	Timeout time.Duration
+
+	// Output runs a command and returns its standard output.
+	//
+	// It's exec.CommandContext(...).Output if nil.
+	Output func(ctx context.Context, name string, args ...string) ([]byte, error)
+}
+
+// Take returns the list of packages installed by a tool.
+func (s *Snapshotter) Take(ctx context.Context, tool Tool) ([]byte, error) {
+	name, args, err := s.command(tool)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := s.Timeout
+	if timeout == 0 {
+		timeout = DefaultTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	output := s.Output
+	if output == nil {
+		output = func(ctx context.Context, name string, args ...string) ([]byte, error) {
+			return exec.CommandContext(ctx, name, args...).Output()
+		}
+	}
+
+	data, err := output(ctx, name, args...)
+	switch {
+	case errors.Is(err, exec.ErrNotFound):
+		return nil, ErrUnavailable
+	case err != nil:
+		return nil, fmt.Errorf("envsnapshot: %s failed: %v", tool, err)
+	}
+	return data, nil
+}
+
+// command returns the command that lists a tool's packages.
+func (s *Snapshotter) command(tool Tool) (string, []string, error) {
+	switch tool {
+	case Pip:
+		if s.Python != "" {
+			return s.Python, []string{"-m", "pip", "freeze"}, nil
+		}
+		return "pip", []string{"freeze"}, nil
+	case Conda:
+		// Conda is only meaningful inside an activated environment.
+		if os.Getenv("CONDA_PREFIX") == "" {
+			return "", nil, ErrUnavailable
+		}
+		return "conda", []string{"env", "export"}, nil
+	case Apt:
+		return "dpkg-query", []string{"-W", "-f=${Package}=${Version}\n"}, nil
+	default:
+		return "", nil, fmt.Errorf("envsnapshot: unknown package manager %q", tool)
+	}
+}
+
+// Diff returns the lines removed from and added to a snapshot, as lines
+// starting with "-" and "+".
+//
+// The order of lines is ignored since package managers list packages in
+// an unspecified order. It returns nil if the snapshots list the same
+// packages.
+func Diff(previous, current []byte) []byte {
+	previousLines := lineSet(previous)
+	currentLines := lineSet(current)
+
+	var diff bytes.Buffer
+	for _, line := range bytes.Split(previous, []byte("\n")) {
+		if len(line) > 0 && !currentLines[string(line)] {
+			fmt.Fprintf(&diff, "-%s\n", line)
+		}
+	}
+	for _, line := range bytes.Split(current, []byte("\n")) {
+		if len(line) > 0 && !previousLines[string(line)] {
+			fmt.Fprintf(&diff, "+%s\n", line)
+		}
+	}
+
+	if diff.Len() == 0 {
+		return nil
+	}
+	return diff.Bytes()
+}
+
+func lineSet(data []byte) map[string]bool {
+	lines := make(map[string]bool)
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		lines[string(line)] = true
+	}
+	return lines
+}
@@ -0,0 +1,71 @@
+package envsnapshot_test
+
+import (
+	"context"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/envsnapshot"
+)
+
+func TestTake_RunsPackageManager(t *testing.T) {
+	var command []string
+	snapshotter := &envsnapshot.Snapshotter{
+		Python: "/usr/bin/python3",
+		Output: func(_ context.Context, name string, args ...string) ([]byte, error) {
+			command = append([]string{name}, args...)
+			return []byte("numpy==2.0.0\n"), nil
+		},
+	}
+
+	data, err := snapshotter.Take(context.Background(), envsnapshot.Pip)
+
+	require.NoError(t, err)
+	assert.Equal(t, "numpy==2.0.0\n", string(data))
+	assert.Equal(t, []string{"/usr/bin/python3", "-m", "pip", "freeze"}, command)
+}
+
+func TestTake_NotInstalled(t *testing.T) {
+	snapshotter := &envsnapshot.Snapshotter{
+		Output: func(context.Context, string, ...string) ([]byte, error) {
+			return nil, &exec.Error{Name: "dpkg-query", Err: exec.ErrNotFound}
+		},
+	}
+
+	_, err := snapshotter.Take(context.Background(), envsnapshot.Apt)
+
+	assert.ErrorIs(t, err, envsnapshot.ErrUnavailable)
+}
+
+func TestTake_UnknownTool(t *testing.T) {
+	snapshotter := &envsnapshot.Snapshotter{}
+
+	_, err := snapshotter.Take(context.Background(), "brew")
+
+	assert.ErrorContains(t, err, "unknown package manager")
+}
+
+func TestDiff(t *testing.T) {
+	previous := []byte("a==1\nb==1\nc==1\n")
+	current := []byte("c==1\nb==2\na==1\n")
+
+	assert.Equal(t, "-b==1\n+b==2\n", string(envsnapshot.Diff(previous, current)))
+	assert.Nil(t, envsnapshot.Diff(previous, []byte("c==1\nb==1\na==1\n")))
+}
+
+func TestHistory(t *testing.T) {
+	history := envsnapshot.NewHistory(t.TempDir())
+
+	_, ok := history.Previous("entity", "project", envsnapshot.Pip)
+	assert.False(t, ok)
+
+	require.NoError(t, history.Record("entity", "project", envsnapshot.Pip, []byte("a==1\n")))
+	data, ok := history.Previous("entity", "project", envsnapshot.Pip)
+	assert.True(t, ok)
+	assert.Equal(t, "a==1\n", string(data))
+
+	_, ok = history.Previous("entity", "other-project", envsnapshot.Pip)
+	assert.False(t, ok)
+}
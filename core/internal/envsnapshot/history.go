@@ -0,0 +1,60 @@
+package envsnapshot
+
+import (
+	"net/url"
+	"os"
+	"path/filepath"
+)
+
+// History remembers the latest snapshot of each tool per project.
+type History struct {
+	root string
+}
+
+// NewHistory returns the snapshot history in a cache directory.
+func NewHistory(cacheDir string) *History {
+	return &History{root: filepath.Join(cacheDir, "env-snapshots")}
+}
+
+// Previous returns the latest snapshot of a tool in a project, if any.
+func (h *History) Previous(entity, project string, tool Tool) ([]byte, bool) {
+	data, err := os.ReadFile(h.path(entity, project, tool))
+	if err != nil {
+		return nil, false
+	}
+	return data, true
+}
+
+// Record makes a snapshot the latest one of a tool in a project.
+func (h *History) Record(entity, project string, tool Tool, data []byte) error {
+	path := h.path(entity, project, tool)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	// Write atomically so that concurrent runs don't see partial snapshots.
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+	}
+	return err
+}
+
+func (h *History) path(entity, project string, tool Tool) string {
+	return filepath.Join(
+		h.root,
+		url.PathEscape(entity),
+		url.PathEscape(project),
+		tool.FileName(),
+	)
+}
@@ -0,0 +1,174 @@
+// Package errorcode classifies errors into the codes reported to clients.
+//
+// Clients get an ErrorInfo with each failed operation and with the
+// background errors they poll for. Its code lets them react, for example
+// by asking the user to log in again, without parsing the message, which
+// is meant for people and changes between versions.
+//
+// Errors get a code either explicitly, by wrapping them with Wrap where
+// the cause is known, or by inspecting HTTP statuses and GraphQL errors
+// from the W&B server.
+package errorcode
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// Code is a machine-readable kind of error.
+type Code = service.ErrorInfo_ErrorCode
+
+// codedError is an error with a known code.
+type codedError struct {
+	code Code
+	err  error
+}
+
+func (e *codedError) Error() string { return e.err.Error() }
+
+func (e *codedError) Unwrap() error { return e.err }
+
+// Wrap returns an error that has the given code.
+//
+// Returns nil if err is nil.
+func Wrap(code Code, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &codedError{code: code, err: err}
+}
+
+// Of returns the code for an error.
+//
+// A code set with Wrap takes precedence. Otherwise, the code is inferred
+// from the error, and is UNKNOWN if nothing matches.
+func Of(err error) Code {
+	if err == nil {
+		return service.ErrorInfo_UNKNOWN
+	}
+
+	var coded *codedError
+	if errors.As(err, &coded) {
+		return coded.code
+	}
+
+	var gqlErrors gqlerror.List
+	if errors.As(err, &gqlErrors) {
+		for _, gqlErr := range gqlErrors {
+			if code := ofMessage(gqlErr.Message); code != service.ErrorInfo_UNKNOWN {
+				return code
+			}
+		}
+		return service.ErrorInfo_UNKNOWN
+	}
+
+	// genqlient reports HTTP errors only in the message.
+	if match := httpStatusRe.FindStringSubmatch(err.Error()); match != nil {
+		status, _ := strconv.Atoi(match[1])
+		if code := OfHTTPStatus(status); code != service.ErrorInfo_UNKNOWN {
+			return code
+		}
+	}
+
+	var netErr net.Error
+	switch {
+	case errors.Is(err, context.DeadlineExceeded),
+		errors.As(err, &netErr):
+		return service.ErrorInfo_COMMUNICATION
+	}
+
+	return ofMessage(err.Error())
+}
+
+// httpStatusRe matches the status in genqlient's HTTP errors.
+var httpStatusRe = regexp.MustCompile(`returned error (\d{3})\b`)
+
+// OfHTTPStatus returns the code for a W&B server's HTTP response status.
+func OfHTTPStatus(status int) Code {
+	switch {
+	case status == http.StatusUnauthorized:
+		return service.ErrorInfo_AUTHENTICATION
+	case status == http.StatusPaymentRequired,
+		status == http.StatusInsufficientStorage:
+		return service.ErrorInfo_QUOTA_EXCEEDED
+	case status == http.StatusForbidden:
+		return service.ErrorInfo_PERMISSION_DENIED
+	case status == http.StatusNotFound:
+		return service.ErrorInfo_NOT_FOUND
+	case status == http.StatusTooManyRequests:
+		return service.ErrorInfo_RATE_LIMITED
+	case status == http.StatusNotImplemented:
+		return service.ErrorInfo_SERVER_INCOMPATIBLE
+	case status >= 500:
+		return service.ErrorInfo_COMMUNICATION
+	default:
+		return service.ErrorInfo_UNKNOWN
+	}
+}
+
+// OfStorageStatus returns the code for a storage bucket's HTTP response
+// status.
+//
+// Storage rejects requests it can't authorize with 401 or 403, which
+// usually means the signed URL expired or a policy blocks the bucket,
+// rather than that the user lacks access to W&B.
+func OfStorageStatus(status int) Code {
+	switch status {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return service.ErrorInfo_STORAGE_DENIED
+	default:
+		return OfHTTPStatus(status)
+	}
+}
+
+// messageCodes are phrases in the W&B server's error messages, checked in
+// order.
+var messageCodes = []struct {
+	phrase string
+	code   Code
+}{
+	{"cannot query field", service.ErrorInfo_SERVER_INCOMPATIBLE},
+	{"unknown argument", service.ErrorInfo_SERVER_INCOMPATIBLE},
+	{"unknown type", service.ErrorInfo_SERVER_INCOMPATIBLE},
+	{"quota", service.ErrorInfo_QUOTA_EXCEEDED},
+	{"storage limit", service.ErrorInfo_QUOTA_EXCEEDED},
+	{"rate limit", service.ErrorInfo_RATE_LIMITED},
+	{"permission denied", service.ErrorInfo_PERMISSION_DENIED},
+	{"not authorized", service.ErrorInfo_PERMISSION_DENIED},
+	{"not found", service.ErrorInfo_NOT_FOUND},
+	{"does not exist", service.ErrorInfo_NOT_FOUND},
+}
+
+func ofMessage(message string) Code {
+	message = strings.ToLower(message)
+	for _, mc := range messageCodes {
+		if strings.Contains(message, mc.phrase) {
+			return mc.code
+		}
+	}
+	return service.ErrorInfo_UNKNOWN
+}
+
+// Info returns the ErrorInfo for an error, with a code from Of or the
+// fallback code if Of doesn't know it.
+//
+// Returns nil if err is nil.
+func Info(err error, fallback Code) *service.ErrorInfo {
+	if err == nil {
+		return nil
+	}
+
+	code := Of(err)
+	if code == service.ErrorInfo_UNKNOWN {
+		code = fallback
+	}
+	return &service.ErrorInfo{Message: err.Error(), Code: code}
+}
@@ -0,0 +1,91 @@
+package errorcode_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/wandb/wandb/core/internal/errorcode"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+func TestOf(t *testing.T) {
+	testCases := []struct {
+		name string
+		err  error
+		code service.ErrorInfo_ErrorCode
+	}{
+		{
+			"wrapped code wins",
+			fmt.Errorf("context: %w", errorcode.Wrap(
+				service.ErrorInfo_STORAGE_DENIED,
+				errors.New("returned error 401 Unauthorized: {}"))),
+			service.ErrorInfo_STORAGE_DENIED,
+		},
+		{
+			"genqlient HTTP error",
+			fmt.Errorf("failed: %w",
+				errors.New("returned error 401 Unauthorized: {}")),
+			service.ErrorInfo_AUTHENTICATION,
+		},
+		{
+			"rate limited",
+			errors.New("returned error 429 Too Many Requests: slow down"),
+			service.ErrorInfo_RATE_LIMITED,
+		},
+		{
+			"GraphQL schema mismatch",
+			fmt.Errorf("failed: %w", gqlerror.List{
+				{Message: `Cannot query field "forkPoints" on type "Run".`},
+			}),
+			service.ErrorInfo_SERVER_INCOMPATIBLE,
+		},
+		{
+			"GraphQL missing project",
+			gqlerror.List{{Message: "project not found"}},
+			service.ErrorInfo_NOT_FOUND,
+		},
+		{
+			"timeout",
+			fmt.Errorf("failed: %w", context.DeadlineExceeded),
+			service.ErrorInfo_COMMUNICATION,
+		},
+		{
+			"unrecognized",
+			errors.New("something went wrong"),
+			service.ErrorInfo_UNKNOWN,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.code, errorcode.Of(tc.err))
+		})
+	}
+}
+
+func TestOfStorageStatus(t *testing.T) {
+	assert.Equal(t,
+		service.ErrorInfo_STORAGE_DENIED,
+		errorcode.OfStorageStatus(http.StatusForbidden))
+	assert.Equal(t,
+		service.ErrorInfo_PERMISSION_DENIED,
+		errorcode.OfHTTPStatus(http.StatusForbidden))
+	assert.Equal(t,
+		service.ErrorInfo_QUOTA_EXCEEDED,
+		errorcode.OfStorageStatus(http.StatusInsufficientStorage))
+}
+
+func TestInfo(t *testing.T) {
+	err := errors.New("connection reset")
+
+	info := errorcode.Info(err, service.ErrorInfo_COMMUNICATION)
+
+	assert.Equal(t, "connection reset", info.GetMessage())
+	assert.Equal(t, service.ErrorInfo_COMMUNICATION, info.GetCode())
+	assert.Nil(t, errorcode.Info(nil, service.ErrorInfo_COMMUNICATION))
+}
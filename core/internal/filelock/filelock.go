@@ -0,0 +1,53 @@
+// Package filelock locks files so that only one process at a time can
+// work on something.
+//
+// Locks are released by the operating system when the process holding
+// them exits, so a crashed process never leaves a stale lock behind.
+package filelock
+
+import (
+	"errors"
+	"fmt"
+	"os"
+)
+
+// ErrLocked is returned by TryLock if another process holds the lock.
+var ErrLocked = errors.New("filelock: locked by another process")
+
+// Lock is a held lock on a file.
+type Lock struct {
+	file *os.File
+}
+
+// TryLock locks the file at the path, creating it if necessary.
+//
+// It returns ErrLocked without waiting if another process holds the lock.
+func TryLock(path string) (*Lock, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("filelock: %v", err)
+	}
+
+	if err := tryLock(file); err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+
+	return &Lock{file: file}, nil
+}
+
+// Unlock releases the lock.
+//
+// The file is left in place: deleting it would let a process that opened
+// it before the deletion lock it at the same time as one that creates it
+// anew.
+func (l *Lock) Unlock() error {
+	err := unlock(l.file)
+	if closeErr := l.file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("filelock: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package filelock_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/filelock"
+)
+
+func TestTryLock(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "lock")
+
+	lock, err := filelock.TryLock(path)
+	require.NoError(t, err)
+
+	// Locks on separate opens of a file exclude each other, as locks of
+	// separate processes do.
+	_, err = filelock.TryLock(path)
+	assert.ErrorIs(t, err, filelock.ErrLocked)
+
+	require.NoError(t, lock.Unlock())
+	lock, err = filelock.TryLock(path)
+	require.NoError(t, err)
+	assert.NoError(t, lock.Unlock())
+}
@@ -0,0 +1,39 @@
+//go:build windows
+
+package filelock
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"golang.org/x/sys/windows"
+)
+
+// The first byte of the file is locked, which is allowed even if the
+// file is empty.
+
+func tryLock(file *os.File) error {
+	err := windows.LockFileEx(
+		windows.Handle(file.Fd()),
+		windows.LOCKFILE_EXCLUSIVE_LOCK|windows.LOCKFILE_FAIL_IMMEDIATELY,
+		0, 1, 0,
+		&windows.Overlapped{},
+	)
+	switch {
+	case errors.Is(err, windows.ERROR_LOCK_VIOLATION):
+		return ErrLocked
+	case err != nil:
+		return fmt.Errorf("filelock: %v", err)
+	default:
+		return nil
+	}
+}
+
+func unlock(file *os.File) error {
+	return windows.UnlockFileEx(
+		windows.Handle(file.Fd()),
+		0, 1, 0,
+		&windows.Overlapped{},
+	)
+}
@@ -0,0 +1,208 @@
+package filestream
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+)
+
+// AckLog persists which lines of a run's files the server acknowledged.
+//
+// A run's data is sometimes sent more than once from its transaction log:
+// after a crash, the outbox re-sends it, and users may `wandb sync` it
+// again. Each time, the log is replayed from the start. Without a record
+// of what was sent, replayed lines are either appended after the ones the
+// server already has, duplicating them, or sent at offsets that don't
+// line up with the previous attempt.
+//
+// The AckLog is a small file next to the transaction log. It records the
+// offset at which the log's lines start in each file, and how many lines
+// the server has acknowledged. A replay starts at the same offsets and
+// skips the acknowledged lines, so that each line is stored exactly once.
+//
+// The record only applies to the run it was made for, so that syncing a
+// log to a different run sends everything.
+type AckLog struct {
+	// path is the file where the offsets are stored.
+	path string
+
+	logger *observability.CoreLogger
+
+	// runPath identifies the run the lines are sent to.
+	runPath string
+
+	// restored is whether the file had offsets for the run.
+	restored bool
+
+	// written is whether the file has the saved offsets.
+	written bool
+
+	// saved is the contents of the file.
+	saved ackLogFile
+}
+
+// ackLogFile is the JSON contents of an AckLog's file.
+type ackLogFile struct {
+	// RunPath is the run the lines were sent to.
+	RunPath string `json:"run_path"`
+
+	// Base is the line number at which the log's lines start, by file.
+	Base map[string]int `json:"base"`
+
+	// Acked is the number of lines from the log acknowledged by the
+	// server, by file.
+	//
+	// Only append-only files are counted.
+	Acked map[string]int `json:"acked"`
+}
+
+// AckLogPath returns the path of the AckLog of a transaction log.
+func AckLogPath(syncFile string) string {
+	return syncFile + ".acked"
+}
+
+// OpenAckLog loads the AckLog at a path for a run.
+//
+// A missing or unreadable file, or one for a different run, results in
+// an empty AckLog.
+func OpenAckLog(
+	path string,
+	runPath string,
+	logger *observability.CoreLogger,
+) *AckLog {
+	log := &AckLog{path: path, logger: logger, runPath: runPath}
+
+	data, err := os.ReadFile(path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return log
+	case err != nil:
+		logger.CaptureError(
+			fmt.Errorf("filestream: failed to read acked offsets: %v", err))
+		return log
+	}
+
+	var saved ackLogFile
+	if err := json.Unmarshal(data, &saved); err != nil {
+		// The file may have been cut short by a crash.
+		logger.Warn("filestream: ignoring corrupt acked offsets", "error", err)
+		return log
+	}
+	if saved.RunPath != runPath {
+		logger.Info(
+			"filestream: ignoring acked offsets for another run",
+			"run", saved.RunPath)
+		return log
+	}
+
+	log.saved = saved
+	log.restored = true
+	log.written = true
+	return log
+}
+
+// Restore sets the offsets of a replay of the log.
+//
+// The state has the offsets at which the server says new lines should
+// go. If the log was sent before, the offsets where its lines started are
+// used instead, and lines the server acknowledged or already has are
+// skipped.
+//
+// A nil AckLog leaves the state unchanged.
+func (l *AckLog) Restore(state *FileStreamState) {
+	if l == nil {
+		return
+	}
+
+	if !l.restored {
+		l.saved = ackLogFile{
+			RunPath: l.runPath,
+			Base: map[string]int{
+				HistoryFileName: state.HistoryLineNum,
+				EventsFileName:  state.EventsLineNum,
+				SummaryFileName: state.SummaryLineNum,
+				OutputFileName:  state.ConsoleLineOffset,
+			},
+			Acked: map[string]int{},
+		}
+		return
+	}
+
+	base, acked := l.saved.Base, l.saved.Acked
+	l.logger.Info(
+		"filestream: resuming from acked offsets",
+		"base", base,
+		"acked", acked)
+
+	// The server has at least the lines it acknowledged, and possibly
+	// more if a response was lost.
+	state.HistorySentLineNum = max(
+		base[HistoryFileName]+acked[HistoryFileName],
+		state.HistoryLineNum)
+	state.EventsSentLineNum = max(
+		base[EventsFileName]+acked[EventsFileName],
+		state.EventsLineNum)
+
+	state.HistoryLineNum = base[HistoryFileName]
+	state.EventsLineNum = base[EventsFileName]
+	state.SummaryLineNum = base[SummaryFileName]
+	state.ConsoleLineOffset = base[OutputFileName]
+}
+
+// Ack records that the server has the lines sent up to the state.
+//
+// A nil AckLog does nothing.
+func (l *AckLog) Ack(state *FileStreamState) {
+	if l == nil {
+		return
+	}
+
+	acked := map[string]int{
+		HistoryFileName: state.HistoryLineNum - l.saved.Base[HistoryFileName],
+		EventsFileName:  state.EventsLineNum - l.saved.Base[EventsFileName],
+	}
+	if l.written &&
+		acked[HistoryFileName] == l.saved.Acked[HistoryFileName] &&
+		acked[EventsFileName] == l.saved.Acked[EventsFileName] {
+		return
+	}
+
+	l.saved.Acked = acked
+	if err := l.write(); err != nil {
+		l.logger.CaptureError(
+			fmt.Errorf("filestream: failed to save acked offsets: %v", err))
+		return
+	}
+	l.written = true
+}
+
+// write atomically replaces the file with the saved offsets.
+func (l *AckLog) write() error {
+	data, err := json.Marshal(l.saved)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(l.path), filepath.Base(l.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), l.path)
+}
@@ -0,0 +1,68 @@
+package filestream_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	. "github.com/wandb/wandb/core/internal/filestream"
+	"github.com/wandb/wandb/core/pkg/observability"
+)
+
+func TestAckLog_ReplaySkipsAckedLines(t *testing.T) {
+	path := AckLogPath(filepath.Join(t.TempDir(), "run-abc.wandb"))
+	logger := observability.NewNoOpLogger()
+
+	// The first attempt starts at the server's offsets and gets two of
+	// three history lines acknowledged before crashing.
+	first := OpenAckLog(path, "files/e/p/abc/file_stream", logger)
+	state := &FileStreamState{HistoryLineNum: 10, ConsoleLineOffset: 4}
+	first.Restore(state)
+	state.HistoryLineNum += 2
+	first.Ack(state)
+
+	// The server now reports 12 lines, which would append the replayed
+	// lines after the acknowledged ones.
+	replay := OpenAckLog(path, "files/e/p/abc/file_stream", logger)
+	state = &FileStreamState{HistoryLineNum: 12, ConsoleLineOffset: 7}
+	replay.Restore(state)
+
+	assert.Equal(t, 10, state.HistoryLineNum)
+	assert.Equal(t, 12, state.HistorySentLineNum)
+	assert.Equal(t, 4, state.ConsoleLineOffset)
+}
+
+func TestAckLog_UsesServerOffsetIfAhead(t *testing.T) {
+	path := AckLogPath(filepath.Join(t.TempDir(), "run-abc.wandb"))
+	logger := observability.NewNoOpLogger()
+	first := OpenAckLog(path, "files/e/p/abc/file_stream", logger)
+	state := &FileStreamState{}
+	first.Restore(state)
+	state.HistoryLineNum += 2
+	first.Ack(state)
+
+	// The server got a third line whose response was lost.
+	replay := OpenAckLog(path, "files/e/p/abc/file_stream", logger)
+	state = &FileStreamState{HistoryLineNum: 3}
+	replay.Restore(state)
+
+	assert.Equal(t, 0, state.HistoryLineNum)
+	assert.Equal(t, 3, state.HistorySentLineNum)
+}
+
+func TestAckLog_IgnoresOtherRun(t *testing.T) {
+	path := AckLogPath(filepath.Join(t.TempDir(), "run-abc.wandb"))
+	logger := observability.NewNoOpLogger()
+	first := OpenAckLog(path, "files/e/p/abc/file_stream", logger)
+	state := &FileStreamState{}
+	first.Restore(state)
+	state.HistoryLineNum += 5
+	first.Ack(state)
+
+	other := OpenAckLog(path, "files/e/other/abc/file_stream", logger)
+	state = &FileStreamState{HistoryLineNum: 1}
+	other.Restore(state)
+
+	assert.Equal(t, 1, state.HistoryLineNum)
+	assert.Zero(t, state.HistorySentLineNum)
+}
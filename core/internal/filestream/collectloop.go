@@ -12,6 +12,17 @@ import (
 // to go through.
 type CollectLoop struct {
 	TransmitRateLimit *rate.Limiter
+
+	// MaxBufferedHistoryBytes is the size of history lines to buffer,
+	// or zero for no limit.
+	//
+	// Once the buffer is full, requests are not accepted until a
+	// transmission goes through, so that the stream's RecordSpill
+	// writes the backlog to disk instead of it piling up here.
+	MaxBufferedHistoryBytes int
+
+	// historyBytes is the size of the history lines in the buffer.
+	historyBytes int
 }
 
 // Start ingests requests and outputs rate-limited, batched requests.
@@ -25,7 +36,7 @@ func (cl CollectLoop) Start(
 		isDone := false
 
 		for request := range requests {
-			buffer.Merge(request)
+			cl.merge(buffer, request)
 
 			cl.waitForRateLimit(buffer, requests)
 			buffer, isDone = cl.transmit(buffer, requests, transmissions)
@@ -34,7 +45,7 @@ func (cl CollectLoop) Start(
 		for !isDone {
 			reader := NewRequestReader(buffer)
 			transmissions <- reader
-			buffer, isDone = reader.Next()
+			buffer, isDone = cl.next(reader)
 		}
 
 		close(transmissions)
@@ -45,7 +56,7 @@ func (cl CollectLoop) Start(
 
 // waitForRateLimit merges requests until the rate limit allows us
 // to transmit data.
-func (cl CollectLoop) waitForRateLimit(
+func (cl *CollectLoop) waitForRateLimit(
 	buffer *FileStreamRequest,
 	requests <-chan *FileStreamRequest,
 ) {
@@ -66,14 +77,14 @@ func (cl CollectLoop) waitForRateLimit(
 		case <-timer.C:
 			return
 
-		case request, ok := <-requests:
+		case request, ok := <-cl.accepting(requests):
 			_ = timer.Stop()
 
 			if !ok {
 				return
 			}
 
-			buffer.Merge(request)
+			cl.merge(buffer, request)
 
 			if shouldSendASAP(buffer) {
 				return
@@ -83,7 +94,7 @@ func (cl CollectLoop) waitForRateLimit(
 }
 
 // transmit accumulates incoming requests until a transmission goes through.
-func (cl CollectLoop) transmit(
+func (cl *CollectLoop) transmit(
 	buffer *FileStreamRequest,
 	requests <-chan *FileStreamRequest,
 	transmissions chan<- *FileStreamRequestReader,
@@ -93,18 +104,55 @@ func (cl CollectLoop) transmit(
 
 		select {
 		case transmissions <- reader:
-			return reader.Next()
+			return cl.next(reader)
 
-		case request, ok := <-requests:
+		case request, ok := <-cl.accepting(requests):
 			if !ok {
 				return buffer, false
 			}
 
-			buffer.Merge(request)
+			cl.merge(buffer, request)
 		}
 	}
 }
 
+// accepting returns the requests channel, or nil if the buffer is full.
+func (cl *CollectLoop) accepting(
+	requests <-chan *FileStreamRequest,
+) <-chan *FileStreamRequest {
+	if cl.MaxBufferedHistoryBytes > 0 &&
+		cl.historyBytes >= cl.MaxBufferedHistoryBytes {
+		return nil
+	}
+
+	return requests
+}
+
+// merge adds a request to the buffer.
+func (cl *CollectLoop) merge(buffer, request *FileStreamRequest) {
+	buffer.Merge(request)
+	cl.historyBytes += historySize(request.HistoryLines)
+}
+
+// next returns the buffer remaining after a transmission, and whether
+// everything has been transmitted.
+func (cl *CollectLoop) next(
+	reader *FileStreamRequestReader,
+) (*FileStreamRequest, bool) {
+	buffer, isDone := reader.Next()
+	cl.historyBytes = historySize(buffer.HistoryLines)
+	return buffer, isDone
+}
+
+// historySize returns the total size of history lines.
+func historySize(lines []string) int {
+	size := 0
+	for _, line := range lines {
+		size += len(line)
+	}
+	return size
+}
+
 // shouldSendASAP returns a request should be made regardless of rate limits.
 func shouldSendASAP(request *FileStreamRequest) bool {
 	// Send the "pre-empting" state immediately.
@@ -49,3 +49,28 @@ func TestCollectLoop_SendsLastRequestImmediately(t *testing.T) {
 		t.Error("timeout after 1 second")
 	}
 }
+
+func TestCollectLoop_BlocksWhileHistoryBufferIsFull(t *testing.T) {
+	requests := make(chan *FileStreamRequest)
+	defer close(requests)
+	loop := CollectLoop{
+		TransmitRateLimit:       rate.NewLimiter(rate.Inf, 1),
+		MaxBufferedHistoryBytes: 2,
+	}
+
+	transmissions := loop.Start(requests)
+	requests <- &FileStreamRequest{HistoryLines: []string{"12"}}
+
+	select {
+	case requests <- &FileStreamRequest{HistoryLines: []string{"34"}}:
+		t.Fatal("accepted a request while the buffer was full")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	(<-transmissions).Next()
+	select {
+	case requests <- &FileStreamRequest{HistoryLines: []string{"34"}}:
+	case <-time.After(time.Second):
+		t.Error("timeout after 1 second")
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/wandb/wandb/core/internal/api"
+	"github.com/wandb/wandb/core/internal/errorcode"
 	"github.com/wandb/wandb/core/internal/waiting"
 	"github.com/wandb/wandb/core/pkg/observability"
 	"github.com/wandb/wandb/core/pkg/service"
@@ -25,6 +26,10 @@ const (
 	//
 	// See https://github.com/wandb/core/pull/7339 for history.
 	maxFileLineBytes = (10 << 20) - (100 << 10)
+
+	// Size of history to buffer while waiting to send it, after which
+	// updates block until a request goes through.
+	maxBufferedHistoryBytes = 64 << 20
 )
 
 type ChunkTypeEnum int8
@@ -62,6 +67,11 @@ type FileStream interface {
 
 	// StreamUpdate uploads information through the filestream API.
 	StreamUpdate(update Update)
+
+	// Err returns the fatal error that stopped uploads, or nil.
+	//
+	// Data passed to the filestream after such an error is not uploaded.
+	Err() error
 }
 
 // fileStream is a stream of data to the server
@@ -89,13 +99,40 @@ type fileStream struct {
 	// The rate limit for sending data to the backend.
 	transmitRateLimit *rate.Limiter
 
+	// Opens a stream to the backend, or nil to only use HTTP.
+	openStream StreamOpener
+
+	// The rate limit for sending data if a stream is open.
+	streamTransmitRateLimit *rate.Limiter
+
+	// How long to wait for the server to accept a stream.
+	negotiateTimeout time.Duration
+
+	// The open stream to the backend, or nil if using HTTP.
+	//
+	// This is only used by the transmit loop after Start.
+	stream StreamConn
+
 	// A schedule on which to send heartbeats to the backend
 	// to prove the run is still alive.
 	heartbeatStopwatch waiting.Stopwatch
 
+	// tracer records a span for each request, or is nil
+	tracer *observability.Tracer
+
+	// onRunControl is called with instructions from the server, or is nil
+	onRunControl func(RunControl)
+
+	// ackLogPath is where to persist the lines acknowledged by the server,
+	// or empty
+	ackLogPath string
+
 	// A channel that is closed if there is a fatal error.
 	deadChan     chan struct{}
 	deadChanOnce *sync.Once
+
+	// The fatal error, set before deadChan is closed.
+	fatalErr error
 }
 
 type FileStreamParams struct {
@@ -105,6 +142,40 @@ type FileStreamParams struct {
 	ApiClient          api.Client
 	TransmitRateLimit  *rate.Limiter
 	HeartbeatStopwatch waiting.Stopwatch
+
+	// OpenStream optionally opens a stream to the backend to use instead
+	// of HTTP requests.
+	//
+	// The stream is used only if the server accepts it. If it breaks,
+	// the filestream falls back to HTTP for the rest of the run.
+	OpenStream StreamOpener
+
+	// StreamTransmitRateLimit is the rate limit to use instead of
+	// TransmitRateLimit while a stream is open.
+	//
+	// Streams are cheaper for the server than HTTP requests, so this is
+	// typically higher. Defaults to TransmitRateLimit.
+	StreamTransmitRateLimit *rate.Limiter
+
+	// NegotiateTimeout is how long to wait for the server to accept
+	// a stream before falling back to HTTP.
+	NegotiateTimeout time.Duration
+
+	// Tracer records a span for each request, or is nil.
+	Tracer *observability.Tracer
+
+	// OnRunControl is called with the instructions for the run that the
+	// server includes in its responses, such as to stop the run.
+	//
+	// It is called at most once per kind of instruction, from the
+	// goroutine processing responses. It may be nil.
+	OnRunControl func(RunControl)
+
+	// AckLogPath is where to persist the lines acknowledged by the server,
+	// so that replaying the run's transaction log doesn't send them again.
+	//
+	// If empty, the log is always replayed in full. See [AckLog].
+	AckLogPath string
 }
 
 func NewFileStream(params FileStreamParams) FileStream {
@@ -126,8 +197,22 @@ func NewFileStream(params FileStreamParams) FileStream {
 		processChan:       make(chan Update, BufferSize),
 		feedbackWait:      &sync.WaitGroup{},
 		transmitRateLimit: params.TransmitRateLimit,
+		openStream:        params.OpenStream,
 		deadChanOnce:      &sync.Once{},
 		deadChan:          make(chan struct{}),
+		tracer:            params.Tracer,
+		onRunControl:      params.OnRunControl,
+		ackLogPath:        params.AckLogPath,
+	}
+
+	fs.streamTransmitRateLimit = params.StreamTransmitRateLimit
+	if fs.streamTransmitRateLimit == nil {
+		fs.streamTransmitRateLimit = fs.transmitRateLimit
+	}
+
+	fs.negotiateTimeout = params.NegotiateTimeout
+	if fs.negotiateTimeout == 0 {
+		fs.negotiateTimeout = defaultNegotiateTimeout
 	}
 
 	fs.heartbeatStopwatch = params.HeartbeatStopwatch
@@ -153,6 +238,16 @@ func (fs *fileStream) Start(
 		runID,
 	)
 
+	if fs.openStream != nil {
+		stream, err := negotiateStream(fs.openStream, fs.path, fs.negotiateTimeout)
+		if err != nil {
+			fs.logger.Info("filestream: using HTTP", "reason", err)
+		} else {
+			fs.logger.Info("filestream: using stream")
+			fs.stream = stream
+		}
+	}
+
 	transmitChan := fs.startProcessingUpdates(fs.processChan)
 	feedbackChan := fs.startTransmitting(transmitChan, offsetMap)
 	fs.startProcessingFeedback(feedbackChan, fs.feedbackWait)
@@ -171,6 +266,9 @@ func (fs *fileStream) FinishWithExit(exitCode int32) {
 func (fs *fileStream) FinishWithoutExit() {
 	close(fs.processChan)
 	fs.feedbackWait.Wait()
+	if fs.stream != nil {
+		_ = fs.stream.Close()
+	}
 	fs.logger.Debug("filestream: closed")
 }
 
@@ -179,18 +277,31 @@ func (fs *fileStream) FinishWithoutExit() {
 // After this, most filestream operations are no-ops. This is meant for
 // when we can't guarantee correctness, in which case we stop uploading
 // data but continue to save it to disk to avoid data loss.
+//
+// The error is also reported to the client with its code.
 func (fs *fileStream) logFatalAndStopWorking(err error) {
-	fs.logger.CaptureFatal(fmt.Errorf("filestream: fatal error: %v", err))
+	fatalErr := fmt.Errorf("filestream: fatal error: %w", err)
+	fs.logger.CaptureFatal(fatalErr)
 	fs.deadChanOnce.Do(func() {
+		fs.fatalErr = fatalErr
 		close(fs.deadChan)
 		fs.printer.Write(
 			"Fatal error while uploading data. Some run data will" +
 				" not be synced, but it will still be written to disk. Use" +
 				" `wandb sync` at the end of the run to try uploading.",
 		)
+		fs.printer.WriteError(
+			errorcode.Info(fatalErr, service.ErrorInfo_COMMUNICATION))
 	})
 }
 
+func (fs *fileStream) Err() error {
+	if !fs.isDead() {
+		return nil
+	}
+	return fs.fatalErr
+}
+
 // isDead reports whether the filestream has been killed.
 func (fs *fileStream) isDead() bool {
 	select {
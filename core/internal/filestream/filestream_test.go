@@ -0,0 +1,42 @@
+package filestream_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wandb/wandb/core/internal/apitest"
+	. "github.com/wandb/wandb/core/internal/filestream"
+	"github.com/wandb/wandb/core/internal/waitingtest"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+	"golang.org/x/time/rate"
+)
+
+func finishWithResponse(statusCode int) FileStream {
+	client := apitest.NewFakeClient("https://example.com")
+	client.SetResponse(&apitest.TestResponse{StatusCode: statusCode}, nil)
+
+	fs := NewFileStream(FileStreamParams{
+		Settings:           &service.Settings{},
+		Logger:             observability.NewNoOpLogger(),
+		Printer:            observability.NewPrinter(),
+		ApiClient:          client,
+		TransmitRateLimit:  rate.NewLimiter(rate.Inf, 1),
+		HeartbeatStopwatch: waitingtest.NewFakeStopwatch(),
+	})
+	fs.Start("entity", "project", "run", FileStreamOffsetMap{})
+	fs.FinishWithExit(0)
+	return fs
+}
+
+func TestFileStream_NoErrIfUploaded(t *testing.T) {
+	fs := finishWithResponse(200)
+
+	assert.NoError(t, fs.Err())
+}
+
+func TestFileStream_ErrIfStopped(t *testing.T) {
+	fs := finishWithResponse(403)
+
+	assert.ErrorContains(t, fs.Err(), "filestream: fatal error")
+}
@@ -1,13 +1,16 @@
 package filestream
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
 	"sync"
+	"time"
 
 	"github.com/wandb/segmentio-encoding/json"
 	"github.com/wandb/wandb/core/internal/api"
+	"github.com/wandb/wandb/core/internal/errorcode"
 )
 
 // startProcessingUpdates asynchronously ingests updates.
@@ -61,14 +64,26 @@ func (fs *fileStream) startTransmitting(
 	requests <-chan *FileStreamRequest,
 	initialOffsets FileStreamOffsetMap,
 ) <-chan map[string]any {
+	transmitRateLimit := fs.transmitRateLimit
+	if fs.stream != nil {
+		transmitRateLimit = fs.streamTransmitRateLimit
+	}
+
 	transmissions := CollectLoop{
-		TransmitRateLimit: fs.transmitRateLimit,
+		TransmitRateLimit:       transmitRateLimit,
+		MaxBufferedHistoryBytes: maxBufferedHistoryBytes,
 	}.Start(requests)
 
+	var ackLog *AckLog
+	if fs.ackLogPath != "" {
+		ackLog = OpenAckLog(fs.ackLogPath, fs.path, fs.logger)
+	}
+
 	feedback := TransmitLoop{
 		HeartbeatStopwatch:     fs.heartbeatStopwatch,
 		Send:                   fs.send,
 		LogFatalAndStopWorking: fs.logFatalAndStopWorking,
+		AckLog:                 ackLog,
 	}.Start(transmissions, initialOffsets)
 
 	return feedback
@@ -86,7 +101,20 @@ func (fs *fileStream) startProcessingFeedback(
 	go func() {
 		defer wg.Done()
 
-		for range feedback {
+		stopped := false
+		for response := range feedback {
+			control, ok := ParseRunControl(response)
+			if !ok || stopped {
+				continue
+			}
+
+			stopped = true
+			fs.logger.Info(
+				"filestream: server asked to stop the run",
+				"reason", control.Reason)
+			if fs.onRunControl != nil {
+				fs.onRunControl(control)
+			}
 		}
 	}()
 }
@@ -100,6 +128,61 @@ func (fs *fileStream) send(
 		return fmt.Errorf("filestream: can't send because I am dead")
 	}
 
+	_, span := fs.tracer.Start(context.Background(), "filestream.send")
+	defer span.End()
+
+	if fs.stream != nil {
+		err := fs.sendOnStream(data, feedbackChan)
+		if err == nil {
+			return nil
+		}
+
+		// Requests carry absolute offsets, so it's safe to resend one
+		// that the server may have already received.
+		fs.logger.CaptureWarn(
+			"filestream: stream failed, falling back to HTTP",
+			"error", err)
+		_ = fs.stream.Close()
+		fs.stream = nil
+	}
+
+	return fs.sendHTTP(data, feedbackChan)
+}
+
+// sendOnStream sends a request on the open stream.
+func (fs *fileStream) sendOnStream(
+	data *FileStreamRequestJSON,
+	feedbackChan chan<- map[string]any,
+) error {
+	fs.logger.Debug("filestream: stream request", "request", data)
+
+	// Give up on the stream if the server stops answering, like an HTTP
+	// request that times out.
+	stream := fs.stream
+	timeout := time.AfterFunc(DefaultNonRetryTimeout, func() {
+		_ = stream.Close()
+	})
+	defer timeout.Stop()
+
+	if err := stream.Send(data); err != nil {
+		return err
+	}
+
+	res, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	feedbackChan <- res
+	fs.logger.Debug("filestream: stream response", "response", res)
+	return nil
+}
+
+// sendHTTP sends a request as an HTTP POST.
+func (fs *fileStream) sendHTTP(
+	data *FileStreamRequestJSON,
+	feedbackChan chan<- map[string]any,
+) error {
 	jsonData, err := json.Marshal(data)
 	if err != nil {
 		return fmt.Errorf("filestream: json marshal error in send(): %v", err)
@@ -120,7 +203,7 @@ func (fs *fileStream) send(
 	switch {
 	case err != nil:
 		return fmt.Errorf(
-			"filestream: error making HTTP request: %v. got response: %v",
+			"filestream: error making HTTP request: %w. got response: %v",
 			err,
 			resp,
 		)
@@ -130,7 +213,10 @@ func (fs *fileStream) send(
 	case resp.StatusCode < 200 || resp.StatusCode > 300:
 		// If we reach here, that means all retries were exhausted. This could
 		// mean, for instance, that the user's internet connection broke.
-		return fmt.Errorf("filestream: failed to upload: %v", resp.Status)
+		return errorcode.Wrap(
+			errorcode.OfHTTPStatus(resp.StatusCode),
+			fmt.Errorf("filestream: failed to upload: %v", resp.Status),
+		)
 	}
 
 	defer func(Body io.ReadCloser) {
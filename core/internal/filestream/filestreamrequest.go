@@ -158,6 +158,15 @@ type FileStreamState struct {
 	// EventsLineNum is the line number where to append system metrics.
 	EventsLineNum int
 
+	// HistorySentLineNum and EventsSentLineNum are the line numbers
+	// before which the server already has the lines.
+	//
+	// This is used when replaying a transaction log that was partly sent
+	// before, in which case lines before these are skipped so that they
+	// aren't duplicated. See [AckLog].
+	HistorySentLineNum int
+	EventsSentLineNum  int
+
 	// SummaryLineNum is the line number where to write the summary.
 	//
 	// The same line is always overwritten. The reason we don't solely
@@ -183,16 +192,24 @@ func (r *FileStreamRequestReader) GetJSON(
 	}
 
 	if r.historyLinesToSend > 0 {
-		json.Files[HistoryFileName] = offsetAndContent{
-			Offset:  state.HistoryLineNum,
-			Content: r.request.HistoryLines[:r.historyLinesToSend],
+		lines := unsentLines(
+			r.request.HistoryLines[:r.historyLinesToSend],
+			state.HistoryLineNum,
+			state.HistorySentLineNum,
+		)
+		if len(lines.Content) > 0 {
+			json.Files[HistoryFileName] = lines
 		}
 		state.HistoryLineNum += r.historyLinesToSend
 	}
 	if r.eventsLinesToSend > 0 {
-		json.Files[EventsFileName] = offsetAndContent{
-			Offset:  state.EventsLineNum,
-			Content: r.request.EventsLines[:r.eventsLinesToSend],
+		lines := unsentLines(
+			r.request.EventsLines[:r.eventsLinesToSend],
+			state.EventsLineNum,
+			state.EventsSentLineNum,
+		)
+		if len(lines.Content) > 0 {
+			json.Files[EventsFileName] = lines
 		}
 		state.EventsLineNum += r.eventsLinesToSend
 	}
@@ -230,6 +247,16 @@ func (r *FileStreamRequestReader) GetJSON(
 	return json
 }
 
+// unsentLines returns the lines to append at an offset, minus those
+// before sentLineNum, which the server already has.
+func unsentLines(lines []string, offset int, sentLineNum int) offsetAndContent {
+	skip := min(max(sentLineNum-offset, 0), len(lines))
+	return offsetAndContent{
+		Offset:  offset + skip,
+		Content: lines[skip:],
+	}
+}
+
 // Next returns the request minus the data consumed in [GetJSON].
 //
 // The second return value indicates whether the entire request was consumed.
@@ -31,6 +31,29 @@ func TestHistory_Read(t *testing.T) {
 	assert.Empty(t, next.HistoryLines)
 }
 
+func TestHistory_ReadSkipsSentLines(t *testing.T) {
+	reader := NewRequestReader(
+		&FileStreamRequest{HistoryLines: []string{"one", "two", "three"}})
+	state := &FileStreamState{HistoryLineNum: 5, HistorySentLineNum: 7}
+
+	json := reader.GetJSON(state)
+
+	assert.Equal(t, 8, state.HistoryLineNum)
+	assert.Equal(t, 7, json.Files[HistoryFileName].Offset)
+	assert.Equal(t, []string{"three"}, json.Files[HistoryFileName].Content)
+}
+
+func TestHistory_ReadOmitsAllSentLines(t *testing.T) {
+	reader := NewRequestReader(
+		&FileStreamRequest{HistoryLines: []string{"one", "two"}})
+	state := &FileStreamState{HistorySentLineNum: 10}
+
+	json := reader.GetJSON(state)
+
+	assert.Equal(t, 2, state.HistoryLineNum)
+	assert.NotContains(t, json.Files, HistoryFileName)
+}
+
 func TestEvents_MergeAppends(t *testing.T) {
 	req1 := &FileStreamRequest{EventsLines: []string{"original"}}
 	req2 := &FileStreamRequest{EventsLines: []string{"new"}}
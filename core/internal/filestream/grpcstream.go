@@ -0,0 +1,250 @@
+package filestream
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/wandb/segmentio-encoding/json"
+	"github.com/wandb/wandb/core/internal/api"
+)
+
+// The filestream can stream requests to the backend over a single gRPC
+// call instead of making an HTTP request for each one.
+//
+// The call is a bidirectional streaming method on the same host and path
+// prefix as the HTTP API, made through the same api.Client so that it
+// uses the same proxy, TLS settings, auth headers, request signing, rate
+// limit and circuit breaker. It follows the gRPC over HTTP/2 protocol
+// with the "json" content subtype:
+//
+//   - The filestream path, e.g. "files/entity/project/run/file_stream",
+//     is sent in the GRPCPathMetadataKey header.
+//   - Each request message is the JSON body of an HTTP filestream request,
+//     and the server answers each with one response message that is the
+//     JSON body of the HTTP response, in order.
+//   - Messages are not compressed.
+//
+// Since gRPC requires HTTP/2, streams only work with https base URLs.
+const (
+	// GRPCStreamMethod is the bidirectional streaming method of the
+	// filestream service.
+	GRPCStreamMethod = "/wandb.filestream.v1.FileStream/Stream"
+
+	// GRPCPathMetadataKey is the metadata key that carries the filestream
+	// path.
+	GRPCPathMetadataKey = "x-wandb-filestream-path"
+
+	// GRPCContentType is the content type of the stream.
+	GRPCContentType = "application/grpc+json"
+
+	// How long to wait for the server to accept a stream before falling
+	// back to HTTP.
+	defaultNegotiateTimeout = 10 * time.Second
+
+	// The largest response message to accept.
+	maxGRPCMessageBytes = 4 << 20
+)
+
+// StreamConn is an open stream of filestream requests to the backend.
+//
+// Every request sent gets exactly one response, in order.
+type StreamConn interface {
+	// Send sends a request on the stream.
+	Send(*FileStreamRequestJSON) error
+
+	// Recv blocks until the response to the oldest unanswered request.
+	Recv() (map[string]any, error)
+
+	// Close ends the stream and releases its connection.
+	//
+	// It unblocks any Send or Recv in progress.
+	Close() error
+}
+
+// StreamOpener opens a stream for the filestream at the given path.
+type StreamOpener func(path string) (StreamConn, error)
+
+// NewGRPCStreamOpener returns a StreamOpener that makes gRPC calls
+// through the client.
+func NewGRPCStreamOpener(client api.StreamClient) StreamOpener {
+	return func(path string) (StreamConn, error) {
+		stream, err := client.OpenStream(
+			context.Background(),
+			&api.StreamRequest{
+				Path: GRPCStreamMethod,
+				Headers: map[string]string{
+					"Content-Type":      GRPCContentType,
+					"TE":                "trailers",
+					GRPCPathMetadataKey: path,
+				},
+			},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("filestream: failed to open gRPC stream: %v", err)
+		}
+
+		return &grpcStreamConn{stream: stream}, nil
+	}
+}
+
+// GRPCStatusError is the non-OK status that ended a gRPC call.
+type GRPCStatusError struct {
+	// Code is the gRPC status code, such as 12 for UNIMPLEMENTED.
+	Code string
+
+	// Message is the status message.
+	Message string
+}
+
+func (e *GRPCStatusError) Error() string {
+	return fmt.Sprintf("gRPC status %s: %s", e.Code, e.Message)
+}
+
+// grpcStatus returns the error in the status headers or trailers of
+// a call, or nil if there's none.
+func grpcStatus(header http.Header) error {
+	code := header.Get("Grpc-Status")
+	if code == "" || code == "0" {
+		return nil
+	}
+
+	// The message is percent-encoded.
+	message, err := url.PathUnescape(header.Get("Grpc-Message"))
+	if err != nil {
+		message = header.Get("Grpc-Message")
+	}
+
+	return &GRPCStatusError{Code: code, Message: message}
+}
+
+// grpcStreamConn is a StreamConn over a gRPC bidirectional stream.
+type grpcStreamConn struct {
+	stream *api.Stream
+
+	// The response, once its headers are received.
+	resp *http.Response
+}
+
+func (c *grpcStreamConn) Send(req *FileStreamRequestJSON) error {
+	data, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("filestream: json marshal error: %v", err)
+	}
+
+	// Each message is prefixed by an uncompressed flag and its length.
+	message := make([]byte, 5+len(data))
+	binary.BigEndian.PutUint32(message[1:5], uint32(len(data)))
+	copy(message[5:], data)
+
+	_, err = c.stream.Write(message)
+	return err
+}
+
+func (c *grpcStreamConn) Recv() (map[string]any, error) {
+	if c.resp == nil {
+		resp, err := c.stream.Response()
+		if err != nil {
+			return nil, err
+		}
+		if err := checkGRPCResponse(resp); err != nil {
+			return nil, err
+		}
+		c.resp = resp
+	}
+
+	var prefix [5]byte
+	_, err := io.ReadFull(c.resp.Body, prefix[:])
+	switch {
+	case errors.Is(err, io.EOF):
+		if err := grpcStatus(c.resp.Trailer); err != nil {
+			return nil, err
+		}
+		return nil, errors.New("filestream: stream ended without a response")
+	case err != nil:
+		return nil, err
+	case prefix[0] != 0:
+		return nil, errors.New("filestream: got a compressed gRPC message")
+	}
+
+	length := binary.BigEndian.Uint32(prefix[1:5])
+	if length > maxGRPCMessageBytes {
+		return nil, fmt.Errorf("filestream: gRPC message too large (%d bytes)", length)
+	}
+
+	data := make([]byte, length)
+	if _, err := io.ReadFull(c.resp.Body, data); err != nil {
+		return nil, err
+	}
+
+	var res map[string]any
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, fmt.Errorf("filestream: json decode error: %v", err)
+	}
+	return res, nil
+}
+
+func (c *grpcStreamConn) Close() error {
+	_ = c.stream.CloseWrite()
+	return c.stream.Close()
+}
+
+// checkGRPCResponse returns an error if the server didn't accept the call.
+func checkGRPCResponse(resp *http.Response) error {
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("filestream: gRPC call failed: %s", resp.Status)
+	}
+
+	contentType := resp.Header.Get("Content-Type")
+	if !strings.HasPrefix(contentType, "application/grpc") {
+		return fmt.Errorf("filestream: not a gRPC response: %q", contentType)
+	}
+
+	// Calls that fail right away only have headers.
+	return grpcStatus(resp.Header)
+}
+
+// negotiateStream opens a stream and checks that the server accepts it
+// by sending a heartbeat.
+//
+// Servers that don't support streaming reject the stream, in which case
+// an error is returned.
+func negotiateStream(
+	open StreamOpener,
+	path string,
+	timeout time.Duration,
+) (StreamConn, error) {
+	conn, err := open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(chan error, 1)
+	go func() {
+		if err := conn.Send(&FileStreamRequestJSON{}); err != nil {
+			result <- err
+			return
+		}
+		_, err := conn.Recv()
+		result <- err
+	}()
+
+	select {
+	case err = <-result:
+	case <-time.After(timeout):
+		err = fmt.Errorf("no response after %v", timeout)
+	}
+
+	if err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("filestream: server did not accept stream: %w", err)
+	}
+
+	return conn, nil
+}
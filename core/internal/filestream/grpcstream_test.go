@@ -0,0 +1,220 @@
+package filestream_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/api"
+	"github.com/wandb/wandb/core/internal/apitest"
+	. "github.com/wandb/wandb/core/internal/filestream"
+	"github.com/wandb/wandb/core/internal/waitingtest"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type testJSONCodec struct{}
+
+func (testJSONCodec) Marshal(v any) ([]byte, error)      { return json.Marshal(v) }
+func (testJSONCodec) Unmarshal(data []byte, v any) error { return json.Unmarshal(data, v) }
+func (testJSONCodec) Name() string                       { return "json" }
+
+var _ encoding.Codec = testJSONCodec{}
+
+// fakeStreamServer is a filestream gRPC server that records requests.
+type fakeStreamServer struct {
+	sync.Mutex
+
+	// failAfter makes the stream fail after this many requests, if positive.
+	failAfter int
+
+	// response is sent in reply to each request, or is empty.
+	response map[string]any
+
+	requests []map[string]any
+	metadata metadata.MD
+}
+
+func (s *fakeStreamServer) Requests() []map[string]any {
+	s.Lock()
+	defer s.Unlock()
+	return s.requests
+}
+
+func (s *fakeStreamServer) handle(_ any, stream grpc.ServerStream) error {
+	method, _ := grpc.MethodFromServerStream(stream)
+	if method != GRPCStreamMethod {
+		return status.Error(codes.Unimplemented, method)
+	}
+
+	s.Lock()
+	s.metadata, _ = metadata.FromIncomingContext(stream.Context())
+	s.Unlock()
+
+	for count := 1; ; count++ {
+		var req map[string]any
+		if err := stream.RecvMsg(&req); err != nil {
+			return nil
+		}
+
+		if s.failAfter > 0 && count > s.failAfter {
+			return status.Error(codes.Internal, "test failure")
+		}
+
+		s.Lock()
+		s.requests = append(s.requests, req)
+		s.Unlock()
+
+		response := s.response
+		if response == nil {
+			response = map[string]any{}
+		}
+		if err := stream.SendMsg(response); err != nil {
+			return err
+		}
+	}
+}
+
+// startTLSServer starts an HTTP/2 server and returns a client for it.
+func startTLSServer(t *testing.T, handler http.Handler) api.StreamClient {
+	server := httptest.NewUnstartedServer(handler)
+	server.EnableHTTP2 = true
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	baseURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	backend := api.New(api.BackendOptions{BaseURL: baseURL, APIKey: "test-key"})
+	client := backend.NewClient(api.ClientOptions{
+		ExtraHeaders: map[string]string{"X-Test": "yes"},
+		TLSConfig:    server.Client().Transport.(*http.Transport).TLSClientConfig,
+	})
+	return client.(api.StreamClient)
+}
+
+// startGRPCServer starts a gRPC server and returns a client for it.
+func startGRPCServer(t *testing.T, opts ...grpc.ServerOption) api.StreamClient {
+	server := grpc.NewServer(
+		append(opts, grpc.ForceServerCodec(testJSONCodec{}))...)
+	t.Cleanup(server.Stop)
+	return startTLSServer(t, server)
+}
+
+func newStreamingFileStream(
+	streams api.StreamClient,
+	client *apitest.FakeClient,
+) FileStream {
+	return NewFileStream(FileStreamParams{
+		Settings:           &service.Settings{},
+		Logger:             observability.NewNoOpLogger(),
+		Printer:            observability.NewPrinter(),
+		ApiClient:          client,
+		TransmitRateLimit:  rate.NewLimiter(rate.Inf, 1),
+		HeartbeatStopwatch: waitingtest.NewFakeStopwatch(),
+		OpenStream:         NewGRPCStreamOpener(streams),
+	})
+}
+
+func TestGRPCStream_SendsOnStream(t *testing.T) {
+	server := &fakeStreamServer{}
+	streams := startGRPCServer(t, grpc.UnknownServiceHandler(server.handle))
+	client := apitest.NewFakeClient("https://example.com")
+
+	fs := newStreamingFileStream(streams, client)
+	fs.Start("entity", "project", "run", FileStreamOffsetMap{})
+	fs.FinishWithExit(3)
+
+	requests := server.Requests()
+	require.Len(t, requests, 2)
+	assert.Empty(t, requests[0]) // negotiation heartbeat
+	assert.EqualValues(t, 3, requests[1]["exitcode"])
+	assert.Empty(t, client.GetRequests())
+
+	server.Lock()
+	defer server.Unlock()
+	assert.Equal(t,
+		[]string{"files/entity/project/run/file_stream"},
+		server.metadata.Get(GRPCPathMetadataKey))
+	assert.Equal(t,
+		[]string{"Basic YXBpOnRlc3Qta2V5"},
+		server.metadata.Get("authorization"))
+	assert.Equal(t, []string{"yes"}, server.metadata.Get("x-test"))
+}
+
+func TestGRPCStream_ReportsStop(t *testing.T) {
+	server := &fakeStreamServer{
+		response: map[string]any{"stopped": true, "stop_reason": "sweep"},
+	}
+	streams := startGRPCServer(t, grpc.UnknownServiceHandler(server.handle))
+	var controls []RunControl
+
+	fs := NewFileStream(FileStreamParams{
+		Settings:           &service.Settings{},
+		Logger:             observability.NewNoOpLogger(),
+		Printer:            observability.NewPrinter(),
+		ApiClient:          apitest.NewFakeClient("https://example.com"),
+		TransmitRateLimit:  rate.NewLimiter(rate.Inf, 1),
+		HeartbeatStopwatch: waitingtest.NewFakeStopwatch(),
+		OpenStream:         NewGRPCStreamOpener(streams),
+		OnRunControl: func(control RunControl) {
+			controls = append(controls, control)
+		},
+	})
+	fs.Start("entity", "project", "run", FileStreamOffsetMap{})
+	fs.FinishWithExit(0)
+
+	assert.Equal(t, []RunControl{{Stop: true, Reason: StopReasonSweep}}, controls)
+}
+
+func TestGRPCStream_FallsBackIfUnimplemented(t *testing.T) {
+	streams := startGRPCServer(t)
+	client := apitest.NewFakeClient("https://example.com")
+	client.SetResponse(&apitest.TestResponse{StatusCode: 200}, nil)
+
+	fs := newStreamingFileStream(streams, client)
+	fs.Start("entity", "project", "run", FileStreamOffsetMap{})
+	fs.FinishWithExit(0)
+
+	requests := client.GetRequests()
+	require.Len(t, requests, 1)
+	assert.Contains(t, string(requests[0].Body), `"exitcode":0`)
+}
+
+func TestGRPCStream_FallsBackIfNotGRPC(t *testing.T) {
+	streams := startTLSServer(t, http.NotFoundHandler())
+	client := apitest.NewFakeClient("https://example.com")
+	client.SetResponse(&apitest.TestResponse{StatusCode: 200}, nil)
+
+	fs := newStreamingFileStream(streams, client)
+	fs.Start("entity", "project", "run", FileStreamOffsetMap{})
+	fs.FinishWithExit(0)
+
+	assert.Len(t, client.GetRequests(), 1)
+}
+
+func TestGRPCStream_FallsBackIfStreamBreaks(t *testing.T) {
+	server := &fakeStreamServer{failAfter: 1}
+	streams := startGRPCServer(t, grpc.UnknownServiceHandler(server.handle))
+	client := apitest.NewFakeClient("https://example.com")
+	client.SetResponse(&apitest.TestResponse{StatusCode: 200}, nil)
+
+	fs := newStreamingFileStream(streams, client)
+	fs.Start("entity", "project", "run", FileStreamOffsetMap{})
+	fs.FinishWithExit(0)
+
+	assert.Len(t, server.Requests(), 1)
+	requests := client.GetRequests()
+	require.Len(t, requests, 1)
+	assert.Contains(t, string(requests[0].Body), `"exitcode":0`)
+}
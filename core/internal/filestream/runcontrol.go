@@ -0,0 +1,43 @@
+package filestream
+
+// StopReason is why the server asked to stop a run.
+type StopReason string
+
+const (
+	// StopReasonUser is when a user stopped the run in the UI.
+	StopReasonUser StopReason = "user"
+
+	// StopReasonSweep is when the run's sweep terminated it early.
+	StopReasonSweep StopReason = "sweep"
+)
+
+// RunControl is an instruction for a run in a filestream response.
+//
+// The server asks to stop a run by including
+//
+//	{"stopped": true, "stop_reason": "sweep"}
+//
+// in its response, where the reason is optional.
+type RunControl struct {
+	// Stop is whether the run should stop.
+	Stop bool
+
+	// Reason is why the run should stop; StopReasonUser if the server
+	// doesn't say.
+	Reason StopReason
+}
+
+// ParseRunControl returns the run control instruction in a filestream
+// response, if any.
+func ParseRunControl(response map[string]any) (RunControl, bool) {
+	stopped, _ := response["stopped"].(bool)
+	if !stopped {
+		return RunControl{}, false
+	}
+
+	control := RunControl{Stop: true, Reason: StopReasonUser}
+	if reason, ok := response["stop_reason"].(string); ok && reason != "" {
+		control.Reason = StopReason(reason)
+	}
+	return control, true
+}
@@ -0,0 +1,63 @@
+package filestream_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wandb/wandb/core/internal/apitest"
+	. "github.com/wandb/wandb/core/internal/filestream"
+	"github.com/wandb/wandb/core/internal/waitingtest"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+	"golang.org/x/time/rate"
+)
+
+func TestParseRunControl(t *testing.T) {
+	_, ok := ParseRunControl(map[string]any{"exitcode": nil})
+	assert.False(t, ok)
+
+	_, ok = ParseRunControl(map[string]any{"stopped": false})
+	assert.False(t, ok)
+
+	control, ok := ParseRunControl(map[string]any{"stopped": true})
+	assert.True(t, ok)
+	assert.Equal(t, RunControl{Stop: true, Reason: StopReasonUser}, control)
+
+	control, ok = ParseRunControl(map[string]any{
+		"stopped":     true,
+		"stop_reason": "sweep",
+	})
+	assert.True(t, ok)
+	assert.Equal(t, StopReasonSweep, control.Reason)
+}
+
+func TestFileStream_ReportsStop(t *testing.T) {
+	client := apitest.NewFakeClient("https://example.com")
+	client.SetResponse(
+		&apitest.TestResponse{
+			StatusCode: 200,
+			Body:       `{"stopped": true, "stop_reason": "sweep"}`,
+		},
+		nil,
+	)
+	var controls []RunControl
+
+	fs := NewFileStream(FileStreamParams{
+		Settings:           &service.Settings{},
+		Logger:             observability.NewNoOpLogger(),
+		Printer:            observability.NewPrinter(),
+		ApiClient:          client,
+		TransmitRateLimit:  rate.NewLimiter(rate.Inf, 1),
+		HeartbeatStopwatch: waitingtest.NewFakeStopwatch(),
+		OnRunControl: func(control RunControl) {
+			controls = append(controls, control)
+		},
+	})
+	fs.Start("entity", "project", "run", FileStreamOffsetMap{})
+	fs.StreamUpdate(&PreemptingUpdate{})
+	fs.FinishWithExit(0)
+
+	assert.Equal(t,
+		[]RunControl{{Stop: true, Reason: StopReasonSweep}},
+		controls)
+}
@@ -9,6 +9,9 @@ type TransmitLoop struct {
 	HeartbeatStopwatch     waiting.Stopwatch
 	Send                   func(*FileStreamRequestJSON, chan<- map[string]any) error
 	LogFatalAndStopWorking func(error)
+
+	// AckLog records the lines acknowledged by the server, or is nil.
+	AckLog *AckLog
 }
 
 // Start makes requests to the filestream API.
@@ -37,6 +40,7 @@ func (tr TransmitLoop) Start(
 			state.SummaryLineNum = offsets[SummaryChunk]
 			state.ConsoleLineOffset = offsets[OutputChunk]
 		}
+		tr.AckLog.Restore(state)
 
 		for {
 			x, ok := readWithHeartbeat(state, data, tr.HeartbeatStopwatch)
@@ -51,6 +55,7 @@ func (tr TransmitLoop) Start(
 				tr.LogFatalAndStopWorking(err)
 				break
 			}
+			tr.AckLog.Ack(state)
 		}
 	}()
 
@@ -62,6 +62,7 @@ func (fs *FakeFileStream) Start(
 
 func (fs *FakeFileStream) FinishWithExit(int32) {}
 func (fs *FakeFileStream) FinishWithoutExit()   {}
+func (fs *FakeFileStream) Err() error           { return nil }
 
 func (fs *FakeFileStream) StreamUpdate(update filestream.Update) {
 	fs.Lock()
@@ -0,0 +1,135 @@
+package filetransfer
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// maxBandwidthBurst is the most bytes a limited upload reads at once.
+const maxBandwidthBurst = 256 << 10 // 256 KiB
+
+// BandwidthLimiter limits the total rate of uploads with a token bucket.
+//
+// A nil limiter doesn't limit anything.
+type BandwidthLimiter struct {
+	limiter *rate.Limiter
+}
+
+// NewBandwidthLimiter returns a limiter of uploads to a number of bytes
+// per second, or nil if the limit is not positive.
+func NewBandwidthLimiter(bytesPerSecond int64) *BandwidthLimiter {
+	if bytesPerSecond <= 0 {
+		return nil
+	}
+
+	burst := int(min(bytesPerSecond, maxBandwidthBurst))
+	return &BandwidthLimiter{
+		limiter: rate.NewLimiter(rate.Limit(bytesPerSecond), burst),
+	}
+}
+
+// Reader returns a reader whose reads count toward the limit.
+func (l *BandwidthLimiter) Reader(
+	ctx context.Context,
+	r io.ReadSeeker,
+) io.ReadSeeker {
+	if l == nil {
+		return r
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return &limitedReader{ctx: ctx, r: r, limiter: l.limiter}
+}
+
+// limitedReader waits for tokens before each read.
+type limitedReader struct {
+	ctx     context.Context
+	r       io.ReadSeeker
+	limiter *rate.Limiter
+}
+
+func (r *limitedReader) Read(p []byte) (int, error) {
+	if len(p) > r.limiter.Burst() {
+		p = p[:r.limiter.Burst()]
+	}
+
+	n, err := r.r.Read(p)
+	if n > 0 {
+		if waitErr := r.limiter.WaitN(r.ctx, n); waitErr != nil {
+			return n, waitErr
+		}
+	}
+	return n, err
+}
+
+func (r *limitedReader) Seek(offset int64, whence int) (int64, error) {
+	return r.r.Seek(offset, whence)
+}
+
+// UploadWindow is a daily period of local time, such as "00:00-06:00".
+//
+// The period wraps around midnight if it ends before it starts.
+type UploadWindow struct {
+	start, end time.Duration // since midnight
+}
+
+// ParseUploadWindow parses a period like "22:00-06:00", or returns nil if
+// the string is empty.
+func ParseUploadWindow(s string) (*UploadWindow, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	var startH, startM, endH, endM int
+	_, err := fmt.Sscanf(s, "%d:%d-%d:%d", &startH, &startM, &endH, &endM)
+	if err != nil ||
+		startH < 0 || startH > 24 || startM < 0 || startM > 59 ||
+		endH < 0 || endH > 24 || endM < 0 || endM > 59 {
+		return nil, fmt.Errorf("filetransfer: invalid upload window %q", s)
+	}
+
+	window := &UploadWindow{
+		start: time.Duration(startH)*time.Hour + time.Duration(startM)*time.Minute,
+		end:   time.Duration(endH)*time.Hour + time.Duration(endM)*time.Minute,
+	}
+	if window.start == window.end {
+		return nil, fmt.Errorf("filetransfer: empty upload window %q", s)
+	}
+	return window, nil
+}
+
+// Contains returns whether a time is in the window.
+func (w *UploadWindow) Contains(t time.Time) bool {
+	return w.Wait(t) == 0
+}
+
+// Wait returns how long from a time until the window starts, or 0 if the
+// time is in the window.
+func (w *UploadWindow) Wait(t time.Time) time.Duration {
+	if w == nil {
+		return 0
+	}
+
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	now := t.Sub(midnight)
+
+	inWindow := false
+	if w.start <= w.end {
+		inWindow = w.start <= now && now < w.end
+	} else {
+		inWindow = now >= w.start || now < w.end
+	}
+	if inWindow {
+		return 0
+	}
+
+	if now < w.start {
+		return w.start - now
+	}
+	return 24*time.Hour - now + w.start
+}
@@ -0,0 +1,106 @@
+package filetransfer_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/filetransfer"
+	"github.com/wandb/wandb/core/pkg/observability"
+)
+
+func TestBandwidthLimiter_LimitsRate(t *testing.T) {
+	limiter := filetransfer.NewBandwidthLimiter(10_000)
+	data := make([]byte, 15_000)
+
+	start := time.Now()
+	read, err := io.ReadAll(
+		limiter.Reader(context.Background(), bytes.NewReader(data)))
+
+	require.NoError(t, err)
+	assert.Len(t, read, len(data))
+	// The first 10 KB are the burst, and the rest take half a second.
+	assert.GreaterOrEqual(t, time.Since(start), 400*time.Millisecond)
+}
+
+func TestBandwidthLimiter_NoLimit(t *testing.T) {
+	limiter := filetransfer.NewBandwidthLimiter(0)
+	reader := bytes.NewReader([]byte("data"))
+
+	assert.Nil(t, limiter)
+	assert.Same(t, reader, limiter.Reader(context.Background(), reader))
+}
+
+func TestUploadWindow(t *testing.T) {
+	at := func(hour, minute int) time.Time {
+		return time.Date(2024, 1, 1, hour, minute, 0, 0, time.Local)
+	}
+
+	window, err := filetransfer.ParseUploadWindow("01:30-06:00")
+	require.NoError(t, err)
+	assert.True(t, window.Contains(at(1, 30)))
+	assert.False(t, window.Contains(at(6, 0)))
+	assert.Equal(t, 90*time.Minute, window.Wait(at(0, 0)))
+	assert.Equal(t, 19*time.Hour+30*time.Minute, window.Wait(at(6, 0)))
+
+	overnight, err := filetransfer.ParseUploadWindow("22:00-02:00")
+	require.NoError(t, err)
+	assert.True(t, overnight.Contains(at(23, 0)))
+	assert.True(t, overnight.Contains(at(1, 0)))
+	assert.Equal(t, 2*time.Hour, overnight.Wait(at(20, 0)))
+}
+
+func TestParseUploadWindow_Invalid(t *testing.T) {
+	for _, s := range []string{"night", "25:00-01:00", "01:00-01:00"} {
+		_, err := filetransfer.ParseUploadWindow(s)
+		assert.Error(t, err, s)
+	}
+
+	window, err := filetransfer.ParseUploadWindow("")
+	assert.NoError(t, err)
+	assert.Nil(t, window)
+}
+
+func TestWaitForUploadWindow(t *testing.T) {
+	// A window that starts in two hours.
+	start := time.Now().Add(2 * time.Hour)
+	window, err := filetransfer.ParseUploadWindow(
+		start.Format("15:04") + "-" + start.Add(time.Hour).Format("15:04"))
+	require.NoError(t, err)
+	ftm := filetransfer.NewFileTransferManager(
+		filetransfer.WithLogger(observability.NewNoOpLogger()),
+		filetransfer.WithUploadWindow(window),
+	)
+
+	t.Run("small files don't wait", func(t *testing.T) {
+		ftm.WaitForUploadWindow(context.Background(), 1024)
+	})
+
+	t.Run("large files wait until the window is ignored", func(t *testing.T) {
+		done := make(chan struct{})
+		go func() {
+			ftm.WaitForUploadWindow(context.Background(), 1<<30)
+			close(done)
+		}()
+
+		select {
+		case <-done:
+			t.Fatal("didn't wait for the upload window")
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		ftm.IgnoreUploadWindow()
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("still waiting after IgnoreUploadWindow")
+		}
+
+		// Later uploads don't wait either.
+		ftm.WaitForUploadWindow(context.Background(), 1<<30)
+	})
+}
@@ -0,0 +1,119 @@
+package filetransfer
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+const (
+	// minConcurrencyLimit is the fewest transfers allowed at once, however
+	// much storage asks to slow down.
+	minConcurrencyLimit = 4
+
+	// slowDownCooldown is how long after lowering the limit before it may
+	// be lowered again.
+	//
+	// Transfers running when storage starts throttling all get slow-down
+	// responses; these count as one signal.
+	slowDownCooldown = 5 * time.Second
+)
+
+// AdaptiveConcurrency is the limit on the number of concurrent transfers.
+//
+// The limit is halved when storage responds that requests should slow
+// down, like S3's "503 SlowDown", and grows by about one for each limit's
+// worth of transfers that succeed, up to a maximum. This is the additive
+// increase, multiplicative decrease scheme of TCP congestion control.
+type AdaptiveConcurrency struct {
+	mu sync.Mutex
+
+	// maxLimit is the highest the limit goes.
+	maxLimit int
+
+	// limit is the current limit; the fraction counts successes.
+	limit float64
+
+	// lastSlowDown is when the limit was last lowered.
+	lastSlowDown time.Time
+
+	// onChange is called with the new limit when it changes.
+	onChange func(limit int)
+}
+
+// NewAdaptiveConcurrency returns a limit that starts at its maximum.
+func NewAdaptiveConcurrency(maxLimit int) *AdaptiveConcurrency {
+	maxLimit = max(maxLimit, 1)
+	return &AdaptiveConcurrency{maxLimit: maxLimit, limit: float64(maxLimit)}
+}
+
+// Limit returns the current limit.
+func (c *AdaptiveConcurrency) Limit() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return int(c.limit)
+}
+
+// OnChange sets a function to call with the limit whenever it changes.
+func (c *AdaptiveConcurrency) OnChange(fn func(limit int)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.onChange = fn
+}
+
+// SlowDown halves the limit, unless it was lowered very recently.
+func (c *AdaptiveConcurrency) SlowDown(now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if now.Sub(c.lastSlowDown) < slowDownCooldown {
+		return
+	}
+	c.lastSlowDown = now
+	c.setLimit(float64(max(int(c.limit)/2, min(minConcurrencyLimit, c.maxLimit))))
+}
+
+// Succeeded raises the limit slightly after a transfer succeeds.
+func (c *AdaptiveConcurrency) Succeeded() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.setLimit(min(c.limit+1/c.limit, float64(c.maxLimit)))
+}
+
+// setLimit updates the limit and reports if its whole part changed.
+//
+// It must be called while mu is held.
+func (c *AdaptiveConcurrency) setLimit(limit float64) {
+	old := int(c.limit)
+	c.limit = limit
+	if int(limit) != old && c.onChange != nil {
+		c.onChange(int(limit))
+	}
+}
+
+// RetryPolicy wraps a retry policy to lower the limit whenever storage
+// says to slow down, including on requests that are retried.
+func (c *AdaptiveConcurrency) RetryPolicy(
+	policy retryablehttp.CheckRetry,
+) retryablehttp.CheckRetry {
+	return func(ctx context.Context, resp *http.Response, err error) (bool, error) {
+		if resp != nil && isSlowDown(resp) {
+			c.SlowDown(time.Now())
+		}
+		return policy(ctx, resp, err)
+	}
+}
+
+// isSlowDown returns whether a response asks the client to send fewer
+// requests.
+//
+// S3 responds "503 SlowDown", GCS "429 Too Many Requests" or 503, and
+// Azure "503 ServerBusy".
+func isSlowDown(resp *http.Response) bool {
+	return resp.StatusCode == http.StatusServiceUnavailable ||
+		resp.StatusCode == http.StatusTooManyRequests
+}
@@ -0,0 +1,85 @@
+package filetransfer
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdaptiveConcurrency_SlowDown(t *testing.T) {
+	c := NewAdaptiveConcurrency(64)
+	var changes []int
+	c.OnChange(func(limit int) { changes = append(changes, limit) })
+	now := time.Now()
+
+	c.SlowDown(now)
+	c.SlowDown(now.Add(time.Second)) // same burst of slow-down responses
+	c.SlowDown(now.Add(slowDownCooldown))
+	for i := range 4 {
+		c.SlowDown(now.Add(time.Duration(i+2) * slowDownCooldown))
+	}
+
+	assert.Equal(t, []int{32, 16, 8, 4}, changes)
+	assert.Equal(t, minConcurrencyLimit, c.Limit())
+}
+
+func TestAdaptiveConcurrency_Succeeded(t *testing.T) {
+	c := NewAdaptiveConcurrency(10)
+	c.SlowDown(time.Now())
+	require.Equal(t, 5, c.Limit())
+
+	// Each success adds 1/limit, so it takes a little over 5 to reach 6.
+	for range 5 {
+		c.Succeeded()
+	}
+	assert.Equal(t, 5, c.Limit())
+	c.Succeeded()
+	assert.Equal(t, 6, c.Limit())
+
+	for range 100 {
+		c.Succeeded()
+	}
+	assert.Equal(t, 10, c.Limit())
+}
+
+func TestAdaptiveConcurrency_RetryPolicy(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			if requests == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				_, _ = w.Write([]byte("<Code>SlowDown</Code>"))
+			}
+		}))
+	defer server.Close()
+	c := NewAdaptiveConcurrency(16)
+	client := retryablehttp.NewClient()
+	client.RetryWaitMin = time.Millisecond
+	client.RetryWaitMax = time.Millisecond
+	client.CheckRetry = c.RetryPolicy(FileTransferRetryPolicy)
+
+	resp, err := client.Get(server.URL)
+
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 8, c.Limit())
+}
+
+func TestTaskScheduler_SetLimit(t *testing.T) {
+	s := newTaskScheduler(1)
+	release := s.Acquire(PriorityNormal)
+	waiting := acquireAsync(s, PriorityNormal)
+	waitForWaiting(t, s, 1)
+
+	s.setLimit(2)
+
+	(<-waiting)()
+	release()
+}
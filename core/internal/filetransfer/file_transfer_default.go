@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/go-retryablehttp"
+	"github.com/wandb/wandb/core/internal/errorcode"
 	"github.com/wandb/wandb/core/pkg/observability"
 )
 
@@ -23,6 +24,9 @@ type DefaultFileTransfer struct {
 
 	// fileTransferStats is used to track upload/download progress
 	fileTransferStats FileTransferStats
+
+	// bandwidth limits the upload rate, if not nil
+	bandwidth *BandwidthLimiter
 }
 
 // NewDefaultFileTransfer creates a new fileTransfer
@@ -87,7 +91,10 @@ func (ft *DefaultFileTransfer) Upload(task *Task) error {
 		task.Size = stat.Size() - task.Offset
 	}
 
-	reader := io.NewSectionReader(file, task.Offset, task.Size)
+	reader := ft.bandwidth.Reader(
+		task.Context,
+		io.NewSectionReader(file, task.Offset, task.Size),
+	)
 
 	progressReader, err := NewProgressReader(
 		reader,
@@ -128,7 +135,10 @@ func (ft *DefaultFileTransfer) Upload(task *Task) error {
 		return err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		return fmt.Errorf("file transfer: upload: failed to upload: %s", resp.Status)
+		return errorcode.Wrap(
+			errorcode.OfStorageStatus(resp.StatusCode),
+			fmt.Errorf("file transfer: upload: failed to upload: %s", resp.Status),
+		)
 	}
 	return nil
 }
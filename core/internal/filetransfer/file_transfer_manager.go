@@ -1,9 +1,12 @@
 package filetransfer
 
 import (
+	"context"
 	"fmt"
 	"sync"
+	"time"
 
+	"github.com/wandb/wandb/core/internal/errorcode"
 	"github.com/wandb/wandb/core/pkg/service"
 
 	"github.com/wandb/wandb/core/pkg/observability"
@@ -21,6 +24,22 @@ type FileTransferManager interface {
 	// AddTask schedules a file upload or download operation.
 	AddTask(task *Task)
 
+	// WaitForUploadWindow blocks until a file of the given size may be
+	// uploaded.
+	//
+	// Large files are only uploaded during the upload window, if one is
+	// set. Upload URLs expire, so this should be called before requesting
+	// the URL for a large file. It returns early if the context is done
+	// or after IgnoreUploadWindow.
+	WaitForUploadWindow(ctx context.Context, size int64)
+
+	// IgnoreUploadWindow lets waiting and future uploads of large files
+	// start immediately.
+	//
+	// This is called when the run is finishing, so that it isn't held up
+	// until the next upload window.
+	IgnoreUploadWindow()
+
 	// Close waits for all tasks to complete.
 	Close()
 }
@@ -33,8 +52,11 @@ type fileTransferManager struct {
 	// fileTransferStats keeps track of upload/download statistics
 	fileTransferStats FileTransferStats
 
-	// semaphore is the semaphore for limiting concurrency
-	semaphore chan struct{}
+	// scheduler limits concurrency and orders waiting transfers
+	scheduler *taskScheduler
+
+	// concurrency adjusts the scheduler's limit, or is nil for a fixed limit
+	concurrency *AdaptiveConcurrency
 
 	// settings is the settings for the file transfer
 	settings *service.Settings
@@ -42,6 +64,16 @@ type fileTransferManager struct {
 	// logger is the logger for the file transfer
 	logger *observability.CoreLogger
 
+	// printer reports errors the client should handle, or is nil
+	printer *observability.Printer
+
+	// uploadWindow is when large files may be uploaded, or nil for any time
+	uploadWindow *UploadWindow
+
+	// uploadWindowIgnored is closed by IgnoreUploadWindow
+	uploadWindowIgnored     chan struct{}
+	uploadWindowIgnoredOnce *sync.Once
+
 	// wg is the wait group
 	wg *sync.WaitGroup
 }
@@ -54,6 +86,12 @@ func WithLogger(logger *observability.CoreLogger) FileTransferManagerOption {
 	}
 }
 
+func WithPrinter(printer *observability.Printer) FileTransferManagerOption {
+	return func(fm *fileTransferManager) {
+		fm.printer = printer
+	}
+}
+
 func WithSettings(settings *service.Settings) FileTransferManagerOption {
 	return func(fm *fileTransferManager) {
 		fm.settings = settings
@@ -72,31 +110,63 @@ func WithFileTransferStats(fileTransferStats FileTransferStats) FileTransferMana
 	}
 }
 
+func WithUploadWindow(uploadWindow *UploadWindow) FileTransferManagerOption {
+	return func(fm *fileTransferManager) {
+		fm.uploadWindow = uploadWindow
+	}
+}
+
+// WithConcurrency makes the number of concurrent transfers follow an
+// adaptive limit.
+func WithConcurrency(concurrency *AdaptiveConcurrency) FileTransferManagerOption {
+	return func(fm *fileTransferManager) {
+		fm.concurrency = concurrency
+	}
+}
+
 func NewFileTransferManager(opts ...FileTransferManagerOption) FileTransferManager {
 
 	fm := fileTransferManager{
-		wg:        &sync.WaitGroup{},
-		semaphore: make(chan struct{}, DefaultConcurrencyLimit),
+		wg:                      &sync.WaitGroup{},
+		scheduler:               newTaskScheduler(DefaultConcurrencyLimit),
+		uploadWindowIgnored:     make(chan struct{}),
+		uploadWindowIgnoredOnce: &sync.Once{},
 	}
 
 	for _, opt := range opts {
 		opt(&fm)
 	}
 
+	if fm.concurrency != nil {
+		scheduler := newTaskScheduler(fm.concurrency.Limit())
+		fm.scheduler = scheduler
+		fm.concurrency.OnChange(func(limit int) {
+			fm.logger.Info("fileTransfer: changing concurrency limit", "limit", limit)
+			scheduler.setLimit(limit)
+		})
+	}
+
 	return &fm
 }
 
 func (fm *fileTransferManager) AddTask(task *Task) {
 	fm.logger.Debug("fileTransfer: adding upload task", "path", task.Path, "url", task.Url)
 
+	priority := PriorityOf(task)
+
 	fm.wg.Add(1)
 	go func() {
 		defer fm.wg.Done()
 
-		// Guard by a semaphore to limit number of concurrent uploads.
-		fm.semaphore <- struct{}{}
+		// Limit the number of concurrent transfers, and let small files
+		// go before large ones.
+		release := fm.scheduler.Acquire(priority)
 		task.Err = fm.transfer(task)
-		<-fm.semaphore
+		release()
+
+		if task.Err == nil && fm.concurrency != nil {
+			fm.concurrency.Succeeded()
+		}
 
 		if task.Err != nil {
 			fm.logger.CaptureError(
@@ -106,6 +176,7 @@ func (fm *fileTransferManager) AddTask(task *Task) {
 					task.Url,
 					task.Err,
 				))
+			fm.reportError(task.Err)
 		}
 
 		// Execute the callback.
@@ -113,6 +184,61 @@ func (fm *fileTransferManager) AddTask(task *Task) {
 	}()
 }
 
+// reportError tells the client about a failed transfer that it can act
+// on, such as storage denying access to the bucket.
+func (fm *fileTransferManager) reportError(err error) {
+	if fm.printer == nil {
+		return
+	}
+
+	switch code := errorcode.Of(err); code {
+	case service.ErrorInfo_STORAGE_DENIED,
+		service.ErrorInfo_QUOTA_EXCEEDED:
+		fm.printer.WriteError(&service.ErrorInfo{
+			Message: err.Error(),
+			Code:    code,
+		})
+	}
+}
+
+func (fm *fileTransferManager) WaitForUploadWindow(ctx context.Context, size int64) {
+	if !IsLargeFile(size) {
+		return
+	}
+
+	wait := fm.uploadWindow.Wait(time.Now())
+	if wait == 0 {
+		return
+	}
+
+	select {
+	case <-fm.uploadWindowIgnored:
+		return
+	default:
+	}
+
+	fm.logger.Info(
+		"fileTransfer: waiting for upload window",
+		"size", size,
+		"wait", wait,
+	)
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+	case <-fm.uploadWindowIgnored:
+		fm.logger.Info("fileTransfer: uploading outside the upload window to finish the run")
+	}
+}
+
+func (fm *fileTransferManager) IgnoreUploadWindow() {
+	fm.uploadWindowIgnoredOnce.Do(func() {
+		close(fm.uploadWindowIgnored)
+	})
+}
+
 // completeTask runs the completion callback and updates statistics.
 func (fm *fileTransferManager) completeTask(task *Task) {
 	task.CompletionCallback(task)
@@ -1,6 +1,8 @@
 package filetransfer
 
 import (
+	"strings"
+
 	"github.com/hashicorp/go-retryablehttp"
 	"github.com/wandb/wandb/core/pkg/observability"
 )
@@ -14,6 +16,12 @@ type FileTransfer interface {
 type FileTransfers struct {
 	// Default makes an HTTP request to the destination URL with the file contents.
 	Default FileTransfer
+
+	// S3 downloads s3:// references, or is nil if they aren't supported.
+	S3 FileTransfer
+
+	// GCS downloads gs:// references, or is nil if they aren't supported.
+	GCS FileTransfer
 }
 
 // NewFileTransfers creates a new fileTransfers
@@ -21,11 +29,13 @@ func NewFileTransfers(
 	client *retryablehttp.Client,
 	logger *observability.CoreLogger,
 	fileTransferStats FileTransferStats,
+	bandwidth *BandwidthLimiter,
 ) *FileTransfers {
 	defaultFileTransfer := &DefaultFileTransfer{
 		logger:            logger,
 		client:            client,
 		fileTransferStats: fileTransferStats,
+		bandwidth:         bandwidth,
 	}
 	return &FileTransfers{
 		Default: defaultFileTransfer,
@@ -34,5 +44,11 @@ func NewFileTransfers(
 
 // Returns the appropriate fileTransfer depending on task
 func (ft *FileTransfers) GetFileTransferForTask(task *Task) FileTransfer {
+	if strings.HasPrefix(task.Url, "s3://") {
+		return ft.S3
+	}
+	if strings.HasPrefix(task.Url, "gs://") {
+		return ft.GCS
+	}
 	return ft.Default
 }
@@ -0,0 +1,274 @@
+package filetransfer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/wandb/wandb/core/internal/errorcode"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// GCSConfig configures access to the buckets of gs:// references.
+type GCSConfig struct {
+	// EndpointURL is the Cloud Storage API to use, or empty for Google's.
+	EndpointURL string
+
+	// Anonymous is whether to make requests without credentials, as for
+	// a Cloud Storage emulator.
+	Anonymous bool
+
+	// CredentialsFile is a JSON file with a service account key, a user's
+	// refresh token or a workload identity federation configuration.
+	//
+	// If empty, tokens come from the metadata server, as on GCE and on
+	// GKE with workload identity.
+	CredentialsFile string
+
+	// Audience replaces the workload identity pool provider in an
+	// external account credentials file, if not empty.
+	Audience string
+
+	// Scopes are the OAuth scopes of the tokens, or nil for read-only
+	// access to Cloud Storage.
+	Scopes []string
+
+	// ImpersonateServiceAccount is the email of a service account whose
+	// tokens to use instead of the credentials' own, if not empty.
+	ImpersonateServiceAccount string
+}
+
+// GCSConfigFromEnv returns the configuration in the standard Google Cloud
+// environment variables.
+//
+// The credentials file is GOOGLE_APPLICATION_CREDENTIALS, or else the one
+// made by `gcloud auth application-default login`, if it exists. Setting
+// STORAGE_EMULATOR_HOST makes requests go to an emulator without
+// credentials.
+func GCSConfigFromEnv() GCSConfig {
+	if emulator := os.Getenv("STORAGE_EMULATOR_HOST"); emulator != "" {
+		if !strings.Contains(emulator, "://") {
+			emulator = "http://" + emulator
+		}
+		return GCSConfig{EndpointURL: emulator, Anonymous: true}
+	}
+
+	config := GCSConfig{
+		CredentialsFile: os.Getenv("GOOGLE_APPLICATION_CREDENTIALS"),
+	}
+	if config.CredentialsFile == "" {
+		if path, ok := gcloudCredentialsFile(); ok {
+			config.CredentialsFile = path
+		}
+	}
+	return config
+}
+
+// gcloudCredentialsFile returns the path of the application default
+// credentials made by gcloud, if the file exists.
+func gcloudCredentialsFile() (string, bool) {
+	dir := os.Getenv("CLOUDSDK_CONFIG")
+	if dir == "" {
+		if appData := os.Getenv("APPDATA"); appData != "" {
+			dir = filepath.Join(appData, "gcloud")
+		} else if home, err := os.UserHomeDir(); err == nil {
+			dir = filepath.Join(home, ".config", "gcloud")
+		} else {
+			return "", false
+		}
+	}
+
+	path := filepath.Join(dir, "application_default_credentials.json")
+	if _, err := os.Stat(path); err != nil {
+		return "", false
+	}
+	return path, true
+}
+
+// ParseGCSURL splits a gs://bucket/object URL into the bucket, object and
+// the generation in its "generation" query parameter, if any.
+func ParseGCSURL(rawURL string) (bucket, object, generation string, err error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", "", "", err
+	}
+	if u.Scheme != "gs" || u.Host == "" {
+		return "", "", "", fmt.Errorf("%q is not a gs:// URL", rawURL)
+	}
+	return u.Host, strings.TrimPrefix(u.Path, "/"), u.Query().Get("generation"), nil
+}
+
+// GCSFileTransfer downloads the objects of gs:// references.
+type GCSFileTransfer struct {
+	// client is the HTTP client for the file transfer
+	client *retryablehttp.Client
+
+	// logger is the logger for the file transfer
+	logger *observability.CoreLogger
+
+	// endpoint is the base URL of the Cloud Storage JSON API
+	endpoint string
+
+	// tokens authorize requests, or are nil for anonymous requests
+	tokens gcpTokenSource
+
+	// tokensErr is why there are no tokens, if the credentials are invalid
+	tokensErr error
+}
+
+// NewGCSFileTransfer creates a new GCSFileTransfer.
+//
+// Invalid credentials are reported when downloading.
+func NewGCSFileTransfer(
+	client *retryablehttp.Client,
+	logger *observability.CoreLogger,
+	config GCSConfig,
+) *GCSFileTransfer {
+	ft := &GCSFileTransfer{
+		client:   client,
+		logger:   logger,
+		endpoint: strings.TrimSuffix(config.EndpointURL, "/"),
+	}
+	if ft.endpoint == "" {
+		ft.endpoint = "https://storage.googleapis.com"
+	}
+	if !config.Anonymous {
+		ft.tokens, ft.tokensErr = newGCPTokenSource(client.StandardClient(), config)
+	}
+	return ft
+}
+
+// Upload is not supported; references are only read.
+func (ft *GCSFileTransfer) Upload(task *Task) error {
+	return errors.New("file transfer: gcs: uploading to references is not supported")
+}
+
+// gcsObject is the metadata of an object in Cloud Storage.
+type gcsObject struct {
+	ETag       string `json:"etag"`
+	Generation string `json:"generation"`
+}
+
+// Download downloads a gs:// URL to the task's path.
+//
+// If the URL has a generation, that generation is downloaded. Otherwise,
+// or if the bucket doesn't keep old generations, the latest generation is
+// downloaded, and it must have the task's ETag if it has one.
+func (ft *GCSFileTransfer) Download(task *Task) error {
+	ft.logger.Debug("gcs file transfer: downloading file", "path", task.Path, "url", task.Url)
+
+	if ft.tokensErr != nil {
+		return fmt.Errorf("file transfer: %v", ft.tokensErr)
+	}
+	ctx := task.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	bucket, object, generation, err := ParseGCSURL(task.Url)
+	if err != nil {
+		return fmt.Errorf("file transfer: gcs: %v", err)
+	}
+	objectURL := ft.endpoint + "/storage/v1/b/" + url.PathEscape(bucket) +
+		"/o/" + url.PathEscape(object)
+
+	var metadata gcsObject
+	err = ft.get(ctx, task.Url, objectURL, generation, "", func(body io.Reader) error {
+		return json.NewDecoder(body).Decode(&metadata)
+	})
+	if generation != "" && errorcode.Of(err) == service.ErrorInfo_NOT_FOUND {
+		// Versioning is disabled on the bucket, so check the latest
+		// generation instead.
+		generation = ""
+		err = ft.get(ctx, task.Url, objectURL, "", "", func(body io.Reader) error {
+			return json.NewDecoder(body).Decode(&metadata)
+		})
+	}
+	if err != nil {
+		return err
+	}
+	if generation == "" && task.ETag != "" && metadata.ETag != task.ETag {
+		return fmt.Errorf(
+			"file transfer: gcs: %s has changed since it was logged (ETag %s, expected %s)",
+			task.Url, metadata.ETag, task.ETag)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(task.Path), os.ModePerm); err != nil {
+		return err
+	}
+	file, err := os.Create(task.Path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	err = ft.get(ctx, task.Url, objectURL, metadata.Generation, "media",
+		func(body io.Reader) error {
+			if _, err := io.Copy(file, body); err != nil {
+				return fmt.Errorf("file transfer: gcs: error downloading %s: %v", task.Url, err)
+			}
+			return nil
+		})
+	if err != nil {
+		return err
+	}
+	return file.Close()
+}
+
+// get makes an authorized GET request for an object's metadata, or its
+// contents if alt is "media", and reads the response body.
+func (ft *GCSFileTransfer) get(
+	ctx context.Context,
+	gsURL string,
+	objectURL string,
+	generation string,
+	alt string,
+	read func(io.Reader) error,
+) error {
+	query := url.Values{}
+	if generation != "" {
+		query.Set("generation", generation)
+	}
+	if alt != "" {
+		query.Set("alt", alt)
+	}
+	if len(query) > 0 {
+		objectURL += "?" + query.Encode()
+	}
+
+	req, err := retryablehttp.NewRequestWithContext(ctx, http.MethodGet, objectURL, nil)
+	if err != nil {
+		return err
+	}
+	if ft.tokens != nil {
+		token, err := ft.tokens.token(ctx)
+		if err != nil {
+			return fmt.Errorf("file transfer: %v", err)
+		}
+		req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	}
+
+	resp, err := ft.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("file transfer: gcs: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return errorcode.Wrap(
+			errorcode.OfStorageStatus(resp.StatusCode),
+			fmt.Errorf("file transfer: gcs: %s returned %s: %s",
+				gsURL, resp.Status, strings.TrimSpace(string(body))))
+	}
+	return read(resp.Body)
+}
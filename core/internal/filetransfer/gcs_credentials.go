@@ -0,0 +1,584 @@
+package filetransfer
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/wandb/wandb/core/internal/errorcode"
+)
+
+const (
+	// gcsReadOnlyScope is the default scope of tokens for gs:// references.
+	gcsReadOnlyScope = "https://www.googleapis.com/auth/devstorage.read_only"
+
+	// gcpCloudPlatformScope is the scope needed to impersonate a service
+	// account.
+	gcpCloudPlatformScope = "https://www.googleapis.com/auth/cloud-platform"
+
+	gcpDefaultTokenURL = "https://oauth2.googleapis.com/token"
+)
+
+// gcpToken is an OAuth2 access token for Google Cloud.
+type gcpToken struct {
+	AccessToken string
+	Expiry      time.Time
+}
+
+// gcpTokenSource gets access tokens for Google Cloud.
+type gcpTokenSource interface {
+	token(ctx context.Context) (gcpToken, error)
+}
+
+// gcpCredentialsFile is a JSON credentials file for Google Cloud.
+//
+// It has the fields of all the supported types of credentials.
+type gcpCredentialsFile struct {
+	Type string `json:"type"`
+
+	// The "service_account" type.
+	ClientEmail  string `json:"client_email"`
+	PrivateKey   string `json:"private_key"`
+	PrivateKeyID string `json:"private_key_id"`
+	TokenURI     string `json:"token_uri"`
+
+	// The "authorized_user" type, made by `gcloud auth application-default
+	// login`.
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+	RefreshToken string `json:"refresh_token"`
+
+	// The "external_account" type, for workload identity federation.
+	Audience         string               `json:"audience"`
+	SubjectTokenType string               `json:"subject_token_type"`
+	TokenURL         string               `json:"token_url"`
+	CredentialSource *gcpCredentialSource `json:"credential_source"`
+
+	// The "impersonated_service_account" type, made by `gcloud auth
+	// application-default login --impersonate-service-account`.
+	SourceCredentials *gcpCredentialsFile `json:"source_credentials"`
+	Delegates         []string            `json:"delegates"`
+
+	// Impersonation of the "external_account" and
+	// "impersonated_service_account" types.
+	ImpersonationURL     string                   `json:"service_account_impersonation_url"`
+	ImpersonationOptions *gcpImpersonationOptions `json:"service_account_impersonation"`
+}
+
+// gcpCredentialSource says where to read the subject token of workload
+// identity federation.
+type gcpCredentialSource struct {
+	File    string            `json:"file"`
+	URL     string            `json:"url"`
+	Headers map[string]string `json:"headers"`
+	Format  struct {
+		Type                  string `json:"type"`
+		SubjectTokenFieldName string `json:"subject_token_field_name"`
+	} `json:"format"`
+
+	// Sources that aren't supported.
+	EnvironmentID string          `json:"environment_id"`
+	Executable    json.RawMessage `json:"executable"`
+}
+
+// gcpImpersonationOptions configures the tokens of an impersonated service
+// account.
+type gcpImpersonationOptions struct {
+	TokenLifetimeSeconds int `json:"token_lifetime_seconds"`
+}
+
+// newGCPTokenSource returns the token source for a GCS configuration.
+//
+// Tokens are read from the credentials file if there is one, and from the
+// metadata server otherwise, which is how workload identity works on GKE.
+// If a service account is to be impersonated, those tokens are exchanged
+// for the service account's.
+func newGCPTokenSource(client *http.Client, config GCSConfig) (gcpTokenSource, error) {
+	scopes := config.Scopes
+	if len(scopes) == 0 {
+		scopes = []string{gcsReadOnlyScope}
+	}
+	sourceScopes := scopes
+	if config.ImpersonateServiceAccount != "" {
+		sourceScopes = []string{gcpCloudPlatformScope}
+	}
+
+	var source gcpTokenSource
+	if config.CredentialsFile == "" {
+		source = &gcpMetadataTokenSource{client: client, scopes: sourceScopes}
+	} else {
+		data, err := os.ReadFile(config.CredentialsFile)
+		if err != nil {
+			return nil, fmt.Errorf("gcs: reading credentials: %v", err)
+		}
+		var file gcpCredentialsFile
+		if err := json.Unmarshal(data, &file); err != nil {
+			return nil, fmt.Errorf("gcs: invalid credentials file %s: %v",
+				config.CredentialsFile, err)
+		}
+		source, err = gcpFileTokenSource(client, &file, config.Audience, sourceScopes)
+		if err != nil {
+			return nil, fmt.Errorf("gcs: credentials file %s: %v",
+				config.CredentialsFile, err)
+		}
+	}
+
+	if config.ImpersonateServiceAccount != "" {
+		source = &gcpImpersonatedTokenSource{
+			client: client,
+			source: source,
+			url:    gcpImpersonationURL(config.ImpersonateServiceAccount),
+			scopes: scopes,
+		}
+	}
+
+	return &gcpCachedTokenSource{source: source}, nil
+}
+
+// gcpFileTokenSource returns the token source for a credentials file.
+//
+// A non-empty audience replaces the audience of external accounts.
+func gcpFileTokenSource(
+	client *http.Client,
+	file *gcpCredentialsFile,
+	audience string,
+	scopes []string,
+) (gcpTokenSource, error) {
+	impersonatedScopes := scopes
+	if file.ImpersonationURL != "" {
+		scopes = []string{gcpCloudPlatformScope}
+	}
+
+	var source gcpTokenSource
+	switch file.Type {
+	case "service_account":
+		key, err := parseRSAPrivateKey(file.PrivateKey)
+		if err != nil {
+			return nil, err
+		}
+		source = &gcpServiceAccountTokenSource{
+			client:   client,
+			email:    file.ClientEmail,
+			key:      key,
+			keyID:    file.PrivateKeyID,
+			tokenURL: valueOr(file.TokenURI, gcpDefaultTokenURL),
+			scopes:   scopes,
+		}
+
+	case "authorized_user":
+		source = &gcpRefreshTokenSource{
+			client:       client,
+			clientID:     file.ClientID,
+			clientSecret: file.ClientSecret,
+			refreshToken: file.RefreshToken,
+			tokenURL:     valueOr(file.TokenURI, gcpDefaultTokenURL),
+		}
+
+	case "external_account":
+		if file.CredentialSource == nil {
+			return nil, errors.New("external account has no credential_source")
+		}
+		if file.CredentialSource.EnvironmentID != "" ||
+			len(file.CredentialSource.Executable) > 0 {
+			return nil, errors.New(
+				"only file and URL credential sources are supported")
+		}
+		source = &gcpExternalAccountTokenSource{
+			client:           client,
+			audience:         valueOr(audience, file.Audience),
+			subjectTokenType: file.SubjectTokenType,
+			tokenURL:         valueOr(file.TokenURL, "https://sts.googleapis.com/v1/token"),
+			credentialSource: file.CredentialSource,
+			scopes:           scopes,
+		}
+
+	case "impersonated_service_account":
+		if file.SourceCredentials == nil {
+			return nil, errors.New("impersonated account has no source_credentials")
+		}
+		var err error
+		source, err = gcpFileTokenSource(client, file.SourceCredentials, audience, scopes)
+		if err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported credentials type %q", file.Type)
+	}
+
+	if file.ImpersonationURL != "" {
+		lifetime := 0
+		if file.ImpersonationOptions != nil {
+			lifetime = file.ImpersonationOptions.TokenLifetimeSeconds
+		}
+		source = &gcpImpersonatedTokenSource{
+			client:          client,
+			source:          source,
+			url:             file.ImpersonationURL,
+			scopes:          impersonatedScopes,
+			delegates:       file.Delegates,
+			lifetimeSeconds: lifetime,
+		}
+	}
+	return source, nil
+}
+
+func valueOr(value, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+	return value
+}
+
+// gcpImpersonationURL returns the URL that makes tokens for a service
+// account.
+func gcpImpersonationURL(email string) string {
+	return "https://iamcredentials.googleapis.com/v1/projects/-/serviceAccounts/" +
+		url.PathEscape(email) + ":generateAccessToken"
+}
+
+// gcpCachedTokenSource reuses a token until shortly before it expires.
+type gcpCachedTokenSource struct {
+	mu     sync.Mutex
+	source gcpTokenSource
+	cached gcpToken
+}
+
+func (s *gcpCachedTokenSource) token(ctx context.Context) (gcpToken, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.cached.AccessToken != "" && time.Until(s.cached.Expiry) > time.Minute {
+		return s.cached, nil
+	}
+
+	token, err := s.source.token(ctx)
+	if err != nil {
+		return gcpToken{}, err
+	}
+	s.cached = token
+	return token, nil
+}
+
+// gcpMetadataTokenSource gets the tokens of the instance's service
+// account from the metadata server.
+//
+// On GKE with workload identity, these are the tokens of the service
+// account bound to the pod's Kubernetes service account.
+type gcpMetadataTokenSource struct {
+	client *http.Client
+	scopes []string
+}
+
+func (s *gcpMetadataTokenSource) token(ctx context.Context) (gcpToken, error) {
+	host := valueOr(os.Getenv("GCE_METADATA_HOST"), "metadata.google.internal")
+	tokenURL := "http://" + host +
+		"/computeMetadata/v1/instance/service-accounts/default/token?scopes=" +
+		url.QueryEscape(strings.Join(s.scopes, ","))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, tokenURL, nil)
+	if err != nil {
+		return gcpToken{}, err
+	}
+	req.Header.Set("Metadata-Flavor", "Google")
+
+	token, err := doGCPTokenRequest(s.client, req)
+	if err != nil {
+		return gcpToken{}, fmt.Errorf(
+			"gcs: no credentials file and no token from the metadata server: %v", err)
+	}
+	return token, nil
+}
+
+// gcpServiceAccountTokenSource gets tokens with a service account key.
+type gcpServiceAccountTokenSource struct {
+	client   *http.Client
+	email    string
+	key      *rsa.PrivateKey
+	keyID    string
+	tokenURL string
+	scopes   []string
+}
+
+func (s *gcpServiceAccountTokenSource) token(ctx context.Context) (gcpToken, error) {
+	now := time.Now()
+	assertion, err := signJWT(s.key,
+		map[string]any{"alg": "RS256", "typ": "JWT", "kid": s.keyID},
+		map[string]any{
+			"iss":   s.email,
+			"scope": strings.Join(s.scopes, " "),
+			"aud":   s.tokenURL,
+			"iat":   now.Unix(),
+			"exp":   now.Add(time.Hour).Unix(),
+		})
+	if err != nil {
+		return gcpToken{}, err
+	}
+
+	return postGCPTokenForm(ctx, s.client, s.tokenURL, url.Values{
+		"grant_type": {"urn:ietf:params:oauth:grant-type:jwt-bearer"},
+		"assertion":  {assertion},
+	})
+}
+
+// gcpRefreshTokenSource gets tokens with a user's refresh token.
+type gcpRefreshTokenSource struct {
+	client       *http.Client
+	clientID     string
+	clientSecret string
+	refreshToken string
+	tokenURL     string
+}
+
+func (s *gcpRefreshTokenSource) token(ctx context.Context) (gcpToken, error) {
+	return postGCPTokenForm(ctx, s.client, s.tokenURL, url.Values{
+		"grant_type":    {"refresh_token"},
+		"client_id":     {s.clientID},
+		"client_secret": {s.clientSecret},
+		"refresh_token": {s.refreshToken},
+	})
+}
+
+// gcpExternalAccountTokenSource gets tokens by workload identity
+// federation, exchanging a token from another identity provider with
+// Google's Security Token Service.
+type gcpExternalAccountTokenSource struct {
+	client           *http.Client
+	audience         string
+	subjectTokenType string
+	tokenURL         string
+	credentialSource *gcpCredentialSource
+	scopes           []string
+}
+
+func (s *gcpExternalAccountTokenSource) token(ctx context.Context) (gcpToken, error) {
+	subjectToken, err := s.subjectToken(ctx)
+	if err != nil {
+		return gcpToken{}, fmt.Errorf("gcs: reading subject token: %v", err)
+	}
+
+	return postGCPTokenForm(ctx, s.client, s.tokenURL, url.Values{
+		"grant_type":           {"urn:ietf:params:oauth:grant-type:token-exchange"},
+		"audience":             {s.audience},
+		"scope":                {strings.Join(s.scopes, " ")},
+		"requested_token_type": {"urn:ietf:params:oauth:token-type:access_token"},
+		"subject_token":        {subjectToken},
+		"subject_token_type":   {s.subjectTokenType},
+	})
+}
+
+// subjectToken reads the token to exchange from the credential source.
+func (s *gcpExternalAccountTokenSource) subjectToken(ctx context.Context) (string, error) {
+	source := s.credentialSource
+
+	var data []byte
+	if source.File != "" {
+		var err error
+		if data, err = os.ReadFile(source.File); err != nil {
+			return "", err
+		}
+	} else {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, source.URL, nil)
+		if err != nil {
+			return "", err
+		}
+		for name, value := range source.Headers {
+			req.Header.Set(name, value)
+		}
+		resp, err := s.client.Do(req)
+		if err != nil {
+			return "", err
+		}
+		defer resp.Body.Close()
+		if data, err = io.ReadAll(resp.Body); err != nil {
+			return "", err
+		}
+		if resp.StatusCode < 200 || resp.StatusCode > 299 {
+			return "", fmt.Errorf("%s returned %s", source.URL, resp.Status)
+		}
+	}
+
+	if source.Format.Type != "json" {
+		return strings.TrimSpace(string(data)), nil
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return "", err
+	}
+	token, ok := fields[source.Format.SubjectTokenFieldName].(string)
+	if !ok {
+		return "", fmt.Errorf("no string field %q",
+			source.Format.SubjectTokenFieldName)
+	}
+	return token, nil
+}
+
+// gcpImpersonatedTokenSource exchanges tokens for those of a service
+// account, using the IAM Credentials API.
+type gcpImpersonatedTokenSource struct {
+	client          *http.Client
+	source          gcpTokenSource
+	url             string
+	scopes          []string
+	delegates       []string
+	lifetimeSeconds int
+}
+
+func (s *gcpImpersonatedTokenSource) token(ctx context.Context) (gcpToken, error) {
+	sourceToken, err := s.source.token(ctx)
+	if err != nil {
+		return gcpToken{}, err
+	}
+
+	request := map[string]any{
+		"scope":    s.scopes,
+		"lifetime": fmt.Sprintf("%ds", valueOrInt(s.lifetimeSeconds, 3600)),
+	}
+	if len(s.delegates) > 0 {
+		request["delegates"] = s.delegates
+	}
+	body, err := json.Marshal(request)
+	if err != nil {
+		return gcpToken{}, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return gcpToken{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+sourceToken.AccessToken)
+
+	var response struct {
+		AccessToken string    `json:"accessToken"`
+		ExpireTime  time.Time `json:"expireTime"`
+	}
+	if err := doGCPJSONRequest(s.client, req, &response); err != nil {
+		return gcpToken{}, fmt.Errorf("gcs: impersonating service account: %v", err)
+	}
+	return gcpToken{AccessToken: response.AccessToken, Expiry: response.ExpireTime}, nil
+}
+
+func valueOrInt(value, fallback int) int {
+	if value == 0 {
+		return fallback
+	}
+	return value
+}
+
+// postGCPTokenForm requests a token from an OAuth2 token endpoint.
+func postGCPTokenForm(
+	ctx context.Context,
+	client *http.Client,
+	tokenURL string,
+	form url.Values,
+) (gcpToken, error) {
+	req, err := http.NewRequestWithContext(ctx,
+		http.MethodPost, tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return gcpToken{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	token, err := doGCPTokenRequest(client, req)
+	if err != nil {
+		return gcpToken{}, fmt.Errorf("gcs: getting token: %v", err)
+	}
+	return token, nil
+}
+
+// doGCPTokenRequest makes a request for an OAuth2 token.
+func doGCPTokenRequest(client *http.Client, req *http.Request) (gcpToken, error) {
+	var response struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+	if err := doGCPJSONRequest(client, req, &response); err != nil {
+		return gcpToken{}, err
+	}
+	if response.AccessToken == "" {
+		return gcpToken{}, errors.New("response has no access token")
+	}
+	return gcpToken{
+		AccessToken: response.AccessToken,
+		Expiry:      time.Now().Add(time.Duration(response.ExpiresIn) * time.Second),
+	}, nil
+}
+
+// doGCPJSONRequest makes a request and decodes its JSON response.
+func doGCPJSONRequest(client *http.Client, req *http.Request, response any) error {
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return errorcode.Wrap(
+			errorcode.OfHTTPStatus(resp.StatusCode),
+			fmt.Errorf("%s returned %s: %s",
+				req.URL.Redacted(), resp.Status, strings.TrimSpace(string(body))))
+	}
+	return json.Unmarshal(body, response)
+}
+
+// parseRSAPrivateKey parses a PEM-encoded RSA key.
+func parseRSAPrivateKey(data string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(data))
+	if block == nil {
+		return nil, errors.New("private key is not PEM-encoded")
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key: %v", err)
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("private key is not an RSA key")
+	}
+	return key, nil
+}
+
+// signJWT returns a JWT signed with RS256.
+func signJWT(key *rsa.PrivateKey, header, claims map[string]any) (string, error) {
+	var parts []string
+	for _, part := range []map[string]any{header, claims} {
+		data, err := json.Marshal(part)
+		if err != nil {
+			return "", err
+		}
+		parts = append(parts, base64.RawURLEncoding.EncodeToString(data))
+	}
+	signed := strings.Join(parts, ".")
+
+	digest := sha256.Sum256([]byte(signed))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", err
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
@@ -0,0 +1,244 @@
+package filetransfer_test
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/filetransfer"
+	"github.com/wandb/wandb/core/pkg/observability"
+)
+
+// fakeGCS serves one object and the token endpoints of Google Cloud.
+type fakeGCS struct {
+	t      *testing.T
+	server *httptest.Server
+
+	// generations are the generations of the object that exist.
+	generations map[string]string
+
+	// token is the access token storage requests must have.
+	token string
+
+	// tokenForms are the forms posted to the token endpoints.
+	tokenForms []map[string]string
+}
+
+func newFakeGCS(t *testing.T, token string) *fakeGCS {
+	t.Helper()
+	f := &fakeGCS{
+		t:           t,
+		generations: map[string]string{"2": "contents"},
+		token:       token,
+	}
+	f.server = httptest.NewServer(http.HandlerFunc(f.serve))
+	t.Cleanup(f.server.Close)
+	return f
+}
+
+func (f *fakeGCS) serve(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/sts", "/token":
+		require.NoError(f.t, r.ParseForm())
+		form := map[string]string{}
+		for key := range r.PostForm {
+			form[key] = r.PostForm.Get(key)
+		}
+		f.tokenForms = append(f.tokenForms, form)
+		_, _ = w.Write([]byte(`{"access_token": "sts-token", "expires_in": 3600}`))
+		return
+
+	case "/impersonate":
+		assert.Equal(f.t, "Bearer sts-token", r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(
+			`{"accessToken": "sa-token", "expireTime": "2099-01-01T00:00:00Z"}`))
+		return
+	}
+
+	if r.URL.EscapedPath() != "/storage/v1/b/bucket/o/dir%2Ffile.txt" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	if f.token != "" {
+		assert.Equal(f.t, "Bearer "+f.token, r.Header.Get("Authorization"))
+	}
+
+	generation := r.URL.Query().Get("generation")
+	if generation == "" {
+		generation = "2"
+	}
+	contents, ok := f.generations[generation]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	if r.URL.Query().Get("alt") == "media" {
+		_, _ = w.Write([]byte(contents))
+	} else {
+		_, _ = w.Write([]byte(`{"etag": "etag-` + generation +
+			`", "generation": "` + generation + `"}`))
+	}
+}
+
+func newGCSFileTransfer(config filetransfer.GCSConfig) *filetransfer.GCSFileTransfer {
+	client := retryablehttp.NewClient()
+	client.RetryMax = 0
+	return filetransfer.NewGCSFileTransfer(
+		client, observability.NewNoOpLogger(), config)
+}
+
+func writeJSON(t *testing.T, path string, value any) {
+	t.Helper()
+	data, err := json.Marshal(value)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, data, 0600))
+}
+
+func TestGCSFileTransfer_Download_WorkloadIdentityFederation(t *testing.T) {
+	gcs := newFakeGCS(t, "sa-token")
+	dir := t.TempDir()
+	require.NoError(t,
+		os.WriteFile(filepath.Join(dir, "subject-token"), []byte("k8s-token\n"), 0600))
+	writeJSON(t, filepath.Join(dir, "credentials.json"), map[string]any{
+		"type":                              "external_account",
+		"audience":                          "//iam.googleapis.com/file-audience",
+		"subject_token_type":                "urn:ietf:params:oauth:token-type:jwt",
+		"token_url":                         gcs.server.URL + "/sts",
+		"service_account_impersonation_url": gcs.server.URL + "/impersonate",
+		"credential_source": map[string]any{
+			"file": filepath.Join(dir, "subject-token"),
+		},
+	})
+	ft := newGCSFileTransfer(filetransfer.GCSConfig{
+		EndpointURL:     gcs.server.URL,
+		CredentialsFile: filepath.Join(dir, "credentials.json"),
+		Audience:        "//iam.googleapis.com/setting-audience",
+	})
+	task := &filetransfer.Task{
+		Path: filepath.Join(dir, "out", "file.txt"),
+		Url:  "gs://bucket/dir/file.txt?generation=2",
+		ETag: "etag-2",
+	}
+
+	err := ft.Download(task)
+
+	require.NoError(t, err)
+	content, err := os.ReadFile(task.Path)
+	require.NoError(t, err)
+	assert.Equal(t, "contents", string(content))
+	require.Len(t, gcs.tokenForms, 1)
+	assert.Equal(t, "k8s-token", gcs.tokenForms[0]["subject_token"])
+	assert.Equal(t,
+		"//iam.googleapis.com/setting-audience",
+		gcs.tokenForms[0]["audience"])
+	assert.Equal(t,
+		"https://www.googleapis.com/auth/cloud-platform",
+		gcs.tokenForms[0]["scope"])
+}
+
+func TestGCSFileTransfer_Download_ServiceAccountKey(t *testing.T) {
+	gcs := newFakeGCS(t, "sts-token")
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	keyPEM := pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(key),
+	})
+	dir := t.TempDir()
+	writeJSON(t, filepath.Join(dir, "key.json"), map[string]any{
+		"type":         "service_account",
+		"client_email": "sa@project.iam.gserviceaccount.com",
+		"private_key":  string(keyPEM),
+		"token_uri":    gcs.server.URL + "/token",
+	})
+	ft := newGCSFileTransfer(filetransfer.GCSConfig{
+		EndpointURL:     gcs.server.URL,
+		CredentialsFile: filepath.Join(dir, "key.json"),
+		Scopes:          []string{"scope-a", "scope-b"},
+	})
+	task := &filetransfer.Task{
+		Path: filepath.Join(dir, "file.txt"),
+		Url:  "gs://bucket/dir/file.txt",
+	}
+
+	require.NoError(t, ft.Download(task))
+
+	require.Len(t, gcs.tokenForms, 1)
+	parts := strings.Split(gcs.tokenForms[0]["assertion"], ".")
+	require.Len(t, parts, 3)
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	require.NoError(t, err)
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	assert.NoError(t,
+		rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, digest[:], signature))
+	claimsJSON, err := base64.RawURLEncoding.DecodeString(parts[1])
+	require.NoError(t, err)
+	var claims map[string]any
+	require.NoError(t, json.Unmarshal(claimsJSON, &claims))
+	assert.Equal(t, "sa@project.iam.gserviceaccount.com", claims["iss"])
+	assert.Equal(t, "scope-a scope-b", claims["scope"])
+}
+
+func TestGCSFileTransfer_Download_GenerationNotKept(t *testing.T) {
+	gcs := newFakeGCS(t, "")
+	ft := newGCSFileTransfer(filetransfer.GCSConfig{
+		EndpointURL: gcs.server.URL,
+		Anonymous:   true,
+	})
+	task := &filetransfer.Task{
+		Path: filepath.Join(t.TempDir(), "file.txt"),
+		Url:  "gs://bucket/dir/file.txt?generation=1",
+		ETag: "etag-1",
+	}
+
+	err := ft.Download(task)
+
+	assert.ErrorContains(t, err, "has changed since it was logged")
+	assert.NoFileExists(t, task.Path)
+}
+
+func TestGCSFileTransfer_Download_InvalidCredentials(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "credentials.json")
+	writeJSON(t, path, map[string]any{"type": "external_account"})
+	ft := newGCSFileTransfer(filetransfer.GCSConfig{CredentialsFile: path})
+
+	err := ft.Download(&filetransfer.Task{
+		Path: filepath.Join(t.TempDir(), "file.txt"),
+		Url:  "gs://bucket/dir/file.txt",
+	})
+
+	assert.ErrorContains(t, err, "external account has no credential_source")
+}
+
+func TestGCSConfigFromEnv_Emulator(t *testing.T) {
+	t.Setenv("STORAGE_EMULATOR_HOST", "localhost:4443")
+
+	config := filetransfer.GCSConfigFromEnv()
+
+	assert.Equal(t, "http://localhost:4443", config.EndpointURL)
+	assert.True(t, config.Anonymous)
+}
+
+func TestParseGCSURL(t *testing.T) {
+	bucket, object, generation, err := filetransfer.ParseGCSURL(
+		"gs://bucket/dir/file.txt?generation=123")
+
+	require.NoError(t, err)
+	assert.Equal(t, "bucket", bucket)
+	assert.Equal(t, "dir/file.txt", object)
+	assert.Equal(t, "123", generation)
+}
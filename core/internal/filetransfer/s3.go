@@ -0,0 +1,274 @@
+package filetransfer
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/wandb/wandb/core/internal/errorcode"
+	"github.com/wandb/wandb/core/pkg/observability"
+)
+
+// S3Config configures access to the buckets of s3:// references.
+type S3Config struct {
+	// EndpointURL is the S3-compatible service to use, such as a MinIO or
+	// Ceph server, or empty for AWS.
+	EndpointURL string
+
+	// PathStyle is whether buckets are addressed in the URL path, as in
+	// "https://host/bucket/key", rather than in the host name.
+	PathStyle bool
+
+	// InsecureTLS is whether to skip verifying the endpoint's certificate.
+	//
+	// It's applied to the HTTP client by the caller.
+	InsecureTLS bool
+
+	// Region is the region requests are signed for.
+	Region string
+
+	// Credentials sign requests.
+	Credentials S3Credentials
+}
+
+// S3Credentials are AWS access keys.
+//
+// Requests are not signed if the access key ID is empty.
+type S3Credentials struct {
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+}
+
+// S3ConfigFromEnv returns the configuration in the standard AWS
+// environment variables and shared credentials file.
+//
+// The endpoint is read from AWS_S3_ENDPOINT_URL like in the Python SDK's
+// S3 handler, or from AWS_ENDPOINT_URL_S3 or AWS_ENDPOINT_URL. Instance
+// roles and SSO are not supported; their users must export keys.
+func S3ConfigFromEnv() S3Config {
+	config := S3Config{
+		EndpointURL: firstEnv("AWS_S3_ENDPOINT_URL", "AWS_ENDPOINT_URL_S3", "AWS_ENDPOINT_URL"),
+		Region:      firstEnv("AWS_REGION", "AWS_DEFAULT_REGION"),
+		Credentials: S3Credentials{
+			AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+			SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+			SessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+		},
+	}
+	if config.Region == "" {
+		config.Region = "us-east-1"
+	}
+
+	if config.Credentials.AccessKeyID == "" {
+		path := os.Getenv("AWS_SHARED_CREDENTIALS_FILE")
+		if path == "" {
+			if home, err := os.UserHomeDir(); err == nil {
+				path = filepath.Join(home, ".aws", "credentials")
+			}
+		}
+		profile := firstEnv("AWS_PROFILE", "AWS_DEFAULT_PROFILE")
+		if profile == "" {
+			profile = "default"
+		}
+		if credentials, err := readSharedCredentials(path, profile); err == nil {
+			config.Credentials = credentials
+		}
+	}
+
+	return config
+}
+
+func firstEnv(names ...string) string {
+	for _, name := range names {
+		if value := os.Getenv(name); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// readSharedCredentials reads a profile's keys from an AWS credentials
+// file.
+func readSharedCredentials(path string, profile string) (S3Credentials, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return S3Credentials{}, err
+	}
+	defer file.Close()
+
+	var credentials S3Credentials
+	inProfile := false
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			inProfile = strings.TrimSpace(line[1:len(line)-1]) == profile
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !inProfile || !ok {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		switch strings.TrimSpace(key) {
+		case "aws_access_key_id":
+			credentials.AccessKeyID = value
+		case "aws_secret_access_key":
+			credentials.SecretAccessKey = value
+		case "aws_session_token":
+			credentials.SessionToken = value
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return S3Credentials{}, err
+	}
+	if credentials.AccessKeyID == "" {
+		return S3Credentials{}, fmt.Errorf("no profile %q in %s", profile, path)
+	}
+	return credentials, nil
+}
+
+// ObjectURL returns the HTTP URL of an object in a bucket.
+//
+// The version is the object's version ID, or empty for its latest
+// version.
+func (c S3Config) ObjectURL(bucket, key, version string) (*url.URL, error) {
+	endpoint := c.EndpointURL
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://s3.%s.amazonaws.com", c.Region)
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid S3 endpoint %q: %v", endpoint, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+		return nil, fmt.Errorf("invalid S3 endpoint %q: not an HTTP URL", endpoint)
+	}
+
+	base := strings.TrimSuffix(u.Path, "/")
+	if c.PathStyle {
+		u.Path = base + "/" + bucket + "/" + key
+		u.RawPath = s3Escape(base, false) + "/" + s3Escape(bucket, true) + "/" + s3Escape(key, false)
+	} else {
+		u.Host = bucket + "." + u.Host
+		u.Path = base + "/" + key
+		u.RawPath = s3Escape(base, false) + "/" + s3Escape(key, false)
+	}
+
+	u.RawQuery = ""
+	if version != "" {
+		u.RawQuery = "versionId=" + s3Escape(version, true)
+	}
+	return u, nil
+}
+
+// ParseS3URL splits an s3://bucket/key URL into the bucket, key and the
+// version ID in its "versionId" query parameter, if any.
+func ParseS3URL(rawURL string) (bucket, key, version string, err error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", "", "", err
+	}
+	if u.Scheme != "s3" || u.Host == "" {
+		return "", "", "", fmt.Errorf("%q is not an s3:// URL", rawURL)
+	}
+	return u.Host, strings.TrimPrefix(u.Path, "/"), u.Query().Get("versionId"), nil
+}
+
+// S3FileTransfer downloads the objects of s3:// references.
+type S3FileTransfer struct {
+	// client is the HTTP client for the file transfer
+	client *retryablehttp.Client
+
+	// logger is the logger for the file transfer
+	logger *observability.CoreLogger
+
+	// config says where the buckets are and how to sign requests
+	config S3Config
+}
+
+// NewS3FileTransfer creates a new S3FileTransfer.
+func NewS3FileTransfer(
+	client *retryablehttp.Client,
+	logger *observability.CoreLogger,
+	config S3Config,
+) *S3FileTransfer {
+	return &S3FileTransfer{
+		client: client,
+		logger: logger,
+		config: config,
+	}
+}
+
+// Upload is not supported; references are only read.
+func (ft *S3FileTransfer) Upload(task *Task) error {
+	return errors.New("file transfer: s3: uploading to references is not supported")
+}
+
+// Download downloads an s3:// URL to the task's path.
+//
+// If the task has an ETag, the object must have it, so that references
+// aren't silently replaced by newer versions of their objects.
+func (ft *S3FileTransfer) Download(task *Task) error {
+	ft.logger.Debug("s3 file transfer: downloading file", "path", task.Path, "url", task.Url)
+
+	bucket, key, version, err := ParseS3URL(task.Url)
+	if err != nil {
+		return fmt.Errorf("file transfer: s3: %v", err)
+	}
+	objectURL, err := ft.config.ObjectURL(bucket, key, version)
+	if err != nil {
+		return fmt.Errorf("file transfer: s3: %v", err)
+	}
+
+	req, err := retryablehttp.NewRequest(http.MethodGet, objectURL.String(), nil)
+	if err != nil {
+		return err
+	}
+	if task.Context != nil {
+		req = req.WithContext(task.Context)
+	}
+	signS3Request(req.Request, ft.config.Credentials, ft.config.Region, time.Now())
+
+	resp, err := ft.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("file transfer: s3: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return errorcode.Wrap(
+			errorcode.OfStorageStatus(resp.StatusCode),
+			fmt.Errorf("file transfer: s3: %s returned %s: %s",
+				task.Url, resp.Status, strings.TrimSpace(string(body))))
+	}
+	if etag := strings.Trim(resp.Header.Get("ETag"), `"`); task.ETag != "" && etag != task.ETag {
+		return fmt.Errorf(
+			"file transfer: s3: %s has changed since it was logged (ETag %s, expected %s)",
+			task.Url, etag, task.ETag)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(task.Path), os.ModePerm); err != nil {
+		return err
+	}
+	file, err := os.Create(task.Path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if _, err := io.Copy(file, resp.Body); err != nil {
+		return fmt.Errorf("file transfer: s3: error downloading %s: %v", task.Url, err)
+	}
+	return file.Close()
+}
@@ -0,0 +1,132 @@
+package filetransfer_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/errorcode"
+	"github.com/wandb/wandb/core/internal/filetransfer"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+func TestS3Config_ObjectURL(t *testing.T) {
+	pathStyle := filetransfer.S3Config{
+		EndpointURL: "http://minio.local:9000/",
+		PathStyle:   true,
+	}
+	virtualHost := filetransfer.S3Config{Region: "eu-west-1"}
+
+	u1, err1 := pathStyle.ObjectURL("bucket", "dir/a b+c.txt", "")
+	u2, err2 := virtualHost.ObjectURL("bucket", "dir/file.txt", "v/1")
+
+	require.NoError(t, err1)
+	require.NoError(t, err2)
+	assert.Equal(t, "http://minio.local:9000/bucket/dir/a%20b%2Bc.txt", u1.String())
+	assert.Equal(t,
+		"https://bucket.s3.eu-west-1.amazonaws.com/dir/file.txt?versionId=v%2F1",
+		u2.String())
+}
+
+func TestS3Config_ObjectURL_InvalidEndpoint(t *testing.T) {
+	config := filetransfer.S3Config{EndpointURL: "minio.local:9000"}
+
+	_, err := config.ObjectURL("bucket", "key", "")
+
+	assert.ErrorContains(t, err, "invalid S3 endpoint")
+}
+
+func TestParseS3URL(t *testing.T) {
+	bucket, key, version, err := filetransfer.ParseS3URL(
+		"s3://bucket/dir/file.txt?versionId=abc")
+
+	require.NoError(t, err)
+	assert.Equal(t, "bucket", bucket)
+	assert.Equal(t, "dir/file.txt", key)
+	assert.Equal(t, "abc", version)
+}
+
+// newS3Server serves one object from a path-style bucket.
+func newS3Server(t *testing.T, etag string) filetransfer.S3Config {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/bucket/dir/file.txt" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			assert.True(t,
+				strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 "))
+			w.Header().Set("ETag", `"`+etag+`"`)
+			_, _ = w.Write([]byte("contents"))
+		}))
+	t.Cleanup(server.Close)
+
+	return filetransfer.S3Config{
+		EndpointURL: server.URL,
+		PathStyle:   true,
+		Region:      "us-east-1",
+		Credentials: filetransfer.S3Credentials{
+			AccessKeyID:     "key",
+			SecretAccessKey: "secret",
+		},
+	}
+}
+
+func newS3FileTransfer(config filetransfer.S3Config) *filetransfer.S3FileTransfer {
+	client := retryablehttp.NewClient()
+	client.RetryMax = 0
+	return filetransfer.NewS3FileTransfer(
+		client, observability.NewNoOpLogger(), config)
+}
+
+func TestS3FileTransfer_Download(t *testing.T) {
+	ft := newS3FileTransfer(newS3Server(t, "etag"))
+	task := &filetransfer.Task{
+		Path:    filepath.Join(t.TempDir(), "dir", "file.txt"),
+		Url:     "s3://bucket/dir/file.txt",
+		ETag:    "etag",
+		Context: context.Background(),
+	}
+
+	err := ft.Download(task)
+
+	require.NoError(t, err)
+	content, err := os.ReadFile(task.Path)
+	require.NoError(t, err)
+	assert.Equal(t, "contents", string(content))
+}
+
+func TestS3FileTransfer_Download_Changed(t *testing.T) {
+	ft := newS3FileTransfer(newS3Server(t, "new-etag"))
+	task := &filetransfer.Task{
+		Path: filepath.Join(t.TempDir(), "file.txt"),
+		Url:  "s3://bucket/dir/file.txt",
+		ETag: "etag",
+	}
+
+	err := ft.Download(task)
+
+	assert.ErrorContains(t, err, "has changed since it was logged")
+	assert.NoFileExists(t, task.Path)
+}
+
+func TestS3FileTransfer_Download_NotFound(t *testing.T) {
+	ft := newS3FileTransfer(newS3Server(t, "etag"))
+	task := &filetransfer.Task{
+		Path: filepath.Join(t.TempDir(), "file.txt"),
+		Url:  "s3://bucket/missing.txt",
+	}
+
+	err := ft.Download(task)
+
+	assert.Equal(t, service.ErrorInfo_NOT_FOUND, errorcode.Of(err))
+}
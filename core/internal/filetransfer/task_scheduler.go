@@ -0,0 +1,145 @@
+package filetransfer
+
+import (
+	"os"
+	"sync"
+)
+
+// TaskPriority is how soon a task runs relative to other waiting tasks.
+type TaskPriority int
+
+const (
+	// PriorityLow is for large files, such as model checkpoints.
+	PriorityLow TaskPriority = iota
+
+	// PriorityNormal is for other files, such as media.
+	PriorityNormal
+
+	// PriorityHigh is for W&B files like the config, summary and console
+	// logs, which is what the UI shows of a run's progress.
+	PriorityHigh
+
+	numPriorities
+)
+
+const (
+	// largeFileSize is the size from which a file is low priority.
+	largeFileSize = 64 << 20 // 64 MiB
+
+	// reservedSlots is how many concurrent transfers large files can't use,
+	// so that small files are not stuck behind them.
+	reservedSlots = 8
+)
+
+// IsLargeFile returns whether a file of the given size is low priority
+// and only uploaded during the upload window.
+func IsLargeFile(size int64) bool {
+	return size >= largeFileSize
+}
+
+// PriorityOf returns the priority of a task.
+func PriorityOf(task *Task) TaskPriority {
+	size := task.Size
+	if size == 0 && task.Type == UploadTask {
+		if info, err := os.Stat(task.Path); err == nil {
+			size = info.Size() - task.Offset
+		}
+	}
+
+	switch {
+	case IsLargeFile(size):
+		return PriorityLow
+	case task.FileKind == RunFileKindWandb:
+		return PriorityHigh
+	default:
+		return PriorityNormal
+	}
+}
+
+// taskScheduler limits the number of concurrent transfers and decides
+// which waiting transfer runs next.
+//
+// Waiting tasks run in order of priority, and in the order they were added
+// within a priority. Low priority tasks may only use some of the slots.
+type taskScheduler struct {
+	mu sync.Mutex
+
+	// limit is the maximum number of concurrent tasks.
+	limit int
+
+	// lowLimit is the maximum number of concurrent low priority tasks.
+	lowLimit int
+
+	// running and runningLow are the numbers of running tasks.
+	running, runningLow int
+
+	// waiting are the channels of waiting tasks, by priority.
+	waiting [numPriorities][]chan struct{}
+}
+
+func newTaskScheduler(limit int) *taskScheduler {
+	return &taskScheduler{
+		limit:    limit,
+		lowLimit: max(1, limit-reservedSlots),
+	}
+}
+
+// Acquire blocks until a task with the priority may run.
+//
+// The returned function must be called when the task is done.
+func (s *taskScheduler) Acquire(priority TaskPriority) (release func()) {
+	ready := make(chan struct{})
+
+	s.mu.Lock()
+	s.waiting[priority] = append(s.waiting[priority], ready)
+	s.dispatch()
+	s.mu.Unlock()
+
+	<-ready
+
+	return func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		s.running--
+		if priority == PriorityLow {
+			s.runningLow--
+		}
+		s.dispatch()
+	}
+}
+
+// dispatch starts as many waiting tasks as possible.
+//
+// It must be called while mu is held.
+func (s *taskScheduler) dispatch() {
+	for priority := numPriorities - 1; priority >= PriorityLow; priority-- {
+		for len(s.waiting[priority]) > 0 && s.running < s.limit {
+			if priority == PriorityLow && s.runningLow >= s.lowLimit {
+				break
+			}
+
+			ready := s.waiting[priority][0]
+			s.waiting[priority] = s.waiting[priority][1:]
+
+			s.running++
+			if priority == PriorityLow {
+				s.runningLow++
+			}
+			close(ready)
+		}
+	}
+}
+
+// setLimit changes the maximum number of concurrent tasks.
+//
+// If more tasks are running than the new limit allows, no more start
+// until enough are done.
+func (s *taskScheduler) setLimit(limit int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.limit = limit
+	s.lowLimit = max(1, limit-reservedSlots)
+	s.dispatch()
+}
@@ -0,0 +1,76 @@
+package filetransfer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// acquireAsync acquires a slot in the background, sending the release
+// function on the returned channel once it's acquired.
+func acquireAsync(s *taskScheduler, priority TaskPriority) <-chan func() {
+	acquired := make(chan func(), 1)
+	go func() { acquired <- s.Acquire(priority) }()
+	return acquired
+}
+
+// waitForWaiting blocks until the scheduler has n waiting tasks.
+func waitForWaiting(t *testing.T, s *taskScheduler, n int) {
+	t.Helper()
+	require.Eventually(t, func() bool {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		total := 0
+		for _, waiting := range s.waiting {
+			total += len(waiting)
+		}
+		return total == n
+	}, time.Second, time.Millisecond)
+}
+
+func TestTaskScheduler_HighPriorityFirst(t *testing.T) {
+	s := newTaskScheduler(1)
+	release := s.Acquire(PriorityNormal)
+
+	low := acquireAsync(s, PriorityLow)
+	waitForWaiting(t, s, 1)
+	high := acquireAsync(s, PriorityHigh)
+	waitForWaiting(t, s, 2)
+	release()
+
+	releaseHigh := <-high
+	assert.Empty(t, low)
+	releaseHigh()
+	(<-low)()
+}
+
+func TestTaskScheduler_ReservesSlotsFromLargeFiles(t *testing.T) {
+	s := newTaskScheduler(reservedSlots + 1)
+	releaseLow := s.Acquire(PriorityLow)
+
+	low := acquireAsync(s, PriorityLow)
+	waitForWaiting(t, s, 1)
+	releaseNormal := s.Acquire(PriorityNormal)
+
+	assert.Empty(t, low)
+	releaseNormal()
+	releaseLow()
+	(<-low)()
+}
+
+func TestPriorityOf(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, os.WriteFile(path, []byte("data"), 0644))
+
+	assert.Equal(t, PriorityHigh,
+		PriorityOf(&Task{FileKind: RunFileKindWandb, Path: path}))
+	assert.Equal(t, PriorityNormal,
+		PriorityOf(&Task{FileKind: RunFileKindMedia, Path: path}))
+	assert.Equal(t, PriorityLow,
+		PriorityOf(&Task{FileKind: RunFileKindWandb, Size: largeFileSize}))
+}
@@ -32,6 +32,10 @@ type Task struct {
 	// Headers to send on the upload
 	Headers []string
 
+	// ETag is the ETag a downloaded reference must have, or empty to
+	// download any version of it
+	ETag string
+
 	// Size is the number of bytes to upload
 	//
 	// If this is zero, then all bytes starting at `Offset` are uploaded; if non-zero,
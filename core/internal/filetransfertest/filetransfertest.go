@@ -2,6 +2,7 @@
 package filetransfertest
 
 import (
+	"context"
 	"slices"
 	"sync"
 
@@ -48,6 +49,10 @@ func (m *FakeFileTransferManager) CompleteTasks() {
 
 func (m *FakeFileTransferManager) Start() {}
 
+func (m *FakeFileTransferManager) WaitForUploadWindow(context.Context, int64) {}
+
+func (m *FakeFileTransferManager) IgnoreUploadWindow() {}
+
 func (m *FakeFileTransferManager) Close() {
 	m.CompleteTasks()
 }
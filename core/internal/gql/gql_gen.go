@@ -4,10 +4,29 @@ package gql
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/Khan/genqlient/graphql"
 )
 
+// AckRunQueueItemAckRunQueueItemAckRunQueueItemPayload includes the requested fields of the GraphQL type AckRunQueueItemPayload.
+type AckRunQueueItemAckRunQueueItemAckRunQueueItemPayload struct {
+	Success bool `json:"success"`
+}
+
+// GetSuccess returns AckRunQueueItemAckRunQueueItemAckRunQueueItemPayload.Success, and is useful for accessing the field via an interface.
+func (v *AckRunQueueItemAckRunQueueItemAckRunQueueItemPayload) GetSuccess() bool { return v.Success }
+
+// AckRunQueueItemResponse is returned by AckRunQueueItem on success.
+type AckRunQueueItemResponse struct {
+	AckRunQueueItem *AckRunQueueItemAckRunQueueItemAckRunQueueItemPayload `json:"ackRunQueueItem"`
+}
+
+// GetAckRunQueueItem returns AckRunQueueItemResponse.AckRunQueueItem, and is useful for accessing the field via an interface.
+func (v *AckRunQueueItemResponse) GetAckRunQueueItem() *AckRunQueueItemAckRunQueueItemAckRunQueueItemPayload {
+	return v.AckRunQueueItem
+}
+
 type AlertSeverity string
 
 const (
@@ -27,6 +46,26 @@ func (v *ArtifactAliasInput) GetArtifactCollectionName() string { return v.Artif
 // GetAlias returns ArtifactAliasInput.Alias, and is useful for accessing the field via an interface.
 func (v *ArtifactAliasInput) GetAlias() string { return v.Alias }
 
+// ArtifactCommitStateArtifact includes the requested fields of the GraphQL type Artifact.
+type ArtifactCommitStateArtifact struct {
+	Id    string        `json:"id"`
+	State ArtifactState `json:"state"`
+}
+
+// GetId returns ArtifactCommitStateArtifact.Id, and is useful for accessing the field via an interface.
+func (v *ArtifactCommitStateArtifact) GetId() string { return v.Id }
+
+// GetState returns ArtifactCommitStateArtifact.State, and is useful for accessing the field via an interface.
+func (v *ArtifactCommitStateArtifact) GetState() ArtifactState { return v.State }
+
+// ArtifactCommitStateResponse is returned by ArtifactCommitState on success.
+type ArtifactCommitStateResponse struct {
+	Artifact *ArtifactCommitStateArtifact `json:"artifact"`
+}
+
+// GetArtifact returns ArtifactCommitStateResponse.Artifact, and is useful for accessing the field via an interface.
+func (v *ArtifactCommitStateResponse) GetArtifact() *ArtifactCommitStateArtifact { return v.Artifact }
+
 // ArtifactFileURLsArtifact includes the requested fields of the GraphQL type Artifact.
 type ArtifactFileURLsArtifact struct {
 	Files ArtifactFileURLsArtifactFilesFileConnection `json:"files"`
@@ -95,6 +134,62 @@ func (v *ArtifactFileURLsArtifactFilesFileConnectionPageInfo) GetEndCursor() *st
 	return v.EndCursor
 }
 
+// ArtifactFileURLsByNamesArtifact includes the requested fields of the GraphQL type Artifact.
+type ArtifactFileURLsByNamesArtifact struct {
+	Files ArtifactFileURLsByNamesArtifactFilesFileConnection `json:"files"`
+}
+
+// GetFiles returns ArtifactFileURLsByNamesArtifact.Files, and is useful for accessing the field via an interface.
+func (v *ArtifactFileURLsByNamesArtifact) GetFiles() ArtifactFileURLsByNamesArtifactFilesFileConnection {
+	return v.Files
+}
+
+// ArtifactFileURLsByNamesArtifactFilesFileConnection includes the requested fields of the GraphQL type FileConnection.
+type ArtifactFileURLsByNamesArtifactFilesFileConnection struct {
+	Edges []ArtifactFileURLsByNamesArtifactFilesFileConnectionEdgesFileEdge `json:"edges"`
+}
+
+// GetEdges returns ArtifactFileURLsByNamesArtifactFilesFileConnection.Edges, and is useful for accessing the field via an interface.
+func (v *ArtifactFileURLsByNamesArtifactFilesFileConnection) GetEdges() []ArtifactFileURLsByNamesArtifactFilesFileConnectionEdgesFileEdge {
+	return v.Edges
+}
+
+// ArtifactFileURLsByNamesArtifactFilesFileConnectionEdgesFileEdge includes the requested fields of the GraphQL type FileEdge.
+type ArtifactFileURLsByNamesArtifactFilesFileConnectionEdgesFileEdge struct {
+	Node *ArtifactFileURLsByNamesArtifactFilesFileConnectionEdgesFileEdgeNodeFile `json:"node"`
+}
+
+// GetNode returns ArtifactFileURLsByNamesArtifactFilesFileConnectionEdgesFileEdge.Node, and is useful for accessing the field via an interface.
+func (v *ArtifactFileURLsByNamesArtifactFilesFileConnectionEdgesFileEdge) GetNode() *ArtifactFileURLsByNamesArtifactFilesFileConnectionEdgesFileEdgeNodeFile {
+	return v.Node
+}
+
+// ArtifactFileURLsByNamesArtifactFilesFileConnectionEdgesFileEdgeNodeFile includes the requested fields of the GraphQL type File.
+type ArtifactFileURLsByNamesArtifactFilesFileConnectionEdgesFileEdgeNodeFile struct {
+	Name      string `json:"name"`
+	DirectUrl string `json:"directUrl"`
+}
+
+// GetName returns ArtifactFileURLsByNamesArtifactFilesFileConnectionEdgesFileEdgeNodeFile.Name, and is useful for accessing the field via an interface.
+func (v *ArtifactFileURLsByNamesArtifactFilesFileConnectionEdgesFileEdgeNodeFile) GetName() string {
+	return v.Name
+}
+
+// GetDirectUrl returns ArtifactFileURLsByNamesArtifactFilesFileConnectionEdgesFileEdgeNodeFile.DirectUrl, and is useful for accessing the field via an interface.
+func (v *ArtifactFileURLsByNamesArtifactFilesFileConnectionEdgesFileEdgeNodeFile) GetDirectUrl() string {
+	return v.DirectUrl
+}
+
+// ArtifactFileURLsByNamesResponse is returned by ArtifactFileURLsByNames on success.
+type ArtifactFileURLsByNamesResponse struct {
+	Artifact *ArtifactFileURLsByNamesArtifact `json:"artifact"`
+}
+
+// GetArtifact returns ArtifactFileURLsByNamesResponse.Artifact, and is useful for accessing the field via an interface.
+func (v *ArtifactFileURLsByNamesResponse) GetArtifact() *ArtifactFileURLsByNamesArtifact {
+	return v.Artifact
+}
+
 // ArtifactFileURLsResponse is returned by ArtifactFileURLs on success.
 type ArtifactFileURLsResponse struct {
 	Artifact *ArtifactFileURLsArtifact `json:"artifact"`
@@ -103,6 +198,30 @@ type ArtifactFileURLsResponse struct {
 // GetArtifact returns ArtifactFileURLsResponse.Artifact, and is useful for accessing the field via an interface.
 func (v *ArtifactFileURLsResponse) GetArtifact() *ArtifactFileURLsArtifact { return v.Artifact }
 
+// ArtifactIDByNameProject includes the requested fields of the GraphQL type Project.
+type ArtifactIDByNameProject struct {
+	Artifact *ArtifactIDByNameProjectArtifact `json:"artifact"`
+}
+
+// GetArtifact returns ArtifactIDByNameProject.Artifact, and is useful for accessing the field via an interface.
+func (v *ArtifactIDByNameProject) GetArtifact() *ArtifactIDByNameProjectArtifact { return v.Artifact }
+
+// ArtifactIDByNameProjectArtifact includes the requested fields of the GraphQL type Artifact.
+type ArtifactIDByNameProjectArtifact struct {
+	Id string `json:"id"`
+}
+
+// GetId returns ArtifactIDByNameProjectArtifact.Id, and is useful for accessing the field via an interface.
+func (v *ArtifactIDByNameProjectArtifact) GetId() string { return v.Id }
+
+// ArtifactIDByNameResponse is returned by ArtifactIDByName on success.
+type ArtifactIDByNameResponse struct {
+	Project *ArtifactIDByNameProject `json:"project"`
+}
+
+// GetProject returns ArtifactIDByNameResponse.Project, and is useful for accessing the field via an interface.
+func (v *ArtifactIDByNameResponse) GetProject() *ArtifactIDByNameProject { return v.Project }
+
 // ArtifactManifestArtifact includes the requested fields of the GraphQL type Artifact.
 type ArtifactManifestArtifact struct {
 	CurrentManifest *ArtifactManifestArtifactCurrentManifestArtifactManifest `json:"currentManifest"`
@@ -216,6 +335,36 @@ func (v *CommitArtifactResponse) GetCommitArtifact() *CommitArtifactCommitArtifa
 	return v.CommitArtifact
 }
 
+// CreateAnonymousApiKeyCreateAnonymousEntityCreateAnonymousEntityPayload includes the requested fields of the GraphQL type CreateAnonymousEntityPayload.
+type CreateAnonymousApiKeyCreateAnonymousEntityCreateAnonymousEntityPayload struct {
+	ApiKey *CreateAnonymousApiKeyCreateAnonymousEntityCreateAnonymousEntityPayloadApiKey `json:"apiKey"`
+}
+
+// GetApiKey returns CreateAnonymousApiKeyCreateAnonymousEntityCreateAnonymousEntityPayload.ApiKey, and is useful for accessing the field via an interface.
+func (v *CreateAnonymousApiKeyCreateAnonymousEntityCreateAnonymousEntityPayload) GetApiKey() *CreateAnonymousApiKeyCreateAnonymousEntityCreateAnonymousEntityPayloadApiKey {
+	return v.ApiKey
+}
+
+// CreateAnonymousApiKeyCreateAnonymousEntityCreateAnonymousEntityPayloadApiKey includes the requested fields of the GraphQL type ApiKey.
+type CreateAnonymousApiKeyCreateAnonymousEntityCreateAnonymousEntityPayloadApiKey struct {
+	Name string `json:"name"`
+}
+
+// GetName returns CreateAnonymousApiKeyCreateAnonymousEntityCreateAnonymousEntityPayloadApiKey.Name, and is useful for accessing the field via an interface.
+func (v *CreateAnonymousApiKeyCreateAnonymousEntityCreateAnonymousEntityPayloadApiKey) GetName() string {
+	return v.Name
+}
+
+// CreateAnonymousApiKeyResponse is returned by CreateAnonymousApiKey on success.
+type CreateAnonymousApiKeyResponse struct {
+	CreateAnonymousEntity *CreateAnonymousApiKeyCreateAnonymousEntityCreateAnonymousEntityPayload `json:"createAnonymousEntity"`
+}
+
+// GetCreateAnonymousEntity returns CreateAnonymousApiKeyResponse.CreateAnonymousEntity, and is useful for accessing the field via an interface.
+func (v *CreateAnonymousApiKeyResponse) GetCreateAnonymousEntity() *CreateAnonymousApiKeyCreateAnonymousEntityCreateAnonymousEntityPayload {
+	return v.CreateAnonymousEntity
+}
+
 // CreateArtifactCreateArtifactCreateArtifactPayload includes the requested fields of the GraphQL type CreateArtifactPayload.
 type CreateArtifactCreateArtifactCreateArtifactPayload struct {
 	Artifact CreateArtifactCreateArtifactCreateArtifactPayloadArtifact `json:"artifact"`
@@ -435,6 +584,26 @@ func (v *CreateArtifactResponse) GetCreateArtifact() *CreateArtifactCreateArtifa
 	return v.CreateArtifact
 }
 
+// CreateLaunchAgentCreateLaunchAgentCreateLaunchAgentPayload includes the requested fields of the GraphQL type CreateLaunchAgentPayload.
+type CreateLaunchAgentCreateLaunchAgentCreateLaunchAgentPayload struct {
+	LaunchAgentId string `json:"launchAgentId"`
+}
+
+// GetLaunchAgentId returns CreateLaunchAgentCreateLaunchAgentCreateLaunchAgentPayload.LaunchAgentId, and is useful for accessing the field via an interface.
+func (v *CreateLaunchAgentCreateLaunchAgentCreateLaunchAgentPayload) GetLaunchAgentId() string {
+	return v.LaunchAgentId
+}
+
+// CreateLaunchAgentResponse is returned by CreateLaunchAgent on success.
+type CreateLaunchAgentResponse struct {
+	CreateLaunchAgent *CreateLaunchAgentCreateLaunchAgentCreateLaunchAgentPayload `json:"createLaunchAgent"`
+}
+
+// GetCreateLaunchAgent returns CreateLaunchAgentResponse.CreateLaunchAgent, and is useful for accessing the field via an interface.
+func (v *CreateLaunchAgentResponse) GetCreateLaunchAgent() *CreateLaunchAgentCreateLaunchAgentCreateLaunchAgentPayload {
+	return v.CreateLaunchAgent
+}
+
 // CreateRunFilesCreateRunFilesCreateRunFilesPayload includes the requested fields of the GraphQL type CreateRunFilesPayload.
 type CreateRunFilesCreateRunFilesCreateRunFilesPayload struct {
 	RunID         string                                                       `json:"runID"`
@@ -479,6 +648,56 @@ func (v *CreateRunFilesResponse) GetCreateRunFiles() *CreateRunFilesCreateRunFil
 	return v.CreateRunFiles
 }
 
+// EntityDefaultSettingsEntity includes the requested fields of the GraphQL type Entity.
+type EntityDefaultSettingsEntity struct {
+	SdkDefaultSettings *string `json:"sdkDefaultSettings"`
+}
+
+// GetSdkDefaultSettings returns EntityDefaultSettingsEntity.SdkDefaultSettings, and is useful for accessing the field via an interface.
+func (v *EntityDefaultSettingsEntity) GetSdkDefaultSettings() *string { return v.SdkDefaultSettings }
+
+// EntityDefaultSettingsResponse is returned by EntityDefaultSettings on success.
+type EntityDefaultSettingsResponse struct {
+	Entity *EntityDefaultSettingsEntity `json:"entity"`
+}
+
+// GetEntity returns EntityDefaultSettingsResponse.Entity, and is useful for accessing the field via an interface.
+func (v *EntityDefaultSettingsResponse) GetEntity() *EntityDefaultSettingsEntity { return v.Entity }
+
+// FailRunQueueItemFailRunQueueItemFailRunQueueItemPayload includes the requested fields of the GraphQL type FailRunQueueItemPayload.
+type FailRunQueueItemFailRunQueueItemFailRunQueueItemPayload struct {
+	Success bool `json:"success"`
+}
+
+// GetSuccess returns FailRunQueueItemFailRunQueueItemFailRunQueueItemPayload.Success, and is useful for accessing the field via an interface.
+func (v *FailRunQueueItemFailRunQueueItemFailRunQueueItemPayload) GetSuccess() bool { return v.Success }
+
+// FailRunQueueItemResponse is returned by FailRunQueueItem on success.
+type FailRunQueueItemResponse struct {
+	FailRunQueueItem *FailRunQueueItemFailRunQueueItemFailRunQueueItemPayload `json:"failRunQueueItem"`
+}
+
+// GetFailRunQueueItem returns FailRunQueueItemResponse.FailRunQueueItem, and is useful for accessing the field via an interface.
+func (v *FailRunQueueItemResponse) GetFailRunQueueItem() *FailRunQueueItemFailRunQueueItemFailRunQueueItemPayload {
+	return v.FailRunQueueItem
+}
+
+// LaunchAgentLaunchAgent includes the requested fields of the GraphQL type LaunchAgent.
+type LaunchAgentLaunchAgent struct {
+	StopPolling bool `json:"stopPolling"`
+}
+
+// GetStopPolling returns LaunchAgentLaunchAgent.StopPolling, and is useful for accessing the field via an interface.
+func (v *LaunchAgentLaunchAgent) GetStopPolling() bool { return v.StopPolling }
+
+// LaunchAgentResponse is returned by LaunchAgent on success.
+type LaunchAgentResponse struct {
+	LaunchAgent *LaunchAgentLaunchAgent `json:"launchAgent"`
+}
+
+// GetLaunchAgent returns LaunchAgentResponse.LaunchAgent, and is useful for accessing the field via an interface.
+func (v *LaunchAgentResponse) GetLaunchAgent() *LaunchAgentLaunchAgent { return v.LaunchAgent }
+
 // LinkArtifactLinkArtifactLinkArtifactPayload includes the requested fields of the GraphQL type LinkArtifactPayload.
 type LinkArtifactLinkArtifactLinkArtifactPayload struct {
 	VersionIndex *int `json:"versionIndex"`
@@ -517,6 +736,430 @@ func (v *NotifyScriptableRunAlertResponse) GetNotifyScriptableRunAlert() *Notify
 	return v.NotifyScriptableRunAlert
 }
 
+// PopFromRunQueuePopFromRunQueuePopFromRunQueuePayload includes the requested fields of the GraphQL type PopFromRunQueuePayload.
+type PopFromRunQueuePopFromRunQueuePopFromRunQueuePayload struct {
+	RunQueueItemId string          `json:"runQueueItemId"`
+	RunSpec        json.RawMessage `json:"runSpec"`
+}
+
+// GetRunQueueItemId returns PopFromRunQueuePopFromRunQueuePopFromRunQueuePayload.RunQueueItemId, and is useful for accessing the field via an interface.
+func (v *PopFromRunQueuePopFromRunQueuePopFromRunQueuePayload) GetRunQueueItemId() string {
+	return v.RunQueueItemId
+}
+
+// GetRunSpec returns PopFromRunQueuePopFromRunQueuePopFromRunQueuePayload.RunSpec, and is useful for accessing the field via an interface.
+func (v *PopFromRunQueuePopFromRunQueuePopFromRunQueuePayload) GetRunSpec() json.RawMessage {
+	return v.RunSpec
+}
+
+// PopFromRunQueueResponse is returned by PopFromRunQueue on success.
+type PopFromRunQueueResponse struct {
+	PopFromRunQueue *PopFromRunQueuePopFromRunQueuePopFromRunQueuePayload `json:"popFromRunQueue"`
+}
+
+// GetPopFromRunQueue returns PopFromRunQueueResponse.PopFromRunQueue, and is useful for accessing the field via an interface.
+func (v *PopFromRunQueueResponse) GetPopFromRunQueue() *PopFromRunQueuePopFromRunQueuePopFromRunQueuePayload {
+	return v.PopFromRunQueue
+}
+
+// ProjectRunQueuesProject includes the requested fields of the GraphQL type Project.
+type ProjectRunQueuesProject struct {
+	RunQueues []ProjectRunQueuesProjectRunQueuesRunQueue `json:"runQueues"`
+}
+
+// GetRunQueues returns ProjectRunQueuesProject.RunQueues, and is useful for accessing the field via an interface.
+func (v *ProjectRunQueuesProject) GetRunQueues() []ProjectRunQueuesProjectRunQueuesRunQueue {
+	return v.RunQueues
+}
+
+// ProjectRunQueuesProjectRunQueuesRunQueue includes the requested fields of the GraphQL type RunQueue.
+type ProjectRunQueuesProjectRunQueuesRunQueue struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// GetId returns ProjectRunQueuesProjectRunQueuesRunQueue.Id, and is useful for accessing the field via an interface.
+func (v *ProjectRunQueuesProjectRunQueuesRunQueue) GetId() string { return v.Id }
+
+// GetName returns ProjectRunQueuesProjectRunQueuesRunQueue.Name, and is useful for accessing the field via an interface.
+func (v *ProjectRunQueuesProjectRunQueuesRunQueue) GetName() string { return v.Name }
+
+// ProjectRunQueuesResponse is returned by ProjectRunQueues on success.
+type ProjectRunQueuesResponse struct {
+	Project *ProjectRunQueuesProject `json:"project"`
+}
+
+// GetProject returns ProjectRunQueuesResponse.Project, and is useful for accessing the field via an interface.
+func (v *ProjectRunQueuesResponse) GetProject() *ProjectRunQueuesProject { return v.Project }
+
+// ProjectsModelsProjectConnection includes the requested fields of the GraphQL type ProjectConnection.
+type ProjectsModelsProjectConnection struct {
+	PageInfo ProjectsModelsProjectConnectionPageInfo           `json:"pageInfo"`
+	Edges    []ProjectsModelsProjectConnectionEdgesProjectEdge `json:"edges"`
+}
+
+// GetPageInfo returns ProjectsModelsProjectConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *ProjectsModelsProjectConnection) GetPageInfo() ProjectsModelsProjectConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetEdges returns ProjectsModelsProjectConnection.Edges, and is useful for accessing the field via an interface.
+func (v *ProjectsModelsProjectConnection) GetEdges() []ProjectsModelsProjectConnectionEdgesProjectEdge {
+	return v.Edges
+}
+
+// ProjectsModelsProjectConnectionEdgesProjectEdge includes the requested fields of the GraphQL type ProjectEdge.
+type ProjectsModelsProjectConnectionEdgesProjectEdge struct {
+	Node *ProjectsModelsProjectConnectionEdgesProjectEdgeNodeProject `json:"node"`
+}
+
+// GetNode returns ProjectsModelsProjectConnectionEdgesProjectEdge.Node, and is useful for accessing the field via an interface.
+func (v *ProjectsModelsProjectConnectionEdgesProjectEdge) GetNode() *ProjectsModelsProjectConnectionEdgesProjectEdgeNodeProject {
+	return v.Node
+}
+
+// ProjectsModelsProjectConnectionEdgesProjectEdgeNodeProject includes the requested fields of the GraphQL type Project.
+type ProjectsModelsProjectConnectionEdgesProjectEdgeNodeProject struct {
+	Id          string  `json:"id"`
+	Name        string  `json:"name"`
+	EntityName  string  `json:"entityName"`
+	Description *string `json:"description"`
+	CreatedAt   string  `json:"createdAt"`
+}
+
+// GetId returns ProjectsModelsProjectConnectionEdgesProjectEdgeNodeProject.Id, and is useful for accessing the field via an interface.
+func (v *ProjectsModelsProjectConnectionEdgesProjectEdgeNodeProject) GetId() string { return v.Id }
+
+// GetName returns ProjectsModelsProjectConnectionEdgesProjectEdgeNodeProject.Name, and is useful for accessing the field via an interface.
+func (v *ProjectsModelsProjectConnectionEdgesProjectEdgeNodeProject) GetName() string { return v.Name }
+
+// GetEntityName returns ProjectsModelsProjectConnectionEdgesProjectEdgeNodeProject.EntityName, and is useful for accessing the field via an interface.
+func (v *ProjectsModelsProjectConnectionEdgesProjectEdgeNodeProject) GetEntityName() string {
+	return v.EntityName
+}
+
+// GetDescription returns ProjectsModelsProjectConnectionEdgesProjectEdgeNodeProject.Description, and is useful for accessing the field via an interface.
+func (v *ProjectsModelsProjectConnectionEdgesProjectEdgeNodeProject) GetDescription() *string {
+	return v.Description
+}
+
+// GetCreatedAt returns ProjectsModelsProjectConnectionEdgesProjectEdgeNodeProject.CreatedAt, and is useful for accessing the field via an interface.
+func (v *ProjectsModelsProjectConnectionEdgesProjectEdgeNodeProject) GetCreatedAt() string {
+	return v.CreatedAt
+}
+
+// ProjectsModelsProjectConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type ProjectsModelsProjectConnectionPageInfo struct {
+	HasNextPage bool    `json:"hasNextPage"`
+	EndCursor   *string `json:"endCursor"`
+}
+
+// GetHasNextPage returns ProjectsModelsProjectConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *ProjectsModelsProjectConnectionPageInfo) GetHasNextPage() bool { return v.HasNextPage }
+
+// GetEndCursor returns ProjectsModelsProjectConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *ProjectsModelsProjectConnectionPageInfo) GetEndCursor() *string { return v.EndCursor }
+
+// ProjectsResponse is returned by Projects on success.
+type ProjectsResponse struct {
+	Models *ProjectsModelsProjectConnection `json:"models"`
+}
+
+// GetModels returns ProjectsResponse.Models, and is useful for accessing the field via an interface.
+func (v *ProjectsResponse) GetModels() *ProjectsModelsProjectConnection { return v.Models }
+
+// RunFields includes the GraphQL fields of Run requested by the fragment RunFields.
+type RunFields struct {
+	Name           string         `json:"name"`
+	DisplayName    *string        `json:"displayName"`
+	State          *string        `json:"state"`
+	Config         *string        `json:"config"`
+	SummaryMetrics *string        `json:"summaryMetrics"`
+	Tags           []string       `json:"tags"`
+	Group          *string        `json:"group"`
+	JobType        *string        `json:"jobType"`
+	User           *RunFieldsUser `json:"user"`
+	CreatedAt      string         `json:"createdAt"`
+	HeartbeatAt    string         `json:"heartbeatAt"`
+}
+
+// GetName returns RunFields.Name, and is useful for accessing the field via an interface.
+func (v *RunFields) GetName() string { return v.Name }
+
+// GetDisplayName returns RunFields.DisplayName, and is useful for accessing the field via an interface.
+func (v *RunFields) GetDisplayName() *string { return v.DisplayName }
+
+// GetState returns RunFields.State, and is useful for accessing the field via an interface.
+func (v *RunFields) GetState() *string { return v.State }
+
+// GetConfig returns RunFields.Config, and is useful for accessing the field via an interface.
+func (v *RunFields) GetConfig() *string { return v.Config }
+
+// GetSummaryMetrics returns RunFields.SummaryMetrics, and is useful for accessing the field via an interface.
+func (v *RunFields) GetSummaryMetrics() *string { return v.SummaryMetrics }
+
+// GetTags returns RunFields.Tags, and is useful for accessing the field via an interface.
+func (v *RunFields) GetTags() []string { return v.Tags }
+
+// GetGroup returns RunFields.Group, and is useful for accessing the field via an interface.
+func (v *RunFields) GetGroup() *string { return v.Group }
+
+// GetJobType returns RunFields.JobType, and is useful for accessing the field via an interface.
+func (v *RunFields) GetJobType() *string { return v.JobType }
+
+// GetUser returns RunFields.User, and is useful for accessing the field via an interface.
+func (v *RunFields) GetUser() *RunFieldsUser { return v.User }
+
+// GetCreatedAt returns RunFields.CreatedAt, and is useful for accessing the field via an interface.
+func (v *RunFields) GetCreatedAt() string { return v.CreatedAt }
+
+// GetHeartbeatAt returns RunFields.HeartbeatAt, and is useful for accessing the field via an interface.
+func (v *RunFields) GetHeartbeatAt() string { return v.HeartbeatAt }
+
+// RunFieldsUser includes the requested fields of the GraphQL type User.
+type RunFieldsUser struct {
+	Username *string `json:"username"`
+}
+
+// GetUsername returns RunFieldsUser.Username, and is useful for accessing the field via an interface.
+func (v *RunFieldsUser) GetUsername() *string { return v.Username }
+
+// RunHistoryPageProject includes the requested fields of the GraphQL type Project.
+type RunHistoryPageProject struct {
+	Run *RunHistoryPageProjectRun `json:"run"`
+}
+
+// GetRun returns RunHistoryPageProject.Run, and is useful for accessing the field via an interface.
+func (v *RunHistoryPageProject) GetRun() *RunHistoryPageProjectRun { return v.Run }
+
+// RunHistoryPageProjectRun includes the requested fields of the GraphQL type Run.
+type RunHistoryPageProjectRun struct {
+	State       *string         `json:"state"`
+	HistoryKeys json.RawMessage `json:"historyKeys"`
+	History     []string        `json:"history"`
+}
+
+// GetState returns RunHistoryPageProjectRun.State, and is useful for accessing the field via an interface.
+func (v *RunHistoryPageProjectRun) GetState() *string { return v.State }
+
+// GetHistoryKeys returns RunHistoryPageProjectRun.HistoryKeys, and is useful for accessing the field via an interface.
+func (v *RunHistoryPageProjectRun) GetHistoryKeys() json.RawMessage { return v.HistoryKeys }
+
+// GetHistory returns RunHistoryPageProjectRun.History, and is useful for accessing the field via an interface.
+func (v *RunHistoryPageProjectRun) GetHistory() []string { return v.History }
+
+// RunHistoryPageResponse is returned by RunHistoryPage on success.
+type RunHistoryPageResponse struct {
+	Project *RunHistoryPageProject `json:"project"`
+}
+
+// GetProject returns RunHistoryPageResponse.Project, and is useful for accessing the field via an interface.
+func (v *RunHistoryPageResponse) GetProject() *RunHistoryPageProject { return v.Project }
+
+// RunHistoryProject includes the requested fields of the GraphQL type Project.
+type RunHistoryProject struct {
+	Run *RunHistoryProjectRun `json:"run"`
+}
+
+// GetRun returns RunHistoryProject.Run, and is useful for accessing the field via an interface.
+func (v *RunHistoryProject) GetRun() *RunHistoryProjectRun { return v.Run }
+
+// RunHistoryProjectRun includes the requested fields of the GraphQL type Run.
+type RunHistoryProjectRun struct {
+	History []string `json:"history"`
+}
+
+// GetHistory returns RunHistoryProjectRun.History, and is useful for accessing the field via an interface.
+func (v *RunHistoryProjectRun) GetHistory() []string { return v.History }
+
+// RunHistoryResponse is returned by RunHistory on success.
+type RunHistoryResponse struct {
+	Project *RunHistoryProject `json:"project"`
+}
+
+// GetProject returns RunHistoryResponse.Project, and is useful for accessing the field via an interface.
+func (v *RunHistoryResponse) GetProject() *RunHistoryProject { return v.Project }
+
+// RunLastStepProject includes the requested fields of the GraphQL type Project.
+type RunLastStepProject struct {
+	Run *RunLastStepProjectRun `json:"run"`
+}
+
+// GetRun returns RunLastStepProject.Run, and is useful for accessing the field via an interface.
+func (v *RunLastStepProject) GetRun() *RunLastStepProjectRun { return v.Run }
+
+// RunLastStepProjectRun includes the requested fields of the GraphQL type Run.
+type RunLastStepProjectRun struct {
+	HistoryKeys json.RawMessage `json:"historyKeys"`
+}
+
+// GetHistoryKeys returns RunLastStepProjectRun.HistoryKeys, and is useful for accessing the field via an interface.
+func (v *RunLastStepProjectRun) GetHistoryKeys() json.RawMessage { return v.HistoryKeys }
+
+// RunLastStepResponse is returned by RunLastStep on success.
+type RunLastStepResponse struct {
+	Project *RunLastStepProject `json:"project"`
+}
+
+// GetProject returns RunLastStepResponse.Project, and is useful for accessing the field via an interface.
+func (v *RunLastStepResponse) GetProject() *RunLastStepProject { return v.Project }
+
+// RunNotesProject includes the requested fields of the GraphQL type Project.
+type RunNotesProject struct {
+	Run *RunNotesProjectRun `json:"run"`
+}
+
+// GetRun returns RunNotesProject.Run, and is useful for accessing the field via an interface.
+func (v *RunNotesProject) GetRun() *RunNotesProjectRun { return v.Run }
+
+// RunNotesProjectRun includes the requested fields of the GraphQL type Run.
+type RunNotesProjectRun struct {
+	Notes *string  `json:"notes"`
+	Tags  []string `json:"tags"`
+}
+
+// GetNotes returns RunNotesProjectRun.Notes, and is useful for accessing the field via an interface.
+func (v *RunNotesProjectRun) GetNotes() *string { return v.Notes }
+
+// GetTags returns RunNotesProjectRun.Tags, and is useful for accessing the field via an interface.
+func (v *RunNotesProjectRun) GetTags() []string { return v.Tags }
+
+// RunNotesResponse is returned by RunNotes on success.
+type RunNotesResponse struct {
+	Project *RunNotesProject `json:"project"`
+}
+
+// GetProject returns RunNotesResponse.Project, and is useful for accessing the field via an interface.
+func (v *RunNotesResponse) GetProject() *RunNotesProject { return v.Project }
+
+// RunProject includes the requested fields of the GraphQL type Project.
+type RunProject struct {
+	Run *RunProjectRun `json:"run"`
+}
+
+// GetRun returns RunProject.Run, and is useful for accessing the field via an interface.
+func (v *RunProject) GetRun() *RunProjectRun { return v.Run }
+
+// RunProjectRun includes the requested fields of the GraphQL type Run.
+type RunProjectRun struct {
+	RunFields `json:"-"`
+}
+
+// GetName returns RunProjectRun.Name, and is useful for accessing the field via an interface.
+func (v *RunProjectRun) GetName() string { return v.RunFields.Name }
+
+// GetDisplayName returns RunProjectRun.DisplayName, and is useful for accessing the field via an interface.
+func (v *RunProjectRun) GetDisplayName() *string { return v.RunFields.DisplayName }
+
+// GetState returns RunProjectRun.State, and is useful for accessing the field via an interface.
+func (v *RunProjectRun) GetState() *string { return v.RunFields.State }
+
+// GetConfig returns RunProjectRun.Config, and is useful for accessing the field via an interface.
+func (v *RunProjectRun) GetConfig() *string { return v.RunFields.Config }
+
+// GetSummaryMetrics returns RunProjectRun.SummaryMetrics, and is useful for accessing the field via an interface.
+func (v *RunProjectRun) GetSummaryMetrics() *string { return v.RunFields.SummaryMetrics }
+
+// GetTags returns RunProjectRun.Tags, and is useful for accessing the field via an interface.
+func (v *RunProjectRun) GetTags() []string { return v.RunFields.Tags }
+
+// GetGroup returns RunProjectRun.Group, and is useful for accessing the field via an interface.
+func (v *RunProjectRun) GetGroup() *string { return v.RunFields.Group }
+
+// GetJobType returns RunProjectRun.JobType, and is useful for accessing the field via an interface.
+func (v *RunProjectRun) GetJobType() *string { return v.RunFields.JobType }
+
+// GetUser returns RunProjectRun.User, and is useful for accessing the field via an interface.
+func (v *RunProjectRun) GetUser() *RunFieldsUser { return v.RunFields.User }
+
+// GetCreatedAt returns RunProjectRun.CreatedAt, and is useful for accessing the field via an interface.
+func (v *RunProjectRun) GetCreatedAt() string { return v.RunFields.CreatedAt }
+
+// GetHeartbeatAt returns RunProjectRun.HeartbeatAt, and is useful for accessing the field via an interface.
+func (v *RunProjectRun) GetHeartbeatAt() string { return v.RunFields.HeartbeatAt }
+
+func (v *RunProjectRun) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*RunProjectRun
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.RunProjectRun = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.RunFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalRunProjectRun struct {
+	Name string `json:"name"`
+
+	DisplayName *string `json:"displayName"`
+
+	State *string `json:"state"`
+
+	Config *string `json:"config"`
+
+	SummaryMetrics *string `json:"summaryMetrics"`
+
+	Tags []string `json:"tags"`
+
+	Group *string `json:"group"`
+
+	JobType *string `json:"jobType"`
+
+	User *RunFieldsUser `json:"user"`
+
+	CreatedAt string `json:"createdAt"`
+
+	HeartbeatAt string `json:"heartbeatAt"`
+}
+
+func (v *RunProjectRun) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *RunProjectRun) __premarshalJSON() (*__premarshalRunProjectRun, error) {
+	var retval __premarshalRunProjectRun
+
+	retval.Name = v.RunFields.Name
+	retval.DisplayName = v.RunFields.DisplayName
+	retval.State = v.RunFields.State
+	retval.Config = v.RunFields.Config
+	retval.SummaryMetrics = v.RunFields.SummaryMetrics
+	retval.Tags = v.RunFields.Tags
+	retval.Group = v.RunFields.Group
+	retval.JobType = v.RunFields.JobType
+	retval.User = v.RunFields.User
+	retval.CreatedAt = v.RunFields.CreatedAt
+	retval.HeartbeatAt = v.RunFields.HeartbeatAt
+	return &retval, nil
+}
+
+// RunResponse is returned by Run on success.
+type RunResponse struct {
+	Project *RunProject `json:"project"`
+}
+
+// GetProject returns RunResponse.Project, and is useful for accessing the field via an interface.
+func (v *RunResponse) GetProject() *RunProject { return v.Project }
+
 // RunResumeStatusModelProject includes the requested fields of the GraphQL type Project.
 type RunResumeStatusModelProject struct {
 	Id     string                                `json:"id"`
@@ -611,6 +1254,30 @@ type RunResumeStatusResponse struct {
 // GetModel returns RunResumeStatusResponse.Model, and is useful for accessing the field via an interface.
 func (v *RunResumeStatusResponse) GetModel() *RunResumeStatusModelProject { return v.Model }
 
+// RunSampledHistoryProject includes the requested fields of the GraphQL type Project.
+type RunSampledHistoryProject struct {
+	Run *RunSampledHistoryProjectRun `json:"run"`
+}
+
+// GetRun returns RunSampledHistoryProject.Run, and is useful for accessing the field via an interface.
+func (v *RunSampledHistoryProject) GetRun() *RunSampledHistoryProjectRun { return v.Run }
+
+// RunSampledHistoryProjectRun includes the requested fields of the GraphQL type Run.
+type RunSampledHistoryProjectRun struct {
+	SampledHistory json.RawMessage `json:"sampledHistory"`
+}
+
+// GetSampledHistory returns RunSampledHistoryProjectRun.SampledHistory, and is useful for accessing the field via an interface.
+func (v *RunSampledHistoryProjectRun) GetSampledHistory() json.RawMessage { return v.SampledHistory }
+
+// RunSampledHistoryResponse is returned by RunSampledHistory on success.
+type RunSampledHistoryResponse struct {
+	Project *RunSampledHistoryProject `json:"project"`
+}
+
+// GetProject returns RunSampledHistoryResponse.Project, and is useful for accessing the field via an interface.
+func (v *RunSampledHistoryResponse) GetProject() *RunSampledHistoryProject { return v.Project }
+
 // RunStoppedStatusProject includes the requested fields of the GraphQL type Project.
 type RunStoppedStatusProject struct {
 	Run *RunStoppedStatusProjectRun `json:"run"`
@@ -635,6 +1302,296 @@ type RunStoppedStatusResponse struct {
 // GetProject returns RunStoppedStatusResponse.Project, and is useful for accessing the field via an interface.
 func (v *RunStoppedStatusResponse) GetProject() *RunStoppedStatusProject { return v.Project }
 
+// RunsProject includes the requested fields of the GraphQL type Project.
+type RunsProject struct {
+	Runs *RunsProjectRunsRunConnection `json:"runs"`
+}
+
+// GetRuns returns RunsProject.Runs, and is useful for accessing the field via an interface.
+func (v *RunsProject) GetRuns() *RunsProjectRunsRunConnection { return v.Runs }
+
+// RunsProjectRunsRunConnection includes the requested fields of the GraphQL type RunConnection.
+type RunsProjectRunsRunConnection struct {
+	PageInfo RunsProjectRunsRunConnectionPageInfo       `json:"pageInfo"`
+	Edges    []RunsProjectRunsRunConnectionEdgesRunEdge `json:"edges"`
+}
+
+// GetPageInfo returns RunsProjectRunsRunConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *RunsProjectRunsRunConnection) GetPageInfo() RunsProjectRunsRunConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetEdges returns RunsProjectRunsRunConnection.Edges, and is useful for accessing the field via an interface.
+func (v *RunsProjectRunsRunConnection) GetEdges() []RunsProjectRunsRunConnectionEdgesRunEdge {
+	return v.Edges
+}
+
+// RunsProjectRunsRunConnectionEdgesRunEdge includes the requested fields of the GraphQL type RunEdge.
+type RunsProjectRunsRunConnectionEdgesRunEdge struct {
+	Node *RunsProjectRunsRunConnectionEdgesRunEdgeNodeRun `json:"node"`
+}
+
+// GetNode returns RunsProjectRunsRunConnectionEdgesRunEdge.Node, and is useful for accessing the field via an interface.
+func (v *RunsProjectRunsRunConnectionEdgesRunEdge) GetNode() *RunsProjectRunsRunConnectionEdgesRunEdgeNodeRun {
+	return v.Node
+}
+
+// RunsProjectRunsRunConnectionEdgesRunEdgeNodeRun includes the requested fields of the GraphQL type Run.
+type RunsProjectRunsRunConnectionEdgesRunEdgeNodeRun struct {
+	RunFields `json:"-"`
+}
+
+// GetName returns RunsProjectRunsRunConnectionEdgesRunEdgeNodeRun.Name, and is useful for accessing the field via an interface.
+func (v *RunsProjectRunsRunConnectionEdgesRunEdgeNodeRun) GetName() string { return v.RunFields.Name }
+
+// GetDisplayName returns RunsProjectRunsRunConnectionEdgesRunEdgeNodeRun.DisplayName, and is useful for accessing the field via an interface.
+func (v *RunsProjectRunsRunConnectionEdgesRunEdgeNodeRun) GetDisplayName() *string {
+	return v.RunFields.DisplayName
+}
+
+// GetState returns RunsProjectRunsRunConnectionEdgesRunEdgeNodeRun.State, and is useful for accessing the field via an interface.
+func (v *RunsProjectRunsRunConnectionEdgesRunEdgeNodeRun) GetState() *string {
+	return v.RunFields.State
+}
+
+// GetConfig returns RunsProjectRunsRunConnectionEdgesRunEdgeNodeRun.Config, and is useful for accessing the field via an interface.
+func (v *RunsProjectRunsRunConnectionEdgesRunEdgeNodeRun) GetConfig() *string {
+	return v.RunFields.Config
+}
+
+// GetSummaryMetrics returns RunsProjectRunsRunConnectionEdgesRunEdgeNodeRun.SummaryMetrics, and is useful for accessing the field via an interface.
+func (v *RunsProjectRunsRunConnectionEdgesRunEdgeNodeRun) GetSummaryMetrics() *string {
+	return v.RunFields.SummaryMetrics
+}
+
+// GetTags returns RunsProjectRunsRunConnectionEdgesRunEdgeNodeRun.Tags, and is useful for accessing the field via an interface.
+func (v *RunsProjectRunsRunConnectionEdgesRunEdgeNodeRun) GetTags() []string { return v.RunFields.Tags }
+
+// GetGroup returns RunsProjectRunsRunConnectionEdgesRunEdgeNodeRun.Group, and is useful for accessing the field via an interface.
+func (v *RunsProjectRunsRunConnectionEdgesRunEdgeNodeRun) GetGroup() *string {
+	return v.RunFields.Group
+}
+
+// GetJobType returns RunsProjectRunsRunConnectionEdgesRunEdgeNodeRun.JobType, and is useful for accessing the field via an interface.
+func (v *RunsProjectRunsRunConnectionEdgesRunEdgeNodeRun) GetJobType() *string {
+	return v.RunFields.JobType
+}
+
+// GetUser returns RunsProjectRunsRunConnectionEdgesRunEdgeNodeRun.User, and is useful for accessing the field via an interface.
+func (v *RunsProjectRunsRunConnectionEdgesRunEdgeNodeRun) GetUser() *RunFieldsUser {
+	return v.RunFields.User
+}
+
+// GetCreatedAt returns RunsProjectRunsRunConnectionEdgesRunEdgeNodeRun.CreatedAt, and is useful for accessing the field via an interface.
+func (v *RunsProjectRunsRunConnectionEdgesRunEdgeNodeRun) GetCreatedAt() string {
+	return v.RunFields.CreatedAt
+}
+
+// GetHeartbeatAt returns RunsProjectRunsRunConnectionEdgesRunEdgeNodeRun.HeartbeatAt, and is useful for accessing the field via an interface.
+func (v *RunsProjectRunsRunConnectionEdgesRunEdgeNodeRun) GetHeartbeatAt() string {
+	return v.RunFields.HeartbeatAt
+}
+
+func (v *RunsProjectRunsRunConnectionEdgesRunEdgeNodeRun) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*RunsProjectRunsRunConnectionEdgesRunEdgeNodeRun
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.RunsProjectRunsRunConnectionEdgesRunEdgeNodeRun = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.RunFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalRunsProjectRunsRunConnectionEdgesRunEdgeNodeRun struct {
+	Name string `json:"name"`
+
+	DisplayName *string `json:"displayName"`
+
+	State *string `json:"state"`
+
+	Config *string `json:"config"`
+
+	SummaryMetrics *string `json:"summaryMetrics"`
+
+	Tags []string `json:"tags"`
+
+	Group *string `json:"group"`
+
+	JobType *string `json:"jobType"`
+
+	User *RunFieldsUser `json:"user"`
+
+	CreatedAt string `json:"createdAt"`
+
+	HeartbeatAt string `json:"heartbeatAt"`
+}
+
+func (v *RunsProjectRunsRunConnectionEdgesRunEdgeNodeRun) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *RunsProjectRunsRunConnectionEdgesRunEdgeNodeRun) __premarshalJSON() (*__premarshalRunsProjectRunsRunConnectionEdgesRunEdgeNodeRun, error) {
+	var retval __premarshalRunsProjectRunsRunConnectionEdgesRunEdgeNodeRun
+
+	retval.Name = v.RunFields.Name
+	retval.DisplayName = v.RunFields.DisplayName
+	retval.State = v.RunFields.State
+	retval.Config = v.RunFields.Config
+	retval.SummaryMetrics = v.RunFields.SummaryMetrics
+	retval.Tags = v.RunFields.Tags
+	retval.Group = v.RunFields.Group
+	retval.JobType = v.RunFields.JobType
+	retval.User = v.RunFields.User
+	retval.CreatedAt = v.RunFields.CreatedAt
+	retval.HeartbeatAt = v.RunFields.HeartbeatAt
+	return &retval, nil
+}
+
+// RunsProjectRunsRunConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type RunsProjectRunsRunConnectionPageInfo struct {
+	HasNextPage bool    `json:"hasNextPage"`
+	EndCursor   *string `json:"endCursor"`
+}
+
+// GetHasNextPage returns RunsProjectRunsRunConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *RunsProjectRunsRunConnectionPageInfo) GetHasNextPage() bool { return v.HasNextPage }
+
+// GetEndCursor returns RunsProjectRunsRunConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *RunsProjectRunsRunConnectionPageInfo) GetEndCursor() *string { return v.EndCursor }
+
+// RunsResponse is returned by Runs on success.
+type RunsResponse struct {
+	Project *RunsProject `json:"project"`
+}
+
+// GetProject returns RunsResponse.Project, and is useful for accessing the field via an interface.
+func (v *RunsResponse) GetProject() *RunsProject { return v.Project }
+
+// ServerCapabilitiesResponse is returned by ServerCapabilities on success.
+type ServerCapabilitiesResponse struct {
+	ServerInfo *ServerCapabilitiesServerInfo `json:"serverInfo"`
+}
+
+// GetServerInfo returns ServerCapabilitiesResponse.ServerInfo, and is useful for accessing the field via an interface.
+func (v *ServerCapabilitiesResponse) GetServerInfo() *ServerCapabilitiesServerInfo {
+	return v.ServerInfo
+}
+
+// ServerCapabilitiesServerInfo includes the requested fields of the GraphQL type ServerInfo.
+type ServerCapabilitiesServerInfo struct {
+	LatestLocalVersionInfo *ServerCapabilitiesServerInfoLatestLocalVersionInfo `json:"latestLocalVersionInfo"`
+}
+
+// GetLatestLocalVersionInfo returns ServerCapabilitiesServerInfo.LatestLocalVersionInfo, and is useful for accessing the field via an interface.
+func (v *ServerCapabilitiesServerInfo) GetLatestLocalVersionInfo() *ServerCapabilitiesServerInfoLatestLocalVersionInfo {
+	return v.LatestLocalVersionInfo
+}
+
+// ServerCapabilitiesServerInfoLatestLocalVersionInfo includes the requested fields of the GraphQL type LocalVersionInfo.
+type ServerCapabilitiesServerInfoLatestLocalVersionInfo struct {
+	VersionOnThisInstanceString string `json:"versionOnThisInstanceString"`
+}
+
+// GetVersionOnThisInstanceString returns ServerCapabilitiesServerInfoLatestLocalVersionInfo.VersionOnThisInstanceString, and is useful for accessing the field via an interface.
+func (v *ServerCapabilitiesServerInfoLatestLocalVersionInfo) GetVersionOnThisInstanceString() string {
+	return v.VersionOnThisInstanceString
+}
+
+// ServerCapabilitiesWithFeaturesResponse is returned by ServerCapabilitiesWithFeatures on success.
+type ServerCapabilitiesWithFeaturesResponse struct {
+	ServerInfo *ServerCapabilitiesWithFeaturesServerInfo `json:"serverInfo"`
+}
+
+// GetServerInfo returns ServerCapabilitiesWithFeaturesResponse.ServerInfo, and is useful for accessing the field via an interface.
+func (v *ServerCapabilitiesWithFeaturesResponse) GetServerInfo() *ServerCapabilitiesWithFeaturesServerInfo {
+	return v.ServerInfo
+}
+
+// ServerCapabilitiesWithFeaturesServerInfo includes the requested fields of the GraphQL type ServerInfo.
+type ServerCapabilitiesWithFeaturesServerInfo struct {
+	LatestLocalVersionInfo *ServerCapabilitiesWithFeaturesServerInfoLatestLocalVersionInfo  `json:"latestLocalVersionInfo"`
+	Features               []*ServerCapabilitiesWithFeaturesServerInfoFeaturesServerFeature `json:"features"`
+}
+
+// GetLatestLocalVersionInfo returns ServerCapabilitiesWithFeaturesServerInfo.LatestLocalVersionInfo, and is useful for accessing the field via an interface.
+func (v *ServerCapabilitiesWithFeaturesServerInfo) GetLatestLocalVersionInfo() *ServerCapabilitiesWithFeaturesServerInfoLatestLocalVersionInfo {
+	return v.LatestLocalVersionInfo
+}
+
+// GetFeatures returns ServerCapabilitiesWithFeaturesServerInfo.Features, and is useful for accessing the field via an interface.
+func (v *ServerCapabilitiesWithFeaturesServerInfo) GetFeatures() []*ServerCapabilitiesWithFeaturesServerInfoFeaturesServerFeature {
+	return v.Features
+}
+
+// ServerCapabilitiesWithFeaturesServerInfoFeaturesServerFeature includes the requested fields of the GraphQL type ServerFeature.
+type ServerCapabilitiesWithFeaturesServerInfoFeaturesServerFeature struct {
+	Name      string `json:"name"`
+	IsEnabled bool   `json:"isEnabled"`
+}
+
+// GetName returns ServerCapabilitiesWithFeaturesServerInfoFeaturesServerFeature.Name, and is useful for accessing the field via an interface.
+func (v *ServerCapabilitiesWithFeaturesServerInfoFeaturesServerFeature) GetName() string {
+	return v.Name
+}
+
+// GetIsEnabled returns ServerCapabilitiesWithFeaturesServerInfoFeaturesServerFeature.IsEnabled, and is useful for accessing the field via an interface.
+func (v *ServerCapabilitiesWithFeaturesServerInfoFeaturesServerFeature) GetIsEnabled() bool {
+	return v.IsEnabled
+}
+
+// ServerCapabilitiesWithFeaturesServerInfoLatestLocalVersionInfo includes the requested fields of the GraphQL type LocalVersionInfo.
+type ServerCapabilitiesWithFeaturesServerInfoLatestLocalVersionInfo struct {
+	VersionOnThisInstanceString string `json:"versionOnThisInstanceString"`
+}
+
+// GetVersionOnThisInstanceString returns ServerCapabilitiesWithFeaturesServerInfoLatestLocalVersionInfo.VersionOnThisInstanceString, and is useful for accessing the field via an interface.
+func (v *ServerCapabilitiesWithFeaturesServerInfoLatestLocalVersionInfo) GetVersionOnThisInstanceString() string {
+	return v.VersionOnThisInstanceString
+}
+
+// ServerInfoFieldsResponse is returned by ServerInfoFields on success.
+type ServerInfoFieldsResponse struct {
+	Type *ServerInfoFieldsType `json:"__type"`
+}
+
+// GetType returns ServerInfoFieldsResponse.Type, and is useful for accessing the field via an interface.
+func (v *ServerInfoFieldsResponse) GetType() *ServerInfoFieldsType { return v.Type }
+
+// ServerInfoFieldsType includes the requested fields of the GraphQL type __Type.
+type ServerInfoFieldsType struct {
+	Fields []ServerInfoFieldsTypeFieldsField `json:"fields"`
+}
+
+// GetFields returns ServerInfoFieldsType.Fields, and is useful for accessing the field via an interface.
+func (v *ServerInfoFieldsType) GetFields() []ServerInfoFieldsTypeFieldsField { return v.Fields }
+
+// ServerInfoFieldsTypeFieldsField includes the requested fields of the GraphQL type __Field.
+type ServerInfoFieldsTypeFieldsField struct {
+	Name string `json:"name"`
+}
+
+// GetName returns ServerInfoFieldsTypeFieldsField.Name, and is useful for accessing the field via an interface.
+func (v *ServerInfoFieldsTypeFieldsField) GetName() string { return v.Name }
+
 // ServerInfoResponse is returned by ServerInfo on success.
 type ServerInfoResponse struct {
 	ServerInfo *ServerInfoServerInfo `json:"serverInfo"`
@@ -705,6 +1662,26 @@ type UpdateArtifactUpdateArtifactUpdateArtifactPayloadArtifact struct {
 // GetId returns UpdateArtifactUpdateArtifactUpdateArtifactPayloadArtifact.Id, and is useful for accessing the field via an interface.
 func (v *UpdateArtifactUpdateArtifactUpdateArtifactPayloadArtifact) GetId() string { return v.Id }
 
+// UpdateLaunchAgentResponse is returned by UpdateLaunchAgent on success.
+type UpdateLaunchAgentResponse struct {
+	UpdateLaunchAgent *UpdateLaunchAgentUpdateLaunchAgentUpdateLaunchAgentPayload `json:"updateLaunchAgent"`
+}
+
+// GetUpdateLaunchAgent returns UpdateLaunchAgentResponse.UpdateLaunchAgent, and is useful for accessing the field via an interface.
+func (v *UpdateLaunchAgentResponse) GetUpdateLaunchAgent() *UpdateLaunchAgentUpdateLaunchAgentUpdateLaunchAgentPayload {
+	return v.UpdateLaunchAgent
+}
+
+// UpdateLaunchAgentUpdateLaunchAgentUpdateLaunchAgentPayload includes the requested fields of the GraphQL type UpdateLaunchAgentPayload.
+type UpdateLaunchAgentUpdateLaunchAgentUpdateLaunchAgentPayload struct {
+	Success *bool `json:"success"`
+}
+
+// GetSuccess returns UpdateLaunchAgentUpdateLaunchAgentUpdateLaunchAgentPayload.Success, and is useful for accessing the field via an interface.
+func (v *UpdateLaunchAgentUpdateLaunchAgentUpdateLaunchAgentPayload) GetSuccess() *bool {
+	return v.Success
+}
+
 type UploadPartsInput struct {
 	PartNumber int64  `json:"partNumber"`
 	HexMD5     string `json:"hexMD5"`
@@ -742,13 +1719,14 @@ func (v *UpsertBucketUpsertBucketUpsertBucketPayload) GetInserted() *bool { retu
 
 // UpsertBucketUpsertBucketUpsertBucketPayloadBucketRun includes the requested fields of the GraphQL type Run.
 type UpsertBucketUpsertBucketUpsertBucketPayloadBucketRun struct {
-	Id          string                                                       `json:"id"`
-	Name        string                                                       `json:"name"`
-	DisplayName *string                                                      `json:"displayName"`
-	Description *string                                                      `json:"description"`
-	Config      *string                                                      `json:"config"`
-	SweepName   *string                                                      `json:"sweepName"`
-	Project     *UpsertBucketUpsertBucketUpsertBucketPayloadBucketRunProject `json:"project"`
+	Id               string                                                       `json:"id"`
+	Name             string                                                       `json:"name"`
+	DisplayName      *string                                                      `json:"displayName"`
+	Description      *string                                                      `json:"description"`
+	Config           *string                                                      `json:"config"`
+	SweepName        *string                                                      `json:"sweepName"`
+	HistoryLineCount *int                                                         `json:"historyLineCount"`
+	Project          *UpsertBucketUpsertBucketUpsertBucketPayloadBucketRunProject `json:"project"`
 }
 
 // GetId returns UpsertBucketUpsertBucketUpsertBucketPayloadBucketRun.Id, and is useful for accessing the field via an interface.
@@ -775,6 +1753,11 @@ func (v *UpsertBucketUpsertBucketUpsertBucketPayloadBucketRun) GetSweepName() *s
 	return v.SweepName
 }
 
+// GetHistoryLineCount returns UpsertBucketUpsertBucketUpsertBucketPayloadBucketRun.HistoryLineCount, and is useful for accessing the field via an interface.
+func (v *UpsertBucketUpsertBucketUpsertBucketPayloadBucketRun) GetHistoryLineCount() *int {
+	return v.HistoryLineCount
+}
+
 // GetProject returns UpsertBucketUpsertBucketUpsertBucketPayloadBucketRun.Project, and is useful for accessing the field via an interface.
 func (v *UpsertBucketUpsertBucketUpsertBucketPayloadBucketRun) GetProject() *UpsertBucketUpsertBucketUpsertBucketPayloadBucketRunProject {
 	return v.Project
@@ -814,6 +1797,34 @@ func (v *UpsertBucketUpsertBucketUpsertBucketPayloadBucketRunProjectEntity) GetN
 	return v.Name
 }
 
+// UpsertViewResponse is returned by UpsertView on success.
+type UpsertViewResponse struct {
+	UpsertView *UpsertViewUpsertViewUpsertViewPayload `json:"upsertView"`
+}
+
+// GetUpsertView returns UpsertViewResponse.UpsertView, and is useful for accessing the field via an interface.
+func (v *UpsertViewResponse) GetUpsertView() *UpsertViewUpsertViewUpsertViewPayload {
+	return v.UpsertView
+}
+
+// UpsertViewUpsertViewUpsertViewPayload includes the requested fields of the GraphQL type UpsertViewPayload.
+type UpsertViewUpsertViewUpsertViewPayload struct {
+	View *UpsertViewUpsertViewUpsertViewPayloadView `json:"view"`
+}
+
+// GetView returns UpsertViewUpsertViewUpsertViewPayload.View, and is useful for accessing the field via an interface.
+func (v *UpsertViewUpsertViewUpsertViewPayload) GetView() *UpsertViewUpsertViewUpsertViewPayloadView {
+	return v.View
+}
+
+// UpsertViewUpsertViewUpsertViewPayloadView includes the requested fields of the GraphQL type View.
+type UpsertViewUpsertViewUpsertViewPayloadView struct {
+	Id string `json:"id"`
+}
+
+// GetId returns UpsertViewUpsertViewUpsertViewPayloadView.Id, and is useful for accessing the field via an interface.
+func (v *UpsertViewUpsertViewUpsertViewPayloadView) GetId() string { return v.Id }
+
 // UseArtifactResponse is returned by UseArtifact on success.
 type UseArtifactResponse struct {
 	UseArtifact *UseArtifactUseArtifactUseArtifactPayload `json:"useArtifact"`
@@ -842,6 +1853,34 @@ type UseArtifactUseArtifactUseArtifactPayloadArtifact struct {
 // GetId returns UseArtifactUseArtifactUseArtifactPayloadArtifact.Id, and is useful for accessing the field via an interface.
 func (v *UseArtifactUseArtifactUseArtifactPayloadArtifact) GetId() string { return v.Id }
 
+// ViewerDefaultSettingsResponse is returned by ViewerDefaultSettings on success.
+type ViewerDefaultSettingsResponse struct {
+	Viewer *ViewerDefaultSettingsViewerUser `json:"viewer"`
+}
+
+// GetViewer returns ViewerDefaultSettingsResponse.Viewer, and is useful for accessing the field via an interface.
+func (v *ViewerDefaultSettingsResponse) GetViewer() *ViewerDefaultSettingsViewerUser { return v.Viewer }
+
+// ViewerDefaultSettingsViewerUser includes the requested fields of the GraphQL type User.
+type ViewerDefaultSettingsViewerUser struct {
+	DefaultEntity *ViewerDefaultSettingsViewerUserDefaultEntity `json:"defaultEntity"`
+}
+
+// GetDefaultEntity returns ViewerDefaultSettingsViewerUser.DefaultEntity, and is useful for accessing the field via an interface.
+func (v *ViewerDefaultSettingsViewerUser) GetDefaultEntity() *ViewerDefaultSettingsViewerUserDefaultEntity {
+	return v.DefaultEntity
+}
+
+// ViewerDefaultSettingsViewerUserDefaultEntity includes the requested fields of the GraphQL type Entity.
+type ViewerDefaultSettingsViewerUserDefaultEntity struct {
+	SdkDefaultSettings *string `json:"sdkDefaultSettings"`
+}
+
+// GetSdkDefaultSettings returns ViewerDefaultSettingsViewerUserDefaultEntity.SdkDefaultSettings, and is useful for accessing the field via an interface.
+func (v *ViewerDefaultSettingsViewerUserDefaultEntity) GetSdkDefaultSettings() *string {
+	return v.SdkDefaultSettings
+}
+
 // ViewerResponse is returned by Viewer on success.
 type ViewerResponse struct {
 	Viewer *ViewerViewerUser `json:"viewer"`
@@ -900,6 +1939,42 @@ func (v *ViewerViewerUserTeamsEntityConnectionEdgesEntityEdgeNodeEntity) GetName
 	return v.Name
 }
 
+// __AckRunQueueItemInput is used internally by genqlient
+type __AckRunQueueItemInput struct {
+	ItemId string `json:"itemId"`
+	RunId  string `json:"runId"`
+}
+
+// GetItemId returns __AckRunQueueItemInput.ItemId, and is useful for accessing the field via an interface.
+func (v *__AckRunQueueItemInput) GetItemId() string { return v.ItemId }
+
+// GetRunId returns __AckRunQueueItemInput.RunId, and is useful for accessing the field via an interface.
+func (v *__AckRunQueueItemInput) GetRunId() string { return v.RunId }
+
+// __ArtifactCommitStateInput is used internally by genqlient
+type __ArtifactCommitStateInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __ArtifactCommitStateInput.Id, and is useful for accessing the field via an interface.
+func (v *__ArtifactCommitStateInput) GetId() string { return v.Id }
+
+// __ArtifactFileURLsByNamesInput is used internally by genqlient
+type __ArtifactFileURLsByNamesInput struct {
+	Id      string   `json:"id"`
+	Names   []string `json:"names"`
+	PerPage *int     `json:"perPage"`
+}
+
+// GetId returns __ArtifactFileURLsByNamesInput.Id, and is useful for accessing the field via an interface.
+func (v *__ArtifactFileURLsByNamesInput) GetId() string { return v.Id }
+
+// GetNames returns __ArtifactFileURLsByNamesInput.Names, and is useful for accessing the field via an interface.
+func (v *__ArtifactFileURLsByNamesInput) GetNames() []string { return v.Names }
+
+// GetPerPage returns __ArtifactFileURLsByNamesInput.PerPage, and is useful for accessing the field via an interface.
+func (v *__ArtifactFileURLsByNamesInput) GetPerPage() *int { return v.PerPage }
+
 // __ArtifactFileURLsInput is used internally by genqlient
 type __ArtifactFileURLsInput struct {
 	Id      string  `json:"id"`
@@ -916,6 +1991,22 @@ func (v *__ArtifactFileURLsInput) GetCursor() *string { return v.Cursor }
 // GetPerPage returns __ArtifactFileURLsInput.PerPage, and is useful for accessing the field via an interface.
 func (v *__ArtifactFileURLsInput) GetPerPage() *int { return v.PerPage }
 
+// __ArtifactIDByNameInput is used internally by genqlient
+type __ArtifactIDByNameInput struct {
+	Entity  string `json:"entity"`
+	Project string `json:"project"`
+	Name    string `json:"name"`
+}
+
+// GetEntity returns __ArtifactIDByNameInput.Entity, and is useful for accessing the field via an interface.
+func (v *__ArtifactIDByNameInput) GetEntity() string { return v.Entity }
+
+// GetProject returns __ArtifactIDByNameInput.Project, and is useful for accessing the field via an interface.
+func (v *__ArtifactIDByNameInput) GetProject() string { return v.Project }
+
+// GetName returns __ArtifactIDByNameInput.Name, and is useful for accessing the field via an interface.
+func (v *__ArtifactIDByNameInput) GetName() string { return v.Name }
+
 // __ArtifactManifestInput is used internally by genqlient
 type __ArtifactManifestInput struct {
 	Artifact_id string `json:"artifact_id"`
@@ -1054,6 +2145,26 @@ func (v *__CreateArtifactManifestInput) GetManifestType() ArtifactManifestType {
 // GetIncludeUpload returns __CreateArtifactManifestInput.IncludeUpload, and is useful for accessing the field via an interface.
 func (v *__CreateArtifactManifestInput) GetIncludeUpload() bool { return v.IncludeUpload }
 
+// __CreateLaunchAgentInput is used internally by genqlient
+type __CreateLaunchAgentInput struct {
+	Entity   string   `json:"entity"`
+	Project  string   `json:"project"`
+	Queues   []string `json:"queues"`
+	Hostname string   `json:"hostname"`
+}
+
+// GetEntity returns __CreateLaunchAgentInput.Entity, and is useful for accessing the field via an interface.
+func (v *__CreateLaunchAgentInput) GetEntity() string { return v.Entity }
+
+// GetProject returns __CreateLaunchAgentInput.Project, and is useful for accessing the field via an interface.
+func (v *__CreateLaunchAgentInput) GetProject() string { return v.Project }
+
+// GetQueues returns __CreateLaunchAgentInput.Queues, and is useful for accessing the field via an interface.
+func (v *__CreateLaunchAgentInput) GetQueues() []string { return v.Queues }
+
+// GetHostname returns __CreateLaunchAgentInput.Hostname, and is useful for accessing the field via an interface.
+func (v *__CreateLaunchAgentInput) GetHostname() string { return v.Hostname }
+
 // __CreateRunFilesInput is used internally by genqlient
 type __CreateRunFilesInput struct {
 	Entity  string   `json:"entity"`
@@ -1074,6 +2185,38 @@ func (v *__CreateRunFilesInput) GetRun() string { return v.Run }
 // GetFiles returns __CreateRunFilesInput.Files, and is useful for accessing the field via an interface.
 func (v *__CreateRunFilesInput) GetFiles() []string { return v.Files }
 
+// __EntityDefaultSettingsInput is used internally by genqlient
+type __EntityDefaultSettingsInput struct {
+	Entity string `json:"entity"`
+}
+
+// GetEntity returns __EntityDefaultSettingsInput.Entity, and is useful for accessing the field via an interface.
+func (v *__EntityDefaultSettingsInput) GetEntity() string { return v.Entity }
+
+// __FailRunQueueItemInput is used internally by genqlient
+type __FailRunQueueItemInput struct {
+	RunQueueItemId string `json:"runQueueItemId"`
+	Message        string `json:"message"`
+	Stage          string `json:"stage"`
+}
+
+// GetRunQueueItemId returns __FailRunQueueItemInput.RunQueueItemId, and is useful for accessing the field via an interface.
+func (v *__FailRunQueueItemInput) GetRunQueueItemId() string { return v.RunQueueItemId }
+
+// GetMessage returns __FailRunQueueItemInput.Message, and is useful for accessing the field via an interface.
+func (v *__FailRunQueueItemInput) GetMessage() string { return v.Message }
+
+// GetStage returns __FailRunQueueItemInput.Stage, and is useful for accessing the field via an interface.
+func (v *__FailRunQueueItemInput) GetStage() string { return v.Stage }
+
+// __LaunchAgentInput is used internally by genqlient
+type __LaunchAgentInput struct {
+	AgentId string `json:"agentId"`
+}
+
+// GetAgentId returns __LaunchAgentInput.AgentId, and is useful for accessing the field via an interface.
+func (v *__LaunchAgentInput) GetAgentId() string { return v.AgentId }
+
 // __LinkArtifactInput is used internally by genqlient
 type __LinkArtifactInput struct {
 	ArtifactPortfolioName string               `json:"artifactPortfolioName"`
@@ -1134,6 +2277,158 @@ func (v *__NotifyScriptableRunAlertInput) GetSeverity() *AlertSeverity { return
 // GetWaitDuration returns __NotifyScriptableRunAlertInput.WaitDuration, and is useful for accessing the field via an interface.
 func (v *__NotifyScriptableRunAlertInput) GetWaitDuration() *int64 { return v.WaitDuration }
 
+// __PopFromRunQueueInput is used internally by genqlient
+type __PopFromRunQueueInput struct {
+	Entity        string  `json:"entity"`
+	Project       string  `json:"project"`
+	QueueName     string  `json:"queueName"`
+	LaunchAgentId *string `json:"launchAgentId"`
+}
+
+// GetEntity returns __PopFromRunQueueInput.Entity, and is useful for accessing the field via an interface.
+func (v *__PopFromRunQueueInput) GetEntity() string { return v.Entity }
+
+// GetProject returns __PopFromRunQueueInput.Project, and is useful for accessing the field via an interface.
+func (v *__PopFromRunQueueInput) GetProject() string { return v.Project }
+
+// GetQueueName returns __PopFromRunQueueInput.QueueName, and is useful for accessing the field via an interface.
+func (v *__PopFromRunQueueInput) GetQueueName() string { return v.QueueName }
+
+// GetLaunchAgentId returns __PopFromRunQueueInput.LaunchAgentId, and is useful for accessing the field via an interface.
+func (v *__PopFromRunQueueInput) GetLaunchAgentId() *string { return v.LaunchAgentId }
+
+// __ProjectRunQueuesInput is used internally by genqlient
+type __ProjectRunQueuesInput struct {
+	Entity      string `json:"entity"`
+	ProjectName string `json:"projectName"`
+}
+
+// GetEntity returns __ProjectRunQueuesInput.Entity, and is useful for accessing the field via an interface.
+func (v *__ProjectRunQueuesInput) GetEntity() string { return v.Entity }
+
+// GetProjectName returns __ProjectRunQueuesInput.ProjectName, and is useful for accessing the field via an interface.
+func (v *__ProjectRunQueuesInput) GetProjectName() string { return v.ProjectName }
+
+// __ProjectsInput is used internally by genqlient
+type __ProjectsInput struct {
+	Entity  string  `json:"entity"`
+	Cursor  *string `json:"cursor"`
+	PerPage int     `json:"perPage"`
+}
+
+// GetEntity returns __ProjectsInput.Entity, and is useful for accessing the field via an interface.
+func (v *__ProjectsInput) GetEntity() string { return v.Entity }
+
+// GetCursor returns __ProjectsInput.Cursor, and is useful for accessing the field via an interface.
+func (v *__ProjectsInput) GetCursor() *string { return v.Cursor }
+
+// GetPerPage returns __ProjectsInput.PerPage, and is useful for accessing the field via an interface.
+func (v *__ProjectsInput) GetPerPage() int { return v.PerPage }
+
+// __RunHistoryInput is used internally by genqlient
+type __RunHistoryInput struct {
+	Entity  string `json:"entity"`
+	Project string `json:"project"`
+	Run     string `json:"run"`
+	Samples int    `json:"samples"`
+	MinStep *int64 `json:"minStep"`
+	MaxStep *int64 `json:"maxStep"`
+}
+
+// GetEntity returns __RunHistoryInput.Entity, and is useful for accessing the field via an interface.
+func (v *__RunHistoryInput) GetEntity() string { return v.Entity }
+
+// GetProject returns __RunHistoryInput.Project, and is useful for accessing the field via an interface.
+func (v *__RunHistoryInput) GetProject() string { return v.Project }
+
+// GetRun returns __RunHistoryInput.Run, and is useful for accessing the field via an interface.
+func (v *__RunHistoryInput) GetRun() string { return v.Run }
+
+// GetSamples returns __RunHistoryInput.Samples, and is useful for accessing the field via an interface.
+func (v *__RunHistoryInput) GetSamples() int { return v.Samples }
+
+// GetMinStep returns __RunHistoryInput.MinStep, and is useful for accessing the field via an interface.
+func (v *__RunHistoryInput) GetMinStep() *int64 { return v.MinStep }
+
+// GetMaxStep returns __RunHistoryInput.MaxStep, and is useful for accessing the field via an interface.
+func (v *__RunHistoryInput) GetMaxStep() *int64 { return v.MaxStep }
+
+// __RunHistoryPageInput is used internally by genqlient
+type __RunHistoryPageInput struct {
+	Entity  string `json:"entity"`
+	Project string `json:"project"`
+	Run     string `json:"run"`
+	MinStep int64  `json:"minStep"`
+	MaxStep int64  `json:"maxStep"`
+	Samples int    `json:"samples"`
+}
+
+// GetEntity returns __RunHistoryPageInput.Entity, and is useful for accessing the field via an interface.
+func (v *__RunHistoryPageInput) GetEntity() string { return v.Entity }
+
+// GetProject returns __RunHistoryPageInput.Project, and is useful for accessing the field via an interface.
+func (v *__RunHistoryPageInput) GetProject() string { return v.Project }
+
+// GetRun returns __RunHistoryPageInput.Run, and is useful for accessing the field via an interface.
+func (v *__RunHistoryPageInput) GetRun() string { return v.Run }
+
+// GetMinStep returns __RunHistoryPageInput.MinStep, and is useful for accessing the field via an interface.
+func (v *__RunHistoryPageInput) GetMinStep() int64 { return v.MinStep }
+
+// GetMaxStep returns __RunHistoryPageInput.MaxStep, and is useful for accessing the field via an interface.
+func (v *__RunHistoryPageInput) GetMaxStep() int64 { return v.MaxStep }
+
+// GetSamples returns __RunHistoryPageInput.Samples, and is useful for accessing the field via an interface.
+func (v *__RunHistoryPageInput) GetSamples() int { return v.Samples }
+
+// __RunInput is used internally by genqlient
+type __RunInput struct {
+	Entity  string `json:"entity"`
+	Project string `json:"project"`
+	Run     string `json:"run"`
+}
+
+// GetEntity returns __RunInput.Entity, and is useful for accessing the field via an interface.
+func (v *__RunInput) GetEntity() string { return v.Entity }
+
+// GetProject returns __RunInput.Project, and is useful for accessing the field via an interface.
+func (v *__RunInput) GetProject() string { return v.Project }
+
+// GetRun returns __RunInput.Run, and is useful for accessing the field via an interface.
+func (v *__RunInput) GetRun() string { return v.Run }
+
+// __RunLastStepInput is used internally by genqlient
+type __RunLastStepInput struct {
+	Entity  string `json:"entity"`
+	Project string `json:"project"`
+	Run     string `json:"run"`
+}
+
+// GetEntity returns __RunLastStepInput.Entity, and is useful for accessing the field via an interface.
+func (v *__RunLastStepInput) GetEntity() string { return v.Entity }
+
+// GetProject returns __RunLastStepInput.Project, and is useful for accessing the field via an interface.
+func (v *__RunLastStepInput) GetProject() string { return v.Project }
+
+// GetRun returns __RunLastStepInput.Run, and is useful for accessing the field via an interface.
+func (v *__RunLastStepInput) GetRun() string { return v.Run }
+
+// __RunNotesInput is used internally by genqlient
+type __RunNotesInput struct {
+	Entity  string `json:"entity"`
+	Project string `json:"project"`
+	Name    string `json:"name"`
+}
+
+// GetEntity returns __RunNotesInput.Entity, and is useful for accessing the field via an interface.
+func (v *__RunNotesInput) GetEntity() string { return v.Entity }
+
+// GetProject returns __RunNotesInput.Project, and is useful for accessing the field via an interface.
+func (v *__RunNotesInput) GetProject() string { return v.Project }
+
+// GetName returns __RunNotesInput.Name, and is useful for accessing the field via an interface.
+func (v *__RunNotesInput) GetName() string { return v.Name }
+
 // __RunResumeStatusInput is used internally by genqlient
 type __RunResumeStatusInput struct {
 	Project *string `json:"project"`
@@ -1150,10 +2445,30 @@ func (v *__RunResumeStatusInput) GetEntity() *string { return v.Entity }
 // GetName returns __RunResumeStatusInput.Name, and is useful for accessing the field via an interface.
 func (v *__RunResumeStatusInput) GetName() string { return v.Name }
 
-// __RunStoppedStatusInput is used internally by genqlient
-type __RunStoppedStatusInput struct {
-	EntityName  *string `json:"entityName"`
-	ProjectName *string `json:"projectName"`
+// __RunSampledHistoryInput is used internally by genqlient
+type __RunSampledHistoryInput struct {
+	Entity  string   `json:"entity"`
+	Project string   `json:"project"`
+	Run     string   `json:"run"`
+	Specs   []string `json:"specs"`
+}
+
+// GetEntity returns __RunSampledHistoryInput.Entity, and is useful for accessing the field via an interface.
+func (v *__RunSampledHistoryInput) GetEntity() string { return v.Entity }
+
+// GetProject returns __RunSampledHistoryInput.Project, and is useful for accessing the field via an interface.
+func (v *__RunSampledHistoryInput) GetProject() string { return v.Project }
+
+// GetRun returns __RunSampledHistoryInput.Run, and is useful for accessing the field via an interface.
+func (v *__RunSampledHistoryInput) GetRun() string { return v.Run }
+
+// GetSpecs returns __RunSampledHistoryInput.Specs, and is useful for accessing the field via an interface.
+func (v *__RunSampledHistoryInput) GetSpecs() []string { return v.Specs }
+
+// __RunStoppedStatusInput is used internally by genqlient
+type __RunStoppedStatusInput struct {
+	EntityName  *string `json:"entityName"`
+	ProjectName *string `json:"projectName"`
 	RunId       string  `json:"runId"`
 }
 
@@ -1166,6 +2481,34 @@ func (v *__RunStoppedStatusInput) GetProjectName() *string { return v.ProjectNam
 // GetRunId returns __RunStoppedStatusInput.RunId, and is useful for accessing the field via an interface.
 func (v *__RunStoppedStatusInput) GetRunId() string { return v.RunId }
 
+// __RunsInput is used internally by genqlient
+type __RunsInput struct {
+	Entity  string  `json:"entity"`
+	Project string  `json:"project"`
+	Filters *string `json:"filters"`
+	Order   *string `json:"order"`
+	Cursor  *string `json:"cursor"`
+	PerPage int     `json:"perPage"`
+}
+
+// GetEntity returns __RunsInput.Entity, and is useful for accessing the field via an interface.
+func (v *__RunsInput) GetEntity() string { return v.Entity }
+
+// GetProject returns __RunsInput.Project, and is useful for accessing the field via an interface.
+func (v *__RunsInput) GetProject() string { return v.Project }
+
+// GetFilters returns __RunsInput.Filters, and is useful for accessing the field via an interface.
+func (v *__RunsInput) GetFilters() *string { return v.Filters }
+
+// GetOrder returns __RunsInput.Order, and is useful for accessing the field via an interface.
+func (v *__RunsInput) GetOrder() *string { return v.Order }
+
+// GetCursor returns __RunsInput.Cursor, and is useful for accessing the field via an interface.
+func (v *__RunsInput) GetCursor() *string { return v.Cursor }
+
+// GetPerPage returns __RunsInput.PerPage, and is useful for accessing the field via an interface.
+func (v *__RunsInput) GetPerPage() int { return v.PerPage }
+
 // __UpdateArtifactInput is used internally by genqlient
 type __UpdateArtifactInput struct {
 	ArtifactID string  `json:"artifactID"`
@@ -1178,6 +2521,18 @@ func (v *__UpdateArtifactInput) GetArtifactID() string { return v.ArtifactID }
 // GetMetadata returns __UpdateArtifactInput.Metadata, and is useful for accessing the field via an interface.
 func (v *__UpdateArtifactInput) GetMetadata() *string { return v.Metadata }
 
+// __UpdateLaunchAgentInput is used internally by genqlient
+type __UpdateLaunchAgentInput struct {
+	AgentId     string  `json:"agentId"`
+	AgentStatus *string `json:"agentStatus"`
+}
+
+// GetAgentId returns __UpdateLaunchAgentInput.AgentId, and is useful for accessing the field via an interface.
+func (v *__UpdateLaunchAgentInput) GetAgentId() string { return v.AgentId }
+
+// GetAgentStatus returns __UpdateLaunchAgentInput.AgentStatus, and is useful for accessing the field via an interface.
+func (v *__UpdateLaunchAgentInput) GetAgentStatus() *string { return v.AgentStatus }
+
 // __UpsertBucketInput is used internally by genqlient
 type __UpsertBucketInput struct {
 	Id             *string  `json:"id"`
@@ -1258,6 +2613,38 @@ func (v *__UpsertBucketInput) GetTags() []string { return v.Tags }
 // GetSummaryMetrics returns __UpsertBucketInput.SummaryMetrics, and is useful for accessing the field via an interface.
 func (v *__UpsertBucketInput) GetSummaryMetrics() *string { return v.SummaryMetrics }
 
+// __UpsertViewInput is used internally by genqlient
+type __UpsertViewInput struct {
+	EntityName  string  `json:"entityName"`
+	ProjectName string  `json:"projectName"`
+	Name        string  `json:"name"`
+	DisplayName string  `json:"displayName"`
+	Description *string `json:"description"`
+	ViewType    string  `json:"viewType"`
+	Spec        string  `json:"spec"`
+}
+
+// GetEntityName returns __UpsertViewInput.EntityName, and is useful for accessing the field via an interface.
+func (v *__UpsertViewInput) GetEntityName() string { return v.EntityName }
+
+// GetProjectName returns __UpsertViewInput.ProjectName, and is useful for accessing the field via an interface.
+func (v *__UpsertViewInput) GetProjectName() string { return v.ProjectName }
+
+// GetName returns __UpsertViewInput.Name, and is useful for accessing the field via an interface.
+func (v *__UpsertViewInput) GetName() string { return v.Name }
+
+// GetDisplayName returns __UpsertViewInput.DisplayName, and is useful for accessing the field via an interface.
+func (v *__UpsertViewInput) GetDisplayName() string { return v.DisplayName }
+
+// GetDescription returns __UpsertViewInput.Description, and is useful for accessing the field via an interface.
+func (v *__UpsertViewInput) GetDescription() *string { return v.Description }
+
+// GetViewType returns __UpsertViewInput.ViewType, and is useful for accessing the field via an interface.
+func (v *__UpsertViewInput) GetViewType() string { return v.ViewType }
+
+// GetSpec returns __UpsertViewInput.Spec, and is useful for accessing the field via an interface.
+func (v *__UpsertViewInput) GetSpec() string { return v.Spec }
+
 // __UseArtifactInput is used internally by genqlient
 type __UseArtifactInput struct {
 	EntityName  string `json:"entityName"`
@@ -1278,6 +2665,79 @@ func (v *__UseArtifactInput) GetRunName() string { return v.RunName }
 // GetArtifactID returns __UseArtifactInput.ArtifactID, and is useful for accessing the field via an interface.
 func (v *__UseArtifactInput) GetArtifactID() string { return v.ArtifactID }
 
+// The query or mutation executed by AckRunQueueItem.
+const AckRunQueueItem_Operation = `
+mutation AckRunQueueItem ($itemId: ID!, $runId: String!) {
+	ackRunQueueItem(input: {runQueueItemId:$itemId,runName:$runId}) {
+		success
+	}
+}
+`
+
+func AckRunQueueItem(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	itemId string,
+	runId string,
+) (*AckRunQueueItemResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "AckRunQueueItem",
+		Query:  AckRunQueueItem_Operation,
+		Variables: &__AckRunQueueItemInput{
+			ItemId: itemId,
+			RunId:  runId,
+		},
+	}
+	var err_ error
+
+	var data_ AckRunQueueItemResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by ArtifactCommitState.
+const ArtifactCommitState_Operation = `
+query ArtifactCommitState ($id: ID!) {
+	artifact(id: $id) {
+		id
+		state
+	}
+}
+`
+
+func ArtifactCommitState(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+) (*ArtifactCommitStateResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "ArtifactCommitState",
+		Query:  ArtifactCommitState_Operation,
+		Variables: &__ArtifactCommitStateInput{
+			Id: id,
+		},
+	}
+	var err_ error
+
+	var data_ ArtifactCommitStateResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by ArtifactFileURLs.
 const ArtifactFileURLs_Operation = `
 query ArtifactFileURLs ($id: ID!, $cursor: String, $perPage: Int) {
@@ -1328,6 +2788,93 @@ func ArtifactFileURLs(
 	return &data_, err_
 }
 
+// The query or mutation executed by ArtifactFileURLsByNames.
+const ArtifactFileURLsByNames_Operation = `
+query ArtifactFileURLsByNames ($id: ID!, $names: [String!], $perPage: Int) {
+	artifact(id: $id) {
+		files(names: $names, first: $perPage) {
+			edges {
+				node {
+					name
+					directUrl
+				}
+			}
+		}
+	}
+}
+`
+
+func ArtifactFileURLsByNames(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+	names []string,
+	perPage *int,
+) (*ArtifactFileURLsByNamesResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "ArtifactFileURLsByNames",
+		Query:  ArtifactFileURLsByNames_Operation,
+		Variables: &__ArtifactFileURLsByNamesInput{
+			Id:      id,
+			Names:   names,
+			PerPage: perPage,
+		},
+	}
+	var err_ error
+
+	var data_ ArtifactFileURLsByNamesResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by ArtifactIDByName.
+const ArtifactIDByName_Operation = `
+query ArtifactIDByName ($entity: String!, $project: String!, $name: String!) {
+	project(name: $project, entityName: $entity) {
+		artifact(name: $name) {
+			id
+		}
+	}
+}
+`
+
+func ArtifactIDByName(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	entity string,
+	project string,
+	name string,
+) (*ArtifactIDByNameResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "ArtifactIDByName",
+		Query:  ArtifactIDByName_Operation,
+		Variables: &__ArtifactIDByNameInput{
+			Entity:  entity,
+			Project: project,
+			Name:    name,
+		},
+	}
+	var err_ error
+
+	var data_ ArtifactIDByNameResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by ArtifactManifest.
 const ArtifactManifest_Operation = `
 query ArtifactManifest ($artifact_id: ID!) {
@@ -1440,6 +2987,39 @@ func CommitArtifact(
 	return &data_, err_
 }
 
+// The query or mutation executed by CreateAnonymousApiKey.
+const CreateAnonymousApiKey_Operation = `
+mutation CreateAnonymousApiKey {
+	createAnonymousEntity(input: {}) {
+		apiKey {
+			name
+		}
+	}
+}
+`
+
+func CreateAnonymousApiKey(
+	ctx_ context.Context,
+	client_ graphql.Client,
+) (*CreateAnonymousApiKeyResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "CreateAnonymousApiKey",
+		Query:  CreateAnonymousApiKey_Operation,
+	}
+	var err_ error
+
+	var data_ CreateAnonymousApiKeyResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by CreateArtifact.
 const CreateArtifact_Operation = `
 mutation CreateArtifact ($entityName: String!, $projectName: String!, $artifactTypeName: String!, $artifactCollectionName: String!, $runName: String, $digest: String!, $description: String, $aliases: [ArtifactAliasInput!], $metadata: JSONString, $ttlDurationSeconds: Int64, $historyStep: Int64, $distributedID: String, $clientID: ID!, $sequenceClientID: ID!) {
@@ -1614,6 +3194,47 @@ func CreateArtifactManifest(
 	return &data_, err_
 }
 
+// The query or mutation executed by CreateLaunchAgent.
+const CreateLaunchAgent_Operation = `
+mutation CreateLaunchAgent ($entity: String!, $project: String!, $queues: [ID!]!, $hostname: String!) {
+	createLaunchAgent(input: {entityName:$entity,projectName:$project,runQueues:$queues,hostname:$hostname}) {
+		launchAgentId
+	}
+}
+`
+
+func CreateLaunchAgent(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	entity string,
+	project string,
+	queues []string,
+	hostname string,
+) (*CreateLaunchAgentResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "CreateLaunchAgent",
+		Query:  CreateLaunchAgent_Operation,
+		Variables: &__CreateLaunchAgentInput{
+			Entity:   entity,
+			Project:  project,
+			Queues:   queues,
+			Hostname: hostname,
+		},
+	}
+	var err_ error
+
+	var data_ CreateLaunchAgentResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by CreateRunFiles.
 const CreateRunFiles_Operation = `
 mutation CreateRunFiles ($entity: String!, $project: String!, $run: String!, $files: [String!]!) {
@@ -1660,40 +3281,30 @@ func CreateRunFiles(
 	return &data_, err_
 }
 
-// The query or mutation executed by LinkArtifact.
-const LinkArtifact_Operation = `
-mutation LinkArtifact ($artifactPortfolioName: String!, $entityName: String!, $projectName: String!, $aliases: [ArtifactAliasInput!], $clientId: ID, $artifactId: ID) {
-	linkArtifact(input: {artifactPortfolioName:$artifactPortfolioName,entityName:$entityName,projectName:$projectName,aliases:$aliases,artifactID:$artifactId,clientID:$clientId}) {
-		versionIndex
+// The query or mutation executed by EntityDefaultSettings.
+const EntityDefaultSettings_Operation = `
+query EntityDefaultSettings ($entity: String!) {
+	entity(name: $entity) {
+		sdkDefaultSettings
 	}
 }
 `
 
-func LinkArtifact(
+func EntityDefaultSettings(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	artifactPortfolioName string,
-	entityName string,
-	projectName string,
-	aliases []ArtifactAliasInput,
-	clientId *string,
-	artifactId *string,
-) (*LinkArtifactResponse, error) {
+	entity string,
+) (*EntityDefaultSettingsResponse, error) {
 	req_ := &graphql.Request{
-		OpName: "LinkArtifact",
-		Query:  LinkArtifact_Operation,
-		Variables: &__LinkArtifactInput{
-			ArtifactPortfolioName: artifactPortfolioName,
-			EntityName:            entityName,
-			ProjectName:           projectName,
-			Aliases:               aliases,
-			ClientId:              clientId,
-			ArtifactId:            artifactId,
+		OpName: "EntityDefaultSettings",
+		Query:  EntityDefaultSettings_Operation,
+		Variables: &__EntityDefaultSettingsInput{
+			Entity: entity,
 		},
 	}
 	var err_ error
 
-	var data_ LinkArtifactResponse
+	var data_ EntityDefaultSettingsResponse
 	resp_ := &graphql.Response{Data: &data_}
 
 	err_ = client_.MakeRequest(
@@ -1705,42 +3316,34 @@ func LinkArtifact(
 	return &data_, err_
 }
 
-// The query or mutation executed by NotifyScriptableRunAlert.
-const NotifyScriptableRunAlert_Operation = `
-mutation NotifyScriptableRunAlert ($entityName: String!, $projectName: String!, $runName: String!, $title: String!, $text: String!, $severity: AlertSeverity = INFO, $waitDuration: Duration) {
-	notifyScriptableRunAlert(input: {entityName:$entityName,projectName:$projectName,runName:$runName,title:$title,text:$text,severity:$severity,waitDuration:$waitDuration}) {
+// The query or mutation executed by FailRunQueueItem.
+const FailRunQueueItem_Operation = `
+mutation FailRunQueueItem ($runQueueItemId: ID!, $message: String!, $stage: String!) {
+	failRunQueueItem(input: {runQueueItemId:$runQueueItemId,message:$message,stage:$stage}) {
 		success
 	}
 }
 `
 
-func NotifyScriptableRunAlert(
+func FailRunQueueItem(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	entityName string,
-	projectName string,
-	runName string,
-	title string,
-	text string,
-	severity *AlertSeverity,
-	waitDuration *int64,
-) (*NotifyScriptableRunAlertResponse, error) {
+	runQueueItemId string,
+	message string,
+	stage string,
+) (*FailRunQueueItemResponse, error) {
 	req_ := &graphql.Request{
-		OpName: "NotifyScriptableRunAlert",
-		Query:  NotifyScriptableRunAlert_Operation,
-		Variables: &__NotifyScriptableRunAlertInput{
-			EntityName:   entityName,
-			ProjectName:  projectName,
-			RunName:      runName,
-			Title:        title,
-			Text:         text,
-			Severity:     severity,
-			WaitDuration: waitDuration,
+		OpName: "FailRunQueueItem",
+		Query:  FailRunQueueItem_Operation,
+		Variables: &__FailRunQueueItemInput{
+			RunQueueItemId: runQueueItemId,
+			Message:        message,
+			Stage:          stage,
 		},
 	}
 	var err_ error
 
-	var data_ NotifyScriptableRunAlertResponse
+	var data_ FailRunQueueItemResponse
 	resp_ := &graphql.Response{Data: &data_}
 
 	err_ = client_.MakeRequest(
@@ -1752,19 +3355,514 @@ func NotifyScriptableRunAlert(
 	return &data_, err_
 }
 
-// The query or mutation executed by RunResumeStatus.
-const RunResumeStatus_Operation = `
-query RunResumeStatus ($project: String, $entity: String, $name: String!) {
-	model(name: $project, entityName: $entity) {
-		id
-		name
-		entity {
-			id
-			name
-		}
-		bucket(name: $name, missingOk: true) {
-			id
-			name
+// The query or mutation executed by LaunchAgent.
+const LaunchAgent_Operation = `
+query LaunchAgent ($agentId: ID!) {
+	launchAgent(id: $agentId) {
+		stopPolling
+	}
+}
+`
+
+func LaunchAgent(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	agentId string,
+) (*LaunchAgentResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "LaunchAgent",
+		Query:  LaunchAgent_Operation,
+		Variables: &__LaunchAgentInput{
+			AgentId: agentId,
+		},
+	}
+	var err_ error
+
+	var data_ LaunchAgentResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by LinkArtifact.
+const LinkArtifact_Operation = `
+mutation LinkArtifact ($artifactPortfolioName: String!, $entityName: String!, $projectName: String!, $aliases: [ArtifactAliasInput!], $clientId: ID, $artifactId: ID) {
+	linkArtifact(input: {artifactPortfolioName:$artifactPortfolioName,entityName:$entityName,projectName:$projectName,aliases:$aliases,artifactID:$artifactId,clientID:$clientId}) {
+		versionIndex
+	}
+}
+`
+
+func LinkArtifact(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	artifactPortfolioName string,
+	entityName string,
+	projectName string,
+	aliases []ArtifactAliasInput,
+	clientId *string,
+	artifactId *string,
+) (*LinkArtifactResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "LinkArtifact",
+		Query:  LinkArtifact_Operation,
+		Variables: &__LinkArtifactInput{
+			ArtifactPortfolioName: artifactPortfolioName,
+			EntityName:            entityName,
+			ProjectName:           projectName,
+			Aliases:               aliases,
+			ClientId:              clientId,
+			ArtifactId:            artifactId,
+		},
+	}
+	var err_ error
+
+	var data_ LinkArtifactResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by NotifyScriptableRunAlert.
+const NotifyScriptableRunAlert_Operation = `
+mutation NotifyScriptableRunAlert ($entityName: String!, $projectName: String!, $runName: String!, $title: String!, $text: String!, $severity: AlertSeverity = INFO, $waitDuration: Duration) {
+	notifyScriptableRunAlert(input: {entityName:$entityName,projectName:$projectName,runName:$runName,title:$title,text:$text,severity:$severity,waitDuration:$waitDuration}) {
+		success
+	}
+}
+`
+
+func NotifyScriptableRunAlert(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	entityName string,
+	projectName string,
+	runName string,
+	title string,
+	text string,
+	severity *AlertSeverity,
+	waitDuration *int64,
+) (*NotifyScriptableRunAlertResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "NotifyScriptableRunAlert",
+		Query:  NotifyScriptableRunAlert_Operation,
+		Variables: &__NotifyScriptableRunAlertInput{
+			EntityName:   entityName,
+			ProjectName:  projectName,
+			RunName:      runName,
+			Title:        title,
+			Text:         text,
+			Severity:     severity,
+			WaitDuration: waitDuration,
+		},
+	}
+	var err_ error
+
+	var data_ NotifyScriptableRunAlertResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by PopFromRunQueue.
+const PopFromRunQueue_Operation = `
+mutation PopFromRunQueue ($entity: String!, $project: String!, $queueName: String!, $launchAgentId: ID) {
+	popFromRunQueue(input: {entityName:$entity,projectName:$project,queueName:$queueName,launchAgentId:$launchAgentId}) {
+		runQueueItemId
+		runSpec
+	}
+}
+`
+
+func PopFromRunQueue(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	entity string,
+	project string,
+	queueName string,
+	launchAgentId *string,
+) (*PopFromRunQueueResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "PopFromRunQueue",
+		Query:  PopFromRunQueue_Operation,
+		Variables: &__PopFromRunQueueInput{
+			Entity:        entity,
+			Project:       project,
+			QueueName:     queueName,
+			LaunchAgentId: launchAgentId,
+		},
+	}
+	var err_ error
+
+	var data_ PopFromRunQueueResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by ProjectRunQueues.
+const ProjectRunQueues_Operation = `
+query ProjectRunQueues ($entity: String!, $projectName: String!) {
+	project(entityName: $entity, name: $projectName) {
+		runQueues {
+			id
+			name
+		}
+	}
+}
+`
+
+func ProjectRunQueues(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	entity string,
+	projectName string,
+) (*ProjectRunQueuesResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "ProjectRunQueues",
+		Query:  ProjectRunQueues_Operation,
+		Variables: &__ProjectRunQueuesInput{
+			Entity:      entity,
+			ProjectName: projectName,
+		},
+	}
+	var err_ error
+
+	var data_ ProjectRunQueuesResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by Projects.
+const Projects_Operation = `
+query Projects ($entity: String!, $cursor: String, $perPage: Int!) {
+	models(entityName: $entity, after: $cursor, first: $perPage) {
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+		edges {
+			node {
+				id
+				name
+				entityName
+				description
+				createdAt
+			}
+		}
+	}
+}
+`
+
+func Projects(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	entity string,
+	cursor *string,
+	perPage int,
+) (*ProjectsResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "Projects",
+		Query:  Projects_Operation,
+		Variables: &__ProjectsInput{
+			Entity:  entity,
+			Cursor:  cursor,
+			PerPage: perPage,
+		},
+	}
+	var err_ error
+
+	var data_ ProjectsResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by Run.
+const Run_Operation = `
+query Run ($entity: String!, $project: String!, $run: String!) {
+	project(name: $project, entityName: $entity) {
+		run(name: $run) {
+			... RunFields
+		}
+	}
+}
+fragment RunFields on Run {
+	name
+	displayName
+	state
+	config
+	summaryMetrics
+	tags
+	group
+	jobType
+	user {
+		username
+	}
+	createdAt
+	heartbeatAt
+}
+`
+
+func Run(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	entity string,
+	project string,
+	run string,
+) (*RunResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "Run",
+		Query:  Run_Operation,
+		Variables: &__RunInput{
+			Entity:  entity,
+			Project: project,
+			Run:     run,
+		},
+	}
+	var err_ error
+
+	var data_ RunResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by RunHistory.
+const RunHistory_Operation = `
+query RunHistory ($entity: String!, $project: String!, $run: String!, $samples: Int!, $minStep: Int64, $maxStep: Int64) {
+	project(name: $project, entityName: $entity) {
+		run(name: $run) {
+			history(samples: $samples, minStep: $minStep, maxStep: $maxStep)
+		}
+	}
+}
+`
+
+func RunHistory(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	entity string,
+	project string,
+	run string,
+	samples int,
+	minStep *int64,
+	maxStep *int64,
+) (*RunHistoryResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "RunHistory",
+		Query:  RunHistory_Operation,
+		Variables: &__RunHistoryInput{
+			Entity:  entity,
+			Project: project,
+			Run:     run,
+			Samples: samples,
+			MinStep: minStep,
+			MaxStep: maxStep,
+		},
+	}
+	var err_ error
+
+	var data_ RunHistoryResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by RunHistoryPage.
+const RunHistoryPage_Operation = `
+query RunHistoryPage ($entity: String!, $project: String!, $run: String!, $minStep: Int64!, $maxStep: Int64!, $samples: Int!) {
+	project(name: $project, entityName: $entity) {
+		run(name: $run) {
+			state
+			historyKeys
+			history(minStep: $minStep, maxStep: $maxStep, samples: $samples)
+		}
+	}
+}
+`
+
+func RunHistoryPage(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	entity string,
+	project string,
+	run string,
+	minStep int64,
+	maxStep int64,
+	samples int,
+) (*RunHistoryPageResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "RunHistoryPage",
+		Query:  RunHistoryPage_Operation,
+		Variables: &__RunHistoryPageInput{
+			Entity:  entity,
+			Project: project,
+			Run:     run,
+			MinStep: minStep,
+			MaxStep: maxStep,
+			Samples: samples,
+		},
+	}
+	var err_ error
+
+	var data_ RunHistoryPageResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by RunLastStep.
+const RunLastStep_Operation = `
+query RunLastStep ($entity: String!, $project: String!, $run: String!) {
+	project(name: $project, entityName: $entity) {
+		run(name: $run) {
+			historyKeys
+		}
+	}
+}
+`
+
+func RunLastStep(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	entity string,
+	project string,
+	run string,
+) (*RunLastStepResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "RunLastStep",
+		Query:  RunLastStep_Operation,
+		Variables: &__RunLastStepInput{
+			Entity:  entity,
+			Project: project,
+			Run:     run,
+		},
+	}
+	var err_ error
+
+	var data_ RunLastStepResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by RunNotes.
+const RunNotes_Operation = `
+query RunNotes ($entity: String!, $project: String!, $name: String!) {
+	project(name: $project, entityName: $entity) {
+		run(name: $name) {
+			notes
+			tags
+		}
+	}
+}
+`
+
+func RunNotes(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	entity string,
+	project string,
+	name string,
+) (*RunNotesResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "RunNotes",
+		Query:  RunNotes_Operation,
+		Variables: &__RunNotesInput{
+			Entity:  entity,
+			Project: project,
+			Name:    name,
+		},
+	}
+	var err_ error
+
+	var data_ RunNotesResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by RunResumeStatus.
+const RunResumeStatus_Operation = `
+query RunResumeStatus ($project: String, $entity: String, $name: String!) {
+	model(name: $project, entityName: $entity) {
+		id
+		name
+		entity {
+			id
+			name
+		}
+		bucket(name: $name, missingOk: true) {
+			id
+			name
 			summaryMetrics
 			displayName
 			logLineCount
@@ -1810,6 +3908,49 @@ func RunResumeStatus(
 	return &data_, err_
 }
 
+// The query or mutation executed by RunSampledHistory.
+const RunSampledHistory_Operation = `
+query RunSampledHistory ($entity: String!, $project: String!, $run: String!, $specs: [JSONString!]!) {
+	project(name: $project, entityName: $entity) {
+		run(name: $run) {
+			sampledHistory(specs: $specs)
+		}
+	}
+}
+`
+
+func RunSampledHistory(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	entity string,
+	project string,
+	run string,
+	specs []string,
+) (*RunSampledHistoryResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "RunSampledHistory",
+		Query:  RunSampledHistory_Operation,
+		Variables: &__RunSampledHistoryInput{
+			Entity:  entity,
+			Project: project,
+			Run:     run,
+			Specs:   specs,
+		},
+	}
+	var err_ error
+
+	var data_ RunSampledHistoryResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by RunStoppedStatus.
 const RunStoppedStatus_Operation = `
 query RunStoppedStatus ($entityName: String, $projectName: String, $runId: String!) {
@@ -1851,6 +3992,148 @@ func RunStoppedStatus(
 	return &data_, err_
 }
 
+// The query or mutation executed by Runs.
+const Runs_Operation = `
+query Runs ($entity: String!, $project: String!, $filters: JSONString, $order: String, $cursor: String, $perPage: Int!) {
+	project(name: $project, entityName: $entity) {
+		runs(filters: $filters, order: $order, after: $cursor, first: $perPage) {
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+			edges {
+				node {
+					... RunFields
+				}
+			}
+		}
+	}
+}
+fragment RunFields on Run {
+	name
+	displayName
+	state
+	config
+	summaryMetrics
+	tags
+	group
+	jobType
+	user {
+		username
+	}
+	createdAt
+	heartbeatAt
+}
+`
+
+func Runs(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	entity string,
+	project string,
+	filters *string,
+	order *string,
+	cursor *string,
+	perPage int,
+) (*RunsResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "Runs",
+		Query:  Runs_Operation,
+		Variables: &__RunsInput{
+			Entity:  entity,
+			Project: project,
+			Filters: filters,
+			Order:   order,
+			Cursor:  cursor,
+			PerPage: perPage,
+		},
+	}
+	var err_ error
+
+	var data_ RunsResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by ServerCapabilities.
+const ServerCapabilities_Operation = `
+query ServerCapabilities {
+	serverInfo {
+		latestLocalVersionInfo {
+			versionOnThisInstanceString
+		}
+	}
+}
+`
+
+func ServerCapabilities(
+	ctx_ context.Context,
+	client_ graphql.Client,
+) (*ServerCapabilitiesResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "ServerCapabilities",
+		Query:  ServerCapabilities_Operation,
+	}
+	var err_ error
+
+	var data_ ServerCapabilitiesResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by ServerCapabilitiesWithFeatures.
+const ServerCapabilitiesWithFeatures_Operation = `
+query ServerCapabilitiesWithFeatures {
+	serverInfo {
+		latestLocalVersionInfo {
+			versionOnThisInstanceString
+		}
+		features {
+			name
+			isEnabled
+		}
+	}
+}
+`
+
+// Servers older than the features field reject this query, so check for
+// the field with ServerInfoFields first.
+func ServerCapabilitiesWithFeatures(
+	ctx_ context.Context,
+	client_ graphql.Client,
+) (*ServerCapabilitiesWithFeaturesResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "ServerCapabilitiesWithFeatures",
+		Query:  ServerCapabilitiesWithFeatures_Operation,
+	}
+	var err_ error
+
+	var data_ ServerCapabilitiesWithFeaturesResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by ServerInfo.
 const ServerInfo_Operation = `
 query ServerInfo {
@@ -1887,6 +4170,39 @@ func ServerInfo(
 	return &data_, err_
 }
 
+// The query or mutation executed by ServerInfoFields.
+const ServerInfoFields_Operation = `
+query ServerInfoFields {
+	__type(name: "ServerInfo") {
+		fields {
+			name
+		}
+	}
+}
+`
+
+func ServerInfoFields(
+	ctx_ context.Context,
+	client_ graphql.Client,
+) (*ServerInfoFieldsResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "ServerInfoFields",
+		Query:  ServerInfoFields_Operation,
+	}
+	var err_ error
+
+	var data_ ServerInfoFieldsResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by UpdateArtifact.
 const UpdateArtifact_Operation = `
 mutation UpdateArtifact ($artifactID: ID!, $metadata: JSONString) {
@@ -1926,6 +4242,43 @@ func UpdateArtifact(
 	return &data_, err_
 }
 
+// The query or mutation executed by UpdateLaunchAgent.
+const UpdateLaunchAgent_Operation = `
+mutation UpdateLaunchAgent ($agentId: ID!, $agentStatus: String) {
+	updateLaunchAgent(input: {launchAgentId:$agentId,agentStatus:$agentStatus}) {
+		success
+	}
+}
+`
+
+func UpdateLaunchAgent(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	agentId string,
+	agentStatus *string,
+) (*UpdateLaunchAgentResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "UpdateLaunchAgent",
+		Query:  UpdateLaunchAgent_Operation,
+		Variables: &__UpdateLaunchAgentInput{
+			AgentId:     agentId,
+			AgentStatus: agentStatus,
+		},
+	}
+	var err_ error
+
+	var data_ UpdateLaunchAgentResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by UpsertBucket.
 const UpsertBucket_Operation = `
 mutation UpsertBucket ($id: String, $name: String, $project: String, $entity: String, $groupName: String, $description: String, $displayName: String, $notes: String, $commit: String, $config: JSONString, $host: String, $debug: Boolean, $program: String, $repo: String, $jobType: String, $state: String, $sweep: String, $tags: [String!], $summaryMetrics: JSONString) {
@@ -1937,6 +4290,7 @@ mutation UpsertBucket ($id: String, $name: String, $project: String, $entity: St
 			description
 			config
 			sweepName
+			historyLineCount
 			project {
 				id
 				name
@@ -2013,6 +4367,55 @@ func UpsertBucket(
 	return &data_, err_
 }
 
+// The query or mutation executed by UpsertView.
+const UpsertView_Operation = `
+mutation UpsertView ($entityName: String!, $projectName: String!, $name: String!, $displayName: String!, $description: String, $viewType: String!, $spec: String!) {
+	upsertView(input: {entityName:$entityName,projectName:$projectName,name:$name,displayName:$displayName,description:$description,type:$viewType,spec:$spec,createdUsing:WANDB_SDK}) {
+		view {
+			id
+		}
+	}
+}
+`
+
+func UpsertView(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	entityName string,
+	projectName string,
+	name string,
+	displayName string,
+	description *string,
+	viewType string,
+	spec string,
+) (*UpsertViewResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "UpsertView",
+		Query:  UpsertView_Operation,
+		Variables: &__UpsertViewInput{
+			EntityName:  entityName,
+			ProjectName: projectName,
+			Name:        name,
+			DisplayName: displayName,
+			Description: description,
+			ViewType:    viewType,
+			Spec:        spec,
+		},
+	}
+	var err_ error
+
+	var data_ UpsertViewResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by UseArtifact.
 const UseArtifact_Operation = `
 mutation UseArtifact ($entityName: String!, $projectName: String!, $runName: String!, $artifactID: ID!) {
@@ -2095,3 +4498,36 @@ func Viewer(
 
 	return &data_, err_
 }
+
+// The query or mutation executed by ViewerDefaultSettings.
+const ViewerDefaultSettings_Operation = `
+query ViewerDefaultSettings {
+	viewer {
+		defaultEntity {
+			sdkDefaultSettings
+		}
+	}
+}
+`
+
+func ViewerDefaultSettings(
+	ctx_ context.Context,
+	client_ graphql.Client,
+) (*ViewerDefaultSettingsResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "ViewerDefaultSettings",
+		Query:  ViewerDefaultSettings_Operation,
+	}
+	var err_ error
+
+	var data_ ViewerDefaultSettingsResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
@@ -0,0 +1,197 @@
+// Package gqlcache reduces the traffic of repeated GraphQL requests.
+//
+// A caching client reuses the responses of queries whose results rarely
+// change, and a persisted query client sends the hash of a query in place
+// of its text once the server has seen it.
+package gqlcache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+)
+
+// DefaultMaxEntries is the default number of responses a Cache keeps.
+const DefaultMaxEntries = 1000
+
+// Cache stores GraphQL responses until they expire.
+//
+// It is safe for concurrent use, so that a process's streams can share
+// one cache.
+type Cache struct {
+	mu         sync.Mutex
+	entries    map[string]cacheEntry
+	maxEntries int
+}
+
+type cacheEntry struct {
+	data    json.RawMessage
+	expires time.Time
+}
+
+// NewCache returns a cache that keeps at most maxEntries responses.
+func NewCache(maxEntries int) *Cache {
+	if maxEntries <= 0 {
+		maxEntries = DefaultMaxEntries
+	}
+
+	return &Cache{
+		entries:    make(map[string]cacheEntry),
+		maxEntries: maxEntries,
+	}
+}
+
+func (c *Cache) get(key string) (json.RawMessage, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if !time.Now().Before(entry.expires) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return entry.data, true
+}
+
+func (c *Cache) put(key string, data json.RawMessage, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	if len(c.entries) >= c.maxEntries {
+		for k, entry := range c.entries {
+			if !now.Before(entry.expires) {
+				delete(c.entries, k)
+			}
+		}
+	}
+	// If nothing expired, make room by evicting an arbitrary entry.
+	for k := range c.entries {
+		if len(c.entries) < c.maxEntries {
+			break
+		}
+		delete(c.entries, k)
+	}
+
+	c.entries[key] = cacheEntry{data: data, expires: now.Add(ttl)}
+}
+
+// CachingClientParams configures NewCachingClient.
+type CachingClientParams struct {
+	// Client makes the requests that aren't answered from the cache.
+	Client graphql.Client
+
+	// Cache stores the responses.
+	Cache *Cache
+
+	// TTL is how long a response is reused.
+	TTL time.Duration
+
+	// Namespace separates the responses of clients that may see different
+	// results for the same query, such as clients of different servers or
+	// with different credentials.
+	Namespace string
+
+	// Operations are the names of the queries whose responses are cached.
+	//
+	// Only queries whose results rarely change should be listed, since a
+	// cached response may be out of date by up to the TTL.
+	Operations []string
+}
+
+// cachingClient answers repeated queries from a cache.
+type cachingClient struct {
+	client     graphql.Client
+	cache      *Cache
+	ttl        time.Duration
+	namespace  string
+	operations map[string]struct{}
+}
+
+// NewCachingClient returns a client that reuses the responses of some
+// queries.
+//
+// Responses with errors are never cached, and neither are mutations.
+func NewCachingClient(params CachingClientParams) graphql.Client {
+	operations := make(map[string]struct{}, len(params.Operations))
+	for _, op := range params.Operations {
+		operations[op] = struct{}{}
+	}
+
+	return &cachingClient{
+		client:     params.Client,
+		cache:      params.Cache,
+		ttl:        params.TTL,
+		namespace:  params.Namespace,
+		operations: operations,
+	}
+}
+
+func (c *cachingClient) MakeRequest(
+	ctx context.Context,
+	req *graphql.Request,
+	resp *graphql.Response,
+) error {
+	if !c.isCacheable(req) {
+		return c.client.MakeRequest(ctx, req, resp)
+	}
+
+	key, err := c.key(req)
+	if err != nil {
+		return c.client.MakeRequest(ctx, req, resp)
+	}
+	if data, ok := c.cache.get(key); ok {
+		return json.Unmarshal(data, resp.Data)
+	}
+
+	var data json.RawMessage
+	rawResp := &graphql.Response{Data: &data}
+	err = c.client.MakeRequest(ctx, req, rawResp)
+	resp.Extensions = rawResp.Extensions
+	resp.Errors = rawResp.Errors
+	if len(data) > 0 && resp.Data != nil {
+		if unmarshalErr := json.Unmarshal(data, resp.Data); err == nil {
+			err = unmarshalErr
+		}
+	}
+
+	if err == nil {
+		c.cache.put(key, data, c.ttl)
+	}
+	return err
+}
+
+// isCacheable returns whether the response to a request may be cached.
+func (c *cachingClient) isCacheable(req *graphql.Request) bool {
+	if c.ttl <= 0 {
+		return false
+	}
+	if _, ok := c.operations[req.OpName]; !ok {
+		return false
+	}
+	return !strings.HasPrefix(strings.TrimSpace(req.Query), "mutation")
+}
+
+// key returns the cache key of a request.
+func (c *cachingClient) key(req *graphql.Request) (string, error) {
+	variables, err := json.Marshal(req.Variables)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.New()
+	for _, part := range []string{req.OpName, req.Query, string(variables)} {
+		hash.Write([]byte(part))
+		hash.Write([]byte{0})
+	}
+	return c.namespace + "\x00" + hex.EncodeToString(hash.Sum(nil)), nil
+}
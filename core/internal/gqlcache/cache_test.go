@@ -0,0 +1,129 @@
+package gqlcache_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/gqlcache"
+	"github.com/wandb/wandb/core/internal/gqlmock"
+)
+
+type viewerResponse struct {
+	Viewer struct {
+		Entity string `json:"entity"`
+	} `json:"viewer"`
+}
+
+func queryViewer(t *testing.T, client graphql.Client, entity string) string {
+	t.Helper()
+
+	data := &viewerResponse{}
+	err := client.MakeRequest(
+		context.Background(),
+		&graphql.Request{
+			OpName:    "Viewer",
+			Query:     "query Viewer { viewer { entity } }",
+			Variables: map[string]any{"entity": entity},
+		},
+		&graphql.Response{Data: data},
+	)
+	require.NoError(t, err)
+	return data.Viewer.Entity
+}
+
+func newCachingClient(
+	mock graphql.Client,
+	cache *gqlcache.Cache,
+	namespace string,
+	ttl time.Duration,
+) graphql.Client {
+	return gqlcache.NewCachingClient(gqlcache.CachingClientParams{
+		Client:     mock,
+		Cache:      cache,
+		TTL:        ttl,
+		Namespace:  namespace,
+		Operations: []string{"Viewer"},
+	})
+}
+
+func TestCachingClient_ReusesResponses(t *testing.T) {
+	mock := gqlmock.NewMockClient()
+	mock.StubAnyOnce(`{"viewer": {"entity": "me"}}`)
+	client := newCachingClient(mock, gqlcache.NewCache(0), "", time.Hour)
+
+	assert.Equal(t, "me", queryViewer(t, client, "x"))
+	assert.Equal(t, "me", queryViewer(t, client, "x"))
+
+	assert.Len(t, mock.AllRequests(), 1)
+}
+
+func TestCachingClient_KeysByVariablesAndNamespace(t *testing.T) {
+	mock := gqlmock.NewMockClient()
+	mock.StubAnyOnce(`{"viewer": {"entity": "a"}}`)
+	mock.StubAnyOnce(`{"viewer": {"entity": "b"}}`)
+	mock.StubAnyOnce(`{"viewer": {"entity": "c"}}`)
+	cache := gqlcache.NewCache(0)
+	client1 := newCachingClient(mock, cache, "server1", time.Hour)
+	client2 := newCachingClient(mock, cache, "server2", time.Hour)
+
+	assert.Equal(t, "a", queryViewer(t, client1, "x"))
+	assert.Equal(t, "b", queryViewer(t, client1, "y"))
+	assert.Equal(t, "c", queryViewer(t, client2, "x"))
+
+	assert.True(t, mock.AllStubsUsed())
+}
+
+func TestCachingClient_Expires(t *testing.T) {
+	mock := gqlmock.NewMockClient()
+	mock.StubAnyOnce(`{"viewer": {"entity": "old"}}`)
+	mock.StubAnyOnce(`{"viewer": {"entity": "new"}}`)
+	client := newCachingClient(mock, gqlcache.NewCache(0), "", time.Millisecond)
+
+	assert.Equal(t, "old", queryViewer(t, client, "x"))
+	time.Sleep(5 * time.Millisecond)
+	assert.Equal(t, "new", queryViewer(t, client, "x"))
+}
+
+func TestCachingClient_DoesNotCacheErrors(t *testing.T) {
+	mock := gqlmock.NewMockClient()
+	client := newCachingClient(mock, gqlcache.NewCache(0), "", time.Hour)
+	resp := &graphql.Response{Data: &viewerResponse{}}
+	req := &graphql.Request{OpName: "Viewer", Query: "query Viewer { viewer { entity } }"}
+
+	// The mock fails requests that aren't stubbed.
+	require.Error(t, client.MakeRequest(context.Background(), req, resp))
+	mock.StubAnyOnce(`{"viewer": {"entity": "me"}}`)
+	require.NoError(t, client.MakeRequest(context.Background(), req, resp))
+}
+
+func TestCachingClient_OnlyListedOperations(t *testing.T) {
+	mock := gqlmock.NewMockClient()
+	mock.StubAnyOnce(`{}`)
+	mock.StubAnyOnce(`{}`)
+	client := newCachingClient(mock, gqlcache.NewCache(0), "", time.Hour)
+	req := &graphql.Request{OpName: "RunStoppedStatus", Query: "query RunStoppedStatus { }"}
+
+	for i := 0; i < 2; i++ {
+		err := client.MakeRequest(context.Background(), req,
+			&graphql.Response{Data: &struct{}{}})
+		require.NoError(t, err)
+	}
+
+	assert.Len(t, mock.AllRequests(), 2)
+}
+
+func TestCache_EvictsWhenFull(t *testing.T) {
+	mock := gqlmock.NewMockClient()
+	mock.StubAnyOnce(`{"viewer": {"entity": "a"}}`)
+	mock.StubAnyOnce(`{"viewer": {"entity": "b"}}`)
+	mock.StubAnyOnce(`{"viewer": {"entity": "c"}}`)
+	client := newCachingClient(mock, gqlcache.NewCache(1), "", time.Hour)
+
+	queryViewer(t, client, "a")
+	queryViewer(t, client, "b")
+	assert.Equal(t, "c", queryViewer(t, client, "a"))
+}
@@ -0,0 +1,218 @@
+package gqlcache
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync/atomic"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Errors a server returns for a persisted query it can't use.
+const (
+	persistedQueryNotFound     = "PersistedQueryNotFound"
+	persistedQueryNotSupported = "PersistedQueryNotSupported"
+)
+
+// persistedQueryClient sends the hash of a query in place of its text.
+//
+// It follows Apollo's automatic persisted queries protocol: a request
+// has only the query's SHA-256 hash, and if the server doesn't know the
+// hash yet, the request is repeated with the query text so that the
+// server can remember it.
+type persistedQueryClient struct {
+	endpoint   string
+	httpClient graphql.Doer
+
+	// unsupported is set once the server turns out not to understand
+	// persisted queries, after which query text is always sent.
+	unsupported atomic.Bool
+}
+
+// NewPersistedQueryClient returns a client that uses persisted queries.
+//
+// It makes POST requests to the endpoint like graphql.NewClient. Servers
+// that don't support persisted queries get the full query text after
+// their first failed request.
+func NewPersistedQueryClient(endpoint string, httpClient graphql.Doer) graphql.Client {
+	return &persistedQueryClient{endpoint: endpoint, httpClient: httpClient}
+}
+
+// persistedRequest is the body of a request.
+type persistedRequest struct {
+	Query      string         `json:"query,omitempty"`
+	Variables  any            `json:"variables,omitempty"`
+	OpName     string         `json:"operationName"`
+	Extensions map[string]any `json:"extensions,omitempty"`
+}
+
+// persistedResponse is a response whose data hasn't been decoded yet.
+type persistedResponse struct {
+	Data       json.RawMessage `json:"data"`
+	Extensions map[string]any  `json:"extensions,omitempty"`
+	Errors     gqlerror.List   `json:"errors,omitempty"`
+}
+
+func (c *persistedQueryClient) MakeRequest(
+	ctx context.Context,
+	req *graphql.Request,
+	resp *graphql.Response,
+) error {
+	if c.unsupported.Load() {
+		result, err := c.post(ctx, &persistedRequest{
+			Query:     req.Query,
+			Variables: req.Variables,
+			OpName:    req.OpName,
+		})
+		if err != nil {
+			return err
+		}
+		return result.decode(resp)
+	}
+
+	hash := sha256.Sum256([]byte(req.Query))
+	extensions := map[string]any{
+		"persistedQuery": map[string]any{
+			"version":    1,
+			"sha256Hash": hex.EncodeToString(hash[:]),
+		},
+	}
+
+	result, err := c.post(ctx, &persistedRequest{
+		Variables:  req.Variables,
+		OpName:     req.OpName,
+		Extensions: extensions,
+	})
+	if err != nil {
+		return err
+	}
+
+	retry, notFound := result.needsQueryText()
+	if !retry {
+		return result.decode(resp)
+	}
+
+	result, err = c.post(ctx, &persistedRequest{
+		Query:      req.Query,
+		Variables:  req.Variables,
+		OpName:     req.OpName,
+		Extensions: extensions,
+	})
+	if err != nil {
+		return err
+	}
+	if !notFound && result.status == http.StatusOK && len(result.Errors) == 0 {
+		// The server answered the query but didn't understand its hash.
+		c.unsupported.Store(true)
+	}
+	return result.decode(resp)
+}
+
+// persistedResult is the outcome of a request.
+type persistedResult struct {
+	persistedResponse
+
+	status int
+
+	// body is the body of a response with a status other than 200 OK.
+	body []byte
+}
+
+// post sends a request and reads its response.
+func (c *persistedQueryClient) post(
+	ctx context.Context,
+	body *persistedRequest,
+) (*persistedResult, error) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	httpReq, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, c.endpoint, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	httpResp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &persistedResult{status: httpResp.StatusCode}
+	if httpResp.StatusCode != http.StatusOK {
+		// Error responses may still be GraphQL responses explaining why.
+		_ = json.Unmarshal(respBody, &result.persistedResponse)
+		result.body = respBody
+		return result, nil
+	}
+
+	if err := json.Unmarshal(respBody, &result.persistedResponse); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// needsQueryText returns whether a request that had only a query hash
+// must be repeated with the query text, and whether that's because the
+// server doesn't know the hash yet.
+//
+// This is the case if the server says so, or if the request was rejected
+// without being executed, as by a server that doesn't support persisted
+// queries and sees a request without a query.
+func (r *persistedResult) needsQueryText() (retry, notFound bool) {
+	for _, err := range r.Errors {
+		code, _ := err.Extensions["code"].(string)
+		switch {
+		case err.Message == persistedQueryNotFound,
+			code == "PERSISTED_QUERY_NOT_FOUND":
+			return true, true
+		case err.Message == persistedQueryNotSupported,
+			code == "PERSISTED_QUERY_NOT_SUPPORTED":
+			return true, false
+		}
+	}
+
+	if r.status == http.StatusBadRequest {
+		return true, false
+	}
+
+	// The data is absent if the request failed before execution.
+	notExecuted := len(r.Data) == 0 || string(r.Data) == "null"
+	return r.status == http.StatusOK && len(r.Errors) > 0 && notExecuted, false
+}
+
+// decode fills in the response to a request, returning an error like
+// graphql.NewClient's clients do if the request failed.
+func (r *persistedResult) decode(resp *graphql.Response) error {
+	if r.status != http.StatusOK {
+		return fmt.Errorf("returned error %v %s: %s",
+			r.status, http.StatusText(r.status), r.body)
+	}
+
+	resp.Extensions = r.Extensions
+	resp.Errors = r.Errors
+	if len(r.Data) > 0 && resp.Data != nil {
+		if err := json.Unmarshal(r.Data, resp.Data); err != nil {
+			return err
+		}
+	}
+	if len(resp.Errors) > 0 {
+		return resp.Errors
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package gqlcache_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/gqlcache"
+)
+
+type requestBody struct {
+	Query      string `json:"query"`
+	Extensions struct {
+		PersistedQuery struct {
+			SHA256Hash string `json:"sha256Hash"`
+		} `json:"persistedQuery"`
+	} `json:"extensions"`
+}
+
+// apqServer is a GraphQL server that records its requests.
+type apqServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	requests []requestBody
+}
+
+// newAPQServer starts a server that supports persisted queries if
+// supported is true, and otherwise rejects requests without a query.
+func newAPQServer(t *testing.T, supported bool) *apqServer {
+	t.Helper()
+
+	server := &apqServer{}
+	known := make(map[string]bool)
+	server.Server = httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			var body requestBody
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			server.mu.Lock()
+			server.requests = append(server.requests, body)
+			server.mu.Unlock()
+
+			hash := body.Extensions.PersistedQuery.SHA256Hash
+			switch {
+			case body.Query != "":
+				if supported {
+					known[hash] = true
+				}
+			case !supported:
+				_, _ = w.Write([]byte(
+					`{"errors": [{"message": "Must provide an operation."}], "data": null}`))
+				return
+			case !known[hash]:
+				_, _ = w.Write([]byte(
+					`{"errors": [{"message": "PersistedQueryNotFound"}]}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"data": {"viewer": {"entity": "me"}}}`))
+		}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func (s *apqServer) queries() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var queries []string
+	for _, req := range s.requests {
+		queries = append(queries, req.Query)
+	}
+	return queries
+}
+
+func TestPersistedQueryClient_RegistersThenSendsHash(t *testing.T) {
+	server := newAPQServer(t, true)
+	client := gqlcache.NewPersistedQueryClient(server.URL, http.DefaultClient)
+
+	assert.Equal(t, "me", queryViewer(t, client, "x"))
+	assert.Equal(t, "me", queryViewer(t, client, "x"))
+
+	query := "query Viewer { viewer { entity } }"
+	assert.Equal(t, []string{"", query, ""}, server.queries())
+}
+
+func TestPersistedQueryClient_FallsBackIfUnsupported(t *testing.T) {
+	server := newAPQServer(t, false)
+	client := gqlcache.NewPersistedQueryClient(server.URL, http.DefaultClient)
+
+	assert.Equal(t, "me", queryViewer(t, client, "x"))
+	assert.Equal(t, "me", queryViewer(t, client, "x"))
+
+	query := "query Viewer { viewer { entity } }"
+	assert.Equal(t, []string{"", query, query}, server.queries())
+}
+
+func TestPersistedQueryClient_ReturnsErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(
+				`{"errors": [{"message": "permission denied"}], "data": {"viewer": null}}`))
+		}))
+	defer server.Close()
+	client := gqlcache.NewPersistedQueryClient(server.URL, http.DefaultClient)
+
+	err := client.MakeRequest(
+		context.Background(),
+		&graphql.Request{OpName: "Viewer", Query: "query Viewer { viewer { entity } }"},
+		&graphql.Response{Data: &viewerResponse{}},
+	)
+
+	assert.ErrorContains(t, err, "permission denied")
+}
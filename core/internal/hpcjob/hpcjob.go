@@ -0,0 +1,97 @@
+// Package hpcjob detects when wandb-core runs inside a job of an HPC
+// scheduler such as Slurm or PBS.
+//
+// Schedulers preempt jobs by sending them SIGTERM and kill them if they
+// don't exit within a grace period, so runs in such jobs must be saved
+// quickly.
+package hpcjob
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// Scheduler is an HPC job scheduler.
+type Scheduler string
+
+const (
+	Slurm Scheduler = "slurm"
+	PBS   Scheduler = "pbs"
+)
+
+// envPrefix is the prefix of each scheduler's environment variables.
+var envPrefix = map[Scheduler]string{
+	Slurm: "SLURM_",
+	PBS:   "PBS_",
+}
+
+// jobIDKey is the variable in Env that holds each scheduler's job ID.
+var jobIDKey = map[Scheduler]string{
+	Slurm: "job_id",
+	PBS:   "jobid",
+}
+
+// Job is the scheduler job wandb-core is running in.
+type Job struct {
+	Scheduler Scheduler
+
+	// ID is the scheduler's ID for the job.
+	ID string
+
+	// Env is the scheduler's environment variables, as returned by Env.
+	Env map[string]string
+}
+
+// Env returns the scheduler's variables in an environment of "KEY=value"
+// strings, such as from os.Environ.
+//
+// Keys are lowercase and without the scheduler's prefix, so SLURM_JOB_ID
+// becomes "job_id".
+func Env(environ []string, scheduler Scheduler) map[string]string {
+	prefix := envPrefix[scheduler]
+	if prefix == "" {
+		return nil
+	}
+
+	vars := make(map[string]string)
+	for _, envVar := range environ {
+		key, value, _ := strings.Cut(envVar, "=")
+		if suffix, ok := strings.CutPrefix(key, prefix); ok {
+			vars[strings.ToLower(suffix)] = value
+		}
+	}
+	return vars
+}
+
+// Detect returns the scheduler job described by an environment of
+// "KEY=value" strings, or nil if it isn't a job's environment.
+func Detect(environ []string) *Job {
+	for _, scheduler := range []Scheduler{Slurm, PBS} {
+		env := Env(environ, scheduler)
+		if id := env[jobIDKey[scheduler]]; id != "" {
+			return &Job{Scheduler: scheduler, ID: id, Env: env}
+		}
+	}
+	return nil
+}
+
+// RunPreemptionHook runs the command given to save a run's state when its
+// job is preempted, such as one that writes a checkpoint.
+//
+// The command gets the run's ID in WANDB_RUN_ID and is killed when the
+// context is done.
+func RunPreemptionHook(ctx context.Context, command []string, runID string) error {
+	if len(command) == 0 {
+		return nil
+	}
+
+	cmd := exec.CommandContext(ctx, command[0], command[1:]...)
+	cmd.Env = append(os.Environ(), "WANDB_RUN_ID="+runID)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("hpcjob: preemption hook %q failed: %v: %s", command, err, output)
+	}
+	return nil
+}
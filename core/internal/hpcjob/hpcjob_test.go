@@ -0,0 +1,46 @@
+package hpcjob_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wandb/wandb/core/internal/hpcjob"
+)
+
+func TestDetect_Slurm(t *testing.T) {
+	job := hpcjob.Detect([]string{
+		"HOME=/home/user",
+		"SLURM_JOB_ID=1234",
+		"SLURM_JOB_PARTITION=gpu",
+	})
+
+	assert.Equal(t, &hpcjob.Job{
+		Scheduler: hpcjob.Slurm,
+		ID:        "1234",
+		Env:       map[string]string{"job_id": "1234", "job_partition": "gpu"},
+	}, job)
+}
+
+func TestDetect_PBS(t *testing.T) {
+	job := hpcjob.Detect([]string{"PBS_JOBID=42.server", "PBS_QUEUE=workq"})
+
+	assert.Equal(t, hpcjob.PBS, job.Scheduler)
+	assert.Equal(t, "42.server", job.ID)
+	assert.Equal(t, "workq", job.Env["queue"])
+}
+
+func TestDetect_NotInJob(t *testing.T) {
+	// Login nodes may have scheduler variables outside of any job.
+	assert.Nil(t, hpcjob.Detect([]string{"SLURM_CONF=/etc/slurm/slurm.conf"}))
+}
+
+func TestRunPreemptionHook_Fails(t *testing.T) {
+	err := hpcjob.RunPreemptionHook(
+		context.Background(),
+		[]string{"/nonexistent/checkpoint.sh"},
+		"run-id",
+	)
+
+	assert.ErrorContains(t, err, "preemption hook")
+}
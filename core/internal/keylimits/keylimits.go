@@ -0,0 +1,207 @@
+// Package keylimits bounds the size of a run's config and summary.
+//
+// The server rejects or struggles to display configs and summaries that
+// are too large, and by then the data is already lost. Checking items as
+// they're logged lets the user know which keys were dropped and why.
+package keylimits
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Limits are the bounds on a config or summary. Zero or less disables a
+// bound.
+type Limits struct {
+	// MaxKeys is the number of distinct keys allowed.
+	MaxKeys int
+
+	// MaxDepth is the deepest nesting allowed, counting both the levels of
+	// an item's key and of its value.
+	MaxDepth int
+
+	// MaxValueBytes is the size of an item's JSON-encoded value.
+	MaxValueBytes int
+}
+
+// DefaultLimits are the limits used unless configured otherwise.
+var DefaultLimits = Limits{
+	MaxKeys:       100_000,
+	MaxDepth:      32,
+	MaxValueBytes: 10 << 20,
+}
+
+// Reason is why an item was dropped.
+type Reason string
+
+const (
+	ReasonTooManyKeys Reason = "too many keys"
+	ReasonTooDeep     Reason = "nested too deeply"
+	ReasonTooLarge    Reason = "value too large"
+)
+
+// Violation describes an item that exceeds the limits.
+type Violation struct {
+	// Key is the item's key, with nested keys joined by ".".
+	Key string
+
+	Reason Reason
+
+	// Limit is the limit the item exceeds.
+	Limit int
+}
+
+func (v *Violation) Error() string {
+	return fmt.Sprintf("keylimits: %q: %s (limit %d)", v.Key, v.Reason, v.Limit)
+}
+
+// Limiter checks the items of a config or summary against limits.
+//
+// Keys whose first component starts with an underscore, like "_wandb",
+// are internal and exempt.
+type Limiter struct {
+	limits Limits
+
+	// keys are the distinct keys accepted so far
+	keys map[string]struct{}
+
+	// dropped is the number of items rejected so far
+	dropped int
+}
+
+func New(limits Limits) *Limiter {
+	return &Limiter{
+		limits: limits,
+		keys:   make(map[string]struct{}),
+	}
+}
+
+// Check records an item being set and returns a violation if it's over
+// the limits, in which case the item should be dropped.
+func (l *Limiter) Check(path []string, valueJSON string) *Violation {
+	if len(path) == 0 || strings.HasPrefix(path[0], "_") {
+		return nil
+	}
+
+	key := strings.Join(path, ".")
+	violation := l.violation(key, len(path), valueJSON)
+	if violation != nil {
+		l.dropped++
+		return violation
+	}
+
+	l.keys[key] = struct{}{}
+	return nil
+}
+
+// Remove records an item being removed.
+func (l *Limiter) Remove(path []string) {
+	delete(l.keys, strings.Join(path, "."))
+}
+
+// Dropped returns the number of items rejected so far.
+func (l *Limiter) Dropped() int {
+	return l.dropped
+}
+
+func (l *Limiter) violation(key string, keyDepth int, valueJSON string) *Violation {
+	if l.limits.MaxValueBytes > 0 && len(valueJSON) > l.limits.MaxValueBytes {
+		return &Violation{
+			Key:    key,
+			Reason: ReasonTooLarge,
+			Limit:  l.limits.MaxValueBytes,
+		}
+	}
+
+	if l.limits.MaxDepth > 0 && keyDepth+jsonDepth(valueJSON) > l.limits.MaxDepth {
+		return &Violation{
+			Key:    key,
+			Reason: ReasonTooDeep,
+			Limit:  l.limits.MaxDepth,
+		}
+	}
+
+	if _, exists := l.keys[key]; !exists &&
+		l.limits.MaxKeys > 0 && len(l.keys) >= l.limits.MaxKeys {
+		return &Violation{
+			Key:    key,
+			Reason: ReasonTooManyKeys,
+			Limit:  l.limits.MaxKeys,
+		}
+	}
+
+	return nil
+}
+
+// jsonDepth returns the deepest nesting of objects and arrays in a JSON
+// value, without fully parsing it.
+func jsonDepth(valueJSON string) int {
+	depth, maxDepth := 0, 0
+	inString, escaped := false, false
+
+	for i := 0; i < len(valueJSON); i++ {
+		c := valueJSON[i]
+		switch {
+		case escaped:
+			escaped = false
+		case inString:
+			switch c {
+			case '\\':
+				escaped = true
+			case '"':
+				inString = false
+			}
+		case c == '"':
+			inString = true
+		case c == '{' || c == '[':
+			depth++
+			maxDepth = max(maxDepth, depth)
+		case c == '}' || c == ']':
+			depth--
+		}
+	}
+
+	return maxDepth
+}
+
+// Item is a config or summary item.
+type Item interface {
+	GetKey() string
+	GetNestedKey() []string
+	GetValueJson() string
+}
+
+// Path returns the key path of an item.
+func Path(item Item) []string {
+	if len(item.GetNestedKey()) > 0 {
+		return item.GetNestedKey()
+	}
+	return []string{item.GetKey()}
+}
+
+// Filter checks items being set and returns the ones within the limits
+// along with the violations of the others.
+//
+// If no items are dropped, the original slice is returned.
+func Filter[T Item](l *Limiter, items []T) ([]T, []*Violation) {
+	var violations []*Violation
+	var kept []T
+
+	for i, item := range items {
+		violation := l.Check(Path(item), item.GetValueJson())
+		switch {
+		case violation != nil:
+			if violations == nil {
+				kept = append(kept, items[:i]...)
+			}
+			violations = append(violations, violation)
+		case violations != nil:
+			kept = append(kept, item)
+		}
+	}
+
+	if violations == nil {
+		return items, nil
+	}
+	return kept, violations
+}
@@ -0,0 +1,53 @@
+package keylimits_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wandb/wandb/core/internal/keylimits"
+)
+
+func TestMaxKeys(t *testing.T) {
+	limiter := keylimits.New(keylimits.Limits{MaxKeys: 2})
+
+	assert.Nil(t, limiter.Check([]string{"a"}, "1"))
+	assert.Nil(t, limiter.Check([]string{"b", "c"}, "1"))
+	assert.Nil(t, limiter.Check([]string{"a"}, "2"), "existing keys can be updated")
+	assert.Nil(t, limiter.Check([]string{"_wandb", "x"}, "1"), "internal keys are exempt")
+
+	violation := limiter.Check([]string{"d"}, "1")
+	if assert.NotNil(t, violation) {
+		assert.Equal(t, keylimits.ReasonTooManyKeys, violation.Reason)
+		assert.Equal(t, "d", violation.Key)
+	}
+
+	limiter.Remove([]string{"b", "c"})
+	assert.Nil(t, limiter.Check([]string{"d"}, "1"))
+	assert.Equal(t, 1, limiter.Dropped())
+}
+
+func TestMaxDepth(t *testing.T) {
+	limiter := keylimits.New(keylimits.Limits{MaxDepth: 3})
+
+	assert.Nil(t, limiter.Check([]string{"a", "b"}, `{"c": "{{[["}`))
+	assert.Nil(t, limiter.Check([]string{"a"}, `[[1], [2]]`))
+
+	violation := limiter.Check([]string{"a", "b"}, `{"c": {"d": 1}}`)
+	if assert.NotNil(t, violation) {
+		assert.Equal(t, keylimits.ReasonTooDeep, violation.Reason)
+		assert.Equal(t, "a.b", violation.Key)
+	}
+}
+
+func TestMaxValueBytes(t *testing.T) {
+	limiter := keylimits.New(keylimits.Limits{MaxValueBytes: 10})
+
+	assert.Nil(t, limiter.Check([]string{"a"}, `"short"`))
+
+	violation := limiter.Check([]string{"a"}, `"`+strings.Repeat("x", 10)+`"`)
+	if assert.NotNil(t, violation) {
+		assert.Equal(t, keylimits.ReasonTooLarge, violation.Reason)
+		assert.Equal(t, 10, violation.Limit)
+	}
+}
@@ -0,0 +1,329 @@
+// Package launchagent runs jobs from W&B Launch run queues.
+//
+// An Agent registers with the W&B server, polls its run queues, runs the
+// jobs it pops and reports their progress back, like `wandb launch-agent`
+// but without needing Python on the machine running the agent.
+package launchagent
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/wandb/wandb/core/internal/waiting"
+	"github.com/wandb/wandb/core/pkg/utils"
+)
+
+const (
+	// DefaultProject is the project that run queues belong to.
+	DefaultProject = "model-registry"
+
+	// DefaultPollInterval is how often to poll the run queues.
+	DefaultPollInterval = 10 * time.Second
+
+	// jobStopTimeout is how long a job has to exit after being
+	// interrupted before it's killed.
+	jobStopTimeout = 30 * time.Second
+)
+
+// Agent polls run queues and runs their jobs.
+type Agent struct {
+	client graphql.Client
+	logger *slog.Logger
+
+	entity  string
+	project string
+	queues  []string
+	maxJobs int
+
+	pollDelay waiting.Delay
+	workDir   string
+	output    io.Writer
+	env       JobEnv
+
+	// agentID is the server's ID for the agent, set by Register.
+	agentID string
+
+	// wg is done when all jobs have exited.
+	wg sync.WaitGroup
+
+	mu sync.Mutex
+
+	// running is the IDs of the run queue items being run.
+	running map[string]struct{}
+}
+
+type Params struct {
+	Client graphql.Client
+	Logger *slog.Logger
+
+	// Entity and Project are where the run queues are; the project
+	// defaults to DefaultProject.
+	Entity  string
+	Project string
+
+	// Queues are the names of the run queues to poll.
+	Queues []string
+
+	// MaxJobs is how many jobs can run at once; the default is 1.
+	MaxJobs int
+
+	// PollDelay is the time between polls; DefaultPollInterval if nil.
+	PollDelay waiting.Delay
+
+	// WorkDir is where git repositories are cloned; the default is the
+	// system's temporary directory.
+	WorkDir string
+
+	// Output is where the jobs' output goes; os.Stdout if nil.
+	Output io.Writer
+
+	// BaseURL and APIKey are passed to jobs for logging their runs.
+	BaseURL string
+	APIKey  string
+}
+
+func New(params Params) *Agent {
+	if params.Logger == nil {
+		params.Logger = slog.New(slog.NewTextHandler(io.Discard, nil))
+	}
+	if params.Project == "" {
+		params.Project = DefaultProject
+	}
+	if params.MaxJobs <= 0 {
+		params.MaxJobs = 1
+	}
+	if params.PollDelay == nil {
+		params.PollDelay = waiting.NewDelay(DefaultPollInterval)
+	}
+	if params.Output == nil {
+		params.Output = os.Stdout
+	}
+
+	return &Agent{
+		client:    params.Client,
+		logger:    params.Logger,
+		entity:    params.Entity,
+		project:   params.Project,
+		queues:    params.Queues,
+		maxJobs:   params.MaxJobs,
+		pollDelay: params.PollDelay,
+		workDir:   params.WorkDir,
+		output:    params.Output,
+		env: JobEnv{
+			BaseURL:     params.BaseURL,
+			APIKey:      params.APIKey,
+			QueueEntity: params.Entity,
+		},
+		running: make(map[string]struct{}),
+	}
+}
+
+// Run registers the agent and runs jobs until the context is cancelled
+// or the agent is stopped from the W&B UI.
+//
+// Running jobs are interrupted when it returns.
+func (a *Agent) Run(ctx context.Context) error {
+	if err := a.Register(ctx); err != nil {
+		return err
+	}
+
+	jobCtx, cancelJobs := context.WithCancel(context.Background())
+	defer func() {
+		cancelJobs()
+		a.Wait()
+
+		// Report the agent's end even though ctx may be done.
+		err := updateLaunchAgent(
+			context.Background(), a.client, a.agentID, StatusKilled)
+		if err != nil {
+			a.logger.Error("launchagent: failed to update status", "error", err)
+		}
+	}()
+
+	for {
+		stop, err := a.Poll(ctx, jobCtx)
+		if err != nil {
+			a.logger.Error("launchagent: failed to poll", "error", err)
+		}
+		if stop {
+			a.logger.Info("launchagent: stopped from the W&B UI")
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-a.pollDelay.Wait():
+		}
+	}
+}
+
+// Register checks that the agent's run queues exist and registers the
+// agent with the server.
+func (a *Agent) Register(ctx context.Context) error {
+	projectQueues, err := projectRunQueues(ctx, a.client, a.entity, a.project)
+	if err != nil {
+		return err
+	}
+
+	queueIDs := make([]string, 0, len(a.queues))
+	for _, name := range a.queues {
+		for _, queue := range projectQueues {
+			if queue.Name == name {
+				queueIDs = append(queueIDs, queue.ID)
+				break
+			}
+		}
+	}
+	if len(queueIDs) != len(a.queues) {
+		var names []string
+		for _, queue := range projectQueues {
+			names = append(names, queue.Name)
+		}
+		return fmt.Errorf(
+			"launchagent: not all of the queues %s exist in %s/%s;"+
+				" its queues are: %s",
+			strings.Join(a.queues, ", "), a.entity, a.project,
+			strings.Join(names, ", "))
+	}
+
+	hostname, _ := os.Hostname()
+	a.agentID, err = createLaunchAgent(
+		ctx, a.client, a.entity, a.project, queueIDs, hostname)
+	if err != nil {
+		return err
+	}
+
+	a.logger.Info("launchagent: registered", "id", a.agentID, "queues", a.queues)
+	return nil
+}
+
+// Poll starts jobs from the run queues while the agent has capacity, and
+// reports the agent's status.
+//
+// Jobs run until they exit or jobCtx is cancelled. It returns true if
+// the agent was stopped from the W&B UI.
+func (a *Agent) Poll(ctx, jobCtx context.Context) (bool, error) {
+	stop, err := stopPolling(ctx, a.client, a.agentID)
+	if err != nil {
+		return false, err
+	}
+	if stop {
+		return true, nil
+	}
+
+	for _, queue := range a.queues {
+		if a.NumRunning() >= a.maxJobs {
+			break
+		}
+
+		item, err := popFromRunQueue(
+			ctx, a.client, a.entity, a.project, queue, a.agentID)
+		if err != nil {
+			return false, err
+		}
+		if item == nil {
+			continue
+		}
+
+		a.mu.Lock()
+		a.running[item.ID] = struct{}{}
+		a.mu.Unlock()
+
+		a.wg.Add(1)
+		go func() {
+			defer a.wg.Done()
+			a.runJob(jobCtx, queue, item)
+
+			a.mu.Lock()
+			delete(a.running, item.ID)
+			a.mu.Unlock()
+		}()
+	}
+
+	status := StatusPolling
+	if a.NumRunning() > 0 {
+		status = StatusRunning
+	}
+	return false, updateLaunchAgent(ctx, a.client, a.agentID, status)
+}
+
+// NumRunning returns the number of jobs running.
+func (a *Agent) NumRunning() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return len(a.running)
+}
+
+// Wait blocks until all jobs have exited.
+func (a *Agent) Wait() {
+	a.wg.Wait()
+}
+
+// runJob runs the job of a run queue item and reports how it went.
+func (a *Agent) runJob(ctx context.Context, queue string, item *RunQueueItem) {
+	logger := a.logger.With("queue", queue, "item", item.ID)
+
+	fail := func(stage string, err error) {
+		logger.Error("launchagent: job failed", "stage", stage, "error", err)
+		err = failRunQueueItem(
+			context.Background(), a.client, item.ID, err.Error(), stage)
+		if err != nil {
+			logger.Error("launchagent: failed to report failure", "error", err)
+		}
+	}
+
+	spec, err := ParseLaunchSpec(item.RunSpec)
+	if err != nil {
+		fail(stageAgent, err)
+		return
+	}
+
+	env := a.env
+	env.Queue = queue
+	env.ItemID = item.ID
+	env.RunID = spec.RunID
+	if env.RunID == "" {
+		env.RunID = utils.ShortID(8)
+	}
+
+	if err := ackRunQueueItem(ctx, a.client, item.ID, env.RunID); err != nil {
+		logger.Error("launchagent: not running job", "error", err)
+		return
+	}
+
+	cmd, cleanup, err := spec.Command(ctx, a.workDir, env)
+	if err != nil {
+		fail(stageAgent, err)
+		return
+	}
+	defer cleanup()
+
+	cmd.Stdout = a.output
+	cmd.Stderr = a.output
+	cmd.Cancel = func() error { return cmd.Process.Signal(os.Interrupt) }
+	cmd.WaitDelay = jobStopTimeout
+
+	logger.Info("launchagent: running job", "run_id", env.RunID, "command", cmd.Args)
+	if err := cmd.Start(); err != nil {
+		fail(stageAgent, fmt.Errorf("launchagent: failed to start job: %v", err))
+		return
+	}
+
+	err = cmd.Wait()
+	switch {
+	case ctx.Err() != nil:
+		fail(stageRun, fmt.Errorf("launchagent: job stopped by agent shutdown"))
+	case err != nil:
+		fail(stageRun, fmt.Errorf("launchagent: job failed: %v", err))
+	default:
+		logger.Info("launchagent: job finished", "run_id", env.RunID)
+	}
+}
@@ -0,0 +1,203 @@
+package launchagent_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/gqlmock"
+	"github.com/wandb/wandb/core/internal/launchagent"
+	"github.com/wandb/wandb/core/internal/waiting"
+)
+
+// gitRepo creates a git repository with a run.sh script.
+func gitRepo(t *testing.T, script string) string {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	dir := t.TempDir()
+	require.NoError(t,
+		os.WriteFile(filepath.Join(dir, "run.sh"), []byte(script), 0o644))
+	for _, args := range [][]string{
+		{"init", "--quiet"},
+		{"add", "run.sh"},
+		{"-c", "user.name=test", "-c", "user.email=test@example.com",
+			"commit", "--quiet", "-m", "init"},
+	} {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+	return dir
+}
+
+// newAgent returns an agent polling the "default" queue, stubbing the
+// requests to register it.
+func newAgent(
+	t *testing.T,
+	client *gqlmock.MockClient,
+	output *bytes.Buffer,
+) *launchagent.Agent {
+	t.Helper()
+
+	client.StubMatchOnce(
+		gqlmock.WithOpName("ProjectRunQueues"),
+		`{"project": {"runQueues": [
+			{"id": "q1", "name": "default"},
+			{"id": "q2", "name": "gpu"}
+		]}}`)
+	client.StubMatchOnce(
+		gqlmock.WithOpName("CreateLaunchAgent"),
+		`{"CreateLaunchAgent": {"launchAgentId": "agent1"}}`)
+
+	return launchagent.New(launchagent.Params{
+		Client:    client,
+		Entity:    "team",
+		Queues:    []string{"default"},
+		PollDelay: waiting.NoDelay(),
+		WorkDir:   t.TempDir(),
+		Output:    output,
+		APIKey:    "secret",
+	})
+}
+
+// registeredAgent returns an agent registered with a mock server.
+func registeredAgent(
+	t *testing.T,
+	client *gqlmock.MockClient,
+	output *bytes.Buffer,
+) *launchagent.Agent {
+	t.Helper()
+
+	agent := newAgent(t, client, output)
+	require.NoError(t, agent.Register(context.Background()))
+	return agent
+}
+
+func stubPop(client *gqlmock.MockClient, runSpec string) {
+	client.StubMatchOnce(
+		gqlmock.WithOpName("LaunchAgent"),
+		`{"launchAgent": {"stopPolling": false}}`)
+	client.StubMatchOnce(
+		gqlmock.WithOpName("PopFromRunQueue"),
+		`{"PopFromRunQueue": {"runQueueItemId": "item1", "runSpec": `+runSpec+`}}`)
+	client.StubMatchOnce(
+		gqlmock.WithOpName("AckRunQueueItem"),
+		`{"AckRunQueueItem": {"success": true}}`)
+	client.StubMatchOnce(
+		gqlmock.WithOpName("UpdateLaunchAgent"),
+		`{"UpdateLaunchAgent": {"success": true}}`)
+}
+
+// requestsNamed returns the variables of the requests with an op name.
+func requestsNamed(
+	t *testing.T,
+	client *gqlmock.MockClient,
+	opName string,
+) []map[string]any {
+	t.Helper()
+	var variables []map[string]any
+	for _, req := range client.AllRequests() {
+		if req.OpName != opName {
+			continue
+		}
+		data, err := json.Marshal(req.Variables)
+		require.NoError(t, err)
+		vars := make(map[string]any)
+		require.NoError(t, json.Unmarshal(data, &vars))
+		variables = append(variables, vars)
+	}
+	return variables
+}
+
+func TestRegister_MissingQueue(t *testing.T) {
+	client := gqlmock.NewMockClient()
+	client.StubMatchOnce(
+		gqlmock.WithOpName("ProjectRunQueues"),
+		`{"project": {"runQueues": [{"id": "q1", "name": "default"}]}}`)
+	agent := launchagent.New(launchagent.Params{
+		Client: client,
+		Entity: "team",
+		Queues: []string{"gpu"},
+	})
+
+	err := agent.Register(context.Background())
+
+	assert.ErrorContains(t, err, "its queues are: default")
+}
+
+func TestPoll_RunsGitJob(t *testing.T) {
+	repo := gitRepo(t, `echo "hello $WANDB_RUN_ID $WANDB_PROJECT $*"`)
+	client := gqlmock.NewMockClient()
+	output := &bytes.Buffer{}
+	agent := registeredAgent(t, client, output)
+	stubPop(client, `{
+		"uri": "`+repo+`",
+		"project": "proj",
+		"run_id": "run1",
+		"overrides": {"entry_point": ["sh", "run.sh"], "args": ["--fast"]}
+	}`)
+
+	stop, err := agent.Poll(context.Background(), context.Background())
+	agent.Wait()
+
+	require.NoError(t, err)
+	assert.False(t, stop)
+	assert.Equal(t, "hello run1 proj --fast\n", output.String())
+	assert.True(t, client.AllStubsUsed())
+	assert.Equal(t, "run1", requestsNamed(t, client, "AckRunQueueItem")[0]["runId"])
+	assert.Equal(t,
+		launchagent.StatusRunning,
+		requestsNamed(t, client, "UpdateLaunchAgent")[0]["agentStatus"])
+}
+
+func TestPoll_ReportsFailedJob(t *testing.T) {
+	repo := gitRepo(t, "exit 3")
+	client := gqlmock.NewMockClient()
+	agent := registeredAgent(t, client, &bytes.Buffer{})
+	stubPop(client, `{
+		"uri": "`+repo+`",
+		"overrides": {"entry_point": ["sh", "run.sh"]}
+	}`)
+	client.StubMatchOnce(
+		gqlmock.WithOpName("FailRunQueueItem"),
+		`{"FailRunQueueItem": {"success": true}}`)
+
+	_, err := agent.Poll(context.Background(), context.Background())
+	agent.Wait()
+
+	require.NoError(t, err)
+	failures := requestsNamed(t, client, "FailRunQueueItem")
+	require.Len(t, failures, 1)
+	assert.Equal(t, "item1", failures[0]["runQueueItemId"])
+	assert.Equal(t, "run", failures[0]["stage"])
+	assert.Contains(t, failures[0]["message"], "exit status 3")
+}
+
+func TestRun_StopsWhenRequested(t *testing.T) {
+	client := gqlmock.NewMockClient()
+	agent := newAgent(t, client, &bytes.Buffer{})
+	client.StubMatchOnce(
+		gqlmock.WithOpName("LaunchAgent"),
+		`{"launchAgent": {"stopPolling": true}}`)
+	client.StubMatchOnce(
+		gqlmock.WithOpName("UpdateLaunchAgent"),
+		`{"UpdateLaunchAgent": {"success": true}}`)
+
+	err := agent.Run(context.Background())
+
+	require.NoError(t, err)
+	assert.True(t, client.AllStubsUsed())
+	assert.Equal(t,
+		launchagent.StatusKilled,
+		requestsNamed(t, client, "UpdateLaunchAgent")[0]["agentStatus"])
+}
@@ -0,0 +1,161 @@
+package launchagent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/wandb/wandb/core/internal/gql"
+)
+
+// Agent statuses reported to the server, as shown in the W&B UI.
+const (
+	StatusPolling = "POLLING"
+	StatusRunning = "RUNNING"
+	StatusKilled  = "KILLED"
+)
+
+// Stages at which a run queue item can fail.
+const (
+	// stageAgent is a failure to prepare or start the job.
+	stageAgent = "agent"
+
+	// stageRun is a failure of the job itself.
+	stageRun = "run"
+)
+
+// RunQueue is a run queue in the agent's project.
+type RunQueue struct {
+	ID   string
+	Name string
+}
+
+// RunQueueItem is a job popped from a run queue.
+type RunQueueItem struct {
+	ID string
+
+	// RunSpec is the JSON launch spec of the job.
+	RunSpec json.RawMessage
+}
+
+func projectRunQueues(
+	ctx context.Context,
+	client graphql.Client,
+	entity, project string,
+) ([]RunQueue, error) {
+	response, err := gql.ProjectRunQueues(ctx, client, entity, project)
+	if err != nil {
+		return nil, fmt.Errorf("launchagent: ProjectRunQueues failed: %v", err)
+	}
+
+	if response.Project == nil {
+		return nil, fmt.Errorf(
+			"launchagent: can't read run queues of %s/%s;"+
+				" check that you have access to this entity and project",
+			entity, project)
+	}
+
+	queues := make([]RunQueue, 0, len(response.Project.RunQueues))
+	for _, queue := range response.Project.RunQueues {
+		queues = append(queues, RunQueue{ID: queue.Id, Name: queue.Name})
+	}
+	return queues, nil
+}
+
+func createLaunchAgent(
+	ctx context.Context,
+	client graphql.Client,
+	entity, project string,
+	queueIDs []string,
+	hostname string,
+) (string, error) {
+	response, err := gql.CreateLaunchAgent(ctx, client,
+		entity, project, queueIDs, hostname)
+	if err != nil {
+		return "", fmt.Errorf("launchagent: CreateLaunchAgent failed: %v", err)
+	}
+
+	if response.CreateLaunchAgent == nil {
+		return "", fmt.Errorf("launchagent: server did not create the agent")
+	}
+	return response.CreateLaunchAgent.LaunchAgentId, nil
+}
+
+// stopPolling returns whether the agent was stopped from the W&B UI.
+func stopPolling(
+	ctx context.Context,
+	client graphql.Client,
+	agentID string,
+) (bool, error) {
+	response, err := gql.LaunchAgent(ctx, client, agentID)
+	if err != nil {
+		return false, fmt.Errorf("launchagent: LaunchAgent failed: %v", err)
+	}
+
+	return response.LaunchAgent != nil && response.LaunchAgent.StopPolling, nil
+}
+
+func updateLaunchAgent(
+	ctx context.Context,
+	client graphql.Client,
+	agentID, status string,
+) error {
+	_, err := gql.UpdateLaunchAgent(ctx, client, agentID, &status)
+	if err != nil {
+		return fmt.Errorf("launchagent: UpdateLaunchAgent failed: %v", err)
+	}
+	return nil
+}
+
+// popFromRunQueue returns the next item in a queue, or nil if it's empty.
+func popFromRunQueue(
+	ctx context.Context,
+	client graphql.Client,
+	entity, project, queue, agentID string,
+) (*RunQueueItem, error) {
+	response, err := gql.PopFromRunQueue(ctx, client,
+		entity, project, queue, &agentID)
+	if err != nil {
+		return nil, fmt.Errorf("launchagent: PopFromRunQueue failed: %v", err)
+	}
+
+	item := response.PopFromRunQueue
+	if item == nil {
+		return nil, nil
+	}
+	return &RunQueueItem{ID: item.RunQueueItemId, RunSpec: item.RunSpec}, nil
+}
+
+// ackRunQueueItem tells the server which run a queue item started.
+func ackRunQueueItem(
+	ctx context.Context,
+	client graphql.Client,
+	itemID, runID string,
+) error {
+	response, err := gql.AckRunQueueItem(ctx, client, itemID, runID)
+	if err != nil {
+		return fmt.Errorf("launchagent: AckRunQueueItem failed: %v", err)
+	}
+
+	if response.AckRunQueueItem == nil || !response.AckRunQueueItem.Success {
+		return fmt.Errorf(
+			"launchagent: failed to acknowledge run queue item %s;"+
+				" it may have been acknowledged by another agent",
+			itemID)
+	}
+	return nil
+}
+
+// failRunQueueItem marks a queue item as failed with an error message.
+func failRunQueueItem(
+	ctx context.Context,
+	client graphql.Client,
+	itemID, message, stage string,
+) error {
+	_, err := gql.FailRunQueueItem(ctx, client, itemID, message, stage)
+	if err != nil {
+		return fmt.Errorf("launchagent: FailRunQueueItem failed: %v", err)
+	}
+	return nil
+}
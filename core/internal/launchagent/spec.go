@@ -0,0 +1,246 @@
+package launchagent
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Resources that jobs can request to run on.
+const (
+	resourceLocalContainer = "local-container"
+	resourceLocalProcess   = "local-process"
+)
+
+// LaunchSpec describes a job in a run queue.
+//
+// Only the fields needed to run a docker image or a git repository are
+// included; jobs that refer to a job artifact are not supported.
+type LaunchSpec struct {
+	// URI is the git repository to run.
+	URI string `json:"uri"`
+
+	// Job is the name of a job artifact.
+	Job string `json:"job"`
+
+	// ImageURI is the docker image to run.
+	ImageURI string `json:"image_uri"`
+
+	Docker struct {
+		DockerImage string `json:"docker_image"`
+	} `json:"docker"`
+
+	Git struct {
+		// Version is the branch, tag or commit to check out.
+		Version string `json:"version"`
+	} `json:"git"`
+
+	// Entity and Project are where the job's run is created.
+	Entity  string `json:"entity"`
+	Project string `json:"project"`
+
+	Name    string `json:"name"`
+	RunID   string `json:"run_id"`
+	SweepID string `json:"sweep_id"`
+	Author  string `json:"author"`
+
+	// Resource is where to run the job, e.g. "local-container".
+	Resource string `json:"resource"`
+
+	Overrides struct {
+		// EntryPoint is the command to run.
+		EntryPoint []string `json:"entry_point"`
+
+		// Args are arguments appended to the command.
+		Args []string `json:"args"`
+
+		// RunConfig is merged into the config of the job's run.
+		RunConfig map[string]any `json:"run_config"`
+	} `json:"overrides"`
+}
+
+// ParseLaunchSpec parses the launch spec of a run queue item.
+//
+// The spec may also be a JSON string containing the encoded spec.
+func ParseLaunchSpec(data []byte) (*LaunchSpec, error) {
+	var encoded string
+	if json.Unmarshal(data, &encoded) == nil {
+		data = []byte(encoded)
+	}
+
+	spec := &LaunchSpec{}
+	if err := json.Unmarshal(data, spec); err != nil {
+		return nil, fmt.Errorf("launchagent: invalid launch spec: %v", err)
+	}
+	return spec, nil
+}
+
+// Image returns the docker image to run, if any.
+func (s *LaunchSpec) Image() string {
+	if s.Docker.DockerImage != "" {
+		return s.Docker.DockerImage
+	}
+	return s.ImageURI
+}
+
+// JobEnv is the context a job runs in.
+type JobEnv struct {
+	BaseURL string
+	APIKey  string
+
+	// RunID is the ID of the job's run.
+	RunID string
+
+	// Queue, QueueEntity and ItemID identify the run queue item.
+	Queue       string
+	QueueEntity string
+	ItemID      string
+}
+
+// Environ returns the environment variables that configure the job's
+// W&B run, sorted by name.
+func (s *LaunchSpec) Environ(env JobEnv) ([]string, error) {
+	vars := map[string]string{
+		"WANDB_BASE_URL":            env.BaseURL,
+		"WANDB_API_KEY":             env.APIKey,
+		"WANDB_ENTITY":              s.Entity,
+		"WANDB_PROJECT":             s.Project,
+		"WANDB_LAUNCH":              "True",
+		"WANDB_RUN_ID":              env.RunID,
+		"WANDB_LAUNCH_QUEUE_NAME":   env.Queue,
+		"WANDB_LAUNCH_QUEUE_ENTITY": env.QueueEntity,
+		"WANDB_LAUNCH_TRACE_ID":     env.ItemID,
+	}
+	if image := s.Image(); image != "" {
+		vars["WANDB_DOCKER"] = image
+	}
+	if s.Name != "" {
+		vars["WANDB_NAME"] = s.Name
+	}
+	if s.Author != "" {
+		vars["WANDB_USERNAME"] = s.Author
+	}
+	if s.SweepID != "" {
+		vars["WANDB_SWEEP_ID"] = s.SweepID
+	}
+	if len(s.Overrides.RunConfig) > 0 {
+		config, err := json.Marshal(s.Overrides.RunConfig)
+		if err != nil {
+			return nil, fmt.Errorf("launchagent: invalid run config: %v", err)
+		}
+		vars["WANDB_CONFIG"] = string(config)
+	}
+
+	environ := make([]string, 0, len(vars))
+	for key, value := range vars {
+		if value != "" {
+			environ = append(environ, key+"="+value)
+		}
+	}
+	sort.Strings(environ)
+	return environ, nil
+}
+
+// Command returns the command that runs the job.
+//
+// Docker images are run with "docker run". Git repositories are cloned
+// into a new directory under workDir and their entry point is run there;
+// the returned cleanup function removes the clone.
+func (s *LaunchSpec) Command(
+	ctx context.Context,
+	workDir string,
+	env JobEnv,
+) (cmd *exec.Cmd, cleanup func(), err error) {
+	environ, err := s.Environ(env)
+	if err != nil {
+		return nil, nil, err
+	}
+	cleanup = func() {}
+
+	switch image := s.Image(); {
+	case s.Job != "" && image == "" && s.URI == "":
+		return nil, nil, errors.New(
+			"launchagent: jobs from job artifacts are not supported;" +
+				" queue a docker image or a git repository")
+
+	case image != "":
+		if s.Resource != "" && s.Resource != resourceLocalContainer {
+			return nil, nil, fmt.Errorf(
+				"launchagent: resource %q is not supported for docker images", s.Resource)
+		}
+
+		// Variables are passed by name so that secrets such as the API key
+		// don't show up in the docker command line.
+		args := []string{"run", "--rm"}
+		for _, v := range environ {
+			name, _, _ := strings.Cut(v, "=")
+			args = append(args, "--env", name)
+		}
+		args = append(args, image)
+		args = append(args, s.Overrides.EntryPoint...)
+		args = append(args, s.Overrides.Args...)
+
+		cmd = exec.CommandContext(ctx, "docker", args...)
+
+	case s.URI != "":
+		if s.Resource != "" && s.Resource != resourceLocalProcess {
+			return nil, nil, fmt.Errorf(
+				"launchagent: resource %q is not supported for git repositories", s.Resource)
+		}
+		if len(s.Overrides.EntryPoint) == 0 {
+			return nil, nil, errors.New(
+				"launchagent: git repository jobs need an entry point")
+		}
+
+		dir, err := os.MkdirTemp(workDir, "launch-")
+		if err != nil {
+			return nil, nil, fmt.Errorf("launchagent: %v", err)
+		}
+		cleanup = func() { _ = os.RemoveAll(dir) }
+
+		repoDir := filepath.Join(dir, "repo")
+		if err := s.clone(ctx, repoDir); err != nil {
+			cleanup()
+			return nil, nil, err
+		}
+
+		var args []string
+		args = append(args, s.Overrides.EntryPoint[1:]...)
+		args = append(args, s.Overrides.Args...)
+		cmd = exec.CommandContext(ctx, s.Overrides.EntryPoint[0], args...)
+		cmd.Dir = repoDir
+
+	default:
+		return nil, nil, errors.New(
+			"launchagent: launch spec has no docker image or git repository")
+	}
+
+	cmd.Env = append(os.Environ(), environ...)
+	return cmd, cleanup, nil
+}
+
+// clone clones the git repository into dir and checks out its version.
+func (s *LaunchSpec) clone(ctx context.Context, dir string) error {
+	out, err := exec.CommandContext(ctx,
+		"git", "clone", "--quiet", "--", s.URI, dir).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("launchagent: failed to clone %s: %v: %s", s.URI, err, out)
+	}
+
+	if s.Git.Version == "" {
+		return nil
+	}
+	out, err = exec.CommandContext(ctx,
+		"git", "-C", dir, "checkout", "--quiet", s.Git.Version, "--").CombinedOutput()
+	if err != nil {
+		return fmt.Errorf(
+			"launchagent: failed to check out %s: %v: %s", s.Git.Version, err, out)
+	}
+	return nil
+}
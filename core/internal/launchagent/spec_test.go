@@ -0,0 +1,103 @@
+package launchagent_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/launchagent"
+)
+
+var testEnv = launchagent.JobEnv{
+	BaseURL:     "https://api.wandb.ai",
+	APIKey:      "secret",
+	RunID:       "run1",
+	Queue:       "default",
+	QueueEntity: "team",
+	ItemID:      "item1",
+}
+
+func TestParseLaunchSpec_EncodedString(t *testing.T) {
+	spec, err := launchagent.ParseLaunchSpec(
+		[]byte(`"{\"image_uri\": \"python:3.11\"}"`))
+
+	require.NoError(t, err)
+	assert.Equal(t, "python:3.11", spec.Image())
+}
+
+func TestEnviron(t *testing.T) {
+	spec, err := launchagent.ParseLaunchSpec([]byte(`{
+		"entity": "me",
+		"project": "proj",
+		"sweep_id": "sweep1",
+		"docker": {"docker_image": "trainer:latest"},
+		"overrides": {"run_config": {"lr": 0.1}}
+	}`))
+	require.NoError(t, err)
+
+	environ, err := spec.Environ(testEnv)
+
+	require.NoError(t, err)
+	assert.Equal(t,
+		[]string{
+			"WANDB_API_KEY=secret",
+			"WANDB_BASE_URL=https://api.wandb.ai",
+			`WANDB_CONFIG={"lr":0.1}`,
+			"WANDB_DOCKER=trainer:latest",
+			"WANDB_ENTITY=me",
+			"WANDB_LAUNCH=True",
+			"WANDB_LAUNCH_QUEUE_ENTITY=team",
+			"WANDB_LAUNCH_QUEUE_NAME=default",
+			"WANDB_LAUNCH_TRACE_ID=item1",
+			"WANDB_PROJECT=proj",
+			"WANDB_RUN_ID=run1",
+			"WANDB_SWEEP_ID=sweep1",
+		},
+		environ)
+}
+
+func TestCommand_Docker(t *testing.T) {
+	spec, err := launchagent.ParseLaunchSpec([]byte(`{
+		"image_uri": "trainer:latest",
+		"overrides": {"entry_point": ["python", "train.py"], "args": ["--epochs", "3"]}
+	}`))
+	require.NoError(t, err)
+
+	cmd, cleanup, err := spec.Command(context.Background(), t.TempDir(), testEnv)
+	require.NoError(t, err)
+	defer cleanup()
+
+	assert.Equal(t,
+		[]string{
+			"docker", "run", "--rm",
+			"--env", "WANDB_API_KEY",
+			"--env", "WANDB_BASE_URL",
+			"--env", "WANDB_DOCKER",
+			"--env", "WANDB_LAUNCH",
+			"--env", "WANDB_LAUNCH_QUEUE_ENTITY",
+			"--env", "WANDB_LAUNCH_QUEUE_NAME",
+			"--env", "WANDB_LAUNCH_TRACE_ID",
+			"--env", "WANDB_RUN_ID",
+			"trainer:latest",
+			"python", "train.py", "--epochs", "3",
+		},
+		cmd.Args)
+	assert.Contains(t, cmd.Env, "WANDB_API_KEY=secret")
+}
+
+func TestCommand_Unsupported(t *testing.T) {
+	for _, specJSON := range []string{
+		`{"job": "team/proj/job-trainer:latest"}`,
+		`{"image_uri": "trainer:latest", "resource": "kubernetes"}`,
+		`{"uri": "https://github.com/wandb/examples"}`,
+		`{}`,
+	} {
+		spec, err := launchagent.ParseLaunchSpec([]byte(specJSON))
+		require.NoError(t, err)
+
+		_, _, err = spec.Command(context.Background(), t.TempDir(), testEnv)
+
+		assert.Error(t, err, specJSON)
+	}
+}
@@ -0,0 +1,465 @@
+package leet
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/wandb/wandb/core/internal/runview"
+)
+
+const (
+	// minChartWidth and minChartHeight are the smallest size of a chart
+	// including its title, which limit the grid on small terminals.
+	minChartWidth  = 12
+	minChartHeight = 4
+
+	// brailleBlank is the Braille pattern without dots.
+	brailleBlank = 0x2800
+)
+
+// chart is a metric's lines in one or more runs.
+type chart struct {
+	metric string
+	series []*runview.Series
+
+	// colors are the colors of the series' lines.
+	colors []string
+}
+
+// chartGrid is the state of a page of charts.
+type chartGrid struct {
+	// xName is the name of the charts' X axis.
+	xName string
+
+	// page is the index of the page of charts shown.
+	page int
+
+	// focus is the index on the page of the focused chart.
+	focus int
+
+	// crosshair selects a point of the focused chart's first line, or is
+	// nil.
+	crosshair       *runview.Crosshair
+	crosshairMetric string
+
+	// cells are where the last View drew charts, for keys and the mouse.
+	cells []chartCell
+
+	// columns is the number of columns of the last View's grid.
+	columns int
+}
+
+// chartCell is where a chart was drawn on the screen.
+type chartCell struct {
+	metric string
+	series *runview.Series
+
+	// x, y, width and height are the screen area of the chart's lines,
+	// excluding its title.
+	x, y, width, height int
+
+	minX, maxX float64
+}
+
+// reset shows the first page again.
+func (g *chartGrid) reset() {
+	g.page = 0
+	g.focus = 0
+	g.crosshair = nil
+}
+
+// focused returns the cell of the focused chart, if any.
+func (g *chartGrid) focused() (chartCell, bool) {
+	if g.focus < 0 || g.focus >= len(g.cells) {
+		return chartCell{}, false
+	}
+	return g.cells[g.focus], true
+}
+
+// label describes the point under the crosshair, or is empty.
+func (g *chartGrid) label() string {
+	if g.crosshair == nil {
+		return ""
+	}
+	return g.crosshairMetric + "  " + g.crosshair.Label(g.xName)
+}
+
+// moveFocus focuses the chart at a position on the page.
+func (g *chartGrid) moveFocus(focus int) {
+	if focus < 0 || focus >= len(g.cells) || focus == g.focus {
+		return
+	}
+	g.focus = focus
+	g.crosshair = nil
+}
+
+// historyCharts returns the charts of the logged metrics that pass the
+// filter.
+//
+// In a workspace, each chart compares the metric across the opened runs,
+// with a color per run.
+func (m *Model) historyCharts() []chart {
+	if m.workspace == nil {
+		if m.single == nil {
+			return nil
+		}
+		return m.singleRunCharts(m.single.History.Metrics(), m.single.History.Series)
+	}
+
+	colors := make(map[string]string)
+	for i, run := range m.workspace.Opened() {
+		colors[run.Info.ID] = m.config.Color(i)
+	}
+
+	var charts []chart
+	for _, metric := range m.selection.Select(m.workspace.Metrics()) {
+		c := chart{metric: metric}
+		for _, series := range m.workspace.Compare(metric) {
+			c.series = append(c.series, series)
+			c.colors = append(c.colors, colors[series.Name])
+		}
+		charts = append(charts, c)
+	}
+	return charts
+}
+
+// systemCharts returns the charts of the current run's system metrics
+// that pass the filter.
+func (m *Model) systemCharts() []chart {
+	run := m.current()
+	if run == nil {
+		return nil
+	}
+	return m.singleRunCharts(run.SystemMetrics.Metrics(), run.SystemMetrics.Series)
+}
+
+// singleRunCharts returns a chart per metric that passes the filter.
+//
+// Colors are by the metric's position among all metrics, so that they
+// don't change with the filter.
+func (m *Model) singleRunCharts(
+	metrics []string,
+	seriesOf func(string) *runview.Series,
+) []chart {
+	index := make(map[string]int, len(metrics))
+	for i, metric := range metrics {
+		index[metric] = i
+	}
+
+	var charts []chart
+	for _, metric := range m.selection.Select(metrics) {
+		charts = append(charts, chart{
+			metric: metric,
+			series: []*runview.Series{seriesOf(metric)},
+			colors: []string{m.config.Color(index[metric])},
+		})
+	}
+	return charts
+}
+
+func (m *Model) handleChartKey(grid *chartGrid, key string) bool {
+	switch m.config.Action(key) {
+	case runview.ActionFilter:
+		m.prompt = &prompt{kind: promptFilter, text: m.selection.Filter()}
+		return true
+	case runview.ActionPin:
+		if cell, ok := grid.focused(); ok {
+			m.selection.TogglePin(cell.metric)
+		}
+		return true
+	case runview.ActionNextPage:
+		grid.page++
+		grid.focus = 0
+		grid.crosshair = nil
+		return true
+	case runview.ActionPrevPage:
+		grid.page = max(grid.page-1, 0)
+		grid.focus = 0
+		grid.crosshair = nil
+		return true
+	}
+
+	n := len(grid.cells)
+	switch key {
+	case "tab":
+		grid.moveFocus((grid.focus + 1) % max(n, 1))
+	case "shift+tab":
+		grid.moveFocus((grid.focus - 1 + n) % max(n, 1))
+	case "up":
+		grid.moveFocus(grid.focus - grid.columns)
+	case "down":
+		grid.moveFocus(grid.focus + grid.columns)
+	case "left":
+		m.moveCrosshair(grid, -1)
+	case "right":
+		m.moveCrosshair(grid, 1)
+	case "esc":
+		grid.crosshair = nil
+	default:
+		return false
+	}
+	return true
+}
+
+// moveCrosshair moves the crosshair along the focused chart, starting at
+// its last point.
+func (m *Model) moveCrosshair(grid *chartGrid, delta int) {
+	cell, ok := grid.focused()
+	if !ok || cell.series == nil {
+		return
+	}
+
+	if grid.crosshair == nil || grid.crosshairMetric != cell.metric {
+		grid.crosshair = runview.NewCrosshair(cell.series)
+		grid.crosshairMetric = cell.metric
+		return
+	}
+	grid.crosshair.Move(delta)
+}
+
+// hoverChart focuses the chart under the mouse and moves the crosshair to
+// the point under it.
+func (m *Model) hoverChart(grid *chartGrid, x, y int) bool {
+	for i, cell := range grid.cells {
+		if x < cell.x || x >= cell.x+cell.width ||
+			y < cell.y-1 || y >= cell.y+cell.height {
+			continue
+		}
+
+		grid.moveFocus(i)
+		if cell.series == nil {
+			return true
+		}
+		if grid.crosshair == nil || grid.crosshairMetric != cell.metric {
+			grid.crosshair = runview.NewCrosshair(cell.series)
+			grid.crosshairMetric = cell.metric
+		}
+		grid.crosshair.HoverAt(
+			runview.XAtColumn(x-cell.x, cell.width, cell.minX, cell.maxX))
+		return true
+	}
+	return false
+}
+
+// chartsView draws a page of charts in a grid.
+func (m *Model) chartsView(
+	grid *chartGrid,
+	charts []chart,
+	width, height int,
+) []string {
+	grid.cells = nil
+	if len(charts) == 0 {
+		if m.selection.Filter() != "" {
+			return []string{"no metrics match " + m.selection.Filter()}
+		}
+		return []string{"no metrics logged yet"}
+	}
+
+	// The last line shows the page number.
+	rows := min(m.config.GridRows, max((height-1)/minChartHeight, 1))
+	columns := min(m.config.GridColumns, max(width/minChartWidth, 1))
+	grid.columns = columns
+
+	names := make([]string, len(charts))
+	for i, c := range charts {
+		names[i] = c.metric
+	}
+	pages := runview.Paginate(names, rows*columns)
+	grid.page = min(grid.page, len(pages)-1)
+	first := grid.page * rows * columns
+	onPage := charts[first : first+len(pages[grid.page])]
+	grid.focus = min(grid.focus, len(onPage)-1)
+
+	cellWidth := width / columns
+	cellHeight := (height - 1) / rows
+
+	var lines []string
+	for row := 0; row*columns < len(onPage); row++ {
+		cellLines := make([][]string, 0, columns)
+		for col := 0; col < columns && row*columns+col < len(onPage); col++ {
+			i := row*columns + col
+			drawn, cell := m.chartView(grid, onPage[i], i == grid.focus,
+				cellWidth, cellHeight)
+			cell.x = col * cellWidth
+			// The header is the first line of the screen.
+			cell.y = 1 + row*cellHeight + 1
+			grid.cells = append(grid.cells, cell)
+			cellLines = append(cellLines, drawn)
+		}
+
+		for line := 0; line < cellHeight; line++ {
+			var joined strings.Builder
+			for _, drawn := range cellLines {
+				joined.WriteString(drawn[line])
+			}
+			lines = append(lines, joined.String())
+		}
+	}
+
+	for len(lines) < height-1 {
+		lines = append(lines, "")
+	}
+	return append(lines, fmt.Sprintf("page %d/%d, %d charts",
+		grid.page+1, len(pages), len(charts)))
+}
+
+// chartView draws a chart with a title in a cell of the grid.
+//
+// Each returned line is exactly width columns wide.
+func (m *Model) chartView(
+	grid *chartGrid,
+	c chart,
+	focused bool,
+	width, height int,
+) ([]string, chartCell) {
+	// A column separates charts.
+	chartWidth := max(width-1, 1)
+	chartHeight := max(height-1, 1)
+	cell := chartCell{metric: c.metric, width: chartWidth, height: chartHeight}
+	if len(c.series) > 0 {
+		cell.series = c.series[0]
+	}
+
+	series := c.series
+	if m.config.Smoothing > 0 {
+		series = make([]*runview.Series, len(c.series))
+		for i, s := range c.series {
+			series[i] = s.Smoothed(m.config.Smoothing)
+		}
+	}
+
+	minX, maxX, minY, maxY, ok := seriesBounds(series)
+	cell.minX, cell.maxX = minX, maxX
+
+	title := c.metric
+	if m.selection.IsPinned(c.metric) {
+		title = "* " + title
+	}
+	if ok {
+		title += " " + formatValue(minY) + ".." + formatValue(maxY)
+	}
+	title = pad(title, chartWidth)
+	if focused {
+		title = m.sgr(title, "7")
+	}
+
+	lines := make([]string, 0, height)
+	lines = append(lines, title+" ")
+	if !ok {
+		for i := 0; i < chartHeight; i++ {
+			lines = append(lines, strings.Repeat(" ", width))
+		}
+		return lines, cell
+	}
+
+	layers := make([][]string, len(series))
+	for i, s := range series {
+		layers[i] = runview.LineChart(s.Points, minX, maxX, minY, maxY,
+			chartWidth, chartHeight, m.style)
+	}
+
+	marker := -1
+	if focused && grid.crosshair != nil && grid.crosshairMetric == c.metric {
+		if p, ok := grid.crosshair.Point(); ok && maxX > minX {
+			marker = int(math.Round((p.X - minX) / (maxX - minX) * float64(chartWidth-1)))
+		}
+	}
+
+	for _, line := range m.overlay(layers, c.colors, marker, chartWidth, chartHeight) {
+		lines = append(lines, line+" ")
+	}
+	return lines, cell
+}
+
+// seriesBounds returns the range of values of several series.
+func seriesBounds(series []*runview.Series) (minX, maxX, minY, maxY float64, ok bool) {
+	for _, s := range series {
+		x0, x1, y0, y1, sOK := s.Bounds()
+		if !sOK {
+			continue
+		}
+		if !ok {
+			minX, maxX, minY, maxY, ok = x0, x1, y0, y1, true
+			continue
+		}
+		minX, maxX = min(minX, x0), max(maxX, x1)
+		minY, maxY = min(minY, y0), max(maxY, y1)
+	}
+	return
+}
+
+// overlay combines charts drawn with the same bounds into one, coloring
+// each character by the last chart that has dots in it.
+//
+// Braille dots of different charts in the same character are all kept.
+// If marker is a column, it's drawn where no chart has dots, to show the
+// crosshair.
+func (m *Model) overlay(
+	layers [][]string,
+	colors []string,
+	marker, width, height int,
+) []string {
+	runes := make([][][]rune, len(layers))
+	for i, layer := range layers {
+		runes[i] = make([][]rune, len(layer))
+		for row, line := range layer {
+			runes[i][row] = []rune(line)
+		}
+	}
+
+	lines := make([]string, height)
+	for row := range lines {
+		var line strings.Builder
+		var segment strings.Builder
+		segmentLayer := -1
+
+		flush := func() {
+			if segmentLayer >= 0 && segmentLayer < len(colors) {
+				line.WriteString(m.colored(segment.String(), colors[segmentLayer]))
+			} else {
+				line.WriteString(segment.String())
+			}
+			segment.Reset()
+		}
+
+		for col := 0; col < width; col++ {
+			r, layer := ' ', -1
+			for i := range runes {
+				if row >= len(runes[i]) || col >= len(runes[i][row]) {
+					continue
+				}
+				c := runes[i][row][col]
+				if c == ' ' {
+					continue
+				}
+				if isBraille(r) && isBraille(c) {
+					c = brailleBlank | (r - brailleBlank) | (c - brailleBlank)
+				}
+				r, layer = c, i
+			}
+			if layer < 0 && col == marker {
+				r = '│'
+			}
+
+			if layer != segmentLayer {
+				flush()
+				segmentLayer = layer
+			}
+			segment.WriteRune(r)
+		}
+		flush()
+		lines[row] = line.String()
+	}
+	return lines
+}
+
+func isBraille(r rune) bool {
+	return r >= brailleBlank && r <= brailleBlank+0xff
+}
+
+// formatValue formats an axis value compactly.
+func formatValue(v float64) string {
+	return strconv.FormatFloat(v, 'g', 4, 64)
+}
@@ -0,0 +1,128 @@
+package leet
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// input is a key press or a mouse movement read from the terminal.
+type input struct {
+	// key is the key's name as passed to Model.HandleKey, or empty for
+	// mouse movements.
+	key string
+
+	// x and y are the mouse position, counting from 0.
+	x, y int
+}
+
+// csiKeys are the names of keys sent as "ESC [" sequences.
+var csiKeys = map[string]string{
+	"A":  "up",
+	"B":  "down",
+	"C":  "right",
+	"D":  "left",
+	"Z":  "shift+tab",
+	"5~": "pgup",
+	"6~": "pgdown",
+}
+
+// parseInput splits bytes read from a terminal in raw mode into inputs.
+//
+// Mouse positions are read from SGR mouse reports, which the terminal
+// sends once mouse tracking is enabled. The wheel scrolls like the arrow
+// keys. Sequences that aren't recognized are dropped.
+func parseInput(data []byte) []input {
+	var inputs []input
+	for len(data) > 0 {
+		switch c := data[0]; {
+		case c == 0x1b && len(data) == 1:
+			inputs = append(inputs, input{key: "esc"})
+			data = data[1:]
+
+		case c == 0x1b && (data[1] == '[' || data[1] == 'O'):
+			sequence, n := csiSequence(data[2:])
+			data = data[2+n:]
+			if in, ok := parseCSI(sequence); ok {
+				inputs = append(inputs, in)
+			}
+
+		case c == 0x1b:
+			inputs = append(inputs, input{key: "esc"})
+			data = data[1:]
+
+		case c == 0x03:
+			inputs = append(inputs, input{key: "ctrl+c"})
+			data = data[1:]
+
+		case c == '\r' || c == '\n':
+			inputs = append(inputs, input{key: "enter"})
+			data = data[1:]
+
+		case c == 0x7f || c == 0x08:
+			inputs = append(inputs, input{key: "backspace"})
+			data = data[1:]
+
+		case c == '\t':
+			inputs = append(inputs, input{key: "tab"})
+			data = data[1:]
+
+		case c < 0x20:
+			data = data[1:]
+
+		default:
+			r, n := utf8.DecodeRune(data)
+			if r != utf8.RuneError {
+				inputs = append(inputs, input{key: string(r)})
+			}
+			data = data[n:]
+		}
+	}
+	return inputs
+}
+
+// csiSequence returns the parameters and final byte of a control sequence
+// and its length.
+func csiSequence(data []byte) (string, int) {
+	for i, c := range data {
+		if c >= 0x40 && c <= 0x7e {
+			return string(data[:i+1]), i + 1
+		}
+	}
+	return "", len(data)
+}
+
+// parseCSI interprets a control sequence sent by the terminal.
+func parseCSI(sequence string) (input, bool) {
+	if key, ok := csiKeys[sequence]; ok {
+		return input{key: key}, true
+	}
+
+	// SGR mouse reports are "<button;x;y" followed by M or m.
+	report, ok := strings.CutPrefix(sequence, "<")
+	if !ok || len(report) == 0 {
+		return input{}, false
+	}
+	fields := strings.Split(report[:len(report)-1], ";")
+	if len(fields) != 3 {
+		return input{}, false
+	}
+
+	var values [3]int
+	for i, field := range fields {
+		value, err := strconv.Atoi(field)
+		if err != nil {
+			return input{}, false
+		}
+		values[i] = value
+	}
+
+	switch button := values[0]; button {
+	case 64:
+		return input{key: "up"}, true
+	case 65:
+		return input{key: "down"}, true
+	default:
+		return input{x: values[1] - 1, y: values[2] - 1}, true
+	}
+}
@@ -0,0 +1,35 @@
+package leet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseInput(t *testing.T) {
+	inputs := parseInput([]byte("a\x1b[A\x1b[6~\r\x7fé\x03\x1b"))
+
+	assert.Equal(t,
+		[]input{
+			{key: "a"},
+			{key: "up"},
+			{key: "pgdown"},
+			{key: "enter"},
+			{key: "backspace"},
+			{key: "é"},
+			{key: "ctrl+c"},
+			{key: "esc"},
+		},
+		inputs)
+}
+
+func TestParseInput_Mouse(t *testing.T) {
+	inputs := parseInput([]byte("\x1b[<35;10;5M\x1b[<64;1;1M\x1b[<0;1M"))
+
+	assert.Equal(t,
+		[]input{
+			{x: 9, y: 4},
+			{key: "up"},
+		},
+		inputs)
+}
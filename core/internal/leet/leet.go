@@ -0,0 +1,379 @@
+// Package leet is a terminal viewer for W&B runs.
+//
+// It shows a run's metrics as a grid of line charts along with its system
+// metrics, config, summary and console output, and follows the run while
+// it's being logged. The run can be read from its .wandb file or fetched
+// from the server, and all runs in a wandb directory can be listed and
+// compared.
+//
+// The views themselves are implemented by package runview; this package
+// lays them out on the terminal and handles input.
+package leet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/wandb/segmentio-encoding/json"
+	"github.com/wandb/wandb/core/internal/runview"
+	"github.com/wandb/wandb/core/internal/runwatch"
+	"github.com/wandb/wandb/core/internal/waiting"
+	"github.com/wandb/wandb/core/internal/watcher"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+const (
+	// followInterval is how often a run's file is reread in case change
+	// notifications are unavailable.
+	followInterval = time.Second
+
+	// pollInterval is how often a run on the server is checked for new
+	// history.
+	pollInterval = 5 * time.Second
+
+	// refreshInterval is how often a workspace is rescanned, so that runs
+	// that stopped being written are shown as finished.
+	refreshInterval = 10 * time.Second
+
+	// drawInterval limits how often updates from the data source are
+	// drawn.
+	drawInterval = 100 * time.Millisecond
+)
+
+// Params are the arguments of Run.
+type Params struct {
+	// Target is a .wandb file, a wandb directory, or a run on the server
+	// given as wandb://entity/project/run_id.
+	Target string
+
+	// Config is the view configuration, loaded from ConfigPath, which is
+	// reread when the user presses the reload key.
+	Config     runview.ViewConfig
+	ConfigPath string
+
+	Style runview.ChartStyle
+
+	// Client makes requests to the W&B server, or is nil if offline.
+	//
+	// It's required for runs on the server, and used to annotate runs.
+	Client graphql.Client
+
+	Logger *observability.CoreLogger
+}
+
+// Run shows the viewer on the terminal until the user quits or ctx is
+// cancelled.
+func Run(ctx context.Context, params Params) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	updates := make(chan func(*Model), 1024)
+	send := func(update func(*Model)) {
+		select {
+		case updates <- update:
+		case <-ctx.Done():
+		}
+	}
+
+	model := NewModel(params.Config, params.ConfigPath, params.Style)
+	model.SetClient(params.Client)
+	model.async = func(task func(context.Context) func(*Model)) {
+		go func() { send(task(ctx)) }()
+	}
+
+	stop, err := startSource(ctx, params, model, send)
+	if err != nil {
+		return err
+	}
+	defer stop()
+
+	restore, err := makeRaw(os.Stdin)
+	if err != nil {
+		return fmt.Errorf("leet: the terminal does not support raw input: %v", err)
+	}
+	defer restore()
+
+	// Use the alternate screen, hide the cursor and report mouse movements.
+	fmt.Print("\x1b[?1049h\x1b[?25l\x1b[?1003h\x1b[?1006h")
+	defer fmt.Print("\x1b[?1006l\x1b[?1003l\x1b[?25h\x1b[?1049l")
+
+	inputs := make(chan input, 64)
+	go readInput(os.Stdin, inputs)
+
+	ticker := time.NewTicker(drawInterval)
+	defer ticker.Stop()
+
+	draw(model)
+	dirty := false
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case in, ok := <-inputs:
+			if !ok {
+				return nil
+			}
+			if in.key == "" {
+				dirty = model.HandleMouse(in.x, in.y) || dirty
+				continue
+			}
+			model.HandleKey(in.key)
+			if model.Quit() {
+				return nil
+			}
+			draw(model)
+			dirty = false
+
+		case update := <-updates:
+			update(model)
+			dirty = true
+
+		case <-ticker.C:
+			if dirty {
+				draw(model)
+				dirty = false
+			}
+		}
+	}
+}
+
+// startSource reads the target's data into the model in the background.
+//
+// It returns a function that stops watching the target.
+func startSource(
+	ctx context.Context,
+	params Params,
+	model *Model,
+	send func(func(*Model)),
+) (func(), error) {
+	if strings.HasPrefix(params.Target, runwatch.URIScheme) {
+		return watchServerRun(ctx, params, model, send)
+	}
+
+	info, err := os.Stat(params.Target)
+	if err != nil {
+		return nil, fmt.Errorf("leet: %v", err)
+	}
+	if info.IsDir() {
+		return watchWorkspace(ctx, params, model, send)
+	}
+	return followFile(ctx, params, model, send)
+}
+
+// followFile reads a run's .wandb file and the records appended to it.
+func followFile(
+	ctx context.Context,
+	params Params,
+	model *Model,
+	send func(func(*Model)),
+) (func(), error) {
+	run := &runview.OpenRun{
+		Info:          runview.RunInfo{Path: params.Target},
+		Overview:      runview.NewOverview(),
+		History:       runview.NewHistory(),
+		SystemMetrics: runview.NewSystemMetrics(),
+	}
+	model.SetRun(run, true)
+
+	go func() {
+		err := runview.FollowRun(ctx, params.Target, followInterval,
+			func(record *service.Record) {
+				send(func(m *Model) { m.AddRecord(record) })
+			},
+			func(status runview.FollowStatus) {
+				send(func(m *Model) { m.SetLive(status == runview.FollowLive) })
+			},
+		)
+		if err != nil && ctx.Err() == nil {
+			send(func(m *Model) { m.SetStatus(err.Error()) })
+		}
+	}()
+
+	return func() {}, nil
+}
+
+// watchWorkspace lists the runs in a wandb directory and rereads them as
+// they change.
+func watchWorkspace(
+	ctx context.Context,
+	params Params,
+	model *Model,
+	send func(func(*Model)),
+) (func(), error) {
+	workspace := runview.NewWorkspace(params.Target)
+	model.SetWorkspace(workspace)
+
+	// Changes come in bursts as records are written, so at most one
+	// refresh is queued at a time.
+	var pending atomic.Bool
+	refresh := func() {
+		if pending.Swap(true) {
+			return
+		}
+		send(func(m *Model) {
+			pending.Store(false)
+			m.refresh()
+		})
+	}
+
+	fileWatcher := watcher.New(watcher.Params{Logger: params.Logger})
+	if err := workspace.Watch(fileWatcher, refresh); err != nil {
+		fileWatcher.Finish()
+		return nil, fmt.Errorf("leet: %v", err)
+	}
+
+	go func() {
+		ticker := time.NewTicker(refreshInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				refresh()
+			}
+		}
+	}()
+
+	return fileWatcher.Finish, nil
+}
+
+// watchServerRun fetches a run's history from the server and polls for
+// new rows while it's running.
+//
+// Only history is available this way; the other pages need the run's
+// .wandb file.
+func watchServerRun(
+	ctx context.Context,
+	params Params,
+	model *Model,
+	send func(func(*Model)),
+) (func(), error) {
+	path, err := runwatch.ParseRunURI(params.Target)
+	if err != nil {
+		return nil, err
+	}
+	if params.Client == nil {
+		return nil, errors.New("leet: viewing a run on the server requires being online")
+	}
+
+	run := &runview.OpenRun{
+		Overview:      runview.NewOverview(),
+		History:       runview.NewHistory(),
+		SystemMetrics: runview.NewSystemMetrics(),
+	}
+	// The overview identifies the run for annotations.
+	run.Overview.Entity = path.Entity
+	run.Overview.Project = path.Project
+	run.Overview.RunID = path.RunID
+	model.SetRun(run, true)
+
+	runWatcher := runwatch.NewWatcher(params.Client, path, runwatch.DefaultPageSize)
+	go func() {
+		err := runWatcher.Watch(ctx, waiting.NewDelay(pollInterval),
+			func(page *runwatch.Page) {
+				records := historyRecords(page.Rows)
+				live := page.IsRunning()
+				send(func(m *Model) {
+					for _, record := range records {
+						m.AddRecord(record)
+					}
+					m.SetLive(live)
+				})
+			})
+		if err != nil && ctx.Err() == nil {
+			send(func(m *Model) { m.SetStatus(err.Error()) })
+		}
+	}()
+
+	return func() {}, nil
+}
+
+// historyRecords converts history rows fetched from the server into
+// records.
+func historyRecords(rows []map[string]any) []*service.Record {
+	records := make([]*service.Record, 0, len(rows))
+	for _, row := range rows {
+		history := &service.HistoryRecord{}
+		for key, value := range row {
+			valueJSON, err := json.Marshal(value)
+			if err != nil {
+				continue
+			}
+			history.Item = append(history.Item, &service.HistoryItem{
+				Key:       key,
+				ValueJson: string(valueJSON),
+			})
+		}
+		if step, ok := row["_step"].(float64); ok {
+			history.Step = &service.HistoryStep{Num: int64(step)}
+		}
+
+		records = append(records, &service.Record{
+			RecordType: &service.Record_History{History: history},
+		})
+	}
+	return records
+}
+
+// readInput reads keys and mouse movements until the input is closed.
+func readInput(r io.Reader, inputs chan<- input) {
+	defer close(inputs)
+
+	buf := make([]byte, 256)
+	for {
+		n, err := r.Read(buf)
+		for _, in := range parseInput(buf[:n]) {
+			inputs <- in
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// draw redraws the screen.
+func draw(model *Model) {
+	width, height := screenSize()
+
+	var screen strings.Builder
+	screen.WriteString("\x1b[H")
+	for i, line := range model.View(width, height) {
+		if i > 0 {
+			// Output processing is off in raw mode, so lines must
+			// return the cursor explicitly.
+			screen.WriteString("\r\n")
+		}
+		screen.WriteString(line)
+		screen.WriteString("\x1b[K")
+	}
+	_, _ = os.Stdout.WriteString(screen.String())
+}
+
+// screenSize returns the size of the terminal, falling back to the
+// COLUMNS and LINES environment variables and then to 80x24.
+func screenSize() (int, int) {
+	if width, height, ok := terminalSize(os.Stdout); ok {
+		return width, height
+	}
+
+	width, err := strconv.Atoi(os.Getenv("COLUMNS"))
+	if err != nil || width <= 0 {
+		width = 80
+	}
+	height, err := strconv.Atoi(os.Getenv("LINES"))
+	if err != nil || height <= 0 {
+		height = 24
+	}
+	return width, height
+}
@@ -0,0 +1,629 @@
+package leet
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/wandb/wandb/core/internal/runview"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// page is one of the viewer's screens.
+type page int
+
+const (
+	pageCharts page = iota
+	pageSystem
+	pageOverview
+	pageConsole
+	pageTimeline
+	pageRuns
+)
+
+// pageActions are the actions that switch to each page other than the
+// charts.
+//
+// Pressing a page's key again returns to the charts.
+var pageActions = map[string]page{
+	runview.ActionSystem:   pageSystem,
+	runview.ActionOverview: pageOverview,
+	runview.ActionConsole:  pageConsole,
+	runview.ActionTimeline: pageTimeline,
+	runview.ActionRuns:     pageRuns,
+}
+
+func (p page) String() string {
+	switch p {
+	case pageCharts:
+		return "charts"
+	case pageSystem:
+		return "system"
+	case pageOverview:
+		return "overview"
+	case pageConsole:
+		return "console"
+	case pageTimeline:
+		return "timeline"
+	case pageRuns:
+		return "runs"
+	default:
+		return "unknown"
+	}
+}
+
+// promptKind is what the text typed into the prompt is for.
+type promptKind int
+
+const (
+	promptFilter promptKind = iota
+	promptSearch
+	promptAnnotate
+	promptStep
+	promptExport
+)
+
+func (k promptKind) String() string {
+	switch k {
+	case promptFilter:
+		return "filter"
+	case promptSearch:
+		return "search"
+	case promptAnnotate:
+		return "annotate"
+	case promptStep:
+		return "step"
+	case promptExport:
+		return "export to (.png or .svg)"
+	default:
+		return ""
+	}
+}
+
+// prompt is a line of text being typed by the user.
+type prompt struct {
+	kind promptKind
+	text string
+}
+
+// Model is the state of the viewer.
+//
+// It's updated by keys and by the run's data source, and drawn by View.
+// A Model is not safe for concurrent use: Run calls it only from its event
+// loop.
+type Model struct {
+	config     runview.ViewConfig
+	configPath string
+	style      runview.ChartStyle
+
+	// color is whether to color the output with ANSI escape codes.
+	color bool
+
+	// single is the run being viewed, or nil when viewing a workspace.
+	single *runview.OpenRun
+
+	// singleLive is whether the single run is still being logged.
+	singleLive bool
+
+	// workspace is the runs in a wandb directory, or nil.
+	workspace *runview.Workspace
+
+	// consoles are the console logs of runs by the path of their files.
+	consoles map[string]*runview.ConsoleLog
+
+	// client makes requests to the W&B server, or is nil if offline.
+	client graphql.Client
+
+	// async runs a slow task, such as a request to the server, and then
+	// updates the model with its result.
+	async func(task func(context.Context) func(*Model))
+
+	page      page
+	selection runview.MetricSelection
+
+	charts chartGrid
+	system chartGrid
+
+	overview overviewPage
+	console  consolePage
+	timeline timelinePage
+	runs     runsPage
+
+	prompt *prompt
+
+	// status is a message for the status line, or empty.
+	status string
+
+	// bodyHeight is the number of lines below the header in the last View.
+	bodyHeight int
+
+	quit bool
+}
+
+// NewModel returns a model that shows charts using a view configuration
+// loaded from configPath.
+//
+// Call SetRun or SetWorkspace to give it data. Requests to the server
+// are made synchronously unless the model is shown by Run.
+func NewModel(
+	config runview.ViewConfig,
+	configPath string,
+	style runview.ChartStyle,
+) *Model {
+	m := &Model{
+		config:     config,
+		configPath: configPath,
+		style:      style,
+		consoles:   make(map[string]*runview.ConsoleLog),
+		charts:     chartGrid{xName: "step"},
+		system:     chartGrid{xName: "seconds"},
+	}
+	m.async = func(task func(context.Context) func(*Model)) {
+		task(context.Background())(m)
+	}
+	m.applyConfig()
+	return m
+}
+
+// SetRun makes the model show a single run.
+//
+// If the run has a .wandb file, its console output is collected by
+// AddRecord and its timeline can be replayed.
+func (m *Model) SetRun(run *runview.OpenRun, live bool) {
+	m.single = run
+	m.singleLive = live
+	if run.Info.Path != "" {
+		m.consoles[run.Info.Path] = runview.NewConsoleLog()
+	}
+}
+
+// AddRecord updates the single run from one of its records.
+func (m *Model) AddRecord(record *service.Record) {
+	run := m.single
+	run.Overview.Add(record)
+	switch x := record.GetRecordType().(type) {
+	case *service.Record_History:
+		run.History.Add(x.History)
+	case *service.Record_Stats:
+		run.SystemMetrics.Add(x.Stats)
+	}
+
+	if console := m.consoles[run.Info.Path]; console != nil {
+		console.Add(record)
+	}
+}
+
+// SetWorkspace makes the model show the runs in a wandb directory.
+//
+// The most recent run is opened.
+func (m *Model) SetWorkspace(workspace *runview.Workspace) {
+	m.workspace = workspace
+	m.refresh()
+	if runs := workspace.Runs(); len(runs) > 0 {
+		m.toggleRun(runs[0].ID)
+	}
+}
+
+// SetClient sets the client used to make requests to the W&B server.
+func (m *Model) SetClient(client graphql.Client) {
+	m.client = client
+}
+
+// SetLive sets whether the single run is still being logged.
+func (m *Model) SetLive(live bool) {
+	m.singleLive = live
+}
+
+// SetStatus shows a message in the status line.
+func (m *Model) SetStatus(status string) {
+	m.status = status
+}
+
+// Quit reports whether the user asked to exit.
+func (m *Model) Quit() bool {
+	return m.quit
+}
+
+// applyConfig updates the model after the view configuration changes.
+func (m *Model) applyConfig() {
+	if err := m.selection.SetOrder(m.config.MetricOrder); err != nil {
+		m.status = err.Error()
+	}
+	m.color = os.Getenv("NO_COLOR") == ""
+}
+
+// reload rereads the view configuration file.
+func (m *Model) reload() {
+	if m.configPath == "" {
+		m.status = "no configuration file"
+		return
+	}
+
+	config, err := runview.LoadViewConfig(m.configPath)
+	m.config = config
+	m.applyConfig()
+	if err != nil {
+		m.status = err.Error()
+	} else {
+		m.status = "reloaded " + m.configPath
+	}
+}
+
+// refresh rereads the workspace's runs.
+func (m *Model) refresh() {
+	if m.workspace == nil {
+		return
+	}
+	if err := m.workspace.Refresh(); err != nil {
+		m.status = err.Error()
+	}
+	m.runs.clamp(len(m.workspace.Runs()))
+}
+
+// current returns the run shown on pages other than the charts, or nil.
+//
+// In a workspace, it's the opened run under the cursor of the runs list,
+// or else the run opened last.
+func (m *Model) current() *runview.OpenRun {
+	if m.workspace == nil {
+		return m.single
+	}
+
+	if runs := m.workspace.Runs(); m.runs.cursor < len(runs) {
+		if run := m.workspace.Run(runs[m.runs.cursor].ID); run != nil {
+			return run
+		}
+	}
+	if opened := m.workspace.Opened(); len(opened) > 0 {
+		return opened[len(opened)-1]
+	}
+	return nil
+}
+
+// isLive returns whether a run is still being logged.
+func (m *Model) isLive(run *runview.OpenRun) bool {
+	if run == m.single {
+		return m.singleLive
+	}
+	return run.Info.Live
+}
+
+// HandleKey updates the model for a key press.
+//
+// Keys are single characters or names such as "enter", "esc", "up" and
+// "ctrl+c". It returns false if the key does nothing.
+func (m *Model) HandleKey(key string) bool {
+	if key == "ctrl+c" {
+		m.quit = true
+		return true
+	}
+	if m.prompt != nil {
+		return m.handlePromptKey(key)
+	}
+
+	m.status = ""
+	switch m.config.Action(key) {
+	case runview.ActionQuit:
+		m.quit = true
+		return true
+	case runview.ActionReload:
+		m.reload()
+		return true
+	case runview.ActionAnnotate:
+		m.prompt = &prompt{kind: promptAnnotate}
+		return true
+	case runview.ActionExport:
+		m.prompt = &prompt{kind: promptExport}
+		return true
+	}
+
+	p, ok := pageActions[m.config.Action(key)]
+	if ok && (p != pageRuns || m.workspace != nil) {
+		m.togglePage(p)
+		return true
+	}
+
+	switch m.page {
+	case pageCharts:
+		return m.handleChartKey(&m.charts, key)
+	case pageSystem:
+		return m.handleChartKey(&m.system, key)
+	case pageOverview:
+		return m.handleOverviewKey(key)
+	case pageConsole:
+		return m.handleConsoleKey(key)
+	case pageTimeline:
+		return m.handleTimelineKey(key)
+	case pageRuns:
+		return m.handleRunsKey(key)
+	}
+	return false
+}
+
+// HandleMouse updates the model for the mouse moving to a cell of the
+// screen, counting from 0.
+func (m *Model) HandleMouse(x, y int) bool {
+	switch m.page {
+	case pageCharts:
+		return m.hoverChart(&m.charts, x, y)
+	case pageSystem:
+		return m.hoverChart(&m.system, x, y)
+	default:
+		return false
+	}
+}
+
+// togglePage switches to a page, or back to the charts if it's open.
+func (m *Model) togglePage(p page) {
+	if m.page == p {
+		m.page = pageCharts
+		return
+	}
+
+	m.page = p
+	switch p {
+	case pageConsole:
+		m.openConsole()
+	case pageTimeline:
+		m.openTimeline()
+	}
+}
+
+func (m *Model) handlePromptKey(key string) bool {
+	switch key {
+	case "esc":
+		m.prompt = nil
+	case "enter":
+		p := m.prompt
+		m.prompt = nil
+		m.submitPrompt(p)
+	case "backspace":
+		if _, size := utf8.DecodeLastRuneInString(m.prompt.text); size > 0 {
+			m.prompt.text = m.prompt.text[:len(m.prompt.text)-size]
+		}
+	default:
+		if utf8.RuneCountInString(key) != 1 {
+			return false
+		}
+		m.prompt.text += key
+	}
+
+	// The overview filters as the user types.
+	if m.page == pageOverview {
+		if m.prompt != nil {
+			m.overview.query = m.prompt.text
+		}
+		m.overview.offset = 0
+	}
+	return true
+}
+
+func (m *Model) submitPrompt(p *prompt) {
+	switch p.kind {
+	case promptFilter:
+		if m.page == pageOverview {
+			m.overview.query = p.text
+			return
+		}
+		if err := m.selection.SetFilter(p.text); err != nil {
+			m.status = err.Error()
+		}
+		m.charts.reset()
+		m.system.reset()
+	case promptSearch:
+		m.search(p.text)
+	case promptAnnotate:
+		m.annotate(p.text)
+	case promptStep:
+		m.gotoStep(p.text)
+	case promptExport:
+		m.export(strings.TrimSpace(p.text))
+	}
+}
+
+// annotate adds a note or tags to the current run on the server.
+func (m *Model) annotate(input string) {
+	run := m.current()
+	if run == nil {
+		m.status = "no run to annotate"
+		return
+	}
+
+	annotation, err := runview.ParseAnnotation(input)
+	if err != nil {
+		m.status = err.Error()
+		return
+	}
+
+	// The annotator updates its overview when done, so it gets a copy
+	// that is only read by the event loop once the request is over.
+	overview := *run.Overview
+	annotator, err := runview.NewAnnotator(m.client, &overview)
+	if err != nil {
+		m.status = err.Error()
+		return
+	}
+
+	m.status = "annotating..."
+	m.async(func(ctx context.Context) func(*Model) {
+		err := annotator.Apply(ctx, annotation)
+		return func(m *Model) {
+			if err != nil {
+				m.status = err.Error()
+				return
+			}
+			run.Overview.Notes = overview.Notes
+			run.Overview.Tags = overview.Tags
+			m.status = "annotated " + overview.RunID
+		}
+	})
+}
+
+// View draws the model as lines that fit in the given number of columns
+// and lines.
+func (m *Model) View(width, height int) []string {
+	width = max(width, 20)
+	height = max(height, 4)
+	m.bodyHeight = height - 2
+
+	var body []string
+	switch m.page {
+	case pageCharts:
+		body = m.chartsView(&m.charts, m.historyCharts(), width, m.bodyHeight)
+	case pageSystem:
+		body = m.chartsView(&m.system, m.systemCharts(), width, m.bodyHeight)
+	case pageOverview:
+		body = m.overviewView(width, m.bodyHeight)
+	case pageConsole:
+		body = m.consoleView(width, m.bodyHeight)
+	case pageTimeline:
+		body = m.timelineView(width, m.bodyHeight)
+	case pageRuns:
+		body = m.runsView(width, m.bodyHeight)
+	}
+
+	lines := make([]string, 0, height)
+	lines = append(lines, m.sgr(truncate(m.header(), width), "1"))
+	for i := 0; i < m.bodyHeight; i++ {
+		if i < len(body) {
+			lines = append(lines, body[i])
+		} else {
+			lines = append(lines, "")
+		}
+	}
+	lines = append(lines, truncate(m.statusLine(), width))
+	return lines
+}
+
+// header describes the run and the page.
+func (m *Model) header() string {
+	var title string
+	switch run := m.current(); {
+	case m.workspace != nil:
+		title = fmt.Sprintf("%d runs, %d open",
+			len(m.workspace.Runs()), len(m.workspace.Opened()))
+	case run != nil:
+		title = runTitle(run)
+		if m.isLive(run) {
+			title += " (live)"
+		}
+	}
+
+	var keys []string
+	for _, action := range []string{
+		runview.ActionSystem,
+		runview.ActionOverview,
+		runview.ActionConsole,
+		runview.ActionTimeline,
+		runview.ActionRuns,
+	} {
+		if action != runview.ActionRuns || m.workspace != nil {
+			keys = append(keys, m.config.Keys[action]+" "+action)
+		}
+	}
+	keys = append(keys, m.config.Keys[runview.ActionQuit]+" quit")
+	return fmt.Sprintf("leet: %s | %s | %s", title, m.page, strings.Join(keys, "  "))
+}
+
+// runTitle names a run by its display name and ID.
+func runTitle(run *runview.OpenRun) string {
+	overview := run.Overview
+	id := overview.RunID
+	if id == "" {
+		id = run.Info.ID
+	}
+
+	switch {
+	case overview.DisplayName != "" && id != "":
+		return overview.DisplayName + " [" + id + "]"
+	case id != "":
+		return id
+	default:
+		return run.Info.Path
+	}
+}
+
+// statusLine shows the prompt, a message, or hints for the page.
+func (m *Model) statusLine() string {
+	switch {
+	case m.prompt != nil:
+		return m.prompt.kind.String() + ": " + m.prompt.text + "_"
+	case m.status != "":
+		return m.status
+	}
+
+	switch m.page {
+	case pageCharts, pageSystem:
+		grid := &m.charts
+		if m.page == pageSystem {
+			grid = &m.system
+		}
+		if label := grid.label(); label != "" {
+			return label
+		}
+		hint := fmt.Sprintf("%s/%s page  tab focus  ←/→ inspect  %s pin  %s filter  %s export",
+			m.config.Keys[runview.ActionNextPage],
+			m.config.Keys[runview.ActionPrevPage],
+			m.config.Keys[runview.ActionPin],
+			m.config.Keys[runview.ActionFilter],
+			m.config.Keys[runview.ActionExport])
+		if filter := m.selection.Filter(); filter != "" {
+			hint = "filter: " + filter + "  " + hint
+		}
+		return hint
+	case pageOverview:
+		return fmt.Sprintf("↑/↓ scroll  %s search keys", m.config.Keys[runview.ActionFilter])
+	case pageConsole:
+		return m.console.hint(m)
+	case pageTimeline:
+		return "←/→ step  [/] config changes  g go to step  ↑/↓ scroll"
+	case pageRuns:
+		return "↑/↓ select  enter open/close"
+	}
+	return ""
+}
+
+// sgr applies an SGR style to text if colors are enabled.
+func (m *Model) sgr(text, code string) string {
+	if !m.color || text == "" {
+		return text
+	}
+	return "\x1b[" + code + "m" + text + "\x1b[0m"
+}
+
+// colored colors text with a "#rrggbb" color if colors are enabled.
+func (m *Model) colored(text, hex string) string {
+	var r, g, b int
+	if _, err := fmt.Sscanf(hex, "#%02x%02x%02x", &r, &g, &b); err != nil {
+		return text
+	}
+	return m.sgr(text, fmt.Sprintf("38;2;%d;%d;%d", r, g, b))
+}
+
+// truncate shortens text to at most width characters.
+func truncate(text string, width int) string {
+	if utf8.RuneCountInString(text) <= width {
+		return text
+	}
+	runes := []rune(text)
+	if width <= 1 {
+		return string(runes[:width])
+	}
+	return string(runes[:width-1]) + "…"
+}
+
+// pad extends text with spaces to width characters, or truncates it.
+func pad(text string, width int) string {
+	text = truncate(text, width)
+	return text + strings.Repeat(" ", width-utf8.RuneCountInString(text))
+}
+
+// window returns up to height lines starting at offset, which is clamped
+// so that the last line is shown at the bottom.
+func window(lines []string, offset *int, height int) []string {
+	*offset = min(max(*offset, 0), max(len(lines)-height, 0))
+	return lines[*offset:min(*offset+height, len(lines))]
+}
@@ -0,0 +1,302 @@
+package leet_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/gqlmock"
+	"github.com/wandb/wandb/core/internal/leet"
+	"github.com/wandb/wandb/core/internal/runview"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// history returns a history record with the given keys and values, in
+// order.
+func history(step int64, keysAndValues ...string) *service.Record {
+	record := &service.HistoryRecord{Step: &service.HistoryStep{Num: step}}
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		record.Item = append(record.Item, &service.HistoryItem{
+			Key:       keysAndValues[i],
+			ValueJson: keysAndValues[i+1],
+		})
+	}
+	return &service.Record{RecordType: &service.Record_History{History: record}}
+}
+
+func config(key, value string) *service.Record {
+	return &service.Record{RecordType: &service.Record_Config{
+		Config: &service.ConfigRecord{
+			Update: []*service.ConfigItem{{Key: key, ValueJson: value}},
+		},
+	}}
+}
+
+func stdout(line string) *service.Record {
+	return &service.Record{RecordType: &service.Record_OutputRaw{
+		OutputRaw: &service.OutputRawRecord{Line: line},
+	}}
+}
+
+func testRecords() []*service.Record {
+	return []*service.Record{
+		{RecordType: &service.Record_Run{Run: &service.RunRecord{
+			RunId:       "run1",
+			DisplayName: "bright-star-1",
+			Entity:      "entity",
+			Project:     "project",
+		}}},
+		config("lr", "0.1"),
+		config("batch_size", "32"),
+		history(0, "loss", "3", "acc", "0.1"),
+		stdout("epoch 0\n"),
+		history(1, "loss", "2", "acc", "0.5"),
+		config("lr", "0.01"),
+		stdout("loss diverged\n"),
+		history(2, "loss", "1", "acc", "0.7"),
+	}
+}
+
+// writeRunFile writes records to a .wandb file.
+func writeRunFile(t *testing.T, path string, records []*service.Record) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+
+	store := server.NewStore(context.Background(), path)
+	require.NoError(t, store.Open(os.O_WRONLY))
+	for _, record := range records {
+		require.NoError(t, store.Write(record))
+	}
+	require.NoError(t, store.Close())
+}
+
+// newTestModel returns a model showing a run that has logged testRecords
+// to a file.
+func newTestModel(t *testing.T) *leet.Model {
+	t.Helper()
+	t.Setenv("NO_COLOR", "1")
+
+	path := filepath.Join(t.TempDir(), "run-run1.wandb")
+	writeRunFile(t, path, testRecords())
+
+	m := leet.NewModel(runview.DefaultViewConfig(), "", runview.ChartASCII)
+	m.SetRun(&runview.OpenRun{
+		Info:          runview.RunInfo{Path: path},
+		Overview:      runview.NewOverview(),
+		History:       runview.NewHistory(),
+		SystemMetrics: runview.NewSystemMetrics(),
+	}, false)
+	for _, record := range testRecords() {
+		m.AddRecord(record)
+	}
+	return m
+}
+
+func typeKeys(m *leet.Model, keys ...string) {
+	for _, key := range keys {
+		m.HandleKey(key)
+	}
+}
+
+func screen(m *leet.Model) string {
+	return strings.Join(m.View(100, 30), "\n")
+}
+
+func statusLine(m *leet.Model) string {
+	lines := m.View(100, 30)
+	return lines[len(lines)-1]
+}
+
+func TestView_FitsScreen(t *testing.T) {
+	m := newTestModel(t)
+
+	for _, page := range []string{"", "s", "o", "l", "t"} {
+		typeKeys(m, page)
+		lines := m.View(60, 20)
+
+		assert.Len(t, lines, 20)
+		for _, line := range lines {
+			assert.LessOrEqual(t, len([]rune(line)), 60, "page %q: %q", page, line)
+		}
+		typeKeys(m, page)
+	}
+}
+
+func TestCharts(t *testing.T) {
+	m := newTestModel(t)
+
+	view := screen(m)
+
+	assert.Contains(t, view, "bright-star-1 [run1]")
+	assert.Contains(t, view, "loss 1..3")
+	assert.Contains(t, view, "acc 0.1..0.7")
+	assert.Contains(t, view, "page 1/1, 2 charts")
+}
+
+func TestCharts_FilterAndPin(t *testing.T) {
+	m := newTestModel(t)
+
+	typeKeys(m, "/", "a", "c", "enter")
+	view := screen(m)
+
+	assert.Contains(t, view, "acc 0.1..0.7")
+	assert.NotContains(t, view, "loss")
+	assert.Contains(t, statusLine(m), "filter: ac")
+
+	typeKeys(m, "/", "backspace", "backspace", "enter", "tab", "p")
+	assert.Contains(t, screen(m), "* acc")
+}
+
+func TestCharts_Crosshair(t *testing.T) {
+	m := newTestModel(t)
+	screen(m)
+
+	typeKeys(m, "right")
+	assert.Equal(t, "loss  step 2: 1", statusLine(m))
+
+	typeKeys(m, "left")
+	assert.Equal(t, "loss  step 1: 2", statusLine(m))
+}
+
+func TestCharts_MouseMovesCrosshair(t *testing.T) {
+	m := newTestModel(t)
+	screen(m)
+
+	// The first chart's lines start on the third line of the screen.
+	assert.True(t, m.HandleMouse(0, 3))
+
+	assert.Equal(t, "loss  step 0: 3", statusLine(m))
+}
+
+func TestSystemPage(t *testing.T) {
+	m := newTestModel(t)
+	m.AddRecord(&service.Record{RecordType: &service.Record_Stats{
+		Stats: &service.StatsRecord{
+			Item: []*service.StatsItem{{Key: "cpu", ValueJson: "12.5"}},
+		},
+	}})
+
+	typeKeys(m, "s")
+	view := screen(m)
+
+	assert.Contains(t, view, "| system |")
+	assert.Contains(t, view, "cpu 12.5..12.5")
+	assert.NotContains(t, view, "loss")
+}
+
+func TestOverviewPage_FiltersKeys(t *testing.T) {
+	m := newTestModel(t)
+
+	typeKeys(m, "o", "/", "b", "s")
+	view := screen(m)
+
+	assert.Contains(t, view, "Project:  entity/project")
+	assert.Contains(t, view, "batch_size = 32")
+	assert.NotContains(t, view, "lr = ")
+}
+
+func TestConsolePage_Search(t *testing.T) {
+	m := newTestModel(t)
+
+	typeKeys(m, "l", "/", "d", "i", "v", "enter")
+	view := screen(m)
+
+	assert.Contains(t, view, "  epoch 0")
+	assert.Contains(t, view, "> loss diverged")
+	assert.Equal(t, "match 1/1", statusLine(m))
+}
+
+func TestTimelinePage(t *testing.T) {
+	m := newTestModel(t)
+
+	typeKeys(m, "t")
+	assert.Contains(t, screen(m), "step 2 of 2")
+	assert.Contains(t, screen(m), "lr = 0.01")
+
+	typeKeys(m, "[")
+	assert.Contains(t, screen(m), "step 1 of 2")
+	assert.Contains(t, screen(m), "loss = 2")
+
+	typeKeys(m, "g", "0", "enter")
+	view := screen(m)
+	assert.Contains(t, view, "step 0 of 2")
+	assert.Contains(t, view, "lr = 0.1")
+	assert.Contains(t, view, "loss = 3")
+}
+
+func TestAnnotate(t *testing.T) {
+	client := gqlmock.NewMockClient()
+	client.StubMatchOnce(
+		gqlmock.WithOpName("RunNotes"),
+		`{"project": {"run": {"notes": "", "tags": []}}}`,
+	)
+	client.StubMatchOnce(
+		gqlmock.WithOpName("UpsertBucket"),
+		`{"upsertBucket": {"bucket": {}}}`,
+	)
+	m := newTestModel(t)
+	m.SetClient(client)
+
+	typeKeys(m, "a")
+	typeKeys(m, strings.Split("lr too high #diverged", "")...)
+	typeKeys(m, "enter")
+
+	assert.True(t, client.AllStubsUsed())
+	assert.Equal(t, "annotated run1", statusLine(m))
+
+	typeKeys(m, "o")
+	assert.Contains(t, screen(m), "Tags:     diverged")
+	assert.Contains(t, screen(m), "Notes:    lr too high")
+}
+
+func TestAnnotate_Offline(t *testing.T) {
+	m := newTestModel(t)
+
+	typeKeys(m, "a", "x", "enter")
+
+	assert.Contains(t, statusLine(m), "requires being online")
+}
+
+func TestReload(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+	path := filepath.Join(t.TempDir(), "leet.toml")
+	require.NoError(t, os.WriteFile(path, []byte("[keys]\nquit = \"x\"\n"), 0644))
+	m := leet.NewModel(runview.DefaultViewConfig(), path, runview.ChartASCII)
+
+	typeKeys(m, "R", "q")
+	assert.False(t, m.Quit())
+
+	typeKeys(m, "x")
+	assert.True(t, m.Quit())
+}
+
+func TestWorkspace(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+	dir := t.TempDir()
+	for i, id := range []string{"old", "new"} {
+		writeRunFile(t,
+			filepath.Join(dir,
+				fmt.Sprintf("run-2024010%d_100000-%s", i+1, id),
+				fmt.Sprintf("run-%s.wandb", id)),
+			[]*service.Record{history(0, "loss", fmt.Sprint(i))})
+	}
+	m := leet.NewModel(runview.DefaultViewConfig(), "", runview.ChartASCII)
+
+	m.SetWorkspace(runview.NewWorkspace(dir))
+	assert.Contains(t, screen(m), "2 runs, 1 open")
+
+	typeKeys(m, "w", "down", "enter")
+	view := screen(m)
+	assert.Contains(t, view, "2 runs, 2 open")
+	assert.Contains(t, view, "[x] new")
+	assert.Contains(t, view, "[x] old")
+
+	typeKeys(m, "w")
+	assert.Contains(t, screen(m), "loss 0..1")
+}
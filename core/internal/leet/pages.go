@@ -0,0 +1,530 @@
+package leet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/wandb/wandb/core/internal/runstate"
+	"github.com/wandb/wandb/core/internal/runview"
+	"github.com/wandb/wandb/core/pkg/server"
+)
+
+// overviewPage is the state of the page with the run's metadata, config
+// and summary.
+type overviewPage struct {
+	// query filters config and summary keys.
+	query string
+
+	offset int
+}
+
+func (m *Model) handleOverviewKey(key string) bool {
+	if m.config.Action(key) == runview.ActionFilter {
+		m.prompt = &prompt{kind: promptFilter, text: m.overview.query}
+		return true
+	}
+
+	switch key {
+	case "up":
+		m.overview.offset--
+	case "down":
+		m.overview.offset++
+	case "pgup":
+		m.overview.offset -= m.bodyHeight
+	case "pgdown":
+		m.overview.offset += m.bodyHeight
+	case "esc":
+		m.overview.query = ""
+	default:
+		return false
+	}
+	return true
+}
+
+func (m *Model) overviewView(width, height int) []string {
+	run := m.current()
+	if run == nil {
+		return []string{"no run is open"}
+	}
+	overview := run.Overview
+
+	var lines []string
+	field := func(name, value string) {
+		if value != "" {
+			lines = append(lines, truncate(fmt.Sprintf("%-9s %s", name+":", value), width))
+		}
+	}
+	field("Run", runTitle(run))
+	if overview.Entity != "" {
+		field("Project", overview.Entity+"/"+overview.Project)
+	}
+	field("Tags", strings.Join(overview.Tags, ", "))
+	field("Notes", strings.ReplaceAll(overview.Notes, "\n", " / "))
+	field("Host", overview.Environment.Host)
+	field("Python", overview.Environment.PythonVersion)
+	field("CLI", overview.Environment.CLIVersion)
+	if env := overview.Environment; env.GitRemote != "" || env.GitCommit != "" {
+		field("Git", strings.TrimSpace(env.GitRemote+" "+env.GitCommit))
+	}
+	if errs := overview.Errors(); len(errs) > 0 {
+		field("Errors", fmt.Sprintf("%d records could not be applied", len(errs)))
+	}
+
+	section := func(title string, entries []runview.KeyValue) {
+		lines = append(lines, "", m.sgr(title, "1"))
+		entries = runview.FuzzyFilter(entries, m.overview.query)
+		if len(entries) == 0 {
+			lines = append(lines, "  (none)")
+		}
+		for _, entry := range entries {
+			lines = append(lines, truncate("  "+entry.Key+" = "+entry.Value, width))
+		}
+	}
+	section("Config", overview.Config())
+	section("Summary", overview.Summary())
+
+	return window(lines, &m.overview.offset, height)
+}
+
+// consolePage is the state of the page with the run's console output.
+type consolePage struct {
+	// matches are the lines that contain the search query.
+	matches []int
+
+	// match is the index in matches of the selected match.
+	match int
+}
+
+// consoleLog returns the current run's console output, or nil if it's
+// not known.
+func (m *Model) consoleLog() *runview.ConsoleLog {
+	run := m.current()
+	if run == nil {
+		return nil
+	}
+	return m.consoles[run.Info.Path]
+}
+
+// openConsole loads the console output of a workspace's current run.
+//
+// A single run's console output is collected as its records are read, but
+// a workspace only keeps the metrics of its runs, so the output is read
+// from the run's file each time the page is opened.
+func (m *Model) openConsole() {
+	m.console = consolePage{}
+	run := m.current()
+	if run == nil || run == m.single || run.Info.Path == "" {
+		return
+	}
+
+	log := runview.NewConsoleLog()
+	if err := runview.ReadFile(run.Info.Path, log.Add); err != nil && !run.Info.Live {
+		m.status = err.Error()
+	}
+	m.consoles[run.Info.Path] = log
+}
+
+func (m *Model) handleConsoleKey(key string) bool {
+	log := m.consoleLog()
+	if log == nil {
+		return false
+	}
+
+	switch m.config.Action(key) {
+	case runview.ActionFilter:
+		m.prompt = &prompt{kind: promptSearch}
+		return true
+	case runview.ActionNextPage:
+		m.selectMatch(log, m.console.match+1)
+		return true
+	case runview.ActionPrevPage:
+		m.selectMatch(log, m.console.match-1)
+		return true
+	}
+
+	switch key {
+	case "up":
+		log.Scroll(-1)
+	case "down":
+		log.Scroll(1)
+	case "pgup":
+		log.Scroll(-m.bodyHeight)
+	case "pgdown":
+		log.Scroll(m.bodyHeight)
+	case "f":
+		log.SetFollowing(!log.Following())
+	case "esc":
+		m.console.matches = nil
+	default:
+		return false
+	}
+	return true
+}
+
+// search finds the console lines containing a query and shows the first.
+func (m *Model) search(query string) {
+	log := m.consoleLog()
+	if log == nil || query == "" {
+		m.console.matches = nil
+		return
+	}
+
+	m.console.matches = log.Search(query)
+	if len(m.console.matches) == 0 {
+		m.status = "no lines contain " + query
+		return
+	}
+	m.selectMatch(log, 0)
+}
+
+// selectMatch scrolls to a search match, wrapping around at either end.
+func (m *Model) selectMatch(log *runview.ConsoleLog, i int) {
+	n := len(m.console.matches)
+	if n == 0 {
+		return
+	}
+	m.console.match = (i%n + n) % n
+	log.ScrollTo(m.console.matches[m.console.match])
+	m.status = fmt.Sprintf("match %d/%d", m.console.match+1, n)
+}
+
+func (m *Model) consoleView(width, height int) []string {
+	log := m.consoleLog()
+	if log == nil {
+		return []string{"console output is only available from the run's .wandb file"}
+	}
+	if log.Len() == 0 {
+		return []string{"no console output"}
+	}
+
+	selected := -1
+	if len(m.console.matches) > 0 {
+		selected = m.console.matches[m.console.match]
+	}
+
+	log.SetHeight(height)
+	top, visible := log.View()
+	lines := make([]string, len(visible))
+	for i, line := range visible {
+		gutter := "  "
+		if top+i == selected {
+			gutter = "> "
+		}
+
+		text := line.Text
+		if line.Source != "" {
+			text = "[" + line.Source + "] " + text
+		}
+		text = truncate(gutter+text, width)
+
+		if line.Stderr {
+			text = m.sgr(text, "31")
+		}
+		lines[i] = text
+	}
+	return lines
+}
+
+func (p *consolePage) hint(m *Model) string {
+	log := m.consoleLog()
+	if log == nil {
+		return ""
+	}
+
+	following := "off"
+	if log.Following() {
+		following = "on"
+	}
+	return fmt.Sprintf("↑/↓ scroll  f follow (%s)  %s search  %s/%s matches",
+		following,
+		m.config.Keys[runview.ActionFilter],
+		m.config.Keys[runview.ActionNextPage],
+		m.config.Keys[runview.ActionPrevPage])
+}
+
+// timelinePage is the state of the page that shows the run's config and
+// summary as they were at a step.
+type timelinePage struct {
+	// path is the .wandb file being replayed.
+	path string
+
+	// loading is whether the file is still being read.
+	loading bool
+
+	// timeline is the run's replayed records, once loaded.
+	timeline *runstate.Timeline
+
+	// at is the step being shown, and lastStep the run's last step.
+	at       int64
+	lastStep int64
+
+	// revisions are the steps at which the config changed.
+	revisions []int64
+
+	state *runstate.State
+	err   error
+
+	offset int
+}
+
+// openTimeline replays the current run to its last step.
+func (m *Model) openTimeline() {
+	m.timeline = timelinePage{}
+	run := m.current()
+	switch {
+	case run == nil:
+		m.timeline.err = errors.New("no run is open")
+		return
+	case run.Info.Path == "":
+		m.timeline.err = errors.New("the timeline needs the run's .wandb file")
+		return
+	}
+
+	path := run.Info.Path
+	m.timeline.path = path
+	m.timeline.loading = true
+	m.async(func(context.Context) func(*Model) {
+		timeline, err := loadTimeline(path)
+		return func(m *Model) {
+			// Ignore the result if the page was reopened since.
+			if m.timeline.path != path || !m.timeline.loading {
+				return
+			}
+			m.showTimeline(timeline, err)
+		}
+	})
+}
+
+// loadTimeline reads a run's .wandb file for replaying.
+func loadTimeline(path string) (*runstate.Timeline, error) {
+	store := server.NewStore(context.Background(), path)
+	if err := store.Open(os.O_RDONLY); err != nil {
+		return nil, err
+	}
+	defer store.Close()
+
+	// Records that can't be applied are skipped, as in the overview.
+	return runstate.NewTimeline(store.Read, func(error) {})
+}
+
+// showTimeline shows a loaded timeline at its last step.
+func (m *Model) showTimeline(timeline *runstate.Timeline, err error) {
+	m.timeline.loading = false
+	m.timeline.err = err
+	if timeline == nil {
+		return
+	}
+
+	state := timeline.Last()
+	m.timeline.timeline = timeline
+	m.timeline.state = state
+	m.timeline.lastStep = state.Step
+	m.timeline.at = state.Step
+	for _, revision := range state.ConfigRevisions {
+		m.timeline.revisions = append(m.timeline.revisions, revision.Step)
+	}
+}
+
+// seek shows the state at a step.
+//
+// It replays records from the timeline's last checkpoint before the step,
+// so it's fast enough to do on each key press.
+func (m *Model) seek(step int64) {
+	if m.timeline.timeline == nil {
+		return
+	}
+
+	step = min(max(step, 0), m.timeline.lastStep)
+	state, err := m.timeline.timeline.At(runstate.Point{Step: step})
+	m.timeline.at = step
+	m.timeline.err = err
+	if state != nil {
+		m.timeline.state = state
+	}
+}
+
+// gotoStep shows the state at a step typed by the user.
+func (m *Model) gotoStep(text string) {
+	step, err := strconv.ParseInt(strings.TrimSpace(text), 10, 64)
+	if err != nil {
+		m.status = fmt.Sprintf("%q is not a step", text)
+		return
+	}
+	m.seek(step)
+}
+
+func (m *Model) handleTimelineKey(key string) bool {
+	t := &m.timeline
+	switch key {
+	case "left":
+		m.seek(t.at - 1)
+	case "right":
+		m.seek(t.at + 1)
+	case "[":
+		for i := len(t.revisions) - 1; i >= 0; i-- {
+			if t.revisions[i] < t.at {
+				m.seek(t.revisions[i])
+				break
+			}
+		}
+	case "]":
+		for _, step := range t.revisions {
+			if step > t.at {
+				m.seek(step)
+				break
+			}
+		}
+	case "g":
+		m.prompt = &prompt{kind: promptStep}
+	case "up":
+		t.offset--
+	case "down":
+		t.offset++
+	case "pgup":
+		t.offset -= m.bodyHeight
+	case "pgdown":
+		t.offset += m.bodyHeight
+	default:
+		return false
+	}
+	return true
+}
+
+func (m *Model) timelineView(width, height int) []string {
+	t := &m.timeline
+	var lines []string
+	if t.loading {
+		lines = append(lines, truncate("loading "+t.path+"...", width))
+	}
+	if t.err != nil {
+		lines = append(lines, truncate(t.err.Error(), width))
+	}
+	if t.state == nil {
+		return lines
+	}
+
+	header := fmt.Sprintf("step %d of %d", t.at, t.lastStep)
+	if !t.state.Time.IsZero() {
+		header += ", " + t.state.Time.Local().Format("2006-01-02 15:04:05")
+	}
+	lines = append(lines, m.sgr(header, "1"))
+
+	if len(t.revisions) > 0 {
+		steps := make([]string, len(t.revisions))
+		for i, step := range t.revisions {
+			steps[i] = strconv.FormatInt(step, 10)
+			if step <= t.at {
+				steps[i] += "*"
+			}
+		}
+		lines = append(lines, truncate(
+			"config changed at steps: "+strings.Join(steps, ", "), width))
+	}
+
+	for _, alert := range t.state.Alerts {
+		lines = append(lines, truncate(fmt.Sprintf("alert at step %d: %s: %s",
+			alert.Step, alert.GetTitle(), alert.GetText()), width))
+	}
+
+	section := func(title string, entries []runview.KeyValue) {
+		lines = append(lines, "", m.sgr(title, "1"))
+		for _, entry := range entries {
+			if strings.HasPrefix(entry.Key, "_wandb.") {
+				continue
+			}
+			lines = append(lines, truncate("  "+entry.Key+" = "+entry.Value, width))
+		}
+	}
+	section("Config", runview.Flatten(t.state.Config.Tree()))
+	section("Summary", runview.Flatten(t.state.Summary.Tree()))
+
+	return window(lines, &t.offset, height)
+}
+
+// runsPage is the state of the list of a workspace's runs.
+type runsPage struct {
+	cursor int
+	offset int
+}
+
+// clamp keeps the cursor on one of n runs.
+func (p *runsPage) clamp(n int) {
+	p.cursor = min(max(p.cursor, 0), max(n-1, 0))
+}
+
+// toggleRun opens a workspace's run, or closes it if it's open.
+func (m *Model) toggleRun(id string) {
+	if m.workspace.Run(id) != nil {
+		m.workspace.Close(id)
+		return
+	}
+	if err := m.workspace.Open(id); err != nil {
+		m.status = err.Error()
+	}
+}
+
+func (m *Model) handleRunsKey(key string) bool {
+	runs := m.workspace.Runs()
+	switch key {
+	case "up":
+		m.runs.cursor--
+	case "down":
+		m.runs.cursor++
+	case "enter", " ":
+		if m.runs.cursor < len(runs) {
+			m.toggleRun(runs[m.runs.cursor].ID)
+		}
+	default:
+		return false
+	}
+	m.runs.clamp(len(runs))
+	return true
+}
+
+func (m *Model) runsView(width, height int) []string {
+	runs := m.workspace.Runs()
+	if len(runs) == 0 {
+		return []string{"no runs in this directory"}
+	}
+
+	colors := make(map[string]string)
+	for i, run := range m.workspace.Opened() {
+		colors[run.Info.ID] = m.config.Color(i)
+	}
+
+	// Scroll just enough to keep the cursor visible.
+	m.runs.offset = min(m.runs.offset, m.runs.cursor)
+	m.runs.offset = max(m.runs.offset, m.runs.cursor-height+1)
+
+	var lines []string
+	for i := m.runs.offset; i < len(runs) && len(lines) < height; i++ {
+		run := runs[i]
+
+		var state []string
+		if run.Live {
+			state = append(state, "live")
+		}
+		if run.Offline {
+			state = append(state, "offline")
+		}
+
+		text := pad(fmt.Sprintf(" %-12s %s  %s",
+			run.ID,
+			run.StartTime.Format("2006-01-02 15:04:05"),
+			strings.Join(state, ", ")), width-3)
+		if i == m.runs.cursor {
+			text = m.sgr(text, "7")
+		}
+
+		marker := "[ ]"
+		if color, ok := colors[run.ID]; ok {
+			marker = m.colored("[x]", color)
+		}
+		lines = append(lines, marker+text)
+	}
+	return lines
+}
@@ -0,0 +1,131 @@
+package leet
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/wandb/wandb/core/internal/runview"
+)
+
+// SnapshotParams are the arguments of Snapshot.
+type SnapshotParams struct {
+	// RunPath is the run's .wandb file.
+	RunPath string
+
+	// OutputPath is the image file to write, ending in .png or .svg.
+	OutputPath string
+
+	// Filter is a regex or glob selecting the metrics to chart, or empty
+	// for all metrics.
+	Filter string
+
+	// System is whether to chart system metrics instead of history.
+	System bool
+
+	Image runview.ImageOptions
+}
+
+// Snapshot renders the charts of a run's .wandb file to an image.
+//
+// It is the headless counterpart of the viewer's export key, for use in
+// scripts.
+func Snapshot(params SnapshotParams) error {
+	selection := &runview.MetricSelection{}
+	if err := selection.SetFilter(params.Filter); err != nil {
+		return err
+	}
+
+	run, err := runview.ReadRun(params.RunPath)
+	if err != nil {
+		return err
+	}
+
+	charts := runCharts(run, selection, params.System)
+	if len(charts) == 0 {
+		return fmt.Errorf("leet: no metrics to chart in %s", params.RunPath)
+	}
+	return writeImage(params.OutputPath, charts, params.Image)
+}
+
+// runCharts returns a run's history or system metrics that pass the
+// selection.
+func runCharts(
+	run *runview.OpenRun,
+	selection *runview.MetricSelection,
+	system bool,
+) []*runview.Series {
+	var charts []*runview.Series
+	if system {
+		for _, metric := range selection.Select(run.SystemMetrics.Metrics()) {
+			charts = append(charts, run.SystemMetrics.Series(metric))
+		}
+	} else {
+		for _, metric := range selection.Select(run.History.Metrics()) {
+			charts = append(charts, run.History.Series(metric))
+		}
+	}
+	return charts
+}
+
+// writeImage writes charts to an image file whose format is chosen by its
+// extension, .png or .svg.
+func writeImage(
+	path string,
+	charts []*runview.Series,
+	opts runview.ImageOptions,
+) error {
+	var write func(*os.File) error
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".png":
+		write = func(f *os.File) error { return runview.WritePNG(f, charts, opts) }
+	case ".svg":
+		write = func(f *os.File) error { return runview.WriteSVG(f, charts, opts) }
+	default:
+		return fmt.Errorf("leet: unsupported image format: %s", path)
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("leet: %v", err)
+	}
+	if err := write(f); err != nil {
+		f.Close()
+		return fmt.Errorf("leet: %v", err)
+	}
+	return f.Close()
+}
+
+// export writes the charts of the current run to an image file, or to
+// "charts.png" or "system.png" if path is empty.
+//
+// The system page exports system metrics, and other pages the history.
+// In a workspace, the current run is exported, since images have one line
+// per chart.
+func (m *Model) export(path string) {
+	run := m.current()
+	if run == nil {
+		m.status = "no run to export"
+		return
+	}
+
+	system := m.page == pageSystem
+	if path == "" {
+		path = pageCharts.String() + ".png"
+		if system {
+			path = pageSystem.String() + ".png"
+		}
+	}
+
+	charts := runCharts(run, &m.selection, system)
+	if len(charts) == 0 {
+		m.status = "no charts to export"
+		return
+	}
+	if err := writeImage(path, charts, runview.DefaultImageOptions()); err != nil {
+		m.status = err.Error()
+		return
+	}
+	m.status = fmt.Sprintf("exported %d charts to %s", len(charts), path)
+}
@@ -0,0 +1,70 @@
+package leet_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/leet"
+	"github.com/wandb/wandb/core/internal/runview"
+)
+
+func TestExport(t *testing.T) {
+	m := newTestModel(t)
+	path := filepath.Join(t.TempDir(), "loss.svg")
+
+	typeKeys(m, "/", "l", "o", "s", "s", "enter", "e")
+	typeKeys(m, strings.Split(path, "")...)
+	typeKeys(m, "enter")
+
+	assert.Equal(t, "exported 1 charts to "+path, statusLine(m))
+	svg, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(svg), ">loss<")
+	assert.NotContains(t, string(svg), ">acc<")
+}
+
+func TestExport_UnsupportedFormat(t *testing.T) {
+	m := newTestModel(t)
+
+	typeKeys(m, "e", "x", ".", "g", "i", "f", "enter")
+
+	assert.Equal(t, "leet: unsupported image format: x.gif", statusLine(m))
+}
+
+func TestSnapshot(t *testing.T) {
+	dir := t.TempDir()
+	runPath := filepath.Join(dir, "run-run1.wandb")
+	writeRunFile(t, runPath, testRecords())
+	output := filepath.Join(dir, "charts.svg")
+
+	err := leet.Snapshot(leet.SnapshotParams{
+		RunPath:    runPath,
+		OutputPath: output,
+		Image:      runview.DefaultImageOptions(),
+	})
+
+	require.NoError(t, err)
+	svg, err := os.ReadFile(output)
+	require.NoError(t, err)
+	assert.Contains(t, string(svg), ">loss<")
+	assert.Contains(t, string(svg), ">acc<")
+}
+
+func TestSnapshot_NoMetrics(t *testing.T) {
+	dir := t.TempDir()
+	runPath := filepath.Join(dir, "run-run1.wandb")
+	writeRunFile(t, runPath, testRecords())
+
+	err := leet.Snapshot(leet.SnapshotParams{
+		RunPath:    runPath,
+		OutputPath: filepath.Join(dir, "charts.png"),
+		Filter:     "val/*",
+		Image:      runview.DefaultImageOptions(),
+	})
+
+	assert.ErrorContains(t, err, "no metrics to chart")
+}
@@ -0,0 +1,16 @@
+//go:build !linux && !darwin
+
+package leet
+
+import "os"
+
+// makeRaw does nothing on this platform, so keys are read once Enter is
+// pressed.
+func makeRaw(*os.File) (func(), error) {
+	return func() {}, nil
+}
+
+// terminalSize is unknown on this platform.
+func terminalSize(*os.File) (int, int, bool) {
+	return 0, 0, false
+}
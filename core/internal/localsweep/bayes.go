@@ -0,0 +1,238 @@
+package localsweep
+
+import (
+	"math"
+	"math/rand"
+)
+
+const (
+	// bayesMinObservations is the number of runs with a metric value
+	// needed before Bayesian search stops sampling at random.
+	bayesMinObservations = 2
+
+	// bayesCandidates is the number of random candidates from which
+	// Bayesian search picks the most promising.
+	bayesCandidates = 1000
+
+	// bayesLengthScale is the Gaussian process kernel's length scale,
+	// in units of the encoded parameters' [0, 1] range.
+	bayesLengthScale = 0.3
+
+	// bayesNoise is the variance of the observations' noise, relative to
+	// the variance of the metric.
+	bayesNoise = 1e-4
+)
+
+// suggestBayes picks the parameters with the highest expected improvement
+// under a Gaussian process model of the metric.
+//
+// Parameters are encoded as numbers in [0, 1] for the model, and the
+// candidates are sampled from the parameters' distributions, so that the
+// suggestions follow the same priors as random search.
+func suggestBayes(config *Config, state *State, rng *rand.Rand) map[string]any {
+	names := config.ParameterNames()
+	encode := func(params map[string]any) []float64 {
+		x := make([]float64, len(names))
+		for i, name := range names {
+			x[i] = config.Parameters[name].encode(params[name])
+		}
+		return x
+	}
+
+	var xs [][]float64
+	var ys []float64
+	for _, trial := range state.Trials {
+		if trial.Metric == nil || math.IsNaN(*trial.Metric) || math.IsInf(*trial.Metric, 0) {
+			continue
+		}
+		y := *trial.Metric
+		if config.Metric.Goal == GoalMaximize {
+			y = -y
+		}
+		xs = append(xs, encode(trial.Params))
+		ys = append(ys, y)
+	}
+	if len(xs) < bayesMinObservations {
+		return suggestRandom(config, rng)
+	}
+
+	model, ok := fitGP(xs, standardize(ys))
+	if !ok {
+		return suggestRandom(config, rng)
+	}
+
+	best := math.Inf(1)
+	for _, y := range model.ys {
+		best = math.Min(best, y)
+	}
+
+	var bestParams map[string]any
+	bestEI := math.Inf(-1)
+	for i := 0; i < bayesCandidates; i++ {
+		params := suggestRandom(config, rng)
+		mean, stddev := model.predict(encode(params))
+		ei := expectedImprovement(best, mean, stddev)
+		if ei > bestEI {
+			bestEI = ei
+			bestParams = params
+		}
+	}
+	return bestParams
+}
+
+// expectedImprovement is the expected amount by which a normally
+// distributed value is below best.
+func expectedImprovement(best, mean, stddev float64) float64 {
+	if stddev <= 0 {
+		return math.Max(best-mean, 0)
+	}
+	z := (best - mean) / stddev
+	pdf := math.Exp(-z*z/2) / math.Sqrt(2*math.Pi)
+	return (best-mean)*normalCDF(z) + stddev*pdf
+}
+
+// standardize shifts and scales values to have mean 0 and variance 1.
+func standardize(values []float64) []float64 {
+	mean := 0.0
+	for _, v := range values {
+		mean += v
+	}
+	mean /= float64(len(values))
+
+	variance := 0.0
+	for _, v := range values {
+		variance += (v - mean) * (v - mean)
+	}
+	stddev := math.Sqrt(variance / float64(len(values)))
+	if stddev == 0 {
+		stddev = 1
+	}
+
+	result := make([]float64, len(values))
+	for i, v := range values {
+		result[i] = (v - mean) / stddev
+	}
+	return result
+}
+
+// gaussianProcess is a Gaussian process regression model.
+type gaussianProcess struct {
+	xs [][]float64
+	ys []float64
+
+	// chol is the Cholesky factor of the observations' covariance matrix.
+	chol [][]float64
+
+	// alpha is the covariance matrix's inverse applied to ys.
+	alpha []float64
+}
+
+// fitGP fits a Gaussian process to observations.
+//
+// It returns false if the covariance matrix is numerically singular.
+func fitGP(xs [][]float64, ys []float64) (*gaussianProcess, bool) {
+	n := len(xs)
+	cov := make([][]float64, n)
+	for i := range xs {
+		cov[i] = make([]float64, n)
+		for j := range xs {
+			cov[i][j] = kernel(xs[i], xs[j])
+		}
+		cov[i][i] += bayesNoise
+	}
+
+	chol, ok := cholesky(cov)
+	if !ok {
+		return nil, false
+	}
+	return &gaussianProcess{
+		xs:    xs,
+		ys:    ys,
+		chol:  chol,
+		alpha: solveUpper(chol, solveLower(chol, ys)),
+	}, true
+}
+
+// predict returns the mean and standard deviation of the model at x.
+func (gp *gaussianProcess) predict(x []float64) (mean, stddev float64) {
+	k := make([]float64, len(gp.xs))
+	for i, xi := range gp.xs {
+		k[i] = kernel(x, xi)
+	}
+
+	for i := range k {
+		mean += k[i] * gp.alpha[i]
+	}
+
+	v := solveLower(gp.chol, k)
+	variance := kernel(x, x)
+	for _, vi := range v {
+		variance -= vi * vi
+	}
+	return mean, math.Sqrt(math.Max(variance, 0))
+}
+
+// kernel is the squared exponential covariance function.
+func kernel(a, b []float64) float64 {
+	dist2 := 0.0
+	for i := range a {
+		d := a[i] - b[i]
+		dist2 += d * d
+	}
+	return math.Exp(-dist2 / (2 * bayesLengthScale * bayesLengthScale))
+}
+
+// cholesky returns the lower triangular L such that L Lᵀ = a, or false if
+// a is not positive definite.
+func cholesky(a [][]float64) ([][]float64, bool) {
+	n := len(a)
+	l := make([][]float64, n)
+	for i := range l {
+		l[i] = make([]float64, n)
+	}
+
+	for i := 0; i < n; i++ {
+		for j := 0; j <= i; j++ {
+			sum := a[i][j]
+			for k := 0; k < j; k++ {
+				sum -= l[i][k] * l[j][k]
+			}
+			if i == j {
+				if sum <= 0 {
+					return nil, false
+				}
+				l[i][i] = math.Sqrt(sum)
+			} else {
+				l[i][j] = sum / l[j][j]
+			}
+		}
+	}
+	return l, true
+}
+
+// solveLower solves L x = b for a lower triangular L.
+func solveLower(l [][]float64, b []float64) []float64 {
+	x := make([]float64, len(b))
+	for i := range b {
+		sum := b[i]
+		for k := 0; k < i; k++ {
+			sum -= l[i][k] * x[k]
+		}
+		x[i] = sum / l[i][i]
+	}
+	return x
+}
+
+// solveUpper solves Lᵀ x = b for a lower triangular L.
+func solveUpper(l [][]float64, b []float64) []float64 {
+	n := len(b)
+	x := make([]float64, n)
+	for i := n - 1; i >= 0; i-- {
+		sum := b[i]
+		for k := i + 1; k < n; k++ {
+			sum -= l[k][i] * x[k]
+		}
+		x[i] = sum / l[i][i]
+	}
+	return x
+}
@@ -0,0 +1,247 @@
+// Package localsweep runs hyperparameter sweeps without the W&B server.
+//
+// Parameters are suggested locally using grid search, random search or
+// Bayesian optimization, so that sweeps work offline and in air-gapped
+// environments. The sweep's progress is checkpointed to its directory
+// so that an interrupted sweep can be resumed.
+package localsweep
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Search methods.
+const (
+	MethodGrid   = "grid"
+	MethodRandom = "random"
+	MethodBayes  = "bayes"
+)
+
+// Metric goals.
+const (
+	GoalMinimize = "minimize"
+	GoalMaximize = "maximize"
+)
+
+// Parameter distributions.
+const (
+	DistConstant         = "constant"
+	DistCategorical      = "categorical"
+	DistIntUniform       = "int_uniform"
+	DistUniform          = "uniform"
+	DistQUniform         = "q_uniform"
+	DistLogUniformValues = "log_uniform_values"
+	DistNormal           = "normal"
+)
+
+// Config is a sweep configuration, in the same format as for sweeps on
+// the W&B server.
+//
+// Only the fields used for local suggestions are included. Nested
+// parameters and early termination are not supported.
+type Config struct {
+	// Method is the search method: "grid", "random" or "bayes".
+	Method string `yaml:"method"`
+
+	// Metric is the metric to optimize. It is required for "bayes".
+	Metric Metric `yaml:"metric"`
+
+	// Parameters are the hyperparameters to search, by name.
+	Parameters map[string]*Parameter `yaml:"parameters"`
+
+	// RunCap is the maximum number of runs, or 0 for no limit.
+	RunCap int `yaml:"run_cap"`
+
+	// Program is the script to run if no command is given.
+	Program string `yaml:"program"`
+}
+
+// Metric is a metric that a sweep optimizes.
+type Metric struct {
+	// Name is the metric's key in the run's summary. Nested keys are
+	// separated by dots.
+	Name string `yaml:"name"`
+
+	// Goal is "minimize" or "maximize". It defaults to "minimize".
+	Goal string `yaml:"goal"`
+}
+
+// Parameter is the range of values of a hyperparameter.
+type Parameter struct {
+	// Distribution is the parameter's distribution.
+	//
+	// If not given, it is inferred: "constant" if Value is set,
+	// "categorical" if Values is set, and otherwise "int_uniform" if Min
+	// and Max are integers or "uniform" if not.
+	Distribution string `yaml:"distribution"`
+
+	// Value is the value of a constant parameter.
+	Value any `yaml:"value"`
+
+	// Values are the values of a categorical parameter.
+	Values []any `yaml:"values"`
+
+	// Min and Max bound uniform parameters.
+	Min *float64 `yaml:"min"`
+	Max *float64 `yaml:"max"`
+
+	// Q is the quantization step of a "q_uniform" parameter.
+	Q float64 `yaml:"q"`
+
+	// Mu and Sigma are the mean and standard deviation of a normal
+	// parameter. They default to 0 and 1.
+	Mu    float64 `yaml:"mu"`
+	Sigma float64 `yaml:"sigma"`
+
+	// integers is whether Min and Max were both written as integers.
+	integers bool
+}
+
+// ParseConfig parses and validates a YAML sweep configuration.
+func ParseConfig(data []byte) (*Config, error) {
+	var config Config
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("localsweep: invalid config: %v", err)
+	}
+
+	// Parse the parameters' bounds again to tell integers from floats.
+	var bounds struct {
+		Parameters map[string]struct {
+			Min any `yaml:"min"`
+			Max any `yaml:"max"`
+		} `yaml:"parameters"`
+	}
+	if err := yaml.Unmarshal(data, &bounds); err != nil {
+		return nil, fmt.Errorf("localsweep: invalid config: %v", err)
+	}
+	for name, param := range config.Parameters {
+		if param == nil {
+			return nil, fmt.Errorf("localsweep: parameter %q is empty", name)
+		}
+		_, minIsInt := bounds.Parameters[name].Min.(int)
+		_, maxIsInt := bounds.Parameters[name].Max.(int)
+		param.integers = minIsInt && maxIsInt
+	}
+
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+// validate checks the configuration and fills in defaults.
+func (c *Config) validate() error {
+	switch c.Method {
+	case MethodGrid, MethodRandom, MethodBayes:
+	case "":
+		return fmt.Errorf("localsweep: method is required")
+	default:
+		return fmt.Errorf("localsweep: unknown method %q", c.Method)
+	}
+
+	switch c.Metric.Goal {
+	case "":
+		c.Metric.Goal = GoalMinimize
+	case GoalMinimize, GoalMaximize:
+	default:
+		return fmt.Errorf("localsweep: unknown metric goal %q", c.Metric.Goal)
+	}
+	if c.Method == MethodBayes && c.Metric.Name == "" {
+		return fmt.Errorf("localsweep: bayes search requires a metric")
+	}
+
+	if len(c.Parameters) == 0 {
+		return fmt.Errorf("localsweep: no parameters")
+	}
+	for _, name := range c.ParameterNames() {
+		if err := c.Parameters[name].validate(); err != nil {
+			return fmt.Errorf("localsweep: parameter %q: %v", name, err)
+		}
+		if c.Method == MethodGrid && !c.Parameters[name].isDiscrete() {
+			return fmt.Errorf(
+				"localsweep: parameter %q: grid search requires values", name)
+		}
+	}
+	return nil
+}
+
+// ParameterNames returns the names of the parameters in sorted order.
+func (c *Config) ParameterNames() []string {
+	names := make([]string, 0, len(c.Parameters))
+	for name := range c.Parameters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// validate checks the parameter and infers its distribution.
+func (p *Parameter) validate() error {
+	if p.Distribution == "" {
+		switch {
+		case p.Value != nil:
+			p.Distribution = DistConstant
+		case len(p.Values) > 0:
+			p.Distribution = DistCategorical
+		case p.integers:
+			p.Distribution = DistIntUniform
+		default:
+			p.Distribution = DistUniform
+		}
+	}
+
+	switch p.Distribution {
+	case DistConstant:
+		if p.Value == nil {
+			return fmt.Errorf("constant requires value")
+		}
+	case DistCategorical:
+		if len(p.Values) == 0 {
+			return fmt.Errorf("categorical requires values")
+		}
+	case DistIntUniform, DistUniform, DistQUniform, DistLogUniformValues:
+		if p.Min == nil || p.Max == nil {
+			return fmt.Errorf("%s requires min and max", p.Distribution)
+		}
+		if *p.Min > *p.Max {
+			return fmt.Errorf("min is greater than max")
+		}
+		if p.Distribution == DistIntUniform &&
+			(*p.Min != math.Trunc(*p.Min) || *p.Max != math.Trunc(*p.Max)) {
+			return fmt.Errorf("int_uniform requires integer min and max")
+		}
+		if p.Distribution == DistQUniform && p.Q <= 0 {
+			return fmt.Errorf("q_uniform requires a positive q")
+		}
+		if p.Distribution == DistLogUniformValues && *p.Min <= 0 {
+			return fmt.Errorf("log_uniform_values requires a positive min")
+		}
+	case DistNormal:
+		if p.Sigma == 0 {
+			p.Sigma = 1
+		}
+		if p.Sigma < 0 {
+			return fmt.Errorf("normal requires a positive sigma")
+		}
+	default:
+		return fmt.Errorf("unknown distribution %q", p.Distribution)
+	}
+	return nil
+}
+
+// isDiscrete returns whether the parameter has a finite list of values.
+func (p *Parameter) isDiscrete() bool {
+	return p.Distribution == DistConstant || p.Distribution == DistCategorical
+}
+
+// choices returns the values of a discrete parameter.
+func (p *Parameter) choices() []any {
+	if p.Distribution == DistConstant {
+		return []any{p.Value}
+	}
+	return p.Values
+}
@@ -0,0 +1,66 @@
+package localsweep_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/localsweep"
+)
+
+func TestParseConfig_InfersDistributions(t *testing.T) {
+	config, err := localsweep.ParseConfig([]byte(`
+method: random
+metric:
+  name: loss
+parameters:
+  epochs:
+    value: 10
+  optimizer:
+    values: [adam, sgd]
+  layers:
+    min: 1
+    max: 4
+  dropout:
+    min: 0.0
+    max: 0.5
+  lr:
+    distribution: log_uniform_values
+    min: 0.0001
+    max: 0.1
+`))
+
+	require.NoError(t, err)
+	assert.Equal(t, localsweep.GoalMinimize, config.Metric.Goal)
+	assert.Equal(t,
+		[]string{"dropout", "epochs", "layers", "lr", "optimizer"},
+		config.ParameterNames())
+	assert.Equal(t, localsweep.DistConstant, config.Parameters["epochs"].Distribution)
+	assert.Equal(t, localsweep.DistCategorical, config.Parameters["optimizer"].Distribution)
+	assert.Equal(t, localsweep.DistIntUniform, config.Parameters["layers"].Distribution)
+	assert.Equal(t, localsweep.DistUniform, config.Parameters["dropout"].Distribution)
+	assert.Equal(t, localsweep.DistLogUniformValues, config.Parameters["lr"].Distribution)
+}
+
+func TestParseConfig_Errors(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		config string
+		err    string
+	}{
+		{"no method", "parameters: {x: {value: 1}}", "method is required"},
+		{"unknown method", "method: hyperband\nparameters: {x: {value: 1}}", "unknown method"},
+		{"no parameters", "method: random", "no parameters"},
+		{"bayes without metric", "method: bayes\nparameters: {x: {min: 0, max: 1}}", "requires a metric"},
+		{"grid with range", "method: grid\nparameters: {x: {min: 0, max: 1}}", "grid search requires values"},
+		{"min above max", "method: random\nparameters: {x: {min: 2, max: 1}}", "min is greater than max"},
+		{"bad log range", "method: random\nparameters: {x: {distribution: log_uniform_values, min: 0, max: 1}}", "positive min"},
+		{"unknown distribution", "method: random\nparameters: {x: {distribution: beta}}", "unknown distribution"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := localsweep.ParseConfig([]byte(tc.config))
+
+			assert.ErrorContains(t, err, tc.err)
+		})
+	}
+}
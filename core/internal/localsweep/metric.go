@@ -0,0 +1,100 @@
+package localsweep
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// FindRunFile returns the path of a run's transaction log in a wandb
+// directory, or "" if there isn't one.
+//
+// If the run was resumed, the most recent log is returned.
+func FindRunFile(wandbDir, runID string) string {
+	// Online runs are in "run-*" directories and offline runs are in
+	// "offline-run-*" directories; both end in the run ID.
+	matches, _ := filepath.Glob(filepath.Join(
+		wandbDir, "*run-*-"+runID, fmt.Sprintf("run-%s.wandb", runID)))
+	if len(matches) == 0 {
+		return ""
+	}
+
+	latest := matches[0]
+	for _, path := range matches[1:] {
+		// Directory names start with the run's start time, after the
+		// "offline-" prefix if any.
+		if runDirTime(path) > runDirTime(latest) {
+			latest = path
+		}
+	}
+	return latest
+}
+
+// runDirTime returns the start time part of a run file's directory name.
+func runDirTime(runFile string) string {
+	dir := filepath.Base(filepath.Dir(runFile))
+	return strings.TrimPrefix(dir, "offline-")
+}
+
+// ReadMetric returns the final value of a metric in a run's transaction
+// log.
+//
+// The value is the last one in the run's summary, which is the last
+// logged value unless the run set its summary explicitly. It returns
+// false if the run never logged a number for the metric.
+func ReadMetric(runFile, name string) (float64, bool, error) {
+	store := server.NewStore(context.Background(), runFile)
+	if err := store.Open(os.O_RDONLY); err != nil {
+		return 0, false, fmt.Errorf("localsweep: %v", err)
+	}
+	defer store.Close()
+
+	var value float64
+	var found bool
+	update := func(key string, nestedKey []string, valueJSON string) {
+		if len(nestedKey) > 0 {
+			key = strings.Join(nestedKey, ".")
+		}
+		if key != name {
+			return
+		}
+		var x float64
+		if json.Unmarshal([]byte(valueJSON), &x) == nil {
+			value, found = x, true
+		}
+	}
+
+	for {
+		record, err := store.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			// A run that crashed may leave a partial record at the end.
+			if found {
+				break
+			}
+			return 0, false, fmt.Errorf("localsweep: failed to read %s: %v", runFile, err)
+		}
+
+		switch x := record.RecordType.(type) {
+		case *service.Record_History:
+			for _, item := range x.History.GetItem() {
+				update(item.GetKey(), item.GetNestedKey(), item.GetValueJson())
+			}
+		case *service.Record_Summary:
+			for _, item := range x.Summary.GetUpdate() {
+				update(item.GetKey(), item.GetNestedKey(), item.GetValueJson())
+			}
+		}
+	}
+	return value, found, nil
+}
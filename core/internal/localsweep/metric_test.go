@@ -0,0 +1,87 @@
+package localsweep_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/localsweep"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// writeRunFile writes a transaction log with the records.
+func writeRunFile(t *testing.T, path string, records ...*service.Record) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	store := server.NewStore(context.Background(), path)
+	require.NoError(t, store.Open(os.O_WRONLY))
+	for _, record := range records {
+		require.NoError(t, store.Write(record))
+	}
+	require.NoError(t, store.Close())
+}
+
+func historyRecord(items ...*service.HistoryItem) *service.Record {
+	return &service.Record{RecordType: &service.Record_History{
+		History: &service.HistoryRecord{Item: items},
+	}}
+}
+
+func TestReadMetric_LastValue(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "run.wandb")
+	writeRunFile(t, path,
+		historyRecord(&service.HistoryItem{NestedKey: []string{"val", "loss"}, ValueJson: "3"}),
+		historyRecord(&service.HistoryItem{NestedKey: []string{"val", "loss"}, ValueJson: "2"}),
+		historyRecord(&service.HistoryItem{Key: "other", ValueJson: "1"}),
+	)
+
+	value, ok, err := localsweep.ReadMetric(path, "val.loss")
+
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 2.0, value)
+}
+
+func TestReadMetric_SummaryOverridesHistory(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "run.wandb")
+	writeRunFile(t, path,
+		historyRecord(&service.HistoryItem{Key: "loss", ValueJson: "3"}),
+		&service.Record{RecordType: &service.Record_Summary{
+			Summary: &service.SummaryRecord{
+				Update: []*service.SummaryItem{{Key: "loss", ValueJson: "1"}},
+			},
+		}},
+	)
+
+	value, ok, err := localsweep.ReadMetric(path, "loss")
+
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 1.0, value)
+}
+
+func TestReadMetric_NotLogged(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "run.wandb")
+	writeRunFile(t, path,
+		historyRecord(&service.HistoryItem{Key: "loss", ValueJson: `"nan?"`}))
+
+	_, ok, err := localsweep.ReadMetric(path, "loss")
+
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestFindRunFile(t *testing.T) {
+	wandbDir := t.TempDir()
+	older := filepath.Join(wandbDir, "run-20240101_000000-abc", "run-abc.wandb")
+	newer := filepath.Join(wandbDir, "offline-run-20240102_000000-abc", "run-abc.wandb")
+	writeRunFile(t, older)
+	writeRunFile(t, newer)
+
+	assert.Equal(t, newer, localsweep.FindRunFile(wandbDir, "abc"))
+	assert.Equal(t, "", localsweep.FindRunFile(wandbDir, "xyz"))
+}
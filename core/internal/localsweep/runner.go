@@ -0,0 +1,275 @@
+package localsweep
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/wandb/wandb/core/pkg/utils"
+	"gopkg.in/yaml.v3"
+)
+
+// runStopTimeout is how long a run has to exit after being interrupted
+// before it's killed.
+const runStopTimeout = 30 * time.Second
+
+// SweepID returns the ID of a local sweep with a configuration.
+//
+// The ID depends only on the configuration file's contents, so that
+// running the same sweep again resumes it.
+func SweepID(configData []byte) string {
+	hash := sha256.Sum256(configData)
+	return hex.EncodeToString(hash[:4])
+}
+
+// SweepDir returns the directory a local sweep's state is saved in.
+func SweepDir(wandbDir, sweepID string) string {
+	return filepath.Join(wandbDir, "sweep-local-"+sweepID)
+}
+
+// Runner runs the trials of a local sweep one at a time.
+type Runner struct {
+	config   *Config
+	command  []string
+	wandbDir string
+	sweepDir string
+	count    int
+	output   io.Writer
+	rng      *rand.Rand
+}
+
+type Params struct {
+	Config *Config
+
+	// Command is the command each trial runs.
+	//
+	// Arguments "${args}" and "${args_no_hyphens}" are replaced by the
+	// trial's parameters as "--name=value" or "name=value" arguments. If
+	// empty, the configuration's program is run with Python and the
+	// parameters as arguments.
+	Command []string
+
+	// WandbDir is the wandb directory the trials' runs are written to.
+	WandbDir string
+
+	// SweepID identifies the sweep; see SweepID.
+	SweepID string
+
+	// Count is the maximum number of trials to run, or 0 to run until
+	// the sweep is complete.
+	Count int
+
+	// Output is where the trials' output goes; os.Stdout if nil.
+	Output io.Writer
+
+	// Rand is the source of random suggestions; a randomly seeded one if
+	// nil.
+	Rand *rand.Rand
+}
+
+func NewRunner(params Params) *Runner {
+	if params.Output == nil {
+		params.Output = os.Stdout
+	}
+	if params.Rand == nil {
+		params.Rand = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+
+	command := params.Command
+	if len(command) == 0 {
+		command = []string{"python", params.Config.Program, "${args}"}
+	}
+
+	return &Runner{
+		config:   params.Config,
+		command:  command,
+		wandbDir: params.WandbDir,
+		sweepDir: SweepDir(params.WandbDir, params.SweepID),
+		count:    params.Count,
+		output:   params.Output,
+		rng:      params.Rand,
+	}
+}
+
+// Run runs trials until the sweep is complete, Count trials have run or
+// the context is cancelled.
+//
+// The sweep's state is saved after each trial starts and ends, and is
+// loaded first so that an interrupted sweep continues where it stopped.
+// A trial that is running when the context is cancelled is interrupted
+// and will be run again when the sweep is resumed.
+func (r *Runner) Run(ctx context.Context) error {
+	if err := os.MkdirAll(r.sweepDir, 0755); err != nil {
+		return fmt.Errorf("localsweep: %v", err)
+	}
+
+	state, err := LoadState(r.sweepDir)
+	if err != nil {
+		return err
+	}
+	if len(state.Trials) > 0 {
+		fmt.Fprintf(r.output, "resuming sweep with %d runs from %s\n",
+			state.NumCounted(), r.sweepDir)
+	}
+
+	for n := 0; r.count <= 0 || n < r.count; n++ {
+		params, ok := Suggest(r.config, state, r.rng)
+		if !ok {
+			fmt.Fprintln(r.output, "sweep complete")
+			break
+		}
+
+		trial := &Trial{
+			RunID:  utils.ShortID(8),
+			Params: params,
+			Status: TrialRunning,
+		}
+		state.Trials = append(state.Trials, trial)
+		if err := state.Save(r.sweepDir); err != nil {
+			return err
+		}
+
+		r.runTrial(ctx, trial)
+		if err := state.Save(r.sweepDir); err != nil {
+			return err
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+	}
+
+	if best := state.Best(r.config.Metric.Goal); best != nil {
+		fmt.Fprintf(r.output, "best run: %s with %s=%v\n",
+			best.RunID, r.config.Metric.Name, *best.Metric)
+	}
+	return nil
+}
+
+// runTrial runs a trial's command and records its outcome.
+func (r *Runner) runTrial(ctx context.Context, trial *Trial) {
+	fail := func(err error) {
+		fmt.Fprintf(r.output, "run %s failed: %v\n", trial.RunID, err)
+		trial.Status = TrialFailed
+	}
+
+	paramPath, err := r.writeParams(trial)
+	if err != nil {
+		fail(err)
+		return
+	}
+
+	cmd := exec.CommandContext(ctx, r.command[0], r.args(trial.Params)...)
+	cmd.Env = append(os.Environ(),
+		"WANDB_RUN_ID="+trial.RunID,
+		"WANDB_SWEEP_PARAM_PATH="+paramPath,
+	)
+	cmd.Stdout = r.output
+	cmd.Stderr = r.output
+	cmd.Cancel = func() error { return cmd.Process.Signal(os.Interrupt) }
+	cmd.WaitDelay = runStopTimeout
+
+	fmt.Fprintf(r.output, "starting run %s with %s\n",
+		trial.RunID, formatParams(r.config, trial.Params))
+	err = cmd.Run()
+	switch {
+	case ctx.Err() != nil:
+		trial.Status = TrialCrashed
+		return
+	case err != nil:
+		fail(err)
+	default:
+		trial.Status = TrialFinished
+	}
+
+	if r.config.Metric.Name == "" {
+		return
+	}
+	runFile := FindRunFile(r.wandbDir, trial.RunID)
+	if runFile == "" {
+		fmt.Fprintf(r.output, "run %s has no data in %s\n", trial.RunID, r.wandbDir)
+		return
+	}
+	value, ok, err := ReadMetric(runFile, r.config.Metric.Name)
+	switch {
+	case err != nil:
+		fmt.Fprintf(r.output, "run %s: %v\n", trial.RunID, err)
+	case !ok:
+		fmt.Fprintf(r.output, "run %s did not log %s\n", trial.RunID, r.config.Metric.Name)
+	default:
+		trial.Metric = &value
+		fmt.Fprintf(r.output, "run %s: %s=%v\n", trial.RunID, r.config.Metric.Name, value)
+	}
+}
+
+// writeParams writes a trial's parameters to the file that wandb.init()
+// reads them from, returning its path.
+func (r *Runner) writeParams(trial *Trial) (string, error) {
+	config := make(map[string]map[string]any, len(trial.Params))
+	for name, value := range trial.Params {
+		config[name] = map[string]any{"value": value}
+	}
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		return "", fmt.Errorf("localsweep: %v", err)
+	}
+
+	path, err := filepath.Abs(filepath.Join(
+		r.sweepDir, fmt.Sprintf("config-%s.yaml", trial.RunID)))
+	if err != nil {
+		return "", fmt.Errorf("localsweep: %v", err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return "", fmt.Errorf("localsweep: %v", err)
+	}
+	return path, nil
+}
+
+// args returns the arguments of a trial's command.
+func (r *Runner) args(params map[string]any) []string {
+	var args []string
+	for _, arg := range r.command[1:] {
+		switch arg {
+		case "${args}":
+			for _, name := range r.config.ParameterNames() {
+				args = append(args, fmt.Sprintf("--%s=%s", name, formatValue(params[name])))
+			}
+		case "${args_no_hyphens}":
+			for _, name := range r.config.ParameterNames() {
+				args = append(args, fmt.Sprintf("%s=%s", name, formatValue(params[name])))
+			}
+		default:
+			args = append(args, arg)
+		}
+	}
+	return args
+}
+
+// formatParams formats a trial's parameters for printing.
+func formatParams(config *Config, params map[string]any) string {
+	parts := make([]string, 0, len(params))
+	for _, name := range config.ParameterNames() {
+		parts = append(parts, fmt.Sprintf("%s=%s", name, formatValue(params[name])))
+	}
+	return strings.Join(parts, " ")
+}
+
+// formatValue formats a parameter value as a command line argument.
+func formatValue(value any) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
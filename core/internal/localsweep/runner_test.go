@@ -0,0 +1,115 @@
+package localsweep_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/localsweep"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// copyRunScript is a command that writes a run by copying the
+// transaction log named after its parameters, like "x=1.wandb".
+const copyRunScript = `
+set -e
+run_dir="$0/run-20240101_000000-$WANDB_RUN_ID"
+mkdir -p "$run_dir"
+cp "$0/templates/$1.wandb" "$run_dir/run-$WANDB_RUN_ID.wandb"
+echo "$WANDB_SWEEP_PARAM_PATH" >> "$0/param_paths"
+`
+
+func newTestRunner(
+	t *testing.T,
+	wandbDir string,
+	configYAML string,
+	count int,
+	output *bytes.Buffer,
+) *localsweep.Runner {
+	t.Helper()
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh is not installed")
+	}
+
+	return localsweep.NewRunner(localsweep.Params{
+		Config: parseConfig(t, configYAML),
+		Command: []string{
+			"sh", "-c", copyRunScript, wandbDir, "${args_no_hyphens}",
+		},
+		WandbDir: wandbDir,
+		SweepID:  "test",
+		Count:    count,
+		Output:   output,
+	})
+}
+
+func TestRunner_GridSweep(t *testing.T) {
+	wandbDir := t.TempDir()
+	for x, loss := range map[string]string{"1": "0.3", "2": "0.1", "3": "0.2"} {
+		writeRunFile(t,
+			filepath.Join(wandbDir, "templates", "x="+x+".wandb"),
+			historyRecord(&service.HistoryItem{Key: "loss", ValueJson: loss}))
+	}
+	config := "method: grid\nmetric: {name: loss}\nparameters: {x: {values: [1, 2, 3]}}"
+	output := &bytes.Buffer{}
+
+	// Run part of the sweep, then resume it.
+	err := newTestRunner(t, wandbDir, config, 2, output).Run(context.Background())
+	require.NoError(t, err)
+	err = newTestRunner(t, wandbDir, config, 0, output).Run(context.Background())
+	require.NoError(t, err)
+
+	state, err := localsweep.LoadState(localsweep.SweepDir(wandbDir, "test"))
+	require.NoError(t, err)
+	var metrics []float64
+	for _, trial := range state.Trials {
+		assert.Equal(t, localsweep.TrialFinished, trial.Status)
+		require.NotNil(t, trial.Metric)
+		metrics = append(metrics, *trial.Metric)
+	}
+	assert.Equal(t, []float64{0.3, 0.1, 0.2}, metrics)
+	assert.Contains(t, output.String(), "sweep complete")
+	assert.Contains(t, output.String(), "best run: "+state.Trials[1].RunID+" with loss=0.1")
+
+	// Each run gets its parameters in a file for wandb.init().
+	paramPaths, err := os.ReadFile(filepath.Join(wandbDir, "param_paths"))
+	require.NoError(t, err)
+	firstPath := strings.Split(string(paramPaths), "\n")[0]
+	params, err := os.ReadFile(firstPath)
+	require.NoError(t, err)
+	assert.Equal(t, "x:\n    value: 1\n", string(params))
+}
+
+func TestRunner_FailedRun(t *testing.T) {
+	wandbDir := t.TempDir()
+	output := &bytes.Buffer{}
+	runner := newTestRunner(t, wandbDir,
+		"method: random\nmetric: {name: loss}\nparameters: {x: {value: 1}}",
+		1, output)
+
+	// There is no template for the run to copy.
+	err := runner.Run(context.Background())
+
+	require.NoError(t, err)
+	state, err := localsweep.LoadState(localsweep.SweepDir(wandbDir, "test"))
+	require.NoError(t, err)
+	require.Len(t, state.Trials, 1)
+	assert.Equal(t, localsweep.TrialFailed, state.Trials[0].Status)
+	assert.Nil(t, state.Trials[0].Metric)
+	assert.Contains(t, output.String(), "failed")
+}
+
+func TestSweepID_DependsOnConfig(t *testing.T) {
+	assert.Equal(t,
+		localsweep.SweepID([]byte("method: grid")),
+		localsweep.SweepID([]byte("method: grid")))
+	assert.NotEqual(t,
+		localsweep.SweepID([]byte("method: grid")),
+		localsweep.SweepID([]byte("method: random")))
+}
@@ -0,0 +1,138 @@
+package localsweep
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// TrialStatus is the outcome of a trial.
+type TrialStatus string
+
+const (
+	// TrialRunning means the trial's command hasn't exited.
+	TrialRunning TrialStatus = "running"
+
+	// TrialFinished means the trial's command exited successfully.
+	TrialFinished TrialStatus = "finished"
+
+	// TrialFailed means the trial's command exited with an error.
+	TrialFailed TrialStatus = "failed"
+
+	// TrialCrashed means the sweep stopped while the trial was running.
+	//
+	// Crashed trials don't count towards the sweep's run cap, and their
+	// grid points are tried again.
+	TrialCrashed TrialStatus = "crashed"
+)
+
+// Trial is one run of a sweep.
+type Trial struct {
+	// RunID is the ID of the trial's run.
+	RunID string `json:"run_id"`
+
+	// Params are the hyperparameters the run used.
+	Params map[string]any `json:"params"`
+
+	Status TrialStatus `json:"status"`
+
+	// Metric is the final value of the sweep's metric, if the run
+	// logged it.
+	Metric *float64 `json:"metric,omitempty"`
+}
+
+// State is the progress of a sweep.
+type State struct {
+	Trials []*Trial `json:"trials"`
+}
+
+// stateFileName is the name of the file a sweep's state is saved to.
+const stateFileName = "state.json"
+
+// LoadState reads a sweep's state from its directory.
+//
+// It returns an empty state if the sweep hasn't started. Trials that were
+// running when the state was saved are marked as crashed.
+func LoadState(dir string) (*State, error) {
+	data, err := os.ReadFile(filepath.Join(dir, stateFileName))
+	if errors.Is(err, os.ErrNotExist) {
+		return &State{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("localsweep: failed to read state: %v", err)
+	}
+
+	state := &State{}
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("localsweep: invalid state: %v", err)
+	}
+	for _, trial := range state.Trials {
+		if trial.Status == TrialRunning {
+			trial.Status = TrialCrashed
+		}
+	}
+	return state, nil
+}
+
+// Save writes the state to a sweep's directory.
+//
+// The file is replaced atomically so that a crash doesn't corrupt it.
+func (s *State) Save(dir string) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("localsweep: failed to encode state: %v", err)
+	}
+
+	tmp, err := os.CreateTemp(dir, stateFileName+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("localsweep: failed to save state: %v", err)
+	}
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), filepath.Join(dir, stateFileName))
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("localsweep: failed to save state: %v", err)
+	}
+	return nil
+}
+
+// NumCounted returns the number of trials that count towards the run cap.
+func (s *State) NumCounted() int {
+	n := 0
+	for _, trial := range s.Trials {
+		if trial.Status != TrialCrashed {
+			n++
+		}
+	}
+	return n
+}
+
+// Best returns the trial with the best metric value, or nil if no trial
+// has one.
+func (s *State) Best(goal string) *Trial {
+	var best *Trial
+	for _, trial := range s.Trials {
+		if trial.Metric == nil {
+			continue
+		}
+		if best == nil || isBetter(*trial.Metric, *best.Metric, goal) {
+			best = trial
+		}
+	}
+	return best
+}
+
+// isBetter returns whether a metric value is better than another.
+func isBetter(value, than float64, goal string) bool {
+	if goal == GoalMaximize {
+		return value > than
+	}
+	return value < than
+}
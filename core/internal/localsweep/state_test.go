@@ -0,0 +1,52 @@
+package localsweep_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/localsweep"
+)
+
+func TestState_SaveAndLoad(t *testing.T) {
+	dir := t.TempDir()
+	metric := 0.5
+	state := &localsweep.State{Trials: []*localsweep.Trial{
+		{
+			RunID:  "run1",
+			Params: map[string]any{"lr": 0.1},
+			Status: localsweep.TrialFinished,
+			Metric: &metric,
+		},
+		{RunID: "run2", Status: localsweep.TrialRunning},
+	}}
+
+	require.NoError(t, state.Save(dir))
+	loaded, err := localsweep.LoadState(dir)
+
+	require.NoError(t, err)
+	require.Len(t, loaded.Trials, 2)
+	assert.Equal(t, state.Trials[0], loaded.Trials[0])
+	assert.Equal(t, localsweep.TrialCrashed, loaded.Trials[1].Status)
+	assert.Equal(t, 1, loaded.NumCounted())
+}
+
+func TestLoadState_NotStarted(t *testing.T) {
+	state, err := localsweep.LoadState(t.TempDir())
+
+	require.NoError(t, err)
+	assert.Empty(t, state.Trials)
+}
+
+func TestState_Best(t *testing.T) {
+	low, high := 1.0, 2.0
+	state := &localsweep.State{Trials: []*localsweep.Trial{
+		{RunID: "none"},
+		{RunID: "low", Metric: &low},
+		{RunID: "high", Metric: &high},
+	}}
+
+	assert.Equal(t, "low", state.Best(localsweep.GoalMinimize).RunID)
+	assert.Equal(t, "high", state.Best(localsweep.GoalMaximize).RunID)
+	assert.Nil(t, (&localsweep.State{}).Best(localsweep.GoalMinimize))
+}
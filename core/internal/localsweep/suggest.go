@@ -0,0 +1,164 @@
+package localsweep
+
+import (
+	"encoding/json"
+	"math"
+	"math/rand"
+)
+
+// Suggest returns the hyperparameters for the sweep's next run.
+//
+// It returns false if there are no more runs to do, because the run cap
+// is reached or a grid search has tried every combination.
+func Suggest(config *Config, state *State, rng *rand.Rand) (map[string]any, bool) {
+	if config.RunCap > 0 && state.NumCounted() >= config.RunCap {
+		return nil, false
+	}
+
+	switch config.Method {
+	case MethodGrid:
+		return suggestGrid(config, state)
+	case MethodBayes:
+		return suggestBayes(config, state, rng), true
+	default:
+		return suggestRandom(config, rng), true
+	}
+}
+
+// suggestGrid returns the first combination of parameter values that no
+// trial has used, in lexicographic order of the parameters' names.
+func suggestGrid(config *Config, state *State) (map[string]any, bool) {
+	tried := make(map[string]bool)
+	for _, trial := range state.Trials {
+		if trial.Status != TrialCrashed {
+			tried[paramsKey(trial.Params)] = true
+		}
+	}
+
+	names := config.ParameterNames()
+	indexes := make([]int, len(names))
+	for {
+		params := make(map[string]any, len(names))
+		for i, name := range names {
+			params[name] = config.Parameters[name].choices()[indexes[i]]
+		}
+		if !tried[paramsKey(params)] {
+			return params, true
+		}
+
+		// Advance to the next combination, like an odometer.
+		i := len(names) - 1
+		for ; i >= 0; i-- {
+			indexes[i]++
+			if indexes[i] < len(config.Parameters[names[i]].choices()) {
+				break
+			}
+			indexes[i] = 0
+		}
+		if i < 0 {
+			return nil, false
+		}
+	}
+}
+
+// suggestRandom samples each parameter from its distribution.
+func suggestRandom(config *Config, rng *rand.Rand) map[string]any {
+	params := make(map[string]any, len(config.Parameters))
+	for _, name := range config.ParameterNames() {
+		params[name] = config.Parameters[name].sample(rng)
+	}
+	return params
+}
+
+// paramsKey returns a string that is equal for equal parameters.
+//
+// Numbers are compared by value, so that parameters read back from a
+// saved state match those from the configuration.
+func paramsKey(params map[string]any) string {
+	// Maps are encoded with sorted keys.
+	data, err := json.Marshal(params)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+// sample returns a random value of the parameter.
+func (p *Parameter) sample(rng *rand.Rand) any {
+	switch p.Distribution {
+	case DistConstant:
+		return p.Value
+	case DistCategorical:
+		return p.Values[rng.Intn(len(p.Values))]
+	case DistIntUniform:
+		return int(*p.Min) + rng.Intn(int(*p.Max-*p.Min)+1)
+	case DistUniform:
+		return *p.Min + rng.Float64()*(*p.Max-*p.Min)
+	case DistQUniform:
+		x := *p.Min + rng.Float64()*(*p.Max-*p.Min)
+		return math.Round(x/p.Q) * p.Q
+	case DistLogUniformValues:
+		logMin, logMax := math.Log(*p.Min), math.Log(*p.Max)
+		return math.Exp(logMin + rng.Float64()*(logMax-logMin))
+	case DistNormal:
+		return p.Mu + p.Sigma*rng.NormFloat64()
+	}
+	return nil
+}
+
+// encode maps a value of the parameter to a number in [0, 1].
+//
+// Values that are close for the parameter's distribution are mapped to
+// close numbers.
+func (p *Parameter) encode(value any) float64 {
+	switch p.Distribution {
+	case DistCategorical:
+		if len(p.Values) < 2 {
+			return 0
+		}
+		key := paramsKey(map[string]any{"": value})
+		for i, v := range p.Values {
+			if paramsKey(map[string]any{"": v}) == key {
+				return float64(i) / float64(len(p.Values)-1)
+			}
+		}
+		return 0
+	case DistIntUniform, DistUniform, DistQUniform:
+		return normalize(toFloat(value), *p.Min, *p.Max)
+	case DistLogUniformValues:
+		return normalize(
+			math.Log(math.Max(toFloat(value), *p.Min)),
+			math.Log(*p.Min),
+			math.Log(*p.Max),
+		)
+	case DistNormal:
+		return normalCDF((toFloat(value) - p.Mu) / p.Sigma)
+	}
+	return 0
+}
+
+// normalize maps x from [lo, hi] to [0, 1].
+func normalize(x, lo, hi float64) float64 {
+	if hi <= lo {
+		return 0
+	}
+	return math.Min(math.Max((x-lo)/(hi-lo), 0), 1)
+}
+
+// toFloat converts a numeric parameter value to a float64.
+func toFloat(value any) float64 {
+	switch x := value.(type) {
+	case int:
+		return float64(x)
+	case int64:
+		return float64(x)
+	case float64:
+		return x
+	}
+	return 0
+}
+
+// normalCDF is the standard normal cumulative distribution function.
+func normalCDF(x float64) float64 {
+	return 0.5 * math.Erfc(-x/math.Sqrt2)
+}
@@ -0,0 +1,141 @@
+package localsweep_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/localsweep"
+)
+
+func parseConfig(t *testing.T, yaml string) *localsweep.Config {
+	t.Helper()
+	config, err := localsweep.ParseConfig([]byte(yaml))
+	require.NoError(t, err)
+	return config
+}
+
+func TestSuggest_GridTriesEachCombinationOnce(t *testing.T) {
+	config := parseConfig(t, `
+method: grid
+parameters:
+  a: {values: [1, 2]}
+  b: {values: [x, y]}
+  c: {value: true}
+`)
+	state := &localsweep.State{}
+	rng := rand.New(rand.NewSource(0))
+
+	var suggested []map[string]any
+	for {
+		params, ok := localsweep.Suggest(config, state, rng)
+		if !ok {
+			break
+		}
+		suggested = append(suggested, params)
+		state.Trials = append(state.Trials, &localsweep.Trial{
+			Params: params,
+			Status: localsweep.TrialFinished,
+		})
+	}
+
+	assert.Equal(t, []map[string]any{
+		{"a": 1, "b": "x", "c": true},
+		{"a": 1, "b": "y", "c": true},
+		{"a": 2, "b": "x", "c": true},
+		{"a": 2, "b": "y", "c": true},
+	}, suggested)
+}
+
+func TestSuggest_GridRetriesCrashedTrials(t *testing.T) {
+	config := parseConfig(t, "method: grid\nparameters: {a: {values: [1, 2]}}")
+	state := &localsweep.State{Trials: []*localsweep.Trial{
+		// Parameters read back from a saved state are float64.
+		{Params: map[string]any{"a": 1.0}, Status: localsweep.TrialFailed},
+		{Params: map[string]any{"a": 2.0}, Status: localsweep.TrialCrashed},
+	}}
+
+	params, ok := localsweep.Suggest(config, state, rand.New(rand.NewSource(0)))
+
+	assert.True(t, ok)
+	assert.Equal(t, map[string]any{"a": 2}, params)
+}
+
+func TestSuggest_RandomStaysInRange(t *testing.T) {
+	config := parseConfig(t, `
+method: random
+parameters:
+  layers: {min: 1, max: 3}
+  dropout: {min: 0.1, max: 0.2}
+  lr: {distribution: log_uniform_values, min: 0.001, max: 0.1}
+  batch: {distribution: q_uniform, min: 16, max: 128, q: 16}
+`)
+	rng := rand.New(rand.NewSource(0))
+
+	for i := 0; i < 100; i++ {
+		params, ok := localsweep.Suggest(config, &localsweep.State{}, rng)
+
+		require.True(t, ok)
+		assert.Contains(t, []any{1, 2, 3}, params["layers"])
+		assert.InDelta(t, 0.15, params["dropout"], 0.05)
+		assert.InDelta(t, 0.0505, params["lr"], 0.0495)
+		assert.Zero(t, math.Mod(params["batch"].(float64), 16))
+	}
+}
+
+func TestSuggest_StopsAtRunCap(t *testing.T) {
+	config := parseConfig(t, `
+method: random
+run_cap: 2
+parameters: {a: {min: 0.0, max: 1.0}}
+`)
+	state := &localsweep.State{Trials: []*localsweep.Trial{
+		{Status: localsweep.TrialFinished},
+		{Status: localsweep.TrialCrashed},
+	}}
+	rng := rand.New(rand.NewSource(0))
+
+	_, ok := localsweep.Suggest(config, state, rng)
+	assert.True(t, ok)
+
+	state.Trials = append(state.Trials, &localsweep.Trial{Status: localsweep.TrialFailed})
+	_, ok = localsweep.Suggest(config, state, rng)
+	assert.False(t, ok)
+}
+
+func TestSuggest_BayesFindsMinimum(t *testing.T) {
+	for _, goal := range []string{"minimize", "maximize"} {
+		t.Run(goal, func(t *testing.T) {
+			config := parseConfig(t, `
+method: bayes
+metric: {name: loss, goal: `+goal+`}
+parameters: {x: {min: 0.0, max: 1.0}}
+`)
+			objective := func(x float64) float64 {
+				loss := (x - 0.7) * (x - 0.7)
+				if goal == "maximize" {
+					return -loss
+				}
+				return loss
+			}
+			state := &localsweep.State{}
+			rng := rand.New(rand.NewSource(1))
+
+			for i := 0; i < 15; i++ {
+				params, ok := localsweep.Suggest(config, state, rng)
+				require.True(t, ok)
+				metric := objective(params["x"].(float64))
+				state.Trials = append(state.Trials, &localsweep.Trial{
+					Params: params,
+					Status: localsweep.TrialFinished,
+					Metric: &metric,
+				})
+			}
+
+			best := state.Best(goal)
+			assert.InDelta(t, 0.7, best.Params["x"], 0.05)
+		})
+	}
+}
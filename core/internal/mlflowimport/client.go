@@ -0,0 +1,345 @@
+// Package mlflowimport copies runs from an MLflow tracking server to W&B.
+//
+// Runs are read through the tracking server's REST API and converted into
+// the records of a W&B transaction log, which is then uploaded like an
+// offline run.
+package mlflowimport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	// maxSearchResults is the page size for searching runs.
+	maxSearchResults = 1000
+
+	// maxHistoryResults is the page size for reading a metric's history.
+	maxHistoryResults = 25000
+)
+
+// Experiment is an MLflow experiment.
+type Experiment struct {
+	ExperimentID string `json:"experiment_id"`
+	Name         string `json:"name"`
+}
+
+// Run is an MLflow run.
+type Run struct {
+	Info RunInfo `json:"info"`
+	Data RunData `json:"data"`
+}
+
+// RunInfo is the metadata of an MLflow run.
+type RunInfo struct {
+	RunID   string `json:"run_id"`
+	RunName string `json:"run_name"`
+
+	// Status is one of RUNNING, SCHEDULED, FINISHED, FAILED or KILLED.
+	Status string `json:"status"`
+
+	// StartTime and EndTime are in milliseconds since the Unix epoch.
+	StartTime jsonInt64 `json:"start_time"`
+	EndTime   jsonInt64 `json:"end_time"`
+}
+
+// RunData is what was logged to an MLflow run.
+type RunData struct {
+	// Metrics are the latest value of each metric.
+	Metrics []Metric   `json:"metrics"`
+	Params  []KeyValue `json:"params"`
+	Tags    []KeyValue `json:"tags"`
+}
+
+// Metric is a value of an MLflow metric.
+type Metric struct {
+	Key   string    `json:"key"`
+	Value jsonFloat `json:"value"`
+	Step  jsonInt64 `json:"step"`
+
+	// Timestamp is in milliseconds since the Unix epoch.
+	Timestamp jsonInt64 `json:"timestamp"`
+}
+
+// KeyValue is an MLflow param or tag.
+type KeyValue struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// FileInfo is a file or directory in a run's artifacts.
+type FileInfo struct {
+	Path     string    `json:"path"`
+	IsDir    bool      `json:"is_dir"`
+	FileSize jsonInt64 `json:"file_size"`
+}
+
+// jsonInt64 is an int64 that may be encoded as a JSON string, as older
+// MLflow servers do.
+type jsonInt64 int64
+
+func (x *jsonInt64) UnmarshalJSON(data []byte) error {
+	n, err := strconv.ParseInt(strings.Trim(string(data), `"`), 10, 64)
+	if err != nil {
+		return err
+	}
+	*x = jsonInt64(n)
+	return nil
+}
+
+// jsonFloat is a float64 that may be encoded as a JSON string, which is
+// how non-finite values like "NaN" are sent.
+type jsonFloat float64
+
+func (x *jsonFloat) UnmarshalJSON(data []byte) error {
+	f, err := strconv.ParseFloat(strings.Trim(string(data), `"`), 64)
+	if err != nil {
+		return err
+	}
+	*x = jsonFloat(f)
+	return nil
+}
+
+// Client reads runs from an MLflow tracking server.
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+
+	token    string
+	username string
+	password string
+}
+
+type ClientParams struct {
+	// TrackingURI is the tracking server's URL.
+	TrackingURI string
+
+	// Token is a bearer token to authenticate with, if any.
+	Token string
+
+	// Username and Password are basic auth credentials, if any.
+	Username string
+	Password string
+
+	// HTTPClient makes requests; the default client is used if nil.
+	HTTPClient *http.Client
+}
+
+func NewClient(params ClientParams) *Client {
+	if params.HTTPClient == nil {
+		params.HTTPClient = http.DefaultClient
+	}
+
+	return &Client{
+		baseURL:    strings.TrimRight(params.TrackingURI, "/"),
+		httpClient: params.HTTPClient,
+		token:      params.Token,
+		username:   params.Username,
+		password:   params.Password,
+	}
+}
+
+// Experiment returns the experiment with the given name.
+func (c *Client) Experiment(ctx context.Context, name string) (*Experiment, error) {
+	var response struct {
+		Experiment Experiment `json:"experiment"`
+	}
+	err := c.call(ctx, http.MethodGet,
+		"/api/2.0/mlflow/experiments/get-by-name",
+		url.Values{"experiment_name": {name}},
+		nil, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response.Experiment, nil
+}
+
+// SearchRuns returns all runs of an experiment.
+func (c *Client) SearchRuns(ctx context.Context, experimentID string) ([]Run, error) {
+	var runs []Run
+	pageToken := ""
+	for {
+		var response struct {
+			Runs          []Run  `json:"runs"`
+			NextPageToken string `json:"next_page_token"`
+		}
+		err := c.call(ctx, http.MethodPost,
+			"/api/2.0/mlflow/runs/search", nil,
+			map[string]any{
+				"experiment_ids": []string{experimentID},
+				"max_results":    maxSearchResults,
+				"page_token":     pageToken,
+			},
+			&response)
+		if err != nil {
+			return nil, err
+		}
+
+		runs = append(runs, response.Runs...)
+		if response.NextPageToken == "" {
+			return runs, nil
+		}
+		pageToken = response.NextPageToken
+	}
+}
+
+// MetricHistory returns every value logged for a run's metric.
+func (c *Client) MetricHistory(ctx context.Context, runID, key string) ([]Metric, error) {
+	var metrics []Metric
+	pageToken := ""
+	for {
+		var response struct {
+			Metrics       []Metric `json:"metrics"`
+			NextPageToken string   `json:"next_page_token"`
+		}
+		query := url.Values{
+			"run_id":      {runID},
+			"metric_key":  {key},
+			"max_results": {strconv.Itoa(maxHistoryResults)},
+		}
+		if pageToken != "" {
+			query.Set("page_token", pageToken)
+		}
+		err := c.call(ctx, http.MethodGet,
+			"/api/2.0/mlflow/metrics/get-history", query, nil, &response)
+		if err != nil {
+			return nil, err
+		}
+
+		metrics = append(metrics, response.Metrics...)
+		if response.NextPageToken == "" {
+			return metrics, nil
+		}
+		pageToken = response.NextPageToken
+	}
+}
+
+// ListArtifacts returns the files and directories in a directory of a
+// run's artifacts, or at their root if dir is empty.
+func (c *Client) ListArtifacts(ctx context.Context, runID, dir string) ([]FileInfo, error) {
+	var files []FileInfo
+	pageToken := ""
+	for {
+		var response struct {
+			Files         []FileInfo `json:"files"`
+			NextPageToken string     `json:"next_page_token"`
+		}
+		query := url.Values{"run_id": {runID}}
+		if dir != "" {
+			query.Set("path", dir)
+		}
+		if pageToken != "" {
+			query.Set("page_token", pageToken)
+		}
+		err := c.call(ctx, http.MethodGet,
+			"/api/2.0/mlflow/artifacts/list", query, nil, &response)
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, response.Files...)
+		if response.NextPageToken == "" {
+			return files, nil
+		}
+		pageToken = response.NextPageToken
+	}
+}
+
+// DownloadArtifact writes the contents of a run's artifact file to w.
+func (c *Client) DownloadArtifact(
+	ctx context.Context,
+	runID, path string,
+	w io.Writer,
+) error {
+	resp, err := c.do(ctx, http.MethodGet, "/get-artifact",
+		url.Values{"run_uuid": {runID}, "path": {path}}, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if _, err := io.Copy(w, resp.Body); err != nil {
+		return fmt.Errorf("mlflowimport: failed to download %s: %v", path, err)
+	}
+	return nil
+}
+
+// call makes a request to the REST API and decodes its JSON response.
+func (c *Client) call(
+	ctx context.Context,
+	method, path string,
+	query url.Values,
+	body any,
+	response any,
+) error {
+	resp, err := c.do(ctx, method, path, query, body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
+		return fmt.Errorf("mlflowimport: bad response from %s: %v", path, err)
+	}
+	return nil
+}
+
+// do makes a request, returning an error unless it succeeds.
+func (c *Client) do(
+	ctx context.Context,
+	method, path string,
+	query url.Values,
+	body any,
+) (*http.Response, error) {
+	var bodyReader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("mlflowimport: failed to encode request: %v", err)
+		}
+		bodyReader = bytes.NewReader(data)
+	}
+
+	reqURL := c.baseURL + path
+	if len(query) > 0 {
+		reqURL += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, bodyReader)
+	if err != nil {
+		return nil, fmt.Errorf("mlflowimport: failed to create request: %v", err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	switch {
+	case c.token != "":
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	case c.username != "":
+		req.SetBasicAuth(c.username, c.password)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("mlflowimport: request to %s failed: %v", path, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		var apiError struct {
+			Message string `json:"message"`
+		}
+		_ = json.NewDecoder(resp.Body).Decode(&apiError)
+		return nil, fmt.Errorf(
+			"mlflowimport: request to %s failed: %s: %s",
+			path, resp.Status, apiError.Message)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,395 @@
+package mlflowimport
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/wandb/wandb/core/internal/runhistory"
+	"github.com/wandb/wandb/core/pkg/service"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// artifactsDir is the directory of the W&B run's files that MLflow
+// artifacts are saved under.
+const artifactsDir = "mlflow-artifacts"
+
+// Importer converts MLflow runs into W&B runs.
+type Importer struct {
+	client        *Client
+	entity        string
+	project       string
+	skipArtifacts bool
+}
+
+type Params struct {
+	Client *Client
+
+	// Entity and Project are where to create the W&B runs.
+	//
+	// The entity may be empty to use the user's default entity.
+	Entity  string
+	Project string
+
+	// SkipArtifacts is whether to leave out the runs' artifacts.
+	SkipArtifacts bool
+}
+
+func New(params Params) *Importer {
+	return &Importer{
+		client:        params.Client,
+		entity:        params.Entity,
+		project:       params.Project,
+		skipArtifacts: params.SkipArtifacts,
+	}
+}
+
+// Runs returns the runs of the MLflow experiment with the given name.
+func (im *Importer) Runs(ctx context.Context, experiment string) ([]Run, error) {
+	exp, err := im.client.Experiment(ctx, experiment)
+	if err != nil {
+		return nil, err
+	}
+	return im.client.SearchRuns(ctx, exp.ExperimentID)
+}
+
+// ImportRun converts an MLflow run into the records of a W&B run.
+//
+// The run's artifacts are downloaded into filesDir, the W&B run's files
+// directory. The records are passed to write in order.
+func (im *Importer) ImportRun(
+	ctx context.Context,
+	run *Run,
+	filesDir string,
+	write func(*service.Record) error,
+) error {
+	var history []Metric
+	for _, metric := range run.Data.Metrics {
+		values, err := im.client.MetricHistory(ctx, run.Info.RunID, metric.Key)
+		if err != nil {
+			return err
+		}
+		history = append(history, values...)
+	}
+
+	var files []string
+	if !im.skipArtifacts {
+		var err error
+		files, err = im.downloadArtifacts(ctx, run.Info.RunID, "", filesDir)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, record := range im.Records(run, history, files) {
+		if err := write(record); err != nil {
+			return fmt.Errorf("mlflowimport: failed to write record: %v", err)
+		}
+	}
+	return nil
+}
+
+// downloadArtifacts downloads the artifacts under a directory recursively
+// and returns their paths relative to filesDir.
+func (im *Importer) downloadArtifacts(
+	ctx context.Context,
+	runID, dir, filesDir string,
+) ([]string, error) {
+	infos, err := im.client.ListArtifacts(ctx, runID, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	for _, info := range infos {
+		if info.IsDir {
+			subFiles, err := im.downloadArtifacts(ctx, runID, info.Path, filesDir)
+			if err != nil {
+				return nil, err
+			}
+			files = append(files, subFiles...)
+			continue
+		}
+
+		// Artifact paths come from the server, so make sure they can't
+		// escape the files directory.
+		relPath := path.Join(artifactsDir, path.Clean("/"+info.Path))
+		localPath := filepath.Join(filesDir, filepath.FromSlash(relPath))
+		if err := os.MkdirAll(filepath.Dir(localPath), os.ModePerm); err != nil {
+			return nil, fmt.Errorf("mlflowimport: %v", err)
+		}
+		f, err := os.Create(localPath)
+		if err != nil {
+			return nil, fmt.Errorf("mlflowimport: %v", err)
+		}
+		err = im.client.DownloadArtifact(ctx, runID, info.Path, f)
+		if closeErr := f.Close(); err == nil && closeErr != nil {
+			err = fmt.Errorf("mlflowimport: %v", closeErr)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, relPath)
+	}
+	return files, nil
+}
+
+// Records returns the records of the W&B run corresponding to an MLflow
+// run, given the history of its metrics and its artifact files.
+//
+// MLflow params become the run's config, and the latest value of each
+// metric its summary. MLflow tags that aren't set by MLflow itself are
+// kept in the config under "mlflow_tags".
+func (im *Importer) Records(run *Run, history []Metric, files []string) []*service.Record {
+	startTime := time.UnixMilli(int64(run.Info.StartTime))
+
+	runRecord := &service.RunRecord{
+		RunId:       run.Info.RunID,
+		Entity:      im.entity,
+		Project:     im.project,
+		DisplayName: run.Info.RunName,
+		StartTime:   timestamppb.New(startTime),
+		Config:      configRecord(run),
+	}
+	for _, tag := range run.Data.Tags {
+		switch tag.Key {
+		case "mlflow.note.content":
+			runRecord.Notes = tag.Value
+		case "mlflow.source.git.commit":
+			if runRecord.Git == nil {
+				runRecord.Git = &service.GitRepoRecord{}
+			}
+			runRecord.Git.Commit = tag.Value
+		case "mlflow.source.git.repoURL":
+			if runRecord.Git == nil {
+				runRecord.Git = &service.GitRepoRecord{}
+			}
+			runRecord.Git.RemoteUrl = tag.Value
+		}
+	}
+
+	records := []*service.Record{
+		{RecordType: &service.Record_Run{Run: runRecord}},
+	}
+
+	endTime := time.UnixMilli(int64(run.Info.EndTime))
+	for _, history := range historyRecords(history, startTime) {
+		records = append(records,
+			&service.Record{RecordType: &service.Record_History{History: history}})
+		for _, item := range history.Item {
+			if item.Key == "_timestamp" {
+				seconds, _ := strconv.ParseFloat(item.ValueJson, 64)
+				endTime = maxTime(endTime, time.UnixMilli(int64(seconds*1000)))
+			}
+		}
+	}
+
+	summary := &service.SummaryRecord{}
+	for _, metric := range run.Data.Metrics {
+		summary.Update = append(summary.Update, &service.SummaryItem{
+			Key:       metric.Key,
+			ValueJson: runhistory.FloatJSON(float64(metric.Value)),
+		})
+	}
+	records = append(records,
+		&service.Record{RecordType: &service.Record_Summary{Summary: summary}})
+
+	if len(files) > 0 {
+		filesRecord := &service.FilesRecord{}
+		for _, file := range files {
+			filesRecord.Files = append(filesRecord.Files,
+				&service.FilesItem{Path: file, Policy: service.FilesItem_END})
+		}
+		records = append(records,
+			&service.Record{RecordType: &service.Record_Files{Files: filesRecord}})
+	}
+
+	exitCode := int32(0)
+	if run.Info.Status != "FINISHED" {
+		exitCode = 1
+	}
+	records = append(records, &service.Record{
+		RecordType: &service.Record_Exit{
+			Exit: &service.RunExitRecord{
+				ExitCode: exitCode,
+				Runtime:  int32(max(0, endTime.Sub(startTime).Seconds())),
+			},
+		},
+	})
+
+	return records
+}
+
+// configRecord returns the W&B config for an MLflow run.
+func configRecord(run *Run) *service.ConfigRecord {
+	config := &service.ConfigRecord{
+		Update: []*service.ConfigItem{
+			{Key: "mlflow_run_id", ValueJson: jsonString(run.Info.RunID)},
+		},
+	}
+
+	for _, param := range run.Data.Params {
+		config.Update = append(config.Update, &service.ConfigItem{
+			Key:       param.Key,
+			ValueJson: paramJSON(param.Value),
+		})
+	}
+
+	tags := make(map[string]string)
+	for _, tag := range run.Data.Tags {
+		if !strings.HasPrefix(tag.Key, "mlflow.") {
+			tags[tag.Key] = tag.Value
+		}
+	}
+	if len(tags) > 0 {
+		tagsJSON, _ := json.Marshal(tags)
+		config.Update = append(config.Update, &service.ConfigItem{
+			Key:       "mlflow_tags",
+			ValueJson: string(tagsJSON),
+		})
+	}
+
+	return config
+}
+
+// historyRecords groups metric values into history rows by step.
+//
+// MLflow metrics may be logged several times at the same step, such as
+// when no step is given. The n-th value of a metric at a step goes into
+// the n-th row for that step. If any step has more than one row, the
+// rows are numbered consecutively and MLflow's step is logged as
+// "mlflow_step", since W&B steps must increase.
+func historyRecords(metrics []Metric, startTime time.Time) []*service.HistoryRecord {
+	type rowKey struct {
+		step  int64
+		index int
+	}
+
+	sorted := make([]Metric, len(metrics))
+	copy(sorted, metrics)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Step != sorted[j].Step {
+			return sorted[i].Step < sorted[j].Step
+		}
+		return sorted[i].Timestamp < sorted[j].Timestamp
+	})
+
+	rows := make(map[rowKey][]Metric)
+	var keys []rowKey
+	counts := make(map[string]int)
+	lastStep := int64(math.MinInt64)
+	hasRepeats := false
+	for _, metric := range sorted {
+		step := int64(metric.Step)
+		if step != lastStep {
+			clear(counts)
+			lastStep = step
+		}
+		key := rowKey{step: step, index: counts[metric.Key]}
+		counts[metric.Key]++
+		if key.index > 0 {
+			hasRepeats = true
+		}
+
+		if _, ok := rows[key]; !ok {
+			keys = append(keys, key)
+		}
+		rows[key] = append(rows[key], metric)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].step != keys[j].step {
+			return keys[i].step < keys[j].step
+		}
+		return keys[i].index < keys[j].index
+	})
+
+	records := make([]*service.HistoryRecord, 0, len(keys))
+	for i, key := range keys {
+		row := rows[key]
+
+		step := key.step
+		if hasRepeats {
+			step = int64(i)
+		}
+
+		timestamp := time.UnixMilli(0)
+		for _, metric := range row {
+			timestamp = maxTime(timestamp, time.UnixMilli(int64(metric.Timestamp)))
+		}
+		seconds := float64(timestamp.UnixMilli()) / 1000
+
+		record := &service.HistoryRecord{
+			Step: &service.HistoryStep{Num: step},
+			Item: []*service.HistoryItem{
+				{Key: "_step", ValueJson: strconv.FormatInt(step, 10)},
+				{Key: "_timestamp", ValueJson: strconv.FormatFloat(seconds, 'f', -1, 64)},
+				{Key: "_runtime", ValueJson: strconv.FormatFloat(
+					max(0, timestamp.Sub(startTime).Seconds()), 'f', -1, 64)},
+			},
+		}
+		if hasRepeats {
+			record.Item = append(record.Item, &service.HistoryItem{
+				Key:       "mlflow_step",
+				ValueJson: strconv.FormatInt(key.step, 10),
+			})
+		}
+		for _, metric := range row {
+			record.Item = append(record.Item, &service.HistoryItem{
+				Key:       metric.Key,
+				ValueJson: runhistory.FloatJSON(float64(metric.Value)),
+			})
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+// paramJSON returns the JSON for an MLflow param's value.
+//
+// MLflow stores params as strings, so numbers and booleans are converted
+// back to be charted and compared as such in W&B.
+func paramJSON(value string) string {
+	var parsed any
+	if json.Unmarshal([]byte(value), &parsed) == nil {
+		switch parsed.(type) {
+		case float64, bool:
+			return value
+		}
+	}
+	return jsonString(value)
+}
+
+func jsonString(value string) string {
+	data, _ := json.Marshal(value)
+	return string(data)
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+// ProjectName returns a W&B project name for an MLflow experiment.
+//
+// Experiment names may be paths, as on Databricks, so characters not
+// allowed in project names are replaced.
+func ProjectName(experiment string) string {
+	name := strings.Trim(experiment, "/")
+	return strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`/\#?%:`, r) {
+			return '-'
+		}
+		return r
+	}, name)
+}
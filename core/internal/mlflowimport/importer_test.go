@@ -0,0 +1,213 @@
+package mlflowimport_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/mlflowimport"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// fakeMLflow serves an experiment with one run.
+func fakeMLflow(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	respond := func(w http.ResponseWriter, body string) {
+		_, _ = w.Write([]byte(body))
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/2.0/mlflow/experiments/get-by-name",
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("experiment_name") != "exp" {
+				w.WriteHeader(http.StatusNotFound)
+				respond(w, `{"error_code": "RESOURCE_DOES_NOT_EXIST", "message": "no such experiment"}`)
+				return
+			}
+			respond(w, `{"experiment": {"experiment_id": "1", "name": "exp"}}`)
+		})
+	mux.HandleFunc("/api/2.0/mlflow/runs/search",
+		func(w http.ResponseWriter, r *http.Request) {
+			var request struct {
+				PageToken string `json:"page_token"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+
+			// The second page is empty, to test paging.
+			if request.PageToken != "" {
+				respond(w, `{}`)
+				return
+			}
+			respond(w, `{
+				"runs": [{
+					"info": {
+						"run_id": "abc123",
+						"run_name": "clever-fox",
+						"status": "FAILED",
+						"start_time": "1700000000000",
+						"end_time": 1700000010000
+					},
+					"data": {
+						"metrics": [{"key": "loss", "value": 0.25, "step": 1, "timestamp": 1700000002000}],
+						"params": [
+							{"key": "lr", "value": "0.01"},
+							{"key": "optimizer", "value": "adam"}
+						],
+						"tags": [
+							{"key": "mlflow.source.git.commit", "value": "deadbeef"},
+							{"key": "team", "value": "vision"}
+						]
+					}
+				}],
+				"next_page_token": "page2"
+			}`)
+		})
+	mux.HandleFunc("/api/2.0/mlflow/metrics/get-history",
+		func(w http.ResponseWriter, r *http.Request) {
+			respond(w, `{"metrics": [
+				{"key": "loss", "value": 1, "step": 0, "timestamp": 1700000001000},
+				{"key": "loss", "value": "NaN", "step": 1, "timestamp": 1700000001500},
+				{"key": "loss", "value": 0.25, "step": 1, "timestamp": 1700000002000}
+			]}`)
+		})
+	mux.HandleFunc("/api/2.0/mlflow/artifacts/list",
+		func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Query().Get("path") {
+			case "":
+				respond(w, `{"files": [{"path": "model", "is_dir": true}]}`)
+			case "model":
+				respond(w, `{"files": [{"path": "model/weights.bin", "file_size": 5}]}`)
+			}
+		})
+	mux.HandleFunc("/get-artifact",
+		func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "model/weights.bin", r.URL.Query().Get("path"))
+			respond(w, "12345")
+		})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newImporter(t *testing.T, skipArtifacts bool) *mlflowimport.Importer {
+	t.Helper()
+	return mlflowimport.New(mlflowimport.Params{
+		Client: mlflowimport.NewClient(mlflowimport.ClientParams{
+			TrackingURI: fakeMLflow(t).URL,
+		}),
+		Project:       "exp",
+		SkipArtifacts: skipArtifacts,
+	})
+}
+
+func TestRuns(t *testing.T) {
+	importer := newImporter(t, false)
+
+	runs, err := importer.Runs(context.Background(), "exp")
+
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+	assert.Equal(t, "abc123", runs[0].Info.RunID)
+}
+
+func TestRuns_NoSuchExperiment(t *testing.T) {
+	importer := newImporter(t, false)
+
+	_, err := importer.Runs(context.Background(), "missing")
+
+	assert.ErrorContains(t, err, "no such experiment")
+}
+
+func TestImportRun(t *testing.T) {
+	importer := newImporter(t, false)
+	runs, err := importer.Runs(context.Background(), "exp")
+	require.NoError(t, err)
+	filesDir := t.TempDir()
+
+	var records []*service.Record
+	err = importer.ImportRun(context.Background(), &runs[0], filesDir,
+		func(record *service.Record) error {
+			records = append(records, record)
+			return nil
+		})
+
+	require.NoError(t, err)
+	require.Len(t, records, 7)
+
+	run := records[0].GetRun()
+	assert.Equal(t, "abc123", run.RunId)
+	assert.Equal(t, "exp", run.Project)
+	assert.Equal(t, "clever-fox", run.DisplayName)
+	assert.Equal(t, "deadbeef", run.Git.Commit)
+	config := make(map[string]string)
+	for _, item := range run.Config.Update {
+		config[item.Key] = item.ValueJson
+	}
+	assert.Equal(t,
+		map[string]string{
+			"mlflow_run_id": `"abc123"`,
+			"lr":            "0.01",
+			"optimizer":     `"adam"`,
+			"mlflow_tags":   `{"team":"vision"}`,
+		},
+		config)
+
+	// Two values at step 1 need two rows, so steps are renumbered.
+	history := func(i int) map[string]string {
+		items := make(map[string]string)
+		for _, item := range records[i].GetHistory().GetItem() {
+			items[item.Key] = item.ValueJson
+		}
+		return items
+	}
+	assert.Equal(t, "0", history(1)["_step"])
+	assert.Equal(t, "1", history(1)["loss"])
+	assert.Equal(t, "1", history(2)["_step"])
+	assert.Equal(t, "1", history(2)["mlflow_step"])
+	assert.Equal(t, "NaN", history(2)["loss"])
+	assert.Equal(t, "2", history(3)["_step"])
+	assert.Equal(t, "0.25", history(3)["loss"])
+	assert.Equal(t, "1700000002", history(3)["_timestamp"])
+
+	assert.Equal(t, "0.25", records[4].GetSummary().Update[0].ValueJson)
+
+	assert.Equal(t,
+		"mlflow-artifacts/model/weights.bin",
+		records[5].GetFiles().GetFiles()[0].GetPath())
+	data, err := os.ReadFile(
+		filepath.Join(filesDir, "mlflow-artifacts", "model", "weights.bin"))
+	require.NoError(t, err)
+	assert.Equal(t, "12345", string(data))
+}
+
+func TestImportRun_Exit(t *testing.T) {
+	importer := newImporter(t, true)
+	runs, err := importer.Runs(context.Background(), "exp")
+	require.NoError(t, err)
+
+	var last *service.Record
+	err = importer.ImportRun(context.Background(), &runs[0], t.TempDir(),
+		func(record *service.Record) error {
+			assert.Nil(t, record.GetFiles(), "artifacts should be skipped")
+			last = record
+			return nil
+		})
+
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, last.GetExit().GetExitCode())
+	assert.EqualValues(t, 10, last.GetExit().GetRuntime())
+}
+
+func TestProjectName(t *testing.T) {
+	assert.Equal(t,
+		"Users-me@example.com-churn",
+		mlflowimport.ProjectName("/Users/me@example.com/churn"))
+}
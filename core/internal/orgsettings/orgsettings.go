@@ -0,0 +1,166 @@
+// Package orgsettings applies default settings configured by an
+// organization's admins on the W&B server.
+//
+// Admins can use them to enforce policies such as upload rate limits or
+// media retention across all clients without asking every user to change
+// their settings. Defaults never override a setting that is already set.
+package orgsettings
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/wandb/segmentio-encoding/json"
+	"github.com/wandb/wandb/core/internal/gql"
+	"github.com/wandb/wandb/core/pkg/service"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Fetch returns the default settings of an entity's organization.
+//
+// If entity is empty, the defaults of the user's default entity are
+// returned. The result maps setting names, as in Python, to values.
+func Fetch(
+	ctx context.Context,
+	client graphql.Client,
+	entity string,
+) (map[string]any, error) {
+	var defaults *string
+
+	if entity != "" {
+		data, err := gql.EntityDefaultSettings(ctx, client, entity)
+		if err != nil {
+			return nil, fmt.Errorf("orgsettings: failed to fetch defaults: %v", err)
+		}
+		if data.Entity != nil {
+			defaults = data.Entity.SdkDefaultSettings
+		}
+	} else {
+		data, err := gql.ViewerDefaultSettings(ctx, client)
+		if err != nil {
+			return nil, fmt.Errorf("orgsettings: failed to fetch defaults: %v", err)
+		}
+		if data.Viewer != nil && data.Viewer.DefaultEntity != nil {
+			defaults = data.Viewer.DefaultEntity.SdkDefaultSettings
+		}
+	}
+
+	if defaults == nil {
+		return nil, nil
+	}
+
+	result := make(map[string]any)
+	err := json.Unmarshal([]byte(*defaults), &result)
+	if err != nil {
+		return nil, fmt.Errorf("orgsettings: invalid defaults: %v", err)
+	}
+	return result, nil
+}
+
+// policySettings are the settings an organization can set.
+//
+// They limit what a run uploads, how fast, and how much of it is kept.
+// Settings that run commands, choose where data is sent or affect
+// security, such as _preemption_hook, x_otel_endpoint or
+// _s3_insecure_tls, are deliberately not included.
+var policySettings = map[string]struct{}{
+	// What is uploaded.
+	"ignore_globs":                    {},
+	"disable_code":                    {},
+	"disable_git":                     {},
+	"_disable_stats":                  {},
+	"_disable_meta":                   {},
+	"_disable_machine_info":           {},
+	"_git_patch_max_bytes":            {},
+	"_max_config_summary_keys":        {},
+	"_max_config_summary_depth":       {},
+	"_max_config_summary_value_bytes": {},
+
+	// Rate limits.
+	"_console_dedupe_lines":            {},
+	"_console_upload_bytes_per_second": {},
+	"_upload_bandwidth_limit":          {},
+	"_large_upload_window":             {},
+	"_file_transfer_max_concurrency":   {},
+	"_history_aggregation":             {},
+	"_history_aggregation_max_rate":    {},
+	"_history_aggregation_keep_raw":    {},
+
+	// Retention.
+	"_media_keep_last":    {},
+	"_media_sample_steps": {},
+}
+
+// Merge sets each default on the settings unless it's already set.
+//
+// It returns the names of the settings that were set. Defaults that are
+// not policy settings or have the wrong type are reported in the error
+// but don't prevent other defaults from being applied.
+func Merge(settings *service.Settings, defaults map[string]any) ([]string, error) {
+	names := make([]string, 0, len(defaults))
+	for name := range defaults {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	message := settings.ProtoReflect()
+	fields := message.Descriptor().Fields()
+
+	var applied, invalid []string
+	for _, name := range names {
+		field := fields.ByName(protoreflect.Name(name))
+		if _, policy := policySettings[name]; !policy || field == nil {
+			invalid = append(invalid, name)
+			continue
+		}
+		if message.Has(field) {
+			continue
+		}
+
+		value, err := parseValue(field, defaults[name])
+		if err != nil {
+			invalid = append(invalid, name)
+			continue
+		}
+
+		message.Set(field, value)
+		applied = append(applied, name)
+	}
+
+	if len(invalid) > 0 {
+		return applied, fmt.Errorf("orgsettings: ignored defaults: %v", invalid)
+	}
+	return applied, nil
+}
+
+// parseValue converts a JSON value to the type of a settings field.
+//
+// Settings are either well-known wrapper types, whose JSON form is the
+// wrapped value, or messages with a single "value" field.
+func parseValue(
+	field protoreflect.FieldDescriptor,
+	value any,
+) (protoreflect.Value, error) {
+	if field.Kind() != protoreflect.MessageKind {
+		return protoreflect.Value{}, fmt.Errorf("unsupported setting type")
+	}
+
+	if field.Message().FullName().Parent() != "google.protobuf" {
+		value = map[string]any{"value": value}
+	}
+
+	valueJSON, err := json.Marshal(map[string]any{field.JSONName(): value})
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+
+	parsed := &service.Settings{}
+	if err := protojson.Unmarshal(valueJSON, parsed); err != nil {
+		return protoreflect.Value{}, err
+	}
+
+	return parsed.ProtoReflect().Get(field), nil
+}
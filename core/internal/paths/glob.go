@@ -0,0 +1,46 @@
+package paths
+
+import (
+	"path"
+	"strings"
+)
+
+// MatchGlob returns whether a slash-separated path matches a pattern.
+//
+// Patterns match whole paths with the syntax of path.Match, except that a
+// "**" path segment matches any number of directories, as in
+// "checkpoints/**/*.pt".
+func MatchGlob(pattern, name string) bool {
+	return matchSegments(strings.Split(pattern, "/"), strings.Split(name, "/"))
+}
+
+// HasGlobMeta returns whether a path contains glob syntax.
+func HasGlobMeta(path string) bool {
+	return strings.ContainsAny(path, `*?[`)
+}
+
+// matchSegments returns whether the segments of a path match those of a
+// pattern.
+func matchSegments(pattern, name []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			// Try matching the rest of the pattern after each number of
+			// skipped segments.
+			for skip := 0; skip <= len(name); skip++ {
+				if matchSegments(pattern[1:], name[skip:]) {
+					return true
+				}
+			}
+			return false
+		}
+
+		if len(name) == 0 {
+			return false
+		}
+		if ok, err := path.Match(pattern[0], name[0]); !ok || err != nil {
+			return false
+		}
+		pattern, name = pattern[1:], name[1:]
+	}
+	return len(name) == 0
+}
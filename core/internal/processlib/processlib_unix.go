@@ -0,0 +1,20 @@
+//go:build !windows
+
+package processlib
+
+import (
+	"errors"
+	"syscall"
+)
+
+// IsRunning returns whether a process with the PID exists.
+func IsRunning(pid int) bool {
+	if pid <= 0 {
+		return false
+	}
+
+	// Signal 0 checks for the process without sending a signal. EPERM
+	// means the process exists but belongs to another user.
+	err := syscall.Kill(pid, 0)
+	return err == nil || errors.Is(err, syscall.EPERM)
+}
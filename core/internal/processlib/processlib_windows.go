@@ -0,0 +1,21 @@
+//go:build windows
+
+package processlib
+
+import "os"
+
+// IsRunning returns whether a process with the PID exists.
+func IsRunning(pid int) bool {
+	if pid <= 0 {
+		return false
+	}
+
+	// On Windows, FindProcess opens a handle to the process and fails if
+	// it doesn't exist.
+	process, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+	_ = process.Release()
+	return true
+}
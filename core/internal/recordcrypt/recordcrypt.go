@@ -0,0 +1,172 @@
+// Package recordcrypt encrypts the records of transaction logs at rest.
+//
+// Records are encrypted with AES-GCM, each with a random nonce and bound
+// to additional data, such as its position in the log, so that records
+// can't be moved or swapped without failing to decrypt. The key
+// is configured in the environment, either directly or through a command
+// that prints it, such as one that decrypts it with a KMS:
+//
+//	WANDB_STORE_ENCRYPTION_KEY=<base64 key>
+//	WANDB_STORE_ENCRYPTION_KEY_COMMAND="fetch-wandb-key --env prod"
+//
+// Keys are 16, 24 or 32 bytes long, selecting AES-128, AES-192 or AES-256.
+package recordcrypt
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// KeyEnv is the environment variable with the base64-encoded key.
+	KeyEnv = "WANDB_STORE_ENCRYPTION_KEY"
+
+	// KeyCommandEnv is the environment variable with a command that
+	// prints the base64-encoded key, used if KeyEnv is not set.
+	//
+	// The command is split into arguments on whitespace, without shell
+	// quoting.
+	KeyCommandEnv = "WANDB_STORE_ENCRYPTION_KEY_COMMAND"
+
+	// keyCommandTimeout is how long the key command may run.
+	keyCommandTimeout = 30 * time.Second
+)
+
+// ErrDecrypt is returned when a record can't be decrypted, because the
+// key is wrong or the record is damaged.
+var ErrDecrypt = errors.New("recordcrypt: wrong key or damaged record")
+
+// Cipher encrypts and decrypts records.
+type Cipher struct {
+	aead cipher.AEAD
+}
+
+// New returns a Cipher using the key.
+func New(key []byte) (*Cipher, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("recordcrypt: invalid key: %v", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("recordcrypt: %v", err)
+	}
+	return &Cipher{aead: aead}, nil
+}
+
+// Seal appends the encryption of plaintext, preceded by its nonce, to
+// dst and returns the result.
+//
+// The additional data is authenticated but not encrypted, and must be
+// passed again to Open.
+func (c *Cipher) Seal(dst, plaintext, additionalData []byte) []byte {
+	nonceStart := len(dst)
+	dst = append(dst, make([]byte, c.aead.NonceSize())...)
+	nonce := dst[nonceStart:]
+	if _, err := rand.Read(nonce); err != nil {
+		// The system's random number generator never fails in practice.
+		panic(fmt.Sprintf("recordcrypt: can't generate nonce: %v", err))
+	}
+	return c.aead.Seal(dst, nonce, plaintext, additionalData)
+}
+
+// Open appends the decryption of a record encrypted by Seal with the same
+// additional data to dst and returns the result.
+func (c *Cipher) Open(dst, sealed, additionalData []byte) ([]byte, error) {
+	nonceSize := c.aead.NonceSize()
+	if len(sealed) < nonceSize {
+		return nil, ErrDecrypt
+	}
+	out, err := c.aead.Open(dst, sealed[:nonceSize], sealed[nonceSize:], additionalData)
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+	return out, nil
+}
+
+// keyCache holds keys loaded from the environment, so that the key
+// command isn't run every time a log is opened.
+var keyCache = struct {
+	sync.Mutex
+	keys map[string][]byte
+}{keys: make(map[string][]byte)}
+
+// FromEnv returns the Cipher for the key configured in the environment,
+// or nil if no key is configured.
+func FromEnv() (*Cipher, error) {
+	key, err := keyFromEnv()
+	if key == nil || err != nil {
+		return nil, err
+	}
+	return New(key)
+}
+
+// keyFromEnv returns the key configured in the environment, or nil.
+func keyFromEnv() ([]byte, error) {
+	if encoded := os.Getenv(KeyEnv); encoded != "" {
+		return decodeKey(encoded, KeyEnv)
+	}
+
+	command := strings.Fields(os.Getenv(KeyCommandEnv))
+	if len(command) == 0 {
+		return nil, nil
+	}
+
+	cacheKey := strings.Join(command, "\x00")
+	keyCache.Lock()
+	defer keyCache.Unlock()
+	if key, ok := keyCache.keys[cacheKey]; ok {
+		return key, nil
+	}
+
+	key, err := runKeyCommand(command)
+	if err != nil {
+		return nil, err
+	}
+	keyCache.keys[cacheKey] = key
+	return key, nil
+}
+
+// runKeyCommand runs a command and decodes the key it prints.
+func runKeyCommand(command []string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), keyCommandTimeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, command[0], command[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf(
+			"recordcrypt: key command failed: %v: %s",
+			err, strings.TrimSpace(stderr.String()))
+	}
+
+	return decodeKey(stdout.String(), KeyCommandEnv)
+}
+
+func decodeKey(encoded string, source string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, fmt.Errorf("recordcrypt: key from %s is not base64: %v", source, err)
+	}
+	switch len(key) {
+	case 16, 24, 32:
+		return key, nil
+	default:
+		return nil, fmt.Errorf(
+			"recordcrypt: key from %s has %d bytes, want 16, 24 or 32",
+			source, len(key))
+	}
+}
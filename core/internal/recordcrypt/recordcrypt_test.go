@@ -0,0 +1,92 @@
+package recordcrypt_test
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/recordcrypt"
+)
+
+var testKey = []byte("0123456789abcdef")
+
+func TestSealOpen(t *testing.T) {
+	c, err := recordcrypt.New(testKey)
+	require.NoError(t, err)
+
+	sealed := c.Seal([]byte("prefix"), []byte("hello"), []byte("ad"))
+	assert.Equal(t, "prefix", string(sealed[:6]))
+
+	opened, err := c.Open(nil, sealed[6:], []byte("ad"))
+	assert.NoError(t, err)
+	assert.Equal(t, "hello", string(opened))
+}
+
+func TestOpen_WrongKey(t *testing.T) {
+	c, err := recordcrypt.New(testKey)
+	require.NoError(t, err)
+	other, err := recordcrypt.New([]byte("fedcba9876543210"))
+	require.NoError(t, err)
+
+	_, err = other.Open(nil, c.Seal(nil, []byte("hello"), nil), nil)
+	assert.ErrorIs(t, err, recordcrypt.ErrDecrypt)
+
+	_, err = c.Open(nil, []byte("short"), nil)
+	assert.ErrorIs(t, err, recordcrypt.ErrDecrypt)
+}
+
+func TestOpen_WrongAdditionalData(t *testing.T) {
+	c, err := recordcrypt.New(testKey)
+	require.NoError(t, err)
+
+	_, err = c.Open(nil, c.Seal(nil, []byte("hello"), []byte("1")), []byte("2"))
+	assert.ErrorIs(t, err, recordcrypt.ErrDecrypt)
+}
+
+func TestFromEnv_NotConfigured(t *testing.T) {
+	t.Setenv(recordcrypt.KeyEnv, "")
+	t.Setenv(recordcrypt.KeyCommandEnv, "")
+
+	c, err := recordcrypt.FromEnv()
+	assert.NoError(t, err)
+	assert.Nil(t, c)
+}
+
+func TestFromEnv_Key(t *testing.T) {
+	t.Setenv(recordcrypt.KeyEnv, base64.StdEncoding.EncodeToString(testKey))
+
+	c, err := recordcrypt.FromEnv()
+	require.NoError(t, err)
+
+	expected, err := recordcrypt.New(testKey)
+	require.NoError(t, err)
+	opened, err := expected.Open(nil, c.Seal(nil, []byte("hello"), nil), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "hello", string(opened))
+}
+
+func TestFromEnv_InvalidKey(t *testing.T) {
+	t.Setenv(recordcrypt.KeyEnv, base64.StdEncoding.EncodeToString([]byte("short")))
+
+	_, err := recordcrypt.FromEnv()
+	assert.ErrorContains(t, err, "has 5 bytes")
+}
+
+func TestFromEnv_KeyCommand(t *testing.T) {
+	t.Setenv(recordcrypt.KeyEnv, "")
+	t.Setenv(recordcrypt.KeyCommandEnv,
+		"echo "+base64.StdEncoding.EncodeToString(testKey))
+
+	c, err := recordcrypt.FromEnv()
+	assert.NoError(t, err)
+	assert.NotNil(t, c)
+}
+
+func TestFromEnv_KeyCommandFails(t *testing.T) {
+	t.Setenv(recordcrypt.KeyEnv, "")
+	t.Setenv(recordcrypt.KeyCommandEnv, "false")
+
+	_, err := recordcrypt.FromEnv()
+	assert.ErrorContains(t, err, "key command failed")
+}
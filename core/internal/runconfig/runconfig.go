@@ -109,6 +109,14 @@ func (rc *RunConfig) AddTelemetryAndMetrics(
 	}
 }
 
+// Records the run and step that a forked run branched from.
+func (rc *RunConfig) AddBranchPoint(runID string, step float64) {
+	rc.internalSubtree()["branch_point"] = map[string]any{
+		"run_id": runID,
+		"step":   step,
+	}
+}
+
 // Incorporates the config from a run that's being resumed.
 func (rc *RunConfig) MergeResumedConfig(oldConfig pathtree.TreeData) error {
 	// Add any top-level keys that aren't already set.
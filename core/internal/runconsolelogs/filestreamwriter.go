@@ -1,16 +1,54 @@
 package runconsolelogs
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	"github.com/wandb/wandb/core/internal/filestream"
 	"github.com/wandb/wandb/core/internal/sparselist"
+	"golang.org/x/time/rate"
 )
 
 // filestreamWriter sends modified console log lines to the filestream.
 type filestreamWriter struct {
 	FileStream filestream.FileStream
+
+	// Ctx cancels waiting for the rate limit.
+	Ctx context.Context
+
+	// RateLimit is an optional limit on the bytes of console logs to send
+	// per second.
+	//
+	// Changes made while waiting are merged into the next update, so
+	// lines that are rewritten often, like progress bars, are sent less
+	// frequently.
+	RateLimit *rate.Limiter
+
+	// LinePrefix is an optional prefix for the content of each line,
+	// such as the node that printed it.
+	LinePrefix string
+
+	// limitCtx is Ctx, also cancelled by StopLimiting.
+	limitCtx     context.Context
+	stopLimiting context.CancelFunc
+}
+
+// SetRateLimit limits the bytes of console logs to send per second.
+func (w *filestreamWriter) SetRateLimit(bytesPerSecond int) {
+	w.RateLimit = rate.NewLimiter(rate.Limit(bytesPerSecond), bytesPerSecond)
+	w.limitCtx, w.stopLimiting = context.WithCancel(w.Ctx)
+}
+
+// StopLimiting makes the writer ignore its rate limit, including for
+// updates already waiting on it.
+//
+// It's used once the run is finishing, so that the remaining logs are
+// sent right away instead of delaying the end of the run.
+func (w *filestreamWriter) StopLimiting() {
+	if w.stopLimiting != nil {
+		w.stopLimiting()
+	}
 }
 
 func (w *filestreamWriter) SendChanged(
@@ -24,14 +62,48 @@ func (w *filestreamWriter) SendChanged(
 			line.Timestamp.UTC().Format(rfc3339Micro), "Z")
 
 		return fmt.Sprintf(
-			"%s%s %s",
+			"%s%s %s%s",
 			line.StreamPrefix,
 			timestamp,
+			w.LinePrefix,
 			string(line.Content),
 		)
 	})
 
+	if w.RateLimit != nil {
+		size := 0
+		lines.ForEach(func(_ int, line string) {
+			size += len(line)
+		})
+
+		if err := w.waitForBytes(size); err != nil {
+			// Cancelled: drop the update.
+			return
+		}
+	}
+
 	w.FileStream.StreamUpdate(&filestream.LogsUpdate{
 		Lines: lines,
 	})
 }
+
+// waitForBytes blocks until the rate limit allows sending n bytes, or
+// until StopLimiting is called.
+//
+// It returns an error if Ctx is cancelled.
+func (w *filestreamWriter) waitForBytes(n int) error {
+	burst := w.RateLimit.Burst()
+
+	for n > 0 {
+		chunk := min(n, burst)
+		if err := w.RateLimit.WaitN(w.limitCtx, chunk); err != nil {
+			if w.Ctx.Err() != nil {
+				return err
+			}
+			return nil
+		}
+		n -= chunk
+	}
+
+	return nil
+}
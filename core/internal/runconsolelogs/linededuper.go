@@ -0,0 +1,94 @@
+package runconsolelogs
+
+import (
+	"fmt"
+	"strings"
+)
+
+// lineDeduper collapses identical consecutive lines of console output.
+//
+// Repeats of a line are dropped and replaced by a single marker once a
+// different line arrives. Only lines that are written whole are collapsed:
+// a line whose start was already passed through is never dropped, so that
+// partial output such as progress bars shows up immediately.
+type lineDeduper struct {
+	// last is the most recent complete line.
+	last string
+
+	// repeats is the number of dropped repeats of last.
+	repeats int
+
+	// midLine is true if the output ends in the middle of a line.
+	midLine bool
+
+	// partial is the start of the current line, if midLine is true.
+	partial strings.Builder
+}
+
+// repeatMarker returns the line that replaces n repeats of a line.
+func repeatMarker(n int) string {
+	if n == 1 {
+		return "(previous line repeated 1 time)\n"
+	}
+	return fmt.Sprintf("(previous line repeated %d times)\n", n)
+}
+
+// Process returns the text to output in place of the given text.
+func (d *lineDeduper) Process(text string) string {
+	var out strings.Builder
+
+	for len(text) > 0 {
+		newline := strings.IndexByte(text, '\n')
+
+		if newline < 0 {
+			// An incomplete line is passed through as is.
+			d.flushRepeats(&out)
+			d.midLine = true
+			d.partial.WriteString(text)
+			out.WriteString(text)
+			break
+		}
+
+		segment := text[:newline]
+		text = text[newline+1:]
+
+		if d.midLine {
+			// The end of a line that was already partially passed through.
+			d.partial.WriteString(segment)
+			d.last = d.partial.String()
+			d.partial.Reset()
+			d.midLine = false
+			out.WriteString(segment)
+			out.WriteByte('\n')
+			continue
+		}
+
+		if segment == d.last && segment != "" {
+			d.repeats++
+			continue
+		}
+
+		d.flushRepeats(&out)
+		d.last = segment
+		out.WriteString(segment)
+		out.WriteByte('\n')
+	}
+
+	return out.String()
+}
+
+// Flush returns the repeat marker for any dropped lines.
+func (d *lineDeduper) Flush() string {
+	var out strings.Builder
+	d.flushRepeats(&out)
+	return out.String()
+}
+
+func (d *lineDeduper) flushRepeats(out *strings.Builder) {
+	if d.repeats == 0 {
+		return
+	}
+
+	out.WriteString(repeatMarker(d.repeats))
+	d.repeats = 0
+}
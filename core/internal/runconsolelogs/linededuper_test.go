@@ -0,0 +1,38 @@
+package runconsolelogs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLineDeduper_CollapsesRepeats(t *testing.T) {
+	d := &lineDeduper{}
+
+	assert.Equal(t, "warn\n", d.Process("warn\n"))
+	assert.Equal(t, "", d.Process("warn\nwarn\n"))
+	assert.Equal(t,
+		"(previous line repeated 2 times)\nok\n",
+		d.Process("ok\n"))
+	assert.Equal(t, "", d.Process("ok\n"))
+	assert.Equal(t, "(previous line repeated 1 time)\n", d.Flush())
+	assert.Equal(t, "", d.Flush())
+}
+
+func TestLineDeduper_PassesPartialLines(t *testing.T) {
+	d := &lineDeduper{}
+
+	assert.Equal(t, "epoch", d.Process("epoch"))
+	assert.Equal(t, " 1\n", d.Process(" 1\n"))
+	// A whole repeat of a line that was written in parts is dropped.
+	assert.Equal(t, "", d.Process("epoch 1\n"))
+	// A repeat that starts as a partial line is not.
+	assert.Equal(t, "(previous line repeated 1 time)\nepoch", d.Process("epoch"))
+	assert.Equal(t, " 1\n", d.Process(" 1\n"))
+}
+
+func TestLineDeduper_KeepsBlankLines(t *testing.T) {
+	d := &lineDeduper{}
+
+	assert.Equal(t, "\n\n\n", d.Process("\n\n\n"))
+}
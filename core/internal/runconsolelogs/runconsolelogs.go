@@ -23,20 +23,48 @@ const (
 	maxTerminalLineLength = 4096
 )
 
+// terminalKey identifies the terminal emulator for a stream of output.
+type terminalKey struct {
+	outputType service.OutputRawRecord_OutputType
+	source     string
+}
+
+// consoleStream is the processing pipeline for one stream of output.
+type consoleStream struct {
+	// term interprets the text as terminal output.
+	term *terminalemulator.Terminal
+
+	// deduper collapses repeated lines, if enabled.
+	deduper *lineDeduper
+}
+
 // Sender processes OutputRawRecords.
 type Sender struct {
-	// stdoutTerm processes captured stdout text.
-	stdoutTerm *terminalemulator.Terminal
+	// streams process captured text, by stream and source process.
+	//
+	// Each source process gets its own terminals so that cursor movements
+	// in the output of one process do not affect the output of another.
+	streams map[terminalKey]*consoleStream
+
+	// dedupeLines is whether to collapse identical consecutive lines.
+	dedupeLines bool
 
-	// stderrTerm processes captured stderr text.
-	stderrTerm *terminalemulator.Terminal
+	// model tracks changes to the console logs.
+	model *RunLogsChangeModel
 
 	// consoleOutputFile is the run file path to which to write captured
 	// console messages.
 	consoleOutputFile paths.RelativePath
 
+	// structuredOutputFile is the run file path to which to write
+	// structured console logs, if enabled.
+	structuredOutputFile *paths.RelativePath
+
 	writer *debouncedWriter
 
+	// fsWriter sends console logs to the filestream, if any.
+	fsWriter *filestreamWriter
+
 	logger       *observability.CoreLogger
 	loopbackChan chan<- *service.Record
 }
@@ -83,9 +111,31 @@ func New(params Params) *Sender {
 		params.GetNow = time.Now
 	}
 
+	// When several nodes log to the same run, each node writes its own
+	// output file and marks its lines in the filestream.
+	var linePrefix string
+	if rank, ok := params.Settings.GetNodeRank(); ok {
+		// This is guaranteed not to fail, since the output file path
+		// is relative.
+		nodeOutputFile, _ := paths.Relative(filepath.Join(
+			fmt.Sprintf("node-%d", rank),
+			string(params.ConsoleOutputFile),
+		))
+		params.ConsoleOutputFile = *nodeOutputFile
+		linePrefix = fmt.Sprintf("[node %d] ", rank)
+	}
+
 	var fsWriter *filestreamWriter
 	if params.FileStreamOrNil != nil {
-		fsWriter = &filestreamWriter{FileStream: params.FileStreamOrNil}
+		fsWriter = &filestreamWriter{
+			FileStream: params.FileStreamOrNil,
+			Ctx:        params.Ctx,
+			LinePrefix: linePrefix,
+		}
+
+		if limit := params.Settings.GetConsoleUploadBytesPerSecond(); limit > 0 {
+			fsWriter.SetRateLimit(limit)
+		}
 	}
 
 	fileWriter, err := NewOutputFileWriter(
@@ -104,6 +154,32 @@ func New(params Params) *Sender {
 			))
 	}
 
+	var structuredOutputFile *paths.RelativePath
+	var structuredWriter *structuredFileWriter
+	if params.Settings.IsConsoleStructured() {
+		// This is guaranteed not to fail, since the output file path
+		// is relative.
+		structuredOutputFile, _ = paths.Relative(
+			structuredFileName(string(params.ConsoleOutputFile)))
+
+		structuredWriter, err = NewStructuredFileWriter(
+			filepath.Join(
+				params.Settings.GetFilesDir(),
+				string(*structuredOutputFile),
+			),
+			params.Logger,
+		)
+
+		if err != nil {
+			params.Logger.CaptureError(
+				fmt.Errorf(
+					"runconsolelogs: cannot write to structured file: %v",
+					err,
+				))
+			structuredOutputFile = nil
+		}
+	}
+
 	writer := NewDebouncedWriter(
 		rate.NewLimiter(rate.Every(10*time.Millisecond), 1),
 		params.Ctx,
@@ -112,6 +188,10 @@ func New(params Params) *Sender {
 				fileWriter.WriteToFile(lines)
 			}
 
+			if structuredWriter != nil {
+				structuredWriter.WriteToFile(lines)
+			}
+
 			if fsWriter != nil {
 				fsWriter.SendChanged(lines)
 			}
@@ -125,18 +205,15 @@ func New(params Params) *Sender {
 	}
 
 	return &Sender{
-		stdoutTerm: terminalemulator.NewTerminal(
-			model.LineSupplier(""),
-			maxTerminalLines,
-		),
-		stderrTerm: terminalemulator.NewTerminal(
-			model.LineSupplier("ERROR "),
-			maxTerminalLineLength,
-		),
+		streams:     make(map[terminalKey]*consoleStream),
+		dedupeLines: params.Settings.IsConsoleDedupeLines(),
+		model:       model,
 
-		consoleOutputFile: params.ConsoleOutputFile,
+		consoleOutputFile:    params.ConsoleOutputFile,
+		structuredOutputFile: structuredOutputFile,
 
 		writer:       writer,
+		fsWriter:     fsWriter,
 		logger:       params.Logger,
 		loopbackChan: params.LoopbackChan,
 	}
@@ -144,8 +221,19 @@ func New(params Params) *Sender {
 
 // Finish sends any remaining logs.
 //
+// The remaining logs are sent without waiting for the upload rate limit.
 // It must run before the filestream is closed.
 func (s *Sender) Finish() {
+	if s.fsWriter != nil {
+		s.fsWriter.StopLimiting()
+	}
+
+	for _, stream := range s.streams {
+		if stream.deduper != nil {
+			stream.term.Write(stream.deduper.Flush())
+		}
+	}
+
 	s.writer.Wait()
 	s.uploadOutputFile()
 }
@@ -153,11 +241,9 @@ func (s *Sender) Finish() {
 // StreamLogs saves captured console logs with the run.
 func (s *Sender) StreamLogs(record *service.OutputRawRecord) {
 	switch record.OutputType {
-	case service.OutputRawRecord_STDOUT:
-		s.stdoutTerm.Write(record.Line)
-
-	case service.OutputRawRecord_STDERR:
-		s.stderrTerm.Write(record.Line)
+	case service.OutputRawRecord_STDOUT,
+		service.OutputRawRecord_STDERR:
+		s.stream(record.OutputType, record.Source).Write(record.Line)
 
 	default:
 		s.logger.CaptureError(
@@ -167,18 +253,67 @@ func (s *Sender) StreamLogs(record *service.OutputRawRecord) {
 	}
 }
 
-// uploadOutputFile uploads the console output file that we created.
+// Write processes captured text from the stream.
+func (cs *consoleStream) Write(text string) {
+	if cs.deduper != nil {
+		text = cs.deduper.Process(text)
+	}
+
+	cs.term.Write(text)
+}
+
+// stream returns the pipeline for a stream and source process,
+// creating it if necessary.
+func (s *Sender) stream(
+	outputType service.OutputRawRecord_OutputType,
+	source string,
+) *consoleStream {
+	key := terminalKey{outputType: outputType, source: source}
+
+	if stream, ok := s.streams[key]; ok {
+		return stream
+	}
+
+	var term *terminalemulator.Terminal
+	if outputType == service.OutputRawRecord_STDOUT {
+		term = terminalemulator.NewTerminal(
+			s.model.LineSupplier("", source),
+			maxTerminalLines,
+		)
+	} else {
+		term = terminalemulator.NewTerminal(
+			s.model.LineSupplier("ERROR ", source),
+			maxTerminalLineLength,
+		)
+	}
+
+	stream := &consoleStream{term: term}
+	if s.dedupeLines {
+		stream.deduper = &lineDeduper{}
+	}
+
+	s.streams[key] = stream
+	return stream
+}
+
+// uploadOutputFile uploads the console output files that we created.
 func (s *Sender) uploadOutputFile() {
+	files := []*service.FilesItem{
+		{
+			Path: string(s.consoleOutputFile),
+			Type: service.FilesItem_WANDB,
+		},
+	}
+	if s.structuredOutputFile != nil {
+		files = append(files, &service.FilesItem{
+			Path: string(*s.structuredOutputFile),
+			Type: service.FilesItem_WANDB,
+		})
+	}
+
 	record := &service.Record{
 		RecordType: &service.Record_Files{
-			Files: &service.FilesRecord{
-				Files: []*service.FilesItem{
-					{
-						Path: string(s.consoleOutputFile),
-						Type: service.FilesItem_WANDB,
-					},
-				},
-			},
+			Files: &service.FilesRecord{Files: files},
 		},
 	}
 
@@ -2,6 +2,8 @@ package runconsolelogs_test
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -49,3 +51,161 @@ func TestFileStreamUpdates(t *testing.T) {
 		},
 		request.ConsoleLines.ToRuns())
 }
+
+func TestFinish_IgnoresRateLimit(t *testing.T) {
+	settingsProto := &service.Settings{
+		FilesDir:                     wrapperspb.String(t.TempDir()),
+		XConsoleUploadBytesPerSecond: wrapperspb.Int32(10),
+	}
+	fileStream := filestreamtest.NewFakeFileStream()
+	outputFile, _ := paths.Relative("output.log")
+	sender := New(Params{
+		ConsoleOutputFile: *outputFile,
+		Settings:          settings.From(settingsProto),
+		Logger:            observability.NewNoOpLogger(),
+		Ctx:               context.Background(),
+		LoopbackChan:      make(chan<- *service.Record, 10),
+		FileStreamOrNil:   fileStream,
+		GetNow: func() time.Time {
+			return time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		},
+	})
+
+	// Each line takes several seconds to send at 10 bytes per second.
+	sender.StreamLogs(&service.OutputRawRecord{Line: "line1\n"})
+	time.Sleep(50 * time.Millisecond)
+	sender.StreamLogs(&service.OutputRawRecord{Line: "line2\n"})
+	start := time.Now()
+	sender.Finish()
+
+	assert.Less(t, time.Since(start), time.Second)
+	request := fileStream.GetRequest(settingsProto)
+	assert.Equal(t,
+		[]sparselist.Run[string]{
+			{Start: 0, Items: []string{
+				"ERROR 2024-01-01T00:00:00.000000 line1",
+				"ERROR 2024-01-01T00:00:00.000000 line2",
+			}},
+		},
+		request.ConsoleLines.ToRuns())
+}
+
+func TestNodeRank(t *testing.T) {
+	filesDir := t.TempDir()
+	settingsProto := &service.Settings{
+		FilesDir:  wrapperspb.String(filesDir),
+		XShared:   wrapperspb.Bool(true),
+		XNodeRank: wrapperspb.Int32(2),
+	}
+	fileStream := filestreamtest.NewFakeFileStream()
+	outputFile, _ := paths.Relative("output.log")
+	sender := New(Params{
+		ConsoleOutputFile: *outputFile,
+		Settings:          settings.From(settingsProto),
+		Logger:            observability.NewNoOpLogger(),
+		Ctx:               context.Background(),
+		LoopbackChan:      make(chan<- *service.Record, 10),
+		FileStreamOrNil:   fileStream,
+		GetNow: func() time.Time {
+			return time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		},
+	})
+
+	sender.StreamLogs(&service.OutputRawRecord{Line: "line1\n"})
+	sender.Finish()
+
+	request := fileStream.GetRequest(settingsProto)
+	assert.Equal(t,
+		[]sparselist.Run[string]{
+			{Start: 0, Items: []string{
+				"ERROR 2024-01-01T00:00:00.000000 [node 2] line1",
+			}},
+		},
+		request.ConsoleLines.ToRuns())
+	content, err := os.ReadFile(filepath.Join(filesDir, "node-2", "output.log"))
+	assert.NoError(t, err)
+	assert.Equal(t, "line1\n", string(content))
+}
+
+func TestStructuredOutputFile(t *testing.T) {
+	filesDir := t.TempDir()
+	settingsProto := &service.Settings{
+		FilesDir:           wrapperspb.String(filesDir),
+		XConsoleStructured: wrapperspb.Bool(true),
+	}
+	loopbackChan := make(chan *service.Record, 10)
+	outputFile, _ := paths.Relative("output.log")
+	sender := New(Params{
+		ConsoleOutputFile: *outputFile,
+		Settings:          settings.From(settingsProto),
+		Logger:            observability.NewNoOpLogger(),
+		Ctx:               context.Background(),
+		LoopbackChan:      loopbackChan,
+		GetNow: func() time.Time {
+			return time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		},
+	})
+
+	sender.StreamLogs(&service.OutputRawRecord{
+		OutputType: service.OutputRawRecord_STDOUT,
+		Line:       "parent\n",
+	})
+	sender.StreamLogs(&service.OutputRawRecord{
+		OutputType: service.OutputRawRecord_STDOUT,
+		Line:       "child 50%",
+		Source:     "pid:42",
+	})
+	sender.StreamLogs(&service.OutputRawRecord{
+		OutputType: service.OutputRawRecord_STDERR,
+		Line:       "oops\n",
+	})
+	// A carriage return only affects the child's own line.
+	sender.StreamLogs(&service.OutputRawRecord{
+		OutputType: service.OutputRawRecord_STDOUT,
+		Line:       "\rchild 100%\n",
+		Source:     "pid:42",
+	})
+	sender.Finish()
+
+	content, err := os.ReadFile(filepath.Join(filesDir, "output.jsonl"))
+	assert.NoError(t, err)
+	assert.Equal(t,
+		`{"time":"2024-01-01T00:00:00Z","stream":"stdout","content":"parent"}`+"\n"+
+			`{"time":"2024-01-01T00:00:00Z","stream":"stdout","source":"pid:42","content":"child 100%"}`+"\n"+
+			`{"time":"2024-01-01T00:00:00Z","stream":"stderr","content":"oops"}`+"\n",
+		string(content))
+
+	record := <-loopbackChan
+	assert.Len(t, record.GetFiles().GetFiles(), 2)
+	assert.Equal(t, "output.jsonl", record.GetFiles().GetFiles()[1].GetPath())
+}
+
+func TestDedupeLines(t *testing.T) {
+	filesDir := t.TempDir()
+	settingsProto := &service.Settings{
+		FilesDir:            wrapperspb.String(filesDir),
+		XConsoleDedupeLines: wrapperspb.Bool(true),
+	}
+	outputFile, _ := paths.Relative("output.log")
+	sender := New(Params{
+		ConsoleOutputFile: *outputFile,
+		Settings:          settings.From(settingsProto),
+		Logger:            observability.NewNoOpLogger(),
+		Ctx:               context.Background(),
+		LoopbackChan:      make(chan *service.Record, 10),
+	})
+
+	for i := 0; i < 100; i++ {
+		sender.StreamLogs(&service.OutputRawRecord{
+			OutputType: service.OutputRawRecord_STDOUT,
+			Line:       "batch done\n",
+		})
+	}
+	sender.Finish()
+
+	content, err := os.ReadFile(filepath.Join(filesDir, "output.log"))
+	assert.NoError(t, err)
+	assert.Equal(t,
+		"batch done\n(previous line repeated 99 times)\n",
+		string(content))
+}
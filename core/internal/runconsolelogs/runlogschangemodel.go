@@ -33,6 +33,11 @@ type RunLogsLine struct {
 	// StreamPrefix is "ERROR " for stderr lines and "" for stdout lines.
 	StreamPrefix string
 
+	// Source identifies the process that wrote the line.
+	//
+	// It is empty for the user process itself.
+	Source string
+
 	// Timestamp is the time this line was created.
 	Timestamp time.Time
 }
@@ -42,22 +47,35 @@ func (l *RunLogsLine) Clone() *RunLogsLine {
 	return &RunLogsLine{
 		LineContent:  l.LineContent.Clone(),
 		StreamPrefix: l.StreamPrefix,
+		Source:       l.Source,
 		Timestamp:    l.Timestamp,
 	}
 }
 
-// LineSupplier returns a terminalemulator.LineSupplier for the stream prefix.
+// LineSupplier returns a terminalemulator.LineSupplier for the stream prefix
+// and source process.
 //
 // The stream prefix should either be "" for stdout or "ERROR " for stderr.
 // This is parsed by the W&B frontend, unfortunately.
-func (o *RunLogsChangeModel) LineSupplier(streamPrefix string) *RunLogsLineSupplier {
-	return &RunLogsLineSupplier{streamPrefix: streamPrefix, output: o}
+func (o *RunLogsChangeModel) LineSupplier(
+	streamPrefix string,
+	source string,
+) *RunLogsLineSupplier {
+	return &RunLogsLineSupplier{
+		streamPrefix: streamPrefix,
+		source:       source,
+		output:       o,
+	}
 }
 
 // NextLine allocates a new line in the output buffer.
-func (o *RunLogsChangeModel) NextLine(streamPrefix string) RunLogsLineRef {
+func (o *RunLogsChangeModel) NextLine(
+	streamPrefix string,
+	source string,
+) RunLogsLineRef {
 	line := &RunLogsLine{}
 	line.StreamPrefix = streamPrefix
+	line.Source = source
 	line.MaxLength = o.maxLineLength
 	line.Timestamp = o.getNow()
 
@@ -78,11 +96,12 @@ func (o *RunLogsChangeModel) NextLine(streamPrefix string) RunLogsLineRef {
 // output buffer.
 type RunLogsLineSupplier struct {
 	streamPrefix string
+	source       string
 	output       *RunLogsChangeModel
 }
 
 func (s *RunLogsLineSupplier) NextLine() terminalemulator.Line {
-	return s.output.NextLine(s.streamPrefix)
+	return s.output.NextLine(s.streamPrefix, s.source)
 }
 
 // RunLogsLineRef is a reference to a console logs line.
@@ -0,0 +1,90 @@
+package runconsolelogs
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/wandb/wandb/core/internal/sparselist"
+	"github.com/wandb/wandb/core/pkg/observability"
+)
+
+// StructuredLine is a line in the structured console logs file.
+type StructuredLine struct {
+	// Time is when the line was created, in RFC 3339 format.
+	Time string `json:"time"`
+
+	// Stream is either "stdout" or "stderr".
+	Stream string `json:"stream"`
+
+	// Source is the process that wrote the line, if not the user process.
+	Source string `json:"source,omitempty"`
+
+	// Content is the text of the line.
+	Content string `json:"content"`
+}
+
+// structuredFileWriter saves run console logs in a local JSON lines file,
+// one JSON object per line of output.
+//
+// Unlike the plain output file, the structured file records which stream
+// and process each line came from.
+type structuredFileWriter struct {
+	outputFile *lineFile
+	logger     *observability.CoreLogger
+}
+
+func NewStructuredFileWriter(
+	path string,
+	logger *observability.CoreLogger,
+) (*structuredFileWriter, error) {
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return nil, err
+	}
+
+	outputFile, err := CreateLineFile(path, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	return &structuredFileWriter{outputFile: outputFile, logger: logger}, nil
+}
+
+func (w *structuredFileWriter) WriteToFile(
+	changes sparselist.SparseList[*RunLogsLine],
+) {
+	lines := sparselist.Map(changes, func(line *RunLogsLine) string {
+		stream := "stdout"
+		if line.StreamPrefix != "" {
+			stream = "stderr"
+		}
+
+		// Marshaling a struct of strings cannot fail.
+		data, _ := json.Marshal(StructuredLine{
+			Time:    line.Timestamp.UTC().Format(time.RFC3339Nano),
+			Stream:  stream,
+			Source:  line.Source,
+			Content: string(line.Content),
+		})
+
+		return string(data)
+	})
+
+	err := w.outputFile.UpdateLines(lines)
+	if err != nil {
+		w.logger.CaptureError(
+			fmt.Errorf(
+				"runconsolelogs: failed to write to structured file: %v",
+				err,
+			))
+	}
+}
+
+// structuredFileName returns the name of the structured logs file that
+// goes with the plain output file.
+func structuredFileName(outputFile string) string {
+	ext := filepath.Ext(outputFile)
+	return outputFile[:len(outputFile)-len(ext)] + ".jsonl"
+}
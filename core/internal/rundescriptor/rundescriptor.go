@@ -0,0 +1,213 @@
+// Package rundescriptor creates portable descriptors of runs.
+//
+// A descriptor identifies a run and carries digests of its identity and
+// initial config. It can be relayed by hand across an air gap, for example
+// as a QR code, and later matched against the synced run.
+package rundescriptor
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"rsc.io/qr"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+const (
+	// Version is the version of the descriptor format.
+	Version = 1
+
+	// FileName is the name of the descriptor file in the run directory.
+	FileName = "wandb-descriptor.json"
+
+	// QRFileName is the name of the QR code image in the run directory.
+	QRFileName = "wandb-descriptor.png"
+)
+
+// Descriptor identifies a run.
+type Descriptor struct {
+	Version     int    `json:"version"`
+	RunID       string `json:"run_id"`
+	Entity      string `json:"entity,omitempty"`
+	Project     string `json:"project,omitempty"`
+	DisplayName string `json:"display_name,omitempty"`
+	Host        string `json:"host,omitempty"`
+	StartedAt   string `json:"started_at"`
+	URL         string `json:"url,omitempty"`
+
+	// ConfigSHA256 is the digest of the run's initial config.
+	ConfigSHA256 string `json:"config_sha256"`
+
+	// Fingerprint is the digest of all the other fields.
+	Fingerprint string `json:"fingerprint"`
+}
+
+// New returns the descriptor of a run.
+func New(run *service.RunRecord, runURL string) (*Descriptor, error) {
+	config, err := proto.MarshalOptions{Deterministic: true}.Marshal(run.GetConfig())
+	if err != nil {
+		return nil, fmt.Errorf("rundescriptor: failed to encode config: %v", err)
+	}
+	configDigest := sha256.Sum256(config)
+
+	d := &Descriptor{
+		Version:      Version,
+		RunID:        run.GetRunId(),
+		Entity:       run.GetEntity(),
+		Project:      run.GetProject(),
+		DisplayName:  run.GetDisplayName(),
+		Host:         run.GetHost(),
+		StartedAt:    run.GetStartTime().AsTime().UTC().Format(time.RFC3339Nano),
+		URL:          runURL,
+		ConfigSHA256: hex.EncodeToString(configDigest[:]),
+	}
+	d.Fingerprint, err = d.fingerprint()
+	if err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// RunURL returns the URL of a run in the W&B app, given the base URL of
+// the W&B API, the same way the SDK's run_url setting does.
+//
+// It returns "" if the run's entity or project is not known.
+func RunURL(baseURL string, run *service.RunRecord) string {
+	if run.GetEntity() == "" || run.GetProject() == "" || run.GetRunId() == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("%s/%s/%s/runs/%s",
+		appURL(baseURL),
+		url.PathEscape(run.GetEntity()),
+		url.PathEscape(run.GetProject()),
+		url.PathEscape(run.GetRunId()))
+}
+
+// appURL returns the URL of the W&B app for the API's base URL.
+func appURL(baseURL string) string {
+	if app := os.Getenv("WANDB_APP_URL"); app != "" {
+		return strings.TrimRight(app, "/")
+	}
+
+	switch {
+	case strings.Contains(baseURL, "://api.wandb.test"):
+		baseURL = strings.Replace(baseURL, "://api.", "://app.", 1)
+	case strings.Contains(baseURL, "://api.wandb."):
+		baseURL = strings.Replace(baseURL, "://api.", "://", 1)
+	case strings.Contains(baseURL, "://api."):
+		baseURL = strings.Replace(baseURL, "://api.", "://app.", 1)
+	}
+	return strings.TrimRight(baseURL, "/")
+}
+
+// fingerprint computes the digest of the descriptor without its fingerprint.
+func (d *Descriptor) fingerprint() (string, error) {
+	unsigned := *d
+	unsigned.Fingerprint = ""
+
+	data, err := json.Marshal(unsigned)
+	if err != nil {
+		return "", fmt.Errorf("rundescriptor: failed to encode: %v", err)
+	}
+
+	digest := sha256.Sum256(data)
+	return hex.EncodeToString(digest[:]), nil
+}
+
+// Verify checks that the descriptor's fingerprint matches its contents.
+func (d *Descriptor) Verify() error {
+	fingerprint, err := d.fingerprint()
+	if err != nil {
+		return err
+	}
+	if fingerprint != d.Fingerprint {
+		return errors.New("rundescriptor: fingerprint mismatch")
+	}
+	return nil
+}
+
+// ShortFingerprint returns a prefix of the fingerprint that is easy to
+// compare by eye.
+func (d *Descriptor) ShortFingerprint() string {
+	if len(d.Fingerprint) < 12 {
+		return d.Fingerprint
+	}
+	return d.Fingerprint[:12]
+}
+
+// Encode returns the descriptor as it's stored and relayed.
+func (d *Descriptor) Encode() ([]byte, error) {
+	data, err := json.Marshal(d)
+	if err != nil {
+		return nil, fmt.Errorf("rundescriptor: failed to encode: %v", err)
+	}
+	return data, nil
+}
+
+// Write stores the descriptor in the directory, with a QR code image
+// of it if withQR is true.
+//
+// It returns the path to the descriptor.
+func (d *Descriptor) Write(dir string, withQR bool) (string, error) {
+	data, err := d.Encode()
+	if err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(dir, FileName)
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return "", fmt.Errorf("rundescriptor: failed to write: %v", err)
+	}
+
+	if withQR {
+		code, err := qr.Encode(string(data), qr.M)
+		if err != nil {
+			return path, fmt.Errorf("rundescriptor: failed to make QR code: %v", err)
+		}
+
+		qrPath := filepath.Join(dir, QRFileName)
+		if err := os.WriteFile(qrPath, code.PNG(), 0o644); err != nil {
+			return path, fmt.Errorf("rundescriptor: failed to write QR code: %v", err)
+		}
+	}
+
+	return path, nil
+}
+
+// Read loads and verifies a descriptor from a file.
+func Read(path string) (*Descriptor, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("rundescriptor: failed to read: %v", err)
+	}
+
+	d := &Descriptor{}
+	if err := json.Unmarshal(data, d); err != nil {
+		return nil, fmt.Errorf("rundescriptor: failed to decode: %v", err)
+	}
+
+	if err := d.Verify(); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// Matches reports whether the descriptor refers to the given run.
+func (d *Descriptor) Matches(run *service.RunRecord) bool {
+	// Offline runs may not know their entity or project yet.
+	return d.RunID == run.GetRunId() &&
+		(d.Entity == "" || d.Entity == run.GetEntity()) &&
+		(d.Project == "" || d.Project == run.GetProject())
+}
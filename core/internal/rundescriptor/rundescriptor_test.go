@@ -0,0 +1,104 @@
+package rundescriptor_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/rundescriptor"
+	"github.com/wandb/wandb/core/pkg/service"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func testRun() *service.RunRecord {
+	return &service.RunRecord{
+		RunId:     "abc123",
+		Entity:    "team",
+		Project:   "proj",
+		StartTime: timestamppb.New(time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)),
+		Config: &service.ConfigRecord{
+			Update: []*service.ConfigItem{{Key: "lr", ValueJson: "0.1"}},
+		},
+	}
+}
+
+func TestNew_IsDeterministic(t *testing.T) {
+	d1, err := rundescriptor.New(testRun(), "https://wandb.ai/team/proj/runs/abc123")
+	require.NoError(t, err)
+	d2, err := rundescriptor.New(testRun(), "https://wandb.ai/team/proj/runs/abc123")
+	require.NoError(t, err)
+
+	assert.Equal(t, d1, d2)
+	assert.Equal(t, "2024-07-01T00:00:00Z", d1.StartedAt)
+	assert.Len(t, d1.Fingerprint, 64)
+	assert.Len(t, d1.ShortFingerprint(), 12)
+	assert.NoError(t, d1.Verify())
+}
+
+func TestNew_ConfigChangesDigest(t *testing.T) {
+	run := testRun()
+	d1, _ := rundescriptor.New(run, "")
+	run.Config.Update[0].ValueJson = "0.2"
+	d2, _ := rundescriptor.New(run, "")
+
+	assert.NotEqual(t, d1.ConfigSHA256, d2.ConfigSHA256)
+	assert.NotEqual(t, d1.Fingerprint, d2.Fingerprint)
+}
+
+func TestWriteAndRead(t *testing.T) {
+	dir := t.TempDir()
+	d, err := rundescriptor.New(testRun(), "")
+	require.NoError(t, err)
+
+	path, err := d.Write(dir, true)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, rundescriptor.FileName), path)
+	assert.FileExists(t, filepath.Join(dir, rundescriptor.QRFileName))
+
+	read, err := rundescriptor.Read(path)
+	require.NoError(t, err)
+	assert.Equal(t, d, read)
+	assert.True(t, read.Matches(testRun()))
+	assert.False(t, read.Matches(&service.RunRecord{RunId: "other"}))
+}
+
+func TestRead_DetectsTampering(t *testing.T) {
+	dir := t.TempDir()
+	d, _ := rundescriptor.New(testRun(), "")
+	d.Project = "other"
+	path := filepath.Join(dir, "descriptor.json")
+	data := []byte(`{"version":1,"run_id":"abc123","project":"other",` +
+		`"started_at":"2024-07-01T00:00:00Z","config_sha256":"",` +
+		`"fingerprint":"` + d.Fingerprint + `"}`)
+	require.NoError(t, os.WriteFile(path, data, 0o644))
+
+	_, err := rundescriptor.Read(path)
+	assert.ErrorContains(t, err, "fingerprint mismatch")
+}
+
+func TestRunURL(t *testing.T) {
+	t.Setenv("WANDB_APP_URL", "")
+	testCases := map[string]string{
+		"https://api.wandb.ai":       "https://wandb.ai/team/proj/runs/abc123",
+		"https://api.example.com/":   "https://app.example.com/team/proj/runs/abc123",
+		"http://localhost:8080":      "http://localhost:8080/team/proj/runs/abc123",
+		"https://api.wandb.test:443": "https://app.wandb.test:443/team/proj/runs/abc123",
+	}
+	for baseURL, expected := range testCases {
+		assert.Equal(t, expected, rundescriptor.RunURL(baseURL, testRun()), baseURL)
+	}
+
+	assert.Empty(t, rundescriptor.RunURL("https://api.wandb.ai",
+		&service.RunRecord{RunId: "abc123"}))
+}
+
+func TestRunURL_AppURLFromEnv(t *testing.T) {
+	t.Setenv("WANDB_APP_URL", "https://wandb.example.com/")
+
+	assert.Equal(t,
+		"https://wandb.example.com/team/proj/runs/abc123",
+		rundescriptor.RunURL("https://api.wandb.ai", testRun()))
+}
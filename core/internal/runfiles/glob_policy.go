@@ -0,0 +1,112 @@
+package runfiles
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/wandb/wandb/core/internal/filetransfer"
+	"github.com/wandb/wandb/core/internal/paths"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// globPolicy is how to upload the files matching a glob saved by a client.
+type globPolicy struct {
+	// The slash-separated glob relative to the run's files directory.
+	glob string
+
+	// Slash-separated globs of files not to upload.
+	exclude []string
+
+	policy   service.FilesItem_PolicyType
+	category filetransfer.RunFileKind
+
+	// Files already uploaded for a "now" glob, which are uploaded once.
+	uploadedNow map[paths.RelativePath]struct{}
+}
+
+func newGlobPolicy(glob paths.RelativePath, file *service.FilesItem) *globPolicy {
+	exclude := make([]string, len(file.GetExclude()))
+	for i, glob := range file.GetExclude() {
+		exclude[i] = filepath.ToSlash(glob)
+	}
+
+	return &globPolicy{
+		glob:        filepath.ToSlash(string(glob)),
+		exclude:     exclude,
+		policy:      file.GetPolicy(),
+		category:    filetransfer.RunFileKindFromProto(file.GetType()),
+		uploadedNow: make(map[paths.RelativePath]struct{}),
+	}
+}
+
+// Matches returns whether the policy applies to a file.
+func (p *globPolicy) Matches(runPath paths.RelativePath) bool {
+	slashPath := filepath.ToSlash(string(runPath))
+
+	if !paths.MatchGlob(p.glob, slashPath) {
+		return false
+	}
+	for _, exclude := range p.exclude {
+		if paths.MatchGlob(exclude, slashPath) {
+			return false
+		}
+	}
+	return true
+}
+
+// Expand returns the files in the files directory that the policy applies
+// to.
+func (p *globPolicy) Expand(filesDir string) []paths.RelativePath {
+	var matches []paths.RelativePath
+
+	// Only walk the part of the tree that can match.
+	root := filesDir
+	if static := p.staticDir(); static != "" {
+		root = filepath.Join(filesDir, filepath.FromSlash(static))
+	}
+
+	_ = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return nil
+		}
+
+		// Symlinks to directories aren't followed.
+		if d.Type()&fs.ModeSymlink != 0 {
+			if info, err := os.Stat(path); err != nil || info.IsDir() {
+				return nil
+			}
+		}
+
+		relPath, err := filepath.Rel(filesDir, path)
+		if err != nil {
+			return nil
+		}
+		runPath, err := paths.Relative(relPath)
+		if err != nil || !p.Matches(*runPath) {
+			return nil
+		}
+
+		matches = append(matches, *runPath)
+		return nil
+	})
+
+	return matches
+}
+
+// staticDir returns the longest directory prefix of the glob without glob
+// syntax.
+func (p *globPolicy) staticDir() string {
+	segments := strings.Split(p.glob, "/")
+
+	var static []string
+	for _, segment := range segments[:len(segments)-1] {
+		if paths.HasGlobMeta(segment) {
+			break
+		}
+		static = append(static, segment)
+	}
+
+	return strings.Join(static, "/")
+}
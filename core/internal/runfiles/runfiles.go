@@ -66,6 +66,10 @@ type UploaderParams struct {
 	GraphQL      graphql.Client
 	FileWatcher  watcher.Watcher
 
+	// OnUploaded, if set, is called with the path of a file after it is
+	// uploaded successfully, unless another upload of it is scheduled.
+	OnUploaded func(path paths.RelativePath)
+
 	// How long to wait to batch upload operations.
 	//
 	// This helps if multiple uploads are scheduled around the same time by
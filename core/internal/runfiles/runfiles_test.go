@@ -87,6 +87,9 @@ func TestUploader(t *testing.T) {
 	// The _sync mode to set on Settings.
 	var isSync bool
 
+	// The files reported to OnUploaded.
+	var uploaded []paths.RelativePath
+
 	// Resets test objects and runs a given test.
 	runTest := func(
 		name string,
@@ -99,6 +102,7 @@ func TestUploader(t *testing.T) {
 		ignoreGlobs = []string{}
 		isOffline = false
 		isSync = false
+		uploaded = nil
 		configure()
 
 		fakeFileStream = filestreamtest.NewFakeFileStream()
@@ -117,6 +121,9 @@ func TestUploader(t *testing.T) {
 			FileTransfer: fakeFileTransfer,
 			FileWatcher:  fakeFileWatcher,
 			BatchDelay:   batchDelay,
+			OnUploaded: func(path paths.RelativePath) {
+				uploaded = append(uploaded, path)
+			},
 			Settings: settings.From(&service.Settings{
 				FilesDir:    &wrapperspb.StringValue{Value: filesDir},
 				IgnoreGlobs: &service.ListStringValue{Value: ignoreGlobs},
@@ -150,6 +157,22 @@ func TestUploader(t *testing.T) {
 			})
 	}
 
+	runTest("OnUploaded is called after upload",
+		func() {},
+		func(t *testing.T) {
+			stubCreateRunFilesOneFile(mockGQLClient, "test.txt")
+			writeEmptyFile(t, filepath.Join(filesDir, "test.txt"))
+
+			uploader.Process(&service.FilesRecord{
+				Files: []*service.FilesItem{
+					{Path: "test.txt", Policy: service.FilesItem_NOW},
+				},
+			})
+			uploader.Finish()
+
+			assert.Equal(t, []paths.RelativePath{"test.txt"}, uploaded)
+		})
+
 	runTest("Process with 'live' policy watches file",
 		func() {},
 		func(t *testing.T) {
@@ -166,6 +189,84 @@ func TestUploader(t *testing.T) {
 				fakeFileWatcher.IsWatching(filepath.Join(filesDir, "test.txt")))
 		})
 
+	runTest("Process with glob uploads matching files",
+		func() {},
+		func(t *testing.T) {
+			mockGQLClient.StubMatchOnce(
+				gomock.All(
+					gqlmock.WithOpName("CreateRunFiles"),
+					gqlmock.WithVariables(
+						gqlmock.GQLVar("files", gomock.Len(2)),
+					),
+				),
+				`{
+					"createRunFiles": {
+						"runID": "test-run",
+						"files": [
+							{"name": "ckpt/x.pt", "uploadUrl": "URL1"},
+							{"name": "ckpt/sub/y.pt", "uploadUrl": "URL2"}
+						]
+					}
+				}`,
+			)
+			writeEmptyFile(t, filepath.Join(filesDir, "ckpt", "x.pt"))
+			writeEmptyFile(t, filepath.Join(filesDir, "ckpt", "sub", "y.pt"))
+			writeEmptyFile(t, filepath.Join(filesDir, "ckpt", "sub", "y.txt"))
+			writeEmptyFile(t, filepath.Join(filesDir, "ckpt", "skip.pt"))
+
+			uploader.Process(&service.FilesRecord{
+				Files: []*service.FilesItem{
+					{
+						Path:    "ckpt/**/*.pt",
+						Exclude: []string{"ckpt/skip.pt"},
+						Policy:  service.FilesItem_NOW,
+					},
+				},
+			})
+			uploader.Finish()
+
+			assert.True(t, mockGQLClient.AllStubsUsed())
+			assert.Len(t, fakeFileTransfer.Tasks(), 2)
+		})
+
+	runTest("Process with 'live' glob uploads new matching files",
+		func() {},
+		func(t *testing.T) {
+			stubCreateRunFilesOneFile(mockGQLClient, "logs/new.txt")
+
+			uploader.Process(&service.FilesRecord{
+				Files: []*service.FilesItem{
+					{Path: "logs/*.txt", Policy: service.FilesItem_LIVE},
+				},
+			})
+			writeEmptyFile(t, filepath.Join(filesDir, "logs", "new.txt"))
+			writeEmptyFile(t, filepath.Join(filesDir, "logs", "new.csv"))
+			fakeFileWatcher.OnChange(filepath.Join(filesDir, "logs", "new.txt"))
+			fakeFileWatcher.OnChange(filepath.Join(filesDir, "logs", "new.csv"))
+			uploader.Finish()
+
+			assert.True(t, fakeFileWatcher.IsWatching(filesDir))
+			assert.Len(t, fakeFileTransfer.Tasks(), 1)
+		})
+
+	runTest("UploadRemaining uploads files matching 'end' glob",
+		func() {},
+		func(t *testing.T) {
+			stubCreateRunFilesOneFile(mockGQLClient, "out/result.json")
+
+			uploader.Process(&service.FilesRecord{
+				Files: []*service.FilesItem{
+					{Path: "out/*.json", Policy: service.FilesItem_END},
+				},
+			})
+			writeEmptyFile(t, filepath.Join(filesDir, "out", "result.json"))
+			uploader.UploadRemaining()
+			uploader.Finish()
+
+			assert.False(t, fakeFileWatcher.IsWatching(filesDir))
+			assert.Len(t, fakeFileTransfer.Tasks(), 1)
+		})
+
 	runTest("Process with 'now' policy during sync is no-op",
 		func() { isSync = true },
 		func(t *testing.T) {
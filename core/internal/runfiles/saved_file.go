@@ -26,6 +26,10 @@ type savedFile struct {
 	// The kind of file this is.
 	category filetransfer.RunFileKind
 
+	// Called after a successful upload that isn't followed by a
+	// reupload, or nil.
+	onUploaded func(path paths.RelativePath)
+
 	// Wait group for uploads of the file.
 	wg *sync.WaitGroup
 
@@ -52,13 +56,15 @@ func newSavedFile(
 	logger *observability.CoreLogger,
 	realPath string,
 	runPath paths.RelativePath,
+	onUploaded func(path paths.RelativePath),
 ) *savedFile {
 	return &savedFile{
-		fs:       fs,
-		ftm:      ftm,
-		logger:   logger,
-		realPath: realPath,
-		runPath:  runPath,
+		fs:         fs,
+		ftm:        ftm,
+		logger:     logger,
+		realPath:   realPath,
+		runPath:    runPath,
+		onUploaded: onUploaded,
 
 		wg: &sync.WaitGroup{},
 	}
@@ -128,12 +134,17 @@ func (f *savedFile) onFinishUpload(task *filetransfer.Task) {
 
 	f.Lock()
 	f.isUploading = false
+	reuploading := f.reuploadScheduled
 	if f.reuploadScheduled {
 		f.reuploadScheduled = false
 		f.doUpload(f.reuploadURL, f.reuploadHeaders)
 	}
 	f.Unlock()
 
+	if task.Err == nil && !reuploading && f.onUploaded != nil {
+		f.onUploaded(f.runPath)
+	}
+
 	f.wg.Done()
 }
 
@@ -34,6 +34,12 @@ type uploader struct {
 	// Files explicitly requested to be uploaded at the end of the run.
 	uploadAtEnd map[paths.RelativePath]struct{}
 
+	// Policies for files matching globs, in the order they were saved.
+	globs []*globPolicy
+
+	// Whether the files directory is watched for files matching globs.
+	isWatchingFilesDir bool
+
 	// Whether 'Finish' was called.
 	isFinished bool
 
@@ -45,6 +51,9 @@ type uploader struct {
 
 	// A watcher for 'live' mode files.
 	watcher watcher.Watcher
+
+	// Called after a file is uploaded, or nil; see UploaderParams.
+	onUploaded func(path paths.RelativePath)
 }
 
 func newUploader(params UploaderParams) *uploader {
@@ -62,7 +71,8 @@ func newUploader(params UploaderParams) *uploader {
 		uploadWG: &sync.WaitGroup{},
 		stateMu:  &sync.Mutex{},
 
-		watcher: params.FileWatcher,
+		watcher:    params.FileWatcher,
+		onUploaded: params.OnUploaded,
 	}
 
 	uploader.uploadBatcher = newUploadBatcher(
@@ -87,6 +97,11 @@ func (u *uploader) Process(record *service.FilesRecord) {
 	nowFiles := make([]paths.RelativePath, 0)
 
 	for _, file := range record.GetFiles() {
+		if paths.HasGlobMeta(file.GetPath()) {
+			nowFiles = append(nowFiles, u.processGlob(file)...)
+			continue
+		}
+
 		maybeRunPath, err := paths.Relative(file.GetPath())
 		if err != nil {
 			u.logger.CaptureError(
@@ -129,6 +144,116 @@ func (u *uploader) Process(record *service.FilesRecord) {
 	u.uploadBatcher.Add(nowFiles)
 }
 
+// processGlob applies a glob's policy to the files that match it, both
+// existing ones and ones created later.
+//
+// Returns the files to upload immediately.
+func (u *uploader) processGlob(file *service.FilesItem) []paths.RelativePath {
+	glob, err := paths.Relative(file.GetPath())
+	if err != nil || !glob.IsLocal() {
+		u.logger.CaptureError(
+			fmt.Errorf(
+				"runfiles: glob is not in the files directory: %s",
+				file.GetPath(),
+			))
+		return nil
+	}
+
+	policy := newGlobPolicy(*glob, file)
+	u.globs = append(u.globs, policy)
+
+	// Files matching "end" globs are found when the run finishes.
+	if policy.policy != service.FilesItem_END {
+		u.watchFilesDir()
+	}
+
+	nowFiles := make([]paths.RelativePath, 0)
+	for _, runPath := range policy.Expand(u.settings.GetFilesDir()) {
+		if u.applyGlobPolicy(policy, runPath) {
+			nowFiles = append(nowFiles, runPath)
+		}
+	}
+	return nowFiles
+}
+
+// applyGlobPolicy records how to upload a file that matches a glob and
+// returns whether to upload it immediately.
+func (u *uploader) applyGlobPolicy(
+	policy *globPolicy,
+	runPath paths.RelativePath,
+) bool {
+	u.knownFile(runPath).SetCategory(policy.category)
+
+	switch policy.policy {
+	case service.FilesItem_NOW:
+		if _, uploaded := policy.uploadedNow[runPath]; uploaded {
+			return false
+		}
+		policy.uploadedNow[runPath] = struct{}{}
+		return true
+
+	case service.FilesItem_LIVE:
+		u.uploadAtEnd[runPath] = struct{}{}
+		return true
+
+	case service.FilesItem_END:
+		u.uploadAtEnd[runPath] = struct{}{}
+	}
+
+	return false
+}
+
+// watchFilesDir starts watching the run's files directory for files
+// matching saved globs, if it isn't watched already.
+func (u *uploader) watchFilesDir() {
+	if u.isWatchingFilesDir {
+		return
+	}
+	u.isWatchingFilesDir = true
+
+	filesDir := u.settings.GetFilesDir()
+	if err := u.watcher.WatchTree(filesDir, u.onFilesDirChange); err != nil {
+		u.logger.CaptureError(
+			fmt.Errorf(
+				"runfiles: error watching files directory %s: %v",
+				filesDir,
+				err,
+			))
+	}
+}
+
+// onFilesDirChange uploads a created or modified file in the files
+// directory according to the globs that match it.
+func (u *uploader) onFilesDirChange(realPath string) {
+	relPath, err := filepath.Rel(u.settings.GetFilesDir(), realPath)
+	if err != nil {
+		return
+	}
+	runPath, err := paths.Relative(relPath)
+	if err != nil || !runPath.IsLocal() {
+		return
+	}
+
+	u.stateMu.Lock()
+	defer u.stateMu.Unlock()
+
+	// Not an error: the watcher may run callbacks until it's finished.
+	if u.isFinished {
+		return
+	}
+
+	uploadNow := false
+	for _, policy := range u.globs {
+		if policy.Matches(*runPath) && u.applyGlobPolicy(policy, *runPath) {
+			uploadNow = true
+		}
+	}
+
+	if uploadNow {
+		u.uploadBatcher.Add([]paths.RelativePath{*runPath})
+	}
+}
+
 // toRealPath takes a path relative to the run's files directory and returns
 // either an absolute path to that file or a path that's relative to the
 // current working directory.
@@ -164,6 +289,16 @@ func (u *uploader) UploadRemaining() {
 	}
 	defer u.stateMu.Unlock()
 
+	// Include files matching globs that the watcher didn't report.
+	for _, policy := range u.globs {
+		if policy.policy == service.FilesItem_NOW {
+			continue
+		}
+		for _, runPath := range policy.Expand(u.settings.GetFilesDir()) {
+			u.applyGlobPolicy(policy, runPath)
+		}
+	}
+
 	runPaths := make([]paths.RelativePath, 0, len(u.uploadAtEnd))
 	for k := range u.uploadAtEnd {
 		runPaths = append(runPaths, k)
@@ -209,6 +344,7 @@ func (u *uploader) knownFile(runPath paths.RelativePath) *savedFile {
 			u.logger,
 			u.toRealPath(string(runPath)),
 			runPath,
+			u.onUploaded,
 		)
 	}
 
@@ -250,67 +386,106 @@ func (u *uploader) upload(runPaths []paths.RelativePath) {
 	runPaths = u.filterIgnored(runPaths)
 	u.uploadWG.Add(len(runPaths))
 
+	// Upload URLs expire, so large files only get theirs once they
+	// may be uploaded.
+	var small, large []paths.RelativePath
+	var largestSize int64
+	for _, runPath := range runPaths {
+		size := u.fileSize(runPath)
+		if filetransfer.IsLargeFile(size) {
+			large = append(large, runPath)
+			largestSize = max(largestSize, size)
+		} else {
+			small = append(small, runPath)
+		}
+	}
+
+	if len(small) > 0 {
+		go u.createAndUpload(small)
+	}
+	if len(large) > 0 {
+		go func() {
+			u.ftm.WaitForUploadWindow(u.ctx, largestSize)
+			u.createAndUpload(large)
+		}()
+	}
+}
+
+// fileSize returns the size of a file in the files directory, or 0 if
+// it can't be read.
+func (u *uploader) fileSize(runPath paths.RelativePath) int64 {
+	info, err := os.Stat(u.toRealPath(string(runPath)))
+	if err != nil {
+		return 0
+	}
+	return info.Size()
+}
+
+// createAndUpload requests upload URLs for files and schedules their
+// uploads.
+//
+// Decrements `uploadWG` for each file once its upload is scheduled or
+// fails to be.
+func (u *uploader) createAndUpload(runPaths []paths.RelativePath) {
 	runSlashPaths := make([]string, len(runPaths))
 	for i, path := range runPaths {
 		runSlashPaths[i] = filepath.ToSlash(string(path))
 	}
 
-	go func() {
-		createRunFilesResponse, err := gql.CreateRunFiles(
-			u.ctx,
-			u.graphQL,
-			u.settings.GetEntity(),
-			u.settings.GetProject(),
-			u.settings.GetRunID(),
-			runSlashPaths,
-		)
-		if err != nil {
-			u.logger.CaptureError(
-				fmt.Errorf("runfiles: CreateRunFiles returned error: %v", err))
-			u.uploadWG.Add(-len(runPaths))
-			return
+	createRunFilesResponse, err := gql.CreateRunFiles(
+		u.ctx,
+		u.graphQL,
+		u.settings.GetEntity(),
+		u.settings.GetProject(),
+		u.settings.GetRunID(),
+		runSlashPaths,
+	)
+	if err != nil {
+		u.logger.CaptureError(
+			fmt.Errorf("runfiles: CreateRunFiles returned error: %v", err))
+		u.uploadWG.Add(-len(runPaths))
+		return
+	}
+
+	if len(createRunFilesResponse.CreateRunFiles.Files) != len(runPaths) {
+		u.logger.CaptureError(
+			fmt.Errorf(
+				"runfiles: CreateRunFiles returned %d files but expected %d",
+				len(createRunFilesResponse.CreateRunFiles.Files),
+				len(runPaths),
+			))
+		u.uploadWG.Add(-len(runPaths))
+		return
+	}
+
+	for _, f := range createRunFilesResponse.CreateRunFiles.Files {
+		if f.UploadUrl == nil {
+			u.logger.CaptureWarn(
+				"runfiles: CreateRunFiles has empty UploadUrl",
+				"response", createRunFilesResponse)
+			u.uploadWG.Done()
+			continue
 		}
 
-		if len(createRunFilesResponse.CreateRunFiles.Files) != len(runPaths) {
+		maybeRunPath, err := paths.Relative(filepath.FromSlash(f.Name))
+		if err != nil || !maybeRunPath.IsLocal() {
 			u.logger.CaptureError(
 				fmt.Errorf(
-					"runfiles: CreateRunFiles returned %d files but expected %d",
-					len(createRunFilesResponse.CreateRunFiles.Files),
-					len(runPaths),
-				))
-			u.uploadWG.Add(-len(runPaths))
-			return
+					"runfiles: CreateRunFiles returned unexpected file name: %v",
+					err,
+				),
+				"response", createRunFilesResponse)
+			u.uploadWG.Done()
+			continue
 		}
+		runPath := *maybeRunPath
 
-		for _, f := range createRunFilesResponse.CreateRunFiles.Files {
-			if f.UploadUrl == nil {
-				u.logger.CaptureWarn(
-					"runfiles: CreateRunFiles has empty UploadUrl",
-					"response", createRunFilesResponse)
-				u.uploadWG.Done()
-				continue
-			}
-
-			maybeRunPath, err := paths.Relative(filepath.FromSlash(f.Name))
-			if err != nil || !maybeRunPath.IsLocal() {
-				u.logger.CaptureError(
-					fmt.Errorf(
-						"runfiles: CreateRunFiles returned unexpected file name: %v",
-						err,
-					),
-					"response", createRunFilesResponse)
-				u.uploadWG.Done()
-				continue
-			}
-			runPath := *maybeRunPath
-
-			u.scheduleUploadTask(
-				runPath,
-				*f.UploadUrl,
-				createRunFilesResponse.CreateRunFiles.UploadHeaders,
-			)
-		}
-	}()
+		u.scheduleUploadTask(
+			runPath,
+			*f.UploadUrl,
+			createRunFilesResponse.CreateRunFiles.UploadHeaders,
+		)
+	}
 }
 
 // Warns for any non-existing files and returns a slice without them.
@@ -0,0 +1,274 @@
+package runhistory
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// AggregationPolicy is how an Aggregator combines the values of a metric
+// within a window.
+type AggregationPolicy string
+
+const (
+	// Subsample keeps the first value in each window.
+	Subsample AggregationPolicy = "subsample"
+
+	// Mean averages numeric values; other values are subsampled.
+	Mean AggregationPolicy = "mean"
+
+	// Reservoir keeps a value chosen uniformly at random from each window.
+	Reservoir AggregationPolicy = "reservoir"
+)
+
+// ParseAggregationPolicy validates a policy name.
+func ParseAggregationPolicy(name string) (AggregationPolicy, error) {
+	switch policy := AggregationPolicy(name); policy {
+	case Subsample, Mean, Reservoir:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("runhistory: unknown aggregation policy %q", name)
+	}
+}
+
+// Aggregator limits the rate of history rows by combining rows that are
+// logged close together.
+//
+// Rows are grouped into windows of 1/MaxRate seconds according to their
+// "_timestamp". When a row arrives outside the current window, the
+// rows of the window are combined into one row, metric by metric,
+// according to each metric's policy.
+type Aggregator struct {
+	interval float64
+	policies []globPolicy
+	rand     *rand.Rand
+
+	// windowStart is the timestamp of the first row in the window.
+	windowStart float64
+
+	// window is the aggregated state of the current window, or nil.
+	window *aggregationWindow
+}
+
+type globPolicy struct {
+	glob   string
+	policy AggregationPolicy
+}
+
+type AggregatorParams struct {
+	// MaxRate is the maximum number of rows per second.
+	MaxRate float64
+
+	// Policies maps metric name globs to policies.
+	//
+	// When several globs match a metric, the longest one wins. Metrics
+	// that match none are subsampled.
+	Policies map[string]AggregationPolicy
+
+	// Rand is the source of randomness for the reservoir policy.
+	Rand *rand.Rand
+}
+
+// NewAggregator returns an Aggregator, or nil if MaxRate is not positive.
+func NewAggregator(params AggregatorParams) *Aggregator {
+	if params.MaxRate <= 0 {
+		return nil
+	}
+
+	policies := make([]globPolicy, 0, len(params.Policies))
+	for glob, policy := range params.Policies {
+		policies = append(policies, globPolicy{glob, policy})
+	}
+	sort.Slice(policies, func(i, j int) bool {
+		if len(policies[i].glob) != len(policies[j].glob) {
+			return len(policies[i].glob) > len(policies[j].glob)
+		}
+		return policies[i].glob < policies[j].glob
+	})
+
+	rng := params.Rand
+	if rng == nil {
+		rng = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+
+	return &Aggregator{
+		interval: 1 / params.MaxRate,
+		policies: policies,
+		rand:     rng,
+	}
+}
+
+// Add adds a history row and returns the rows that are ready to send.
+//
+// Rows must be added in order.
+func (a *Aggregator) Add(history *service.HistoryRecord) []*service.HistoryRecord {
+	timestamp, ok := rowTimestamp(history)
+	if !ok {
+		timestamp = float64(time.Now().UnixNano()) / 1e9
+	}
+
+	var ready []*service.HistoryRecord
+	if a.window != nil && timestamp-a.windowStart >= a.interval {
+		ready = append(ready, a.Flush()...)
+	}
+
+	if a.window == nil {
+		a.window = newAggregationWindow()
+		a.windowStart = timestamp
+	}
+	a.window.add(history, a.policyFor, a.rand)
+
+	return ready
+}
+
+// Flush returns the aggregated row of the current window, if any,
+// and starts a new window.
+func (a *Aggregator) Flush() []*service.HistoryRecord {
+	if a.window == nil {
+		return nil
+	}
+
+	row := a.window.result()
+	a.window = nil
+	return []*service.HistoryRecord{row}
+}
+
+// policyFor returns the policy for a metric.
+func (a *Aggregator) policyFor(key string) AggregationPolicy {
+	// Internal keys like "_step" and "_timestamp" describe the row as
+	// a whole, and the aggregated row is placed at the end of the window.
+	if strings.HasPrefix(key, "_") {
+		return last
+	}
+
+	for _, p := range a.policies {
+		if matched, _ := filepath.Match(p.glob, key); matched {
+			return p.policy
+		}
+	}
+	return Subsample
+}
+
+// last keeps the last value in each window.
+const last AggregationPolicy = "last"
+
+// aggregationWindow accumulates the values of each metric in a window.
+type aggregationWindow struct {
+	// order is the metrics in order of first appearance.
+	order []string
+
+	metrics map[string]*aggregatedMetric
+
+	// step is the last step in the window.
+	step *service.HistoryStep
+}
+
+type aggregatedMetric struct {
+	item   *service.HistoryItem
+	policy AggregationPolicy
+
+	// count is the number of values seen.
+	count int
+
+	// sum and numeric are used by the mean policy. The mean is used only
+	// if every value was a finite number.
+	sum     float64
+	numeric bool
+}
+
+func newAggregationWindow() *aggregationWindow {
+	return &aggregationWindow{metrics: make(map[string]*aggregatedMetric)}
+}
+
+func (w *aggregationWindow) add(
+	history *service.HistoryRecord,
+	policyFor func(string) AggregationPolicy,
+	rng *rand.Rand,
+) {
+	if history.GetStep() != nil {
+		w.step = history.GetStep()
+	}
+
+	for _, item := range history.GetItem() {
+		key := string(getMetricKey(item))
+
+		metric, ok := w.metrics[key]
+		if !ok {
+			metric = &aggregatedMetric{policy: policyFor(key), numeric: true}
+			w.metrics[key] = metric
+			w.order = append(w.order, key)
+		}
+		metric.count++
+
+		switch metric.policy {
+		case Subsample:
+			if metric.count == 1 {
+				metric.item = item
+			}
+
+		case Reservoir:
+			// Reservoir sampling with a reservoir of size one.
+			if rng.Intn(metric.count) == 0 {
+				metric.item = item
+			}
+
+		case Mean:
+			if metric.count == 1 {
+				metric.item = item
+			}
+			value, err := strconv.ParseFloat(item.GetValueJson(), 64)
+			if err != nil || math.IsInf(value, 0) || math.IsNaN(value) {
+				metric.numeric = false
+			} else {
+				metric.sum += value
+			}
+
+		default:
+			metric.item = item
+		}
+	}
+}
+
+func (w *aggregationWindow) result() *service.HistoryRecord {
+	row := &service.HistoryRecord{Step: w.step}
+
+	for _, key := range w.order {
+		metric := w.metrics[key]
+		item := metric.item
+
+		if metric.policy == Mean && metric.numeric && metric.count > 1 {
+			item = &service.HistoryItem{
+				Key:       item.GetKey(),
+				NestedKey: slices.Clone(item.GetNestedKey()),
+				ValueJson: strconv.FormatFloat(
+					metric.sum/float64(metric.count), 'g', -1, 64),
+			}
+		}
+
+		row.Item = append(row.Item, item)
+	}
+
+	return row
+}
+
+// rowTimestamp returns a row's "_timestamp" in seconds.
+func rowTimestamp(history *service.HistoryRecord) (float64, bool) {
+	for _, item := range history.GetItem() {
+		if item.GetKey() != "_timestamp" {
+			continue
+		}
+
+		timestamp, err := strconv.ParseFloat(item.GetValueJson(), 64)
+		return timestamp, err == nil
+	}
+
+	return 0, false
+}
@@ -0,0 +1,129 @@
+package runhistory_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/runhistory"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// row makes a history row at the given time with the given values.
+func row(step int64, timestamp float64, values map[string]string) *service.HistoryRecord {
+	history := &service.HistoryRecord{
+		Step: &service.HistoryStep{Num: step},
+		Item: []*service.HistoryItem{
+			{Key: "_step", ValueJson: fmt.Sprint(step)},
+			{Key: "_timestamp", ValueJson: fmt.Sprint(timestamp)},
+		},
+	}
+	for key, value := range values {
+		history.Item = append(history.Item,
+			&service.HistoryItem{Key: key, ValueJson: value})
+	}
+	return history
+}
+
+// valueOf returns the value of a key in a row.
+func valueOf(history *service.HistoryRecord, key string) string {
+	for _, item := range history.GetItem() {
+		if item.GetKey() == key {
+			return item.GetValueJson()
+		}
+	}
+	return ""
+}
+
+func TestNewAggregator_NilWithoutRate(t *testing.T) {
+	assert.Nil(t, runhistory.NewAggregator(runhistory.AggregatorParams{}))
+}
+
+func TestAggregator_Mean(t *testing.T) {
+	aggregator := runhistory.NewAggregator(runhistory.AggregatorParams{
+		MaxRate: 1,
+		Policies: map[string]runhistory.AggregationPolicy{
+			"*": runhistory.Mean,
+		},
+	})
+
+	assert.Empty(t, aggregator.Add(row(0, 100.0, map[string]string{"loss": "1"})))
+	assert.Empty(t, aggregator.Add(row(1, 100.5, map[string]string{"loss": "2"})))
+	assert.Empty(t, aggregator.Add(row(2, 100.9, map[string]string{"loss": "6"})))
+	rows := aggregator.Add(row(3, 101.0, map[string]string{"loss": "10"}))
+
+	require.Len(t, rows, 1)
+	assert.Equal(t, "3", valueOf(rows[0], "loss"))
+	assert.Equal(t, "2", valueOf(rows[0], "_step"))
+	assert.EqualValues(t, 2, rows[0].GetStep().GetNum())
+
+	rows = aggregator.Flush()
+	require.Len(t, rows, 1)
+	assert.Equal(t, "10", valueOf(rows[0], "loss"))
+	assert.Empty(t, aggregator.Flush())
+}
+
+func TestAggregator_MeanOfNonNumbersSubsamples(t *testing.T) {
+	aggregator := runhistory.NewAggregator(runhistory.AggregatorParams{
+		MaxRate: 1,
+		Policies: map[string]runhistory.AggregationPolicy{
+			"*": runhistory.Mean,
+		},
+	})
+
+	aggregator.Add(row(0, 100.0, map[string]string{"x": `"a"`}))
+	aggregator.Add(row(1, 100.5, map[string]string{"x": "NaN"}))
+	rows := aggregator.Flush()
+
+	require.Len(t, rows, 1)
+	assert.Equal(t, `"a"`, valueOf(rows[0], "x"))
+}
+
+func TestAggregator_PolicyByGlob(t *testing.T) {
+	aggregator := runhistory.NewAggregator(runhistory.AggregatorParams{
+		MaxRate: 1,
+		Policies: map[string]runhistory.AggregationPolicy{
+			"*":       runhistory.Mean,
+			"train/*": runhistory.Subsample,
+		},
+	})
+
+	aggregator.Add(row(0, 100.0, map[string]string{"train/loss": "1", "acc": "1"}))
+	aggregator.Add(row(1, 100.5, map[string]string{"train/loss": "2", "acc": "2"}))
+	rows := aggregator.Flush()
+
+	require.Len(t, rows, 1)
+	assert.Equal(t, "1", valueOf(rows[0], "train/loss"))
+	assert.Equal(t, "1.5", valueOf(rows[0], "acc"))
+}
+
+func TestAggregator_Reservoir(t *testing.T) {
+	aggregator := runhistory.NewAggregator(runhistory.AggregatorParams{
+		MaxRate: 0.001,
+		Policies: map[string]runhistory.AggregationPolicy{
+			"*": runhistory.Reservoir,
+		},
+		Rand: rand.New(rand.NewSource(1)),
+	})
+
+	for i := 0; i < 100; i++ {
+		aggregator.Add(row(int64(i), 100+float64(i)/100,
+			map[string]string{"loss": fmt.Sprint(i)}))
+	}
+	rows := aggregator.Flush()
+
+	require.Len(t, rows, 1)
+	assert.NotEmpty(t, valueOf(rows[0], "loss"))
+	assert.Equal(t, "99", valueOf(rows[0], "_step"))
+}
+
+func TestParseAggregationPolicy(t *testing.T) {
+	policy, err := runhistory.ParseAggregationPolicy("mean")
+	assert.NoError(t, err)
+	assert.Equal(t, runhistory.Mean, policy)
+
+	_, err = runhistory.ParseAggregationPolicy("median")
+	assert.Error(t, err)
+}
@@ -0,0 +1,93 @@
+package runhistory
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// ExpandBatch returns the rows of a columnar history batch as separate
+// history records, in order.
+//
+// A column's missing rows are left out of the corresponding records, and
+// records without any values are skipped.
+func ExpandBatch(batch *service.HistoryBatchRecord) ([]*service.HistoryRecord, error) {
+	steps := batch.GetStep()
+	for i := 1; i < len(steps); i++ {
+		if steps[i] <= steps[i-1] {
+			return nil, fmt.Errorf(
+				"runhistory: batch steps must be increasing, got %d after %d",
+				steps[i], steps[i-1])
+		}
+	}
+
+	rows := make([][]*service.HistoryItem, len(steps))
+	for _, column := range batch.GetColumn() {
+		if column.GetKey() == "" && len(column.GetNestedKey()) == 0 {
+			return nil, fmt.Errorf("runhistory: batch column has no key")
+		}
+		if len(column.GetValue()) != len(steps) {
+			return nil, fmt.Errorf(
+				"runhistory: batch column %q has %d values for %d steps",
+				columnName(column), len(column.GetValue()), len(steps))
+		}
+
+		missing := make([]bool, len(steps))
+		for _, i := range column.GetMissing() {
+			if int(i) >= len(steps) {
+				return nil, fmt.Errorf(
+					"runhistory: batch column %q has missing row %d of %d",
+					columnName(column), i, len(steps))
+			}
+			missing[i] = true
+		}
+
+		for i, value := range column.GetValue() {
+			if missing[i] {
+				continue
+			}
+			rows[i] = append(rows[i], &service.HistoryItem{
+				Key:       column.GetKey(),
+				NestedKey: column.GetNestedKey(),
+				ValueJson: FloatJSON(value),
+			})
+		}
+	}
+
+	records := make([]*service.HistoryRecord, 0, len(steps))
+	for i, items := range rows {
+		if len(items) == 0 {
+			continue
+		}
+		records = append(records, &service.HistoryRecord{
+			Step: &service.HistoryStep{Num: steps[i]},
+			Item: items,
+		})
+	}
+	return records, nil
+}
+
+// columnName returns a batch column's key for error messages.
+func columnName(column *service.HistoryBatchColumn) string {
+	if len(column.GetNestedKey()) > 0 {
+		return fmt.Sprint(column.GetNestedKey())
+	}
+	return column.GetKey()
+}
+
+// FloatJSON encodes a float the way the SDK encodes history values, which
+// allows NaN and infinities.
+func FloatJSON(value float64) string {
+	switch {
+	case math.IsNaN(value):
+		return "NaN"
+	case math.IsInf(value, 1):
+		return "Infinity"
+	case math.IsInf(value, -1):
+		return "-Infinity"
+	default:
+		return strconv.FormatFloat(value, 'g', -1, 64)
+	}
+}
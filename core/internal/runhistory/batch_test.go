@@ -0,0 +1,86 @@
+package runhistory_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/runhistory"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+func TestExpandBatch(t *testing.T) {
+	records, err := runhistory.ExpandBatch(&service.HistoryBatchRecord{
+		Step: []int64{3, 4, 7},
+		Column: []*service.HistoryBatchColumn{
+			{Key: "loss", Value: []float64{0.5, math.NaN(), 0.25}},
+			{
+				NestedKey: []string{"val", "acc"},
+				Value:     []float64{0, 0.75, 0},
+				Missing:   []uint32{0, 2},
+			},
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	assert.EqualValues(t, 3, records[0].GetStep().GetNum())
+	assert.EqualValues(t, 7, records[2].GetStep().GetNum())
+
+	require.Len(t, records[0].GetItem(), 1)
+	assert.Equal(t, "loss", records[0].GetItem()[0].GetKey())
+	assert.Equal(t, "0.5", records[0].GetItem()[0].GetValueJson())
+
+	require.Len(t, records[1].GetItem(), 2)
+	assert.Equal(t, "NaN", records[1].GetItem()[0].GetValueJson())
+	assert.Equal(t, []string{"val", "acc"}, records[1].GetItem()[1].GetNestedKey())
+	assert.Equal(t, "0.75", records[1].GetItem()[1].GetValueJson())
+
+	assert.Len(t, records[2].GetItem(), 1)
+}
+
+func TestExpandBatch_SkipsEmptyRows(t *testing.T) {
+	records, err := runhistory.ExpandBatch(&service.HistoryBatchRecord{
+		Step: []int64{0, 1},
+		Column: []*service.HistoryBatchColumn{
+			{Key: "x", Value: []float64{1, 2}, Missing: []uint32{0}},
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.EqualValues(t, 1, records[0].GetStep().GetNum())
+}
+
+func TestExpandBatch_Invalid(t *testing.T) {
+	testCases := []struct {
+		name  string
+		batch *service.HistoryBatchRecord
+	}{
+		{"decreasing steps", &service.HistoryBatchRecord{
+			Step: []int64{2, 1},
+		}},
+		{"wrong value count", &service.HistoryBatchRecord{
+			Step:   []int64{1, 2},
+			Column: []*service.HistoryBatchColumn{{Key: "x", Value: []float64{1}}},
+		}},
+		{"missing row out of range", &service.HistoryBatchRecord{
+			Step: []int64{1},
+			Column: []*service.HistoryBatchColumn{
+				{Key: "x", Value: []float64{1}, Missing: []uint32{1}},
+			},
+		}},
+		{"no key", &service.HistoryBatchRecord{
+			Step:   []int64{1},
+			Column: []*service.HistoryBatchColumn{{Value: []float64{1}}},
+		}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := runhistory.ExpandBatch(tc.batch)
+			assert.Error(t, err)
+		})
+	}
+}
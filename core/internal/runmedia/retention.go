@@ -0,0 +1,263 @@
+// Package runmedia deals with the media files of a W&B run.
+//
+// Media such as images are written by the client to the run's
+// "media" directory, one file per key and step.
+package runmedia
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// MediaFile is a media file's key and step, parsed from its path.
+type MediaFile struct {
+	// Key identifies the media series, including the media type,
+	// e.g. "images/train/samples".
+	Key string
+
+	// Step is the step at which the file was logged.
+	Step int64
+}
+
+// ParseMediaPath parses a path relative to the run's files directory.
+//
+// Media paths have the form "media/<type>/<key>_<step>_<id>.<ext>", where
+// the key may contain slashes. The second return value is false if the
+// path is not a media path.
+func ParseMediaPath(path string) (MediaFile, bool) {
+	rest, ok := strings.CutPrefix(filepath.ToSlash(path), "media/")
+	if !ok {
+		return MediaFile{}, false
+	}
+
+	mediaType, name, ok := strings.Cut(rest, "/")
+	if !ok {
+		return MediaFile{}, false
+	}
+
+	// Strip the extension, which may have several parts as in ".pts.json".
+	dir, base := "", name
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		dir, base = name[:i+1], name[i+1:]
+	}
+	if i := strings.Index(base, "."); i >= 0 {
+		base = base[:i]
+	}
+
+	// Split off the ID and then the step.
+	i := strings.LastIndex(base, "_")
+	if i < 0 {
+		return MediaFile{}, false
+	}
+	base = base[:i]
+
+	i = strings.LastIndex(base, "_")
+	if i < 0 {
+		return MediaFile{}, false
+	}
+	step, err := strconv.ParseInt(base[i+1:], 10, 64)
+	if err != nil {
+		return MediaFile{}, false
+	}
+
+	return MediaFile{
+		Key:  mediaType + "/" + dir + base[:i],
+		Step: step,
+	}, true
+}
+
+// Retention limits how much media is kept on disk for each key.
+//
+// High-frequency media logging can fill up the disk before files are
+// uploaded. Retention drops media from steps that are not sampled and
+// deletes the oldest files of a key once it has too many.
+//
+// Files that were already passed on for upload are only deleted after
+// they are uploaded, so that the upload doesn't fail and the run's
+// history doesn't refer to missing files. Offline runs keep them, since
+// they are uploaded when the run is synced.
+type Retention struct {
+	mu sync.Mutex
+
+	keepLast    int
+	sampleSteps int64
+	filesDir    string
+	logger      *observability.CoreLogger
+
+	// kept are the paths of the media files on disk for each key,
+	// oldest first.
+	kept map[string][]string
+
+	// isKept is the set of all paths in kept.
+	isKept map[string]struct{}
+
+	// uploaded is the set of paths in kept that have been uploaded.
+	uploaded map[string]struct{}
+
+	// rotatedOut is the set of paths that are no longer kept but are
+	// waiting to be uploaded before they are deleted.
+	rotatedOut map[string]struct{}
+
+	sampledOut int
+	rotated    int
+}
+
+type RetentionParams struct {
+	// KeepLast is the number of files to keep per key, or 0 to keep all.
+	KeepLast int
+
+	// SampleSteps drops media logged at steps that are not a multiple
+	// of it. Values less than 2 keep media from every step.
+	SampleSteps int
+
+	// FilesDir is the run's files directory.
+	FilesDir string
+
+	Logger *observability.CoreLogger
+}
+
+// NewRetention returns a Retention, or nil if no limits are set.
+func NewRetention(params RetentionParams) *Retention {
+	if params.KeepLast <= 0 && params.SampleSteps <= 1 {
+		return nil
+	}
+
+	return &Retention{
+		keepLast:    params.KeepLast,
+		sampleSteps: int64(params.SampleSteps),
+		filesDir:    params.FilesDir,
+		logger:      params.Logger,
+		kept:        make(map[string][]string),
+		isKept:      make(map[string]struct{}),
+		uploaded:    make(map[string]struct{}),
+		rotatedOut:  make(map[string]struct{}),
+	}
+}
+
+// Filter applies the retention limits to newly saved files.
+//
+// Media files that are dropped, and files rotated out by later files in
+// the same record, are deleted from disk. Files from earlier records that
+// are rotated out are deleted once Uploaded is called for them.
+//
+// It returns the record of the remaining files, which is nil if none
+// remain, and whether any file was dropped.
+func (r *Retention) Filter(
+	record *service.FilesRecord,
+) (*service.FilesRecord, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	dropped := r.sampledOut + r.rotated
+
+	files := make([]*service.FilesItem, 0, len(record.GetFiles()))
+	inRecord := make(map[string]struct{})
+	removed := make(map[string]struct{})
+
+	for _, file := range record.GetFiles() {
+		path := filepath.Clean(file.GetPath())
+		media, ok := ParseMediaPath(path)
+		if !ok {
+			files = append(files, file)
+			continue
+		}
+
+		if r.sampleSteps > 1 && media.Step%r.sampleSteps != 0 {
+			r.sampledOut++
+			r.remove(path)
+			continue
+		}
+
+		files = append(files, file)
+		if _, ok := r.isKept[path]; ok {
+			continue
+		}
+		r.kept[media.Key] = append(r.kept[media.Key], path)
+		r.isKept[path] = struct{}{}
+		inRecord[path] = struct{}{}
+
+		if r.keepLast > 0 && len(r.kept[media.Key]) > r.keepLast {
+			oldest := r.kept[media.Key][0]
+			r.kept[media.Key] = r.kept[media.Key][1:]
+			delete(r.isKept, oldest)
+			r.rotated++
+
+			_, isNew := inRecord[oldest]
+			_, isUploaded := r.uploaded[oldest]
+			delete(r.uploaded, oldest)
+			switch {
+			case isNew:
+				r.remove(oldest)
+				removed[oldest] = struct{}{}
+			case isUploaded:
+				r.remove(oldest)
+			default:
+				r.rotatedOut[oldest] = struct{}{}
+			}
+		}
+	}
+
+	// Don't forward files that were rotated out by later files
+	// in the same record.
+	if len(removed) > 0 {
+		remaining := files[:0]
+		for _, file := range files {
+			if _, ok := removed[filepath.Clean(file.GetPath())]; !ok {
+				remaining = append(remaining, file)
+			}
+		}
+		files = remaining
+	}
+
+	changed := r.sampledOut+r.rotated != dropped
+	if len(files) == 0 {
+		return nil, changed
+	}
+
+	return &service.FilesRecord{Files: files}, changed
+}
+
+// Uploaded is called after a file in the run's files directory has been
+// uploaded, and deletes it if it was rotated out.
+//
+// The path is relative to the files directory.
+func (r *Retention) Uploaded(path string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	path = filepath.Clean(path)
+	if _, ok := r.rotatedOut[path]; ok {
+		delete(r.rotatedOut, path)
+		r.remove(path)
+	} else if _, ok := r.isKept[path]; ok {
+		r.uploaded[path] = struct{}{}
+	}
+}
+
+// Telemetry returns the number of media files dropped so far.
+func (r *Retention) Telemetry() *service.TelemetryRecord {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return &service.TelemetryRecord{
+		MediaSampledOut: int32(r.sampledOut),
+		MediaRotated:    int32(r.rotated),
+	}
+}
+
+// remove deletes a file in the run's files directory.
+func (r *Retention) remove(path string) {
+	err := os.Remove(filepath.Join(r.filesDir, path))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		r.logger.CaptureError(
+			fmt.Errorf("runmedia: failed to delete %s: %v", path, err))
+	}
+}
@@ -0,0 +1,139 @@
+package runmedia_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/runmedia"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+func TestParseMediaPath(t *testing.T) {
+	testCases := []struct {
+		path string
+		file runmedia.MediaFile
+		ok   bool
+	}{
+		{"media/images/samples_12_abc123.png",
+			runmedia.MediaFile{Key: "images/samples", Step: 12}, true},
+		{"media/images/train/samples_3_abc123.png",
+			runmedia.MediaFile{Key: "images/train/samples", Step: 3}, true},
+		{"media/object3D/cloud_point_7_abc.pts.json",
+			runmedia.MediaFile{Key: "object3D/cloud_point", Step: 7}, true},
+		{"media/images/not-media.png", runmedia.MediaFile{}, false},
+		{"media/images/key_notastep_abc.png", runmedia.MediaFile{}, false},
+		{"output.log", runmedia.MediaFile{}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.path, func(t *testing.T) {
+			file, ok := runmedia.ParseMediaPath(tc.path)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.file, file)
+		})
+	}
+}
+
+// saveFiles creates empty files and returns a record of them.
+func saveFiles(t *testing.T, dir string, paths ...string) *service.FilesRecord {
+	record := &service.FilesRecord{}
+	for _, path := range paths {
+		fullPath := filepath.Join(dir, path)
+		require.NoError(t, os.MkdirAll(filepath.Dir(fullPath), 0o755))
+		require.NoError(t, os.WriteFile(fullPath, []byte{}, 0o644))
+		record.Files = append(record.Files, &service.FilesItem{Path: path})
+	}
+	return record
+}
+
+func paths(record *service.FilesRecord) []string {
+	var result []string
+	for _, file := range record.GetFiles() {
+		result = append(result, file.GetPath())
+	}
+	return result
+}
+
+func TestNewRetention_NilIfUnlimited(t *testing.T) {
+	assert.Nil(t, runmedia.NewRetention(runmedia.RetentionParams{SampleSteps: 1}))
+}
+
+func TestRetention_KeepLast(t *testing.T) {
+	dir := t.TempDir()
+	retention := runmedia.NewRetention(runmedia.RetentionParams{
+		KeepLast: 2,
+		FilesDir: dir,
+		Logger:   observability.NewNoOpLogger(),
+	})
+
+	record, dropped := retention.Filter(saveFiles(t, dir,
+		"media/images/a_0_x.png",
+		"media/images/a_1_x.png",
+		"media/images/b_1_x.png",
+		"output.log",
+	))
+	assert.False(t, dropped)
+	assert.Len(t, record.GetFiles(), 4)
+	retention.Uploaded("media/images/a_0_x.png")
+
+	record, dropped = retention.Filter(saveFiles(t, dir,
+		"media/images/a_2_x.png",
+		"media/images/a_3_x.png",
+	))
+	assert.True(t, dropped)
+	assert.Equal(t,
+		[]string{"media/images/a_2_x.png", "media/images/a_3_x.png"},
+		paths(record))
+	assert.NoFileExists(t, filepath.Join(dir, "media/images/a_0_x.png"))
+	assert.FileExists(t, filepath.Join(dir, "media/images/b_1_x.png"))
+	assert.EqualValues(t, 2, retention.Telemetry().GetMediaRotated())
+
+	// a_1 was rotated out before it was uploaded.
+	assert.FileExists(t, filepath.Join(dir, "media/images/a_1_x.png"))
+	retention.Uploaded("media/images/a_1_x.png")
+	assert.NoFileExists(t, filepath.Join(dir, "media/images/a_1_x.png"))
+
+	retention.Uploaded("media/images/a_2_x.png")
+	assert.FileExists(t, filepath.Join(dir, "media/images/a_2_x.png"))
+}
+
+func TestRetention_RotatesWithinRecord(t *testing.T) {
+	dir := t.TempDir()
+	retention := runmedia.NewRetention(runmedia.RetentionParams{
+		KeepLast: 1,
+		FilesDir: dir,
+		Logger:   observability.NewNoOpLogger(),
+	})
+
+	record, _ := retention.Filter(saveFiles(t, dir,
+		"media/images/a_0_x.png",
+		"media/images/a_1_x.png",
+	))
+
+	assert.Equal(t, []string{"media/images/a_1_x.png"}, paths(record))
+}
+
+func TestRetention_SampleSteps(t *testing.T) {
+	dir := t.TempDir()
+	retention := runmedia.NewRetention(runmedia.RetentionParams{
+		SampleSteps: 5,
+		FilesDir:    dir,
+		Logger:      observability.NewNoOpLogger(),
+	})
+
+	record, dropped := retention.Filter(saveFiles(t, dir,
+		"media/images/a_4_x.png",
+		"media/images/a_5_x.png",
+	))
+	assert.True(t, dropped)
+	assert.Equal(t, []string{"media/images/a_5_x.png"}, paths(record))
+	assert.NoFileExists(t, filepath.Join(dir, "media/images/a_4_x.png"))
+
+	record, _ = retention.Filter(saveFiles(t, dir, "media/images/a_6_x.png"))
+	assert.Nil(t, record)
+	assert.EqualValues(t, 2, retention.Telemetry().GetMediaSampledOut())
+}
@@ -275,6 +275,9 @@ func TestUpdate(t *testing.T) {
 					value, ok := configCopy.Tree()["lr"]
 					require.True(t, ok, "Expected key 'lr' in config")
 					assert.Equal(t, 0.001, value)
+					require.Len(t, tc.run.Config.Update, 1)
+					assert.Equal(t, "lr", tc.run.Config.Update[0].Key)
+					assert.Equal(t, "0.001", tc.run.Config.Update[0].ValueJson)
 				}
 
 				if tc.expectTagsUpdate {
@@ -156,7 +156,7 @@ func (r *State) update(bucket *Bucket, run *service.RunRecord, config *runconfig
 	}
 
 	r.AddOffset(filestream.OutputChunk, *bucket.GetLogLineCount())
-	if err := r.updateConfig(bucket, config); err != nil {
+	if err := r.updateConfig(run, bucket, config); err != nil {
 		r.logger.Error(err.Error())
 		isErr = true
 	}
@@ -251,7 +251,11 @@ func (r *State) updateSummary(run *service.RunRecord, bucket *Bucket) error {
 }
 
 // Merges the original run's config into the current config.
+//
+// The run record's config is replaced by the merged config so that the
+// client can see the values it did not set itself.
 func (r *State) updateConfig(
+	run *service.RunRecord,
 	bucket *Bucket,
 	config *runconfig.RunConfig,
 ) error {
@@ -300,6 +304,28 @@ func (r *State) updateConfig(
 			),
 		)
 	}
+
+	run.Config = &service.ConfigRecord{}
+	for key, value := range config.Tree() {
+		if key == "_wandb" {
+			continue
+		}
+		valueJson, err := json.Marshal(value)
+		if err != nil {
+			r.logger.Error(
+				fmt.Sprintf(
+					"sender: updateConfig: failed to marshal config"+
+						" value for '%v': %s",
+					key, err,
+				),
+			)
+			continue
+		}
+		run.Config.Update = append(run.Config.Update, &service.ConfigItem{
+			Key:       key,
+			ValueJson: string(valueJson),
+		})
+	}
 	return nil
 }
 
@@ -0,0 +1,229 @@
+// Package runstate reconstructs the state of a run at a point in its past.
+//
+// The state is rebuilt by replaying the run's records, as stored in its
+// transaction log, up to the requested step or time. This makes it possible
+// to see what the config and summary looked like at any step, for example
+// to find out when a config change affected a metric.
+package runstate
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/wandb/segmentio-encoding/json"
+	"github.com/wandb/wandb/core/internal/runconfig"
+	"github.com/wandb/wandb/core/internal/runsummary"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// Point is a position in a run's timeline.
+//
+// Replaying stops at the first history row past either limit.
+type Point struct {
+	// Step is the last history step to include.
+	Step int64
+
+	// Time is the latest history timestamp to include.
+	//
+	// The zero value means there is no time limit.
+	Time time.Time
+}
+
+// End is the point after all of a run's records.
+var End = Point{Step: math.MaxInt64}
+
+// Alert is an alert that was raised during the run.
+type Alert struct {
+	*service.AlertRecord
+
+	// Step is the last history step logged before the alert.
+	Step int64
+}
+
+// ConfigRevision is a change to the run's config.
+type ConfigRevision struct {
+	*service.ConfigRecord
+
+	// Step is the last history step logged before the change.
+	Step int64
+}
+
+// State is the reconstructed state of a run.
+type State struct {
+	// Run is the latest run record, if any.
+	Run *service.RunRecord
+
+	// Step is the last history step that was replayed, or -1 if none.
+	Step int64
+
+	// Time is the timestamp of the last history row that was replayed.
+	Time time.Time
+
+	// Config is the run config.
+	Config *runconfig.RunConfig
+
+	// ConfigRevisions are the config changes, in order.
+	ConfigRevisions []ConfigRevision
+
+	// Summary is the run summary.
+	Summary *runsummary.RunSummary
+
+	// Alerts are the alerts raised, in order.
+	Alerts []Alert
+}
+
+// Replay reconstructs a run's state at the given point.
+//
+// The next function returns the run's records in order, and [io.EOF]
+// after the last one. Records that cannot be applied are passed to onError
+// and skipped.
+func Replay(
+	next func() (*service.Record, error),
+	at Point,
+	onError func(error),
+) (*State, error) {
+	state := newState()
+	return state, state.replay(next, at, onError)
+}
+
+func newState() *State {
+	return &State{
+		Step:    -1,
+		Config:  runconfig.New(),
+		Summary: runsummary.New(runsummary.Params{}),
+	}
+}
+
+// clone returns a copy of the state that can be updated separately.
+func (s *State) clone() (*State, error) {
+	config, err := s.Config.CloneTree()
+	if err != nil {
+		return nil, fmt.Errorf("runstate: failed to copy config: %v", err)
+	}
+	summary, err := s.Summary.CloneTree()
+	if err != nil {
+		return nil, fmt.Errorf("runstate: failed to copy summary: %v", err)
+	}
+
+	return &State{
+		Run:             s.Run,
+		Step:            s.Step,
+		Time:            s.Time,
+		Config:          runconfig.NewFrom(config),
+		ConfigRevisions: slices.Clone(s.ConfigRevisions),
+		Summary:         runsummary.NewFrom(summary),
+		Alerts:          slices.Clone(s.Alerts),
+	}, nil
+}
+
+// replay applies records to the state up to the given point.
+func (s *State) replay(
+	next func() (*service.Record, error),
+	at Point,
+	onError func(error),
+) error {
+	for {
+		record, err := next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("runstate: failed to read record: %v", err)
+		}
+
+		if history := record.GetHistory(); history != nil {
+			if !s.includes(history, at) {
+				return nil
+			}
+			s.applyHistory(history, onError)
+			continue
+		}
+
+		s.apply(record, onError)
+	}
+}
+
+// includes reports whether the history row is at or before the point.
+func (s *State) includes(history *service.HistoryRecord, at Point) bool {
+	if history.GetStep().GetNum() > at.Step {
+		return false
+	}
+
+	if !at.Time.IsZero() {
+		if timestamp, ok := historyTimestamp(history); ok && timestamp.After(at.Time) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// applyHistory updates the summary with the latest values in the row.
+func (s *State) applyHistory(
+	history *service.HistoryRecord,
+	onError func(error),
+) {
+	s.Step = history.GetStep().GetNum()
+	if timestamp, ok := historyTimestamp(history); ok {
+		s.Time = timestamp
+	}
+
+	update := &service.SummaryRecord{}
+	for _, item := range history.GetItem() {
+		update.Update = append(update.Update, &service.SummaryItem{
+			Key:       item.GetKey(),
+			NestedKey: item.GetNestedKey(),
+			ValueJson: item.GetValueJson(),
+		})
+	}
+	s.Summary.ApplyChangeRecord(update, onError)
+}
+
+// apply updates the state with a non-history record.
+func (s *State) apply(record *service.Record, onError func(error)) {
+	switch x := record.RecordType.(type) {
+	case *service.Record_Run:
+		s.Run = x.Run
+		if x.Run.GetConfig() != nil {
+			s.applyConfig(x.Run.GetConfig(), onError)
+		}
+		if x.Run.GetSummary() != nil {
+			s.Summary.ApplyChangeRecord(x.Run.GetSummary(), onError)
+		}
+	case *service.Record_Config:
+		s.applyConfig(x.Config, onError)
+	case *service.Record_Summary:
+		s.Summary.ApplyChangeRecord(x.Summary, onError)
+	case *service.Record_Alert:
+		s.Alerts = append(s.Alerts, Alert{AlertRecord: x.Alert, Step: s.Step})
+	}
+}
+
+func (s *State) applyConfig(config *service.ConfigRecord, onError func(error)) {
+	s.Config.ApplyChangeRecord(config, onError)
+	s.ConfigRevisions = append(s.ConfigRevisions,
+		ConfigRevision{ConfigRecord: config, Step: s.Step})
+}
+
+// historyTimestamp returns the "_timestamp" of a history row.
+func historyTimestamp(history *service.HistoryRecord) (time.Time, bool) {
+	for _, item := range history.GetItem() {
+		if item.GetKey() != "_timestamp" {
+			continue
+		}
+
+		var seconds float64
+		if err := json.Unmarshal([]byte(item.GetValueJson()), &seconds); err != nil {
+			return time.Time{}, false
+		}
+
+		whole, frac := math.Modf(seconds)
+		return time.Unix(int64(whole), int64(frac*1e9)), true
+	}
+
+	return time.Time{}, false
+}
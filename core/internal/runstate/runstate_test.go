@@ -0,0 +1,109 @@
+package runstate_test
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/runstate"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+func reader(records ...*service.Record) func() (*service.Record, error) {
+	return func() (*service.Record, error) {
+		if len(records) == 0 {
+			return nil, io.EOF
+		}
+		record := records[0]
+		records = records[1:]
+		return record, nil
+	}
+}
+
+func history(step int64, timestamp string, key, value string) *service.Record {
+	return &service.Record{RecordType: &service.Record_History{
+		History: &service.HistoryRecord{
+			Step: &service.HistoryStep{Num: step},
+			Item: []*service.HistoryItem{
+				{Key: "_step", ValueJson: "0"},
+				{Key: "_timestamp", ValueJson: timestamp},
+				{Key: key, ValueJson: value},
+			},
+		},
+	}}
+}
+
+func config(key, value string) *service.Record {
+	return &service.Record{RecordType: &service.Record_Config{
+		Config: &service.ConfigRecord{
+			Update: []*service.ConfigItem{{Key: key, ValueJson: value}},
+		},
+	}}
+}
+
+func alert(title string) *service.Record {
+	return &service.Record{RecordType: &service.Record_Alert{
+		Alert: &service.AlertRecord{Title: title},
+	}}
+}
+
+func testRecords() []*service.Record {
+	return []*service.Record{
+		config("lr", "0.1"),
+		history(0, "1000", "loss", "3"),
+		history(1, "1010", "loss", "2"),
+		config("lr", "0.01"),
+		alert("loss plateau"),
+		history(2, "1020", "loss", "1"),
+	}
+}
+
+func TestReplay_AtStep(t *testing.T) {
+	state, err := runstate.Replay(
+		reader(testRecords()...),
+		runstate.Point{Step: 1},
+		func(err error) { t.Error(err) },
+	)
+
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, state.Step)
+	assert.Equal(t, 2.0, state.Summary.Tree()["loss"])
+	// The config change after step 1 is replayed since it comes before
+	// the next history row.
+	assert.Equal(t, 0.01, state.Config.Tree()["lr"])
+	assert.Len(t, state.ConfigRevisions, 2)
+	assert.EqualValues(t, -1, state.ConfigRevisions[0].Step)
+	assert.EqualValues(t, 1, state.ConfigRevisions[1].Step)
+	require.Len(t, state.Alerts, 1)
+	assert.Equal(t, "loss plateau", state.Alerts[0].Title)
+}
+
+func TestReplay_AtTime(t *testing.T) {
+	state, err := runstate.Replay(
+		reader(testRecords()...),
+		runstate.Point{Step: runstate.End.Step, Time: time.Unix(1005, 0)},
+		func(err error) { t.Error(err) },
+	)
+
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, state.Step)
+	assert.Equal(t, time.Unix(1000, 0), state.Time)
+	assert.Equal(t, 3.0, state.Summary.Tree()["loss"])
+	assert.Equal(t, 0.1, state.Config.Tree()["lr"])
+	assert.Empty(t, state.Alerts)
+}
+
+func TestReplay_End(t *testing.T) {
+	state, err := runstate.Replay(
+		reader(testRecords()...),
+		runstate.End,
+		func(err error) { t.Error(err) },
+	)
+
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, state.Step)
+	assert.Equal(t, 1.0, state.Summary.Tree()["loss"])
+	assert.Equal(t, 0.01, state.Config.Tree()["lr"])
+}
@@ -0,0 +1,145 @@
+package runstate
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// checkpointInterval is the number of records between checkpoints.
+const checkpointInterval = 1000
+
+// Timeline is a run's records with checkpoints of its state along the way.
+//
+// Finding the state at a point replays records from the last checkpoint
+// before it, rather than from the start of the run, which makes stepping
+// through a long run fast.
+type Timeline struct {
+	records     []*service.Record
+	checkpoints []checkpoint
+	last        *State
+	onError     func(error)
+}
+
+// checkpoint is the state before one of the records.
+type checkpoint struct {
+	// next is the index of the first record not applied to the state.
+	next  int
+	state *State
+
+	// maxStep and maxTime are the largest step and timestamp of the
+	// history rows applied to the state.
+	maxStep int64
+	maxTime time.Time
+}
+
+// NewTimeline reads a run's records and replays them to the end.
+//
+// The next function returns the run's records in order, and [io.EOF]
+// after the last one. Records that cannot be applied are passed to onError
+// and skipped, now and when finding the state at a point. If reading
+// fails, the timeline has the records read so far and an error is returned.
+func NewTimeline(
+	next func() (*service.Record, error),
+	onError func(error),
+) (*Timeline, error) {
+	tl := &Timeline{onError: onError}
+	state := newState()
+	current := checkpoint{state: state, maxStep: math.MinInt64}
+	tl.checkpoints = append(tl.checkpoints, checkpoint{
+		state:   newState(),
+		maxStep: current.maxStep,
+	})
+
+	for {
+		record, err := next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			tl.last = state
+			return tl, fmt.Errorf("runstate: failed to read record: %v", err)
+		}
+
+		if n := len(tl.records); n > 0 && n%checkpointInterval == 0 {
+			tl.addCheckpoint(current, n)
+		}
+		tl.records = append(tl.records, record)
+
+		history := record.GetHistory()
+		if history == nil {
+			state.apply(record, onError)
+			continue
+		}
+
+		state.applyHistory(history, onError)
+		current.maxStep = max(current.maxStep, state.Step)
+		if timestamp, ok := historyTimestamp(history); ok && timestamp.After(current.maxTime) {
+			current.maxTime = timestamp
+		}
+	}
+
+	tl.last = state
+	return tl, nil
+}
+
+// addCheckpoint saves a copy of the current state before the n-th record.
+func (tl *Timeline) addCheckpoint(current checkpoint, n int) {
+	state, err := current.state.clone()
+	if err != nil {
+		// Finding states near here will replay from an earlier checkpoint.
+		tl.onError(err)
+		return
+	}
+
+	current.next = n
+	current.state = state
+	tl.checkpoints = append(tl.checkpoints, current)
+}
+
+// Last returns the state after all records.
+//
+// It is shared and must not be modified.
+func (tl *Timeline) Last() *State {
+	return tl.last
+}
+
+// At returns the state at the given point, as Replay would.
+func (tl *Timeline) At(at Point) (*State, error) {
+	// Checkpoints are in order, and all history rows before one are
+	// included at the point only if its largest step and time are.
+	i := sort.Search(len(tl.checkpoints), func(i int) bool {
+		return !tl.checkpoints[i].includedAt(at)
+	})
+	start := tl.checkpoints[max(i-1, 0)]
+
+	state, err := start.state.clone()
+	if err != nil {
+		return nil, err
+	}
+
+	records := tl.records[start.next:]
+	next := func() (*service.Record, error) {
+		if len(records) == 0 {
+			return nil, io.EOF
+		}
+		record := records[0]
+		records = records[1:]
+		return record, nil
+	}
+	return state, state.replay(next, at, tl.onError)
+}
+
+// includedAt reports whether all history rows before the checkpoint are
+// at or before the point.
+func (c checkpoint) includedAt(at Point) bool {
+	if c.maxStep > at.Step {
+		return false
+	}
+	return at.Time.IsZero() || c.maxTime.IsZero() || !c.maxTime.After(at.Time)
+}
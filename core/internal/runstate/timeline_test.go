@@ -0,0 +1,76 @@
+package runstate_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/runstate"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// longRun returns the records of a run with enough steps to span several
+// of a timeline's checkpoints.
+func longRun() []*service.Record {
+	var records []*service.Record
+	for step := int64(0); step < 2500; step++ {
+		if step%300 == 0 {
+			records = append(records, config("lr", strconv.FormatInt(step, 10)))
+		}
+		records = append(records, history(step,
+			strconv.FormatInt(1000+step, 10),
+			"loss", strconv.FormatInt(step, 10)))
+	}
+	return records
+}
+
+func TestTimeline_AtMatchesReplay(t *testing.T) {
+	onError := func(err error) { t.Error(err) }
+	timeline, err := runstate.NewTimeline(reader(longRun()...), onError)
+	require.NoError(t, err)
+
+	for _, at := range []runstate.Point{
+		{Step: -1},
+		{Step: 0},
+		{Step: 999},
+		{Step: 1000},
+		{Step: 2100},
+		{Step: runstate.End.Step, Time: time.Unix(1000+1500, 0)},
+		runstate.End,
+	} {
+		expected, err := runstate.Replay(reader(longRun()...), at, onError)
+		require.NoError(t, err)
+
+		state, err := timeline.At(at)
+
+		require.NoError(t, err)
+		assert.Equal(t, expected.Step, state.Step, at)
+		assert.Equal(t, expected.Config.Tree(), state.Config.Tree(), at)
+		assert.Equal(t, expected.Summary.Tree(), state.Summary.Tree(), at)
+		assert.Len(t, state.ConfigRevisions, len(expected.ConfigRevisions), at)
+	}
+}
+
+func TestTimeline_AtDoesNotChangeCheckpoints(t *testing.T) {
+	timeline, err := runstate.NewTimeline(reader(longRun()...), func(error) {})
+	require.NoError(t, err)
+
+	_, err = timeline.At(runstate.Point{Step: 1500})
+	require.NoError(t, err)
+	state, err := timeline.At(runstate.Point{Step: 1200})
+
+	require.NoError(t, err)
+	assert.EqualValues(t, 1200, state.Step)
+	assert.EqualValues(t, 1200, state.Config.Tree()["lr"])
+	assert.Len(t, state.ConfigRevisions, 5)
+}
+
+func TestTimeline_Last(t *testing.T) {
+	timeline, err := runstate.NewTimeline(reader(longRun()...), func(error) {})
+
+	require.NoError(t, err)
+	assert.EqualValues(t, 2499, timeline.Last().Step)
+	assert.Len(t, timeline.Last().ConfigRevisions, 9)
+}
@@ -15,10 +15,26 @@ type RunSummary struct {
 	pathTree *pathtree.PathTree
 	stats    *Node
 	mh       *runmetric.MetricHandler
+
+	// skipAggregates is whether to leave min, max and mean summaries to
+	// the server.
+	skipAggregates bool
+
+	// summaryTypes caches the result of GetSummaryTypes for each metric
+	// name, since matching against the defined metrics is expensive for
+	// runs with many keys.
+	summaryTypes map[string][]SummaryType
 }
 
 type Params struct {
 	MetricHandler *runmetric.MetricHandler
+
+	// SkipAggregates is whether to leave min, max and mean summaries to
+	// the server, which computes them from the run's history.
+	//
+	// The summary then only records the latest value of each metric, which
+	// saves the cost of maintaining the statistics.
+	SkipAggregates bool
 }
 
 func New(params Params) *RunSummary {
@@ -27,9 +43,11 @@ func New(params Params) *RunSummary {
 	}
 
 	rs := &RunSummary{
-		pathTree: pathtree.New(),
-		stats:    NewNode(),
-		mh:       params.MetricHandler,
+		pathTree:       pathtree.New(),
+		stats:          NewNode(),
+		mh:             params.MetricHandler,
+		skipAggregates: params.SkipAggregates,
+		summaryTypes:   make(map[string][]SummaryType),
 	}
 	return rs
 }
@@ -50,12 +68,18 @@ func statsTreeFromPathTree(tree pathtree.TreeData) *Node {
 
 func NewFrom(tree pathtree.TreeData) *RunSummary {
 	return &RunSummary{
-		pathTree: pathtree.NewFrom(tree),
-		stats:    statsTreeFromPathTree(tree),
-		mh:       runmetric.NewMetricHandler(),
+		pathTree:     pathtree.NewFrom(tree),
+		stats:        statsTreeFromPathTree(tree),
+		mh:           runmetric.NewMetricHandler(),
+		summaryTypes: make(map[string][]SummaryType),
 	}
 }
 
+// MetricsChanged must be called after the defined metrics change.
+func (rs *RunSummary) MetricsChanged() {
+	clear(rs.summaryTypes)
+}
+
 // GetSummaryTypes matches the path against the defined metrics and returns the
 // requested summary type for the metric.
 //
@@ -67,6 +91,16 @@ func (rs *RunSummary) GetSummaryTypes(path []string) []SummaryType {
 	// see test_metric_full.py::test_metric_dotted for an example
 	name := strings.Join(path, ".")
 
+	if types, ok := rs.summaryTypes[name]; ok {
+		return types
+	}
+	types := rs.matchSummaryTypes(name)
+	rs.summaryTypes[name] = types
+	return types
+}
+
+// matchSummaryTypes implements GetSummaryTypes without the cache.
+func (rs *RunSummary) matchSummaryTypes(name string) []SummaryType {
 	types := make([]SummaryType, 0)
 
 	for pattern, definedMetric := range rs.mh.DefinedMetrics {
@@ -134,13 +168,17 @@ func (rs *RunSummary) ApplyChangeRecord(
 			onError(err)
 			continue
 		}
-		// update all the stats for the given key path
 		path := keyPath(item)
-		err = rs.stats.UpdateStats(path, update)
-		if err != nil {
-			onError(err)
-			continue
+
+		// update all the stats for the given key path
+		if !rs.skipAggregates {
+			err = rs.stats.UpdateStats(path, update)
+			if err != nil {
+				onError(err)
+				continue
+			}
 		}
+
 		// get the summary type for the item
 		summaryTypes := rs.GetSummaryTypes(path)
 
@@ -149,6 +187,14 @@ func (rs *RunSummary) ApplyChangeRecord(
 			continue
 		}
 
+		if rs.skipAggregates {
+			updates = append(updates, &pathtree.PathItem{
+				Path:  path,
+				Value: update,
+			})
+			continue
+		}
+
 		// get the requested stats for the item
 		updateMap := make(map[string]interface{})
 		for summaryType := range summaryTypes {
@@ -1,12 +1,14 @@
 package runsummary_test
 
 import (
+	"math"
 	"reflect"
 	"sort"
 	"strings"
 	"testing"
 
 	"github.com/wandb/wandb/core/internal/pathtree"
+	"github.com/wandb/wandb/core/internal/runmetric"
 	"github.com/wandb/wandb/core/internal/runsummary"
 	"github.com/wandb/wandb/core/pkg/service"
 )
@@ -156,3 +158,65 @@ func TestSerialize(t *testing.T) {
 	}
 
 }
+
+func TestSummaryTypes_UpdatedWhenMetricsChange(t *testing.T) {
+	mh := runmetric.NewMetricHandler()
+	rs := runsummary.New(runsummary.Params{MetricHandler: mh})
+	update := func(value string) {
+		rs.ApplyChangeRecord(
+			&service.SummaryRecord{
+				Update: []*service.SummaryItem{{Key: "loss", ValueJson: value}},
+			},
+			func(err error) { t.Error("onError should not be called", err) },
+		)
+	}
+
+	update("2")
+	mh.DefinedMetrics["loss"] = &service.MetricRecord{
+		Name:    "loss",
+		Summary: &service.MetricSummary{Min: true},
+	}
+	rs.MetricsChanged()
+	update("3")
+
+	expected := pathtree.TreeData{"loss": pathtree.TreeData{"min": float64(2)}}
+	if !reflect.DeepEqual(rs.Tree(), expected) {
+		t.Errorf("Expected %v, got %v", expected, rs.Tree())
+	}
+}
+
+func TestSkipAggregates(t *testing.T) {
+	mh := runmetric.NewMetricHandler()
+	mh.DefinedMetrics["loss"] = &service.MetricRecord{
+		Name:    "loss",
+		Summary: &service.MetricSummary{Min: true, Mean: true},
+	}
+	rs := runsummary.New(runsummary.Params{
+		MetricHandler:  mh,
+		SkipAggregates: true,
+	})
+
+	for _, value := range []string{"2", "3"} {
+		rs.ApplyChangeRecord(
+			&service.SummaryRecord{
+				Update: []*service.SummaryItem{{Key: "loss", ValueJson: value}},
+			},
+			func(err error) { t.Error("onError should not be called", err) },
+		)
+	}
+
+	expected := pathtree.TreeData{"loss": float64(3)}
+	if !reflect.DeepEqual(rs.Tree(), expected) {
+		t.Errorf("Expected %v, got %v", expected, rs.Tree())
+	}
+}
+
+func TestStatsMean_LargeValues(t *testing.T) {
+	stats := &runsummary.Stats{}
+	stats.Update(math.MaxFloat64)
+	stats.Update(math.MaxFloat64)
+
+	if stats.Mean != math.MaxFloat64 {
+		t.Errorf("Expected mean %v, got %v", math.MaxFloat64, stats.Mean)
+	}
+}
@@ -27,7 +27,10 @@ func (s *Stats) Update(newVal float64) {
 	} else {
 		s.Count++
 		s.Sum += newVal
-		s.Mean = s.Sum / float64(s.Count)
+		// Updating the mean incrementally rather than dividing the sum
+		// keeps it finite and accurate when the sum is too large to
+		// represent exactly.
+		s.Mean += (newVal - s.Mean) / float64(s.Count)
 
 		if newVal < s.Min {
 			s.Min = newVal
@@ -0,0 +1,157 @@
+package runview
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/wandb/wandb/core/internal/gql"
+)
+
+// Annotation is a change to a run's notes and tags typed by the user.
+type Annotation struct {
+	// Note is a line to add to the run's notes, or empty.
+	Note string
+
+	// AddTags are tags to add to the run.
+	AddTags []string
+
+	// RemoveTags are tags to remove from the run.
+	RemoveTags []string
+}
+
+// ParseAnnotation parses an annotation typed into the annotation prompt.
+//
+// Words starting with "#" add tags and words starting with "-#" remove
+// them; the rest of the input is a note. For example,
+// "learning rate too high #diverged -#promising" adds a note, adds the tag
+// "diverged" and removes the tag "promising".
+func ParseAnnotation(input string) (Annotation, error) {
+	var annotation Annotation
+	var note []string
+
+	for _, word := range strings.Fields(input) {
+		switch {
+		case strings.HasPrefix(word, "-#") && len(word) > 2:
+			annotation.RemoveTags = append(annotation.RemoveTags, word[2:])
+		case strings.HasPrefix(word, "#") && len(word) > 1:
+			annotation.AddTags = append(annotation.AddTags, word[1:])
+		default:
+			note = append(note, word)
+		}
+	}
+	annotation.Note = strings.Join(note, " ")
+
+	if annotation.Note == "" &&
+		len(annotation.AddTags) == 0 &&
+		len(annotation.RemoveTags) == 0 {
+		return Annotation{}, errors.New("runview: empty annotation")
+	}
+	return annotation, nil
+}
+
+// Annotator updates the notes and tags of a synced run on the server.
+type Annotator struct {
+	client   graphql.Client
+	overview *Overview
+}
+
+// NewAnnotator returns an annotator for the run described by an overview.
+//
+// It fails for runs that aren't on the server, such as offline runs.
+func NewAnnotator(client graphql.Client, overview *Overview) (*Annotator, error) {
+	switch {
+	case client == nil:
+		return nil, errors.New("runview: annotating requires being online")
+	case overview.Entity == "" || overview.Project == "" || overview.RunID == "":
+		return nil, errors.New("runview: the run hasn't been synced")
+	}
+	return &Annotator{client: client, overview: overview}, nil
+}
+
+// Apply updates the run's notes and tags on the server.
+//
+// Notes are appended to the run's current notes on the server, so that
+// notes edited in the UI are kept. On success, the overview's notes and
+// tags are updated to match the server's.
+func (a *Annotator) Apply(ctx context.Context, annotation Annotation) error {
+	notes, tags, err := a.current(ctx)
+	if err != nil {
+		return err
+	}
+
+	if annotation.Note != "" {
+		if notes != "" {
+			notes += "\n"
+		}
+		notes += annotation.Note
+	}
+	for _, tag := range annotation.AddTags {
+		if !slices.Contains(tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+	tags = slices.DeleteFunc(tags, func(tag string) bool {
+		return slices.Contains(annotation.RemoveTags, tag)
+	})
+	if tags == nil {
+		// An empty list clears the tags whereas nil leaves them unchanged.
+		tags = []string{}
+	}
+
+	_, err = gql.UpsertBucket(
+		ctx,
+		a.client,
+		nil, // id
+		&a.overview.RunID,
+		&a.overview.Project,
+		&a.overview.Entity,
+		nil, // groupName
+		nil, // description
+		nil, // displayName
+		&notes,
+		nil, // commit
+		nil, // config
+		nil, // host
+		nil, // debug
+		nil, // program
+		nil, // repo
+		nil, // jobType
+		nil, // state
+		nil, // sweep
+		tags,
+		nil, // summaryMetrics
+	)
+	if err != nil {
+		return fmt.Errorf("runview: failed to update run: %v", err)
+	}
+
+	a.overview.Notes = notes
+	a.overview.Tags = tags
+	return nil
+}
+
+// current returns the run's notes and tags on the server.
+func (a *Annotator) current(ctx context.Context) (string, []string, error) {
+	response, err := gql.RunNotes(ctx, a.client,
+		a.overview.Entity,
+		a.overview.Project,
+		a.overview.RunID,
+	)
+	switch {
+	case err != nil:
+		return "", nil, fmt.Errorf("runview: failed to get run notes: %v", err)
+	case response.Project == nil || response.Project.Run == nil:
+		return "", nil, errors.New("runview: run not found on the server")
+	}
+
+	run := response.Project.Run
+	notes := ""
+	if run.Notes != nil {
+		notes = *run.Notes
+	}
+	return notes, run.Tags, nil
+}
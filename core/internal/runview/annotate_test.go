@@ -0,0 +1,75 @@
+package runview_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/gqlmock"
+	"github.com/wandb/wandb/core/internal/runview"
+)
+
+func TestParseAnnotation(t *testing.T) {
+	annotation, err := runview.ParseAnnotation(
+		"learning rate #diverged too high -#promising")
+
+	require.NoError(t, err)
+	assert.Equal(t, runview.Annotation{
+		Note:       "learning rate too high",
+		AddTags:    []string{"diverged"},
+		RemoveTags: []string{"promising"},
+	}, annotation)
+}
+
+func TestParseAnnotation_Empty(t *testing.T) {
+	_, err := runview.ParseAnnotation("   ")
+
+	assert.Error(t, err)
+}
+
+func TestNewAnnotator_OfflineRun(t *testing.T) {
+	overview := runview.NewOverview()
+	overview.RunID = "run1"
+
+	_, err := runview.NewAnnotator(gqlmock.NewMockClient(), overview)
+
+	assert.ErrorContains(t, err, "hasn't been synced")
+}
+
+func TestAnnotator_Apply(t *testing.T) {
+	client := gqlmock.NewMockClient()
+	client.StubMatchOnce(
+		gqlmock.WithOpName("RunNotes"),
+		`{"project": {"run": {"notes": "baseline", "tags": ["promising", "lr"]}}}`,
+	)
+	client.StubMatchOnce(
+		gqlmock.WithOpName("UpsertBucket"),
+		`{"upsertBucket": {"bucket": {}}}`,
+	)
+	overview := runview.NewOverview()
+	overview.Entity = "entity"
+	overview.Project = "project"
+	overview.RunID = "run1"
+	annotator, err := runview.NewAnnotator(client, overview)
+	require.NoError(t, err)
+
+	err = annotator.Apply(context.Background(), runview.Annotation{
+		Note:       "learning rate too high",
+		AddTags:    []string{"diverged", "lr"},
+		RemoveTags: []string{"promising"},
+	})
+
+	require.NoError(t, err)
+	assert.True(t, client.AllStubsUsed())
+	gqlmock.AssertRequest(t,
+		gqlmock.WithVariables(
+			gqlmock.GQLVar("name", gomock.Eq("run1")),
+			gqlmock.GQLVar("notes", gomock.Eq("baseline\nlearning rate too high")),
+			gqlmock.GQLVar("tags", gomock.Eq([]any{"lr", "diverged"})),
+		),
+		client.AllRequests()[1])
+	assert.Equal(t, "baseline\nlearning rate too high", overview.Notes)
+	assert.Equal(t, []string{"lr", "diverged"}, overview.Tags)
+}
@@ -0,0 +1,222 @@
+package runview
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/wandb/wandb/core/internal/terminalemulator"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+const (
+	// consoleTerminalHeight is the number of recent lines that terminal
+	// escape sequences such as cursor movements can modify.
+	consoleTerminalHeight = 32
+
+	// maxConsoleLineLength is the number of characters kept per line.
+	maxConsoleLineLength = 4096
+)
+
+// ConsoleLine is a line of a run's console output.
+type ConsoleLine struct {
+	Text string
+
+	// Stderr is whether the line was written to stderr.
+	Stderr bool
+
+	// Source is the process that wrote the line, or empty for the
+	// user process.
+	Source string
+}
+
+// ConsoleLog is a run's console output in a scrollable view.
+//
+// Output is passed through a terminal emulator per stream and source, so
+// that progress bars and other lines rewritten using carriage returns or
+// cursor movements show up as they would in a terminal.
+//
+// The view shows Height lines starting at Top. While following, the view
+// stays at the end of the output as lines are added; this is the default.
+type ConsoleLog struct {
+	lines []*consoleLine
+	terms map[consoleKey]*terminalemulator.Terminal
+
+	height    int
+	top       int
+	following bool
+}
+
+type consoleKey struct {
+	stderr bool
+	source string
+}
+
+func NewConsoleLog() *ConsoleLog {
+	return &ConsoleLog{
+		terms:     make(map[consoleKey]*terminalemulator.Terminal),
+		following: true,
+	}
+}
+
+// Add appends console output from a record.
+//
+// Records that are not console output are ignored.
+func (c *ConsoleLog) Add(record *service.Record) {
+	switch x := record.GetRecordType().(type) {
+	case *service.Record_OutputRaw:
+		c.write(
+			consoleKey{
+				stderr: x.OutputRaw.GetOutputType() == service.OutputRawRecord_STDERR,
+				source: x.OutputRaw.GetSource(),
+			},
+			x.OutputRaw.GetLine(),
+		)
+	case *service.Record_Output:
+		c.write(
+			consoleKey{
+				stderr: x.Output.GetOutputType() == service.OutputRecord_STDERR,
+			},
+			x.Output.GetLine(),
+		)
+	}
+}
+
+func (c *ConsoleLog) write(key consoleKey, text string) {
+	term, ok := c.terms[key]
+	if !ok {
+		term = terminalemulator.NewTerminal(
+			&consoleLineSupplier{log: c, key: key},
+			consoleTerminalHeight,
+		)
+		c.terms[key] = term
+	}
+
+	term.Write(text)
+
+	if c.following {
+		c.top = c.maxTop()
+	}
+}
+
+// Len returns the number of lines of output.
+func (c *ConsoleLog) Len() int {
+	return len(c.lines)
+}
+
+// Line returns the i-th line of output.
+func (c *ConsoleLog) Line(i int) ConsoleLine {
+	return c.lines[i].ConsoleLine()
+}
+
+// Search returns the indices of the lines containing the query.
+//
+// The search ignores case unless the query has an uppercase letter.
+func (c *ConsoleLog) Search(query string) []int {
+	if query == "" {
+		return nil
+	}
+
+	fold := !strings.ContainsFunc(query, unicode.IsUpper)
+	if fold {
+		query = strings.ToLower(query)
+	}
+
+	var matches []int
+	for i, line := range c.lines {
+		text := string(line.content.Content)
+		if fold {
+			text = strings.ToLower(text)
+		}
+		if strings.Contains(text, query) {
+			matches = append(matches, i)
+		}
+	}
+	return matches
+}
+
+// SetHeight sets the number of lines in the view.
+func (c *ConsoleLog) SetHeight(height int) {
+	c.height = max(height, 0)
+	c.scrollTo(c.top)
+}
+
+// Scroll moves the view by delta lines, down if positive and up if
+// negative.
+//
+// Following stops when scrolling up and resumes when the view reaches the
+// end of the output.
+func (c *ConsoleLog) Scroll(delta int) {
+	c.scrollTo(c.top + delta)
+}
+
+// ScrollTo moves the view so that it shows the given line, such as a
+// search match, near its middle.
+func (c *ConsoleLog) ScrollTo(line int) {
+	c.scrollTo(line - c.height/2)
+}
+
+// SetFollowing sets whether the view stays at the end of the output.
+func (c *ConsoleLog) SetFollowing(following bool) {
+	c.following = following
+	if following {
+		c.top = c.maxTop()
+	}
+}
+
+// Following returns whether the view stays at the end of the output.
+func (c *ConsoleLog) Following() bool {
+	return c.following
+}
+
+// View returns the index of the first visible line and the visible lines.
+func (c *ConsoleLog) View() (int, []ConsoleLine) {
+	end := min(c.top+c.height, len(c.lines))
+
+	lines := make([]ConsoleLine, 0, end-c.top)
+	for _, line := range c.lines[c.top:end] {
+		lines = append(lines, line.ConsoleLine())
+	}
+	return c.top, lines
+}
+
+func (c *ConsoleLog) scrollTo(top int) {
+	c.top = min(max(top, 0), c.maxTop())
+	c.following = c.top == c.maxTop()
+}
+
+func (c *ConsoleLog) maxTop() int {
+	return max(len(c.lines)-c.height, 0)
+}
+
+// consoleLine is a line in a ConsoleLog modified by a terminal emulator.
+type consoleLine struct {
+	key     consoleKey
+	content terminalemulator.LineContent
+}
+
+func (l *consoleLine) PutChar(c rune, offset int) {
+	l.content.PutChar(c, offset)
+}
+
+func (l *consoleLine) ConsoleLine() ConsoleLine {
+	return ConsoleLine{
+		Text:   string(l.content.Content),
+		Stderr: l.key.stderr,
+		Source: l.key.source,
+	}
+}
+
+// consoleLineSupplier appends lines for one stream to a ConsoleLog.
+type consoleLineSupplier struct {
+	log *ConsoleLog
+	key consoleKey
+}
+
+func (s *consoleLineSupplier) NextLine() terminalemulator.Line {
+	line := &consoleLine{
+		key:     s.key,
+		content: terminalemulator.LineContent{MaxLength: maxConsoleLineLength},
+	}
+	s.log.lines = append(s.log.lines, line)
+	return line
+}
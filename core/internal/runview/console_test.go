@@ -0,0 +1,183 @@
+package runview_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/runview"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+func outputRecord(
+	outputType service.OutputRawRecord_OutputType,
+	line string,
+) *service.Record {
+	return &service.Record{
+		RecordType: &service.Record_OutputRaw{
+			OutputRaw: &service.OutputRawRecord{
+				OutputType: outputType,
+				Line:       line,
+			},
+		},
+	}
+}
+
+func stdout(line string) *service.Record {
+	return outputRecord(service.OutputRawRecord_STDOUT, line)
+}
+
+func consoleText(log *runview.ConsoleLog) []string {
+	var lines []string
+	for i := 0; i < log.Len(); i++ {
+		lines = append(lines, log.Line(i).Text)
+	}
+	return lines
+}
+
+func TestConsoleLog_CarriageReturnRewritesLine(t *testing.T) {
+	log := runview.NewConsoleLog()
+
+	log.Add(stdout("epoch 1\n"))
+	log.Add(stdout("progress 10%\rprogress 50%"))
+	log.Add(stdout("\rprogress 100%\n"))
+	log.Add(outputRecord(service.OutputRawRecord_STDERR, "warning\n"))
+
+	assert.Equal(t,
+		[]string{"epoch 1", "progress 100%", "warning"},
+		consoleText(log))
+	assert.False(t, log.Line(1).Stderr)
+	assert.True(t, log.Line(2).Stderr)
+}
+
+func TestConsoleLog_Search(t *testing.T) {
+	log := runview.NewConsoleLog()
+	log.Add(stdout("Loss: 0.5\nstep 2\nloss: 0.3\n"))
+
+	assert.Equal(t, []int{0, 2}, log.Search("loss"))
+	assert.Equal(t, []int{0}, log.Search("Loss"))
+	assert.Empty(t, log.Search("accuracy"))
+	assert.Empty(t, log.Search(""))
+}
+
+func TestConsoleLog_FollowsUntilScrolledUp(t *testing.T) {
+	log := runview.NewConsoleLog()
+	log.SetHeight(2)
+	for i := 0; i < 5; i++ {
+		log.Add(stdout(fmt.Sprintf("line %d\n", i)))
+	}
+
+	top, lines := log.View()
+	assert.Equal(t, 3, top)
+	assert.Equal(t, "line 4", lines[1].Text)
+	assert.True(t, log.Following())
+
+	log.Scroll(-2)
+	log.Add(stdout("line 5\n"))
+
+	top, _ = log.View()
+	assert.Equal(t, 1, top)
+	assert.False(t, log.Following())
+
+	log.Scroll(100)
+
+	top, _ = log.View()
+	assert.Equal(t, 4, top)
+	assert.True(t, log.Following())
+}
+
+func TestConsoleLog_ScrollToCentersLine(t *testing.T) {
+	log := runview.NewConsoleLog()
+	log.SetHeight(4)
+	for i := 0; i < 20; i++ {
+		log.Add(stdout(fmt.Sprintf("line %d\n", i)))
+	}
+
+	log.ScrollTo(10)
+	top, lines := log.View()
+
+	assert.Equal(t, 8, top)
+	assert.Len(t, lines, 4)
+	assert.False(t, log.Following())
+}
+
+func TestFollowFile_ReadsAppendedRecords(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "run.wandb")
+
+	// Write the complete file elsewhere, then expose it in two parts,
+	// splitting the second record.
+	complete := filepath.Join(dir, "complete.wandb")
+	store := server.NewStore(context.Background(), complete)
+	require.NoError(t, store.Open(os.O_WRONLY))
+	require.NoError(t, store.Write(stdout("first\n")))
+	require.NoError(t, store.Write(stdout("second\n")))
+	require.NoError(t, store.Close())
+	data, err := os.ReadFile(complete)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, data[:len(data)-4], 0600))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	records := make(chan *service.Record, 2)
+	done := make(chan error)
+	go func() {
+		done <- runview.FollowFile(ctx, path, time.Millisecond,
+			func(record *service.Record) { records <- record })
+	}()
+
+	assert.Equal(t, "first\n", (<-records).GetOutputRaw().GetLine())
+	require.NoError(t, os.WriteFile(path, data, 0600))
+	assert.Equal(t, "second\n", (<-records).GetOutputRaw().GetLine())
+	cancel()
+	assert.NoError(t, <-done)
+}
+
+func TestFollowRun_ReportsLiveUntilExit(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "run.wandb")
+
+	// Expose a complete file with its exit record cut short first.
+	complete := filepath.Join(dir, "complete.wandb")
+	store := server.NewStore(context.Background(), complete)
+	require.NoError(t, store.Open(os.O_WRONLY))
+	require.NoError(t, store.Write(stdout("training\n")))
+	require.NoError(t, store.Write(&service.Record{
+		RecordType: &service.Record_Exit{
+			Exit: &service.RunExitRecord{ExitCode: 1, Runtime: 10},
+		},
+	}))
+	require.NoError(t, store.Close())
+	data, err := os.ReadFile(complete)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, data[:len(data)-4], 0600))
+
+	records := make(chan *service.Record, 2)
+	statuses := make(chan runview.FollowStatus, 2)
+	done := make(chan error)
+	go func() {
+		// The interval is long so that only change notifications can
+		// pick up the rest of the file in time.
+		done <- runview.FollowRun(context.Background(), path, time.Hour,
+			func(record *service.Record) { records <- record },
+			func(status runview.FollowStatus) { statuses <- status })
+	}()
+
+	assert.Equal(t, "training\n", (<-records).GetOutputRaw().GetLine())
+	assert.Equal(t, runview.FollowLive, <-statuses)
+
+	require.NoError(t, os.WriteFile(path, data, 0600))
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("FollowRun didn't return after the exit record")
+	}
+	assert.EqualValues(t, 1, (<-records).GetExit().GetExitCode())
+	assert.Equal(t, runview.FollowComplete, <-statuses)
+}
@@ -0,0 +1,99 @@
+package runview
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// Nearest returns the index of the point whose X is closest to x.
+//
+// Ties go to the earlier point. The second return value is false if the
+// series is empty.
+func (s *Series) Nearest(x float64) (int, bool) {
+	n := len(s.Points)
+	if n == 0 {
+		return 0, false
+	}
+
+	i := sort.Search(n, func(i int) bool { return s.Points[i].X >= x })
+	switch {
+	case i == 0:
+		return 0, true
+	case i == n:
+		return n - 1, true
+	case x-s.Points[i-1].X <= s.Points[i].X-x:
+		return i - 1, true
+	default:
+		return i, true
+	}
+}
+
+// XAtColumn returns the X value displayed at a column of a chart that is
+// width columns wide and shows X values in [minX, maxX].
+//
+// It maps mouse positions to data for a Crosshair.
+func XAtColumn(col, width int, minX, maxX float64) float64 {
+	if width <= 1 {
+		return minX
+	}
+
+	col = min(max(col, 0), width-1)
+	return minX + (maxX-minX)*float64(col)/float64(width-1)
+}
+
+// Crosshair selects a point of a series to show its exact value.
+//
+// It follows the mouse with HoverAt or is moved between points with Move,
+// such as when scrubbing with arrow keys.
+type Crosshair struct {
+	series *Series
+	index  int
+}
+
+// NewCrosshair returns a crosshair on the last point of the series.
+func NewCrosshair(series *Series) *Crosshair {
+	return &Crosshair{
+		series: series,
+		index:  len(series.Points) - 1,
+	}
+}
+
+// HoverAt moves the crosshair to the point nearest to x.
+func (c *Crosshair) HoverAt(x float64) {
+	if i, ok := c.series.Nearest(x); ok {
+		c.index = i
+	}
+}
+
+// Move moves the crosshair by delta points, stopping at either end.
+func (c *Crosshair) Move(delta int) {
+	c.index = min(max(c.index+delta, 0), len(c.series.Points)-1)
+}
+
+// Point returns the selected point.
+//
+// The second return value is false if the series is empty.
+func (c *Crosshair) Point() (Point, bool) {
+	if c.index < 0 || c.index >= len(c.series.Points) {
+		return Point{}, false
+	}
+	return c.series.Points[c.index], true
+}
+
+// Label describes the selected point for a chart title or status line,
+// e.g. "step 120: 0.4312".
+//
+// xName is the name of the x-axis. The label is empty if the series is
+// empty.
+func (c *Crosshair) Label(xName string) string {
+	p, ok := c.Point()
+	if !ok {
+		return ""
+	}
+
+	return fmt.Sprintf("%s %s: %s",
+		xName,
+		strconv.FormatFloat(p.X, 'f', -1, 64),
+		strconv.FormatFloat(p.Y, 'g', 6, 64))
+}
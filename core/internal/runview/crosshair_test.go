@@ -0,0 +1,67 @@
+package runview_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wandb/wandb/core/internal/runview"
+)
+
+func TestSeries_Nearest(t *testing.T) {
+	series := &runview.Series{}
+	_, ok := series.Nearest(1)
+	assert.False(t, ok)
+
+	series.Add(0, 1)
+	series.Add(10, 2)
+	series.Add(20, 3)
+
+	for x, expected := range map[float64]int{
+		-5: 0,
+		4:  0,
+		5:  0,
+		6:  1,
+		17: 2,
+		99: 2,
+	} {
+		i, ok := series.Nearest(x)
+		assert.True(t, ok)
+		assert.Equal(t, expected, i, x)
+	}
+}
+
+func TestXAtColumn(t *testing.T) {
+	assert.Equal(t, 0.0, runview.XAtColumn(0, 11, 0, 100))
+	assert.Equal(t, 50.0, runview.XAtColumn(5, 11, 0, 100))
+	assert.Equal(t, 100.0, runview.XAtColumn(20, 11, 0, 100))
+	assert.Equal(t, 3.0, runview.XAtColumn(5, 1, 3, 7))
+}
+
+func TestCrosshair(t *testing.T) {
+	series := &runview.Series{}
+	series.Add(100, 0.5)
+	series.Add(200, 0.25)
+	series.Add(300, 0.125)
+	crosshair := runview.NewCrosshair(series)
+
+	assert.Equal(t, "step 300: 0.125", crosshair.Label("step"))
+
+	crosshair.Move(-5)
+	assert.Equal(t, "step 100: 0.5", crosshair.Label("step"))
+
+	crosshair.HoverAt(190)
+	point, ok := crosshair.Point()
+	assert.True(t, ok)
+	assert.Equal(t, runview.Point{X: 200, Y: 0.25}, point)
+}
+
+func TestCrosshair_EmptySeries(t *testing.T) {
+	crosshair := runview.NewCrosshair(&runview.Series{})
+
+	crosshair.Move(1)
+	crosshair.HoverAt(1)
+
+	_, ok := crosshair.Point()
+	assert.False(t, ok)
+	assert.Empty(t, crosshair.Label("step"))
+}
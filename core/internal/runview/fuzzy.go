@@ -0,0 +1,78 @@
+package runview
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// FuzzyFilter returns the entries whose keys fuzzily match the query,
+// best matches first.
+//
+// A key matches if it contains the characters of the query in order,
+// ignoring case. Matches are ranked higher when the characters are
+// consecutive or start words of the key. An empty query matches all
+// entries in their original order.
+func FuzzyFilter(entries []KeyValue, query string) []KeyValue {
+	if query == "" {
+		return entries
+	}
+
+	type scored struct {
+		entry KeyValue
+		score int
+	}
+
+	var matches []scored
+	for _, entry := range entries {
+		if score, ok := fuzzyScore(entry.Key, query); ok {
+			matches = append(matches, scored{entry, score})
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].score > matches[j].score
+	})
+
+	result := make([]KeyValue, len(matches))
+	for i, match := range matches {
+		result[i] = match.entry
+	}
+	return result
+}
+
+// fuzzyScore scores how well the key matches the query.
+//
+// The second return value is false if the key doesn't match.
+func fuzzyScore(key, query string) (int, bool) {
+	keyRunes := []rune(strings.ToLower(key))
+	queryRunes := []rune(strings.ToLower(query))
+
+	score := 0
+	last := -2
+	qi := 0
+	for ki := 0; ki < len(keyRunes) && qi < len(queryRunes); ki++ {
+		if keyRunes[ki] != queryRunes[qi] {
+			continue
+		}
+
+		score++
+		if ki == last+1 {
+			score += 2
+		}
+		if ki == 0 || !unicode.IsLetter(keyRunes[ki-1]) &&
+			!unicode.IsDigit(keyRunes[ki-1]) {
+			score += 3
+		}
+
+		last = ki
+		qi++
+	}
+
+	if qi < len(queryRunes) {
+		return 0, false
+	}
+
+	// Prefer shorter keys among otherwise equal matches.
+	return score*100 - len(keyRunes), true
+}
@@ -0,0 +1,173 @@
+package runview
+
+import (
+	"encoding/json"
+	"math"
+	"strings"
+)
+
+// Histogram is a distribution logged with wandb.Histogram.
+type Histogram struct {
+	// Bins are the edges of the bins, in increasing order. There is one
+	// more edge than there are counts.
+	Bins []float64
+
+	// Counts are the number of values in each bin.
+	Counts []float64
+}
+
+// histogramJSON is how wandb.Histogram is serialized in history.
+//
+// The bins are either listed explicitly or, to save space, packed as a
+// number of equal-width bins.
+type histogramJSON struct {
+	Type       string    `json:"_type"`
+	Values     []float64 `json:"values"`
+	Bins       []float64 `json:"bins"`
+	PackedBins *struct {
+		Min   float64 `json:"min"`
+		Size  float64 `json:"size"`
+		Count int     `json:"count"`
+	} `json:"packedBins"`
+}
+
+// ParseHistogram parses a history value logged with wandb.Histogram.
+//
+// The second return value is false if the value isn't a valid histogram.
+func ParseHistogram(valueJSON string) (Histogram, bool) {
+	var data histogramJSON
+	if err := json.Unmarshal([]byte(valueJSON), &data); err != nil {
+		return Histogram{}, false
+	}
+	if data.Type != "histogram" || len(data.Values) == 0 {
+		return Histogram{}, false
+	}
+
+	bins := data.Bins
+	if packed := data.PackedBins; packed != nil {
+		bins = make([]float64, packed.Count+1)
+		for i := range bins {
+			bins[i] = packed.Min + float64(i)*packed.Size
+		}
+	}
+	if len(bins) != len(data.Values)+1 {
+		return Histogram{}, false
+	}
+
+	return Histogram{Bins: bins, Counts: data.Values}, true
+}
+
+// HistogramSeries is the histograms of a metric ordered by step.
+type HistogramSeries struct {
+	Name       string
+	Steps      []float64
+	Histograms []Histogram
+}
+
+func (s *HistogramSeries) add(step float64, histogram Histogram) {
+	s.Steps = append(s.Steps, step)
+	s.Histograms = append(s.Histograms, histogram)
+}
+
+// barEighths draws the top of a bar in eighths of a character cell.
+var barEighths = []rune(" ▁▂▃▄▅▆▇█")
+
+// BarChart draws the histogram as vertical bars, height lines tall and
+// at most width characters wide.
+//
+// Adjacent bins are merged if there are more bins than columns.
+func (h Histogram) BarChart(width, height int) []string {
+	if width <= 0 || height <= 0 || len(h.Counts) == 0 {
+		return nil
+	}
+
+	columns := rebin(h.Counts, min(width, len(h.Counts)))
+	maxCount := 0.0
+	for _, count := range columns {
+		maxCount = max(maxCount, count)
+	}
+
+	lines := make([]string, height)
+	for row := range lines {
+		// The number of eighths below this line, counting from the bottom.
+		floor := (height - 1 - row) * 8
+
+		var line strings.Builder
+		for _, count := range columns {
+			eighths := 0
+			if maxCount > 0 {
+				eighths = int(math.Round(count / maxCount * float64(height*8)))
+			}
+			line.WriteRune(barEighths[min(8, max(0, eighths-floor))])
+		}
+		lines[row] = line.String()
+	}
+	return lines
+}
+
+// rebin merges counts into n bins by summing adjacent counts.
+func rebin(counts []float64, n int) []float64 {
+	merged := make([]float64, n)
+	for i, count := range counts {
+		merged[i*n/len(counts)] += count
+	}
+	return merged
+}
+
+// heatmapShades shade the cells of a heatmap from empty to fullest.
+var heatmapShades = []rune(" ░▒▓█")
+
+// Heatmap draws how the distribution changes over time.
+//
+// Each column is a step and each line a range of values, largest at the
+// top, shaded by the fraction of that step's values in the range. If
+// there are more steps than columns, evenly spaced steps are drawn.
+func (s *HistogramSeries) Heatmap(width, height int) []string {
+	if width <= 0 || height <= 0 || len(s.Histograms) == 0 {
+		return nil
+	}
+
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for _, histogram := range s.Histograms {
+		lo = min(lo, histogram.Bins[0])
+		hi = max(hi, histogram.Bins[len(histogram.Bins)-1])
+	}
+
+	columns := min(width, len(s.Histograms))
+	cells := make([][]rune, height)
+	for row := range cells {
+		cells[row] = make([]rune, columns)
+	}
+
+	for col := 0; col < columns; col++ {
+		histogram := s.Histograms[col*len(s.Histograms)/columns]
+
+		rows := make([]float64, height)
+		for i, count := range histogram.Counts {
+			mid := (histogram.Bins[i] + histogram.Bins[i+1]) / 2
+			row := 0
+			if hi > lo {
+				row = int((mid - lo) / (hi - lo) * float64(height))
+			}
+			rows[min(row, height-1)] += count
+		}
+
+		maxCount := 0.0
+		for _, count := range rows {
+			maxCount = max(maxCount, count)
+		}
+		for row, count := range rows {
+			shade := 0
+			if maxCount > 0 {
+				shade = int(math.Ceil(count / maxCount * float64(len(heatmapShades)-1)))
+			}
+			cells[height-1-row][col] = heatmapShades[shade]
+		}
+	}
+
+	lines := make([]string, height)
+	for row, line := range cells {
+		lines[row] = string(line)
+	}
+	return lines
+}
@@ -0,0 +1,93 @@
+package runview_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wandb/wandb/core/internal/runview"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+func TestParseHistogram(t *testing.T) {
+	explicit, ok := runview.ParseHistogram(
+		`{"_type": "histogram", "values": [1, 2], "bins": [0, 0.5, 1]}`)
+	assert.True(t, ok)
+	assert.Equal(t, []float64{0, 0.5, 1}, explicit.Bins)
+	assert.Equal(t, []float64{1, 2}, explicit.Counts)
+
+	packed, ok := runview.ParseHistogram(
+		`{"_type": "histogram", "values": [1, 2],
+		  "packedBins": {"min": 0, "size": 0.5, "count": 2}}`)
+	assert.True(t, ok)
+	assert.Equal(t, explicit, packed)
+}
+
+func TestParseHistogram_Invalid(t *testing.T) {
+	for _, valueJSON := range []string{
+		`{"_type": "image-file"}`,
+		`{"_type": "histogram", "values": [1, 2], "bins": [0, 1]}`,
+		`[1, 2, 3]`,
+	} {
+		_, ok := runview.ParseHistogram(valueJSON)
+		assert.False(t, ok, valueJSON)
+	}
+}
+
+func TestHistory_Histograms(t *testing.T) {
+	history := runview.NewHistory()
+
+	for step := int64(0); step < 2; step++ {
+		history.Add(&service.HistoryRecord{
+			Step: &service.HistoryStep{Num: step},
+			Item: []*service.HistoryItem{
+				{Key: "loss", ValueJson: "0.5"},
+				{Key: "weights", ValueJson: `{"_type": "histogram", "values": [1], "bins": [0, 1]}`},
+			},
+		})
+	}
+
+	assert.Equal(t, []string{"loss"}, history.Metrics())
+	assert.Equal(t, []string{"weights"}, history.HistogramMetrics())
+	assert.Equal(t, []float64{0, 1}, history.Histograms("weights").Steps)
+}
+
+func TestHistogram_BarChart(t *testing.T) {
+	histogram := runview.Histogram{
+		Bins:   []float64{0, 1, 2, 3, 4},
+		Counts: []float64{1, 4, 2, 0},
+	}
+
+	assert.Equal(t,
+		[]string{
+			" █  ",
+			" █▄ ",
+			"▆██ ",
+		},
+		histogram.BarChart(10, 3))
+}
+
+func TestHistogram_BarChart_MergesBins(t *testing.T) {
+	histogram := runview.Histogram{
+		Bins:   []float64{0, 1, 2, 3, 4},
+		Counts: []float64{1, 1, 0, 0},
+	}
+
+	assert.Equal(t, []string{"█ "}, histogram.BarChart(2, 1))
+}
+
+func TestHistogramSeries_Heatmap(t *testing.T) {
+	series := &runview.HistogramSeries{
+		Steps: []float64{0, 1},
+		Histograms: []runview.Histogram{
+			{Bins: []float64{0, 1, 2}, Counts: []float64{4, 0}},
+			{Bins: []float64{0, 1, 2}, Counts: []float64{1, 4}},
+		},
+	}
+
+	assert.Equal(t,
+		[]string{
+			" █",
+			"█░",
+		},
+		series.Heatmap(10, 2))
+}
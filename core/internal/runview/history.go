@@ -0,0 +1,99 @@
+package runview
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// History collects the metrics in a run's history records.
+//
+// Each metric is a series whose X is the step. Internal metrics such as
+// "_runtime" are skipped.
+type History struct {
+	series map[string]*Series
+
+	// metrics are the names of the metrics in the order they were first
+	// logged.
+	metrics []string
+
+	histograms map[string]*HistogramSeries
+
+	// histogramMetrics are the names of the metrics logged as histograms
+	// in the order they were first logged.
+	histogramMetrics []string
+}
+
+func NewHistory() *History {
+	return &History{
+		series:     make(map[string]*Series),
+		histograms: make(map[string]*HistogramSeries),
+	}
+}
+
+// Add records the values in a history record.
+//
+// Histograms are collected separately from numbers, and other values are
+// ignored. Nested keys are joined by ".".
+func (h *History) Add(record *service.HistoryRecord) {
+	step := float64(record.GetStep().GetNum())
+
+	for _, item := range record.GetItem() {
+		key := item.GetKey()
+		if len(item.GetNestedKey()) > 0 {
+			key = strings.Join(item.GetNestedKey(), ".")
+		}
+		if strings.HasPrefix(key, "_") {
+			continue
+		}
+
+		value, err := strconv.ParseFloat(item.GetValueJson(), 64)
+		if err != nil {
+			if histogram, ok := ParseHistogram(item.GetValueJson()); ok {
+				h.addHistogram(key, step, histogram)
+			}
+			continue
+		}
+
+		series, ok := h.series[key]
+		if !ok {
+			series = &Series{Name: key}
+			h.series[key] = series
+			h.metrics = append(h.metrics, key)
+		}
+		series.Add(step, value)
+	}
+}
+
+// Series returns the series of a metric, or nil if it has no values.
+func (h *History) Series(metric string) *Series {
+	return h.series[metric]
+}
+
+// Metrics returns the names of the metrics in the order they were first
+// logged.
+func (h *History) Metrics() []string {
+	return h.metrics
+}
+
+func (h *History) addHistogram(key string, step float64, histogram Histogram) {
+	series, ok := h.histograms[key]
+	if !ok {
+		series = &HistogramSeries{Name: key}
+		h.histograms[key] = series
+		h.histogramMetrics = append(h.histogramMetrics, key)
+	}
+	series.add(step, histogram)
+}
+
+// Histograms returns the histograms of a metric, or nil if it has none.
+func (h *History) Histograms(metric string) *HistogramSeries {
+	return h.histograms[metric]
+}
+
+// HistogramMetrics returns the names of the metrics logged as histograms
+// in the order they were first logged.
+func (h *History) HistogramMetrics() []string {
+	return h.histogramMetrics
+}
@@ -0,0 +1,66 @@
+package runview_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/runview"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+func TestHistory(t *testing.T) {
+	history := runview.NewHistory()
+
+	history.Add(&service.HistoryRecord{
+		Step: &service.HistoryStep{Num: 3},
+		Item: []*service.HistoryItem{
+			{Key: "_runtime", ValueJson: "1.5"},
+			{Key: "loss", ValueJson: "0.5"},
+			{NestedKey: []string{"eval", "acc"}, ValueJson: "0.9"},
+			{Key: "image", ValueJson: `{"_type": "image-file"}`},
+		},
+	})
+
+	assert.Equal(t, []string{"loss", "eval.acc"}, history.Metrics())
+	assert.Equal(t,
+		[]runview.Point{{X: 3, Y: 0.5}},
+		history.Series("loss").Points)
+}
+
+func TestReadFileFromStep(t *testing.T) {
+	for _, indexed := range []bool{false, true} {
+		path := filepath.Join(t.TempDir(), "run.wandb")
+		store := server.NewStore(context.Background(), path)
+		if indexed {
+			store.EnableIndex()
+		}
+		require.NoError(t, store.Open(os.O_WRONLY))
+		for step := int64(0); step < 10; step++ {
+			require.NoError(t, store.Write(&service.Record{
+				RecordType: &service.Record_History{
+					History: &service.HistoryRecord{
+						Step: &service.HistoryStep{Num: step},
+						Item: []*service.HistoryItem{{Key: "loss", ValueJson: "1"}},
+					},
+				},
+			}))
+		}
+		require.NoError(t, store.Close())
+		history := runview.NewHistory()
+
+		err := runview.ReadFileFromStep(path, 7, func(record *service.Record) {
+			history.Add(record.GetHistory())
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t,
+			[]runview.Point{{X: 7, Y: 1}, {X: 8, Y: 1}, {X: 9, Y: 1}},
+			history.Series("loss").Points,
+			"indexed: %v", indexed)
+	}
+}
@@ -0,0 +1,254 @@
+package runview
+
+import (
+	"bufio"
+	"fmt"
+	"html"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"strconv"
+)
+
+// ImageOptions configures how charts are rendered to an image.
+type ImageOptions struct {
+	// ChartWidth and ChartHeight are the size of each chart in pixels.
+	ChartWidth, ChartHeight int
+
+	// Columns is the number of charts per row of the grid.
+	Columns int
+}
+
+// DefaultImageOptions returns the options used for chart snapshots.
+func DefaultImageOptions() ImageOptions {
+	return ImageOptions{
+		ChartWidth:  480,
+		ChartHeight: 300,
+		Columns:     2,
+	}
+}
+
+const (
+	// chartMarginLeft leaves room for Y axis labels.
+	chartMarginLeft = 64
+	// chartMarginTop leaves room for the title.
+	chartMarginTop = 28
+	// chartMarginRight and chartMarginBottom leave room for X axis labels.
+	chartMarginRight  = 16
+	chartMarginBottom = 24
+)
+
+var (
+	backgroundColor = color.RGBA{0xff, 0xff, 0xff, 0xff}
+	frameColor      = color.RGBA{0xc8, 0xc8, 0xc8, 0xff}
+	lineColor       = color.RGBA{0x1f, 0x77, 0xb4, 0xff}
+)
+
+// chartFrame maps a series onto the plot area of a chart in a grid.
+type chartFrame struct {
+	// left, top, right and bottom are the pixel bounds of the plot area.
+	left, top, right, bottom int
+
+	minX, maxX, minY, maxY float64
+}
+
+func newChartFrame(index int, series *Series, opts ImageOptions) chartFrame {
+	x0 := (index % opts.Columns) * opts.ChartWidth
+	y0 := (index / opts.Columns) * opts.ChartHeight
+
+	frame := chartFrame{
+		left:   x0 + chartMarginLeft,
+		top:    y0 + chartMarginTop,
+		right:  x0 + opts.ChartWidth - chartMarginRight,
+		bottom: y0 + opts.ChartHeight - chartMarginBottom,
+	}
+
+	frame.minX, frame.maxX, frame.minY, frame.maxY, _ = series.Bounds()
+	if frame.minX == frame.maxX {
+		frame.minX -= 0.5
+		frame.maxX += 0.5
+	}
+	if frame.minY == frame.maxY {
+		frame.minY -= 0.5
+		frame.maxY += 0.5
+	}
+
+	return frame
+}
+
+// project returns the pixel position of a point.
+func (f chartFrame) project(p Point) (int, int) {
+	x := float64(f.left) +
+		(p.X-f.minX)/(f.maxX-f.minX)*float64(f.right-f.left)
+	y := float64(f.bottom) -
+		(p.Y-f.minY)/(f.maxY-f.minY)*float64(f.bottom-f.top)
+	return int(x + 0.5), int(y + 0.5)
+}
+
+func gridSize(n int, opts ImageOptions) (int, int) {
+	rows := (n + opts.Columns - 1) / opts.Columns
+	return min(n, opts.Columns) * opts.ChartWidth, rows * opts.ChartHeight
+}
+
+func validateImageOptions(opts ImageOptions) error {
+	if opts.ChartWidth <= chartMarginLeft+chartMarginRight ||
+		opts.ChartHeight <= chartMarginTop+chartMarginBottom {
+		return fmt.Errorf(
+			"runview: chart size %dx%d is too small",
+			opts.ChartWidth, opts.ChartHeight)
+	}
+	if opts.Columns <= 0 {
+		return fmt.Errorf("runview: invalid number of columns: %d", opts.Columns)
+	}
+	return nil
+}
+
+// WriteSVG renders a grid of line charts, one per series, as an SVG image.
+//
+// Each chart is titled by its series name and labeled with its bounds.
+func WriteSVG(w io.Writer, charts []*Series, opts ImageOptions) error {
+	if err := validateImageOptions(opts); err != nil {
+		return err
+	}
+
+	width, height := gridSize(len(charts), opts)
+	out := bufio.NewWriter(w)
+
+	fmt.Fprintf(out,
+		`<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d"`+
+			` font-family="sans-serif" font-size="12">`+"\n",
+		width, height)
+	fmt.Fprintf(out,
+		`<rect width="100%%" height="100%%" fill="%s"/>`+"\n",
+		svgColor(backgroundColor))
+
+	for i, series := range charts {
+		frame := newChartFrame(i, series, opts)
+
+		fmt.Fprintf(out,
+			`<text x="%d" y="%d" font-size="14">%s</text>`+"\n",
+			frame.left, frame.top-10, html.EscapeString(series.Name))
+		fmt.Fprintf(out,
+			`<rect x="%d" y="%d" width="%d" height="%d" fill="none" stroke="%s"/>`+"\n",
+			frame.left, frame.top,
+			frame.right-frame.left, frame.bottom-frame.top,
+			svgColor(frameColor))
+		fmt.Fprintf(out,
+			`<text x="%d" y="%d" text-anchor="end">%s</text>`+"\n",
+			frame.left-4, frame.top+12, formatAxisValue(frame.maxY))
+		fmt.Fprintf(out,
+			`<text x="%d" y="%d" text-anchor="end">%s</text>`+"\n",
+			frame.left-4, frame.bottom, formatAxisValue(frame.minY))
+		fmt.Fprintf(out,
+			`<text x="%d" y="%d">%s</text>`+"\n",
+			frame.left, frame.bottom+16, formatAxisValue(frame.minX))
+		fmt.Fprintf(out,
+			`<text x="%d" y="%d" text-anchor="end">%s</text>`+"\n",
+			frame.right, frame.bottom+16, formatAxisValue(frame.maxX))
+
+		fmt.Fprintf(out,
+			`<polyline fill="none" stroke="%s" stroke-width="1.5" points="`,
+			svgColor(lineColor))
+		for j, p := range series.Points {
+			x, y := frame.project(p)
+			if j > 0 {
+				out.WriteByte(' ')
+			}
+			fmt.Fprintf(out, "%d,%d", x, y)
+		}
+		out.WriteString("\"/>\n")
+	}
+
+	out.WriteString("</svg>\n")
+	return out.Flush()
+}
+
+// WritePNG renders a grid of line charts, one per series, as a PNG image.
+//
+// Unlike WriteSVG, the charts have no text since no fonts are available
+// to draw it.
+func WritePNG(w io.Writer, charts []*Series, opts ImageOptions) error {
+	if err := validateImageOptions(opts); err != nil {
+		return err
+	}
+
+	width, height := gridSize(len(charts), opts)
+	img := image.NewRGBA(image.Rect(0, 0, max(width, 1), max(height, 1)))
+	fillRect(img, img.Bounds(), backgroundColor)
+
+	for i, series := range charts {
+		frame := newChartFrame(i, series, opts)
+
+		drawLine(img, frame.left, frame.top, frame.right, frame.top, frameColor)
+		drawLine(img, frame.right, frame.top, frame.right, frame.bottom, frameColor)
+		drawLine(img, frame.right, frame.bottom, frame.left, frame.bottom, frameColor)
+		drawLine(img, frame.left, frame.bottom, frame.left, frame.top, frameColor)
+
+		for j := 1; j < len(series.Points); j++ {
+			x0, y0 := frame.project(series.Points[j-1])
+			x1, y1 := frame.project(series.Points[j])
+			drawLine(img, x0, y0, x1, y1, lineColor)
+		}
+		if len(series.Points) == 1 {
+			x, y := frame.project(series.Points[0])
+			fillRect(img, image.Rect(x-1, y-1, x+2, y+2), lineColor)
+		}
+	}
+
+	return png.Encode(w, img)
+}
+
+func fillRect(img *image.RGBA, rect image.Rectangle, c color.RGBA) {
+	rect = rect.Intersect(img.Bounds())
+	for y := rect.Min.Y; y < rect.Max.Y; y++ {
+		for x := rect.Min.X; x < rect.Max.X; x++ {
+			img.SetRGBA(x, y, c)
+		}
+	}
+}
+
+// drawLine draws a line using Bresenham's algorithm.
+func drawLine(img *image.RGBA, x0, y0, x1, y1 int, c color.RGBA) {
+	dx := abs(x1 - x0)
+	dy := -abs(y1 - y0)
+	sx, sy := 1, 1
+	if x0 > x1 {
+		sx = -1
+	}
+	if y0 > y1 {
+		sy = -1
+	}
+
+	err := dx + dy
+	for {
+		img.SetRGBA(x0, y0, c)
+		if x0 == x1 && y0 == y1 {
+			return
+		}
+		e2 := 2 * err
+		if e2 >= dy {
+			err += dy
+			x0 += sx
+		}
+		if e2 <= dx {
+			err += dx
+			y0 += sy
+		}
+	}
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+func svgColor(c color.RGBA) string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
+
+func formatAxisValue(v float64) string {
+	return strconv.FormatFloat(v, 'g', 4, 64)
+}
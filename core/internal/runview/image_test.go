@@ -0,0 +1,56 @@
+package runview_test
+
+import (
+	"bytes"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/runview"
+)
+
+func testCharts() []*runview.Series {
+	loss := &runview.Series{Name: "train/loss"}
+	loss.Add(0, 1)
+	loss.Add(10, 0.5)
+	acc := &runview.Series{Name: "acc<top1>"}
+	acc.Add(0, 0.7)
+	lr := &runview.Series{Name: "lr"}
+	return []*runview.Series{loss, acc, lr}
+}
+
+func TestWriteSVG(t *testing.T) {
+	buf := &bytes.Buffer{}
+
+	err := runview.WriteSVG(buf, testCharts(), runview.DefaultImageOptions())
+
+	require.NoError(t, err)
+	svg := buf.String()
+	assert.True(t, strings.HasPrefix(svg, "<svg"))
+	assert.Contains(t, svg, `width="960" height="600"`)
+	assert.Contains(t, svg, ">train/loss</text>")
+	assert.Contains(t, svg, ">acc&lt;top1&gt;</text>")
+	assert.Equal(t, 3, strings.Count(svg, "<polyline"))
+}
+
+func TestWritePNG(t *testing.T) {
+	buf := &bytes.Buffer{}
+	opts := runview.ImageOptions{ChartWidth: 200, ChartHeight: 100, Columns: 3}
+
+	err := runview.WritePNG(buf, testCharts(), opts)
+
+	require.NoError(t, err)
+	img, err := png.Decode(buf)
+	require.NoError(t, err)
+	assert.Equal(t, 600, img.Bounds().Dx())
+	assert.Equal(t, 100, img.Bounds().Dy())
+}
+
+func TestWriteImage_InvalidOptions(t *testing.T) {
+	opts := runview.ImageOptions{ChartWidth: 10, ChartHeight: 10, Columns: 1}
+
+	assert.Error(t, runview.WriteSVG(&bytes.Buffer{}, testCharts(), opts))
+	assert.Error(t, runview.WritePNG(&bytes.Buffer{}, testCharts(), opts))
+}
@@ -0,0 +1,220 @@
+package runview
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"strings"
+)
+
+// ChartStyle is the set of characters used to draw line charts.
+type ChartStyle int
+
+const (
+	// ChartBraille draws with Braille patterns, which have 2x4 dots per
+	// character for the smoothest lines.
+	ChartBraille ChartStyle = iota
+
+	// ChartBlocks draws with half blocks, which have 1x2 dots per
+	// character and render in most fonts.
+	ChartBlocks
+
+	// ChartASCII draws with "*" only, for terminals, fonts and screen
+	// readers that don't handle other characters.
+	ChartASCII
+)
+
+// ChartStyleEnv is the environment variable that selects the chart style.
+const ChartStyleEnv = "WANDB_CHART_STYLE"
+
+// ParseChartStyle parses "braille", "blocks" or "ascii".
+func ParseChartStyle(name string) (ChartStyle, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "braille":
+		return ChartBraille, nil
+	case "blocks":
+		return ChartBlocks, nil
+	case "ascii":
+		return ChartASCII, nil
+	default:
+		return ChartBraille, fmt.Errorf(
+			"runview: unknown chart style %q, expected braille, blocks or ascii",
+			name)
+	}
+}
+
+// ChartStyleFromEnv returns the chart style set by ChartStyleEnv, or
+// ChartBraille if it's unset or invalid.
+func ChartStyleFromEnv() ChartStyle {
+	style, _ := ParseChartStyle(os.Getenv(ChartStyleEnv))
+	return style
+}
+
+// dotsPerCell returns the resolution of a character cell.
+func (s ChartStyle) dotsPerCell() (int, int) {
+	switch s {
+	case ChartBraille:
+		return 2, 4
+	case ChartBlocks:
+		return 1, 2
+	default:
+		return 1, 1
+	}
+}
+
+// LineChart draws points as a line chart that is width characters wide
+// and height lines tall, showing X in [minX, maxX] and Y in [minY, maxY].
+//
+// All styles plot the same dots at different resolutions, so charts look
+// alike whichever style is used. Non-finite values break the line.
+func LineChart(
+	points []Point,
+	minX, maxX, minY, maxY float64,
+	width, height int,
+	style ChartStyle,
+) []string {
+	if width <= 0 || height <= 0 {
+		return nil
+	}
+
+	dx, dy := style.dotsPerCell()
+	canvas := newDotCanvas(width*dx, height*dy)
+
+	prevX, prevY, hasPrev := 0, 0, false
+	for _, p := range points {
+		if math.IsNaN(p.Y) || math.IsInf(p.Y, 0) {
+			hasPrev = false
+			continue
+		}
+
+		x := project(p.X, minX, maxX, canvas.width)
+		// Dot rows count down from the top.
+		y := canvas.height - 1 - project(p.Y, minY, maxY, canvas.height)
+
+		if hasPrev {
+			canvas.line(prevX, prevY, x, y)
+		} else {
+			canvas.set(x, y)
+		}
+		prevX, prevY, hasPrev = x, y, true
+	}
+
+	lines := make([]string, height)
+	for row := range lines {
+		var line strings.Builder
+		for col := 0; col < width; col++ {
+			line.WriteRune(canvas.cell(col, row, style))
+		}
+		lines[row] = line.String()
+	}
+	return lines
+}
+
+// project maps a value in [lo, hi] to one of n dots.
+func project(value, lo, hi float64, n int) int {
+	if hi <= lo {
+		return n / 2
+	}
+	i := int(math.Round((value - lo) / (hi - lo) * float64(n-1)))
+	return min(max(i, 0), n-1)
+}
+
+// dotCanvas is a grid of dots that are drawn or not.
+type dotCanvas struct {
+	width, height int
+	dots          []bool
+}
+
+func newDotCanvas(width, height int) *dotCanvas {
+	return &dotCanvas{
+		width:  width,
+		height: height,
+		dots:   make([]bool, width*height),
+	}
+}
+
+func (c *dotCanvas) set(x, y int) {
+	if x >= 0 && x < c.width && y >= 0 && y < c.height {
+		c.dots[y*c.width+x] = true
+	}
+}
+
+func (c *dotCanvas) get(x, y int) bool {
+	return c.dots[y*c.width+x]
+}
+
+// line draws a line between two dots with Bresenham's algorithm.
+func (c *dotCanvas) line(x0, y0, x1, y1 int) {
+	dx, dy := abs(x1-x0), -abs(y1-y0)
+	sx, sy := 1, 1
+	if x0 > x1 {
+		sx = -1
+	}
+	if y0 > y1 {
+		sy = -1
+	}
+
+	err := dx + dy
+	for {
+		c.set(x0, y0)
+		if x0 == x1 && y0 == y1 {
+			return
+		}
+		e2 := 2 * err
+		if e2 >= dy {
+			err += dy
+			x0 += sx
+		}
+		if e2 <= dx {
+			err += dx
+			y0 += sy
+		}
+	}
+}
+
+// brailleBits are the bits of a Braille pattern for each dot of a cell,
+// indexed by row and then column.
+var brailleBits = [4][2]rune{
+	{0x01, 0x08},
+	{0x02, 0x10},
+	{0x04, 0x20},
+	{0x40, 0x80},
+}
+
+// cell returns the character for a cell of the chart.
+func (c *dotCanvas) cell(col, row int, style ChartStyle) rune {
+	switch style {
+	case ChartBraille:
+		var bits rune
+		for y := 0; y < 4; y++ {
+			for x := 0; x < 2; x++ {
+				if c.get(col*2+x, row*4+y) {
+					bits |= brailleBits[y][x]
+				}
+			}
+		}
+		if bits == 0 {
+			return ' '
+		}
+		return 0x2800 + bits
+
+	case ChartBlocks:
+		top, bottom := c.get(col, row*2), c.get(col, row*2+1)
+		switch {
+		case top && bottom:
+			return '█'
+		case top:
+			return '▀'
+		case bottom:
+			return '▄'
+		default:
+			return ' '
+		}
+
+	default:
+		if c.get(col, row) {
+			return '*'
+		}
+		return ' '
+	}
+}
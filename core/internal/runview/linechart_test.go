@@ -0,0 +1,57 @@
+package runview_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wandb/wandb/core/internal/runview"
+)
+
+var diagonal = []runview.Point{{X: 0, Y: 0}, {X: 1, Y: 1}, {X: 2, Y: 2}}
+
+func TestLineChart_ASCII(t *testing.T) {
+	lines := runview.LineChart(diagonal, 0, 2, 0, 2, 3, 3, runview.ChartASCII)
+
+	assert.Equal(t, []string{
+		"  *",
+		" * ",
+		"*  ",
+	}, lines)
+}
+
+func TestLineChart_Blocks(t *testing.T) {
+	lines := runview.LineChart(diagonal, 0, 2, 0, 2, 2, 1, runview.ChartBlocks)
+
+	assert.Equal(t, []string{"▄▀"}, lines)
+}
+
+func TestLineChart_Braille(t *testing.T) {
+	flat := []runview.Point{{X: 0, Y: 1}, {X: 1, Y: 1}}
+
+	lines := runview.LineChart(flat, 0, 1, 0, 2, 1, 1, runview.ChartBraille)
+
+	// A constant line halfway up four rows of dots rounds to the second
+	// row and fills both of its columns.
+	assert.Equal(t, []string{"⠒"}, lines)
+}
+
+func TestLineChart_NonFiniteBreaksLine(t *testing.T) {
+	points := []runview.Point{{X: 0, Y: 0}, {X: 1, Y: math.NaN()}, {X: 2, Y: 0}}
+
+	lines := runview.LineChart(points, 0, 2, 0, 1, 3, 1, runview.ChartASCII)
+
+	assert.Equal(t, []string{"* *"}, lines)
+}
+
+func TestParseChartStyle(t *testing.T) {
+	style, err := runview.ParseChartStyle("ASCII")
+	assert.NoError(t, err)
+	assert.Equal(t, runview.ChartASCII, style)
+
+	_, err = runview.ParseChartStyle("sixel")
+	assert.Error(t, err)
+
+	t.Setenv(runview.ChartStyleEnv, "blocks")
+	assert.Equal(t, runview.ChartBlocks, runview.ChartStyleFromEnv())
+}
@@ -0,0 +1,152 @@
+package runview
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/wandb/segmentio-encoding/json"
+	"github.com/wandb/wandb/core/internal/pathtree"
+	"github.com/wandb/wandb/core/internal/runconfig"
+	"github.com/wandb/wandb/core/internal/runsummary"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// KeyValue is a flattened config or summary entry.
+type KeyValue struct {
+	// Key is the dot-separated path of the value, e.g. "optimizer.lr".
+	Key string
+
+	// Value is the JSON encoding of the value.
+	Value string
+}
+
+// Environment describes where a run was executed.
+type Environment struct {
+	Host          string
+	PythonVersion string
+	CLIVersion    string
+	GitRemote     string
+	GitCommit     string
+}
+
+// Overview collects a run's metadata, config and summary.
+type Overview struct {
+	RunID       string
+	DisplayName string
+	Entity      string
+	Project     string
+	Tags        []string
+	Notes       string
+	Environment Environment
+
+	config  *runconfig.RunConfig
+	summary *runsummary.RunSummary
+
+	// errors are problems with the records that were skipped.
+	errors []error
+}
+
+func NewOverview() *Overview {
+	return &Overview{
+		config:  runconfig.New(),
+		summary: runsummary.New(runsummary.Params{}),
+	}
+}
+
+// Add updates the overview from a record.
+//
+// Records that are not relevant to the overview are ignored.
+func (o *Overview) Add(record *service.Record) {
+	switch x := record.GetRecordType().(type) {
+	case *service.Record_Run:
+		o.addRun(x.Run)
+	case *service.Record_Config:
+		o.config.ApplyChangeRecord(x.Config, o.onError)
+	case *service.Record_Summary:
+		o.summary.ApplyChangeRecord(x.Summary, o.onError)
+	case *service.Record_Telemetry:
+		o.addTelemetry(x.Telemetry)
+	}
+}
+
+func (o *Overview) addRun(run *service.RunRecord) {
+	o.RunID = run.GetRunId()
+	o.DisplayName = run.GetDisplayName()
+	o.Entity = run.GetEntity()
+	o.Project = run.GetProject()
+	o.Tags = run.GetTags()
+	o.Notes = run.GetNotes()
+
+	if run.GetHost() != "" {
+		o.Environment.Host = run.GetHost()
+	}
+	if git := run.GetGit(); git != nil {
+		o.Environment.GitRemote = git.GetRemoteUrl()
+		o.Environment.GitCommit = git.GetCommit()
+	}
+
+	if run.GetConfig() != nil {
+		o.config.ApplyChangeRecord(run.GetConfig(), o.onError)
+	}
+	if run.GetSummary() != nil {
+		o.summary.ApplyChangeRecord(run.GetSummary(), o.onError)
+	}
+	o.addTelemetry(run.GetTelemetry())
+}
+
+func (o *Overview) addTelemetry(telemetry *service.TelemetryRecord) {
+	if telemetry.GetPythonVersion() != "" {
+		o.Environment.PythonVersion = telemetry.GetPythonVersion()
+	}
+	if telemetry.GetCliVersion() != "" {
+		o.Environment.CLIVersion = telemetry.GetCliVersion()
+	}
+}
+
+func (o *Overview) onError(err error) {
+	o.errors = append(o.errors, err)
+}
+
+// Errors returns the problems encountered while applying records.
+func (o *Overview) Errors() []error {
+	return o.errors
+}
+
+// Config returns the run's config sorted by key.
+//
+// The internal "_wandb" section is omitted.
+func (o *Overview) Config() []KeyValue {
+	tree := make(pathtree.TreeData)
+	for key, value := range o.config.Tree() {
+		if key != "_wandb" {
+			tree[key] = value
+		}
+	}
+	return Flatten(tree)
+}
+
+// Summary returns the run's summary sorted by key.
+func (o *Overview) Summary() []KeyValue {
+	return Flatten(o.summary.Tree())
+}
+
+// Flatten returns the leaves of a config or summary tree sorted by key.
+func Flatten(tree pathtree.TreeData) []KeyValue {
+	items := pathtree.NewFrom(tree).Flatten()
+
+	values := make([]KeyValue, 0, len(items))
+	for _, item := range items {
+		valueJSON, err := json.Marshal(item.Value)
+		if err != nil {
+			valueJSON = []byte(fmt.Sprintf("%q", fmt.Sprint(item.Value)))
+		}
+		values = append(values, KeyValue{
+			Key:   strings.Join(item.Path, "."),
+			Value: string(valueJSON),
+		})
+	}
+
+	sort.Slice(values, func(i, j int) bool { return values[i].Key < values[j].Key })
+	return values
+}
@@ -0,0 +1,87 @@
+package runview_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wandb/wandb/core/internal/runview"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+func TestOverview(t *testing.T) {
+	overview := runview.NewOverview()
+
+	overview.Add(&service.Record{RecordType: &service.Record_Run{
+		Run: &service.RunRecord{
+			RunId:       "abc",
+			DisplayName: "my run",
+			Host:        "gpu-box",
+			Git: &service.GitRepoRecord{
+				RemoteUrl: "https://github.com/x/y",
+				Commit:    "123abc",
+			},
+			Config: &service.ConfigRecord{Update: []*service.ConfigItem{
+				{Key: "lr", ValueJson: "0.01"},
+				{Key: "_wandb", ValueJson: `{"t": {}}`},
+			}},
+			Telemetry: &service.TelemetryRecord{PythonVersion: "3.11.4"},
+		},
+	}})
+	overview.Add(&service.Record{RecordType: &service.Record_Config{
+		Config: &service.ConfigRecord{Update: []*service.ConfigItem{
+			{NestedKey: []string{"optimizer", "name"}, ValueJson: `"adam"`},
+		}},
+	}})
+	overview.Add(&service.Record{RecordType: &service.Record_Summary{
+		Summary: &service.SummaryRecord{Update: []*service.SummaryItem{
+			{Key: "loss", ValueJson: "0.5"},
+		}},
+	}})
+	overview.Add(&service.Record{RecordType: &service.Record_Telemetry{
+		Telemetry: &service.TelemetryRecord{CliVersion: "0.17.0"},
+	}})
+
+	assert.Equal(t, "abc", overview.RunID)
+	assert.Equal(t, "my run", overview.DisplayName)
+	assert.Equal(t,
+		runview.Environment{
+			Host:          "gpu-box",
+			PythonVersion: "3.11.4",
+			CLIVersion:    "0.17.0",
+			GitRemote:     "https://github.com/x/y",
+			GitCommit:     "123abc",
+		},
+		overview.Environment)
+	assert.Equal(t,
+		[]runview.KeyValue{
+			{Key: "lr", Value: "0.01"},
+			{Key: "optimizer.name", Value: `"adam"`},
+		},
+		overview.Config())
+	assert.Equal(t,
+		[]runview.KeyValue{{Key: "loss", Value: "0.5"}},
+		overview.Summary())
+	assert.Empty(t, overview.Errors())
+}
+
+func TestFuzzyFilter(t *testing.T) {
+	entries := []runview.KeyValue{
+		{Key: "optimizer.learning_rate"},
+		{Key: "lr"},
+		{Key: "model.layers"},
+		{Key: "batch_size"},
+	}
+
+	assert.Equal(t, entries, runview.FuzzyFilter(entries, ""))
+	assert.Equal(t,
+		[]runview.KeyValue{
+			{Key: "lr"},
+			{Key: "optimizer.learning_rate"},
+			{Key: "model.layers"},
+		},
+		runview.FuzzyFilter(entries, "lr"))
+	assert.Equal(t,
+		[]runview.KeyValue{{Key: "batch_size"}},
+		runview.FuzzyFilter(entries, "bsz"))
+	assert.Empty(t, runview.FuzzyFilter(entries, "xyz"))
+}
@@ -0,0 +1,264 @@
+package runview
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// ReadFile passes each record in a .wandb file to onRecord, in order.
+//
+// It stops at the end of the file, or at the first record that cannot be
+// read, in which case the error is returned.
+func ReadFile(path string, onRecord func(*service.Record)) error {
+	_, err := readRecords(path, 0, onRecord)
+	return err
+}
+
+// ReadFileFromStep is like ReadFile, but starts at the first history
+// record whose step is at least step.
+//
+// It seeks using the file's sidecar index if it has one, and otherwise
+// scans from the start, skipping earlier records.
+func ReadFileFromStep(
+	path string,
+	step int64,
+	onRecord func(*service.Record),
+) error {
+	store := server.NewStore(context.Background(), path)
+	if err := store.Open(os.O_RDONLY); err != nil {
+		return err
+	}
+	defer store.Close()
+
+	started := true
+	switch err := store.SeekStep(step); {
+	case errors.Is(err, io.EOF):
+		return nil
+	case errors.Is(err, server.ErrNoIndex):
+		started = false
+	case err != nil:
+		return err
+	}
+
+	for {
+		record, err := store.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if !started {
+			history := record.GetHistory()
+			if history == nil || history.GetStep().GetNum() < step {
+				continue
+			}
+			started = true
+		}
+		onRecord(record)
+	}
+}
+
+// FollowStatus is whether a followed run's file is still being written.
+type FollowStatus int
+
+const (
+	// FollowLive means the run hasn't exited, so records may still be
+	// appended to its file.
+	FollowLive FollowStatus = iota
+
+	// FollowComplete means the run's exit record has been read.
+	FollowComplete
+)
+
+func (s FollowStatus) String() string {
+	switch s {
+	case FollowLive:
+		return "live"
+	case FollowComplete:
+		return "complete"
+	default:
+		return "unknown"
+	}
+}
+
+// FollowFile is like ReadFile, but keeps passing records to onRecord as
+// they're appended to a file that is still being written, until ctx is
+// cancelled.
+//
+// The file is reread when it's reported to have changed, and also every
+// interval in case change notifications aren't available, as on some
+// network file systems. A record that is only partly written is passed
+// to onRecord once the rest of it is written.
+func FollowFile(
+	ctx context.Context,
+	path string,
+	interval time.Duration,
+	onRecord func(*service.Record),
+) error {
+	return follow(ctx, path, interval, onRecord, nil)
+}
+
+// FollowRun is like FollowFile, but returns once the run's exit record
+// has been read.
+//
+// onStatus is called with FollowLive once the records written so far
+// have been read if the run hasn't exited, and with FollowComplete after
+// the exit record.
+func FollowRun(
+	ctx context.Context,
+	path string,
+	interval time.Duration,
+	onRecord func(*service.Record),
+	onStatus func(FollowStatus),
+) error {
+	return follow(ctx, path, interval, onRecord, onStatus)
+}
+
+// follow implements FollowFile and FollowRun, stopping at the exit record
+// only if onStatus is set.
+func follow(
+	ctx context.Context,
+	path string,
+	interval time.Duration,
+	onRecord func(*service.Record),
+	onStatus func(FollowStatus),
+) error {
+	changes, stopWatching := watchFile(path)
+	defer stopWatching()
+
+	exited := false
+	wasLive := false
+	handleRecord := func(record *service.Record) {
+		if record.GetExit() != nil {
+			exited = true
+		}
+		onRecord(record)
+	}
+
+	var size int64 = -1
+	read := 0
+
+	for {
+		info, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+
+		if info.Size() != size {
+			size = info.Size()
+
+			// Errors are expected at the end of a file that's being
+			// written, so we retry from the last complete record when
+			// the file changes again.
+			read, _ = readRecords(path, read, handleRecord)
+		}
+
+		if onStatus != nil {
+			if exited {
+				onStatus(FollowComplete)
+				return nil
+			}
+			if !wasLive {
+				wasLive = true
+				onStatus(FollowLive)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-changes:
+		case <-time.After(interval):
+		}
+	}
+}
+
+// watchFile returns a channel that receives a value when the file at the
+// path is written to, and a function to stop watching it.
+//
+// If the file system doesn't support change notifications, the channel
+// never receives anything.
+func watchFile(path string) (<-chan struct{}, func()) {
+	changes := make(chan struct{}, 1)
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return changes, func() {}
+	}
+
+	// Watching the directory rather than the file keeps working if the
+	// file is replaced rather than appended to.
+	path = filepath.Clean(path)
+	if err := watcher.Add(filepath.Dir(path)); err != nil {
+		watcher.Close()
+		return changes, func() {}
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for {
+			select {
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if filepath.Clean(event.Name) != path {
+					continue
+				}
+				select {
+				case changes <- struct{}{}:
+				default:
+				}
+			case _, ok := <-watcher.Errors:
+				// Errors such as dropped events are made up for by
+				// polling.
+				if !ok {
+					return
+				}
+			}
+		}
+	}()
+
+	return changes, func() {
+		watcher.Close()
+		<-done
+	}
+}
+
+// readRecords passes the records in a .wandb file after the first skip
+// records to onRecord, and returns the total number of records read.
+func readRecords(
+	path string,
+	skip int,
+	onRecord func(*service.Record),
+) (int, error) {
+	store := server.NewStore(context.Background(), path)
+	if err := store.Open(os.O_RDONLY); err != nil {
+		return skip, err
+	}
+	defer store.Close()
+
+	for n := 0; ; n++ {
+		record, err := store.Read()
+		if errors.Is(err, io.EOF) {
+			return max(n, skip), nil
+		}
+		if err != nil {
+			return max(n, skip), err
+		}
+		if n >= skip {
+			onRecord(record)
+		}
+	}
+}
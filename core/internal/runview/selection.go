@@ -0,0 +1,164 @@
+package runview
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// regexSyntax are sequences that only appear in regex filters.
+//
+// Other filters are globs, in which "*" matches any sequence of characters
+// and "?" matches any single character.
+var regexSyntax = []string{".*", ".+", "^", "$", "(", "|", "\\", "["}
+
+// MetricSelection chooses which metrics to chart and in what order.
+//
+// Metrics are filtered by a pattern, and pinned metrics come before all
+// others so that they're on the first page of a chart grid.
+type MetricSelection struct {
+	pattern string
+	filter  *regexp.Regexp
+	pinned  []string
+
+	// order are patterns of metrics that come before others, in order
+	order []*regexp.Regexp
+}
+
+// SetFilter sets the pattern that metric names must contain.
+//
+// The pattern is a regex such as "train/.*loss" if it uses regex syntax,
+// and a glob such as "train/*loss" otherwise. An empty pattern matches all
+// metrics.
+func (s *MetricSelection) SetFilter(pattern string) error {
+	if pattern == "" {
+		s.pattern = ""
+		s.filter = nil
+		return nil
+	}
+
+	expr := pattern
+	if !isRegex(pattern) {
+		expr = globToRegex(pattern)
+	}
+
+	filter, err := regexp.Compile(expr)
+	if err != nil {
+		return fmt.Errorf("runview: invalid filter %q: %v", pattern, err)
+	}
+
+	s.pattern = pattern
+	s.filter = filter
+	return nil
+}
+
+// SetOrder sets globs of metrics to list before the others, such as
+// "train/*", in order.
+func (s *MetricSelection) SetOrder(patterns []string) error {
+	order := make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		expr, err := regexp.Compile("^" + globToRegex(pattern) + "$")
+		if err != nil {
+			return fmt.Errorf("runview: invalid metric pattern %q: %v", pattern, err)
+		}
+		order = append(order, expr)
+	}
+	s.order = order
+	return nil
+}
+
+// rank returns the index of the first order pattern a metric matches, or
+// the number of patterns if none.
+func (s *MetricSelection) rank(metric string) int {
+	for i, pattern := range s.order {
+		if pattern.MatchString(metric) {
+			return i
+		}
+	}
+	return len(s.order)
+}
+
+// Filter returns the current filter pattern.
+func (s *MetricSelection) Filter() string {
+	return s.pattern
+}
+
+// Matches returns whether a metric passes the filter.
+func (s *MetricSelection) Matches(metric string) bool {
+	return s.filter == nil || s.filter.MatchString(metric)
+}
+
+// TogglePin pins the metric if it's not pinned, and unpins it otherwise.
+func (s *MetricSelection) TogglePin(metric string) {
+	if i := slices.Index(s.pinned, metric); i >= 0 {
+		s.pinned = slices.Delete(s.pinned, i, i+1)
+	} else {
+		s.pinned = append(s.pinned, metric)
+	}
+}
+
+// IsPinned returns whether the metric is pinned.
+func (s *MetricSelection) IsPinned(metric string) bool {
+	return slices.Contains(s.pinned, metric)
+}
+
+// Select returns the metrics that pass the filter.
+//
+// Pinned metrics come first in the order they were pinned, followed by
+// the other metrics ordered by the first pattern given to SetOrder that
+// they match, and otherwise in their original order.
+func (s *MetricSelection) Select(metrics []string) []string {
+	var selected []string
+
+	for _, metric := range s.pinned {
+		if slices.Contains(metrics, metric) && s.Matches(metric) {
+			selected = append(selected, metric)
+		}
+	}
+
+	var others []string
+	for _, metric := range metrics {
+		if !s.IsPinned(metric) && s.Matches(metric) {
+			others = append(others, metric)
+		}
+	}
+	if len(s.order) > 0 {
+		slices.SortStableFunc(others, func(a, b string) int {
+			return s.rank(a) - s.rank(b)
+		})
+	}
+
+	return append(selected, others...)
+}
+
+// Paginate splits metrics into pages for a grid of perPage charts.
+func Paginate(metrics []string, perPage int) [][]string {
+	if perPage <= 0 {
+		return nil
+	}
+
+	var pages [][]string
+	for len(metrics) > 0 {
+		n := min(perPage, len(metrics))
+		pages = append(pages, metrics[:n])
+		metrics = metrics[n:]
+	}
+	return pages
+}
+
+func isRegex(pattern string) bool {
+	for _, syntax := range regexSyntax {
+		if strings.Contains(pattern, syntax) {
+			return true
+		}
+	}
+	return false
+}
+
+func globToRegex(glob string) string {
+	expr := regexp.QuoteMeta(glob)
+	expr = strings.ReplaceAll(expr, `\*`, ".*")
+	expr = strings.ReplaceAll(expr, `\?`, ".")
+	return expr
+}
@@ -0,0 +1,86 @@
+package runview_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/runview"
+)
+
+var chartMetrics = []string{
+	"train/loss",
+	"train/acc",
+	"train/aux_loss",
+	"val/loss",
+	"lr",
+}
+
+func TestMetricSelection_Regex(t *testing.T) {
+	selection := &runview.MetricSelection{}
+
+	require.NoError(t, selection.SetFilter("train/.*loss"))
+
+	assert.Equal(t,
+		[]string{"train/loss", "train/aux_loss"},
+		selection.Select(chartMetrics))
+}
+
+func TestMetricSelection_Glob(t *testing.T) {
+	selection := &runview.MetricSelection{}
+
+	require.NoError(t, selection.SetFilter("*/loss"))
+
+	assert.Equal(t, []string{"train/loss", "val/loss"}, selection.Select(chartMetrics))
+	assert.Equal(t, "*/loss", selection.Filter())
+}
+
+func TestMetricSelection_InvalidRegex(t *testing.T) {
+	selection := &runview.MetricSelection{}
+	require.NoError(t, selection.SetFilter("val"))
+
+	err := selection.SetFilter("train/(loss")
+
+	assert.Error(t, err)
+	assert.Equal(t, "val", selection.Filter())
+}
+
+func TestMetricSelection_PinnedFirst(t *testing.T) {
+	selection := &runview.MetricSelection{}
+
+	selection.TogglePin("lr")
+	selection.TogglePin("val/loss")
+	selection.TogglePin("train/acc")
+	selection.TogglePin("train/acc")
+
+	assert.Equal(t,
+		[]string{"lr", "val/loss", "train/loss", "train/acc", "train/aux_loss"},
+		selection.Select(chartMetrics))
+	assert.False(t, selection.IsPinned("train/acc"))
+}
+
+func TestMetricSelection_FilterAppliesToPinned(t *testing.T) {
+	selection := &runview.MetricSelection{}
+	selection.TogglePin("lr")
+
+	require.NoError(t, selection.SetFilter("loss"))
+
+	assert.NotContains(t, selection.Select(chartMetrics), "lr")
+}
+
+func TestPaginate(t *testing.T) {
+	assert.Equal(t,
+		[][]string{{"train/loss", "train/acc"}, {"train/aux_loss", "val/loss"}, {"lr"}},
+		runview.Paginate(chartMetrics, 2))
+	assert.Nil(t, runview.Paginate(nil, 2))
+}
+
+func TestMetricSelection_Order(t *testing.T) {
+	selection := &runview.MetricSelection{}
+	require.NoError(t, selection.SetOrder([]string{"val/*", "lr"}))
+	selection.TogglePin("train/acc")
+
+	assert.Equal(t,
+		[]string{"train/acc", "val/loss", "lr", "train/loss", "train/aux_loss"},
+		selection.Select(chartMetrics))
+}
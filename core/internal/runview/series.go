@@ -0,0 +1,84 @@
+// Package runview extracts what terminal run viewers display from the
+// records of a run's transaction log.
+//
+// Viewers feed records read from a .wandb file to the types in this
+// package, which keep the data in a form that is cheap to render.
+package runview
+
+import "sort"
+
+// Point is a value of a metric at an x-axis position such as a step or a
+// number of seconds since the run started.
+type Point struct {
+	X, Y float64
+}
+
+// Series is a sequence of points of a metric ordered by X.
+type Series struct {
+	Name   string
+	Points []Point
+}
+
+// Add appends a point, keeping the points ordered by X.
+//
+// Points are usually added in order, in which case this is constant time.
+func (s *Series) Add(x, y float64) {
+	n := len(s.Points)
+	if n == 0 || s.Points[n-1].X <= x {
+		s.Points = append(s.Points, Point{x, y})
+		return
+	}
+
+	i := sort.Search(n, func(i int) bool { return s.Points[i].X > x })
+	s.Points = append(s.Points, Point{})
+	copy(s.Points[i+1:], s.Points[i:])
+	s.Points[i] = Point{x, y}
+}
+
+// Bounds returns the smallest and largest X and Y of the points.
+//
+// The last return value is false if the series is empty.
+func (s *Series) Bounds() (minX, maxX, minY, maxY float64, ok bool) {
+	if len(s.Points) == 0 {
+		return 0, 0, 0, 0, false
+	}
+
+	minX, maxX = s.Points[0].X, s.Points[len(s.Points)-1].X
+	minY, maxY = s.Points[0].Y, s.Points[0].Y
+	for _, p := range s.Points[1:] {
+		minY = min(minY, p.Y)
+		maxY = max(maxY, p.Y)
+	}
+	return minX, maxX, minY, maxY, true
+}
+
+// Window returns the points whose X is within [minX, maxX].
+//
+// It is used to zoom into part of a chart. The result shares memory
+// with the series.
+func (s *Series) Window(minX, maxX float64) []Point {
+	lo := sort.Search(len(s.Points), func(i int) bool {
+		return s.Points[i].X >= minX
+	})
+	hi := sort.Search(len(s.Points), func(i int) bool {
+		return s.Points[i].X > maxX
+	})
+	return s.Points[lo:hi]
+}
+
+// Smoothed returns the series with exponential moving average smoothing.
+//
+// The weight is in [0, 1), where 0 leaves the series unchanged. As in the
+// W&B UI, the average is debiased so that early points aren't pulled
+// toward zero.
+func (s *Series) Smoothed(weight float64) *Series {
+	smoothed := &Series{Name: s.Name, Points: make([]Point, len(s.Points))}
+
+	average, debias := 0.0, 1.0
+	for i, p := range s.Points {
+		average = weight*average + (1-weight)*p.Y
+		debias *= weight
+		smoothed.Points[i] = Point{X: p.X, Y: average / (1 - debias)}
+	}
+	return smoothed
+}
@@ -0,0 +1,60 @@
+package runview_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wandb/wandb/core/internal/runview"
+)
+
+func TestSeries_AddOutOfOrder(t *testing.T) {
+	series := &runview.Series{}
+
+	series.Add(1, 10)
+	series.Add(3, 30)
+	series.Add(2, 20)
+
+	assert.Equal(t,
+		[]runview.Point{{X: 1, Y: 10}, {X: 2, Y: 20}, {X: 3, Y: 30}},
+		series.Points)
+}
+
+func TestSeries_Bounds(t *testing.T) {
+	series := &runview.Series{}
+	_, _, _, _, ok := series.Bounds()
+	assert.False(t, ok)
+
+	series.Add(0, 5)
+	series.Add(1, -1)
+	series.Add(2, 3)
+	minX, maxX, minY, maxY, ok := series.Bounds()
+
+	assert.True(t, ok)
+	assert.Equal(t, []float64{0, 2, -1, 5}, []float64{minX, maxX, minY, maxY})
+}
+
+func TestSeries_Window(t *testing.T) {
+	series := &runview.Series{}
+	for i := 0; i < 10; i++ {
+		series.Add(float64(i), float64(i))
+	}
+
+	points := series.Window(2.5, 5)
+
+	assert.Equal(t,
+		[]runview.Point{{X: 3, Y: 3}, {X: 4, Y: 4}, {X: 5, Y: 5}},
+		points)
+}
+
+func TestSeries_Smoothed(t *testing.T) {
+	series := &runview.Series{Name: "loss"}
+	series.Add(0, 4)
+	series.Add(1, 0)
+
+	unchanged := series.Smoothed(0)
+	smoothed := series.Smoothed(0.5)
+
+	assert.Equal(t, series.Points, unchanged.Points)
+	assert.Equal(t, []runview.Point{{X: 0, Y: 4}, {X: 1, Y: 4.0 / 3}},
+		smoothed.Points)
+}
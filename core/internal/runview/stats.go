@@ -0,0 +1,137 @@
+package runview
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// StatsCategory groups related system metrics onto one page section.
+type StatsCategory string
+
+const (
+	StatsGPU     StatsCategory = "GPU"
+	StatsCPU     StatsCategory = "CPU"
+	StatsMemory  StatsCategory = "Memory"
+	StatsNetwork StatsCategory = "Network"
+	StatsDisk    StatsCategory = "Disk"
+	StatsOther   StatsCategory = "Other"
+)
+
+// statsCategoryOrder is the order in which categories are displayed.
+var statsCategoryOrder = []StatsCategory{
+	StatsGPU, StatsCPU, StatsMemory, StatsNetwork, StatsDisk, StatsOther,
+}
+
+// CategoryOf returns the category of a system metric, such as
+// "gpu.0.memory" or "network.sent".
+func CategoryOf(metric string) StatsCategory {
+	switch {
+	case strings.HasPrefix(metric, "gpu."),
+		strings.HasPrefix(metric, "tpu"),
+		strings.HasPrefix(metric, "trn."):
+		return StatsGPU
+	case metric == "cpu",
+		strings.HasPrefix(metric, "cpu."),
+		strings.HasPrefix(metric, "proc.cpu."):
+		return StatsCPU
+	case strings.HasPrefix(metric, "memory"),
+		strings.HasPrefix(metric, "proc.memory."):
+		return StatsMemory
+	case strings.HasPrefix(metric, "network."):
+		return StatsNetwork
+	case strings.HasPrefix(metric, "disk"):
+		return StatsDisk
+	default:
+		return StatsOther
+	}
+}
+
+// SystemMetrics collects the system metrics in a run's stats records.
+//
+// Each metric is a series whose X is the number of seconds since the
+// first stats record.
+type SystemMetrics struct {
+	series map[string]*Series
+
+	// start is the timestamp of the first record in seconds.
+	start    float64
+	hasStart bool
+}
+
+func NewSystemMetrics() *SystemMetrics {
+	return &SystemMetrics{series: make(map[string]*Series)}
+}
+
+// Add records the values in a stats record.
+//
+// Values that are not numbers are ignored.
+func (m *SystemMetrics) Add(record *service.StatsRecord) {
+	timestamp := float64(record.GetTimestamp().GetSeconds()) +
+		float64(record.GetTimestamp().GetNanos())/1e9
+	if !m.hasStart {
+		m.start = timestamp
+		m.hasStart = true
+	}
+
+	for _, item := range record.GetItem() {
+		value, err := strconv.ParseFloat(item.GetValueJson(), 64)
+		if err != nil {
+			continue
+		}
+
+		series, ok := m.series[item.GetKey()]
+		if !ok {
+			series = &Series{Name: item.GetKey()}
+			m.series[item.GetKey()] = series
+		}
+		series.Add(timestamp-m.start, value)
+	}
+}
+
+// Series returns the series of a metric, or nil if it has no values.
+func (m *SystemMetrics) Series(metric string) *Series {
+	return m.series[metric]
+}
+
+// Metrics returns the names of the metrics with values, in the order of
+// Groups.
+func (m *SystemMetrics) Metrics() []string {
+	var metrics []string
+	for _, group := range m.Groups() {
+		for _, series := range group.Series {
+			metrics = append(metrics, series.Name)
+		}
+	}
+	return metrics
+}
+
+// StatsGroup is the metrics of one category, sorted by name.
+type StatsGroup struct {
+	Category StatsCategory
+	Series   []*Series
+}
+
+// Groups returns the metrics by category, omitting empty categories.
+func (m *SystemMetrics) Groups() []StatsGroup {
+	byCategory := make(map[StatsCategory][]*Series)
+	for name, series := range m.series {
+		category := CategoryOf(name)
+		byCategory[category] = append(byCategory[category], series)
+	}
+
+	var groups []StatsGroup
+	for _, category := range statsCategoryOrder {
+		series := byCategory[category]
+		if len(series) == 0 {
+			continue
+		}
+		sort.Slice(series, func(i, j int) bool {
+			return series[i].Name < series[j].Name
+		})
+		groups = append(groups, StatsGroup{Category: category, Series: series})
+	}
+	return groups
+}
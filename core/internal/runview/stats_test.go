@@ -0,0 +1,89 @@
+package runview_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/runview"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func statsRecord(seconds int64, items map[string]string) *service.StatsRecord {
+	record := &service.StatsRecord{
+		StatsType: service.StatsRecord_SYSTEM,
+		Timestamp: &timestamppb.Timestamp{Seconds: seconds},
+	}
+	for key, value := range items {
+		record.Item = append(record.Item,
+			&service.StatsItem{Key: key, ValueJson: value})
+	}
+	return record
+}
+
+func TestCategoryOf(t *testing.T) {
+	assert.Equal(t, runview.StatsGPU, runview.CategoryOf("gpu.0.memory"))
+	assert.Equal(t, runview.StatsCPU, runview.CategoryOf("cpu"))
+	assert.Equal(t, runview.StatsCPU, runview.CategoryOf("proc.cpu.threads"))
+	assert.Equal(t, runview.StatsMemory, runview.CategoryOf("memory_percent"))
+	assert.Equal(t, runview.StatsMemory, runview.CategoryOf("proc.memory.rssMB"))
+	assert.Equal(t, runview.StatsNetwork, runview.CategoryOf("network.sent"))
+	assert.Equal(t, runview.StatsDisk, runview.CategoryOf("disk./.usagePercent"))
+	assert.Equal(t, runview.StatsOther, runview.CategoryOf("something"))
+}
+
+func TestSystemMetrics_SeriesRelativeToStart(t *testing.T) {
+	metrics := runview.NewSystemMetrics()
+
+	metrics.Add(statsRecord(100, map[string]string{"cpu": "10", "gpu.0.gpu": "50"}))
+	metrics.Add(statsRecord(102, map[string]string{"cpu": "20", "bad": `"x"`}))
+
+	assert.Equal(t,
+		[]runview.Point{{X: 0, Y: 10}, {X: 2, Y: 20}},
+		metrics.Series("cpu").Points)
+	assert.Nil(t, metrics.Series("bad"))
+}
+
+func TestSystemMetrics_Groups(t *testing.T) {
+	metrics := runview.NewSystemMetrics()
+	metrics.Add(statsRecord(0, map[string]string{
+		"network.sent": "1",
+		"gpu.1.gpu":    "2",
+		"gpu.0.gpu":    "3",
+		"cpu":          "4",
+	}))
+
+	groups := metrics.Groups()
+
+	require.Len(t, groups, 3)
+	assert.Equal(t, runview.StatsGPU, groups[0].Category)
+	assert.Equal(t, "gpu.0.gpu", groups[0].Series[0].Name)
+	assert.Equal(t, "gpu.1.gpu", groups[0].Series[1].Name)
+	assert.Equal(t, runview.StatsCPU, groups[1].Category)
+	assert.Equal(t, runview.StatsNetwork, groups[2].Category)
+}
+
+func TestReadFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "run.wandb")
+	store := server.NewStore(context.Background(), path)
+	require.NoError(t, store.Open(os.O_WRONLY))
+	require.NoError(t, store.Write(&service.Record{
+		RecordType: &service.Record_Stats{
+			Stats: statsRecord(0, map[string]string{"cpu": "1"}),
+		},
+	}))
+	require.NoError(t, store.Close())
+	metrics := runview.NewSystemMetrics()
+
+	err := runview.ReadFile(path, func(record *service.Record) {
+		metrics.Add(record.GetStats())
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, metrics.Series("cpu").Points, 1)
+}
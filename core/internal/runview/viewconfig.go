@@ -0,0 +1,268 @@
+package runview
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/BurntSushi/toml"
+)
+
+// ViewConfig is the user's configuration of a terminal run viewer.
+//
+// It is read from a TOML file, by default ~/.config/wandb/leet.toml:
+//
+//	[grid]
+//	rows = 2
+//	columns = 3
+//
+//	[colors]
+//	palette = ["#e41a1c", "#377eb8", "#4daf4a"]
+//
+//	[metrics]
+//	order = ["train/loss", "val/*"]
+//	smoothing = 0.6
+//
+//	[keys]
+//	next_page = "j"
+//	prev_page = "k"
+//
+// Omitted values keep their defaults.
+type ViewConfig struct {
+	// GridRows and GridColumns are the size of the chart grid.
+	GridRows, GridColumns int
+
+	// Palette are the colors of chart lines as "#rrggbb" strings, used in
+	// order and repeated as needed.
+	Palette []string
+
+	// MetricOrder are globs of metrics to chart before others, in order.
+	MetricOrder []string
+
+	// Smoothing is the default exponential smoothing weight in [0, 1).
+	Smoothing float64
+
+	// Keys maps each action to the key that triggers it.
+	Keys map[string]string
+}
+
+// Actions that keys can be bound to.
+const (
+	ActionFilter   = "filter"
+	ActionPin      = "pin"
+	ActionNextPage = "next_page"
+	ActionPrevPage = "prev_page"
+	ActionConsole  = "console"
+	ActionSystem   = "system"
+	ActionOverview = "overview"
+	ActionTimeline = "timeline"
+	ActionRuns     = "runs"
+	ActionAnnotate = "annotate"
+	ActionExport   = "export"
+	ActionReload   = "reload"
+	ActionQuit     = "quit"
+)
+
+var hexColor = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// DefaultViewConfig returns the configuration used without a file.
+func DefaultViewConfig() ViewConfig {
+	return ViewConfig{
+		GridRows:    3,
+		GridColumns: 5,
+		Palette: []string{
+			"#1f77b4", "#ff7f0e", "#2ca02c", "#d62728", "#9467bd",
+			"#8c564b", "#e377c2", "#7f7f7f", "#bcbd22", "#17becf",
+		},
+		Keys: map[string]string{
+			ActionFilter:   "/",
+			ActionPin:      "p",
+			ActionNextPage: "n",
+			ActionPrevPage: "N",
+			ActionConsole:  "l",
+			ActionSystem:   "s",
+			ActionOverview: "o",
+			ActionTimeline: "t",
+			ActionRuns:     "w",
+			ActionAnnotate: "a",
+			ActionExport:   "e",
+			ActionReload:   "R",
+			ActionQuit:     "q",
+		},
+	}
+}
+
+// DefaultViewConfigPath returns ~/.config/wandb/leet.toml, respecting
+// XDG_CONFIG_HOME.
+func DefaultViewConfigPath() (string, error) {
+	configDir := os.Getenv("XDG_CONFIG_HOME")
+	if configDir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("runview: %v", err)
+		}
+		configDir = filepath.Join(home, ".config")
+	}
+	return filepath.Join(configDir, "wandb", "leet.toml"), nil
+}
+
+// LoadViewConfig reads a view configuration file.
+//
+// It returns the defaults if the file doesn't exist. Viewers call it at
+// startup and again when the user presses the reload key.
+func LoadViewConfig(path string) (ViewConfig, error) {
+	config := DefaultViewConfig()
+
+	data, err := os.ReadFile(path)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return config, nil
+	case err != nil:
+		return config, fmt.Errorf("runview: failed to read view config: %v", err)
+	}
+
+	var tables map[string]any
+	if _, err := toml.Decode(string(data), &tables); err != nil {
+		return config, fmt.Errorf("runview: %s: %v", path, err)
+	}
+	if err := config.apply(tables); err != nil {
+		return DefaultViewConfig(), fmt.Errorf("runview: %s: %v", path, err)
+	}
+	return config, nil
+}
+
+// ChartsPerPage returns the number of charts in the grid.
+func (c ViewConfig) ChartsPerPage() int {
+	return c.GridRows * c.GridColumns
+}
+
+// Color returns the color of the i-th line.
+func (c ViewConfig) Color(i int) string {
+	return c.Palette[i%len(c.Palette)]
+}
+
+// Action returns the action bound to a key, or "" if none.
+func (c ViewConfig) Action(key string) string {
+	for action, bound := range c.Keys {
+		if bound == key {
+			return action
+		}
+	}
+	return ""
+}
+
+// apply overrides the configuration with the values in a parsed file.
+func (c *ViewConfig) apply(tables map[string]any) error {
+	for table, values := range tables {
+		values, ok := values.(map[string]any)
+		if !ok {
+			return fmt.Errorf("%s: expected a table", table)
+		}
+
+		for key, value := range values {
+			if err := c.set(table, key, value); err != nil {
+				return fmt.Errorf("[%s] %s: %v", table, key, err)
+			}
+		}
+	}
+
+	seen := make(map[string]string)
+	for action, key := range c.Keys {
+		if other, ok := seen[key]; ok {
+			return fmt.Errorf("key %q is bound to both %s and %s", key, other, action)
+		}
+		seen[key] = action
+	}
+	return nil
+}
+
+func (c *ViewConfig) set(table, key string, value any) error {
+	switch table + "." + key {
+	case "grid.rows":
+		return setPositiveInt(&c.GridRows, value)
+	case "grid.columns":
+		return setPositiveInt(&c.GridColumns, value)
+
+	case "colors.palette":
+		palette, ok := asStrings(value)
+		if !ok || len(palette) == 0 {
+			return errors.New("expected a non-empty list of colors")
+		}
+		for _, color := range palette {
+			if !hexColor.MatchString(color) {
+				return fmt.Errorf("invalid color %q, expected #rrggbb", color)
+			}
+		}
+		c.Palette = palette
+
+	case "metrics.order":
+		order, ok := asStrings(value)
+		if !ok {
+			return errors.New("expected a list of metric patterns")
+		}
+		c.MetricOrder = order
+	case "metrics.smoothing":
+		smoothing, ok := asNumber(value)
+		if !ok || smoothing < 0 || smoothing >= 1 {
+			return errors.New("expected a number in [0, 1)")
+		}
+		c.Smoothing = smoothing
+
+	default:
+		if table != "keys" {
+			return errors.New("unknown setting")
+		}
+		if _, ok := c.Keys[key]; !ok {
+			return errors.New("unknown action")
+		}
+		bound, ok := value.(string)
+		if !ok || bound == "" {
+			return errors.New("expected a key")
+		}
+		c.Keys[key] = bound
+	}
+
+	return nil
+}
+
+func setPositiveInt(target *int, value any) error {
+	number, ok := asNumber(value)
+	if !ok || number < 1 || number != float64(int(number)) {
+		return errors.New("expected a positive integer")
+	}
+	*target = int(number)
+	return nil
+}
+
+// asNumber returns the value of a TOML integer or float.
+func asNumber(value any) (float64, bool) {
+	switch number := value.(type) {
+	case int64:
+		return float64(number), true
+	case float64:
+		return number, true
+	default:
+		return 0, false
+	}
+}
+
+// asStrings returns the items of a TOML array of strings.
+func asStrings(value any) ([]string, bool) {
+	items, ok := value.([]any)
+	if !ok {
+		return nil, false
+	}
+
+	strs := make([]string, 0, len(items))
+	for _, item := range items {
+		str, ok := item.(string)
+		if !ok {
+			return nil, false
+		}
+		strs = append(strs, str)
+	}
+	return strs, true
+}
@@ -0,0 +1,104 @@
+package runview_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/runview"
+)
+
+func writeViewConfig(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "leet.toml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	return path
+}
+
+func TestLoadViewConfig(t *testing.T) {
+	path := writeViewConfig(t, `
+# A smaller grid for a laptop screen.
+[grid]
+rows = 2
+columns = 3
+
+[colors]
+palette = ["#e41a1c", "#377eb8"]  # colorblind-friendly
+
+[metrics]
+order = ["train/loss", "val/*"]
+smoothing = 0.6
+
+[keys]
+next_page = "j"
+prev_page = "k"
+`)
+
+	config, err := runview.LoadViewConfig(path)
+
+	require.NoError(t, err)
+	assert.Equal(t, 6, config.ChartsPerPage())
+	assert.Equal(t, "#377eb8", config.Color(3))
+	assert.Equal(t, []string{"train/loss", "val/*"}, config.MetricOrder)
+	assert.Equal(t, 0.6, config.Smoothing)
+	assert.Equal(t, runview.ActionNextPage, config.Action("j"))
+	assert.Equal(t, runview.ActionConsole, config.Action("l"))
+	assert.Equal(t, runview.ActionQuit, config.Action("q"))
+	assert.Equal(t, "", config.Action("n"))
+}
+
+func TestLoadViewConfig_TOMLSyntax(t *testing.T) {
+	path := writeViewConfig(t, `
+[metrics]
+order = [
+  'C:\runs\loss',     # literal strings keep backslashes
+  "val/{acc,loss}",    # commas inside strings
+]
+smoothing = 0
+
+[keys]
+"quit" = 'Q'
+`)
+
+	config, err := runview.LoadViewConfig(path)
+
+	require.NoError(t, err)
+	assert.Equal(t,
+		[]string{`C:\runs\loss`, "val/{acc,loss}"},
+		config.MetricOrder)
+	assert.Equal(t, 0.0, config.Smoothing)
+	assert.Equal(t, runview.ActionQuit, config.Action("Q"))
+}
+
+func TestLoadViewConfig_Missing(t *testing.T) {
+	config, err := runview.LoadViewConfig(
+		filepath.Join(t.TempDir(), "leet.toml"))
+
+	require.NoError(t, err)
+	assert.Equal(t, runview.DefaultViewConfig(), config)
+}
+
+func TestLoadViewConfig_Invalid(t *testing.T) {
+	testCases := []struct{ name, content string }{
+		{"unknown setting", "[grid]\nsize = 3"},
+		{"bad rows", "[grid]\nrows = 0"},
+		{"bad color", "[colors]\npalette = [\"red\"]"},
+		{"bad smoothing", "[metrics]\nsmoothing = 1"},
+		{"unknown action", "[keys]\nfly = \"f\""},
+		{"duplicate key", "[keys]\nquit = \"p\""},
+		{"page key", "[keys]\nquit = \"s\""},
+		{"outside a table", "rows = 2"},
+		{"list of numbers", "[metrics]\norder = [1, 2]"},
+		{"syntax", "[grid\nrows = 2"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config, err := runview.LoadViewConfig(writeViewConfig(t, tc.content))
+
+			assert.Error(t, err)
+			assert.Equal(t, runview.DefaultViewConfig(), config)
+		})
+	}
+}
@@ -0,0 +1,308 @@
+package runview
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/wandb/wandb/core/internal/watcher"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// liveThreshold is how recently a run's .wandb file must have been
+// modified for the run to be considered live.
+//
+// Running runs write system metrics every few seconds, so a run whose file
+// hasn't changed for this long has most likely finished or crashed.
+const liveThreshold = time.Minute
+
+// runDirPattern matches run directories such as
+// "run-20240102_150405-abc123" or "offline-run-20240102_150405-abc123".
+var runDirPattern = regexp.MustCompile(`^(offline-)?run-(\d{8}_\d{6})-(.+)$`)
+
+// RunInfo describes a run in a wandb directory.
+type RunInfo struct {
+	ID string
+
+	// Path is the path to the run's .wandb file.
+	Path string
+
+	Offline   bool
+	StartTime time.Time
+
+	// Modified is when the run's .wandb file was last modified.
+	Modified time.Time
+
+	// Live is whether the run appears to still be running.
+	Live bool
+}
+
+// ScanRuns lists the runs in a wandb directory, most recent first.
+//
+// Directories that aren't runs or don't have a .wandb file are skipped.
+func ScanRuns(wandbDir string) ([]RunInfo, error) {
+	entries, err := os.ReadDir(wandbDir)
+	if err != nil {
+		return nil, fmt.Errorf("runview: failed to list runs: %v", err)
+	}
+
+	var runs []RunInfo
+	for _, entry := range entries {
+		match := runDirPattern.FindStringSubmatch(entry.Name())
+		if !entry.IsDir() || match == nil {
+			continue
+		}
+
+		path := filepath.Join(wandbDir, entry.Name(), fmt.Sprintf("run-%s.wandb", match[3]))
+		info, err := os.Stat(path)
+		if err != nil {
+			continue
+		}
+
+		runs = append(runs, runInfoOf(path, match, info.ModTime()))
+	}
+
+	sort.SliceStable(runs, func(i, j int) bool {
+		return runs[i].StartTime.After(runs[j].StartTime)
+	})
+	return runs, nil
+}
+
+// runInfoOf describes the run whose .wandb file is at path, given the match
+// of runDirPattern against its directory's name.
+func runInfoOf(path string, match []string, modified time.Time) RunInfo {
+	startTime, _ := time.ParseInLocation("20060102_150405", match[2], time.Local)
+	return RunInfo{
+		ID:        match[3],
+		Path:      path,
+		Offline:   match[1] != "",
+		StartTime: startTime,
+		Modified:  modified,
+		Live:      time.Since(modified) < liveThreshold,
+	}
+}
+
+// OpenRun is the data of a run, either opened in a Workspace or read with
+// ReadRun.
+type OpenRun struct {
+	Info          RunInfo
+	Overview      *Overview
+	History       *History
+	SystemMetrics *SystemMetrics
+
+	// read is the number of records read from the run's file.
+	read int
+}
+
+func newOpenRun(info RunInfo) *OpenRun {
+	return &OpenRun{
+		Info:          info,
+		Overview:      NewOverview(),
+		History:       NewHistory(),
+		SystemMetrics: NewSystemMetrics(),
+	}
+}
+
+// ReadRun reads a run's .wandb file, for viewers and tools that show a
+// single run rather than a Workspace.
+//
+// The run's ID and start time are only known if the file is in a run
+// directory.
+func ReadRun(path string) (*OpenRun, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("runview: %v", err)
+	}
+
+	runInfo := RunInfo{Path: path, Modified: info.ModTime()}
+	if match := runDirPattern.FindStringSubmatch(
+		filepath.Base(filepath.Dir(path)),
+	); match != nil {
+		runInfo = runInfoOf(path, match, info.ModTime())
+	}
+
+	run := newOpenRun(runInfo)
+	if err := run.update(); err != nil {
+		return nil, err
+	}
+	return run, nil
+}
+
+func (r *OpenRun) add(record *service.Record) {
+	r.Overview.Add(record)
+	switch x := record.GetRecordType().(type) {
+	case *service.Record_History:
+		r.History.Add(x.History)
+	case *service.Record_Stats:
+		r.SystemMetrics.Add(x.Stats)
+	}
+}
+
+// update reads the records appended to the run's file since the last
+// update.
+func (r *OpenRun) update() error {
+	read, err := readRecords(r.Info.Path, r.read, r.add)
+	r.read = read
+	return err
+}
+
+// Workspace is the runs in a wandb directory, some of which are opened to
+// compare their metrics side by side.
+//
+// A Workspace is not safe for concurrent use. Viewers call Refresh from
+// their event loop, for example after a change reported by Watch.
+type Workspace struct {
+	dir string
+
+	runs   []RunInfo
+	opened []*OpenRun
+
+	watcher  watcher.Watcher
+	onChange func()
+}
+
+func NewWorkspace(wandbDir string) *Workspace {
+	return &Workspace{dir: wandbDir}
+}
+
+// Refresh rescans the wandb directory and reads new records of the
+// opened runs.
+func (w *Workspace) Refresh() error {
+	runs, err := ScanRuns(w.dir)
+	if err != nil {
+		return err
+	}
+	w.runs = runs
+
+	var errs []error
+	for _, run := range w.opened {
+		if i := slices.IndexFunc(runs, func(info RunInfo) bool {
+			return info.ID == run.Info.ID
+		}); i >= 0 {
+			run.Info = runs[i]
+		}
+
+		// A partly written record at the end of a live run's file is
+		// read on a later refresh.
+		if err := run.update(); err != nil && !run.Info.Live {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("runview: failed to read runs: %v", errs)
+	}
+	return nil
+}
+
+// Runs returns the runs found by the last Refresh, most recent first.
+func (w *Workspace) Runs() []RunInfo {
+	return w.runs
+}
+
+// Open reads a run's data so that it can be compared to other opened runs.
+//
+// Opening a run that's already open does nothing.
+func (w *Workspace) Open(id string) error {
+	if w.Run(id) != nil {
+		return nil
+	}
+
+	i := slices.IndexFunc(w.runs, func(info RunInfo) bool { return info.ID == id })
+	if i < 0 {
+		return fmt.Errorf("runview: no run with ID %q", id)
+	}
+
+	run := newOpenRun(w.runs[i])
+	if err := run.update(); err != nil && !run.Info.Live {
+		return err
+	}
+
+	if w.watcher != nil {
+		if err := w.watcher.Watch(run.Info.Path, w.onChange); err != nil {
+			return err
+		}
+	}
+
+	w.opened = append(w.opened, run)
+	return nil
+}
+
+// Close removes a run from the opened runs.
+func (w *Workspace) Close(id string) {
+	w.opened = slices.DeleteFunc(w.opened, func(run *OpenRun) bool {
+		return run.Info.ID == id
+	})
+}
+
+// Run returns an opened run, or nil if the run isn't open.
+func (w *Workspace) Run(id string) *OpenRun {
+	for _, run := range w.opened {
+		if run.Info.ID == id {
+			return run
+		}
+	}
+	return nil
+}
+
+// Opened returns the opened runs in the order they were opened.
+func (w *Workspace) Opened() []*OpenRun {
+	return w.opened
+}
+
+// Metrics returns the history metrics of all opened runs.
+//
+// Metrics are in the order they were first logged, going through the runs
+// in the order they were opened.
+func (w *Workspace) Metrics() []string {
+	var metrics []string
+	seen := make(map[string]struct{})
+
+	for _, run := range w.opened {
+		for _, metric := range run.History.Metrics() {
+			if _, ok := seen[metric]; !ok {
+				seen[metric] = struct{}{}
+				metrics = append(metrics, metric)
+			}
+		}
+	}
+	return metrics
+}
+
+// Compare returns a metric's series in each opened run that logged it,
+// named by the run's ID, for charting the runs side by side.
+func (w *Workspace) Compare(metric string) []*Series {
+	var series []*Series
+	for _, run := range w.opened {
+		if s := run.History.Series(metric); s != nil {
+			series = append(series, &Series{Name: run.Info.ID, Points: s.Points})
+		}
+	}
+	return series
+}
+
+// Watch invokes onChange when a run is created in the wandb directory
+// or an opened run's file changes.
+//
+// onChange runs on the watcher's goroutine and should only notify the
+// viewer, which then calls Refresh.
+func (w *Workspace) Watch(watcher watcher.Watcher, onChange func()) error {
+	w.watcher = watcher
+	w.onChange = onChange
+
+	err := watcher.WatchDir(w.dir, func(string) { onChange() })
+	if err != nil {
+		return fmt.Errorf("runview: failed to watch %s: %v", w.dir, err)
+	}
+
+	for _, run := range w.opened {
+		if err := watcher.Watch(run.Info.Path, onChange); err != nil {
+			return fmt.Errorf("runview: failed to watch %s: %v", run.Info.Path, err)
+		}
+	}
+	return nil
+}
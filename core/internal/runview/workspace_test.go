@@ -0,0 +1,125 @@
+package runview_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/runview"
+	"github.com/wandb/wandb/core/internal/watchertest"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// writeRun creates a run directory with a .wandb file that logs the
+// given loss at each step.
+func writeRun(t *testing.T, wandbDir, dirName, id string, losses ...float64) {
+	t.Helper()
+	dir := filepath.Join(wandbDir, dirName)
+	require.NoError(t, os.MkdirAll(dir, 0755))
+
+	store := server.NewStore(context.Background(),
+		filepath.Join(dir, fmt.Sprintf("run-%s.wandb", id)))
+	require.NoError(t, store.Open(os.O_WRONLY))
+	for step, loss := range losses {
+		require.NoError(t, store.Write(&service.Record{
+			RecordType: &service.Record_History{
+				History: &service.HistoryRecord{
+					Step: &service.HistoryStep{Num: int64(step)},
+					Item: []*service.HistoryItem{
+						{Key: "loss", ValueJson: fmt.Sprint(loss)},
+					},
+				},
+			},
+		}))
+	}
+	require.NoError(t, store.Close())
+}
+
+func TestScanRuns(t *testing.T) {
+	dir := t.TempDir()
+	writeRun(t, dir, "run-20240101_100000-old", "old", 1)
+	writeRun(t, dir, "offline-run-20240102_100000-new", "new", 1)
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "run-20240103_100000-empty"), 0755))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "latest-run"), 0755))
+
+	runs, err := runview.ScanRuns(dir)
+
+	require.NoError(t, err)
+	require.Len(t, runs, 2)
+	assert.Equal(t, "new", runs[0].ID)
+	assert.True(t, runs[0].Offline)
+	assert.True(t, runs[0].Live)
+	assert.Equal(t, "old", runs[1].ID)
+	assert.False(t, runs[1].Offline)
+}
+
+func TestWorkspace_Compare(t *testing.T) {
+	dir := t.TempDir()
+	writeRun(t, dir, "run-20240101_100000-a", "a", 3, 2)
+	writeRun(t, dir, "run-20240102_100000-b", "b", 5)
+	workspace := runview.NewWorkspace(dir)
+	require.NoError(t, workspace.Refresh())
+
+	require.NoError(t, workspace.Open("a"))
+	require.NoError(t, workspace.Open("b"))
+	require.NoError(t, workspace.Open("a"))
+	series := workspace.Compare("loss")
+
+	assert.Equal(t, []string{"loss"}, workspace.Metrics())
+	require.Len(t, series, 2)
+	assert.Equal(t, "a", series[0].Name)
+	assert.Len(t, series[0].Points, 2)
+	assert.Equal(t, "b", series[1].Name)
+
+	workspace.Close("a")
+	assert.Len(t, workspace.Compare("loss"), 1)
+	assert.Error(t, workspace.Open("missing"))
+}
+
+func TestWorkspace_WatchesForNewRuns(t *testing.T) {
+	dir := t.TempDir()
+	writeRun(t, dir, "run-20240101_100000-a", "a", 1)
+	workspace := runview.NewWorkspace(dir)
+	require.NoError(t, workspace.Refresh())
+	require.NoError(t, workspace.Open("a"))
+	watcher := watchertest.NewFakeWatcher()
+	changes := 0
+
+	require.NoError(t, workspace.Watch(watcher, func() { changes++ }))
+	writeRun(t, dir, "run-20240102_100000-b", "b", 1)
+	watcher.OnChange(filepath.Join(dir, "run-20240102_100000-b"))
+	require.NoError(t, workspace.Refresh())
+
+	assert.Equal(t, 1, changes)
+	assert.Len(t, workspace.Runs(), 2)
+	assert.True(t, watcher.IsWatching(workspace.Run("a").Info.Path))
+}
+
+func TestReadRun(t *testing.T) {
+	dir := t.TempDir()
+	writeRun(t, dir, "run-20240101_100000-abc", "abc", 3, 2)
+
+	run, err := runview.ReadRun(
+		filepath.Join(dir, "run-20240101_100000-abc", "run-abc.wandb"))
+
+	require.NoError(t, err)
+	assert.Equal(t, "abc", run.Info.ID)
+	assert.Equal(t, []string{"loss"}, run.History.Metrics())
+	assert.Len(t, run.History.Series("loss").Points, 2)
+}
+
+func TestReadRun_OutsideRunDir(t *testing.T) {
+	dir := t.TempDir()
+	writeRun(t, dir, "copied", "abc", 1)
+
+	run, err := runview.ReadRun(filepath.Join(dir, "copied", "run-abc.wandb"))
+
+	require.NoError(t, err)
+	assert.Empty(t, run.Info.ID)
+	assert.Len(t, run.History.Series("loss").Points, 1)
+}
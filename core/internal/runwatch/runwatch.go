@@ -0,0 +1,213 @@
+// Package runwatch follows the history of a run stored on a W&B server.
+//
+// A Watcher pages through the run's history using the public GraphQL API
+// and then polls for new rows until the run finishes. It is the data
+// source for watching a cloud run from a terminal.
+package runwatch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/wandb/segmentio-encoding/json"
+	"github.com/wandb/wandb/core/internal/gql"
+	"github.com/wandb/wandb/core/internal/waiting"
+)
+
+const (
+	// URIScheme is the scheme of run URIs, as in wandb://entity/project/id.
+	URIScheme = "wandb://"
+
+	// DefaultPageSize is the default number of steps to request at a time.
+	DefaultPageSize = 500
+)
+
+// RunPath identifies a run on the server.
+type RunPath struct {
+	Entity  string
+	Project string
+	RunID   string
+}
+
+func (p RunPath) String() string {
+	return URIScheme + p.Entity + "/" + p.Project + "/" + p.RunID
+}
+
+// ParseRunURI parses a URI of the form wandb://entity/project/run_id.
+func ParseRunURI(uri string) (RunPath, error) {
+	rest, ok := strings.CutPrefix(uri, URIScheme)
+	if !ok {
+		return RunPath{}, fmt.Errorf(
+			"runwatch: %q does not start with %s", uri, URIScheme)
+	}
+
+	parts := strings.Split(strings.TrimSuffix(rest, "/"), "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return RunPath{}, fmt.Errorf(
+			"runwatch: %q is not of the form %sentity/project/run_id",
+			uri, URIScheme)
+	}
+
+	return RunPath{Entity: parts[0], Project: parts[1], RunID: parts[2]}, nil
+}
+
+// Page is a batch of history rows.
+type Page struct {
+	// Rows are history rows ordered by step.
+	Rows []map[string]any
+
+	// State is the run's state on the server, e.g. "running" or "finished".
+	State string
+}
+
+// IsRunning reports whether the run may still log more history.
+func (p *Page) IsRunning() bool {
+	return p.State == "running" || p.State == "pending" || p.State == ""
+}
+
+// historyKeys is the part of a run's historyKeys that's used.
+type historyKeys struct {
+	LastStep *int64 `json:"lastStep"`
+}
+
+// ErrRunNotFound is returned if the server has no such run.
+var ErrRunNotFound = errors.New("runwatch: run not found")
+
+// Watcher fetches a run's history in order.
+type Watcher struct {
+	client   graphql.Client
+	path     RunPath
+	pageSize int64
+
+	// nextStep is the first step that has not been fetched.
+	nextStep int64
+
+	// lastStep is the last step of the run's history as of the last page,
+	// or -1 if the run has no history.
+	lastStep int64
+}
+
+func NewWatcher(client graphql.Client, path RunPath, pageSize int) *Watcher {
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+
+	return &Watcher{
+		client:   client,
+		path:     path,
+		pageSize: int64(pageSize),
+		lastStep: -1,
+	}
+}
+
+// NextPage fetches the rows following the last fetched row.
+//
+// A page covers at most the page size of steps, so it can be empty if
+// the run skipped steps; use CaughtUp to tell whether there are more.
+func (w *Watcher) NextPage(ctx context.Context) (*Page, error) {
+	pageEnd := w.nextStep + w.pageSize
+	data, err := gql.RunHistoryPage(ctx, w.client,
+		w.path.Entity,
+		w.path.Project,
+		w.path.RunID,
+		w.nextStep,
+		pageEnd,
+		int(w.pageSize),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("runwatch: failed to fetch history: %v", err)
+	}
+
+	if data.Project == nil || data.Project.Run == nil {
+		return nil, ErrRunNotFound
+	}
+	run := data.Project.Run
+
+	page := &Page{}
+	if run.State != nil {
+		page.State = *run.State
+	}
+	for _, line := range run.History {
+		row := make(map[string]any)
+		if err := json.Unmarshal([]byte(line), &row); err != nil {
+			return nil, fmt.Errorf("runwatch: invalid history row: %v", err)
+		}
+
+		if step, ok := rowStep(row); ok && step >= w.nextStep {
+			w.nextStep = step + 1
+		}
+		page.Rows = append(page.Rows, row)
+	}
+
+	if len(run.HistoryKeys) > 0 {
+		keys := historyKeys{}
+		if err := json.Unmarshal(run.HistoryKeys, &keys); err != nil {
+			return nil, fmt.Errorf("runwatch: invalid history keys: %v", err)
+		}
+		if keys.LastStep != nil {
+			w.lastStep = *keys.LastStep
+		}
+	}
+
+	// Steps are logged in order, so if the run has logged a step after
+	// the page, every step in the page was fetched even if some were
+	// skipped by the run.
+	if w.lastStep >= pageEnd && w.nextStep < pageEnd {
+		w.nextStep = pageEnd
+	}
+
+	return page, nil
+}
+
+// CaughtUp reports whether every row the run had logged as of the last
+// page has been fetched.
+func (w *Watcher) CaughtUp() bool {
+	return w.nextStep > w.lastStep
+}
+
+// Watch fetches all of the run's history and then polls for new rows
+// until the run stops or the context is cancelled.
+//
+// The onPage callback is invoked for every page, including empty pages
+// when polling, so that it can observe changes to the run's state.
+func (w *Watcher) Watch(
+	ctx context.Context,
+	pollDelay waiting.Delay,
+	onPage func(*Page),
+) error {
+	for {
+		page, err := w.NextPage(ctx)
+		if err != nil {
+			return err
+		}
+		onPage(page)
+
+		if !w.CaughtUp() {
+			continue
+		}
+		if !page.IsRunning() {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-pollDelay.Wait():
+		}
+	}
+}
+
+// rowStep returns the "_step" of a history row.
+func rowStep(row map[string]any) (int64, bool) {
+	switch step := row["_step"].(type) {
+	case float64:
+		return int64(step), true
+	case int64:
+		return step, true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,177 @@
+package runwatch_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/gqlmock"
+	"github.com/wandb/wandb/core/internal/runwatch"
+	"github.com/wandb/wandb/core/internal/waitingtest"
+)
+
+func TestParseRunURI(t *testing.T) {
+	path, err := runwatch.ParseRunURI("wandb://my-team/my-project/abc123")
+	require.NoError(t, err)
+	assert.Equal(t,
+		runwatch.RunPath{Entity: "my-team", Project: "my-project", RunID: "abc123"},
+		path)
+	assert.Equal(t, "wandb://my-team/my-project/abc123", path.String())
+
+	for _, uri := range []string{
+		"my-team/my-project/abc123",
+		"wandb://my-team/abc123",
+		"wandb://my-team//abc123",
+		"wandb://a/b/c/d",
+	} {
+		_, err := runwatch.ParseRunURI(uri)
+		assert.Error(t, err, uri)
+	}
+}
+
+func historyResponse(state string, lastStep int, rows ...string) string {
+	history := "["
+	for i, row := range rows {
+		if i > 0 {
+			history += ","
+		}
+		history += `"` + row + `"`
+	}
+	history += "]"
+
+	return fmt.Sprintf(
+		`{"project": {"run": {"state": %q, "historyKeys": {"lastStep": %d}, "history": %s}}}`,
+		state, lastStep, history)
+}
+
+// assertMinStep asserts that a history request starts at a step.
+func assertMinStep(t *testing.T, step int, req *graphql.Request) {
+	t.Helper()
+	gqlmock.AssertRequest(t,
+		gqlmock.WithVariables(
+			gqlmock.GQLVar("minStep", gomock.Eq(float64(step))),
+		),
+		req)
+}
+
+func TestNextPage_AdvancesStep(t *testing.T) {
+	client := gqlmock.NewMockClient()
+	client.StubMatchOnce(
+		gqlmock.WithOpName("RunHistoryPage"),
+		historyResponse("running", 1,
+			`{\"_step\": 0, \"loss\": 1.5}`,
+			`{\"_step\": 1, \"loss\": 1.2}`),
+	)
+	client.StubAnyOnce(historyResponse("running", 1))
+	watcher := runwatch.NewWatcher(
+		client,
+		runwatch.RunPath{Entity: "e", Project: "p", RunID: "r"},
+		2,
+	)
+
+	page, err := watcher.NextPage(context.Background())
+	require.NoError(t, err)
+	require.Len(t, page.Rows, 2)
+	assert.Equal(t, 1.2, page.Rows[1]["loss"])
+	assert.True(t, page.IsRunning())
+
+	_, err = watcher.NextPage(context.Background())
+	require.NoError(t, err)
+	requests := client.AllRequests()
+	require.Len(t, requests, 2)
+	assertMinStep(t, 2, requests[1])
+}
+
+func TestNextPage_RunNotFound(t *testing.T) {
+	client := gqlmock.NewMockClient()
+	client.StubAnyOnce(`{"project": {"run": null}}`)
+	watcher := runwatch.NewWatcher(client, runwatch.RunPath{}, 0)
+
+	_, err := watcher.NextPage(context.Background())
+
+	assert.ErrorIs(t, err, runwatch.ErrRunNotFound)
+}
+
+func TestWatch_PollsUntilFinished(t *testing.T) {
+	client := gqlmock.NewMockClient()
+	client.StubAnyOnce(historyResponse("running", 2,
+		`{\"_step\": 0}`, `{\"_step\": 1}`))
+	client.StubAnyOnce(historyResponse("running", 2, `{\"_step\": 2}`))
+	client.StubAnyOnce(historyResponse("finished", 3, `{\"_step\": 3}`))
+	watcher := runwatch.NewWatcher(client, runwatch.RunPath{}, 2)
+	delay := waitingtest.NewFakeDelay()
+
+	var rows []map[string]any
+	result := make(chan error)
+	go func() {
+		result <- watcher.Watch(context.Background(), delay,
+			func(page *runwatch.Page) { rows = append(rows, page.Rows...) })
+	}()
+	delay.WaitAndTick(t, false, time.Second)
+
+	select {
+	case err := <-result:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("Watch did not return")
+	}
+	assert.Len(t, rows, 4)
+	assert.True(t, client.AllStubsUsed())
+}
+
+func TestNextPage_SkipsGapsLongerThanPage(t *testing.T) {
+	client := gqlmock.NewMockClient()
+	client.StubAnyOnce(historyResponse("running", 1000, `{\"_step\": 0}`))
+	client.StubAnyOnce(historyResponse("running", 1000))
+	client.StubAnyOnce(historyResponse("running", 1000))
+	watcher := runwatch.NewWatcher(client, runwatch.RunPath{}, 2)
+
+	for i := 0; i < 3; i++ {
+		_, err := watcher.NextPage(context.Background())
+		require.NoError(t, err)
+		assert.False(t, watcher.CaughtUp())
+	}
+
+	requests := client.AllRequests()
+	require.Len(t, requests, 3)
+	assertMinStep(t, 2, requests[1])
+	assertMinStep(t, 4, requests[2])
+}
+
+func TestNextPage_DoesNotSkipStepsNotYetLogged(t *testing.T) {
+	client := gqlmock.NewMockClient()
+	client.StubAnyOnce(historyResponse("running", 0, `{\"_step\": 0}`))
+	client.StubAnyOnce(historyResponse("running", 0))
+	watcher := runwatch.NewWatcher(client, runwatch.RunPath{}, 2)
+
+	for i := 0; i < 2; i++ {
+		_, err := watcher.NextPage(context.Background())
+		require.NoError(t, err)
+		assert.True(t, watcher.CaughtUp())
+	}
+
+	requests := client.AllRequests()
+	require.Len(t, requests, 2)
+	assertMinStep(t, 1, requests[1])
+}
+
+func TestWatch_FetchesAllOfFinishedRunWithGaps(t *testing.T) {
+	client := gqlmock.NewMockClient()
+	client.StubAnyOnce(historyResponse("finished", 5, `{\"_step\": 0}`))
+	client.StubAnyOnce(historyResponse("finished", 5))
+	client.StubAnyOnce(historyResponse("finished", 5, `{\"_step\": 5}`))
+	watcher := runwatch.NewWatcher(client, runwatch.RunPath{}, 2)
+
+	var rows []map[string]any
+	err := watcher.Watch(context.Background(), waitingtest.NewFakeDelay(),
+		func(page *runwatch.Page) { rows = append(rows, page.Rows...) })
+
+	require.NoError(t, err)
+	assert.Len(t, rows, 2)
+	assert.True(t, client.AllStubsUsed())
+}
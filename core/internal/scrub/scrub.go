@@ -0,0 +1,364 @@
+// Package scrub redacts secrets from a run's data before it's written or
+// uploaded.
+//
+// Secrets end up in runs in two ways: as config values under telling
+// keys like "api_key", and as text that looks like a credential, such as
+// a token printed to the console or passed on the command line. The
+// Scrubber handles the first with a deny-list of key patterns and the
+// second with regular expressions.
+//
+// Scrubbing is configured in the environment:
+//
+//	WANDB_SCRUB=true                         # use the built-in rules
+//	WANDB_SCRUB_PATTERNS='sk-[a-z0-9]{32}'   # extra regexps, one per line
+//	WANDB_SCRUB_KEYS='*_password,db_url'     # extra key globs
+//
+// Setting extra patterns or keys also enables the built-in rules.
+package scrub
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// EnableEnv is the environment variable that turns on scrubbing with
+	// the built-in rules.
+	EnableEnv = "WANDB_SCRUB"
+
+	// PatternsEnv is the environment variable with extra regular
+	// expressions, one per line.
+	PatternsEnv = "WANDB_SCRUB_PATTERNS"
+
+	// KeysEnv is the environment variable with extra comma-separated key
+	// globs.
+	KeysEnv = "WANDB_SCRUB_KEYS"
+
+	// Redacted replaces scrubbed text and values.
+	Redacted = "[REDACTED]"
+)
+
+// DefaultPatterns match common kinds of credentials.
+var DefaultPatterns = []string{
+	// AWS access key IDs.
+	`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`,
+	// GitHub tokens.
+	`\bgh[pousr]_[A-Za-z0-9]{36,}\b`,
+	// Slack tokens.
+	`\bxox[abprs]-[A-Za-z0-9-]{10,}`,
+	// Bearer tokens in HTTP headers.
+	`(?i)\bbearer\s+[A-Za-z0-9._~+/-]{16,}=*`,
+	// PEM private keys.
+	`-----BEGIN [A-Z ]*PRIVATE KEY-----[\s\S]*?-----END [A-Z ]*PRIVATE KEY-----`,
+	// Assignments like "password=hunter2" or "API_KEY: abc".
+	`(?i)\b[a-z_]*(?:password|passwd|secret|api_?key|access_?key|auth_?token)[a-z_]*\s*[=:]\s*\S+`,
+}
+
+// DefaultKeys are globs for config keys whose values are always redacted.
+//
+// Globs are matched against each component of a key, lowercased. Tokens
+// are matched by kind, since tokenizer configs have keys like "pad_token".
+var DefaultKeys = []string{
+	"*password*",
+	"*passwd*",
+	"*secret*",
+	"*api_key*",
+	"*apikey*",
+	"*access_key*",
+	"*private_key*",
+	"*credentials*",
+	"token",
+	"*auth_token*",
+	"*access_token*",
+	"*api_token*",
+	"*refresh_token*",
+	"*session_token*",
+	"hf_token",
+}
+
+// Source is the kind of data that was scrubbed, for counting redactions.
+type Source string
+
+const (
+	SourceConfig   Source = "config"
+	SourceConsole  Source = "console"
+	SourceMetadata Source = "metadata"
+)
+
+// Scrubber redacts secrets.
+//
+// It is safe for concurrent use.
+type Scrubber struct {
+	patterns []*regexp.Regexp
+	keys     []string
+
+	mu sync.Mutex
+
+	// redactions counts redactions by source
+	redactions map[Source]int
+}
+
+// New returns a Scrubber for the regular expressions and key globs.
+func New(patterns []string, keys []string) (*Scrubber, error) {
+	s := &Scrubber{redactions: make(map[Source]int)}
+
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("scrub: invalid pattern %q: %v", pattern, err)
+		}
+		s.patterns = append(s.patterns, re)
+	}
+
+	for _, key := range keys {
+		key = strings.ToLower(strings.TrimSpace(key))
+		if _, err := path.Match(key, ""); err != nil {
+			return nil, fmt.Errorf("scrub: invalid key glob %q: %v", key, err)
+		}
+		s.keys = append(s.keys, key)
+	}
+
+	return s, nil
+}
+
+// FromEnv returns the Scrubber configured in the environment, or nil if
+// scrubbing is not enabled.
+func FromEnv() (*Scrubber, error) {
+	var patterns, keys []string
+
+	for _, line := range strings.Split(os.Getenv(PatternsEnv), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			patterns = append(patterns, line)
+		}
+	}
+	for _, key := range strings.Split(os.Getenv(KeysEnv), ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			keys = append(keys, key)
+		}
+	}
+
+	enabled, _ := strconv.ParseBool(os.Getenv(EnableEnv))
+	if !enabled && len(patterns) == 0 && len(keys) == 0 {
+		return nil, nil
+	}
+
+	return New(
+		append(DefaultPatterns[:len(DefaultPatterns):len(DefaultPatterns)], patterns...),
+		append(DefaultKeys[:len(DefaultKeys):len(DefaultKeys)], keys...),
+	)
+}
+
+// Redactions returns the number of redactions made so far by source.
+func (s *Scrubber) Redactions() map[Source]int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	redactions := make(map[Source]int, len(s.redactions))
+	for source, n := range s.redactions {
+		redactions[source] = n
+	}
+	return redactions
+}
+
+func (s *Scrubber) count(source Source, n int) {
+	if n == 0 {
+		return
+	}
+	s.mu.Lock()
+	s.redactions[source] += n
+	s.mu.Unlock()
+}
+
+// Text returns text with the matches of the patterns redacted.
+func (s *Scrubber) Text(source Source, text string) string {
+	text, n := s.text(text)
+	s.count(source, n)
+	return text
+}
+
+func (s *Scrubber) text(text string) (string, int) {
+	n := 0
+	for _, re := range s.patterns {
+		text = re.ReplaceAllStringFunc(text, func(string) string {
+			n++
+			return Redacted
+		})
+	}
+	return text, n
+}
+
+// IsDeniedKey returns whether any component of a key path matches a key
+// glob.
+func (s *Scrubber) IsDeniedKey(keyPath []string) bool {
+	for _, key := range keyPath {
+		key = strings.ToLower(key)
+		for _, glob := range s.keys {
+			if ok, _ := path.Match(glob, key); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// JSON returns the JSON value of the item at keyPath with secrets
+// redacted.
+//
+// The whole value is redacted if its key is denied. Otherwise, values
+// under denied keys in nested objects are redacted, and the patterns are
+// applied to strings. The original JSON is returned if nothing changed.
+//
+// The "_wandb" item, which holds the SDK's own data, is never changed.
+func (s *Scrubber) JSON(source Source, keyPath []string, valueJSON string) string {
+	if len(keyPath) > 0 && keyPath[0] == "_wandb" {
+		return valueJSON
+	}
+
+	if s.IsDeniedKey(keyPath) {
+		s.count(source, 1)
+		return `"` + Redacted + `"`
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(valueJSON))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		// Not ours to fix; let the server reject it.
+		return valueJSON
+	}
+
+	value, n := s.value(value)
+	if n == 0 {
+		return valueJSON
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(value); err != nil {
+		return valueJSON
+	}
+	s.count(source, n)
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// value scrubs a decoded JSON value and returns it with the number of
+// redactions.
+func (s *Scrubber) value(value any) (any, int) {
+	switch value := value.(type) {
+	case string:
+		return s.text(value)
+
+	case []any:
+		total := 0
+		for i, elem := range value {
+			var n int
+			value[i], n = s.value(elem)
+			total += n
+		}
+		return value, total
+
+	case map[string]any:
+		total := 0
+		for key, elem := range value {
+			if s.IsDeniedKey([]string{key}) {
+				value[key] = Redacted
+				total++
+				continue
+			}
+			var n int
+			value[key], n = s.value(elem)
+			total += n
+		}
+		return value, total
+
+	default:
+		return value, 0
+	}
+}
+
+// Message redacts the matches of the patterns in the string fields of a
+// message and of the messages it contains.
+func (s *Scrubber) Message(source Source, msg proto.Message) {
+	s.count(source, s.message(msg.ProtoReflect()))
+}
+
+func (s *Scrubber) message(msg protoreflect.Message) int {
+	total := 0
+
+	// Fields are set after ranging over them, since changing a message
+	// while ranging over it is not allowed.
+	type update struct {
+		field protoreflect.FieldDescriptor
+		value protoreflect.Value
+	}
+	var updates []update
+
+	msg.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		switch {
+		case field.IsList():
+			list := value.List()
+			for i := 0; i < list.Len(); i++ {
+				switch field.Kind() {
+				case protoreflect.StringKind:
+					text, n := s.text(list.Get(i).String())
+					if n > 0 {
+						list.Set(i, protoreflect.ValueOfString(text))
+						total += n
+					}
+				case protoreflect.MessageKind, protoreflect.GroupKind:
+					total += s.message(list.Get(i).Message())
+				}
+			}
+
+		case field.IsMap():
+			m := value.Map()
+			var scrubbed []protoreflect.MapKey
+			var texts []string
+			m.Range(func(key protoreflect.MapKey, elem protoreflect.Value) bool {
+				switch field.MapValue().Kind() {
+				case protoreflect.StringKind:
+					text, n := s.text(elem.String())
+					if n > 0 {
+						scrubbed = append(scrubbed, key)
+						texts = append(texts, text)
+						total += n
+					}
+				case protoreflect.MessageKind, protoreflect.GroupKind:
+					total += s.message(elem.Message())
+				}
+				return true
+			})
+			for i, key := range scrubbed {
+				m.Set(key, protoreflect.ValueOfString(texts[i]))
+			}
+
+		case field.Kind() == protoreflect.StringKind:
+			text, n := s.text(value.String())
+			if n > 0 {
+				updates = append(updates, update{field, protoreflect.ValueOfString(text)})
+				total += n
+			}
+
+		case field.Kind() == protoreflect.MessageKind,
+			field.Kind() == protoreflect.GroupKind:
+			total += s.message(value.Message())
+		}
+		return true
+	})
+
+	for _, u := range updates {
+		msg.Set(u.field, u.value)
+	}
+	return total
+}
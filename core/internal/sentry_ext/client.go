@@ -25,21 +25,37 @@ type Params struct {
 	BeforeSend func(*sentry.Event, *sentry.EventHint) *sentry.Event
 	// LRUSize is the size of the LRU cache
 	LRUSize int
+	// Policy is what may be reported, and whether events are queued
+	// locally instead of sent
+	Policy Policy
 }
 
 type Client struct {
 	// Recent is the cache of recent errors sent to sentry to avoid sending
 	// the same error multiple times
 	Recent *cache
+
+	// policy is what may be reported
+	policy Policy
 }
 
 // New initializes the sentry client.
 //
-// If the DSN is not set, the client is effectively disabled and will not send
-// any errors to sentry.
+// If the DSN is not set, or the policy disables every category of events,
+// the client is effectively disabled and will not send any errors to sentry.
 // If we can't create the cache, we will log an error and return nil.
 func New(params Params) *Client {
 
+	if params.Policy.DisabledAll() {
+		params.DSN = ""
+	}
+
+	var transport sentry.Transport
+	if params.DSN != "" && params.Policy.QueueDir != "" {
+		transport = &queueTransport{dir: params.Policy.QueueDir}
+		slog.Debug("sentry_ext: New: queueing events", "dir", params.Policy.QueueDir)
+	}
+
 	if params.BeforeSend == nil {
 		params.BeforeSend = RemoveBottomFrames
 	}
@@ -51,6 +67,7 @@ func New(params Params) *Client {
 			Dist:             params.Commit,
 			BeforeSend:       params.BeforeSend,
 			Environment:      params.Environment,
+			Transport:        transport,
 		}); err != nil {
 		slog.Error("sentry_ext: New: failed to initialize sentry", "err", err)
 	}
@@ -70,9 +87,15 @@ func New(params Params) *Client {
 	// If the DSN is not set, the client is effectively disabled.
 	return &Client{
 		Recent: cache,
+		policy: params.Policy,
 	}
 }
 
+// allows returns whether events of the category may be reported.
+func (s *Client) allows(category Category) bool {
+	return s != nil && !s.policy.Disabled[category]
+}
+
 // SetUser sets the user information for the sentry client.
 func (s *Client) SetUser(id, email, name string) {
 	sentry.ConfigureScope(func(scope *sentry.Scope) {
@@ -88,7 +111,7 @@ func (s *Client) SetUser(id, email, name string) {
 // Used for capturing errors. The error is sent to sentry as an error level
 // event. The event is enriched with the tags provided.
 func (s *Client) CaptureException(err error, tags map[string]string) {
-	if !s.Recent.shouldCapture(err) {
+	if !s.allows(CategoryErrors) || !s.Recent.shouldCapture(err) {
 		return
 	}
 
@@ -106,6 +129,22 @@ func (s *Client) CaptureException(err error, tags map[string]string) {
 // Used for capturing non-error messages. The message is sent to sentry as an
 // info level event. The event is enriched with the tags provided.
 func (s *Client) CaptureMessage(msg string, tags map[string]string) {
+	if !s.allows(CategoryErrors) {
+		return
+	}
+	s.captureMessage(msg, tags)
+}
+
+// CaptureUsage sends a usage ping or analytics event to sentry, unless its
+// category is disabled.
+func (s *Client) CaptureUsage(category Category, msg string, tags map[string]string) {
+	if !s.allows(category) {
+		return
+	}
+	s.captureMessage(msg, tags)
+}
+
+func (s *Client) captureMessage(msg string, tags map[string]string) {
 	if !s.Recent.shouldCapture(errors.New(msg)) {
 		return
 	}
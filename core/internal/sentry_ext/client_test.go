@@ -2,7 +2,9 @@ package sentry_ext_test
 
 import (
 	"errors"
+	"os"
 	"testing"
+	"time"
 
 	"github.com/getsentry/sentry-go"
 	"github.com/stretchr/testify/assert"
@@ -209,3 +211,65 @@ func TestRemoveBottomFrames(t *testing.T) {
 	actualFrames := modifiedEvent.Exception[0].Stacktrace.Frames
 	assert.Equal(t, expectedFrames, actualFrames, "The bottom-most sentry.go and logging.go frames should be removed")
 }
+
+func TestPolicyFromEnv(t *testing.T) {
+	t.Setenv(sentry_ext.ErrorReportingEnv, "")
+	t.Setenv(sentry_ext.DisableEnv, "usage, TUI")
+	t.Setenv(sentry_ext.QueueDirEnv, "queue")
+
+	policy := sentry_ext.PolicyFromEnv()
+
+	assert.False(t, policy.Disabled[sentry_ext.CategoryErrors])
+	assert.True(t, policy.Disabled[sentry_ext.CategoryUsage])
+	assert.True(t, policy.Disabled[sentry_ext.CategoryTUI])
+	assert.False(t, policy.DisabledAll())
+	assert.Equal(t, "queue", policy.QueueDir)
+
+	t.Setenv(sentry_ext.ErrorReportingEnv, "false")
+	assert.True(t, sentry_ext.PolicyFromEnv().DisabledAll())
+}
+
+func TestSentryClient_DisabledCategory(t *testing.T) {
+	sc := sentry_ext.New(sentry_ext.Params{
+		Policy: sentry_ext.Policy{
+			Disabled: map[sentry_ext.Category]bool{
+				sentry_ext.CategoryErrors: true,
+				sentry_ext.CategoryTUI:    true,
+			},
+		},
+	})
+
+	sc.CaptureException(errors.New("error"), nil)
+	sc.CaptureMessage("warning", nil)
+	sc.CaptureUsage(sentry_ext.CategoryTUI, "tui", nil)
+	assert.Equal(t, 0, sc.Recent.Len())
+
+	sc.CaptureUsage(sentry_ext.CategoryUsage, "usage", nil)
+	assert.Equal(t, 1, sc.Recent.Len())
+}
+
+func TestSentryClient_Queue(t *testing.T) {
+	dir := t.TempDir()
+	sc := sentry_ext.New(sentry_ext.Params{
+		DSN:    "https://key@o1.ingest.sentry.io/1",
+		Policy: sentry_ext.Policy{QueueDir: dir},
+	})
+
+	sc.CaptureException(errors.New("queued error"), map[string]string{"tag": "x"})
+	sc.Flush(time.Second)
+
+	paths, err := sentry_ext.QueuedEvents(dir)
+	assert.NoError(t, err)
+	if assert.Len(t, paths, 1) {
+		data, err := os.ReadFile(paths[0])
+		assert.NoError(t, err)
+		assert.Contains(t, string(data), "queued error")
+	}
+
+	// Sending to a DSN that's not set only empties the queue.
+	sent, err := sentry_ext.SendQueued(dir, "")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, sent)
+	paths, _ = sentry_ext.QueuedEvents(dir)
+	assert.Empty(t, paths)
+}
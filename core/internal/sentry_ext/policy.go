@@ -0,0 +1,75 @@
+package sentry_ext
+
+import (
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Category is a kind of event reported to Sentry, which can be turned off
+// on its own.
+type Category string
+
+const (
+	// CategoryErrors are errors, warnings and crashes.
+	CategoryErrors Category = "errors"
+
+	// CategoryUsage are pings recording that a binary was used.
+	CategoryUsage Category = "usage"
+
+	// CategoryTUI are analytics from terminal UIs.
+	CategoryTUI Category = "tui"
+)
+
+const (
+	// ErrorReportingEnv turns off all reporting when false, like in the
+	// Python SDK.
+	ErrorReportingEnv = "WANDB_ERROR_REPORTING"
+
+	// DisableEnv is a comma-separated list of categories not to report.
+	DisableEnv = "WANDB_TELEMETRY_DISABLE"
+
+	// QueueDirEnv is a directory to store events in instead of sending
+	// them; see SendQueued.
+	QueueDirEnv = "WANDB_TELEMETRY_QUEUE_DIR"
+)
+
+// Policy is what may be reported to Sentry.
+type Policy struct {
+	// Disabled are the categories of events that are dropped.
+	Disabled map[Category]bool
+
+	// QueueDir, if set, is where events are stored instead of being sent.
+	//
+	// Stored events are only sent by SendQueued.
+	QueueDir string
+}
+
+// PolicyFromEnv returns the policy configured in the environment.
+func PolicyFromEnv() Policy {
+	policy := Policy{
+		Disabled: make(map[Category]bool),
+		QueueDir: os.Getenv(QueueDirEnv),
+	}
+
+	if enabled, err := strconv.ParseBool(os.Getenv(ErrorReportingEnv)); err == nil && !enabled {
+		for _, category := range []Category{CategoryErrors, CategoryUsage, CategoryTUI} {
+			policy.Disabled[category] = true
+		}
+	}
+
+	for _, category := range strings.Split(os.Getenv(DisableEnv), ",") {
+		if category = strings.TrimSpace(category); category != "" {
+			policy.Disabled[Category(strings.ToLower(category))] = true
+		}
+	}
+
+	return policy
+}
+
+// DisabledAll returns whether no category of events is reported.
+func (p Policy) DisabledAll() bool {
+	return p.Disabled[CategoryErrors] &&
+		p.Disabled[CategoryUsage] &&
+		p.Disabled[CategoryTUI]
+}
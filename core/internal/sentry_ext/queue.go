@@ -0,0 +1,110 @@
+package sentry_ext
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+)
+
+// maxQueuedEvents is how many events are kept in a queue directory; newer
+// events are dropped once it's full.
+const maxQueuedEvents = 1000
+
+// queueTransport stores events in a directory instead of sending them.
+type queueTransport struct {
+	dir string
+}
+
+func (t *queueTransport) Configure(sentry.ClientOptions) {}
+
+func (t *queueTransport) Flush(time.Duration) bool { return true }
+
+func (t *queueTransport) SendEvent(event *sentry.Event) {
+	if err := os.MkdirAll(t.dir, 0o700); err != nil {
+		slog.Error("sentry_ext: failed to create queue directory", "err", err)
+		return
+	}
+
+	if paths, _ := QueuedEvents(t.dir); len(paths) >= maxQueuedEvents {
+		slog.Warn("sentry_ext: queue is full, dropping event", "dir", t.dir)
+		return
+	}
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		slog.Error("sentry_ext: failed to encode event", "err", err)
+		return
+	}
+
+	name := fmt.Sprintf("%s-%s.json",
+		time.Now().UTC().Format("20060102T150405.000000000"),
+		event.EventID)
+	if err := os.WriteFile(filepath.Join(t.dir, name), data, 0o600); err != nil {
+		slog.Error("sentry_ext: failed to queue event", "err", err)
+	}
+}
+
+// QueuedEvents returns the paths of the events queued in a directory,
+// oldest first.
+func QueuedEvents(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var paths []string
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), ".json") {
+			paths = append(paths, filepath.Join(dir, entry.Name()))
+		}
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+// SendQueued sends the events queued in a directory to Sentry and deletes
+// them, returning how many were sent.
+//
+// Delivery is best-effort: an event that Sentry rejects is not retried.
+func SendQueued(dir string, dsn string) (int, error) {
+	paths, err := QueuedEvents(dir)
+	if err != nil {
+		return 0, err
+	}
+
+	transport := sentry.NewHTTPSyncTransport()
+	transport.Configure(sentry.ClientOptions{Dsn: dsn})
+
+	sent := 0
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return sent, err
+		}
+
+		var event sentry.Event
+		if err := json.Unmarshal(data, &event); err != nil {
+			slog.Warn("sentry_ext: dropping unreadable queued event",
+				"path", path, "err", err)
+		} else {
+			transport.SendEvent(&event)
+			sent++
+		}
+
+		if err := os.Remove(path); err != nil {
+			return sent, err
+		}
+	}
+
+	return sent, nil
+}
@@ -0,0 +1,174 @@
+// Package servercaps finds out which features the W&B server supports.
+//
+// wandb-core is often newer than the self-hosted server it talks to. When
+// it uses an endpoint or a record type such a server doesn't understand,
+// uploads fail in ways that are hard to trace back to the version
+// mismatch. Instead, the server is asked what it supports when a run
+// starts, and features it lacks are turned off or reported up front.
+package servercaps
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/wandb/wandb/core/internal/gql"
+)
+
+// Feature is a capability of wandb-core that needs server support.
+//
+// Only features that servers actually report, by a flag or by the version
+// that shipped them, belong here: a server that doesn't list a feature and
+// doesn't report its version, such as the W&B cloud, is assumed to
+// support it.
+type Feature struct {
+	// Name describes the feature to users.
+	Name string
+
+	// Flag is the feature's name in the server's feature list.
+	Flag string
+
+	// MinVersion is the oldest server version that supports the feature,
+	// used when the server doesn't list it as a flag.
+	MinVersion string
+}
+
+var (
+	// ServerSideSummary is the server computing the summaries of defined
+	// metrics.
+	ServerSideSummary = Feature{
+		Name:       "server-side summaries",
+		Flag:       "SERVER_SIDE_SUMMARY",
+		MinVersion: "0.62.0",
+	}
+
+	// SharedRuns is several processes logging to the same run through the
+	// asynchronous filestream.
+	SharedRuns = Feature{
+		Name:       "shared runs",
+		Flag:       "ASYNC_FILESTREAM",
+		MinVersion: "0.58.0",
+	}
+)
+
+// Capabilities are what a server supports.
+//
+// A nil *Capabilities supports everything; it's used when the server
+// can't be asked.
+type Capabilities struct {
+	// Version is the server's version, or empty if it doesn't report one,
+	// as for the W&B cloud, which is always up to date.
+	Version string
+
+	// flags are the server's feature flags and whether each is enabled,
+	// or nil if the server doesn't list them
+	flags map[string]bool
+}
+
+// Supports returns whether the server supports a feature.
+//
+// A feature the server lists is supported if it's enabled. Otherwise,
+// it's supported if the server's version is recent enough or unknown.
+func (c *Capabilities) Supports(feature Feature) bool {
+	if c == nil {
+		return true
+	}
+	if enabled, ok := c.flags[feature.Flag]; ok {
+		return enabled
+	}
+	if c.Version == "" || feature.MinVersion == "" {
+		return true
+	}
+	return compareVersions(c.Version, feature.MinVersion) >= 0
+}
+
+// Describe returns a name for the server in messages, like "W&B server
+// 0.60.0".
+func (c *Capabilities) Describe() string {
+	if c == nil || c.Version == "" {
+		return "the W&B server"
+	}
+	return "W&B server " + c.Version
+}
+
+// Fetch asks the server what it supports.
+//
+// The server's schema is checked first, since asking older servers for
+// their feature list is an error.
+func Fetch(ctx context.Context, client graphql.Client) (*Capabilities, error) {
+	fields, err := gql.ServerInfoFields(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("servercaps: failed to inspect schema: %v", err)
+	}
+
+	hasFeatures := false
+	if fields.Type != nil {
+		for _, field := range fields.Type.Fields {
+			if field.Name == "features" {
+				hasFeatures = true
+			}
+		}
+	}
+
+	caps := &Capabilities{}
+	if !hasFeatures {
+		data, err := gql.ServerCapabilities(ctx, client)
+		if err != nil {
+			return nil, fmt.Errorf("servercaps: failed to fetch server info: %v", err)
+		}
+		if info := data.ServerInfo; info != nil && info.LatestLocalVersionInfo != nil {
+			caps.Version = info.LatestLocalVersionInfo.VersionOnThisInstanceString
+		}
+		return caps, nil
+	}
+
+	data, err := gql.ServerCapabilitiesWithFeatures(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("servercaps: failed to fetch server info: %v", err)
+	}
+	if info := data.ServerInfo; info != nil {
+		if info.LatestLocalVersionInfo != nil {
+			caps.Version = info.LatestLocalVersionInfo.VersionOnThisInstanceString
+		}
+		caps.flags = make(map[string]bool, len(info.Features))
+		for _, feature := range info.Features {
+			if feature != nil {
+				caps.flags[feature.Name] = feature.IsEnabled
+			}
+		}
+	}
+	return caps, nil
+}
+
+// compareVersions compares dotted versions like "0.63.1" numerically,
+// ignoring suffixes like "-rc1".
+func compareVersions(a, b string) int {
+	as := strings.Split(a, ".")
+	bs := strings.Split(b, ".")
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		an, bn := versionPart(as, i), versionPart(bs, i)
+		switch {
+		case an < bn:
+			return -1
+		case an > bn:
+			return 1
+		}
+	}
+	return 0
+}
+
+func versionPart(parts []string, i int) int {
+	if i >= len(parts) {
+		return 0
+	}
+	digits := strings.TrimLeft(parts[i], "v")
+	if end := strings.IndexFunc(digits, func(r rune) bool {
+		return r < '0' || r > '9'
+	}); end >= 0 {
+		digits = digits[:end]
+	}
+	n, _ := strconv.Atoi(digits)
+	return n
+}
@@ -0,0 +1,81 @@
+package servercaps_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/gqlmock"
+	"github.com/wandb/wandb/core/internal/servercaps"
+)
+
+func TestFetch_Features(t *testing.T) {
+	client := gqlmock.NewMockClient()
+	client.StubMatchOnce(
+		gqlmock.WithOpName("ServerInfoFields"),
+		`{"__type": {"fields": [{"name": "features"}]}}`,
+	)
+	client.StubMatchOnce(
+		gqlmock.WithOpName("ServerCapabilitiesWithFeatures"),
+		`{"serverInfo": {
+			"latestLocalVersionInfo": {"versionOnThisInstanceString": "0.50.0"},
+			"features": [
+				{"name": "SERVER_SIDE_SUMMARY", "isEnabled": false},
+				{"name": "ASYNC_FILESTREAM", "isEnabled": true}
+			]
+		}}`,
+	)
+
+	caps, err := servercaps.Fetch(context.Background(), client)
+
+	require.NoError(t, err)
+	assert.Equal(t, "0.50.0", caps.Version)
+	assert.False(t, caps.Supports(servercaps.ServerSideSummary))
+	assert.True(t, caps.Supports(servercaps.SharedRuns), "listed, though too old")
+	assert.Contains(t, client.AllRequests()[1].Query, "features")
+}
+
+func TestFetch_OldServer(t *testing.T) {
+	client := gqlmock.NewMockClient()
+	client.StubMatchOnce(
+		gqlmock.WithOpName("ServerInfoFields"),
+		`{"__type": {"fields": [{"name": "latestLocalVersionInfo"}]}}`,
+	)
+	client.StubMatchOnce(
+		gqlmock.WithOpName("ServerCapabilities"),
+		`{"serverInfo": {
+			"latestLocalVersionInfo": {"versionOnThisInstanceString": "0.60.1-rc1"}
+		}}`,
+	)
+
+	caps, err := servercaps.Fetch(context.Background(), client)
+
+	require.NoError(t, err)
+	assert.NotContains(t, client.AllRequests()[1].Query, "features")
+	assert.False(t, caps.Supports(servercaps.ServerSideSummary))
+	assert.True(t, caps.Supports(servercaps.SharedRuns))
+	assert.Equal(t, "W&B server 0.60.1-rc1", caps.Describe())
+}
+
+func TestFetch_Cloud(t *testing.T) {
+	client := gqlmock.NewMockClient()
+	client.StubMatchOnce(gqlmock.WithOpName("ServerInfoFields"), `{"__type": null}`)
+	client.StubMatchOnce(
+		gqlmock.WithOpName("ServerCapabilities"),
+		`{"serverInfo": {"latestLocalVersionInfo": null}}`,
+	)
+
+	caps, err := servercaps.Fetch(context.Background(), client)
+
+	require.NoError(t, err)
+	assert.Empty(t, caps.Version)
+	assert.True(t, caps.Supports(servercaps.ServerSideSummary))
+}
+
+func TestSupports_Nil(t *testing.T) {
+	var caps *servercaps.Capabilities
+
+	assert.True(t, caps.Supports(servercaps.ServerSideSummary))
+	assert.Equal(t, "the W&B server", caps.Describe())
+}
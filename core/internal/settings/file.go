@@ -0,0 +1,55 @@
+package settings
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// settingsFileSection is the section of a settings file with the settings.
+const settingsFileSection = "default"
+
+// ReadSettingsFile reads the settings in a settings file, such as the
+// workspace's wandb/settings file.
+//
+// Settings files are INI files, as written by the Python SDK's
+// configparser. Only the "default" section is read; keys are lowercased
+// and values are returned as written.
+func ReadSettingsFile(path string) (map[string]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	values := make(map[string]string)
+	section := ""
+	scanner := bufio.NewScanner(file)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+
+		switch {
+		case line == "", strings.HasPrefix(line, "#"), strings.HasPrefix(line, ";"):
+			continue
+		case strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]"):
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			continue
+		case section != settingsFileSection:
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			key, value, ok = strings.Cut(line, ":")
+		}
+		if !ok {
+			return nil, fmt.Errorf("settings: %s:%d: expected key = value", path, lineNum)
+		}
+		values[strings.ToLower(strings.TrimSpace(key))] = strings.TrimSpace(value)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
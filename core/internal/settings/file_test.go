@@ -0,0 +1,44 @@
+package settings_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/settings"
+)
+
+func TestReadSettingsFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "settings")
+	require.NoError(t, os.WriteFile(path, []byte(`
+# comment
+[other]
+console = wrap
+
+[default]
+; another comment
+Console = off
+_stats_sample_rate_seconds: 30
+base_url = https://example.com/a=b
+`), 0o644))
+
+	values, err := settings.ReadSettingsFile(path)
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"console":                    "off",
+		"_stats_sample_rate_seconds": "30",
+		"base_url":                   "https://example.com/a=b",
+	}, values)
+}
+
+func TestReadSettingsFile_Invalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "settings")
+	require.NoError(t, os.WriteFile(path, []byte("[default]\nconsole\n"), 0o644))
+
+	_, err := settings.ReadSettingsFile(path)
+
+	assert.ErrorContains(t, err, ":2: expected key = value")
+}
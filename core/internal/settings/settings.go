@@ -4,10 +4,12 @@ package settings
 import (
 	"fmt"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/wandb/wandb/core/internal/auth"
 	"github.com/wandb/wandb/core/pkg/service"
+	"github.com/wandb/wandb/core/pkg/utils"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
@@ -73,6 +75,17 @@ func (s *Settings) GetRunID() string {
 	return s.Proto.RunId.GetValue()
 }
 
+// Derives the run ID from the idempotency key if the client didn't set
+// one, like the Python SDK does, so that retried jobs get the same run.
+func (s *Settings) DeriveRunID() {
+	key := s.Proto.IdempotencyKey.GetValue()
+	if s.Proto.RunId.GetValue() != "" || key == "" {
+		return
+	}
+	s.Proto.RunId = wrapperspb.String(
+		utils.IdempotentID(key, utils.IdempotentIDLength))
+}
+
 // The W&B URL where the run can be viewed.
 func (s *Settings) GetRunURL() string {
 	return s.Proto.RunUrl.GetValue()
@@ -128,6 +141,11 @@ func (s *Settings) GetFilesDir() string {
 	return s.Proto.FilesDir.GetValue()
 }
 
+// The run's transaction log.
+func (s *Settings) GetSyncFile() string {
+	return s.Proto.SyncFile.GetValue()
+}
+
 // Unix glob patterns relative to `files_dir` to not upload.
 func (s *Settings) GetIgnoreGlobs() []string {
 	return s.Proto.IgnoreGlobs.GetValue()
@@ -142,3 +160,221 @@ func (s *Settings) GetHTTPProxy() string {
 func (s *Settings) GetHTTPSProxy() string {
 	return s.Proto.HttpsProxy.GetValue()
 }
+
+// Command used to sign requests to W&B, if any.
+func (s *Settings) GetRequestSigningCommand() []string {
+	return s.Proto.XRequestSigningCommand.GetValue()
+}
+
+// Whether to save console output as structured JSON lines.
+func (s *Settings) IsConsoleStructured() bool {
+	return s.Proto.XConsoleStructured.GetValue()
+}
+
+// Whether to collapse identical consecutive console lines.
+func (s *Settings) IsConsoleDedupeLines() bool {
+	return s.Proto.XConsoleDedupeLines.GetValue()
+}
+
+// Maximum bytes of console logs to upload per second, or 0 if unlimited.
+func (s *Settings) GetConsoleUploadBytesPerSecond() int {
+	return int(s.Proto.XConsoleUploadBytesPerSecond.GetValue())
+}
+
+// The transport to use for filestream uploads, "http" or "grpc".
+func (s *Settings) GetFileStreamTransport() string {
+	return s.Proto.XFileStreamTransport.GetValue()
+}
+
+// Aggregation policy names by metric name glob for history aggregation.
+func (s *Settings) GetHistoryAggregationPolicies() map[string]string {
+	return s.Proto.XHistoryAggregation.GetValue()
+}
+
+// Maximum history rows per second to upload, or 0 if unlimited.
+func (s *Settings) GetHistoryAggregationMaxRate() float64 {
+	return s.Proto.XHistoryAggregationMaxRate.GetValue()
+}
+
+// Whether to write unaggregated history to the transaction log.
+func (s *Settings) IsHistoryAggregationKeepRaw() bool {
+	return s.Proto.XHistoryAggregationKeepRaw.GetValue()
+}
+
+// Whether to skip fetching the organization's default settings.
+func (s *Settings) IsDisableOrgDefaults() bool {
+	return s.Proto.XDisableOrgDefaults.GetValue()
+}
+
+// Whether to write a sidecar index of the transaction log.
+func (s *Settings) IsStoreIndex() bool {
+	return s.Proto.XStoreIndex.GetValue()
+}
+
+// How to compress the transaction log, or empty for no compression.
+func (s *Settings) GetStoreCompression() string {
+	return s.Proto.XStoreCompression.GetValue()
+}
+
+// Hosts to reach without the custom proxies, like NO_PROXY.
+func (s *Settings) GetNoProxy() string {
+	return s.Proto.NoProxy.GetValue()
+}
+
+// PEM file with extra root certificates to trust for W&B traffic.
+func (s *Settings) GetCACertFile() string {
+	return s.Proto.CaCertFile.GetValue()
+}
+
+// PEM file with the client certificate for mutual TLS.
+func (s *Settings) GetClientCertFile() string {
+	return s.Proto.ClientCertFile.GetValue()
+}
+
+// PEM file with the private key of the client certificate.
+func (s *Settings) GetClientKeyFile() string {
+	return s.Proto.ClientKeyFile.GetValue()
+}
+
+// Maximum number of records a stream can have waiting to be processed,
+// or zero for the default.
+func (s *Settings) GetStreamMaxQueuedRecords() int {
+	return int(s.Proto.XStreamMaxQueuedRecords.GetValue())
+}
+
+// Maximum total size of the records a stream can have waiting to be
+// processed, or zero for no limit.
+func (s *Settings) GetStreamMaxQueuedBytes() int64 {
+	return int64(s.Proto.XStreamMaxQueuedMb.GetValue()) * 1024 * 1024
+}
+
+// Maximum total size of the records a stream keeps in memory while they
+// wait to be sent, or zero for the default.
+func (s *Settings) GetStreamMaxBufferedBytes() int64 {
+	return int64(s.Proto.XStreamMaxBufferedMb.GetValue()) * 1024 * 1024
+}
+
+// Directory of tfevents files to log to the run, or empty for none.
+func (s *Settings) GetSyncTensorBoardDir() string {
+	return s.Proto.SyncTensorboardDir.GetValue()
+}
+
+// OTLP/HTTP endpoint to export traces of the core's work to, or empty.
+func (s *Settings) GetOTelEndpoint() string {
+	return s.Proto.XOtelEndpoint.GetValue()
+}
+
+// How long to reuse the responses of GraphQL queries that rarely change,
+// or zero to not cache them.
+func (s *Settings) GetGraphQLCacheTTL() time.Duration {
+	return time.Duration(s.Proto.XGraphqlCacheTtlSeconds.GetValue() * float64(time.Second))
+}
+
+// Whether to send GraphQL query hashes in place of the query text.
+func (s *Settings) IsGraphQLPersistedQueries() bool {
+	return s.Proto.XGraphqlPersistedQueries.GetValue()
+}
+
+// Level of the core's debug log, like "debug", or empty for the default.
+func (s *Settings) GetCoreLogLevel() string {
+	return s.Proto.XCoreLogLevel.GetValue()
+}
+
+// Paths of the user's global settings file and the workspace settings
+// file, which are read in that order.
+func (s *Settings) GetSettingsFiles() []string {
+	var files []string
+	for _, path := range []string{
+		s.Proto.SettingsSystem.GetValue(),
+		s.Proto.SettingsWorkspace.GetValue(),
+	} {
+		if path != "" {
+			files = append(files, path)
+		}
+	}
+	return files
+}
+
+// Whether to log runs locally when there is no API key, deferring
+// authentication until they are synced.
+func (s *Settings) IsDeferAuth() bool {
+	return s.Proto.XDeferAuth.GetValue()
+}
+
+// Switches to offline mode, in which the run is only written locally.
+func (s *Settings) SetOffline() {
+	s.Proto.XOffline = wrapperspb.Bool(true)
+	s.Proto.Mode = wrapperspb.String("offline")
+}
+
+// Maximum total upload rate of files in bytes per second, or 0 if
+// unlimited.
+func (s *Settings) GetUploadBandwidthLimit() int64 {
+	return s.Proto.XUploadBandwidthLimit.GetValue()
+}
+
+// Daily period in which large files are uploaded, like "00:00-06:00", or
+// empty if they're uploaded at any time.
+func (s *Settings) GetLargeUploadWindow() string {
+	return s.Proto.XLargeUploadWindow.GetValue()
+}
+
+// Rank of this node when several nodes log to the same run, and whether
+// the run is shared by several nodes.
+func (s *Settings) GetNodeRank() (int32, bool) {
+	if !s.Proto.XShared.GetValue() || s.Proto.XNodeRank == nil {
+		return 0, false
+	}
+	return s.Proto.XNodeRank.GetValue(), true
+}
+
+// Command to run when the job scheduler preempts the run, or nil.
+func (s *Settings) GetPreemptionHook() []string {
+	return strings.Fields(s.Proto.XPreemptionHook.GetValue())
+}
+
+// Time to save the run when the job scheduler preempts it, or 0 if not set.
+func (s *Settings) GetPreemptionGrace() time.Duration {
+	return time.Duration(s.Proto.XPreemptionGraceSeconds.GetValue() * float64(time.Second))
+}
+
+// Whether the server computes the min, max and mean summaries of defined
+// metrics, so that wandb-core only records their latest values.
+func (s *Settings) IsServerSideSummary() bool {
+	return s.Proto.XServerSideSummary.GetValue()
+}
+
+// The S3-compatible service to download s3:// references from, or empty
+// for AWS.
+func (s *Settings) GetS3EndpointURL() string {
+	return s.Proto.XS3EndpointUrl.GetValue()
+}
+
+// Whether the bucket of s3:// references goes in the URL path rather than
+// in the host name.
+func (s *Settings) IsS3PathStyle() bool {
+	return s.Proto.XS3PathStyle.GetValue()
+}
+
+// Whether to skip verifying the TLS certificate of the S3 endpoint.
+func (s *Settings) IsS3InsecureTLS() bool {
+	return s.Proto.XS3InsecureTls.GetValue()
+}
+
+// The audience of the workload identity pool provider for gs:// references,
+// or empty to use the one in the credentials file.
+func (s *Settings) GetGCSAudience() string {
+	return s.Proto.XGcsAudience.GetValue()
+}
+
+// The OAuth scopes of the tokens for gs:// references, or nil for the
+// default.
+func (s *Settings) GetGCSScopes() []string {
+	return s.Proto.XGcsScopes.GetValue()
+}
+
+// The email of the service account to impersonate for gs:// references,
+// or empty.
+func (s *Settings) GetGCSImpersonateServiceAccount() string {
+	return s.Proto.XGcsImpersonateServiceAccount.GetValue()
+}
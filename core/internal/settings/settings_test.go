@@ -0,0 +1,31 @@
+package settings_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wandb/wandb/core/internal/settings"
+	"github.com/wandb/wandb/core/pkg/service"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestDeriveRunID(t *testing.T) {
+	s := settings.From(&service.Settings{
+		IdempotencyKey: wrapperspb.String("slurm-1234"),
+	})
+
+	s.DeriveRunID()
+
+	assert.Equal(t, "zq12n8r9wtc0", s.GetRunID())
+}
+
+func TestDeriveRunID_KeepsRunID(t *testing.T) {
+	s := settings.From(&service.Settings{
+		RunId:          wrapperspb.String("chosen"),
+		IdempotencyKey: wrapperspb.String("slurm-1234"),
+	})
+
+	s.DeriveRunID()
+
+	assert.Equal(t, "chosen", s.GetRunID())
+}
@@ -45,37 +45,91 @@ func tensorFieldToHistogramJSON[T numeric](
 	directField []T,
 	byteCount int,
 ) (string, error) {
-	if len(proto.TensorContent) > 0 {
-		if len(proto.TensorContent)%byteCount != 0 {
-			return "", fmt.Errorf(
-				"tensor content has %d bytes, which is not a multiple of %d",
-				len(proto.TensorContent),
-				byteCount)
-		}
+	data, err := tensorFieldData(proto, directField, byteCount)
+	if err != nil {
+		return "", err
+	}
 
-		data := make([]T, len(proto.TensorContent)/byteCount)
-
-		// This might be a bug in TensorBoard, but its Python implementation
-		// reads data using the NumPy `frombuffer` function and a dtype
-		// without an explicit byte order, so the tensor content is
-		// interpreted with the native byte order.
-		//
-		// It's not clear what byte order is used to serialize tensors in C
-		// and C++, but it's possible it's the native byte order.
-		//
-		// tesnor_content conversion: https://github.com/tensorflow/tensorboard/blob/ae7d0b9250f5986dd0f0c238fcaf3c8d7f4312ca/tensorboard/util/tensor_util.py#L513-L523
-		if err := binary.Read(
-			bytes.NewBuffer(proto.TensorContent),
-			binary.NativeEndian,
-			&data,
-		); err != nil {
-			return "", err
-		}
+	return numbersToHistogramJSON(data)
+}
+
+// tensorToFloats returns the values of a numeric tensor in row-major order.
+func tensorToFloats(proto *tbproto.TensorProto) ([]float64, error) {
+	switch proto.Dtype {
+	case tbproto.DataType_DT_FLOAT:
+		return tensorFieldFloats(proto, proto.FloatVal, 4)
+
+	case tbproto.DataType_DT_DOUBLE:
+		return tensorFieldFloats(proto, proto.DoubleVal, 8)
+
+	case tbproto.DataType_DT_INT32:
+		return tensorFieldFloats(proto, proto.IntVal, 4)
+
+	case tbproto.DataType_DT_INT64:
+		return tensorFieldFloats(proto, proto.Int64Val, 8)
+	}
+
+	return nil, fmt.Errorf("unsupported tensor dtype: %v", proto.Dtype)
+}
+
+func tensorFieldFloats[T numeric](
+	proto *tbproto.TensorProto,
+	directField []T,
+	byteCount int,
+) ([]float64, error) {
+	data, err := tensorFieldData(proto, directField, byteCount)
+	if err != nil {
+		return nil, err
+	}
+
+	floats := make([]float64, len(data))
+	for i, x := range data {
+		floats[i] = float64(x)
+	}
+	return floats, nil
+}
+
+// tensorFieldData returns the values stored in either the `tensor_content`
+// field of a tensor proto or a type-specific field.
+//
+// `directField` is the value of the type-specific field on the proto.
+// `byteCount` is the number of bytes per value of T in `tensor_content`.
+func tensorFieldData[T numeric](
+	proto *tbproto.TensorProto,
+	directField []T,
+	byteCount int,
+) ([]T, error) {
+	if len(proto.TensorContent) == 0 {
+		return directField, nil
+	}
+
+	if len(proto.TensorContent)%byteCount != 0 {
+		return nil, fmt.Errorf(
+			"tensor content has %d bytes, which is not a multiple of %d",
+			len(proto.TensorContent),
+			byteCount)
+	}
 
-		return numbersToHistogramJSON(data)
-	} else {
-		return numbersToHistogramJSON(directField)
+	data := make([]T, len(proto.TensorContent)/byteCount)
+
+	// This might be a bug in TensorBoard, but its Python implementation
+	// reads data using the NumPy `frombuffer` function and a dtype
+	// without an explicit byte order, so the tensor content is
+	// interpreted with the native byte order.
+	//
+	// It's not clear what byte order is used to serialize tensors in C
+	// and C++, but it's possible it's the native byte order.
+	//
+	// tesnor_content conversion: https://github.com/tensorflow/tensorboard/blob/ae7d0b9250f5986dd0f0c238fcaf3c8d7f4312ca/tensorboard/util/tensor_util.py#L513-L523
+	if err := binary.Read(
+		bytes.NewBuffer(proto.TensorContent),
+		binary.NativeEndian,
+		&data,
+	); err != nil {
+		return nil, err
 	}
+
+	return data, nil
 }
 
 func numbersToHistogramJSON[T numeric](data []T) (string, error) {
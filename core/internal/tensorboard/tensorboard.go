@@ -304,7 +304,10 @@ func (tb *TBHandler) convertToRunHistory(
 	events <-chan *tbproto.TFEvent,
 	namespace string,
 ) {
-	converter := TFEventConverter{Namespace: namespace}
+	converter := TFEventConverter{
+		Namespace: namespace,
+		SaveMedia: tb.saveMedia,
+	}
 
 	for event := range events {
 		tb.logger.Debug(
@@ -335,6 +338,29 @@ func (tb *TBHandler) sendHistoryRequest(request *service.PartialHistoryRequest)
 	}
 }
 
+// saveMedia writes a media file converted from tfevents into the run's
+// files directory and uploads it.
+func (tb *TBHandler) saveMedia(slashPath string, data []byte) error {
+	path := filepath.Join(tb.settings.GetFilesDir(), filepath.FromSlash(slashPath))
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return err
+	}
+
+	tb.outChan <- &service.Record{
+		RecordType: &service.Record_Files{
+			Files: &service.FilesRecord{
+				Files: []*service.FilesItem{
+					{Policy: service.FilesItem_NOW, Path: slashPath},
+				},
+			},
+		},
+	}
+	return nil
+}
+
 func (tb *TBHandler) saveFiles(
 	files <-chan paths.AbsolutePath,
 	shouldSave bool,
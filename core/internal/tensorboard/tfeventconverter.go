@@ -1,9 +1,16 @@
 package tensorboard
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"path"
+	"strconv"
 	"strings"
 
+	"github.com/wandb/segmentio-encoding/json"
+
 	"github.com/wandb/wandb/core/internal/tensorboard/tbproto"
 	"github.com/wandb/wandb/core/pkg/observability"
 	"github.com/wandb/wandb/core/pkg/service"
@@ -14,6 +21,12 @@ type TFEventConverter struct {
 	// Namespace is a prefix to add to all events.
 	Namespace string
 
+	// SaveMedia writes a media file with the run.
+	//
+	// The path is slash-separated and relative to the run's files
+	// directory. If this is nil, images are ignored.
+	SaveMedia func(path string, data []byte) error
+
 	// pluginNameByTag tracks the plugin name for each summary value tag.
 	//
 	// tfevents files may only contain a `metadata` field on the first
@@ -49,8 +62,13 @@ func (h *TFEventConverter) ConvertNext(
 	for _, value := range event.GetSummary().GetValue() {
 		tag := h.withNamespace(value.GetTag())
 
-		if h.rememberPluginName(tag, value) == "scalars" {
+		switch h.rememberPluginName(tag, value) {
+		case "scalars":
 			jsonData = processScalars(jsonData, tag, value, logger)
+		case "histograms":
+			jsonData = processHistograms(jsonData, tag, value, logger)
+		case "images":
+			jsonData = h.processImages(jsonData, tag, event.Step, value, logger)
 		}
 	}
 
@@ -115,6 +133,181 @@ func processScalars(
 	}
 }
 
+// processHistograms processes a value associated to the "histograms"
+// plugin.
+//
+// The value is a tensor with a row of (left edge, right edge, count) for
+// each bucket. Takes ownership of the jsonData slice and returns the
+// amended slice.
+func processHistograms(
+	jsonData []tagAndJSON,
+	tag string,
+	value *tbproto.Summary_Value,
+	logger *observability.CoreLogger,
+) []tagAndJSON {
+	tensor := value.GetTensor()
+	if tensor == nil {
+		return jsonData
+	}
+
+	data, err := tensorToFloats(tensor)
+	if err != nil {
+		logger.CaptureError(
+			fmt.Errorf("tensorboard: error reading a histogram: %v", err))
+		return jsonData
+	}
+	if len(data)%3 != 0 {
+		logger.CaptureError(
+			fmt.Errorf(
+				"tensorboard: histogram has %d values, not a multiple of 3",
+				len(data)))
+		return jsonData
+	}
+	if len(data) == 0 {
+		return jsonData
+	}
+
+	buckets := len(data) / 3
+	bins := make([]float64, 0, buckets+1)
+	counts := make([]float64, 0, buckets)
+	for i := 0; i < buckets; i++ {
+		bins = append(bins, data[3*i])
+		counts = append(counts, data[3*i+2])
+	}
+	bins = append(bins, data[len(data)-2])
+
+	str, err := json.Marshal(map[string]any{
+		"_type":  "histogram",
+		"values": counts,
+		"bins":   bins,
+	})
+	if err != nil {
+		logger.CaptureError(
+			fmt.Errorf("tensorboard: error serializing a histogram: %v", err))
+		return jsonData
+	}
+
+	return append(jsonData, tagAndJSON{tag: tag, json: string(str)})
+}
+
+// processImages processes a value associated to the "images" plugin.
+//
+// The value is a string tensor of the images' width and height followed
+// by the encoded images. Each image is saved as a media file. Takes
+// ownership of the jsonData slice and returns the amended slice.
+func (h *TFEventConverter) processImages(
+	jsonData []tagAndJSON,
+	tag string,
+	step int64,
+	value *tbproto.Summary_Value,
+	logger *observability.CoreLogger,
+) []tagAndJSON {
+	if h.SaveMedia == nil {
+		return jsonData
+	}
+
+	strs := value.GetTensor().GetStringVal()
+	if len(strs) < 3 {
+		return jsonData
+	}
+
+	width, errWidth := strconv.Atoi(string(strs[0]))
+	height, errHeight := strconv.Atoi(string(strs[1]))
+	if errWidth != nil || errHeight != nil {
+		logger.CaptureError(
+			fmt.Errorf(
+				"tensorboard: invalid image dimensions %q x %q",
+				strs[0], strs[1]))
+		return jsonData
+	}
+
+	images := strs[2:]
+	format := imageFormat(images[0])
+	files := make([]map[string]any, 0, len(images))
+	for _, image := range images {
+		file, err := h.saveImage(tag, step, image, format)
+		if err != nil {
+			logger.CaptureError(
+				fmt.Errorf("tensorboard: error saving an image: %v", err))
+			return jsonData
+		}
+		files = append(files, file)
+	}
+
+	var imageJSON map[string]any
+	if len(files) == 1 {
+		imageJSON = files[0]
+		imageJSON["_type"] = "image-file"
+		imageJSON["format"] = format
+		imageJSON["width"] = width
+		imageJSON["height"] = height
+	} else {
+		filenames := make([]string, len(files))
+		for i, file := range files {
+			filenames[i] = file["path"].(string)
+		}
+		imageJSON = map[string]any{
+			"_type":     "images/separated",
+			"format":    format,
+			"width":     width,
+			"height":    height,
+			"count":     len(files),
+			"filenames": filenames,
+		}
+	}
+
+	str, err := json.Marshal(imageJSON)
+	if err != nil {
+		logger.CaptureError(
+			fmt.Errorf("tensorboard: error serializing images: %v", err))
+		return jsonData
+	}
+
+	return append(jsonData, tagAndJSON{tag: tag, json: string(str)})
+}
+
+// saveImage saves an encoded image as a media file and returns the fields
+// describing it.
+func (h *TFEventConverter) saveImage(
+	tag string,
+	step int64,
+	image []byte,
+	format string,
+) (map[string]any, error) {
+	sum := sha256.Sum256(image)
+	digest := hex.EncodeToString(sum[:])
+
+	// Media files are named "<key>_<step>_<hash>.<ext>" like those logged
+	// by the Python SDK.
+	mediaPath := path.Join(
+		"media", "images",
+		fmt.Sprintf("%s_%d_%s.%s", tag, step, digest[:20], format))
+
+	if err := h.SaveMedia(mediaPath, image); err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"path":   mediaPath,
+		"sha256": digest,
+		"size":   len(image),
+	}, nil
+}
+
+// imageFormat returns the file extension for an encoded image.
+//
+// TensorBoard encodes images as PNG, but GIFs and JPEGs can be logged too.
+func imageFormat(image []byte) string {
+	switch {
+	case bytes.HasPrefix(image, []byte("\xff\xd8\xff")):
+		return "jpg"
+	case bytes.HasPrefix(image, []byte("GIF8")):
+		return "gif"
+	default:
+		return "png"
+	}
+}
+
 // toHistoryRequest creates a history update with the given data.
 func (h *TFEventConverter) toHistoryRequest(
 	jsonData []tagAndJSON,
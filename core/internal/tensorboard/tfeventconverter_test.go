@@ -3,6 +3,7 @@ package tensorboard_test
 import (
 	"bytes"
 	"encoding/binary"
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -182,3 +183,118 @@ func TestConvertTensor(t *testing.T) {
 		},
 		result.Item[4])
 }
+
+func TestConvertHistogram(t *testing.T) {
+	converter := tensorboard.TFEventConverter{}
+
+	result := converter.ConvertNext(
+		summaryEvent(123, 0.345,
+			tensorValue("weights", "histograms",
+				0, 0.5, 3,
+				0.5, 1, 4)),
+		observability.NewNoOpLogger(),
+	)
+
+	require.NotNil(t, result)
+	require.Len(t, result.Item, 3)
+	assert.Equal(t, []string{"weights"}, result.Item[2].NestedKey)
+	assert.JSONEq(t,
+		`{"_type": "histogram", "values": [3, 4], "bins": [0, 0.5, 1]}`,
+		result.Item[2].ValueJson)
+}
+
+func TestConvertHistogram_InvalidShape(t *testing.T) {
+	converter := tensorboard.TFEventConverter{}
+
+	result := converter.ConvertNext(
+		summaryEvent(123, 0.345, tensorValue("weights", "histograms", 0, 1)),
+		observability.NewNoOpLogger(),
+	)
+
+	assert.Nil(t, result)
+}
+
+func imageValue(tag string, width, height string, images ...string) *tbproto.Summary_Value {
+	strs := [][]byte{[]byte(width), []byte(height)}
+	for _, image := range images {
+		strs = append(strs, []byte(image))
+	}
+
+	return &tbproto.Summary_Value{
+		Tag: tag,
+		Value: &tbproto.Summary_Value_Tensor{
+			Tensor: &tbproto.TensorProto{
+				Dtype:     tbproto.DataType_DT_STRING,
+				StringVal: strs,
+			},
+		},
+		Metadata: &tbproto.SummaryMetadata{
+			PluginData: &tbproto.SummaryMetadata_PluginData{
+				PluginName: "images",
+			},
+		},
+	}
+}
+
+func TestConvertImage(t *testing.T) {
+	saved := make(map[string]string)
+	converter := tensorboard.TFEventConverter{
+		Namespace: "train",
+		SaveMedia: func(path string, data []byte) error {
+			saved[path] = string(data)
+			return nil
+		},
+	}
+
+	result := converter.ConvertNext(
+		summaryEvent(7, 0.345, imageValue("samples", "2", "3", "\x89PNG")),
+		observability.NewNoOpLogger(),
+	)
+
+	path := "media/images/train/samples_7_0f4636c78f65d3639ece.png"
+	assert.Equal(t, map[string]string{path: "\x89PNG"}, saved)
+	require.NotNil(t, result)
+	require.Len(t, result.Item, 3)
+	assert.JSONEq(t,
+		`{
+			"_type": "image-file",
+			"format": "png",
+			"width": 2,
+			"height": 3,
+			"path": "`+path+`",
+			"sha256": "0f4636c78f65d3639ece5a064b5ae753e3408614a14fb18ab4d7540d2c248543",
+			"size": 4
+		}`,
+		result.Item[2].ValueJson)
+}
+
+func TestConvertImages_Separated(t *testing.T) {
+	converter := tensorboard.TFEventConverter{
+		SaveMedia: func(path string, data []byte) error { return nil },
+	}
+
+	result := converter.ConvertNext(
+		summaryEvent(7, 0.345, imageValue("samples", "2", "3", "GIF8a", "GIF8b")),
+		observability.NewNoOpLogger(),
+	)
+
+	require.NotNil(t, result)
+	require.Len(t, result.Item, 3)
+	var value map[string]any
+	require.NoError(t, json.Unmarshal([]byte(result.Item[2].ValueJson), &value))
+	assert.Equal(t, "images/separated", value["_type"])
+	assert.Equal(t, "gif", value["format"])
+	assert.EqualValues(t, 2, value["count"])
+	assert.Len(t, value["filenames"], 2)
+}
+
+func TestConvertImage_NoMediaSaver(t *testing.T) {
+	converter := tensorboard.TFEventConverter{}
+
+	result := converter.ConvertNext(
+		summaryEvent(7, 0.345, imageValue("samples", "2", "3", "\x89PNG")),
+		observability.NewNoOpLogger(),
+	)
+
+	assert.Nil(t, result)
+}
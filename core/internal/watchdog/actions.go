@@ -0,0 +1,40 @@
+package watchdog
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+)
+
+// Terminate asks the process with the PID to exit.
+func Terminate(pid int) error {
+	if pid <= 0 {
+		return fmt.Errorf("watchdog: no user process to terminate")
+	}
+	process, err := os.FindProcess(pid)
+	if err != nil {
+		return fmt.Errorf("watchdog: %v", err)
+	}
+	if err := terminate(process); err != nil {
+		return fmt.Errorf("watchdog: failed to terminate process %d: %v", pid, err)
+	}
+	return nil
+}
+
+// Exec starts an ActionExec action's command.
+//
+// The command's environment includes WANDB_RUN_ID, WANDB_WATCHDOG_RULE and
+// WANDB_WATCHDOG_VALUE. It returns once the command exits.
+func Exec(ctx context.Context, action Action, trigger Trigger, runID string) error {
+	cmd := exec.CommandContext(ctx, action.Command[0], action.Command[1:]...)
+	cmd.Env = append(os.Environ(),
+		"WANDB_RUN_ID="+runID,
+		"WANDB_WATCHDOG_RULE="+trigger.Rule.Text,
+		fmt.Sprintf("WANDB_WATCHDOG_VALUE=%v", trigger.Value),
+	)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("watchdog: %q failed: %v: %s", action.Command, err, output)
+	}
+	return nil
+}
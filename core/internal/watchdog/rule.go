@@ -0,0 +1,164 @@
+package watchdog
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ActionKind is what to do when a rule triggers.
+type ActionKind string
+
+const (
+	// ActionAlert sends an alert for the run.
+	ActionAlert ActionKind = "alert"
+
+	// ActionStop asks the SDK to stop the run, as if it were stopped in the
+	// UI; the Python SDK interrupts the main thread.
+	ActionStop ActionKind = "stop"
+
+	// ActionTerminate sends SIGTERM to the user process, for processes
+	// that are wedged and don't respond to ActionStop.
+	ActionTerminate ActionKind = "terminate"
+
+	// ActionExec runs a command.
+	ActionExec ActionKind = "exec"
+)
+
+// Action is something to do when a rule triggers.
+type Action struct {
+	Kind ActionKind
+
+	// Command is the command and arguments to run for ActionExec.
+	Command []string
+}
+
+// Rule is a condition on a metric and what to do when it holds.
+//
+// Rules are written as
+//
+//	METRIC CONDITION [for N] [-> ACTION[; ACTION...]]
+//
+// where CONDITION is "is nan", "is inf", "is nonfinite" or a comparison
+// such as "> 10", N is the number of consecutive logged steps for which the
+// condition must hold, and each ACTION is "alert", "stop", "terminate" or
+// "exec COMMAND ARGS...". For example:
+//
+//	loss is nan for 3 -> alert; stop
+//	val/accuracy < 0.1 for 100 -> exec ./notify.sh
+//
+// Without actions, the rule sends an alert.
+type Rule struct {
+	// Text is the rule as written.
+	Text string
+
+	// Metric is the name of the metric; nested keys are joined with ".".
+	Metric string
+
+	// Steps is the number of consecutive steps the condition must hold.
+	Steps int
+
+	Actions []Action
+
+	// holds reports whether the condition holds for a value.
+	holds func(float64) bool
+}
+
+// ParseRule parses a rule in the syntax documented on Rule.
+func ParseRule(text string) (*Rule, error) {
+	rule := &Rule{Text: strings.TrimSpace(text), Steps: 1}
+
+	condition, actions, hasActions := strings.Cut(rule.Text, "->")
+	if hasActions {
+		for _, actionText := range strings.Split(actions, ";") {
+			action, err := parseAction(actionText)
+			if err != nil {
+				return nil, fmt.Errorf("watchdog: rule %q: %v", rule.Text, err)
+			}
+			rule.Actions = append(rule.Actions, action)
+		}
+	} else {
+		rule.Actions = []Action{{Kind: ActionAlert}}
+	}
+
+	fields := strings.Fields(condition)
+	if n := len(fields); n >= 2 && fields[n-2] == "for" {
+		steps, err := strconv.Atoi(fields[n-1])
+		if err != nil || steps < 1 {
+			return nil, fmt.Errorf(
+				"watchdog: rule %q: invalid step count %q", rule.Text, fields[n-1])
+		}
+		rule.Steps = steps
+		fields = fields[:n-2]
+	}
+
+	if len(fields) != 3 {
+		return nil, fmt.Errorf("watchdog: rule %q: expected METRIC CONDITION", rule.Text)
+	}
+	rule.Metric = fields[0]
+
+	holds, err := parseCondition(fields[1], fields[2])
+	if err != nil {
+		return nil, fmt.Errorf("watchdog: rule %q: %v", rule.Text, err)
+	}
+	rule.holds = holds
+
+	return rule, nil
+}
+
+func parseCondition(op, operand string) (func(float64) bool, error) {
+	if op == "is" {
+		switch operand {
+		case "nan":
+			return math.IsNaN, nil
+		case "inf":
+			return func(x float64) bool { return math.IsInf(x, 0) }, nil
+		case "nonfinite":
+			return func(x float64) bool { return math.IsNaN(x) || math.IsInf(x, 0) }, nil
+		default:
+			return nil, fmt.Errorf("unknown condition \"is %s\"", operand)
+		}
+	}
+
+	threshold, err := strconv.ParseFloat(operand, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid threshold %q", operand)
+	}
+	switch op {
+	case ">":
+		return func(x float64) bool { return x > threshold }, nil
+	case ">=":
+		return func(x float64) bool { return x >= threshold }, nil
+	case "<":
+		return func(x float64) bool { return x < threshold }, nil
+	case "<=":
+		return func(x float64) bool { return x <= threshold }, nil
+	case "==":
+		return func(x float64) bool { return x == threshold }, nil
+	default:
+		return nil, fmt.Errorf("unknown operator %q", op)
+	}
+}
+
+func parseAction(text string) (Action, error) {
+	fields := strings.Fields(text)
+	if len(fields) == 0 {
+		return Action{}, fmt.Errorf("empty action")
+	}
+
+	switch kind := ActionKind(fields[0]); kind {
+	case ActionAlert, ActionStop, ActionTerminate:
+		if len(fields) > 1 {
+			return Action{}, fmt.Errorf("action %q takes no arguments", kind)
+		}
+		return Action{Kind: kind}, nil
+	case ActionExec:
+		if len(fields) == 1 {
+			return Action{}, fmt.Errorf("exec action needs a command")
+		}
+		return Action{Kind: kind, Command: fields[1:]}, nil
+	default:
+		return Action{}, fmt.Errorf("unknown action %q", fields[0])
+	}
+}
@@ -0,0 +1,12 @@
+//go:build !windows
+
+package watchdog
+
+import (
+	"os"
+	"syscall"
+)
+
+func terminate(process *os.Process) error {
+	return process.Signal(syscall.SIGTERM)
+}
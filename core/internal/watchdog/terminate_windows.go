@@ -0,0 +1,10 @@
+//go:build windows
+
+package watchdog
+
+import "os"
+
+// terminate kills the process since Windows has no SIGTERM.
+func terminate(process *os.Process) error {
+	return process.Kill()
+}
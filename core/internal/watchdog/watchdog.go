@@ -0,0 +1,84 @@
+// Package watchdog checks a run's history against user-defined rules.
+//
+// Since wandb-core sees every history row, it can act on a diverging
+// metric even if the training process is wedged.
+package watchdog
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// Trigger is a rule whose condition held for enough steps.
+type Trigger struct {
+	Rule *Rule
+
+	// Value is the metric's latest value.
+	Value float64
+}
+
+// Watchdog tracks the rules' conditions across history rows.
+//
+// Each rule triggers at most once per run.
+type Watchdog struct {
+	rules []*Rule
+
+	// streaks are the numbers of consecutive steps for which each rule's
+	// condition held
+	streaks []int
+
+	// triggered is whether each rule has triggered
+	triggered []bool
+}
+
+func New(rules []*Rule) *Watchdog {
+	return &Watchdog{
+		rules:     rules,
+		streaks:   make([]int, len(rules)),
+		triggered: make([]bool, len(rules)),
+	}
+}
+
+// Observe checks a history row and returns the rules that triggered.
+//
+// Rules whose metric isn't a number in the row are unaffected by it.
+func (w *Watchdog) Observe(history *service.HistoryRecord) []Trigger {
+	var triggers []Trigger
+
+	values := make(map[string]float64)
+	for _, item := range history.GetItem() {
+		key := item.GetKey()
+		if len(item.GetNestedKey()) > 0 {
+			key = strings.Join(item.GetNestedKey(), ".")
+		}
+		value, err := strconv.ParseFloat(item.GetValueJson(), 64)
+		if err != nil {
+			continue
+		}
+		values[key] = value
+	}
+
+	for i, rule := range w.rules {
+		if w.triggered[i] {
+			continue
+		}
+		value, ok := values[rule.Metric]
+		if !ok {
+			continue
+		}
+
+		if !rule.holds(value) {
+			w.streaks[i] = 0
+			continue
+		}
+		w.streaks[i]++
+		if w.streaks[i] >= rule.Steps {
+			w.triggered[i] = true
+			triggers = append(triggers, Trigger{Rule: rule, Value: value})
+		}
+	}
+
+	return triggers
+}
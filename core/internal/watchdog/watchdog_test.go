@@ -0,0 +1,92 @@
+package watchdog_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/watchdog"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+func history(key, valueJSON string) *service.HistoryRecord {
+	return &service.HistoryRecord{
+		Item: []*service.HistoryItem{{Key: key, ValueJson: valueJSON}},
+	}
+}
+
+func TestParseRule(t *testing.T) {
+	rule, err := watchdog.ParseRule("loss is nan for 3 -> alert; exec ./notify.sh --urgent")
+
+	require.NoError(t, err)
+	assert.Equal(t, "loss", rule.Metric)
+	assert.Equal(t, 3, rule.Steps)
+	assert.Equal(t, []watchdog.Action{
+		{Kind: watchdog.ActionAlert},
+		{Kind: watchdog.ActionExec, Command: []string{"./notify.sh", "--urgent"}},
+	}, rule.Actions)
+}
+
+func TestParseRule_DefaultsToAlert(t *testing.T) {
+	rule, err := watchdog.ParseRule("acc < 0.1")
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, rule.Steps)
+	assert.Equal(t, []watchdog.Action{{Kind: watchdog.ActionAlert}}, rule.Actions)
+}
+
+func TestParseRule_Invalid(t *testing.T) {
+	for _, text := range []string{
+		"loss",
+		"loss is big",
+		"loss > high",
+		"loss ~ 3",
+		"loss is nan for 0",
+		"loss is nan -> explode",
+		"loss is nan -> exec",
+		"loss is nan -> stop now",
+	} {
+		_, err := watchdog.ParseRule(text)
+		assert.Error(t, err, text)
+	}
+}
+
+func TestObserve_ConsecutiveSteps(t *testing.T) {
+	rule, err := watchdog.ParseRule("loss is nan for 2 -> stop")
+	require.NoError(t, err)
+	w := watchdog.New([]*watchdog.Rule{rule})
+
+	assert.Empty(t, w.Observe(history("loss", "NaN")))
+	assert.Empty(t, w.Observe(history("loss", "0.5")))
+	assert.Empty(t, w.Observe(history("loss", "NaN")))
+	assert.Empty(t, w.Observe(history("acc", "0.5")))
+	triggers := w.Observe(history("loss", "NaN"))
+	require.Len(t, triggers, 1)
+	assert.Same(t, rule, triggers[0].Rule)
+
+	// Rules trigger only once.
+	assert.Empty(t, w.Observe(history("loss", "NaN")))
+}
+
+func TestObserve_Threshold(t *testing.T) {
+	rule, err := watchdog.ParseRule("train.loss > 10")
+	require.NoError(t, err)
+	w := watchdog.New([]*watchdog.Rule{rule})
+
+	nested := &service.HistoryRecord{Item: []*service.HistoryItem{
+		{NestedKey: []string{"train", "loss"}, ValueJson: "12.5"},
+	}}
+	triggers := w.Observe(nested)
+
+	require.Len(t, triggers, 1)
+	assert.Equal(t, 12.5, triggers[0].Value)
+}
+
+func TestObserve_IgnoresNonNumbers(t *testing.T) {
+	rule, err := watchdog.ParseRule("loss is nonfinite")
+	require.NoError(t, err)
+	w := watchdog.New([]*watchdog.Rule{rule})
+
+	assert.Empty(t, w.Observe(history("loss", `"NaN"`)))
+	assert.Len(t, w.Observe(history("loss", "-Infinity")), 1)
+}
@@ -18,6 +18,7 @@ type watcher struct {
 	delegate   *poller.Watcher
 	wg         *sync.WaitGroup
 	handlers   map[string]func(string)
+	tree       *treeWatcher
 	isFinished bool
 
 	pollingPeriod time.Duration
@@ -45,6 +46,25 @@ func (w *watcher) WatchDir(path string, onChange func(string)) error {
 	return w.watchFileOrDir(path, onChange)
 }
 
+func (w *watcher) WatchTree(path string, onChange func(string)) error {
+	w.Lock()
+	defer w.Unlock()
+
+	if w.isFinished {
+		return fmt.Errorf("watcher: tried to call WatchTree() after Finish()")
+	}
+
+	if w.tree == nil {
+		tree, err := newTreeWatcher(w.logger)
+		if err != nil {
+			return err
+		}
+		w.tree = tree
+	}
+
+	return w.tree.Watch(path, onChange)
+}
+
 func (w *watcher) watchFileOrDir(path string, onChange func(string)) error {
 	w.Lock()
 	defer w.Unlock()
@@ -69,15 +89,20 @@ func (w *watcher) watchFileOrDir(path string, onChange func(string)) error {
 
 func (w *watcher) Finish() {
 	var delegate *poller.Watcher
+	var tree *treeWatcher
 
 	w.Lock()
 	w.isFinished = true
 	delegate = w.delegate
+	tree = w.tree
 	w.Unlock()
 
 	if delegate != nil {
 		delegate.Close()
 	}
+	if tree != nil {
+		tree.Close()
+	}
 	w.wg.Wait()
 }
 
@@ -0,0 +1,144 @@
+package watcher
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/wandb/wandb/core/pkg/observability"
+)
+
+// treeWatcher watches directory trees using the OS's file notifications.
+//
+// Unlike the polling watcher, it reports new files as soon as they're
+// created, which matters for trees with many files, but it has to watch
+// each directory in the tree separately.
+type treeWatcher struct {
+	sync.Mutex
+	logger   *observability.CoreLogger
+	notify   *fsnotify.Watcher
+	wg       *sync.WaitGroup
+	handlers map[string]func(string)
+}
+
+func newTreeWatcher(logger *observability.CoreLogger) (*treeWatcher, error) {
+	notify, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("watcher: failed to create watcher: %v", err)
+	}
+
+	w := &treeWatcher{
+		logger:   logger,
+		notify:   notify,
+		wg:       &sync.WaitGroup{},
+		handlers: make(map[string]func(string)),
+	}
+
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		w.loop()
+	}()
+
+	return w, nil
+}
+
+// Watch watches the directory at the root and all its subdirectories.
+func (w *treeWatcher) Watch(root string, onChange func(string)) error {
+	root = filepath.Clean(root)
+
+	w.Lock()
+	w.handlers[root] = onChange
+	w.Unlock()
+
+	return w.addTree(root, false)
+}
+
+// Close stops watching and waits for pending callbacks.
+func (w *treeWatcher) Close() {
+	_ = w.notify.Close()
+	w.wg.Wait()
+}
+
+// addTree watches a directory and its subdirectories.
+//
+// If notifyFiles is true, the files already in the tree are reported as
+// changed, which is needed for directories that may have been filled in
+// before they were watched.
+func (w *treeWatcher) addTree(root string, notifyFiles bool) error {
+	return filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			// The root must exist; anything below it may have been deleted
+			// since it was listed.
+			if path == root {
+				return err
+			}
+			return nil
+		}
+
+		if d.IsDir() {
+			return w.notify.Add(path)
+		}
+		if notifyFiles {
+			w.onChange(path)
+		}
+		return nil
+	})
+}
+
+func (w *treeWatcher) loop() {
+	for {
+		select {
+		case event, ok := <-w.notify.Events:
+			if !ok {
+				return
+			}
+			if !event.Has(fsnotify.Create) && !event.Has(fsnotify.Write) {
+				continue
+			}
+
+			if event.Has(fsnotify.Create) && isDir(event.Name) {
+				if err := w.addTree(event.Name, true); err != nil {
+					w.logger.CaptureError(
+						fmt.Errorf("watcher: failed to watch directory: %v", err))
+				}
+				continue
+			}
+
+			w.onChange(event.Name)
+
+		case err, ok := <-w.notify.Errors:
+			if !ok {
+				return
+			}
+			w.logger.CaptureError(
+				fmt.Errorf("watcher: error in tree watcher: %v", err))
+		}
+	}
+}
+
+// onChange invokes the handler of the tree that contains the path.
+func (w *treeWatcher) onChange(path string) {
+	w.Lock()
+	var handler func(string)
+	for root, rootHandler := range w.handlers {
+		if strings.HasPrefix(path, root+string(filepath.Separator)) {
+			handler = rootHandler
+			break
+		}
+	}
+	w.Unlock()
+
+	if handler != nil {
+		handler(path)
+	}
+}
+
+func isDir(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
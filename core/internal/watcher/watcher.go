@@ -30,6 +30,16 @@ type Watcher interface {
 	// The directory must exist, or an error is returned.
 	WatchDir(path string, onChange func(string)) error
 
+	// WatchTree begins watching the directory at the path recursively.
+	//
+	// `onChange` is invoked with a file path if any file in the directory
+	// or its subdirectories is changed or created, including files in
+	// subdirectories created later. Unlike Watch and WatchDir, this uses
+	// the OS's file notifications rather than polling.
+	//
+	// The directory must exist, or an error is returned.
+	WatchTree(path string, onChange func(string)) error
+
 	// Finish stops the watcher from emitting any more change events.
 	Finish()
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/wandb/wandb/core/internal/watcher"
+	"github.com/wandb/wandb/core/pkg/observability"
 )
 
 func mkdir(t *testing.T, path string) {
@@ -129,6 +130,28 @@ func TestWatcher(t *testing.T) {
 		assert.Equal(t, result, file)
 	})
 
+	t.Run("runs callback on new file in subdirectory of tree", func(t *testing.T) {
+		t.Parallel()
+
+		// Buffered because the file may be reported both when its
+		// directory is discovered and when it's written.
+		onChangeChan := make(chan string, 10)
+		dir := t.TempDir()
+		file := filepath.Join(dir, "sub", "subsub", "file.txt")
+
+		watcher := watcher.New(watcher.Params{
+			Logger: observability.NewNoOpLogger(),
+		})
+		defer finishWithDeadline(t, watcher)
+		require.NoError(t,
+			watcher.WatchTree(dir, func(s string) { onChangeChan <- s }))
+		writeFile(t, file, "")
+
+		result := waitWithDeadline(t, onChangeChan,
+			"expected file callback to be called")
+		assert.Equal(t, file, result)
+	})
+
 	t.Run("fails if file does not exist", func(t *testing.T) {
 		t.Parallel()
 
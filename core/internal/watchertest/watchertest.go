@@ -14,6 +14,7 @@ type FakeWatcher struct {
 	sync.Mutex
 
 	handlers map[string]func(string)
+	trees    map[string]func(string)
 }
 
 var _ watcher.Watcher = &FakeWatcher{}
@@ -21,6 +22,7 @@ var _ watcher.Watcher = &FakeWatcher{}
 func NewFakeWatcher() *FakeWatcher {
 	return &FakeWatcher{
 		handlers: make(map[string]func(string)),
+		trees:    make(map[string]func(string)),
 	}
 }
 
@@ -29,12 +31,28 @@ func (w *FakeWatcher) OnChange(path string) {
 	w.Lock()
 	handler := w.handlers[path]
 	parentHandler := w.handlers[filepath.Dir(path)]
+	treeHandler := w.treeHandler(path)
 	w.Unlock()
 
-	if handler != nil {
+	switch {
+	case handler != nil:
 		handler(path)
-	} else if parentHandler != nil {
+	case parentHandler != nil:
 		parentHandler(path)
+	case treeHandler != nil:
+		treeHandler(path)
+	}
+}
+
+// treeHandler returns the callback of a watched tree containing the path.
+func (w *FakeWatcher) treeHandler(path string) func(string) {
+	for dir := filepath.Dir(path); ; dir = filepath.Dir(dir) {
+		if handler := w.trees[dir]; handler != nil {
+			return handler
+		}
+		if dir == filepath.Dir(dir) {
+			return nil
+		}
 	}
 }
 
@@ -43,7 +61,7 @@ func (w *FakeWatcher) IsWatching(path string) bool {
 	w.Lock()
 	defer w.Unlock()
 
-	return w.handlers[w.toAbs(path)] != nil
+	return w.handlers[w.toAbs(path)] != nil || w.trees[w.toAbs(path)] != nil
 }
 
 func (w *FakeWatcher) Watch(path string, callback func()) error {
@@ -54,6 +72,18 @@ func (w *FakeWatcher) WatchDir(path string, callback func(string)) error {
 	return w.watchFileOrDir(path, callback)
 }
 
+func (w *FakeWatcher) WatchTree(path string, callback func(string)) error {
+	w.Lock()
+	defer w.Unlock()
+
+	if _, err := os.Stat(path); err != nil {
+		return err
+	}
+
+	w.trees[w.toAbs(path)] = callback
+	return nil
+}
+
 func (w *FakeWatcher) watchFileOrDir(path string, callback func(string)) error {
 	w.Lock()
 	defer w.Unlock()
@@ -0,0 +1,157 @@
+package artifacts
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// CompressionExtraKey is the key in a manifest entry's "extra" metadata
+// that says how the entry's stored contents are compressed.
+const CompressionExtraKey = "compression"
+
+// Compression is an encoding applied to an entry's contents in storage.
+//
+// The digest and size of a compressed entry describe its decompressed
+// contents, so that entries are cached and verified the same way
+// regardless of how they're stored.
+type Compression string
+
+const (
+	CompressionNone Compression = ""
+	CompressionGzip Compression = "gzip"
+	CompressionZstd Compression = "zstd"
+)
+
+// Compression returns how the entry's stored contents are compressed.
+func (e ManifestEntry) Compression() (Compression, error) {
+	value, ok := e.Extra[CompressionExtraKey]
+	if !ok {
+		return CompressionNone, nil
+	}
+
+	name, ok := value.(string)
+	if !ok {
+		return CompressionNone, fmt.Errorf(
+			"artifacts: invalid %q in manifest entry: %v",
+			CompressionExtraKey, value)
+	}
+
+	switch compression := Compression(name); compression {
+	case CompressionNone, CompressionGzip, CompressionZstd:
+		return compression, nil
+	default:
+		return CompressionNone, fmt.Errorf(
+			"artifacts: unsupported compression %q", name)
+	}
+}
+
+// NewDecompressingReader returns a reader of the decompressed contents
+// of src.
+//
+// Closing the reader closes src.
+func NewDecompressingReader(
+	src io.ReadCloser,
+	compression Compression,
+) (io.ReadCloser, error) {
+	switch compression {
+	case CompressionNone:
+		return src, nil
+
+	case CompressionGzip:
+		reader, err := gzip.NewReader(src)
+		if err != nil {
+			return nil, fmt.Errorf("artifacts: invalid gzip data: %v", err)
+		}
+		return &decompressingReader{Reader: reader, closers: []io.Closer{reader, src}}, nil
+
+	case CompressionZstd:
+		decoder, err := zstd.NewReader(src)
+		if err != nil {
+			return nil, fmt.Errorf("artifacts: invalid zstd data: %v", err)
+		}
+		reader := decoder.IOReadCloser()
+		return &decompressingReader{Reader: reader, closers: []io.Closer{reader, src}}, nil
+
+	default:
+		return nil, fmt.Errorf(
+			"artifacts: unsupported compression %q", compression)
+	}
+}
+
+// NewStoredEntryReader is like NewEntryReader, but for an entry's contents
+// as they are stored, which may be compressed.
+func NewStoredEntryReader(
+	src io.ReadCloser,
+	entry ManifestEntry,
+	prefetch PrefetchOptions,
+) (io.ReadCloser, error) {
+	compression, err := entry.Compression()
+	if err != nil {
+		return nil, err
+	}
+
+	reader, err := NewDecompressingReader(src, compression)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewEntryReader(reader, entry, prefetch), nil
+}
+
+type decompressingReader struct {
+	io.Reader
+	closers []io.Closer
+}
+
+func (r *decompressingReader) Close() error {
+	var firstErr error
+	for _, closer := range r.closers {
+		if err := closer.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// decompressFile replaces a downloaded file by its decompressed contents.
+func decompressFile(path string, compression Compression) error {
+	if compression == CompressionNone {
+		return nil
+	}
+
+	src, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	reader, err := NewDecompressingReader(src, compression)
+	if err != nil {
+		src.Close()
+		return err
+	}
+
+	dst, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		reader.Close()
+		return err
+	}
+	defer func() {
+		dst.Close()
+		_ = os.Remove(dst.Name())
+	}()
+
+	_, err = io.Copy(dst, reader)
+	reader.Close()
+	if err != nil {
+		return fmt.Errorf("artifacts: failed to decompress %s: %v", path, err)
+	}
+	if err := dst.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(dst.Name(), path)
+}
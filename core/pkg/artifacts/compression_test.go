@@ -0,0 +1,110 @@
+package artifacts
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func compress(t *testing.T, data []byte, compression Compression) []byte {
+	t.Helper()
+	buf := &bytes.Buffer{}
+
+	switch compression {
+	case CompressionGzip:
+		w := gzip.NewWriter(buf)
+		_, err := w.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+	case CompressionZstd:
+		w, err := zstd.NewWriter(buf)
+		require.NoError(t, err)
+		_, err = w.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+	default:
+		buf.Write(data)
+	}
+
+	return buf.Bytes()
+}
+
+func TestManifestEntry_Compression(t *testing.T) {
+	compression, err := ManifestEntry{}.Compression()
+	require.NoError(t, err)
+	assert.Equal(t, CompressionNone, compression)
+
+	compression, err = ManifestEntry{
+		Extra: map[string]interface{}{"compression": "zstd"},
+	}.Compression()
+	require.NoError(t, err)
+	assert.Equal(t, CompressionZstd, compression)
+
+	_, err = ManifestEntry{
+		Extra: map[string]interface{}{"compression": "lz4"},
+	}.Compression()
+	assert.Error(t, err)
+
+	_, err = ManifestEntry{
+		Extra: map[string]interface{}{"compression": 1},
+	}.Compression()
+	assert.Error(t, err)
+}
+
+func TestStoredEntryReader_Decompresses(t *testing.T) {
+	data := bytes.Repeat([]byte("a line of text\n"), 1000)
+
+	for _, compression := range []Compression{
+		CompressionNone,
+		CompressionGzip,
+		CompressionZstd,
+	} {
+		entry := entryFor(data)
+		entry.Extra = map[string]interface{}{"compression": string(compression)}
+		stored := compress(t, data, compression)
+
+		reader, err := NewStoredEntryReader(
+			io.NopCloser(bytes.NewReader(stored)), entry, DefaultPrefetchOptions())
+		require.NoError(t, err)
+		contents, err := io.ReadAll(reader)
+
+		require.NoError(t, err, compression)
+		assert.Equal(t, data, contents, compression)
+		assert.NoError(t, reader.Close())
+	}
+}
+
+func TestStoredEntryReader_VerifiesDecompressedContents(t *testing.T) {
+	entry := entryFor([]byte("expected"))
+	entry.Extra = map[string]interface{}{"compression": "gzip"}
+	stored := compress(t, []byte("corrupt!"), CompressionGzip)
+
+	reader, err := NewStoredEntryReader(
+		io.NopCloser(bytes.NewReader(stored)), entry, PrefetchOptions{})
+	require.NoError(t, err)
+	_, err = io.ReadAll(reader)
+
+	assert.ErrorIs(t, err, ErrDigestMismatch)
+}
+
+func TestDecompressFile(t *testing.T) {
+	data := []byte("decompressed contents")
+	path := filepath.Join(t.TempDir(), "file.txt")
+	require.NoError(t, os.WriteFile(path, compress(t, data, CompressionZstd), 0600))
+
+	require.NoError(t, decompressFile(path, CompressionZstd))
+
+	contents, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, data, contents)
+	files, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	assert.Len(t, files, 1)
+}
@@ -0,0 +1,96 @@
+package artifacts
+
+import (
+	"fmt"
+
+	"github.com/wandb/wandb/core/internal/gql"
+)
+
+// deduplicateFiles points the manifest's entries at earlier artifact
+// versions in the project that have the same files, so that they're not
+// uploaded again.
+//
+// It returns the names of the entries that don't need uploading. Only
+// versions that the server says are committed are used, since the files
+// of versions that failed to upload or were deleted may be gone.
+func (as *ArtifactSaver) deduplicateFiles(manifest *Manifest) map[string]bool {
+	if as.UploadIndex == nil {
+		return nil
+	}
+
+	candidates := make(map[string]string)
+	for name, entry := range manifest.Contents {
+		if entry.LocalPath == nil || entry.BirthArtifactID != nil || entry.Ref != nil {
+			continue
+		}
+		artifactID, ok := as.UploadIndex.Lookup(
+			as.Artifact.Entity, as.Artifact.Project, entry.Digest)
+		if ok {
+			candidates[name] = artifactID
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	// Ask the server about each version once.
+	committed := make(map[string]bool)
+	failed := make(map[string]bool)
+	for _, artifactID := range candidates {
+		if committed[artifactID] || failed[artifactID] {
+			continue
+		}
+		ok, err := as.isCommitted(artifactID)
+		switch {
+		case err != nil:
+			as.Logger.Warn("artifacts: failed to check earlier upload", "err", err)
+			failed[artifactID] = true
+		case ok:
+			committed[artifactID] = true
+		default:
+			failed[artifactID] = true
+		}
+	}
+
+	deduplicated := make(map[string]bool)
+	for name, artifactID := range candidates {
+		entry := manifest.Contents[name]
+		if !committed[artifactID] {
+			_ = as.UploadIndex.Forget(
+				as.Artifact.Entity, as.Artifact.Project, entry.Digest)
+			continue
+		}
+		entry.BirthArtifactID = &artifactID
+		manifest.Contents[name] = entry
+		deduplicated[name] = true
+	}
+
+	if len(deduplicated) > 0 {
+		as.Logger.Info(
+			"artifacts: skipping files uploaded by earlier versions",
+			"count", len(deduplicated))
+	}
+	return deduplicated
+}
+
+// recordUpload records that the server has an entry's file.
+func (as *ArtifactSaver) recordUpload(entry ManifestEntry) {
+	if entry.BirthArtifactID == nil || *entry.BirthArtifactID == "" {
+		return
+	}
+	err := as.UploadIndex.Record(
+		as.Artifact.Entity, as.Artifact.Project, entry.Digest, *entry.BirthArtifactID)
+	if err != nil {
+		as.Logger.Warn("artifacts: failed to record upload", "err", err)
+	}
+}
+
+// isCommitted returns whether an artifact version exists and is committed.
+func (as *ArtifactSaver) isCommitted(artifactID string) (bool, error) {
+	response, err := gql.ArtifactCommitState(as.Ctx, as.GraphqlClient, artifactID)
+	if err != nil {
+		return false, fmt.Errorf("ArtifactCommitState: %v", err)
+	}
+	return response.Artifact != nil &&
+		response.Artifact.State == gql.ArtifactStateCommitted, nil
+}
@@ -4,12 +4,19 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"net/url"
+	"os"
 	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/Khan/genqlient/graphql"
+	"github.com/wandb/wandb/core/internal/errorcode"
 	"github.com/wandb/wandb/core/internal/filetransfer"
 	"github.com/wandb/wandb/core/internal/gql"
+	"github.com/wandb/wandb/core/pkg/service"
 )
 
 const BATCH_SIZE int = 10000
@@ -24,9 +31,9 @@ type ArtifactDownloader struct {
 	// Input
 	ArtifactID             string
 	DownloadRoot           string
-	AllowMissingReferences bool   // Currently unused
-	SkipCache              bool   // Currently unused
-	PathPrefix             string // Currently unused
+	AllowMissingReferences bool
+	SkipCache              bool // Currently unused
+	Filter                 PathFilter
 }
 
 func NewArtifactDownloader(
@@ -38,6 +45,7 @@ func NewArtifactDownloader(
 	allowMissingReferences bool,
 	skipCache bool,
 	pathPrefix string,
+	pathGlobs []string,
 ) *ArtifactDownloader {
 	fileCache := NewHashOnlyCache()
 	if !skipCache {
@@ -51,39 +59,82 @@ func NewArtifactDownloader(
 		DownloadRoot:           downloadRoot,
 		AllowMissingReferences: allowMissingReferences,
 		SkipCache:              skipCache,
-		PathPrefix:             pathPrefix,
+		Filter:                 PathFilter{Prefix: pathPrefix, Globs: pathGlobs},
 		FileCache:              fileCache,
 	}
 }
 
-func (ad *ArtifactDownloader) getArtifactManifest(artifactID string) (manifest Manifest, rerr error) {
+// getArtifactManifest downloads an artifact's manifest and opens it.
+//
+// The caller must close the manifest and then remove its file, whose
+// path is returned.
+func (ad *ArtifactDownloader) getArtifactManifest(artifactID string) (*ManifestReader, string, error) {
 	response, err := gql.ArtifactManifest(
 		ad.Ctx,
 		ad.GraphqlClient,
 		artifactID,
 	)
 	if err != nil {
-		return Manifest{}, err
+		return nil, "", err
 	} else if response == nil {
-		return Manifest{}, fmt.Errorf("could not get manifest for artifact")
+		return nil, "", fmt.Errorf("could not get manifest for artifact")
 	}
 	artifact := response.Artifact
 	if artifact == nil {
-		return Manifest{}, fmt.Errorf("could not access artifact")
+		return nil, "", fmt.Errorf("could not access artifact")
 	}
 	artifactManifest := artifact.CurrentManifest
 	if artifactManifest == nil {
-		return Manifest{}, fmt.Errorf("could not access manifest for artifact")
+		return nil, "", fmt.Errorf("could not access manifest for artifact")
 	}
 	directURL := artifactManifest.GetFile().DirectUrl
-	manifest, err = loadManifestFromURL(directURL)
+	return ad.downloadManifest(directURL)
+}
+
+// downloadManifest downloads a manifest and opens it.
+//
+// The manifest is downloaded by the download manager so that it goes
+// through the same proxies as the artifact's files. It's kept in a file
+// so that large manifests don't have to be loaded into memory.
+func (ad *ArtifactDownloader) downloadManifest(url string) (*ManifestReader, string, error) {
+	f, err := os.CreateTemp("", "wandb-manifest-*.json")
 	if err != nil {
-		return Manifest{}, err
+		return nil, "", err
+	}
+	f.Close()
+
+	done := make(chan *filetransfer.Task, 1)
+	task := &filetransfer.Task{
+		FileKind: filetransfer.RunFileKindArtifact,
+		Type:     filetransfer.DownloadTask,
+		Path:     f.Name(),
+		Url:      url,
 	}
-	return manifest, nil
+	task.SetCompletionCallback(func(t *filetransfer.Task) { done <- t })
+	ad.DownloadManager.AddTask(task)
+
+	if task := <-done; task.Err != nil {
+		os.Remove(f.Name())
+		return nil, "", fmt.Errorf("failed to download manifest: %v", task.Err)
+	}
+
+	manifest, err := OpenManifest(f.Name())
+	if err != nil {
+		os.Remove(f.Name())
+		return nil, "", err
+	}
+	return manifest, f.Name(), nil
 }
 
-func (ad *ArtifactDownloader) downloadFiles(artifactID string, manifest Manifest) error {
+// downloadFiles downloads an artifact's files.
+//
+// If names is not nil, only the named files are downloaded, and their
+// URLs are fetched by name.
+func (ad *ArtifactDownloader) downloadFiles(
+	artifactID string,
+	manifest *ManifestReader,
+	names []string,
+) error {
 	// retrieve from "WANDB_ARTIFACT_FETCH_FILE_URL_BATCH_SIZE"?
 	batchSize := BATCH_SIZE
 
@@ -92,54 +143,58 @@ func (ad *ArtifactDownloader) downloadFiles(artifactID string, manifest Manifest
 		Name string
 	}
 
+	numEntries := manifest.Len()
+	if names != nil {
+		numEntries = len(names)
+	}
+
 	// Fetch URLs and download files in batches
-	manifestEntries := manifest.Contents
 	numInProgress, numDone := 0, 0
 	nameToScheduledTime := map[string]time.Time{}
+	nameToEntry := map[string]ManifestEntry{}
 	taskResultsChan := make(chan TaskResult)
 	manifestEntriesBatch := make([]ManifestEntry, 0, batchSize)
 
-	for numDone < len(manifestEntries) {
+	for numDone < numEntries {
+		numDoneBefore := numDone
+		numScheduled := 0
+
 		var cursor *string
 		hasNextPage := true
 		for hasNextPage {
 			// Prepare a batch
 			now := time.Now()
 			manifestEntriesBatch = manifestEntriesBatch[:0]
-			response, err := gql.ArtifactFileURLs(
-				ad.Ctx,
-				ad.GraphqlClient,
-				artifactID,
-				cursor,
-				&batchSize,
-			)
+			page, err := ad.fetchFileURLs(artifactID, names, cursor, batchSize)
 			if err != nil {
 				return err
 			}
-			hasNextPage = response.Artifact.Files.PageInfo.HasNextPage
-			cursor = response.Artifact.Files.PageInfo.EndCursor
-			for _, edge := range response.GetArtifact().GetFiles().Edges {
-				filePath := edge.GetNode().Name
-				entry, err := manifest.GetManifestEntryFromArtifactFilePath(filePath)
+			hasNextPage = page.HasNextPage
+			cursor = page.EndCursor
+			for _, file := range page.Files {
+				filePath := file.Name
+				entry, err := manifest.Entry(filePath)
 				if err != nil {
 					return err
 				}
 				if _, ok := nameToScheduledTime[filePath]; ok {
 					continue
 				}
-				// Reference artifacts will temporarily be handled by the python user process
-				if entry.Ref != nil {
+				// Other references are handled by the python user process
+				if entry.Ref != nil && !isCoreReference(entry) {
 					numDone++
 					continue
 				}
-				node := edge.GetNode()
-				if node == nil {
-					return fmt.Errorf("error reading entry from fetched file urls")
+				directURL := file.DirectURL
+				if entry.Ref != nil {
+					directURL = referenceURL(entry)
 				}
-				entry.DownloadURL = &node.DirectUrl
+				entry.DownloadURL = &directURL
 				entry.LocalPath = &filePath
 				nameToScheduledTime[*entry.LocalPath] = now
+				nameToEntry[*entry.LocalPath] = entry
 				manifestEntriesBatch = append(manifestEntriesBatch, entry)
+				numScheduled++
 			}
 
 			// Schedule downloads
@@ -149,7 +204,9 @@ func (ad *ArtifactDownloader) downloadFiles(artifactID string, manifest Manifest
 					downloadLocalPath := filepath.Join(ad.DownloadRoot, *entry.LocalPath)
 					// If we're skipping the cache, the HashOnlyCache still checks the destination
 					// and returns true if the file is there and has the correct hash.
-					if success := ad.FileCache.RestoreTo(entry, downloadLocalPath); success {
+					// References' digests are ETags, which the cache doesn't use.
+					if entry.Ref == nil && ad.FileCache.RestoreTo(entry, downloadLocalPath) {
+						delete(nameToEntry, *entry.LocalPath)
 						numDone++
 						continue
 					}
@@ -159,6 +216,9 @@ func (ad *ArtifactDownloader) downloadFiles(artifactID string, manifest Manifest
 						Path:     downloadLocalPath,
 						Url:      *entry.DownloadURL,
 					}
+					if entry.Ref != nil {
+						task.ETag = entry.Digest
+					}
 					task.SetCompletionCallback(
 						func(t *filetransfer.Task) {
 							taskResultsChan <- TaskResult{t, *entry.LocalPath}
@@ -169,9 +229,20 @@ func (ad *ArtifactDownloader) downloadFiles(artifactID string, manifest Manifest
 				}
 			}
 			// Wait for downloader to catch up. If there's nothing more to schedule, wait for all in progress tasks.
-			for numInProgress > MAX_BACKLOG || (len(manifestEntriesBatch) == 0 && numInProgress > 0) {
+			for numInProgress > MAX_BACKLOG ||
+				((len(manifestEntriesBatch) == 0 || !hasNextPage) && numInProgress > 0) {
 				numInProgress--
 				result := <-taskResultsChan
+				if result.Task.Err != nil && nameToEntry[result.Name].Ref != nil {
+					if !ad.AllowMissingReferences ||
+						errorcode.Of(result.Task.Err) != service.ErrorInfo_NOT_FOUND {
+						return result.Task.Err
+					}
+					slog.Warn("Skipping missing reference", "ref", *nameToEntry[result.Name].Ref)
+					delete(nameToEntry, result.Name)
+					numDone++
+					continue
+				}
 				if result.Task.Err != nil {
 					// We want to retry when the signed URL expires. However, distinguishing that error from others is not
 					// trivial. As a heuristic, we retry if the request failed more than an hour after we fetched the URL.
@@ -182,7 +253,19 @@ func (ad *ArtifactDownloader) downloadFiles(artifactID string, manifest Manifest
 					continue
 				}
 				numDone++
-				digest := manifest.Contents[result.Name].Digest
+				entry := nameToEntry[result.Name]
+				delete(nameToEntry, result.Name)
+				compression, err := entry.Compression()
+				if err == nil {
+					err = decompressFile(result.Task.Path, compression)
+				}
+				if err != nil {
+					return err
+				}
+				if entry.Ref != nil {
+					continue
+				}
+				digest := entry.Digest
 				go func() {
 					err := ad.FileCache.AddFileAndCheckDigest(result.Task.Path, digest)
 					if err != nil {
@@ -191,18 +274,265 @@ func (ad *ArtifactDownloader) downloadFiles(artifactID string, manifest Manifest
 				}()
 			}
 		}
+
+		// Re-listing the files won't help if some aren't listed at all.
+		if numScheduled == 0 && numInProgress == 0 &&
+			numDone == numDoneBefore && numDone < numEntries {
+			return fmt.Errorf(
+				"server did not list %d of the artifact's files", numEntries-numDone)
+		}
 	}
 	return nil
 }
 
 func (ad *ArtifactDownloader) Download() (rerr error) {
-	artifactManifest, err := ad.getArtifactManifest(ad.ArtifactID)
+	artifactManifest, manifestPath, err := ad.getArtifactManifest(ad.ArtifactID)
 	if err != nil {
 		return err
 	}
+	defer os.Remove(manifestPath)
+	defer artifactManifest.Close()
+
+	// With a filter, only the matching files' URLs are fetched, by name.
+	var names []string
+	if !ad.Filter.IsEmpty() {
+		if names, err = ad.matchingFiles(artifactManifest); err != nil {
+			return err
+		}
+	}
 
-	if err := ad.downloadFiles(ad.ArtifactID, artifactManifest); err != nil {
+	if err := ad.downloadFiles(ad.ArtifactID, artifactManifest, names); err != nil {
 		return err
 	}
 	return nil
 }
+
+// Verify checks the files in the download root against the artifact's
+// manifest, hashing up to parallelism files at once.
+func (ad *ArtifactDownloader) Verify(parallelism int) (*VerifyReport, error) {
+	artifactManifest, manifestPath, err := ad.getArtifactManifest(ad.ArtifactID)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(manifestPath)
+	defer artifactManifest.Close()
+
+	return VerifyTree(ad.Ctx, artifactManifest, ad.DownloadRoot, parallelism)
+}
+
+// Repair re-downloads the files a verification found missing or corrupt,
+// and verifies the download root again.
+//
+// It returns the new report, in which files that now match are marked as
+// repaired.
+func (ad *ArtifactDownloader) Repair(
+	report *VerifyReport,
+	parallelism int,
+) (*VerifyReport, error) {
+	bad := report.BadPaths()
+	if len(bad) == 0 {
+		return report, nil
+	}
+
+	artifactManifest, manifestPath, err := ad.getArtifactManifest(ad.ArtifactID)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(manifestPath)
+	defer artifactManifest.Close()
+
+	if err := ad.downloadFiles(ad.ArtifactID, artifactManifest, bad); err != nil {
+		return nil, err
+	}
+
+	after, err := VerifyTree(ad.Ctx, artifactManifest, ad.DownloadRoot, parallelism)
+	if err != nil {
+		return nil, err
+	}
+	stillBad := map[string]bool{}
+	for _, path := range after.BadPaths() {
+		stillBad[path] = true
+	}
+	for _, result := range report.Results {
+		if result.Status != VerifyMismatch && result.Status != VerifyMissing {
+			continue
+		}
+		if stillBad[result.Path] {
+			continue
+		}
+		after.Results = append(after.Results, VerifyResult{
+			Path:   result.Path,
+			Status: VerifyRepaired,
+			Detail: "re-downloaded; was " + result.Detail,
+		})
+	}
+	slices.SortFunc(after.Results, func(a, b VerifyResult) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+	return after, nil
+}
+
+// ResolveArtifactID returns the ID of an artifact given its path, as
+// "entity/project/name:alias" or "entity/project/name", which means the
+// latest version.
+func ResolveArtifactID(
+	ctx context.Context,
+	client graphql.Client,
+	path string,
+) (string, error) {
+	parts := strings.Split(path, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", fmt.Errorf(
+			"%q is not an artifact path like entity/project/name:alias", path)
+	}
+	name := parts[2]
+	if !strings.Contains(name, ":") {
+		name += ":latest"
+	}
+
+	response, err := gql.ArtifactIDByName(ctx, client, parts[0], parts[1], name)
+	switch {
+	case err != nil:
+		return "", err
+	case response.Project == nil:
+		return "", fmt.Errorf("project %s/%s not found", parts[0], parts[1])
+	case response.Project.Artifact == nil:
+		return "", fmt.Errorf("artifact %s not found", path)
+	default:
+		return response.Project.Artifact.Id, nil
+	}
+}
+
+// matchingFiles returns the paths of the files to download that match the
+// downloader's filter.
+func (ad *ArtifactDownloader) matchingFiles(manifest *ManifestReader) ([]string, error) {
+	names := []string{}
+	err := manifest.ForEachWithPrefix(
+		ad.Filter.StringPrefix(),
+		func(name string, entry ManifestEntry) error {
+			// Other references are handled by the python user process
+			if (entry.Ref == nil || isCoreReference(entry)) && ad.Filter.Matches(name) {
+				names = append(names, name)
+			}
+			return nil
+		},
+	)
+	return names, err
+}
+
+// isCoreReference returns whether a reference entry is downloaded by
+// wandb-core rather than by the Python user process.
+//
+// Only s3:// and gs:// references logged with checksums are, since the
+// ETag in their digest makes sure the object hasn't changed.
+func isCoreReference(entry ManifestEntry) bool {
+	return entry.Ref != nil &&
+		(strings.HasPrefix(*entry.Ref, "s3://") ||
+			strings.HasPrefix(*entry.Ref, "gs://")) &&
+		entry.Digest != *entry.Ref
+}
+
+// referenceURL returns the URL to download a reference entry from,
+// including the object version it was logged with, if known.
+func referenceURL(entry ManifestEntry) string {
+	var version string
+	switch v := entry.Extra["versionID"].(type) {
+	case string:
+		version = v
+	case float64:
+		// GCS generations are numbers.
+		version = strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	if version == "" {
+		return *entry.Ref
+	}
+
+	param := "versionId"
+	if strings.HasPrefix(*entry.Ref, "gs://") {
+		param = "generation"
+	}
+	return *entry.Ref + "?" + param + "=" + url.QueryEscape(version)
+}
+
+// fileURL is the download URL of a file in an artifact.
+type fileURL struct {
+	Name      string
+	DirectURL string
+}
+
+// fileURLPage is a page of an artifact's files.
+type fileURLPage struct {
+	Files       []fileURL
+	HasNextPage bool
+	EndCursor   *string
+}
+
+// fetchFileURLs returns a page of the download URLs of an artifact's
+// files.
+//
+// If names is not nil, only the URLs of the named files are fetched, and
+// the cursor is the index of the next name.
+func (ad *ArtifactDownloader) fetchFileURLs(
+	artifactID string,
+	names []string,
+	cursor *string,
+	batchSize int,
+) (fileURLPage, error) {
+	if names == nil {
+		response, err := gql.ArtifactFileURLs(
+			ad.Ctx,
+			ad.GraphqlClient,
+			artifactID,
+			cursor,
+			&batchSize,
+		)
+		if err != nil {
+			return fileURLPage{}, err
+		}
+
+		files := response.GetArtifact().GetFiles()
+		page := fileURLPage{
+			HasNextPage: files.PageInfo.HasNextPage,
+			EndCursor:   files.PageInfo.EndCursor,
+		}
+		for _, edge := range files.Edges {
+			node := edge.GetNode()
+			if node == nil {
+				return fileURLPage{}, fmt.Errorf("error reading entry from fetched file urls")
+			}
+			page.Files = append(page.Files, fileURL{node.Name, node.DirectUrl})
+		}
+		return page, nil
+	}
+
+	start := 0
+	if cursor != nil {
+		start, _ = strconv.Atoi(*cursor)
+	}
+	end := min(start+batchSize, len(names))
+
+	perPage := end - start
+	response, err := gql.ArtifactFileURLsByNames(
+		ad.Ctx,
+		ad.GraphqlClient,
+		artifactID,
+		names[start:end],
+		&perPage,
+	)
+	if err != nil {
+		return fileURLPage{}, err
+	}
+	if response.Artifact == nil {
+		return fileURLPage{}, fmt.Errorf("could not access artifact")
+	}
+
+	endCursor := strconv.Itoa(end)
+	page := fileURLPage{HasNextPage: end < len(names), EndCursor: &endCursor}
+	for _, edge := range response.Artifact.Files.Edges {
+		if edge.Node == nil {
+			return fileURLPage{}, fmt.Errorf("error reading entry from fetched file urls")
+		}
+		page.Files = append(page.Files, fileURL{edge.Node.Name, edge.Node.DirectUrl})
+	}
+	return page, nil
+}
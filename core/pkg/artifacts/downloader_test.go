@@ -0,0 +1,279 @@
+package artifacts
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/segmentio-encoding/json"
+
+	"github.com/wandb/wandb/core/internal/errorcode"
+	"github.com/wandb/wandb/core/internal/filetransfer"
+	"github.com/wandb/wandb/core/internal/gqlmock"
+	"github.com/wandb/wandb/core/pkg/service"
+	"github.com/wandb/wandb/core/pkg/utils"
+)
+
+// fakeDownloads completes download tasks in the background by writing
+// the contents stored for their URLs, or failing with the errors stored
+// for them.
+type fakeDownloads struct {
+	mu       sync.Mutex
+	contents map[string][]byte
+	errs     map[string]error
+	urls     []string
+	etags    []string
+}
+
+func (f *fakeDownloads) Start() {}
+func (f *fakeDownloads) Close() {}
+
+func (f *fakeDownloads) WaitForUploadWindow(context.Context, int64) {}
+func (f *fakeDownloads) IgnoreUploadWindow()                        {}
+
+func (f *fakeDownloads) AddTask(task *filetransfer.Task) {
+	f.mu.Lock()
+	f.urls = append(f.urls, task.Url)
+	f.etags = append(f.etags, task.ETag)
+	data := f.contents[task.Url]
+	err := f.errs[task.Url]
+	f.mu.Unlock()
+
+	go func() {
+		if err != nil {
+			task.Err = err
+			task.CompletionCallback(task)
+			return
+		}
+		task.Err = os.MkdirAll(filepath.Dir(task.Path), 0755)
+		if task.Err == nil {
+			task.Err = os.WriteFile(task.Path, data, 0644)
+		}
+		task.CompletionCallback(task)
+	}()
+}
+
+func TestDownload_Filtered(t *testing.T) {
+	files := map[string]string{
+		"ckpt/epoch-1/model.pt":  "model 1",
+		"ckpt/epoch-1/notes.txt": "notes",
+		"ckpt/epoch-2/model.pt":  "model 2",
+		"data/train.csv":         "a,b",
+	}
+	manifest := Manifest{Version: 1, Contents: map[string]ManifestEntry{}}
+	downloads := &fakeDownloads{contents: map[string][]byte{}}
+	for name, data := range files {
+		manifest.Contents[name] = ManifestEntry{
+			Digest: utils.ComputeB64MD5([]byte(data)),
+			Size:   int64(len(data)),
+		}
+		downloads.contents["https://files/"+name] = []byte(data)
+	}
+	manifestJSON, err := json.Marshal(manifest)
+	require.NoError(t, err)
+	downloads.contents["https://manifest"] = manifestJSON
+
+	client := gqlmock.NewMockClient()
+	client.StubMatchOnce(
+		gqlmock.WithOpName("ArtifactManifest"),
+		`{"artifact": {"currentManifest": {"file": {"directUrl": "https://manifest"}}}}`,
+	)
+	client.StubMatchOnce(
+		gqlmock.WithOpName("ArtifactFileURLsByNames"),
+		`{"artifact": {"files": {"edges": [
+			{"node": {"name": "ckpt/epoch-1/model.pt",
+				"directUrl": "https://files/ckpt/epoch-1/model.pt"}}
+		]}}}`,
+	)
+
+	root := t.TempDir()
+	downloader := NewArtifactDownloader(
+		context.Background(),
+		client,
+		downloads,
+		"artifact-id",
+		root,
+		false,
+		true,
+		"ckpt/epoch-1",
+		[]string{"**/*.pt"},
+	)
+
+	require.NoError(t, downloader.Download())
+
+	data, err := os.ReadFile(filepath.Join(root, "ckpt", "epoch-1", "model.pt"))
+	require.NoError(t, err)
+	assert.Equal(t, "model 1", string(data))
+	assert.Equal(t,
+		[]string{"https://manifest", "https://files/ckpt/epoch-1/model.pt"},
+		downloads.urls)
+
+	requests := client.AllRequests()
+	require.Len(t, requests, 2)
+	gqlmock.AssertRequest(t,
+		gqlmock.WithVariables(
+			gqlmock.GQLVar("names", gomock.Eq([]any{"ckpt/epoch-1/model.pt"})),
+		),
+		requests[1])
+}
+
+func TestDownload_FileNotListed(t *testing.T) {
+	manifest := Manifest{Version: 1, Contents: map[string]ManifestEntry{
+		"model.pt": {Digest: "digest", Size: 1},
+	}}
+	manifestJSON, err := json.Marshal(manifest)
+	require.NoError(t, err)
+	downloads := &fakeDownloads{contents: map[string][]byte{
+		"https://manifest": manifestJSON,
+	}}
+
+	client := gqlmock.NewMockClient()
+	client.StubMatchOnce(
+		gqlmock.WithOpName("ArtifactManifest"),
+		`{"artifact": {"currentManifest": {"file": {"directUrl": "https://manifest"}}}}`,
+	)
+	client.StubMatchOnce(
+		gqlmock.WithOpName("ArtifactFileURLsByNames"),
+		`{"artifact": {"files": {"edges": []}}}`,
+	)
+
+	err = NewArtifactDownloader(
+		context.Background(),
+		client,
+		downloads,
+		"artifact-id",
+		t.TempDir(),
+		false,
+		true,
+		"",
+		[]string{"*.pt"},
+	).Download()
+
+	assert.True(t, err != nil && strings.Contains(err.Error(), "did not list 1"), err)
+}
+
+// s3ReferenceManifest returns a manifest with an s3:// reference logged
+// with a checksum, and one logged without.
+func s3ReferenceManifest(t *testing.T) []byte {
+	t.Helper()
+	checked := "s3://bucket/data.csv"
+	unchecked := "s3://bucket/raw/"
+	manifest := Manifest{Version: 1, Contents: map[string]ManifestEntry{
+		"data.csv": {
+			Ref:    &checked,
+			Digest: "etag",
+			Size:   3,
+			Extra:  map[string]interface{}{"versionID": "v1"},
+		},
+		"raw": {Ref: &unchecked, Digest: unchecked},
+	}}
+	manifestJSON, err := json.Marshal(manifest)
+	require.NoError(t, err)
+	return manifestJSON
+}
+
+func stubS3ReferenceFiles(client *gqlmock.MockClient) {
+	client.StubMatchOnce(
+		gqlmock.WithOpName("ArtifactManifest"),
+		`{"artifact": {"currentManifest": {"file": {"directUrl": "https://manifest"}}}}`,
+	)
+	client.StubMatchOnce(
+		gqlmock.WithOpName("ArtifactFileURLs"),
+		`{"artifact": {"files": {
+			"pageInfo": {"hasNextPage": false},
+			"edges": [
+				{"node": {"name": "data.csv", "directUrl": "https://files/data.csv"}},
+				{"node": {"name": "raw", "directUrl": "https://files/raw"}}
+			]
+		}}}`,
+	)
+}
+
+func TestDownload_S3Reference(t *testing.T) {
+	downloads := &fakeDownloads{contents: map[string][]byte{
+		"https://manifest":                  s3ReferenceManifest(t),
+		"s3://bucket/data.csv?versionId=v1": []byte("a,b"),
+	}}
+	client := gqlmock.NewMockClient()
+	stubS3ReferenceFiles(client)
+
+	root := t.TempDir()
+	err := NewArtifactDownloader(
+		context.Background(),
+		client,
+		downloads,
+		"artifact-id",
+		root,
+		false,
+		true,
+		"",
+		nil,
+	).Download()
+
+	require.NoError(t, err)
+	data, err := os.ReadFile(filepath.Join(root, "data.csv"))
+	require.NoError(t, err)
+	assert.Equal(t, "a,b", string(data))
+	assert.Equal(t,
+		[]string{"https://manifest", "s3://bucket/data.csv?versionId=v1"},
+		downloads.urls)
+	assert.Equal(t, "etag", downloads.etags[1])
+}
+
+func TestDownload_S3Reference_Missing(t *testing.T) {
+	for _, allowMissing := range []bool{false, true} {
+		downloads := &fakeDownloads{
+			contents: map[string][]byte{"https://manifest": s3ReferenceManifest(t)},
+			errs: map[string]error{
+				"s3://bucket/data.csv?versionId=v1": errorcode.Wrap(
+					service.ErrorInfo_NOT_FOUND, errors.New("no such key")),
+			},
+		}
+		client := gqlmock.NewMockClient()
+		stubS3ReferenceFiles(client)
+
+		err := NewArtifactDownloader(
+			context.Background(),
+			client,
+			downloads,
+			"artifact-id",
+			t.TempDir(),
+			allowMissing,
+			true,
+			"",
+			nil,
+		).Download()
+
+		if allowMissing {
+			assert.NoError(t, err)
+		} else {
+			assert.ErrorContains(t, err, "no such key")
+		}
+	}
+}
+
+func TestReferenceURL(t *testing.T) {
+	s3Ref := "s3://bucket/key"
+	gcsRef := "gs://bucket/key"
+
+	assert.Equal(t,
+		"s3://bucket/key?versionId=a%2Bb",
+		referenceURL(ManifestEntry{
+			Ref:   &s3Ref,
+			Extra: map[string]interface{}{"versionID": "a+b"},
+		}))
+	assert.Equal(t,
+		"gs://bucket/key?generation=1717171717171717",
+		referenceURL(ManifestEntry{
+			Ref:   &gcsRef,
+			Extra: map[string]interface{}{"versionID": float64(1717171717171717)},
+		}))
+	assert.Equal(t, "gs://bucket/key", referenceURL(ManifestEntry{Ref: &gcsRef}))
+}
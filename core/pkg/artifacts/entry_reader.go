@@ -0,0 +1,266 @@
+package artifacts
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"sync"
+)
+
+// ErrDigestMismatch is returned when the contents of a manifest entry do not
+// match its digest or size.
+var ErrDigestMismatch = errors.New("artifacts: entry digest mismatch")
+
+const (
+	// DefaultPrefetchWindowSize is the default number of bytes read at a time
+	// by a prefetching reader.
+	DefaultPrefetchWindowSize = 1 << 20
+
+	// DefaultPrefetchWindows is the default number of windows a prefetching
+	// reader reads ahead.
+	DefaultPrefetchWindows = 4
+)
+
+// PrefetchOptions configures how far an entry reader reads ahead.
+type PrefetchOptions struct {
+	// WindowSize is the number of bytes read at a time.
+	//
+	// Uses DefaultPrefetchWindowSize if not positive.
+	WindowSize int
+
+	// Windows is the number of windows to read ahead of the caller.
+	//
+	// If zero, the entry is read on demand without prefetching.
+	Windows int
+}
+
+// DefaultPrefetchOptions returns the options used by data loaders unless
+// they configure their own read-ahead.
+func DefaultPrefetchOptions() PrefetchOptions {
+	return PrefetchOptions{
+		WindowSize: DefaultPrefetchWindowSize,
+		Windows:    DefaultPrefetchWindows,
+	}
+}
+
+// NewEntryReader wraps the contents of a manifest entry so that they're
+// verified against the entry's digest and size while being read.
+//
+// Reading returns an error wrapping ErrDigestMismatch as soon as more bytes
+// than the entry's size are read, and at the end of the stream if the MD5
+// digest doesn't match. Digests that are not base-64 MD5 hashes, such as the
+// ETags of some reference entries, are not checked.
+//
+// With prefetching, the contents are read and verified in the background,
+// so corruption is usually reported before the caller reaches it.
+func NewEntryReader(
+	src io.ReadCloser,
+	entry ManifestEntry,
+	prefetch PrefetchOptions,
+) io.ReadCloser {
+	reader := newVerifyingReader(src, entry.Digest, entry.Size)
+	if prefetch.Windows <= 0 {
+		return reader
+	}
+	return newPrefetchingReader(reader, prefetch)
+}
+
+// OpenEntry opens the cached copy of a manifest entry for streaming.
+//
+// See NewEntryReader.
+func (c *FileCache) OpenEntry(
+	entry ManifestEntry,
+	prefetch PrefetchOptions,
+) (io.ReadCloser, error) {
+	cachePath, err := c.md5Path(entry.Digest)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(cachePath)
+	if err != nil {
+		return nil, err
+	}
+	return NewEntryReader(f, entry, prefetch), nil
+}
+
+// verifyingReader hashes the bytes it reads and checks them at EOF.
+type verifyingReader struct {
+	src io.ReadCloser
+
+	// hasher is nil if the expected digest isn't an MD5 hash.
+	hasher hash.Hash
+	digest string
+
+	// size is the expected size, or a negative number if unknown.
+	size int64
+	read int64
+
+	// err is returned by all reads after the stream ends or fails.
+	err error
+}
+
+func newVerifyingReader(
+	src io.ReadCloser,
+	digest string,
+	size int64,
+) *verifyingReader {
+	r := &verifyingReader{src: src, digest: digest, size: size}
+	if decoded, err := base64.StdEncoding.DecodeString(digest); err == nil &&
+		len(decoded) == md5.Size {
+		r.hasher = md5.New()
+	}
+	return r
+}
+
+func (r *verifyingReader) Read(p []byte) (int, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+
+	n, err := r.src.Read(p)
+	if r.hasher != nil {
+		r.hasher.Write(p[:n])
+	}
+	r.read += int64(n)
+
+	switch {
+	case r.size >= 0 && r.read > r.size:
+		r.err = fmt.Errorf(
+			"%w: read more than the expected %d bytes", ErrDigestMismatch, r.size)
+	case errors.Is(err, io.EOF):
+		r.err = r.checkAtEOF()
+	case err != nil:
+		r.err = err
+	}
+
+	return n, r.err
+}
+
+func (r *verifyingReader) checkAtEOF() error {
+	if r.size >= 0 && r.read != r.size {
+		return fmt.Errorf(
+			"%w: expected %d bytes, got %d", ErrDigestMismatch, r.size, r.read)
+	}
+
+	if r.hasher != nil {
+		actual := base64.StdEncoding.EncodeToString(r.hasher.Sum(nil))
+		if actual != r.digest {
+			return fmt.Errorf(
+				"%w: expected %s, got %s", ErrDigestMismatch, r.digest, actual)
+		}
+	}
+
+	return io.EOF
+}
+
+func (r *verifyingReader) Close() error {
+	return r.src.Close()
+}
+
+// prefetchingReader reads windows of its source in a goroutine.
+type prefetchingReader struct {
+	src io.ReadCloser
+
+	windows chan prefetchedWindow
+	done    chan struct{}
+	wg      sync.WaitGroup
+
+	closeOnce sync.Once
+	closeErr  error
+
+	// current is the unread part of the window being read.
+	current []byte
+
+	// err is the error that ended the stream, returned once current
+	// is exhausted.
+	err error
+}
+
+type prefetchedWindow struct {
+	data []byte
+	err  error
+}
+
+func newPrefetchingReader(
+	src io.ReadCloser,
+	opts PrefetchOptions,
+) *prefetchingReader {
+	windowSize := opts.WindowSize
+	if windowSize <= 0 {
+		windowSize = DefaultPrefetchWindowSize
+	}
+
+	r := &prefetchingReader{
+		src:     src,
+		windows: make(chan prefetchedWindow, opts.Windows),
+		done:    make(chan struct{}),
+	}
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		r.prefetch(windowSize)
+	}()
+
+	return r
+}
+
+// prefetch reads the source until it ends, fails or the reader is closed.
+func (r *prefetchingReader) prefetch(windowSize int) {
+	for {
+		buf := make([]byte, windowSize)
+		n, err := io.ReadFull(r.src, buf)
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			err = io.EOF
+		}
+
+		select {
+		case r.windows <- prefetchedWindow{data: buf[:n], err: err}:
+		case <-r.done:
+			return
+		}
+
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (r *prefetchingReader) Read(p []byte) (int, error) {
+	select {
+	case <-r.done:
+		return 0, os.ErrClosed
+	default:
+	}
+
+	for len(r.current) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+
+		select {
+		case window := <-r.windows:
+			r.current = window.data
+			r.err = window.err
+		case <-r.done:
+			return 0, os.ErrClosed
+		}
+	}
+
+	n := copy(p, r.current)
+	r.current = r.current[n:]
+	return n, nil
+}
+
+func (r *prefetchingReader) Close() error {
+	r.closeOnce.Do(func() {
+		close(r.done)
+		r.closeErr = r.src.Close()
+		r.wg.Wait()
+	})
+	return r.closeErr
+}
@@ -0,0 +1,133 @@
+package artifacts
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wandb/wandb/core/pkg/utils"
+)
+
+func entryFor(data []byte) ManifestEntry {
+	return ManifestEntry{
+		Digest: utils.ComputeB64MD5(data),
+		Size:   int64(len(data)),
+	}
+}
+
+func TestEntryReader_ReadsContents(t *testing.T) {
+	data := bytes.Repeat([]byte("0123456789"), 1000)
+
+	for _, prefetch := range []PrefetchOptions{
+		{},
+		{WindowSize: 7, Windows: 1},
+		{WindowSize: 4096, Windows: 3},
+		DefaultPrefetchOptions(),
+	} {
+		reader := NewEntryReader(
+			io.NopCloser(bytes.NewReader(data)), entryFor(data), prefetch)
+
+		contents, err := io.ReadAll(reader)
+
+		require.NoError(t, err)
+		assert.Equal(t, data, contents)
+		assert.NoError(t, reader.Close())
+	}
+}
+
+func TestEntryReader_DigestMismatch(t *testing.T) {
+	entry := entryFor([]byte("expected contents"))
+
+	for _, prefetch := range []PrefetchOptions{{}, {WindowSize: 4, Windows: 2}} {
+		reader := NewEntryReader(
+			io.NopCloser(bytes.NewReader([]byte("corrupted content"))),
+			entry,
+			prefetch,
+		)
+
+		_, err := io.ReadAll(reader)
+
+		assert.ErrorIs(t, err, ErrDigestMismatch)
+	}
+}
+
+func TestEntryReader_TooLong(t *testing.T) {
+	entry := ManifestEntry{Digest: "not-md5", Size: 4}
+	reader := NewEntryReader(
+		io.NopCloser(bytes.NewReader([]byte("more than four bytes"))),
+		entry,
+		PrefetchOptions{},
+	)
+
+	_, err := reader.Read(make([]byte, 8))
+
+	assert.ErrorIs(t, err, ErrDigestMismatch)
+}
+
+func TestEntryReader_TooShort(t *testing.T) {
+	entry := ManifestEntry{Digest: "not-md5", Size: 100}
+	reader := NewEntryReader(
+		io.NopCloser(bytes.NewReader([]byte("short"))),
+		entry,
+		PrefetchOptions{Windows: 1},
+	)
+
+	_, err := io.ReadAll(reader)
+
+	assert.ErrorIs(t, err, ErrDigestMismatch)
+}
+
+func TestEntryReader_CloseStopsPrefetching(t *testing.T) {
+	data := bytes.Repeat([]byte("x"), 1000)
+	reader := NewEntryReader(
+		io.NopCloser(bytes.NewReader(data)),
+		entryFor(data),
+		PrefetchOptions{WindowSize: 1, Windows: 1},
+	)
+
+	_, err := reader.Read(make([]byte, 1))
+	require.NoError(t, err)
+
+	assert.NoError(t, reader.Close())
+	_, err = reader.Read(make([]byte, 1))
+	assert.ErrorIs(t, err, os.ErrClosed)
+}
+
+func TestFileCache_OpenEntry(t *testing.T) {
+	cache, cleanup := setupTestEnvironment(t)
+	defer cleanup()
+	data := []byte("cached contents")
+	_, err := cache.Write(bytes.NewReader(data))
+	require.NoError(t, err)
+
+	reader, err := cache.OpenEntry(entryFor(data), DefaultPrefetchOptions())
+	require.NoError(t, err)
+	defer reader.Close()
+	contents, err := io.ReadAll(reader)
+
+	require.NoError(t, err)
+	assert.Equal(t, data, contents)
+}
+
+func TestFileCache_OpenEntry_Corrupted(t *testing.T) {
+	cache, cleanup := setupTestEnvironment(t)
+	defer cleanup()
+	data := []byte("cached contents")
+	_, err := cache.Write(bytes.NewReader(data))
+	require.NoError(t, err)
+	entry := entryFor(data)
+	cachePath, err := cache.md5Path(entry.Digest)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(cachePath, []byte("cached c0ntents"), 0600))
+
+	reader, err := cache.OpenEntry(entry, DefaultPrefetchOptions())
+	require.NoError(t, err)
+	defer reader.Close()
+	_, err = io.ReadAll(reader)
+
+	assert.ErrorIs(t, err, ErrDigestMismatch)
+}
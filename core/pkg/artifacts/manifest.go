@@ -2,10 +2,7 @@ package artifacts
 
 import (
 	"fmt"
-	"io"
-	"net/http"
 
-	"github.com/hashicorp/go-retryablehttp"
 	"github.com/wandb/segmentio-encoding/json"
 
 	"github.com/wandb/wandb/core/pkg/service"
@@ -80,25 +77,3 @@ func (m *Manifest) GetManifestEntryFromArtifactFilePath(path string) (ManifestEn
 	}
 	return manifestEntry, nil
 }
-
-func loadManifestFromURL(url string) (Manifest, error) {
-	resp, err := retryablehttp.NewClient().Get(url)
-
-	if err != nil {
-		return Manifest{}, err
-	}
-	defer resp.Body.Close()
-	manifest := Manifest{}
-	if resp.StatusCode != http.StatusOK {
-		return Manifest{}, fmt.Errorf("request to get manifest from url failed with status code: %d", resp.StatusCode)
-	}
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return Manifest{}, fmt.Errorf("error reading response body: %v", err)
-	}
-	err = json.Unmarshal(body, &manifest)
-	if err != nil {
-		return Manifest{}, nil
-	}
-	return manifest, nil
-}
@@ -0,0 +1,506 @@
+package artifacts
+
+import (
+	"bufio"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/hex"
+	stdjson "encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/wandb/segmentio-encoding/json"
+)
+
+// Version 2 manifests are indexed so that they can be read without
+// loading every entry into memory.
+//
+// A version 2 manifest is still a single JSON object with the same fields
+// as a version 1 manifest, so readers that load manifests whole can read
+// it. Before "contents" it has two more fields:
+//
+//   - "directories" maps each directory in the artifact, including the
+//     root "", to the number and total size of the files under it and a
+//     rollup digest of their paths and digests.
+//   - "partitions" splits "contents", whose entries are sorted by path,
+//     into runs of consecutive entries. Each partition has its first and
+//     last path and the byte range of its entries, relative to the first
+//     byte after the opening brace of "contents".
+//
+// A reader can therefore find an entry by decoding the small header,
+// choosing the partition whose range covers the path, and decoding only
+// that partition's bytes.
+
+const (
+	// ManifestV2MinEntries is the number of entries from which artifacts
+	// are saved with a version 2 manifest, if the _artifact_manifest_v2
+	// setting is on.
+	ManifestV2MinEntries = 100_000
+
+	// DefaultManifestPartitionSize is the number of entries in each
+	// partition of a version 2 manifest.
+	DefaultManifestPartitionSize = 10_000
+
+	// maxCachedPartitions is the number of decoded partitions a
+	// ManifestReader keeps in memory.
+	maxCachedPartitions = 4
+)
+
+// ManifestDirectory summarizes the files under a directory of an artifact.
+type ManifestDirectory struct {
+	// Digest is a hex MD5 hash of the directory's files' paths, relative
+	// to the directory, and digests.
+	//
+	// Two directories have the same digest if and only if they contain the
+	// same files with the same contents.
+	Digest string `json:"digest"`
+
+	// Size is the total size of the files in bytes.
+	Size int64 `json:"size"`
+
+	// NumFiles is the number of files, including those in subdirectories.
+	NumFiles int64 `json:"numFiles"`
+}
+
+// manifestPartition is the index of a range of a manifest's entries.
+type manifestPartition struct {
+	First  string `json:"first"`
+	Last   string `json:"last"`
+	Count  int    `json:"count"`
+	Offset int64  `json:"offset"`
+	Length int64  `json:"length"`
+}
+
+// WriteManifestV2 writes a manifest in the version 2 format.
+//
+// partitionSize is the number of entries per partition, or
+// DefaultManifestPartitionSize if not positive.
+func WriteManifestV2(w io.Writer, m *Manifest, partitionSize int) error {
+	if partitionSize <= 0 {
+		partitionSize = DefaultManifestPartitionSize
+	}
+
+	paths := make([]string, 0, len(m.Contents))
+	for name := range m.Contents {
+		paths = append(paths, name)
+	}
+	sort.Strings(paths)
+
+	// The partitions' offsets precede the entries, so the entries are
+	// encoded once to measure them and again to write them. Keeping them
+	// all encoded would double the memory used by large manifests.
+	var partitions []manifestPartition
+	var offset int64
+	for start := 0; start < len(paths); start += partitionSize {
+		end := min(start+partitionSize, len(paths))
+		if start > 0 {
+			offset++ // the comma between partitions
+		}
+
+		partition := manifestPartition{
+			First:  paths[start],
+			Last:   paths[end-1],
+			Count:  end - start,
+			Offset: offset,
+		}
+		for i, name := range paths[start:end] {
+			data, err := encodeManifestEntry(name, m.Contents[name])
+			if err != nil {
+				return err
+			}
+			if i > 0 {
+				partition.Length++
+			}
+			partition.Length += int64(len(data))
+		}
+		offset += partition.Length
+		partitions = append(partitions, partition)
+	}
+
+	header, err := json.Marshal(struct {
+		Version             int32                        `json:"version"`
+		StoragePolicy       string                       `json:"storagePolicy"`
+		StoragePolicyConfig StoragePolicyConfig          `json:"storagePolicyConfig"`
+		Directories         map[string]ManifestDirectory `json:"directories"`
+		Partitions          []manifestPartition          `json:"partitions"`
+	}{
+		Version:             2,
+		StoragePolicy:       m.StoragePolicy,
+		StoragePolicyConfig: m.StoragePolicyConfig,
+		Directories:         manifestDirectories(paths, m.Contents),
+		Partitions:          partitions,
+	})
+	if err != nil {
+		return fmt.Errorf("artifacts: failed to encode manifest: %v", err)
+	}
+
+	bw := bufio.NewWriter(w)
+	// Replace the header's closing brace by the contents.
+	_, _ = bw.Write(header[:len(header)-1])
+	_, _ = bw.WriteString(`,"contents":{`)
+	for i, name := range paths {
+		data, err := encodeManifestEntry(name, m.Contents[name])
+		if err != nil {
+			return err
+		}
+		if i > 0 {
+			_ = bw.WriteByte(',')
+		}
+		_, _ = bw.Write(data)
+	}
+	_, _ = bw.WriteString("}}")
+	return bw.Flush()
+}
+
+// encodeManifestEntry returns the JSON of an entry in a manifest's
+// contents, as `"path":{...}`.
+func encodeManifestEntry(name string, entry ManifestEntry) ([]byte, error) {
+	key, err := json.Marshal(name)
+	if err != nil {
+		return nil, fmt.Errorf("artifacts: failed to encode manifest: %v", err)
+	}
+	value, err := json.Marshal(entry)
+	if err != nil {
+		return nil, fmt.Errorf("artifacts: failed to encode manifest: %v", err)
+	}
+	data := append(key, ':')
+	return append(data, value...), nil
+}
+
+// manifestDirectories returns the summaries of the directories containing
+// the entries at the given sorted paths.
+func manifestDirectories(
+	paths []string,
+	contents map[string]ManifestEntry,
+) map[string]ManifestDirectory {
+	directories := make(map[string]ManifestDirectory)
+	hashers := make(map[string]hash.Hash)
+
+	for _, name := range paths {
+		entry := contents[name]
+		for _, dir := range parentDirs(name) {
+			hasher, ok := hashers[dir]
+			if !ok {
+				hasher = md5.New()
+				hashers[dir] = hasher
+			}
+			// Paths are sorted, so each directory's files are hashed in
+			// the same order every time.
+			rel := strings.TrimPrefix(name, dir+"/")
+			if dir == "" {
+				rel = name
+			}
+			fmt.Fprintf(hasher, "%s:%s\n", rel, entry.Digest)
+
+			summary := directories[dir]
+			summary.Size += entry.Size
+			summary.NumFiles++
+			directories[dir] = summary
+		}
+	}
+
+	for dir, hasher := range hashers {
+		summary := directories[dir]
+		summary.Digest = hex.EncodeToString(hasher.Sum(nil))
+		directories[dir] = summary
+	}
+	return directories
+}
+
+// parentDirs returns the directories containing a path in an artifact,
+// from the root "" to its immediate parent.
+func parentDirs(name string) []string {
+	dirs := []string{""}
+	for i, c := range name {
+		if c == '/' && i > 0 {
+			dirs = append(dirs, name[:i])
+		}
+	}
+	return dirs
+}
+
+// WriteToFileV2 writes the manifest in the version 2 format to a temporary
+// file, returning its path, base-64 MD5 digest and size.
+func (m *Manifest) WriteToFileV2() (filename string, digest string, size int64, rerr error) {
+	f, err := os.CreateTemp("", "tmpfile-")
+	if err != nil {
+		return "", "", 0, err
+	}
+	defer f.Close()
+
+	hasher := md5.New()
+	counter := &countingWriter{}
+	err = WriteManifestV2(
+		io.MultiWriter(f, hasher, counter), m, DefaultManifestPartitionSize)
+	if err != nil {
+		_ = os.Remove(f.Name())
+		return "", "", 0, err
+	}
+
+	digest = base64.StdEncoding.EncodeToString(hasher.Sum(nil))
+	return f.Name(), digest, counter.n, nil
+}
+
+// countingWriter counts the bytes written to it.
+type countingWriter struct{ n int64 }
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.n += int64(len(p))
+	return len(p), nil
+}
+
+// ManifestReader reads the entries of a manifest file on demand.
+//
+// For a version 2 manifest, only the header is read when the manifest is
+// opened, and entries are decoded a partition at a time as they're looked
+// up. A version 1 manifest is read whole when it's opened.
+type ManifestReader struct {
+	file *os.File
+
+	Version             int32
+	StoragePolicy       string
+	StoragePolicyConfig StoragePolicyConfig
+
+	// directories is nil for version 1 manifests.
+	directories map[string]ManifestDirectory
+	partitions  []manifestPartition
+
+	// contentsStart is the file offset that partition offsets are
+	// relative to.
+	contentsStart int64
+
+	// cache holds recently decoded partitions, by index.
+	cache      map[int]map[string]ManifestEntry
+	cacheOrder []int
+}
+
+// OpenManifest opens a manifest file for reading.
+func OpenManifest(path string) (*ManifestReader, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("artifacts: failed to open manifest: %v", err)
+	}
+
+	r := &ManifestReader{
+		file:  f,
+		cache: make(map[int]map[string]ManifestEntry),
+	}
+	if err := r.readHeader(); err != nil {
+		f.Close()
+		return nil, fmt.Errorf("artifacts: failed to parse manifest: %v", err)
+	}
+	return r, nil
+}
+
+// readHeader decodes the manifest's fields up to its contents.
+//
+// The contents of version 1 manifests are decoded into a single
+// partition.
+func (r *ManifestReader) readHeader() error {
+	// The standard decoder is used because it reports its offset.
+	dec := stdjson.NewDecoder(bufio.NewReader(r.file))
+	if err := expectDelim(dec, '{'); err != nil {
+		return err
+	}
+
+	hasContents := false
+	for dec.More() {
+		token, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key, _ := token.(string)
+
+		switch key {
+		case "version":
+			err = dec.Decode(&r.Version)
+		case "storagePolicy":
+			err = dec.Decode(&r.StoragePolicy)
+		case "storagePolicyConfig":
+			err = dec.Decode(&r.StoragePolicyConfig)
+		case "directories":
+			err = dec.Decode(&r.directories)
+		case "partitions":
+			err = dec.Decode(&r.partitions)
+		case "contents":
+			if r.Version >= 2 {
+				if err := expectDelim(dec, '{'); err != nil {
+					return err
+				}
+				r.contentsStart = dec.InputOffset()
+				return nil
+			}
+			err = r.readAllContents(dec)
+			hasContents = true
+		default:
+			var ignored stdjson.RawMessage
+			err = dec.Decode(&ignored)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if !hasContents {
+		return errors.New("no contents")
+	}
+	return nil
+}
+
+// readAllContents decodes the rest of a version 1 manifest's contents.
+func (r *ManifestReader) readAllContents(dec *stdjson.Decoder) error {
+	var raw stdjson.RawMessage
+	if err := dec.Decode(&raw); err != nil {
+		return err
+	}
+	contents := make(map[string]ManifestEntry)
+	if err := json.Unmarshal(raw, &contents); err != nil {
+		return err
+	}
+
+	r.partitions = []manifestPartition{{Count: len(contents)}}
+	if len(contents) > 0 {
+		paths := make([]string, 0, len(contents))
+		for name := range contents {
+			paths = append(paths, name)
+		}
+		sort.Strings(paths)
+		r.partitions[0].First = paths[0]
+		r.partitions[0].Last = paths[len(paths)-1]
+	}
+
+	// The partition is never evicted since it can't be read again.
+	r.cache[0] = contents
+	return nil
+}
+
+func expectDelim(dec *stdjson.Decoder, delim stdjson.Delim) error {
+	token, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if token != delim {
+		return fmt.Errorf("expected %v, got %v", delim, token)
+	}
+	return nil
+}
+
+// Close closes the manifest file.
+func (r *ManifestReader) Close() error {
+	return r.file.Close()
+}
+
+// Len returns the number of entries in the manifest.
+func (r *ManifestReader) Len() int {
+	n := 0
+	for _, partition := range r.partitions {
+		n += partition.Count
+	}
+	return n
+}
+
+// Entry returns the manifest entry for a path in the artifact.
+func (r *ManifestReader) Entry(name string) (ManifestEntry, error) {
+	// Find the first partition that doesn't end before the path.
+	i := sort.Search(len(r.partitions), func(i int) bool {
+		return r.partitions[i].Last >= name
+	})
+	if i < len(r.partitions) && r.partitions[i].First <= name {
+		contents, err := r.partition(i)
+		if err != nil {
+			return ManifestEntry{}, err
+		}
+		if entry, ok := contents[name]; ok {
+			return entry, nil
+		}
+	}
+	return ManifestEntry{}, fmt.Errorf("path not contained in artifact: %s", name)
+}
+
+// Directory returns the summary of a directory in the artifact, such as
+// "images/train" or "" for the whole artifact.
+//
+// It returns false if the directory doesn't exist or the manifest has no
+// directory summaries.
+func (r *ManifestReader) Directory(dir string) (ManifestDirectory, bool) {
+	summary, ok := r.directories[strings.TrimSuffix(dir, "/")]
+	return summary, ok
+}
+
+// ForEach calls fn for each entry in the manifest, in order of path for
+// version 2 manifests, stopping at the first error.
+func (r *ManifestReader) ForEach(fn func(name string, entry ManifestEntry) error) error {
+	return r.ForEachWithPrefix("", fn)
+}
+
+// ForEachWithPrefix is like ForEach, but only for the entries whose paths
+// start with a prefix.
+//
+// Partitions of version 2 manifests whose paths can't have the prefix
+// aren't read.
+func (r *ManifestReader) ForEachWithPrefix(
+	prefix string,
+	fn func(name string, entry ManifestEntry) error,
+) error {
+	for i, partition := range r.partitions {
+		if partition.Last < prefix {
+			continue
+		}
+		if partition.First > prefix && !strings.HasPrefix(partition.First, prefix) {
+			break
+		}
+
+		contents, err := r.partition(i)
+		if err != nil {
+			return err
+		}
+
+		paths := make([]string, 0, len(contents))
+		for name := range contents {
+			if strings.HasPrefix(name, prefix) {
+				paths = append(paths, name)
+			}
+		}
+		sort.Strings(paths)
+		for _, name := range paths {
+			if err := fn(name, contents[name]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// partition returns the entries of a partition, decoding it if it isn't
+// cached.
+func (r *ManifestReader) partition(i int) (map[string]ManifestEntry, error) {
+	if contents, ok := r.cache[i]; ok {
+		return contents, nil
+	}
+
+	partition := r.partitions[i]
+	data := make([]byte, partition.Length+2)
+	data[0] = '{'
+	data[len(data)-1] = '}'
+	_, err := r.file.ReadAt(data[1:len(data)-1], r.contentsStart+partition.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("artifacts: failed to read manifest: %v", err)
+	}
+
+	contents := make(map[string]ManifestEntry, partition.Count)
+	if err := json.Unmarshal(data, &contents); err != nil {
+		return nil, fmt.Errorf("artifacts: failed to parse manifest: %v", err)
+	}
+
+	if len(r.cacheOrder) >= maxCachedPartitions {
+		delete(r.cache, r.cacheOrder[0])
+		r.cacheOrder = r.cacheOrder[1:]
+	}
+	r.cache[i] = contents
+	r.cacheOrder = append(r.cacheOrder, i)
+	return contents, nil
+}
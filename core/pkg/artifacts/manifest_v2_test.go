@@ -0,0 +1,196 @@
+package artifacts
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/segmentio-encoding/json"
+)
+
+func testManifest(numEntries int) *Manifest {
+	manifest := &Manifest{
+		Version:             1,
+		StoragePolicy:       "wandb-storage-policy-v1",
+		StoragePolicyConfig: StoragePolicyConfig{StorageLayout: "V2"},
+		Contents:            make(map[string]ManifestEntry),
+	}
+	for i := 0; i < numEntries; i++ {
+		name := fmt.Sprintf("dir%d/file%03d.txt", i%3, i)
+		manifest.Contents[name] = ManifestEntry{
+			Digest: fmt.Sprintf("digest%d", i),
+			Size:   int64(i),
+		}
+	}
+	return manifest
+}
+
+func writeManifestV2(t *testing.T, manifest *Manifest, partitionSize int) string {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, WriteManifestV2(&buf, manifest, partitionSize))
+
+	path := filepath.Join(t.TempDir(), "manifest.json")
+	require.NoError(t, os.WriteFile(path, buf.Bytes(), 0644))
+	return path
+}
+
+func TestManifestV2_ReadableAsV1(t *testing.T) {
+	manifest := testManifest(20)
+	data, err := os.ReadFile(writeManifestV2(t, manifest, 3))
+	require.NoError(t, err)
+
+	var decoded Manifest
+	require.NoError(t, json.Unmarshal(data, &decoded))
+
+	assert.EqualValues(t, 2, decoded.Version)
+	assert.Equal(t, manifest.StoragePolicy, decoded.StoragePolicy)
+	assert.Equal(t, manifest.Contents, decoded.Contents)
+}
+
+func TestManifestReader_V2Entry(t *testing.T) {
+	manifest := testManifest(20)
+	reader, err := OpenManifest(writeManifestV2(t, manifest, 3))
+	require.NoError(t, err)
+	defer reader.Close()
+
+	assert.EqualValues(t, 2, reader.Version)
+	assert.Equal(t, 20, reader.Len())
+	for name, expected := range manifest.Contents {
+		entry, err := reader.Entry(name)
+		require.NoError(t, err)
+		assert.Equal(t, expected, entry)
+	}
+
+	_, err = reader.Entry("dir0/missing.txt")
+	assert.ErrorContains(t, err, "path not contained in artifact")
+}
+
+func TestManifestReader_V2ForEach(t *testing.T) {
+	manifest := testManifest(10)
+	reader, err := OpenManifest(writeManifestV2(t, manifest, 4))
+	require.NoError(t, err)
+	defer reader.Close()
+
+	var names []string
+	err = reader.ForEach(func(name string, entry ManifestEntry) error {
+		assert.Equal(t, manifest.Contents[name], entry)
+		names = append(names, name)
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, names, 10)
+	assert.IsIncreasing(t, names)
+}
+
+func TestManifestReader_V2Directories(t *testing.T) {
+	manifest := testManifest(6)
+	reader, err := OpenManifest(writeManifestV2(t, manifest, 0))
+	require.NoError(t, err)
+	defer reader.Close()
+
+	root, ok := reader.Directory("")
+	require.True(t, ok)
+	dir0, ok := reader.Directory("dir0/")
+	require.True(t, ok)
+	_, ok = reader.Directory("missing")
+	assert.False(t, ok)
+
+	assert.EqualValues(t, 6, root.NumFiles)
+	assert.EqualValues(t, 0+1+2+3+4+5, root.Size)
+	assert.EqualValues(t, 2, dir0.NumFiles) // files 0 and 3
+	assert.EqualValues(t, 0+3, dir0.Size)
+}
+
+func TestManifestReader_V2DirectoryDigest(t *testing.T) {
+	// The same files in different directories have the same digest.
+	manifest := &Manifest{Contents: map[string]ManifestEntry{
+		"a/x.txt":   {Digest: "1"},
+		"a/y/z.txt": {Digest: "2"},
+		"b/x.txt":   {Digest: "1"},
+		"b/y/z.txt": {Digest: "2"},
+		"c/x.txt":   {Digest: "3"},
+		"c/y/z.txt": {Digest: "2"},
+	}}
+	reader, err := OpenManifest(writeManifestV2(t, manifest, 0))
+	require.NoError(t, err)
+	defer reader.Close()
+
+	a, _ := reader.Directory("a")
+	b, _ := reader.Directory("b")
+	c, _ := reader.Directory("c")
+	ay, _ := reader.Directory("a/y")
+	cy, _ := reader.Directory("c/y")
+
+	assert.Equal(t, a.Digest, b.Digest)
+	assert.NotEqual(t, a.Digest, c.Digest)
+	assert.Equal(t, ay.Digest, cy.Digest)
+}
+
+func TestManifestReader_V1(t *testing.T) {
+	manifest := testManifest(5)
+	data, err := json.Marshal(manifest)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "manifest.json")
+	require.NoError(t, os.WriteFile(path, data, 0644))
+
+	reader, err := OpenManifest(path)
+	require.NoError(t, err)
+	defer reader.Close()
+
+	assert.EqualValues(t, 1, reader.Version)
+	assert.Equal(t, 5, reader.Len())
+	for name, expected := range manifest.Contents {
+		entry, err := reader.Entry(name)
+		require.NoError(t, err)
+		assert.Equal(t, expected, entry)
+	}
+	_, ok := reader.Directory("")
+	assert.False(t, ok)
+}
+
+func TestManifestReader_Empty(t *testing.T) {
+	reader, err := OpenManifest(writeManifestV2(t, testManifest(0), 0))
+	require.NoError(t, err)
+	defer reader.Close()
+
+	assert.Equal(t, 0, reader.Len())
+	_, err = reader.Entry("anything")
+	assert.Error(t, err)
+}
+
+func TestManifestReader_Invalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "manifest.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"version": 1}`), 0644))
+
+	_, err := OpenManifest(path)
+
+	assert.ErrorContains(t, err, "failed to parse manifest")
+}
+
+func TestManifestReader_ForEachWithPrefix(t *testing.T) {
+	manifest := testManifest(30)
+	reader, err := OpenManifest(writeManifestV2(t, manifest, 4))
+	require.NoError(t, err)
+	defer reader.Close()
+
+	var names []string
+	err = reader.ForEachWithPrefix("dir1/", func(name string, _ ManifestEntry) error {
+		names = append(names, name)
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, names, 10)
+	for _, name := range names {
+		assert.True(t, strings.HasPrefix(name, "dir1/"), name)
+	}
+	// Only the partitions with dir1 paths are decoded.
+	assert.Len(t, reader.cache, 3)
+}
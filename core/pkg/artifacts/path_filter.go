@@ -0,0 +1,76 @@
+package artifacts
+
+import (
+	"path"
+	"strings"
+
+	"github.com/wandb/wandb/core/internal/paths"
+)
+
+// PathFilter selects some of an artifact's files by their paths.
+type PathFilter struct {
+	// Prefix is a directory whose files are selected, such as
+	// "checkpoints/epoch-10", or empty to select files in any directory.
+	//
+	// A prefix that names a file selects just that file.
+	Prefix string
+
+	// Globs are patterns of which at least one must match the path of a
+	// selected file, or empty to select every file under Prefix.
+	//
+	// Patterns match whole paths with the syntax of path.Match, except
+	// that a "**" path segment matches any number of directories, as in
+	// "checkpoints/**/*.pt".
+	Globs []string
+}
+
+// IsEmpty returns whether the filter selects every file.
+func (f PathFilter) IsEmpty() bool {
+	return f.prefix() == "" && len(f.Globs) == 0
+}
+
+// Matches returns whether the filter selects the file at a path in an
+// artifact.
+func (f PathFilter) Matches(name string) bool {
+	if prefix := f.prefix(); prefix != "" &&
+		name != prefix && !strings.HasPrefix(name, prefix+"/") {
+		return false
+	}
+
+	if len(f.Globs) == 0 {
+		return true
+	}
+	for _, glob := range f.Globs {
+		if paths.MatchGlob(glob, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// StringPrefix returns a prefix of every path that the filter selects.
+//
+// Lookups in sorted manifests can skip paths without it.
+func (f PathFilter) StringPrefix() string {
+	if prefix := f.prefix(); prefix != "" {
+		return prefix
+	}
+	if len(f.Globs) != 1 {
+		return ""
+	}
+
+	glob := f.Globs[0]
+	if i := strings.IndexAny(glob, `*?[\`); i >= 0 {
+		return glob[:i]
+	}
+	return glob
+}
+
+// prefix returns the filter's prefix without leading or trailing slashes.
+func (f PathFilter) prefix() string {
+	prefix := strings.Trim(f.Prefix, "/")
+	if prefix == "" {
+		return ""
+	}
+	return path.Clean(prefix)
+}
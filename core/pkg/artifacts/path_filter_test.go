@@ -0,0 +1,71 @@
+package artifacts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPathFilter_Matches(t *testing.T) {
+	testCases := []struct {
+		name    string
+		filter  PathFilter
+		matches []string
+		misses  []string
+	}{
+		{
+			name:    "empty",
+			filter:  PathFilter{},
+			matches: []string{"a.txt", "dir/a.txt"},
+		},
+		{
+			name:    "directory prefix",
+			filter:  PathFilter{Prefix: "ckpt/epoch-1/"},
+			matches: []string{"ckpt/epoch-1/model.pt", "ckpt/epoch-1/opt/state.pt"},
+			misses:  []string{"ckpt/epoch-10/model.pt", "ckpt/model.pt"},
+		},
+		{
+			name:    "file prefix",
+			filter:  PathFilter{Prefix: "ckpt/model.pt"},
+			matches: []string{"ckpt/model.pt"},
+			misses:  []string{"ckpt/model.pt.bak"},
+		},
+		{
+			name:    "glob",
+			filter:  PathFilter{Globs: []string{"*.json", "ckpt/*.pt"}},
+			matches: []string{"config.json", "ckpt/model.pt"},
+			misses:  []string{"dir/config.json", "ckpt/sub/model.pt"},
+		},
+		{
+			name:    "double star",
+			filter:  PathFilter{Globs: []string{"ckpt/**/*.pt"}},
+			matches: []string{"ckpt/model.pt", "ckpt/a/b/model.pt"},
+			misses:  []string{"model.pt", "ckpt/a/model.json"},
+		},
+		{
+			name:    "prefix and glob",
+			filter:  PathFilter{Prefix: "ckpt", Globs: []string{"**/*.pt"}},
+			matches: []string{"ckpt/model.pt"},
+			misses:  []string{"other/model.pt"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, name := range tc.matches {
+				assert.True(t, tc.filter.Matches(name), name)
+			}
+			for _, name := range tc.misses {
+				assert.False(t, tc.filter.Matches(name), name)
+			}
+		})
+	}
+}
+
+func TestPathFilter_StringPrefix(t *testing.T) {
+	assert.Equal(t, "", PathFilter{}.StringPrefix())
+	assert.Equal(t, "ckpt/a", PathFilter{Prefix: "/ckpt/a/"}.StringPrefix())
+	assert.Equal(t, "ckpt/", PathFilter{Globs: []string{"ckpt/*.pt"}}.StringPrefix())
+	assert.Equal(t, "a.txt", PathFilter{Globs: []string{"a.txt"}}.StringPrefix())
+	assert.Equal(t, "", PathFilter{Globs: []string{"a/*", "b/*"}}.StringPrefix())
+}
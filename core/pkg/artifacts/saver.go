@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
@@ -24,6 +25,11 @@ type ArtifactSaver struct {
 	GraphqlClient       graphql.Client
 	FileTransferManager filetransfer.FileTransferManager
 	FileCache           Cache
+	UploadIndex         *UploadIndex
+	// ManifestV2 is whether to save artifacts with at least
+	// ManifestV2MinEntries entries with a version 2 manifest; it's off by
+	// default since older servers can only read version 1.
+	ManifestV2 bool
 	// Input.
 	Artifact         *service.ArtifactRecord
 	HistoryStep      int64
@@ -56,6 +62,7 @@ func NewArtifactSaver(
 		GraphqlClient:       graphQLClient,
 		FileTransferManager: uploadManager,
 		FileCache:           NewFileCache(UserCacheDir()),
+		UploadIndex:         NewUploadIndex(UserCacheDir()),
 		Artifact:            artifact,
 		HistoryStep:         historyStep,
 		StagingDir:          stagingDir,
@@ -141,10 +148,12 @@ func (as *ArtifactSaver) createManifest(
 func (as *ArtifactSaver) uploadFiles(
 	artifactID string, manifest *Manifest, manifestID string, _ chan<- *service.Record,
 ) error {
+	deduplicated := as.deduplicateFiles(manifest)
+
 	// Prepare GQL input for files that (might) need to be uploaded.
 	namedFileSpecs := map[string]gql.CreateArtifactFileSpecInput{}
 	for name, entry := range manifest.Contents {
-		if entry.LocalPath == nil {
+		if entry.LocalPath == nil || deduplicated[name] {
 			continue
 		}
 		fileSpec := gql.CreateArtifactFileSpecInput{
@@ -201,12 +210,32 @@ func (as *ArtifactSaver) processFiles(
 		fileSpecs = append(fileSpecs, spec)
 	}
 
+	// Batches with large files may wait for the upload window before
+	// requesting URLs, so put them last to not hold up small files.
+	slices.SortStableFunc(fileSpecs, func(a, b gql.CreateArtifactFileSpecInput) int {
+		aLarge := filetransfer.IsLargeFile(manifest.Contents[a.Name].Size)
+		bLarge := filetransfer.IsLargeFile(manifest.Contents[b.Name].Size)
+		switch {
+		case !aLarge && bLarge:
+			return -1
+		case aLarge && !bLarge:
+			return 1
+		default:
+			return 0
+		}
+	})
+
 	for as.numDone+len(mustRetry) < as.numTotal {
 		// Start new batches until we get to the desired number of active uploads.
 		for len(fileSpecs) > 0 && numActive <= (as.maxActiveBatches-1)*as.batchSize() {
 			batch, fileSpecs = as.nextBatch(fileSpecs)
 			numActive += len(batch)
-			go as.batchFileDataRetriever(batch, readyChan, errorChan)
+
+			var largestSize int64
+			for _, spec := range batch {
+				largestSize = max(largestSize, manifest.Contents[spec.Name].Size)
+			}
+			go as.batchFileDataRetriever(batch, largestSize, readyChan, errorChan)
 		}
 		select {
 		// Start any uploads that are ready.
@@ -217,6 +246,7 @@ func (as *ArtifactSaver) processFiles(
 			as.cacheEntry(entry)
 			if fileInfo.UploadUrl == nil {
 				// The server already has this file.
+				as.recordUpload(entry)
 				numActive--
 				as.numDone++
 				continue
@@ -230,6 +260,7 @@ func (as *ArtifactSaver) processFiles(
 			if result.Err != nil {
 				mustRetry[result.Name] = namedFileSpecs[result.Name]
 			} else {
+				as.recordUpload(manifest.Contents[result.Name])
 				as.numDone++
 			}
 		// Check for errors.
@@ -242,11 +273,17 @@ func (as *ArtifactSaver) processFiles(
 
 // batchFileDataRetriever takes a batch of file specs, requests upload URLs for each file,
 // assembles the info needed for the next step, and feeds them into an output channel.
+//
+// URLs expire, so they're only requested once the batch's largest file may
+// be uploaded.
 func (as *ArtifactSaver) batchFileDataRetriever(
 	batch []gql.CreateArtifactFileSpecInput,
+	largestSize int64,
 	resultChan chan<- serverFileResponse,
 	errorChan chan<- error,
 ) {
+	as.FileTransferManager.WaitForUploadWindow(as.Ctx, largestSize)
+
 	response, err := gql.CreateArtifactFiles(
 		as.Ctx, as.GraphqlClient, batch, gql.ArtifactStorageLayoutV2,
 	)
@@ -377,11 +414,14 @@ func (as *ArtifactSaver) commitArtifact(artifactID string) error {
 }
 
 func (as *ArtifactSaver) deleteStagingFiles(manifest *Manifest) {
+	// Without a staging directory, the files are the user's originals.
+	if as.StagingDir == "" {
+		return
+	}
+
 	for _, entry := range manifest.Contents {
 		if entry.LocalPath != nil && strings.HasPrefix(*entry.LocalPath, as.StagingDir) {
-			// We intentionally ignore errors below.
-			_ = os.Chmod(*entry.LocalPath, 0600)
-			_ = os.Remove(*entry.LocalPath)
+			removeStagedFile(as.StagingDir, *entry.LocalPath, entry.Digest)
 		}
 	}
 }
@@ -443,7 +483,11 @@ func (as *ArtifactSaver) Save(ch chan<- *service.Record) (artifactID string, rer
 		return "", fmt.Errorf("ArtifactSaver.resolveClientIDReferences: %w", err)
 	}
 	// TODO: check if size is needed
-	manifestFile, manifestDigest, _, err := manifest.WriteToFile()
+	writeManifest := manifest.WriteToFile
+	if as.ManifestV2 && len(manifest.Contents) >= ManifestV2MinEntries {
+		writeManifest = manifest.WriteToFileV2
+	}
+	manifestFile, manifestDigest, _, err := writeManifest()
 	if err != nil {
 		return "", fmt.Errorf("ArtifactSaver.writeManifest: %w", err)
 	}
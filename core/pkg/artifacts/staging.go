@@ -0,0 +1,63 @@
+package artifacts
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/wandb/wandb/core/pkg/utils"
+)
+
+// stagedContentPath returns where the staging area keeps the shared copy
+// of a file with the digest.
+//
+// Where hard links are supported, the Python SDK stages each file's
+// contents once in a "staging-content" directory next to the staging
+// directory, and the staged files are hard links to those copies.
+func stagedContentPath(stagingDir, digest string) (string, error) {
+	hexHash, err := utils.B64ToHex(digest)
+	if err != nil {
+		return "", err
+	}
+	if len(hexHash) < 3 {
+		return "", errors.New("artifacts: digest too short")
+	}
+	return filepath.Join(
+		filepath.Dir(stagingDir),
+		"staging-content",
+		hexHash[:2],
+		hexHash[2:],
+	), nil
+}
+
+// removeStagedFile deletes a staged copy of an artifact file.
+//
+// The shared copy of the file's contents is deleted too once no other
+// staged file links to it.
+func removeStagedFile(stagingDir, path, digest string) {
+	// We intentionally ignore errors below.
+	contentPath, err := stagedContentPath(stagingDir, digest)
+	if err != nil || !isSameFile(path, contentPath) {
+		_ = os.Chmod(path, 0600)
+		_ = os.Remove(path)
+		return
+	}
+
+	// Don't make the shared copy writable while other runs use it.
+	_ = os.Remove(path)
+	if n, ok := linkCount(contentPath); ok && n <= 1 {
+		_ = os.Remove(contentPath)
+	}
+}
+
+func isSameFile(path1, path2 string) bool {
+	info1, err := os.Stat(path1)
+	if err != nil {
+		return false
+	}
+	info2, err := os.Stat(path2)
+	if err != nil {
+		return false
+	}
+	return os.SameFile(info1, info2)
+}
@@ -0,0 +1,21 @@
+//go:build !windows
+
+package artifacts
+
+import (
+	"os"
+	"syscall"
+)
+
+// linkCount returns the number of hard links to a file.
+func linkCount(path string) (uint64, bool) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0, false
+	}
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return 0, false
+	}
+	return uint64(stat.Nlink), true
+}
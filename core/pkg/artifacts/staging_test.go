@@ -0,0 +1,51 @@
+//go:build !windows
+
+package artifacts
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wandb/wandb/core/pkg/utils"
+)
+
+func TestRemoveStagedFile_SharedContent(t *testing.T) {
+	stagingDir := filepath.Join(t.TempDir(), "staging")
+	require.NoError(t, os.MkdirAll(stagingDir, 0755))
+	digest := utils.ComputeB64MD5([]byte("data"))
+	contentPath, err := stagedContentPath(stagingDir, digest)
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Dir(contentPath), 0755))
+	require.NoError(t, os.WriteFile(contentPath, []byte("data"), 0400))
+	staged1 := filepath.Join(stagingDir, "staged1")
+	staged2 := filepath.Join(stagingDir, "staged2")
+	require.NoError(t, os.Link(contentPath, staged1))
+	require.NoError(t, os.Link(contentPath, staged2))
+
+	removeStagedFile(stagingDir, staged1, digest)
+
+	assert.NoFileExists(t, staged1)
+	assert.FileExists(t, contentPath)
+	info, err := os.Stat(staged2)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0400), info.Mode().Perm())
+
+	removeStagedFile(stagingDir, staged2, digest)
+
+	assert.NoFileExists(t, staged2)
+	assert.NoFileExists(t, contentPath)
+}
+
+func TestRemoveStagedFile_Copy(t *testing.T) {
+	stagingDir := t.TempDir()
+	staged := filepath.Join(stagingDir, "staged")
+	require.NoError(t, os.WriteFile(staged, []byte("data"), 0400))
+
+	removeStagedFile(stagingDir, staged, utils.ComputeB64MD5([]byte("data")))
+
+	assert.NoFileExists(t, staged)
+}
@@ -0,0 +1,119 @@
+package artifacts
+
+import (
+	"errors"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/wandb/wandb/core/pkg/utils"
+)
+
+// UploadIndex remembers which artifact versions uploaded files with each
+// digest, per project.
+//
+// The server only skips uploading the files that the previous version of
+// an artifact already has. The index lets the saver skip files uploaded by
+// any artifact in the project, such as a dataset logged under a new name
+// or an older version, by pointing the manifest at the version that has
+// the file.
+//
+// It has one small file per digest so that looking up a few digests
+// doesn't require reading the whole index.
+type UploadIndex struct {
+	root string
+}
+
+// NewUploadIndex returns the upload index in a cache directory.
+func NewUploadIndex(cacheDir string) *UploadIndex {
+	return &UploadIndex{root: filepath.Join(cacheDir, "artifacts", "uploaded")}
+}
+
+// Lookup returns the ID of an artifact version in a project that has a
+// file with the digest, if one is recorded.
+func (i *UploadIndex) Lookup(entity, project, digest string) (string, bool) {
+	if i == nil {
+		return "", false
+	}
+
+	path, err := i.path(entity, project, digest)
+	if err != nil {
+		return "", false
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", false
+	}
+
+	artifactID := strings.TrimSpace(string(data))
+	return artifactID, artifactID != ""
+}
+
+// Record records that an artifact version in a project has a file with
+// the digest.
+func (i *UploadIndex) Record(entity, project, digest, artifactID string) error {
+	if i == nil {
+		return nil
+	}
+
+	path, err := i.path(entity, project, digest)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), defaultDirPermissions); err != nil {
+		return err
+	}
+
+	// Write atomically so that concurrent lookups don't see partial IDs.
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	_, err = tmp.WriteString(artifactID)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+	}
+	return err
+}
+
+// Forget removes the record of a digest, such as when the artifact version
+// that had it was deleted.
+func (i *UploadIndex) Forget(entity, project, digest string) error {
+	if i == nil {
+		return nil
+	}
+
+	path, err := i.path(entity, project, digest)
+	if err != nil {
+		return err
+	}
+	err = os.Remove(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+func (i *UploadIndex) path(entity, project, digest string) (string, error) {
+	hexHash, err := utils.B64ToHex(digest)
+	if err != nil {
+		return "", err
+	}
+	if len(hexHash) < 3 {
+		return "", errors.New("artifacts: digest too short")
+	}
+	return filepath.Join(
+		i.root,
+		url.PathEscape(entity),
+		url.PathEscape(project),
+		hexHash[:2],
+		hexHash[2:],
+	), nil
+}
@@ -0,0 +1,85 @@
+package artifacts
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wandb/wandb/core/internal/gqlmock"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+	"github.com/wandb/wandb/core/pkg/utils"
+)
+
+func TestUploadIndex(t *testing.T) {
+	index := NewUploadIndex(t.TempDir())
+	digest := utils.ComputeB64MD5([]byte("data"))
+
+	_, ok := index.Lookup("entity", "project", digest)
+	assert.False(t, ok)
+
+	require.NoError(t, index.Record("entity", "project", digest, "artifact-1"))
+	id, ok := index.Lookup("entity", "project", digest)
+	assert.True(t, ok)
+	assert.Equal(t, "artifact-1", id)
+	_, ok = index.Lookup("entity", "other-project", digest)
+	assert.False(t, ok)
+
+	require.NoError(t, index.Forget("entity", "project", digest))
+	require.NoError(t, index.Forget("entity", "project", digest))
+	_, ok = index.Lookup("entity", "project", digest)
+	assert.False(t, ok)
+}
+
+func TestUploadIndex_Nil(t *testing.T) {
+	var index *UploadIndex
+
+	assert.NoError(t, index.Record("entity", "project", "digest", "id"))
+	_, ok := index.Lookup("entity", "project", "digest")
+	assert.False(t, ok)
+}
+
+func TestDeduplicateFiles(t *testing.T) {
+	index := NewUploadIndex(t.TempDir())
+	digest := func(data string) string {
+		return utils.ComputeB64MD5([]byte(data))
+	}
+	require.NoError(t, index.Record("entity", "project", digest("a"), "committed"))
+	require.NoError(t, index.Record("entity", "project", digest("b"), "deleted"))
+
+	localPath := "/staging/file"
+	manifest := &Manifest{Contents: map[string]ManifestEntry{
+		"a.txt": {Digest: digest("a"), LocalPath: &localPath},
+		"b.txt": {Digest: digest("b"), LocalPath: &localPath},
+		"c.txt": {Digest: digest("c"), LocalPath: &localPath},
+	}}
+
+	client := gqlmock.NewMockClient()
+	client.StubMatchOnce(
+		gqlmock.WithVariables(gqlmock.GQLVar("id", gomock.Eq("committed"))),
+		`{"artifact": {"id": "committed", "state": "COMMITTED"}}`,
+	)
+	client.StubMatchOnce(
+		gqlmock.WithVariables(gqlmock.GQLVar("id", gomock.Eq("deleted"))),
+		`{"artifact": null}`,
+	)
+	saver := &ArtifactSaver{
+		Ctx:           context.Background(),
+		Logger:        observability.NewNoOpLogger(),
+		GraphqlClient: client,
+		UploadIndex:   index,
+		Artifact:      &service.ArtifactRecord{Entity: "entity", Project: "project"},
+	}
+
+	deduplicated := saver.deduplicateFiles(manifest)
+
+	assert.Equal(t, map[string]bool{"a.txt": true}, deduplicated)
+	assert.Equal(t, "committed", *manifest.Contents["a.txt"].BirthArtifactID)
+	assert.Nil(t, manifest.Contents["b.txt"].BirthArtifactID)
+	_, ok := index.Lookup("entity", "project", digest("b"))
+	assert.False(t, ok)
+	assert.True(t, client.AllStubsUsed())
+}
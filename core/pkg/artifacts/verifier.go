@@ -0,0 +1,234 @@
+package artifacts
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/wandb/wandb/core/pkg/utils"
+)
+
+// VerifyStatus is the outcome of verifying a downloaded file.
+type VerifyStatus string
+
+const (
+	VerifyOK       VerifyStatus = "ok"
+	VerifyMismatch VerifyStatus = "mismatch"
+	VerifyMissing  VerifyStatus = "missing"
+	VerifyRepaired VerifyStatus = "repaired"
+)
+
+// VerifyResult is the outcome of verifying one file.
+type VerifyResult struct {
+	// Path is the file's path in the artifact.
+	Path string `json:"path"`
+
+	Status VerifyStatus `json:"status"`
+
+	// Detail explains the status.
+	Detail string `json:"detail,omitempty"`
+}
+
+// VerifyReport is the outcome of verifying a downloaded artifact.
+type VerifyReport struct {
+	// Root is the directory the artifact was downloaded to.
+	Root string `json:"root"`
+
+	// NumFiles is the number of files verified.
+	NumFiles int `json:"num_files"`
+
+	// NumSkipped is the number of reference entries, whose digests can't
+	// be checked against downloaded files.
+	NumSkipped int `json:"num_skipped"`
+
+	// Results are the files that didn't match the manifest, sorted by path.
+	Results []VerifyResult `json:"results"`
+}
+
+// Failed returns whether any file is missing or doesn't match.
+func (r *VerifyReport) Failed() bool {
+	return len(r.BadPaths()) > 0
+}
+
+// BadPaths returns the paths of the files that are missing or don't
+// match the manifest.
+func (r *VerifyReport) BadPaths() []string {
+	var paths []string
+	for _, result := range r.Results {
+		if result.Status == VerifyMismatch || result.Status == VerifyMissing {
+			paths = append(paths, result.Path)
+		}
+	}
+	return paths
+}
+
+// WriteText writes the report for people to read.
+func (r *VerifyReport) WriteText(w io.Writer) {
+	for _, result := range r.Results {
+		fmt.Fprintf(w, "[%-8s] %s: %s\n", result.Status, result.Path, result.Detail)
+	}
+
+	bad := len(r.BadPaths())
+	fmt.Fprintf(w, "verified %d files in %s: %d ok, %d bad",
+		r.NumFiles, r.Root, r.NumFiles-bad, bad)
+	if repaired := len(r.Results) - bad; repaired > 0 {
+		fmt.Fprintf(w, " (%d repaired)", repaired)
+	}
+	if r.NumSkipped > 0 {
+		fmt.Fprintf(w, ", %d references skipped", r.NumSkipped)
+	}
+	fmt.Fprintln(w)
+}
+
+// WriteJSON writes the report as indented JSON.
+func (r *VerifyReport) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r)
+}
+
+// VerifyTree checks the files downloaded to root against a manifest.
+//
+// Files are hashed by up to parallelism goroutines at once. Files in
+// root that aren't in the manifest are ignored.
+func VerifyTree(
+	ctx context.Context,
+	manifest *ManifestReader,
+	root string,
+	parallelism int,
+) (*VerifyReport, error) {
+	return verify(ctx, root, parallelism, manifest.ForEach)
+}
+
+// verifyPaths checks some of the files downloaded to root against a
+// manifest.
+func verifyPaths(
+	ctx context.Context,
+	manifest *ManifestReader,
+	root string,
+	paths []string,
+	parallelism int,
+) (*VerifyReport, error) {
+	return verify(ctx, root, parallelism,
+		func(fn func(string, ManifestEntry) error) error {
+			for _, path := range paths {
+				entry, err := manifest.Entry(path)
+				if err != nil {
+					return err
+				}
+				if err := fn(path, entry); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+}
+
+// verify checks the entries listed by forEach against the files in root.
+func verify(
+	ctx context.Context,
+	root string,
+	parallelism int,
+	forEach func(func(string, ManifestEntry) error) error,
+) (*VerifyReport, error) {
+	type job struct {
+		name  string
+		entry ManifestEntry
+	}
+
+	jobs := make(chan job)
+	results := make(chan VerifyResult)
+
+	wg := &sync.WaitGroup{}
+	for range max(parallelism, 1) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range jobs {
+				results <- verifyFile(root, job.name, job.entry)
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	report := &VerifyReport{Root: root}
+	var listErr error
+	go func() {
+		defer close(jobs)
+		listErr = forEach(func(name string, entry ManifestEntry) error {
+			// References' digests are ETags or URLs, not MD5 hashes.
+			if entry.Ref != nil {
+				report.NumSkipped++
+				return nil
+			}
+			select {
+			case jobs <- job{name, entry}:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+	}()
+
+	for result := range results {
+		report.NumFiles++
+		if result.Status != VerifyOK {
+			report.Results = append(report.Results, result)
+		}
+	}
+	if listErr != nil {
+		return nil, listErr
+	}
+
+	slices.SortFunc(report.Results, func(a, b VerifyResult) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+	return report, nil
+}
+
+// verifyFile checks a downloaded file against its manifest entry.
+func verifyFile(root string, name string, entry ManifestEntry) VerifyResult {
+	result := VerifyResult{Path: name, Status: VerifyMismatch}
+	path := filepath.Join(root, filepath.FromSlash(name))
+
+	info, err := os.Stat(path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		result.Status = VerifyMissing
+		result.Detail = "not downloaded"
+		return result
+	case err != nil:
+		result.Detail = err.Error()
+		return result
+	case info.IsDir():
+		result.Detail = "is a directory"
+		return result
+	case info.Size() != entry.Size:
+		// Compressed entries' sizes are of their decompressed contents,
+		// so this avoids hashing files that were cut short.
+		result.Detail = fmt.Sprintf(
+			"size is %d bytes, expected %d", info.Size(), entry.Size)
+		return result
+	}
+
+	digest, err := utils.ComputeFileB64MD5(path)
+	switch {
+	case err != nil:
+		result.Detail = err.Error()
+	case digest != entry.Digest:
+		result.Detail = fmt.Sprintf("digest is %s, expected %s", digest, entry.Digest)
+	default:
+		result.Status = VerifyOK
+	}
+	return result
+}
@@ -0,0 +1,181 @@
+package artifacts
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/segmentio-encoding/json"
+
+	"github.com/wandb/wandb/core/internal/gqlmock"
+	"github.com/wandb/wandb/core/pkg/utils"
+)
+
+// writeTree writes files under a new directory and returns a manifest
+// for the contents given in want.
+func writeTree(
+	t *testing.T,
+	files map[string]string,
+	want map[string]string,
+) (string, *Manifest) {
+	t.Helper()
+	root := t.TempDir()
+	for name, data := range files {
+		path := filepath.Join(root, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(data), 0644))
+	}
+
+	manifest := &Manifest{Version: 1, Contents: map[string]ManifestEntry{}}
+	for name, data := range want {
+		manifest.Contents[name] = ManifestEntry{
+			Digest: utils.ComputeB64MD5([]byte(data)),
+			Size:   int64(len(data)),
+		}
+	}
+	return root, manifest
+}
+
+func TestVerifyTree(t *testing.T) {
+	root, manifest := writeTree(t,
+		map[string]string{
+			"ok.txt":        "ok",
+			"dir/same.txt":  "abc",
+			"dir/short.txt": "ab",
+			"extra.txt":     "not in the manifest",
+		},
+		map[string]string{
+			"ok.txt":        "ok",
+			"dir/same.txt":  "xyz",
+			"dir/short.txt": "abc",
+			"missing.txt":   "missing",
+		})
+	ref := "s3://bucket/key"
+	manifest.Contents["ref.txt"] = ManifestEntry{Ref: &ref, Digest: "etag"}
+
+	reader, err := OpenManifest(writeManifestV2(t, manifest, 2))
+	require.NoError(t, err)
+	defer reader.Close()
+
+	report, err := VerifyTree(context.Background(), reader, root, 2)
+
+	require.NoError(t, err)
+	assert.Equal(t, 4, report.NumFiles)
+	assert.Equal(t, 1, report.NumSkipped)
+	assert.Equal(t,
+		[]string{"dir/same.txt", "dir/short.txt", "missing.txt"},
+		report.BadPaths())
+	assert.Equal(t, VerifyMismatch, report.Results[0].Status)
+	assert.Contains(t, report.Results[0].Detail, "digest is")
+	assert.Contains(t, report.Results[1].Detail, "size is 2 bytes, expected 3")
+	assert.Equal(t, VerifyMissing, report.Results[2].Status)
+	assert.True(t, report.Failed())
+}
+
+func TestVerifyReport_WriteText(t *testing.T) {
+	report := &VerifyReport{
+		Root:     "artifacts/data:v1",
+		NumFiles: 3,
+		Results: []VerifyResult{
+			{Path: "a.txt", Status: VerifyRepaired, Detail: "re-downloaded"},
+			{Path: "b.txt", Status: VerifyMissing, Detail: "not downloaded"},
+		},
+	}
+
+	var buf bytes.Buffer
+	report.WriteText(&buf)
+
+	assert.Equal(t,
+		"[repaired] a.txt: re-downloaded\n"+
+			"[missing ] b.txt: not downloaded\n"+
+			"verified 3 files in artifacts/data:v1: 2 ok, 1 bad (1 repaired)\n",
+		buf.String())
+}
+
+func TestRepair(t *testing.T) {
+	root, manifest := writeTree(t,
+		map[string]string{"ok.txt": "ok", "bad.txt": "bad"},
+		map[string]string{"ok.txt": "ok", "bad.txt": "good", "gone.txt": "gone"})
+	manifestJSON, err := json.Marshal(manifest)
+	require.NoError(t, err)
+	downloads := &fakeDownloads{contents: map[string][]byte{
+		"https://manifest":       manifestJSON,
+		"https://files/bad.txt":  []byte("good"),
+		"https://files/gone.txt": []byte("gone"),
+	}}
+
+	client := gqlmock.NewMockClient()
+	for range 2 {
+		client.StubMatchOnce(
+			gqlmock.WithOpName("ArtifactManifest"),
+			`{"artifact": {"currentManifest": {"file": {"directUrl": "https://manifest"}}}}`,
+		)
+	}
+	client.StubMatchOnce(
+		gqlmock.WithOpName("ArtifactFileURLsByNames"),
+		`{"artifact": {"files": {"edges": [
+			{"node": {"name": "bad.txt", "directUrl": "https://files/bad.txt"}},
+			{"node": {"name": "gone.txt", "directUrl": "https://files/gone.txt"}}
+		]}}}`,
+	)
+	downloader := NewArtifactDownloader(
+		context.Background(),
+		client,
+		downloads,
+		"artifact-id",
+		root,
+		false,
+		true,
+		"",
+		nil,
+	)
+
+	report, err := downloader.Verify(4)
+	require.NoError(t, err)
+	require.Equal(t, []string{"bad.txt", "gone.txt"}, report.BadPaths())
+	report, err = downloader.Repair(report, 4)
+	require.NoError(t, err)
+
+	assert.False(t, report.Failed())
+	assert.Equal(t, VerifyRepaired, report.Results[0].Status)
+	assert.Equal(t, VerifyRepaired, report.Results[1].Status)
+	data, err := os.ReadFile(filepath.Join(root, "bad.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "good", string(data))
+	gqlmock.AssertRequest(t,
+		gqlmock.WithVariables(
+			gqlmock.GQLVar("names", gomock.Eq([]any{"bad.txt", "gone.txt"})),
+		),
+		client.AllRequests()[2])
+}
+
+func TestResolveArtifactID(t *testing.T) {
+	client := gqlmock.NewMockClient()
+	client.StubMatchOnce(
+		gqlmock.WithOpName("ArtifactIDByName"),
+		`{"project": {"artifact": {"id": "artifact-id"}}}`,
+	)
+
+	id, err := ResolveArtifactID(context.Background(), client, "entity/project/data")
+
+	require.NoError(t, err)
+	assert.Equal(t, "artifact-id", id)
+	gqlmock.AssertRequest(t,
+		gqlmock.WithVariables(
+			gqlmock.GQLVar("name", gomock.Eq("data:latest")),
+			gqlmock.GQLVar("project", gomock.Eq("project")),
+		),
+		client.AllRequests()[0])
+}
+
+func TestResolveArtifactID_InvalidPath(t *testing.T) {
+	_, err := ResolveArtifactID(
+		context.Background(), gqlmock.NewMockClient(), "project/data:v1")
+
+	assert.ErrorContains(t, err, "not an artifact path")
+}
@@ -140,6 +140,13 @@ type Reader struct {
 	err error
 	// buf is the buffer.
 	buf [blockSize]byte
+	// blockOffset is the offset in r of the block held in buf.
+	blockOffset int64
+	// nextBlockOffset is the offset in r of the next block to read.
+	nextBlockOffset int64
+	// lastRecordOffset is the offset in r of the record returned by the
+	// most recent Next call, or -1 if there is none.
+	lastRecordOffset int64
 	// CRC function
 	crc func([]byte) uint32
 }
@@ -151,8 +158,9 @@ func NewReaderExt(r io.Reader, algo CRCAlgo) *Reader {
 		crc = CRCStandard
 	}
 	return &Reader{
-		r:   r,
-		crc: crc,
+		r:                r,
+		lastRecordOffset: -1,
+		crc:              crc,
 	}
 }
 
@@ -219,6 +227,8 @@ func (r *Reader) nextChunk(wantFirst bool) error {
 			return err
 		}
 		r.i, r.j, r.n = 0, 0, n
+		r.blockOffset = r.nextBlockOffset
+		r.nextBlockOffset += int64(n)
 	}
 }
 
@@ -236,9 +246,25 @@ func (r *Reader) Next() (io.Reader, error) {
 		return nil, r.err
 	}
 	r.started = true
+	r.lastRecordOffset = r.blockOffset + int64(r.i-headerSize)
 	return singleReader{r, r.seq}, nil
 }
 
+// LastRecordOffset returns the offset in the underlying io.Reader of the
+// record returned by the most recent Next call. It is the offset of the
+// first chunk header, the same as Writer.LastRecordOffset returned when
+// the record was written to the start of the io.Reader.
+//
+// The offset is relative to the position of the io.Reader when passed to
+// NewReader, or absolute after SeekRecord. If Next has not returned
+// a record, LastRecordOffset returns ErrNoLastRecord.
+func (r *Reader) LastRecordOffset() (int64, error) {
+	if r.lastRecordOffset < 0 {
+		return 0, ErrNoLastRecord
+	}
+	return r.lastRecordOffset, nil
+}
+
 // Recover clears any errors read so far, so that calling Next will start
 // reading from the next good 32KiB block. If there are no such blocks, Next
 // will return io.EOF. Recover also marks the current reader, the one most
@@ -293,6 +319,7 @@ func (r *Reader) SeekRecord(offset int64) error {
 
 	// Clear the state of the internal reader.
 	r.i, r.j, r.n = 0, 0, 0
+	r.nextBlockOffset = offset &^ blockSizeMask
 	r.started, r.recovering, r.last = false, false, false
 	if r.err = r.nextChunk(false); r.err != nil {
 		return r.err
@@ -768,6 +768,36 @@ func TestLastRecordOffset(t *testing.T) {
 	}
 }
 
+func TestReaderLastRecordOffset(t *testing.T) {
+	recs, err := makeTestRecords(blockSize*3, 100, blockSize-headerSize, 200)
+	if err != nil {
+		t.Fatalf("makeTestRecords: %v", err)
+	}
+
+	r := NewReader(bytes.NewReader(recs.buf))
+	if _, err := r.LastRecordOffset(); err != ErrNoLastRecord {
+		t.Fatalf("LastRecordOffset: got: %v, want ErrNoLastRecord", err)
+	}
+	for i, want := range recs.offsets {
+		if _, err := r.Next(); err != nil {
+			t.Fatalf("Next: %v", err)
+		}
+		if got, err := r.LastRecordOffset(); err != nil || got != want {
+			t.Errorf("record #%d: got %d, %v, want %d", i, got, err, want)
+		}
+	}
+
+	if err := r.SeekRecord(recs.offsets[2]); err != nil {
+		t.Fatalf("SeekRecord: %v", err)
+	}
+	if _, err := r.Next(); err != nil {
+		t.Fatalf("Next: %v", err)
+	}
+	if got, _ := r.LastRecordOffset(); got != recs.offsets[2] {
+		t.Errorf("after seeking: got %d, want %d", got, recs.offsets[2])
+	}
+}
+
 func TestNoLastRecordOffset(t *testing.T) {
 	buf := new(bytes.Buffer)
 	w := NewWriter(buf)
@@ -0,0 +1,232 @@
+package monitor
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// unlimitedCgroupMemory is the smallest cgroup v1 memory limit that means
+// there is no limit; the kernel reports a page-aligned maximum integer.
+const unlimitedCgroupMemory = 1 << 62
+
+var containerIDRegex = regexp.MustCompile(`[0-9a-f]{64}`)
+
+// ContainerProbe reads what container and Kubernetes pod the process is
+// in, if any.
+type ContainerProbe struct {
+	// Root is the root of the file system, "/" except in tests.
+	Root string
+
+	// Getenv returns the value of an environment variable.
+	Getenv func(string) string
+}
+
+func NewContainerProbe() *ContainerProbe {
+	return &ContainerProbe{Root: "/", Getenv: os.Getenv}
+}
+
+// Container returns information about the process's container, or nil if
+// it isn't in one.
+//
+// The image is the image name given in WANDB_DOCKER, if any, since it
+// can't generally be found from inside the container.
+func (p *ContainerProbe) Container(image string) *service.ContainerInfo {
+	cgroup := p.read("proc", "self", "cgroup")
+	info := &service.ContainerInfo{Image: image}
+
+	switch {
+	case p.exists("run", ".containerenv"):
+		info.Runtime = "podman"
+		p.readContainerEnv(info)
+	case p.exists(".dockerenv"):
+		info.Runtime = "docker"
+	case strings.Contains(cgroup, "containerd"):
+		info.Runtime = "containerd"
+	case strings.Contains(cgroup, "docker"):
+		info.Runtime = "docker"
+	case strings.Contains(cgroup, "libpod"):
+		info.Runtime = "podman"
+	case strings.Contains(cgroup, "kubepods"):
+		info.Runtime = "kubernetes"
+	default:
+		return nil
+	}
+
+	if ids := containerIDRegex.FindAllString(cgroup, -1); len(ids) > 0 {
+		info.Id = ids[len(ids)-1]
+	} else if id := containerIDRegex.FindString(
+		p.read("proc", "self", "mountinfo")); id != "" {
+		info.Id = id
+	}
+
+	if info.ImageDigest == "" {
+		if _, digest, ok := strings.Cut(info.Image, "@"); ok {
+			info.ImageDigest = digest
+		}
+	}
+
+	info.CpuLimit = p.cgroupCPULimit()
+	info.MemoryLimit = p.cgroupMemoryLimit()
+	return info
+}
+
+// readContainerEnv reads the image from Podman's /run/.containerenv.
+func (p *ContainerProbe) readContainerEnv(info *service.ContainerInfo) {
+	for _, line := range strings.Split(p.read("run", ".containerenv"), "\n") {
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		value = strings.Trim(value, `"`)
+
+		switch key {
+		case "id":
+			info.Id = value
+		case "image":
+			if info.Image == "" {
+				info.Image = value
+			}
+		case "imageid":
+			info.ImageDigest = "sha256:" + value
+		}
+	}
+}
+
+// cgroupCPULimit returns the cgroup's CPU quota in CPUs, or 0 if none.
+func (p *ContainerProbe) cgroupCPULimit() float64 {
+	// cgroup v2: "<quota> <period>" or "max <period>".
+	if quota, period, ok := strings.Cut(
+		p.read("sys", "fs", "cgroup", "cpu.max"), " "); ok {
+		return cpus(quota, period)
+	}
+
+	// cgroup v1: a quota of -1 means no limit.
+	return cpus(
+		p.read("sys", "fs", "cgroup", "cpu", "cpu.cfs_quota_us"),
+		p.read("sys", "fs", "cgroup", "cpu", "cpu.cfs_period_us"),
+	)
+}
+
+func cpus(quota, period string) float64 {
+	q, err := strconv.ParseFloat(quota, 64)
+	if err != nil || q <= 0 {
+		return 0
+	}
+	per, err := strconv.ParseFloat(period, 64)
+	if err != nil || per <= 0 {
+		return 0
+	}
+	return q / per
+}
+
+// cgroupMemoryLimit returns the cgroup's memory limit in bytes, or 0 if
+// there's none.
+func (p *ContainerProbe) cgroupMemoryLimit() uint64 {
+	limit := p.read("sys", "fs", "cgroup", "memory.max")
+	if limit == "" {
+		limit = p.read("sys", "fs", "cgroup", "memory", "memory.limit_in_bytes")
+	}
+
+	bytes, err := strconv.ParseUint(limit, 10, 64)
+	if err != nil || bytes >= unlimitedCgroupMemory {
+		return 0
+	}
+	return bytes
+}
+
+// Kubernetes returns information about the process's Kubernetes pod, or
+// nil if it isn't in one.
+func (p *ContainerProbe) Kubernetes() *service.KubernetesInfo {
+	if p.Getenv("KUBERNETES_SERVICE_HOST") == "" {
+		return nil
+	}
+
+	info := &service.KubernetesInfo{
+		PodName:   p.Getenv("POD_NAME"),
+		Namespace: p.Getenv("POD_NAMESPACE"),
+		NodeName:  p.Getenv("NODE_NAME"),
+		PodUid:    p.Getenv("POD_UID"),
+	}
+	if info.PodName == "" {
+		// Unless overridden, a pod's hostname is its name.
+		info.PodName = p.Getenv("HOSTNAME")
+	}
+	if info.Namespace == "" {
+		info.Namespace = p.read(
+			"var", "run", "secrets", "kubernetes.io", "serviceaccount", "namespace")
+	}
+
+	// The file names used in the Kubernetes documentation's example of
+	// exposing resources through a downward API volume.
+	podInfo := []string{"etc", "podinfo"}
+	for file, resource := range map[string]string{
+		"cpu_request": "cpu",
+		"mem_request": "memory",
+	} {
+		if value := p.read(append(podInfo, file)...); value != "" {
+			if info.Requests == nil {
+				info.Requests = make(map[string]string)
+			}
+			info.Requests[resource] = value
+		}
+	}
+	for file, resource := range map[string]string{
+		"cpu_limit": "cpu",
+		"mem_limit": "memory",
+	} {
+		if value := p.read(append(podInfo, file)...); value != "" {
+			if info.Limits == nil {
+				info.Limits = make(map[string]string)
+			}
+			info.Limits[resource] = value
+		}
+	}
+
+	info.Labels = parseDownwardAPIMap(p.read(append(podInfo, "labels")...))
+	return info
+}
+
+// parseDownwardAPIMap parses labels or annotations written by the
+// downward API as lines of key="value".
+func parseDownwardAPIMap(data string) map[string]string {
+	var result map[string]string
+
+	scanner := bufio.NewScanner(strings.NewReader(data))
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(scanner.Text(), "=")
+		if !ok {
+			continue
+		}
+		if unquoted, err := strconv.Unquote(value); err == nil {
+			value = unquoted
+		}
+
+		if result == nil {
+			result = make(map[string]string)
+		}
+		result[key] = value
+	}
+
+	return result
+}
+
+// read returns the trimmed contents of a file, or an empty string if it
+// can't be read.
+func (p *ContainerProbe) read(path ...string) string {
+	data, err := os.ReadFile(filepath.Join(append([]string{p.Root}, path...)...))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
+
+func (p *ContainerProbe) exists(path ...string) bool {
+	_, err := os.Stat(filepath.Join(append([]string{p.Root}, path...)...))
+	return err == nil
+}
@@ -0,0 +1,112 @@
+package monitor_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/pkg/monitor"
+)
+
+const testContainerID = "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+func writeProbeFile(t *testing.T, root, path, content string) {
+	t.Helper()
+	path = filepath.Join(root, filepath.FromSlash(path))
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+}
+
+func testProbe(root string, env map[string]string) *monitor.ContainerProbe {
+	return &monitor.ContainerProbe{
+		Root:   root,
+		Getenv: func(key string) string { return env[key] },
+	}
+}
+
+func TestContainer_NotInContainer(t *testing.T) {
+	root := t.TempDir()
+	writeProbeFile(t, root, "proc/self/cgroup", "0::/user.slice\n")
+
+	assert.Nil(t, testProbe(root, nil).Container(""))
+}
+
+func TestContainer_DockerCgroupV2(t *testing.T) {
+	root := t.TempDir()
+	writeProbeFile(t, root, ".dockerenv", "")
+	writeProbeFile(t, root, "proc/self/cgroup", "0::/\n")
+	writeProbeFile(t, root, "proc/self/mountinfo",
+		"1 0 8:1 /var/lib/docker/containers/"+testContainerID+"/hostname /etc/hostname rw\n")
+	writeProbeFile(t, root, "sys/fs/cgroup/cpu.max", "200000 100000\n")
+	writeProbeFile(t, root, "sys/fs/cgroup/memory.max", "1073741824\n")
+
+	info := testProbe(root, nil).Container("trainer@sha256:abc")
+
+	require.NotNil(t, info)
+	assert.Equal(t, "docker", info.Runtime)
+	assert.Equal(t, testContainerID, info.Id)
+	assert.Equal(t, "trainer@sha256:abc", info.Image)
+	assert.Equal(t, "sha256:abc", info.ImageDigest)
+	assert.Equal(t, 2.0, info.CpuLimit)
+	assert.EqualValues(t, 1<<30, info.MemoryLimit)
+}
+
+func TestContainer_ContainerdCgroupV1Unlimited(t *testing.T) {
+	root := t.TempDir()
+	writeProbeFile(t, root, "proc/self/cgroup",
+		"4:memory:/kubepods/pod1/cri-containerd-"+testContainerID+"\n")
+	writeProbeFile(t, root, "sys/fs/cgroup/cpu/cpu.cfs_quota_us", "-1\n")
+	writeProbeFile(t, root, "sys/fs/cgroup/cpu/cpu.cfs_period_us", "100000\n")
+	writeProbeFile(t, root, "sys/fs/cgroup/memory/memory.limit_in_bytes",
+		"9223372036854771712\n")
+
+	info := testProbe(root, nil).Container("")
+
+	require.NotNil(t, info)
+	assert.Equal(t, "containerd", info.Runtime)
+	assert.Equal(t, testContainerID, info.Id)
+	assert.Zero(t, info.CpuLimit)
+	assert.Zero(t, info.MemoryLimit)
+}
+
+func TestContainer_Podman(t *testing.T) {
+	root := t.TempDir()
+	writeProbeFile(t, root, "run/.containerenv",
+		"engine=\"podman-4.9\"\nimage=\"quay.io/org/trainer:1\"\nimageid=\"feed\"\n")
+
+	info := testProbe(root, nil).Container("")
+
+	require.NotNil(t, info)
+	assert.Equal(t, "podman", info.Runtime)
+	assert.Equal(t, "quay.io/org/trainer:1", info.Image)
+	assert.Equal(t, "sha256:feed", info.ImageDigest)
+}
+
+func TestKubernetes(t *testing.T) {
+	root := t.TempDir()
+	writeProbeFile(t, root,
+		"var/run/secrets/kubernetes.io/serviceaccount/namespace", "training\n")
+	writeProbeFile(t, root, "etc/podinfo/cpu_limit", "4\n")
+	writeProbeFile(t, root, "etc/podinfo/mem_request", "2147483648\n")
+	writeProbeFile(t, root, "etc/podinfo/labels", "app=\"trainer\"\nteam=\"ml\"\n")
+
+	info := testProbe(root, map[string]string{
+		"KUBERNETES_SERVICE_HOST": "10.0.0.1",
+		"HOSTNAME":                "trainer-abc",
+		"NODE_NAME":               "gpu-node-1",
+	}).Kubernetes()
+
+	require.NotNil(t, info)
+	assert.Equal(t, "trainer-abc", info.PodName)
+	assert.Equal(t, "training", info.Namespace)
+	assert.Equal(t, "gpu-node-1", info.NodeName)
+	assert.Equal(t, map[string]string{"cpu": "4"}, info.Limits)
+	assert.Equal(t, map[string]string{"memory": "2147483648"}, info.Requests)
+	assert.Equal(t, map[string]string{"app": "trainer", "team": "ml"}, info.Labels)
+}
+
+func TestKubernetes_NotInPod(t *testing.T) {
+	assert.Nil(t, testProbe(t.TempDir(), nil).Kubernetes())
+}
@@ -0,0 +1,115 @@
+package monitor
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+const defaultProfilingInterval = 10 * time.Second
+
+// DCGMField describes a DCGM profiling field that can be sampled.
+type DCGMField struct {
+	// ID is the DCGM field ID, e.g. 1003 for DCGM_FI_PROF_SM_OCCUPANCY.
+	ID int
+
+	// Name is the DCGM field name.
+	Name string
+
+	// Key is the suffix of the metric name, e.g. "gpu.0.<Key>".
+	Key string
+
+	// Scale is applied to the sampled value before it is reported.
+	Scale float64
+}
+
+const mebibyte = 1 << 20
+
+// dcgmFields are the DCGM profiling fields that are supported.
+//
+// Utilization fields are reported as percentages like the other GPU metrics,
+// and bandwidth fields are reported in bytes per second.
+var dcgmFields = []DCGMField{
+	{1001, "DCGM_FI_PROF_GR_ENGINE_ACTIVE", "grEngineActive", 1},
+	{1002, "DCGM_FI_PROF_SM_ACTIVE", "smActive", 1},
+	{1003, "DCGM_FI_PROF_SM_OCCUPANCY", "smOccupancy", 1},
+	{1004, "DCGM_FI_PROF_PIPE_TENSOR_ACTIVE", "tensorActive", 1},
+	{1005, "DCGM_FI_PROF_DRAM_ACTIVE", "dramActive", 1},
+	{1006, "DCGM_FI_PROF_PIPE_FP64_ACTIVE", "fp64Active", 1},
+	{1007, "DCGM_FI_PROF_PIPE_FP32_ACTIVE", "fp32Active", 1},
+	{1008, "DCGM_FI_PROF_PIPE_FP16_ACTIVE", "fp16Active", 1},
+	{1009, "DCGM_FI_PROF_PCIE_TX_BYTES", "pcieTxBytes", mebibyte},
+	{1010, "DCGM_FI_PROF_PCIE_RX_BYTES", "pcieRxBytes", mebibyte},
+	{1011, "DCGM_FI_PROF_NVLINK_TX_BYTES", "nvlinkTxBytes", mebibyte},
+	{1012, "DCGM_FI_PROF_NVLINK_RX_BYTES", "nvlinkRxBytes", mebibyte},
+	{1013, "DCGM_FI_PROF_PIPE_TENSOR_IMMA_ACTIVE", "tensorImmaActive", 1},
+	{1014, "DCGM_FI_PROF_PIPE_TENSOR_HMMA_ACTIVE", "tensorHmmaActive", 1},
+	{1015, "DCGM_FI_PROF_PIPE_TENSOR_DFMA_ACTIVE", "tensorDfmaActive", 1},
+	{1016, "DCGM_FI_PROF_PIPE_INT_ACTIVE", "intActive", 1},
+}
+
+// ParseDCGMFields returns the DCGM profiling fields selected in settings.
+//
+// Each entry may be either a numeric field ID or a field name. Names are
+// matched case-insensitively and the "DCGM_FI_PROF_" prefix is optional.
+// Duplicate entries are ignored. Unsupported entries are skipped and
+// reported in the returned error, alongside the fields that were recognized.
+func ParseDCGMFields(entries []string) ([]DCGMField, error) {
+	var fields []DCGMField
+	var errs []error
+	seen := make(map[int]struct{})
+
+	for _, entry := range entries {
+		field, ok := lookupDCGMField(entry)
+		if !ok {
+			errs = append(errs, fmt.Errorf("monitor: unsupported DCGM field %q", entry))
+			continue
+		}
+
+		if _, ok := seen[field.ID]; ok {
+			continue
+		}
+		seen[field.ID] = struct{}{}
+		fields = append(fields, field)
+	}
+
+	return fields, errors.Join(errs...)
+}
+
+func lookupDCGMField(entry string) (DCGMField, bool) {
+	entry = strings.TrimSpace(entry)
+
+	if id, err := strconv.Atoi(entry); err == nil {
+		for _, field := range dcgmFields {
+			if field.ID == id {
+				return field, true
+			}
+		}
+		return DCGMField{}, false
+	}
+
+	name := strings.ToUpper(entry)
+	if !strings.HasPrefix(name, "DCGM_FI_PROF_") {
+		name = "DCGM_FI_PROF_" + name
+	}
+	for _, field := range dcgmFields {
+		if field.Name == name {
+			return field, true
+		}
+	}
+	return DCGMField{}, false
+}
+
+// profilingInterval returns the interval at which DCGM profiling fields
+// should be sampled.
+func profilingInterval(settings *service.Settings) time.Duration {
+	seconds := settings.GetXStatsDcgmProfilingIntervalSeconds()
+	if seconds == nil || seconds.GetValue() <= 0 {
+		return defaultProfilingInterval
+	}
+	return time.Duration(seconds.GetValue() * float64(time.Second))
+}
@@ -0,0 +1,41 @@
+package monitor_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wandb/wandb/core/pkg/monitor"
+)
+
+func TestParseDCGMFields_IDsAndNames(t *testing.T) {
+	fields, err := monitor.ParseDCGMFields(
+		[]string{"1003", "DCGM_FI_PROF_PIPE_TENSOR_ACTIVE", "nvlink_tx_bytes"},
+	)
+
+	assert.NoError(t, err)
+	assert.Len(t, fields, 3)
+	assert.Equal(t, "smOccupancy", fields[0].Key)
+	assert.Equal(t, "tensorActive", fields[1].Key)
+	assert.Equal(t, 1011, fields[2].ID)
+}
+
+func TestParseDCGMFields_SkipsDuplicates(t *testing.T) {
+	fields, err := monitor.ParseDCGMFields(
+		[]string{"1009", "PCIE_TX_BYTES", " 1009 "},
+	)
+
+	assert.NoError(t, err)
+	assert.Len(t, fields, 1)
+	assert.Equal(t, "pcieTxBytes", fields[0].Key)
+}
+
+func TestParseDCGMFields_Unsupported(t *testing.T) {
+	fields, err := monitor.ParseDCGMFields(
+		[]string{"150", "1002", "not_a_field"},
+	)
+
+	assert.ErrorContains(t, err, `"150"`)
+	assert.ErrorContains(t, err, `"not_a_field"`)
+	assert.Len(t, fields, 1)
+	assert.Equal(t, "smActive", fields[0].Key)
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/shirou/gopsutil/v4/process"
 
@@ -14,21 +15,64 @@ import (
 	"github.com/wandb/wandb/core/pkg/service"
 )
 
+// gpmMetricIDs maps DCGM profiling field IDs to the equivalent NVML GPM
+// (GPU Performance Monitoring) metrics.
+var gpmMetricIDs = map[int]nvml.GpmMetricId{
+	1001: nvml.GPM_METRIC_GRAPHICS_UTIL,
+	1002: nvml.GPM_METRIC_SM_UTIL,
+	1003: nvml.GPM_METRIC_SM_OCCUPANCY,
+	1004: nvml.GPM_METRIC_ANY_TENSOR_UTIL,
+	1005: nvml.GPM_METRIC_DRAM_BW_UTIL,
+	1006: nvml.GPM_METRIC_FP64_UTIL,
+	1007: nvml.GPM_METRIC_FP32_UTIL,
+	1008: nvml.GPM_METRIC_FP16_UTIL,
+	1009: nvml.GPM_METRIC_PCIE_TX_PER_SEC,
+	1010: nvml.GPM_METRIC_PCIE_RX_PER_SEC,
+	1011: nvml.GPM_METRIC_NVLINK_TOTAL_TX_PER_SEC,
+	1012: nvml.GPM_METRIC_NVLINK_TOTAL_RX_PER_SEC,
+	1013: nvml.GPM_METRIC_IMMA_TENSOR_UTIL,
+	1014: nvml.GPM_METRIC_HMMA_TENSOR_UTIL,
+	1015: nvml.GPM_METRIC_DFMA_TENSOR_UTIL,
+	1016: nvml.GPM_METRIC_INTEGER_UTIL,
+}
+
+// gpmSample is the last GPM sample taken for a device.
+type gpmSample struct {
+	sample    nvml.GpmSample
+	timestamp time.Time
+}
+
 type GPUNvidia struct {
 	name     string
 	metrics  map[string][]float64
 	settings *service.Settings
 	mutex    sync.RWMutex
 	nvmlInit nvml.Return
+
+	// profilingFields are the DCGM profiling fields to sample.
+	profilingFields []DCGMField
+
+	// profilingInterval is the minimum time between two GPM samples
+	// used to compute the profiling fields.
+	profilingInterval time.Duration
+
+	// gpmSamples are the last GPM samples taken, by device index.
+	gpmSamples map[int]*gpmSample
 }
 
 func NewGPUNvidia(settings *service.Settings) *GPUNvidia {
 	gpu := &GPUNvidia{
-		name:     "gpu",
-		metrics:  map[string][]float64{},
-		settings: settings,
+		name:       "gpu",
+		metrics:    map[string][]float64{},
+		settings:   settings,
+		gpmSamples: map[int]*gpmSample{},
 	}
 
+	// Unsupported fields are reported by the system monitor.
+	gpu.profilingFields, _ = ParseDCGMFields(
+		settings.GetXStatsDcgmProfilingFields().GetValue())
+	gpu.profilingInterval = profilingInterval(settings)
+
 	return gpu
 }
 
@@ -174,6 +218,8 @@ func (g *GPUNvidia) SampleMetrics() {
 			}
 		}
 
+		g.sampleProfilingMetrics(di, device, gpuInUseByProcess)
+
 		// gpu power usage (W)
 		powerUsage, ret := device.GetPowerUsage()
 		if ret != nvml.SUCCESS {
@@ -220,6 +266,74 @@ func (g *GPUNvidia) SampleMetrics() {
 	}
 }
 
+// sampleProfilingMetrics samples the configured DCGM profiling fields.
+//
+// Profiling metrics are computed by NVML from two GPM samples, so nothing is
+// recorded until a second sample is taken at least profilingInterval after
+// the first one.
+func (g *GPUNvidia) sampleProfilingMetrics(
+	di int,
+	device nvml.Device,
+	gpuInUseByProcess bool,
+) {
+	if len(g.profilingFields) == 0 {
+		return
+	}
+
+	prev, ok := g.gpmSamples[di]
+	if ok && time.Since(prev.timestamp) < g.profilingInterval {
+		return
+	}
+
+	if !ok {
+		support, ret := device.GpmQueryDeviceSupport()
+		if ret != nvml.SUCCESS || support.IsSupportedDevice == 0 {
+			return
+		}
+	}
+
+	sample, ret := nvml.GpmSampleAlloc()
+	if ret != nvml.SUCCESS {
+		return
+	}
+	if ret = device.GpmSampleGet(sample); ret != nvml.SUCCESS {
+		_ = sample.Free()
+		return
+	}
+	g.gpmSamples[di] = &gpmSample{sample: sample, timestamp: time.Now()}
+
+	if !ok {
+		return
+	}
+	defer func() { _ = prev.sample.Free() }()
+
+	metricsGet := &nvml.GpmMetricsGetType{
+		NumMetrics: uint32(len(g.profilingFields)),
+		Sample1:    prev.sample,
+		Sample2:    sample,
+	}
+	for i, field := range g.profilingFields {
+		metricsGet.Metrics[i].MetricId = uint32(gpmMetricIDs[field.ID])
+	}
+	if ret = nvml.GpmMetricsGet(metricsGet); ret != nvml.SUCCESS {
+		return
+	}
+
+	for i, field := range g.profilingFields {
+		metric := metricsGet.Metrics[i]
+		if nvml.Return(metric.NvmlReturn) != nvml.SUCCESS {
+			continue
+		}
+
+		key := fmt.Sprintf("gpu.%d.%s", di, field.Key)
+		g.metrics[key] = append(g.metrics[key], metric.Value*field.Scale)
+		if gpuInUseByProcess {
+			keyProc := fmt.Sprintf("gpu.process.%d.%s", di, field.Key)
+			g.metrics[keyProc] = append(g.metrics[keyProc], g.metrics[key][len(g.metrics[key])-1])
+		}
+	}
+}
+
 func (g *GPUNvidia) AggregateMetrics() map[string]float64 {
 	g.mutex.Lock()
 	defer g.mutex.Unlock()
@@ -251,6 +365,11 @@ func (g *GPUNvidia) IsAvailable() bool {
 }
 
 func (g *GPUNvidia) Close() {
+	for _, prev := range g.gpmSamples {
+		_ = prev.sample.Free()
+	}
+	g.gpmSamples = map[int]*gpmSample{}
+
 	err := nvml.Shutdown()
 	if err != nvml.SUCCESS {
 		return
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"strings"
 	"sync"
 	"time"
 
@@ -12,6 +11,8 @@ import (
 
 	"google.golang.org/protobuf/proto"
 
+	"github.com/wandb/wandb/core/internal/hpcjob"
+	wbsettings "github.com/wandb/wandb/core/internal/settings"
 	"github.com/wandb/wandb/core/pkg/observability"
 	"github.com/wandb/wandb/core/pkg/service"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -90,11 +91,21 @@ type SystemMonitor struct {
 	// samplingInterval is the interval at which metrics are sampled
 	samplingInterval time.Duration
 
+	// samplingIntervalChanged is closed when samplingInterval changes
+	samplingIntervalChanged chan struct{}
+
+	// samplingMu protects samplingInterval and samplingIntervalChanged
+	samplingMu sync.Mutex
+
 	// samplesToAverage is the number of samples to average before sending the metrics
 	samplesToAverage int32
 
 	// logger is the logger for the system monitor
 	logger *observability.CoreLogger
+
+	// keyPrefix namespaces the metrics of this node when several nodes
+	// share the run, or is empty
+	keyPrefix string
 }
 
 // NewSystemMonitor creates a new SystemMonitor with the given settings
@@ -120,6 +131,8 @@ func NewSystemMonitor(
 		buffer:           buffer,
 		samplingInterval: defaultSamplingInterval,
 		samplesToAverage: defaultSamplesToAverage,
+
+		samplingIntervalChanged: make(chan struct{}),
 	}
 
 	// TODO: rename the setting...should be SamplingIntervalSeconds
@@ -129,6 +142,9 @@ func NewSystemMonitor(
 	if sta := settings.XStatsSamplesToAverage; sta != nil {
 		systemMonitor.samplesToAverage = int32(sta.GetValue())
 	}
+	if rank, ok := wbsettings.From(settings).GetNodeRank(); ok {
+		systemMonitor.keyPrefix = fmt.Sprintf("node.%d.", rank)
+	}
 
 	systemMonitor.logger.Debug(
 		fmt.Sprintf(
@@ -143,11 +159,20 @@ func NewSystemMonitor(
 		return systemMonitor
 	}
 
+	_, err := ParseDCGMFields(settings.GetXStatsDcgmProfilingFields().GetValue())
+	if err != nil {
+		systemMonitor.logger.Warn(
+			"monitor: ignoring unsupported DCGM profiling fields",
+			"error", err,
+		)
+	}
+
 	assets := []Asset{
 		NewMemory(settings),
 		NewCPU(settings),
 		NewDisk(settings),
 		NewNetwork(settings),
+		NewProcessIO(settings),
 		NewGPUNvidia(settings),
 		NewGPUAMD(settings),
 		NewGPUApple(settings),
@@ -178,21 +203,6 @@ func (sm *SystemMonitor) Do() {
 	}
 }
 
-func getSlurmEnvVars() map[string]string {
-	slurmVars := make(map[string]string)
-	for _, envVar := range os.Environ() {
-		keyValPair := strings.SplitN(envVar, "=", 2)
-		key := keyValPair[0]
-		value := keyValPair[1]
-
-		if strings.HasPrefix(key, "SLURM_") {
-			suffix := strings.ToLower(strings.TrimPrefix(key, "SLURM_"))
-			slurmVars[suffix] = value
-		}
-	}
-	return slurmVars
-}
-
 func (sm *SystemMonitor) Probe() *service.MetadataRequest {
 	if sm == nil {
 		return nil
@@ -204,17 +214,47 @@ func (sm *SystemMonitor) Probe() *service.MetadataRequest {
 			proto.Merge(&systemInfo, probeResponse)
 		}
 	}
-	// capture SLURM-related environment variables
-	for k, v := range getSlurmEnvVars() {
-		if systemInfo.Slurm == nil {
-			systemInfo.Slurm = make(map[string]string)
-		}
-		systemInfo.Slurm[k] = v
+	// capture environment variables of HPC job schedulers
+	if slurm := hpcjob.Env(os.Environ(), hpcjob.Slurm); len(slurm) > 0 {
+		systemInfo.Slurm = slurm
+	}
+	if pbs := hpcjob.Env(os.Environ(), hpcjob.PBS); len(pbs) > 0 {
+		systemInfo.Pbs = pbs
 	}
 
+	probe := NewContainerProbe()
+	systemInfo.Container = probe.Container(sm.settings.GetDocker().GetValue())
+	systemInfo.Kubernetes = probe.Kubernetes()
+
 	return &systemInfo
 }
 
+// SetSamplingInterval changes the interval at which metrics are sampled,
+// including for assets that are already being monitored.
+func (sm *SystemMonitor) SetSamplingInterval(interval time.Duration) {
+	if sm == nil || interval <= 0 {
+		return
+	}
+
+	sm.samplingMu.Lock()
+	defer sm.samplingMu.Unlock()
+	if interval == sm.samplingInterval {
+		return
+	}
+	sm.logger.Info("monitor: changing sampling interval", "interval", interval)
+	sm.samplingInterval = interval
+	close(sm.samplingIntervalChanged)
+	sm.samplingIntervalChanged = make(chan struct{})
+}
+
+// currentSamplingInterval returns the sampling interval and a channel
+// that's closed when it changes.
+func (sm *SystemMonitor) currentSamplingInterval() (time.Duration, <-chan struct{}) {
+	sm.samplingMu.Lock()
+	defer sm.samplingMu.Unlock()
+	return sm.samplingInterval, sm.samplingIntervalChanged
+}
+
 func (sm *SystemMonitor) Monitor(asset Asset) {
 	// recover from panic and log the error
 	defer func() {
@@ -225,7 +265,8 @@ func (sm *SystemMonitor) Monitor(asset Asset) {
 	}()
 
 	// Create a ticker that fires every `samplingInterval` seconds
-	ticker := time.NewTicker(sm.samplingInterval)
+	interval, intervalChanged := sm.currentSamplingInterval()
+	ticker := time.NewTicker(interval)
 	defer ticker.Stop()
 
 	// Create a new channel and immediately send a signal to it.
@@ -245,6 +286,9 @@ func (sm *SystemMonitor) Monitor(asset Asset) {
 		select {
 		case <-sm.ctx.Done():
 			return
+		case <-intervalChanged:
+			interval, intervalChanged = sm.currentSamplingInterval()
+			ticker.Reset(interval)
 		case <-tickChan:
 			asset.SampleMetrics()
 			samplesCollected++
@@ -261,7 +305,7 @@ func (sm *SystemMonitor) Monitor(asset Asset) {
 					}
 
 					// publish metrics
-					record := makeStatsRecord(aggregatedMetrics, ts)
+					record := makeStatsRecord(sm.namespaced(aggregatedMetrics), ts)
 					// ensure that the context is not done before sending the record
 					select {
 					case <-sm.ctx.Done():
@@ -280,6 +324,19 @@ func (sm *SystemMonitor) Monitor(asset Asset) {
 
 }
 
+// namespaced returns metrics with their keys prefixed by the node's
+// namespace, if any.
+func (sm *SystemMonitor) namespaced(metrics map[string]float64) map[string]float64 {
+	if sm.keyPrefix == "" {
+		return metrics
+	}
+	prefixed := make(map[string]float64, len(metrics))
+	for k, v := range metrics {
+		prefixed[sm.keyPrefix+k] = v
+	}
+	return prefixed
+}
+
 func (sm *SystemMonitor) GetBuffer() map[string]List {
 	if sm == nil || sm.buffer == nil {
 		return nil
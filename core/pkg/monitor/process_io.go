@@ -0,0 +1,305 @@
+package monitor
+
+import (
+	"sync"
+	"time"
+
+	"github.com/shirou/gopsutil/v4/process"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// ProcessIOCounters are cumulative I/O counters of a process tree.
+type ProcessIOCounters struct {
+	// Disk are the disk counters of the process and each of its
+	// descendants, by PID.
+	Disk map[int32]ProcessDiskCounters
+
+	// NetSentBytes and NetRecvBytes are the counters of the process's
+	// network namespace. Unless the process runs in its own namespace,
+	// such as in a container, these include all traffic of the host.
+	NetSentBytes uint64
+	NetRecvBytes uint64
+}
+
+// ProcessDiskCounters are the cumulative disk counters of one process.
+type ProcessDiskCounters struct {
+	// Parent is the PID of the process's parent.
+	Parent int32
+
+	ReadBytes  uint64
+	WriteBytes uint64
+}
+
+// processIOTotals are the increases of a process tree's counters.
+type processIOTotals struct {
+	DiskReadBytes  uint64
+	DiskWriteBytes uint64
+	NetSentBytes   uint64
+	NetRecvBytes   uint64
+}
+
+// ProcessIO monitors disk I/O of the user process and its children, and
+// network I/O of its network namespace.
+//
+// This makes it possible to tell whether a job is the one saturating
+// shared storage, which system-wide counters cannot show. The network
+// metrics are named proc.netns.* because they are only specific to the
+// job if it has its own network namespace.
+type ProcessIO struct {
+	name     string
+	metrics  map[string][]float64
+	settings *service.Settings
+	mutex    sync.RWMutex
+
+	// GetCountersFunc returns the current I/O counters of the process tree.
+	GetCountersFunc func() (ProcessIOCounters, error)
+
+	// started is whether the first sample has been taken.
+	started bool
+
+	// total are the increases of the counters since monitoring started.
+	//
+	// Processes come and go, so the total is the sum of the increases
+	// between samples rather than the difference from the first sample.
+	total processIOTotals
+
+	// last are the counters at the previous sample.
+	last ProcessIOCounters
+
+	// lastTime is the time of the previous sample.
+	lastTime time.Time
+}
+
+func NewProcessIO(settings *service.Settings) *ProcessIO {
+	p := &ProcessIO{
+		name:     "process_io",
+		metrics:  map[string][]float64{},
+		settings: settings,
+	}
+	p.GetCountersFunc = p.getCounters
+	return p
+}
+
+func (p *ProcessIO) Name() string { return p.name }
+
+// getCounters reads the counters of the monitored process tree.
+func (p *ProcessIO) getCounters() (ProcessIOCounters, error) {
+	counters := ProcessIOCounters{Disk: make(map[int32]ProcessDiskCounters)}
+
+	proc, err := process.NewProcess(p.settings.GetXStatsPid().GetValue())
+	if err != nil {
+		return counters, err
+	}
+
+	for pr, parent := range processTree(proc) {
+		io, err := pr.IOCounters()
+		if err != nil {
+			continue
+		}
+		counters.Disk[pr.Pid] = ProcessDiskCounters{
+			Parent:     parent,
+			ReadBytes:  io.ReadBytes,
+			WriteBytes: io.WriteBytes,
+		}
+	}
+
+	// Network counters are per network namespace rather than per process,
+	// so only the root process is queried to avoid double counting.
+	sent, recv, err := processNetIOCounters(proc.Pid)
+	if err == nil {
+		counters.NetSentBytes = sent
+		counters.NetRecvBytes = recv
+	}
+
+	return counters, nil
+}
+
+// processTree returns the process and all of its descendants, with the
+// PIDs of their parents.
+func processTree(root *process.Process) map[*process.Process]int32 {
+	tree := map[*process.Process]int32{root: 0}
+	queue := []*process.Process{root}
+	for len(queue) > 0 {
+		parent := queue[0]
+		queue = queue[1:]
+
+		children, err := parent.Children()
+		if err != nil {
+			continue
+		}
+		for _, child := range children {
+			tree[child] = parent.Pid
+		}
+		queue = append(queue, children...)
+	}
+	return tree
+}
+
+// counterDelta returns the increase of a counter, treating decreases
+// (e.g. when the namespace's counters are reset) as zero.
+func counterDelta(current, previous uint64) float64 {
+	if current < previous {
+		return 0
+	}
+	return float64(current - previous)
+}
+
+// diskDeltas returns the increases of the disk counters of a process tree
+// between two samples.
+//
+// Each process's counters are compared with its own previous counters.
+// A process that exited was counted up to the previous sample; the rest
+// of its I/O shows up in its parent once the parent reaps it, because the
+// kernel adds a reaped child's counters to its parent's. So the counters
+// of exited processes are subtracted from the increase of the nearest
+// ancestor that is still running, to count them only once.
+func diskDeltas(current, previous map[int32]ProcessDiskCounters) (read, write uint64) {
+	exited := make(map[int32]ProcessDiskCounters)
+	for pid, counters := range previous {
+		if _, ok := current[pid]; ok {
+			continue
+		}
+
+		reaper := counters.Parent
+		for {
+			if _, ok := current[reaper]; ok {
+				break
+			}
+			ancestor, ok := previous[reaper]
+			if !ok {
+				// The process was orphaned, so its parent is not
+				// monitored.
+				break
+			}
+			reaper = ancestor.Parent
+		}
+
+		reaped := exited[reaper]
+		reaped.ReadBytes += counters.ReadBytes
+		reaped.WriteBytes += counters.WriteBytes
+		exited[reaper] = reaped
+	}
+
+	for pid, counters := range current {
+		last := previous[pid]
+		if counters.ReadBytes < last.ReadBytes || counters.WriteBytes < last.WriteBytes {
+			// The PID was reused by a new process.
+			last = ProcessDiskCounters{}
+		}
+		last.ReadBytes += exited[pid].ReadBytes
+		last.WriteBytes += exited[pid].WriteBytes
+
+		read += uint64(counterDelta(counters.ReadBytes, last.ReadBytes))
+		write += uint64(counterDelta(counters.WriteBytes, last.WriteBytes))
+	}
+	return read, write
+}
+
+func (p *ProcessIO) SampleMetrics() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	counters, err := p.GetCountersFunc()
+	if err != nil {
+		return
+	}
+	now := time.Now()
+
+	if !p.started {
+		p.started = true
+		p.last = counters
+		p.lastTime = now
+		return
+	}
+
+	diskRead, diskWrite := diskDeltas(counters.Disk, p.last.Disk)
+	p.total.DiskReadBytes += diskRead
+	p.total.DiskWriteBytes += diskWrite
+	p.total.NetSentBytes += uint64(counterDelta(counters.NetSentBytes, p.last.NetSentBytes))
+	p.total.NetRecvBytes += uint64(counterDelta(counters.NetRecvBytes, p.last.NetRecvBytes))
+
+	// MB read/written and bytes sent/received since monitoring started
+	p.metrics["proc.disk.in"] = append(
+		p.metrics["proc.disk.in"],
+		float64(p.total.DiskReadBytes)/1024/1024,
+	)
+	p.metrics["proc.disk.out"] = append(
+		p.metrics["proc.disk.out"],
+		float64(p.total.DiskWriteBytes)/1024/1024,
+	)
+	p.metrics["proc.netns.sent"] = append(
+		p.metrics["proc.netns.sent"],
+		float64(p.total.NetSentBytes),
+	)
+	p.metrics["proc.netns.recv"] = append(
+		p.metrics["proc.netns.recv"],
+		float64(p.total.NetRecvBytes),
+	)
+
+	// MB/s read/written and bytes/s sent/received since the last sample
+	if elapsed := now.Sub(p.lastTime).Seconds(); elapsed > 0 {
+		p.metrics["proc.disk.readRateMBps"] = append(
+			p.metrics["proc.disk.readRateMBps"],
+			float64(diskRead)/1024/1024/elapsed,
+		)
+		p.metrics["proc.disk.writeRateMBps"] = append(
+			p.metrics["proc.disk.writeRateMBps"],
+			float64(diskWrite)/1024/1024/elapsed,
+		)
+		p.metrics["proc.netns.sentRateBps"] = append(
+			p.metrics["proc.netns.sentRateBps"],
+			counterDelta(counters.NetSentBytes, p.last.NetSentBytes)/elapsed,
+		)
+		p.metrics["proc.netns.recvRateBps"] = append(
+			p.metrics["proc.netns.recvRateBps"],
+			counterDelta(counters.NetRecvBytes, p.last.NetRecvBytes)/elapsed,
+		)
+	}
+
+	p.last = counters
+	p.lastTime = now
+}
+
+func (p *ProcessIO) AggregateMetrics() map[string]float64 {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	aggregates := make(map[string]float64)
+	for metric, samples := range p.metrics {
+		if len(samples) == 0 {
+			continue
+		}
+		switch metric {
+		case "proc.disk.in", "proc.disk.out", "proc.netns.sent", "proc.netns.recv":
+			// cumulative values: report the latest
+			aggregates[metric] = samples[len(samples)-1]
+		default:
+			aggregates[metric] = Average(samples)
+		}
+	}
+	return aggregates
+}
+
+func (p *ProcessIO) ClearMetrics() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.metrics = map[string][]float64{}
+}
+
+func (p *ProcessIO) IsAvailable() bool {
+	return p.settings.GetXStatsPid().GetValue() != 0
+}
+
+func (p *ProcessIO) Probe() *service.MetadataRequest {
+	return nil
+}
+
+// Samples returns the raw samples collected so far.
+func (p *ProcessIO) Samples() map[string][]float64 {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	return p.metrics
+}
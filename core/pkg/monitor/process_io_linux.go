@@ -0,0 +1,23 @@
+//go:build linux
+
+package monitor
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/shirou/gopsutil/v4/net"
+)
+
+// processNetIOCounters returns the bytes sent and received in the network
+// namespace of the process.
+func processNetIOCounters(pid int32) (sent, recv uint64, err error) {
+	counters, err := net.IOCountersByFile(false, fmt.Sprintf("/proc/%d/net/dev", pid))
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(counters) == 0 {
+		return 0, 0, errors.New("monitor: no network counters")
+	}
+	return counters[0].BytesSent, counters[0].BytesRecv, nil
+}
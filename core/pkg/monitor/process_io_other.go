@@ -0,0 +1,10 @@
+//go:build !linux
+
+package monitor
+
+import "errors"
+
+// processNetIOCounters is only supported on Linux.
+func processNetIOCounters(int32) (sent, recv uint64, err error) {
+	return 0, 0, errors.New("monitor: per-process network counters are not supported")
+}
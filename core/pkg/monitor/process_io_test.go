@@ -0,0 +1,92 @@
+package monitor_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wandb/wandb/core/pkg/monitor"
+	"github.com/wandb/wandb/core/pkg/service"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestProcessIO_SampleMetrics(t *testing.T) {
+	pio := monitor.NewProcessIO(&service.Settings{})
+	disk := func(parent int32, read, write uint64) monitor.ProcessDiskCounters {
+		return monitor.ProcessDiskCounters{Parent: parent, ReadBytes: read, WriteBytes: write}
+	}
+	counters := []monitor.ProcessIOCounters{
+		{
+			Disk:         map[int32]monitor.ProcessDiskCounters{1: disk(0, 1<<20, 0), 2: disk(1, 0, 0)},
+			NetSentBytes: 100, NetRecvBytes: 50,
+		},
+		{
+			Disk:         map[int32]monitor.ProcessDiskCounters{1: disk(0, 1<<20, 0), 2: disk(1, 2<<20, 1<<20)},
+			NetSentBytes: 300, NetRecvBytes: 50,
+		},
+		// the child read 1 MB more and exited, and its counters were added
+		// to its parent's when it was reaped
+		{
+			Disk:         map[int32]monitor.ProcessDiskCounters{1: disk(0, 4<<20, 1<<20)},
+			NetSentBytes: 400, NetRecvBytes: 80,
+		},
+		// a new child read 1 MB
+		{
+			Disk:         map[int32]monitor.ProcessDiskCounters{1: disk(0, 4<<20, 1<<20), 3: disk(1, 1<<20, 0)},
+			NetSentBytes: 400, NetRecvBytes: 80,
+		},
+	}
+	pio.GetCountersFunc = func() (monitor.ProcessIOCounters, error) {
+		c := counters[0]
+		counters = counters[1:]
+		return c, nil
+	}
+
+	pio.SampleMetrics()
+	assert.Len(t, pio.Samples(), 0)
+
+	pio.SampleMetrics()
+	pio.SampleMetrics()
+	pio.SampleMetrics()
+	samples := pio.Samples()
+	assert.Equal(t, []float64{2, 3, 4}, samples["proc.disk.in"])
+	assert.Equal(t, []float64{1, 1, 1}, samples["proc.disk.out"])
+	assert.Equal(t, []float64{200, 300, 300}, samples["proc.netns.sent"])
+	assert.Equal(t, []float64{0, 30, 30}, samples["proc.netns.recv"])
+
+	aggregates := pio.AggregateMetrics()
+	assert.Equal(t, 4.0, aggregates["proc.disk.in"])
+	assert.Equal(t, 300.0, aggregates["proc.netns.sent"])
+	assert.Contains(t, aggregates, "proc.disk.readRateMBps")
+	assert.Contains(t, aggregates, "proc.netns.sentRateBps")
+	for _, v := range aggregates {
+		assert.GreaterOrEqual(t, v, 0.0)
+	}
+}
+
+func TestProcessIO_ExitedSubtree(t *testing.T) {
+	pio := monitor.NewProcessIO(&service.Settings{})
+	counters := []map[int32]monitor.ProcessDiskCounters{
+		{1: {}, 2: {Parent: 1}, 3: {Parent: 2}},
+		{1: {}, 2: {Parent: 1, ReadBytes: 1 << 20}, 3: {Parent: 2, ReadBytes: 2 << 20}},
+		// the grandchild read 1 MB more, and both exited and were reaped
+		{1: {ReadBytes: 4 << 20}},
+	}
+	pio.GetCountersFunc = func() (monitor.ProcessIOCounters, error) {
+		c := counters[0]
+		counters = counters[1:]
+		return monitor.ProcessIOCounters{Disk: c}, nil
+	}
+
+	pio.SampleMetrics()
+	pio.SampleMetrics()
+	pio.SampleMetrics()
+
+	assert.Equal(t, []float64{3, 4}, pio.Samples()["proc.disk.in"])
+}
+
+func TestProcessIO_IsAvailable(t *testing.T) {
+	assert.False(t, monitor.NewProcessIO(&service.Settings{}).IsAvailable())
+	assert.True(t, monitor.NewProcessIO(&service.Settings{
+		XStatsPid: wrapperspb.Int32(1),
+	}).IsAvailable())
+}
@@ -4,6 +4,9 @@ import (
 	"fmt"
 	"sync"
 	"time"
+
+	"github.com/wandb/wandb/core/pkg/service"
+	"google.golang.org/protobuf/proto"
 )
 
 // Printer stores console messages to display to the user.
@@ -11,6 +14,10 @@ type Printer struct {
 	sync.Mutex
 	messages []string
 
+	// errors are errors for clients to handle, like the messages but with
+	// machine-readable codes.
+	errors []*service.ErrorInfo
+
 	// For rate-limited messages, this is the next time a message may be sent.
 	rateLimits map[string]time.Time
 
@@ -68,6 +75,33 @@ func (p *Printer) Writef(format string, args ...any) {
 	p.messages = append(p.messages, fmt.Sprintf(format, args...))
 }
 
+// ReadErrors returns all buffered errors and clears the buffer.
+func (p *Printer) ReadErrors() []*service.ErrorInfo {
+	p.Lock()
+	defer p.Unlock()
+
+	polledErrors := p.errors
+	p.errors = nil
+
+	return polledErrors
+}
+
+// WriteError adds an error for clients to handle.
+//
+// An error equal to one that's not yet read is dropped, so that a problem
+// hit by many operations, like every upload failing, is reported once.
+func (p *Printer) WriteError(err *service.ErrorInfo) {
+	p.Lock()
+	defer p.Unlock()
+
+	for _, other := range p.errors {
+		if proto.Equal(err, other) {
+			return
+		}
+	}
+	p.errors = append(p.errors, err)
+}
+
 // AtMostEvery allows rate-limiting how often a message is printed.
 //
 // Usage:
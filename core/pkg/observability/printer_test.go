@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/wandb/wandb/core/pkg/service"
 )
 
 func TestReadAfterWrite(t *testing.T) {
@@ -19,6 +20,31 @@ func TestReadAfterWrite(t *testing.T) {
 		p.Read())
 }
 
+func TestWriteError_DropsUnreadDuplicates(t *testing.T) {
+	p := NewPrinter()
+	denied := &service.ErrorInfo{
+		Message: "denied",
+		Code:    service.ErrorInfo_STORAGE_DENIED,
+	}
+
+	p.WriteError(denied)
+	p.WriteError(&service.ErrorInfo{
+		Message: "denied",
+		Code:    service.ErrorInfo_STORAGE_DENIED,
+	})
+	p.WriteError(&service.ErrorInfo{
+		Message: "slow down",
+		Code:    service.ErrorInfo_RATE_LIMITED,
+	})
+	errs := p.ReadErrors()
+	p.WriteError(denied)
+
+	assert.Len(t, errs, 2)
+	assert.Equal(t, service.ErrorInfo_STORAGE_DENIED, errs[0].GetCode())
+	assert.Equal(t, service.ErrorInfo_RATE_LIMITED, errs[1].GetCode())
+	assert.Len(t, p.ReadErrors(), 1)
+}
+
 func TestRateLimitedWrite(t *testing.T) {
 	nowMilli := &atomic.Int64{}
 	p := NewPrinter()
@@ -0,0 +1,147 @@
+package observability
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/wandb/wandb/core/pkg/service"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// Tracer records OpenTelemetry spans for the work a stream does, so that
+// wandb-core's overhead can be seen in a tracing backend.
+//
+// Each stage of the record pipeline---handler, writer and sender---makes
+// a span per record, and the span of the stage that forwarded a record is
+// the parent of the next stage's span.
+//
+// A nil Tracer records nothing.
+type Tracer struct {
+	provider *sdktrace.TracerProvider
+	tracer   trace.Tracer
+
+	// parents are the span contexts of the stages that forwarded records
+	// to the next stage, by record. Each is removed when the next stage
+	// starts its span.
+	parents sync.Map
+}
+
+// NewOTLPTracer returns a Tracer that exports spans over OTLP/HTTP.
+//
+// The endpoint is a URL like "http://localhost:4318". If it's empty, the
+// standard OTEL_EXPORTER_OTLP_* environment variables are used. The
+// attributes describe the resource being traced, such as the run.
+func NewOTLPTracer(
+	ctx context.Context,
+	endpoint string,
+	attrs ...attribute.KeyValue,
+) (*Tracer, error) {
+	var opts []otlptracehttp.Option
+	if endpoint != "" {
+		opts = append(opts, otlptracehttp.WithEndpointURL(endpoint))
+	}
+
+	exporter, err := otlptracehttp.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("observability: failed to create exporter: %v", err)
+	}
+
+	attrs = append(attrs, attribute.String("service.name", "wandb-core"))
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(attrs...)),
+	)
+	return NewTracer(provider), nil
+}
+
+// NewTracer returns a Tracer that records spans with the given provider.
+//
+// Shutdown shuts down the provider.
+func NewTracer(provider *sdktrace.TracerProvider) *Tracer {
+	return &Tracer{
+		provider: provider,
+		tracer:   provider.Tracer("github.com/wandb/wandb/core"),
+	}
+}
+
+// Shutdown exports the remaining spans and stops the exporter.
+func (t *Tracer) Shutdown(ctx context.Context) error {
+	if t == nil || t.provider == nil {
+		return nil
+	}
+	return t.provider.Shutdown(ctx)
+}
+
+// Start starts a span that isn't tied to a record.
+func (t *Tracer) Start(
+	ctx context.Context,
+	name string,
+	attrs ...attribute.KeyValue,
+) (context.Context, trace.Span) {
+	if t == nil {
+		return noop.NewTracerProvider().Tracer("").Start(ctx, name)
+	}
+	return t.tracer.Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// StartRecord starts the span of a pipeline stage processing a record.
+//
+// The span is a child of the span of the stage that forwarded the record,
+// if any.
+func (t *Tracer) StartRecord(record *service.Record, stage string) trace.Span {
+	if t == nil {
+		_, span := t.Start(context.Background(), stage)
+		return span
+	}
+
+	ctx := context.Background()
+	if parent, ok := t.parents.LoadAndDelete(record); ok {
+		ctx = trace.ContextWithSpanContext(ctx, parent.(trace.SpanContext))
+	}
+
+	_, span := t.tracer.Start(ctx, stage,
+		trace.WithAttributes(
+			attribute.String("wandb.record_type", RecordTypeName(record)),
+		))
+	return span
+}
+
+// Forward makes span the parent of the span of the next stage to process
+// the record.
+//
+// It must only be called when the record is forwarded to a stage that
+// calls StartRecord on it.
+func (t *Tracer) Forward(record *service.Record, span trace.Span) {
+	if t == nil || !span.SpanContext().IsValid() {
+		return
+	}
+	t.parents.Store(record, span.SpanContext())
+}
+
+// RecordTypeName returns a short name for the type of a record, like
+// "history" or "request.defer".
+func RecordTypeName(record *service.Record) string {
+	switch x := record.GetRecordType().(type) {
+	case nil:
+		return "none"
+	case *service.Record_Request:
+		request := x.Request.ProtoReflect()
+		if field := request.WhichOneof(
+			request.Descriptor().Oneofs().ByName("request_type"),
+		); field != nil {
+			return "request." + string(field.Name())
+		}
+		return "request"
+	default:
+		message := record.ProtoReflect()
+		field := message.WhichOneof(
+			message.Descriptor().Oneofs().ByName("record_type"))
+		return string(field.Name())
+	}
+}
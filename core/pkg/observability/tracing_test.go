@@ -0,0 +1,80 @@
+package observability_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func newTestTracer() (*observability.Tracer, *tracetest.InMemoryExporter) {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	return observability.NewTracer(provider), exporter
+}
+
+func TestTracer_LinksStages(t *testing.T) {
+	tracer, exporter := newTestTracer()
+	record := &service.Record{RecordType: &service.Record_History{}}
+
+	handlerSpan := tracer.StartRecord(record, "handler")
+	tracer.Forward(record, handlerSpan)
+	handlerSpan.End()
+	writerSpan := tracer.StartRecord(record, "writer")
+	writerSpan.End()
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 2)
+	assert.Equal(t, "handler", spans[0].Name)
+	assert.Equal(t, "writer", spans[1].Name)
+	assert.Equal(t, spans[0].SpanContext.TraceID(), spans[1].SpanContext.TraceID())
+	assert.Equal(t, spans[0].SpanContext.SpanID(), spans[1].Parent.SpanID())
+}
+
+func TestTracer_ParentIsConsumed(t *testing.T) {
+	tracer, exporter := newTestTracer()
+	record := &service.Record{RecordType: &service.Record_History{}}
+
+	handlerSpan := tracer.StartRecord(record, "handler")
+	tracer.Forward(record, handlerSpan)
+	handlerSpan.End()
+	tracer.StartRecord(record, "writer").End()
+	tracer.StartRecord(record, "writer").End()
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 3)
+	assert.False(t, spans[2].Parent.IsValid())
+}
+
+func TestTracer_Nil(t *testing.T) {
+	var tracer *observability.Tracer
+	record := &service.Record{}
+
+	span := tracer.StartRecord(record, "handler")
+	tracer.Forward(record, span)
+	span.End()
+	_, span = tracer.Start(context.Background(), "filestream.send")
+	span.End()
+
+	assert.NoError(t, tracer.Shutdown(context.Background()))
+}
+
+func TestRecordTypeName(t *testing.T) {
+	assert.Equal(t, "history",
+		observability.RecordTypeName(
+			&service.Record{RecordType: &service.Record_History{}}))
+	assert.Equal(t, "request.defer",
+		observability.RecordTypeName(&service.Record{
+			RecordType: &service.Record_Request{
+				Request: &service.Request{
+					RequestType: &service.Request_Defer{},
+				},
+			},
+		}))
+	assert.Equal(t, "none", observability.RecordTypeName(&service.Record{}))
+}
@@ -0,0 +1,51 @@
+package publicapi
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+// DownloadFile downloads a file, such as one of a run's files, to a local
+// path.
+//
+// The download has no time limit, since files can be large. It's written
+// to a temporary file in the same directory and then renamed, so the path
+// never holds a partially downloaded file, and an existing file there is
+// only replaced once the download succeeds.
+func (c *Client) DownloadFile(ctx context.Context, fileURL, path string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fileURL, nil)
+	if err != nil {
+		return fmt.Errorf("publicapi: invalid file URL: %v", err)
+	}
+
+	resp, err := c.files.Do(req)
+	if err != nil {
+		return fmt.Errorf("publicapi: failed to download %s: %v", path, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("publicapi: failed to download %s: %s", path, resp.Status)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("publicapi: %v", err)
+	}
+	_, err = io.Copy(tmp, resp.Body)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("publicapi: failed to download %s: %v", path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package publicapi_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/gqlmock"
+	"github.com/wandb/wandb/core/pkg/publicapi"
+)
+
+func TestDownloadFile(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/missing" {
+				http.NotFound(w, r)
+				return
+			}
+			_, _ = w.Write([]byte("checkpoint"))
+		}))
+	defer server.Close()
+	client := publicapi.NewClient(gqlmock.NewMockClient())
+	dir := t.TempDir()
+	path := filepath.Join(dir, "model.pt")
+
+	err := client.DownloadFile(context.Background(), server.URL+"/model.pt", path)
+	require.NoError(t, err)
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "checkpoint", string(content))
+
+	err = client.DownloadFile(context.Background(), server.URL+"/missing", path)
+	assert.ErrorContains(t, err, "404")
+	content, err = os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "checkpoint", string(content))
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
@@ -0,0 +1,88 @@
+package publicapi
+
+import "strings"
+
+// Filter selects runs.
+//
+// Filters are MongoDB-style queries, the same as the filters of
+// wandb.Api().runs() in Python, so a Filter can also be written by hand:
+//
+//	publicapi.Filter{"tags": map[string]any{"$in": []string{"baseline"}}}
+type Filter map[string]any
+
+// Key is a property of a run that filters compare.
+type Key string
+
+// ConfigKey is a key in the runs' config.
+//
+// Keys of nested values are separated by dots, as in "optimizer.lr".
+func ConfigKey(key string) Key {
+	// Config values are stored as {"value": ..., "desc": ...}.
+	first, rest, nested := strings.Cut(key, ".")
+	if nested {
+		return Key("config." + first + ".value." + rest)
+	}
+	return Key("config." + first + ".value")
+}
+
+// SummaryKey is a key in the runs' summary.
+//
+// Keys of nested values are separated by dots.
+func SummaryKey(key string) Key {
+	return Key("summary_metrics." + key)
+}
+
+// Eq selects runs where the key's value is equal to the value.
+func (k Key) Eq(value any) Filter {
+	return Filter{string(k): value}
+}
+
+// Ne selects runs where the key's value is not equal to the value.
+func (k Key) Ne(value any) Filter {
+	return k.compare("$ne", value)
+}
+
+// Gt selects runs where the key's value is greater than the value.
+func (k Key) Gt(value any) Filter {
+	return k.compare("$gt", value)
+}
+
+// Gte selects runs where the key's value is at least the value.
+func (k Key) Gte(value any) Filter {
+	return k.compare("$gte", value)
+}
+
+// Lt selects runs where the key's value is less than the value.
+func (k Key) Lt(value any) Filter {
+	return k.compare("$lt", value)
+}
+
+// Lte selects runs where the key's value is at most the value.
+func (k Key) Lte(value any) Filter {
+	return k.compare("$lte", value)
+}
+
+// In selects runs where the key's value is one of the values.
+func (k Key) In(values ...any) Filter {
+	return k.compare("$in", values)
+}
+
+// Exists selects runs that have the key, or don't have it if exists is
+// false.
+func (k Key) Exists(exists bool) Filter {
+	return k.compare("$exists", exists)
+}
+
+func (k Key) compare(operator string, value any) Filter {
+	return Filter{string(k): map[string]any{operator: value}}
+}
+
+// And selects runs that match all of the filters.
+func And(filters ...Filter) Filter {
+	return Filter{"$and": filters}
+}
+
+// Or selects runs that match any of the filters.
+func Or(filters ...Filter) Filter {
+	return Filter{"$or": filters}
+}
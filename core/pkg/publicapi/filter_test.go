@@ -0,0 +1,28 @@
+package publicapi_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wandb/segmentio-encoding/json"
+	"github.com/wandb/wandb/core/pkg/publicapi"
+)
+
+func TestFilter(t *testing.T) {
+	filter := publicapi.And(
+		publicapi.ConfigKey("optimizer").Eq("adam"),
+		publicapi.ConfigKey("model.layers").In(2, 4),
+		publicapi.SummaryKey("accuracy").Gte(0.9),
+	)
+
+	filterJSON, err := json.Marshal(filter)
+
+	assert.NoError(t, err)
+	assert.JSONEq(t,
+		`{"$and": [
+			{"config.optimizer.value": "adam"},
+			{"config.model.value.layers": {"$in": [2, 4]}},
+			{"summary_metrics.accuracy": {"$gte": 0.9}}
+		]}`,
+		string(filterJSON))
+}
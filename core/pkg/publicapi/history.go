@@ -0,0 +1,256 @@
+package publicapi
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/wandb/segmentio-encoding/json"
+	"github.com/wandb/wandb/core/internal/gql"
+)
+
+// DefaultHistoryPageSize is the default number of steps a HistoryScanner
+// requests at a time.
+const DefaultHistoryPageSize = 1000
+
+// historyParams are the options of a history request.
+type historyParams struct {
+	keys     []string
+	minStep  *int64
+	maxStep  *int64
+	pageSize int64
+}
+
+// HistoryOption configures a history request.
+type HistoryOption func(*historyParams)
+
+// WithKeys returns only the rows that have all of the keys, and only
+// those keys and "_step".
+func WithKeys(keys ...string) HistoryOption {
+	return func(p *historyParams) {
+		p.keys = append(p.keys, keys...)
+	}
+}
+
+// WithMinStep skips rows before the step.
+func WithMinStep(step int64) HistoryOption {
+	return func(p *historyParams) {
+		p.minStep = &step
+	}
+}
+
+// WithMaxStep skips rows at or after the step.
+func WithMaxStep(step int64) HistoryOption {
+	return func(p *historyParams) {
+		p.maxStep = &step
+	}
+}
+
+// WithHistoryPageSize sets the number of steps a HistoryScanner requests
+// at a time.
+func WithHistoryPageSize(size int) HistoryOption {
+	return func(p *historyParams) {
+		if size > 0 {
+			p.pageSize = int64(size)
+		}
+	}
+}
+
+func newHistoryParams(opts []HistoryOption) *historyParams {
+	params := &historyParams{pageSize: DefaultHistoryPageSize}
+	for _, opt := range opts {
+		opt(params)
+	}
+	return params
+}
+
+// History returns about the given number of a run's history rows, ordered
+// by step.
+//
+// The server samples the rows, keeping the first and last rows and the
+// minimum and maximum of each key, so that the result can be plotted. A
+// run with fewer rows returns all of them.
+func (c *Client) History(
+	ctx context.Context,
+	path RunPath,
+	samples int,
+	opts ...HistoryOption,
+) ([]map[string]any, error) {
+	return c.history(ctx, path, samples, newHistoryParams(opts))
+}
+
+func (c *Client) history(
+	ctx context.Context,
+	path RunPath,
+	samples int,
+	params *historyParams,
+) ([]map[string]any, error) {
+	if len(params.keys) == 0 {
+		data, err := gql.RunHistory(ctx, c.graphql,
+			path.Entity,
+			path.Project,
+			path.RunID,
+			samples,
+			params.minStep,
+			params.maxStep,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("publicapi: RunHistory failed: %v", err)
+		}
+		if data.Project == nil || data.Project.Run == nil {
+			return nil, ErrRunNotFound
+		}
+
+		rows := make([]map[string]any, 0, len(data.Project.Run.History))
+		for _, line := range data.Project.Run.History {
+			row := make(map[string]any)
+			if err := json.Unmarshal([]byte(line), &row); err != nil {
+				return nil, fmt.Errorf("publicapi: invalid history row: %v", err)
+			}
+			rows = append(rows, row)
+		}
+		return rows, nil
+	}
+
+	spec := map[string]any{
+		"keys":    append([]string{"_step"}, params.keys...),
+		"samples": samples,
+	}
+	if params.minStep != nil {
+		spec["minStep"] = *params.minStep
+	}
+	if params.maxStep != nil {
+		spec["maxStep"] = *params.maxStep
+	}
+	specJSON, err := json.Marshal(spec)
+	if err != nil {
+		return nil, fmt.Errorf("publicapi: invalid history keys: %v", err)
+	}
+
+	data, err := gql.RunSampledHistory(ctx, c.graphql,
+		path.Entity,
+		path.Project,
+		path.RunID,
+		[]string{string(specJSON)},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("publicapi: RunSampledHistory failed: %v", err)
+	}
+	if data.Project == nil || data.Project.Run == nil {
+		return nil, ErrRunNotFound
+	}
+
+	// There's a list of rows for each spec.
+	var sampled [][]map[string]any
+	if raw := data.Project.Run.SampledHistory; len(raw) > 0 {
+		if err := json.Unmarshal(raw, &sampled); err != nil {
+			return nil, fmt.Errorf("publicapi: invalid sampled history: %v", err)
+		}
+	}
+	if len(sampled) == 0 {
+		return nil, nil
+	}
+	return sampled[0], nil
+}
+
+// HistoryScanner returns every row of a run's history, requesting a page
+// of steps at a time.
+type HistoryScanner struct {
+	client *Client
+	path   RunPath
+	params *historyParams
+
+	// nextStep is the first step of the next page.
+	nextStep int64
+
+	// endStep is the step after the last step to return; it's fetched
+	// with the first page if not given as an option.
+	endStep *int64
+
+	// page is the rest of the last page of rows.
+	page []map[string]any
+}
+
+// ScanHistory returns a scanner over all of a run's history rows, ordered
+// by step.
+//
+// Unlike History, no rows are left out, however many there are.
+func (c *Client) ScanHistory(path RunPath, opts ...HistoryOption) *HistoryScanner {
+	params := newHistoryParams(opts)
+	scanner := &HistoryScanner{
+		client:  c,
+		path:    path,
+		params:  params,
+		endStep: params.maxStep,
+	}
+	if params.minStep != nil {
+		scanner.nextStep = *params.minStep
+	}
+	return scanner
+}
+
+// Next returns the next row.
+//
+// It returns io.EOF after the last row.
+func (s *HistoryScanner) Next(ctx context.Context) (map[string]any, error) {
+	if s.endStep == nil {
+		endStep, err := s.client.endStep(ctx, s.path)
+		if err != nil {
+			return nil, err
+		}
+		s.endStep = &endStep
+	}
+
+	for len(s.page) == 0 {
+		if s.nextStep >= *s.endStep {
+			return nil, io.EOF
+		}
+
+		pageStart := s.nextStep
+		pageEnd := min(pageStart+s.params.pageSize, *s.endStep)
+		page := *s.params
+		page.minStep = &pageStart
+		page.maxStep = &pageEnd
+
+		// Each page has at most pageSize steps, so none are sampled out.
+		rows, err := s.client.history(ctx, s.path, int(s.params.pageSize), &page)
+		if err != nil {
+			return nil, err
+		}
+		s.page = rows
+		s.nextStep = pageEnd
+	}
+
+	row := s.page[0]
+	s.page = s.page[1:]
+	return row, nil
+}
+
+// endStep returns the step after the last step in a run's history.
+func (c *Client) endStep(ctx context.Context, path RunPath) (int64, error) {
+	data, err := gql.RunLastStep(ctx, c.graphql,
+		path.Entity,
+		path.Project,
+		path.RunID,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("publicapi: RunLastStep failed: %v", err)
+	}
+	if data.Project == nil || data.Project.Run == nil {
+		return 0, ErrRunNotFound
+	}
+
+	var keys struct {
+		LastStep *int64 `json:"lastStep"`
+	}
+	if raw := data.Project.Run.HistoryKeys; len(raw) > 0 {
+		if err := json.Unmarshal(raw, &keys); err != nil {
+			return 0, fmt.Errorf("publicapi: invalid history keys: %v", err)
+		}
+	}
+	if keys.LastStep == nil {
+		// The run has no history.
+		return 0, nil
+	}
+	return *keys.LastStep + 1, nil
+}
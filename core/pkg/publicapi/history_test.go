@@ -0,0 +1,88 @@
+package publicapi_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/gqlmock"
+	"github.com/wandb/wandb/core/pkg/publicapi"
+)
+
+func TestHistory_SampledKeys(t *testing.T) {
+	client := gqlmock.NewMockClient()
+	client.StubMatchOnce(
+		gqlmock.WithOpName("RunSampledHistory"),
+		`{"project": {"run": {"sampledHistory": [[
+			{"_step": 0, "loss": 2},
+			{"_step": 10, "loss": 1}
+		]]}}}`,
+	)
+
+	rows, err := publicapi.NewClient(client).History(
+		context.Background(),
+		publicapi.RunPath{Entity: "e", Project: "p", RunID: "r"},
+		2,
+		publicapi.WithKeys("loss"),
+		publicapi.WithMinStep(0),
+	)
+
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	assert.EqualValues(t, 1, rows[1]["loss"])
+	specs := requestVariables(t, client.AllRequests()[0])["specs"]
+	assert.Equal(t,
+		[]any{`{"keys":["_step","loss"],"minStep":0,"samples":2}`},
+		specs)
+}
+
+func TestScanHistory_PagesUntilLastStep(t *testing.T) {
+	client := gqlmock.NewMockClient()
+	client.StubMatchOnce(
+		gqlmock.WithOpName("RunLastStep"),
+		`{"project": {"run": {"historyKeys": {"lastStep": 2}}}}`,
+	)
+	client.StubMatchOnce(
+		gqlmock.WithOpName("RunHistory"),
+		`{"project": {"run": {"history": [
+			"{\"_step\": 0}", "{\"_step\": 1}"
+		]}}}`,
+	)
+	client.StubMatchOnce(
+		gqlmock.WithOpName("RunHistory"),
+		`{"project": {"run": {"history": ["{\"_step\": 2}"]}}}`,
+	)
+	scanner := publicapi.NewClient(client).ScanHistory(
+		publicapi.RunPath{Entity: "e", Project: "p", RunID: "r"},
+		publicapi.WithHistoryPageSize(2),
+	)
+
+	var steps []any
+	for {
+		row, err := scanner.Next(context.Background())
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		steps = append(steps, row["_step"])
+	}
+
+	assert.EqualValues(t, []any{0.0, 1.0, 2.0}, steps)
+	assert.True(t, client.AllStubsUsed())
+	variables := requestVariables(t, client.AllRequests()[2])
+	assert.EqualValues(t, 2, variables["minStep"])
+	assert.EqualValues(t, 3, variables["maxStep"])
+}
+
+func TestScanHistory_NoHistory(t *testing.T) {
+	client := gqlmock.NewMockClient()
+	client.StubAnyOnce(`{"project": {"run": {"historyKeys": {}}}}`)
+	scanner := publicapi.NewClient(client).ScanHistory(publicapi.RunPath{})
+
+	_, err := scanner.Next(context.Background())
+
+	assert.ErrorIs(t, err, io.EOF)
+}
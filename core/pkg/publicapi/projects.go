@@ -0,0 +1,56 @@
+package publicapi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/wandb/wandb/core/internal/gql"
+)
+
+// Project is a project on the server.
+type Project struct {
+	ID          string
+	Entity      string
+	Name        string
+	Description string
+	CreatedAt   time.Time
+}
+
+// Projects returns an entity's projects.
+func (c *Client) Projects(ctx context.Context, entity string) ([]*Project, error) {
+	var projects []*Project
+	var cursor *string
+	perPage := DefaultPageSize
+
+	for {
+		data, err := gql.Projects(ctx, c.graphql, entity, cursor, perPage)
+		if err != nil {
+			return nil, fmt.Errorf("publicapi: Projects failed: %v", err)
+		}
+		if data.Models == nil {
+			return nil, fmt.Errorf("publicapi: entity %q not found", entity)
+		}
+
+		for _, edge := range data.Models.Edges {
+			if edge.Node == nil {
+				continue
+			}
+			project := &Project{
+				ID:        edge.Node.Id,
+				Entity:    edge.Node.EntityName,
+				Name:      edge.Node.Name,
+				CreatedAt: parseTime(edge.Node.CreatedAt),
+			}
+			if edge.Node.Description != nil {
+				project.Description = *edge.Node.Description
+			}
+			projects = append(projects, project)
+		}
+
+		if !data.Models.PageInfo.HasNextPage {
+			return projects, nil
+		}
+		cursor = data.Models.PageInfo.EndCursor
+	}
+}
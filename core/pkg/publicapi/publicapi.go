@@ -0,0 +1,185 @@
+// Package publicapi reads projects, runs and run history from a W&B
+// server, like wandb.Api() in Python.
+//
+// It is a supported API for Go programs that analyze logged runs, and
+// doesn't require importing wandb-core's internal packages:
+//
+//	client, err := publicapi.NewFromEnvironment()
+//	if err != nil { ... }
+//
+//	runs := client.Runs("my-team", "my-project",
+//		publicapi.WithFilter(publicapi.And(
+//			publicapi.ConfigKey("optimizer").Eq("adam"),
+//			publicapi.SummaryKey("accuracy").Gte(0.9),
+//		)),
+//		publicapi.WithOrder("-summary_metrics.accuracy"))
+//	for {
+//		run, err := runs.Next(ctx)
+//		if errors.Is(err, io.EOF) {
+//			break
+//		}
+//		if err != nil { ... }
+//		rows, err := client.History(ctx, run.Path(), 500,
+//			publicapi.WithKeys("loss"))
+//		...
+//	}
+//
+// The client never modifies runs. Apart from reading, it can only create
+// reports; see Report.
+package publicapi
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/wandb/wandb/core/internal/api"
+	"github.com/wandb/wandb/core/internal/clients"
+	"github.com/wandb/wandb/core/internal/settings"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// DefaultBaseURL is the W&B server used if WANDB_BASE_URL is not set.
+const DefaultBaseURL = "https://api.wandb.ai"
+
+// Client makes requests to the W&B public API.
+type Client struct {
+	graphql graphql.Client
+
+	// files makes requests to download files.
+	files httpDoer
+
+	// appURL is the URL of the W&B UI, or empty if it isn't known.
+	appURL string
+}
+
+// httpDoer sends HTTP requests, like an http.Client.
+type httpDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// NewClient returns a Client that makes requests with a GraphQL client.
+//
+// The GraphQL client is responsible for authentication. Files are
+// downloaded with http.DefaultClient.
+func NewClient(client graphql.Client) *Client {
+	return &Client{graphql: client, files: http.DefaultClient}
+}
+
+// New returns a Client for a W&B server, such as DefaultBaseURL.
+//
+// Requests are rate-limited and retried in the same way as when logging
+// a run. If apiKey is empty, it's read from the user's .netrc file.
+func New(baseURL, apiKey string) (*Client, error) {
+	return NewFromSettings(&service.Settings{
+		BaseUrl: wrapperspb.String(baseURL),
+		ApiKey:  wrapperspb.String(apiKey),
+	})
+}
+
+// NewFromEnvironment returns a Client that uses the local credentials of
+// the user.
+//
+// The server is read from WANDB_BASE_URL and the API key from
+// WANDB_API_KEY or, if that is not set, from the user's .netrc file,
+// the same way as when logging a run.
+func NewFromEnvironment() (*Client, error) {
+	baseURL := os.Getenv("WANDB_BASE_URL")
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+
+	return NewFromSettings(&service.Settings{
+		BaseUrl: wrapperspb.String(baseURL),
+		ApiKey:  wrapperspb.String(os.Getenv("WANDB_API_KEY")),
+	})
+}
+
+// NewFromSettings returns a Client for the server in a run's settings.
+//
+// Requests are made the same way as a run's: with its credentials,
+// proxies, certificates, extra headers and retry limits. If the settings
+// have no API key, it's read from the user's .netrc file.
+func NewFromSettings(runSettings *service.Settings) (*Client, error) {
+	s := settings.From(proto.Clone(runSettings).(*service.Settings))
+	if s.IsOffline() {
+		return nil, errors.New("publicapi: the settings are offline")
+	}
+
+	baseURL, err := url.Parse(s.GetBaseURL())
+	if err != nil {
+		return nil, fmt.Errorf("publicapi: failed to parse base URL: %v", err)
+	}
+	if err := s.EnsureAPIKey(); err != nil {
+		return nil, fmt.Errorf("publicapi: no credentials: %v", err)
+	}
+
+	logger := observability.NewNoOpLogger()
+	backend := server.NewBackend(logger, s)
+
+	tlsConfig, err := api.NewTLSConfig(
+		s.GetCACertFile(),
+		s.GetClientCertFile(),
+		s.GetClientKeyFile(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("publicapi: invalid TLS settings: %v", err)
+	}
+
+	client := NewClient(server.NewGraphQLClient(backend, logger, s, nil))
+	client.files = backend.NewClient(api.ClientOptions{
+		RetryPolicy:  clients.CheckRetry,
+		RetryMax:     api.DefaultRetryMax,
+		RetryWaitMin: api.DefaultRetryWaitMin,
+		RetryWaitMax: api.DefaultRetryWaitMax,
+		Proxy:        server.ProxyFn(s.GetHTTPProxy(), s.GetHTTPSProxy(), s.GetNoProxy()),
+		TLSConfig:    tlsConfig,
+	})
+	client.appURL = strings.TrimSuffix(
+		strings.Replace(baseURL.String(), "//api.", "//", 1), "/")
+	return client, nil
+}
+
+// GraphQL returns the client's GraphQL client, for requests that Client
+// has no method for.
+func (c *Client) GraphQL() graphql.Client {
+	return c.graphql
+}
+
+// RunPath identifies a run on the server.
+type RunPath struct {
+	Entity  string
+	Project string
+	RunID   string
+}
+
+func (p RunPath) String() string {
+	return p.Entity + "/" + p.Project + "/" + p.RunID
+}
+
+// ParseRunPath parses a path of the form entity/project/run_id.
+//
+// The path in a run's URL, entity/project/runs/run_id, and URIs of the
+// form wandb://entity/project/run_id are also accepted.
+func ParseRunPath(path string) (RunPath, error) {
+	rest := strings.TrimPrefix(path, "wandb://")
+	parts := strings.Split(strings.TrimSuffix(rest, "/"), "/")
+	if len(parts) == 4 && parts[2] == "runs" {
+		parts = []string{parts[0], parts[1], parts[3]}
+	}
+
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return RunPath{}, fmt.Errorf(
+			"publicapi: %q is not of the form entity/project/run_id", path)
+	}
+
+	return RunPath{Entity: parts[0], Project: parts[1], RunID: parts[2]}, nil
+}
@@ -0,0 +1,44 @@
+package publicapi_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/pkg/publicapi"
+)
+
+// requestVariables returns a request's variables as they're sent.
+func requestVariables(t *testing.T, req *graphql.Request) map[string]any {
+	t.Helper()
+	data, err := json.Marshal(req.Variables)
+	require.NoError(t, err)
+	variables := make(map[string]any)
+	require.NoError(t, json.Unmarshal(data, &variables))
+	return variables
+}
+
+func TestParseRunPath(t *testing.T) {
+	want := publicapi.RunPath{Entity: "my-team", Project: "my-project", RunID: "abc123"}
+	for _, path := range []string{
+		"my-team/my-project/abc123",
+		"my-team/my-project/runs/abc123",
+		"wandb://my-team/my-project/abc123",
+	} {
+		got, err := publicapi.ParseRunPath(path)
+		require.NoError(t, err, path)
+		assert.Equal(t, want, got, path)
+	}
+	assert.Equal(t, "my-team/my-project/abc123", want.String())
+
+	for _, path := range []string{
+		"my-project/abc123",
+		"my-team//abc123",
+		"a/b/c/d",
+	} {
+		_, err := publicapi.ParseRunPath(path)
+		assert.Error(t, err, path)
+	}
+}
@@ -0,0 +1,356 @@
+package publicapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/wandb/segmentio-encoding/json"
+	"github.com/wandb/wandb/core/internal/gql"
+	"github.com/wandb/wandb/core/pkg/utils"
+)
+
+// Report is a W&B Report to create.
+//
+// A report is a document of text and panel grids, where each grid plots
+// metrics of a set of runs:
+//
+//	report := &publicapi.Report{
+//		Entity:  "my-team",
+//		Project: "my-project",
+//		Title:   "Nightly training summary",
+//		Blocks: []publicapi.Block{
+//			publicapi.Heading{Text: "Loss"},
+//			publicapi.PanelGrid{
+//				RunSets: []publicapi.RunSet{{RunIDs: runIDs}},
+//				Panels: []publicapi.Panel{
+//					publicapi.LinePlot{Metrics: []string{"loss"}},
+//				},
+//			},
+//		},
+//	}
+type Report struct {
+	// Entity and Project are where the report is saved.
+	Entity  string
+	Project string
+
+	Title       string
+	Description string
+
+	// Blocks are the contents of the report, in order.
+	Blocks []Block
+
+	// Draft is whether to save the report as a draft that only its author
+	// can see, instead of publishing it.
+	Draft bool
+}
+
+// Block is part of a report: a Heading, Paragraph or PanelGrid.
+type Block interface {
+	spec(report *Report) map[string]any
+}
+
+// Heading is a heading in a report.
+type Heading struct {
+	Text string
+
+	// Level is the heading's level from 1 to 3, or 0 for 1.
+	Level int
+}
+
+func (h Heading) spec(*Report) map[string]any {
+	return map[string]any{
+		"type":     "heading",
+		"level":    min(max(h.Level, 1), 3),
+		"children": textChildren(h.Text),
+	}
+}
+
+// Paragraph is a paragraph of text in a report.
+type Paragraph struct {
+	Text string
+}
+
+func (p Paragraph) spec(*Report) map[string]any {
+	return map[string]any{
+		"type":     "paragraph",
+		"children": textChildren(p.Text),
+	}
+}
+
+func textChildren(text string) []map[string]any {
+	return []map[string]any{{"text": text}}
+}
+
+// PanelGrid is a grid of panels that plot the runs of its run sets.
+type PanelGrid struct {
+	RunSets []RunSet
+	Panels  []Panel
+}
+
+// RunSet is a set of runs that a panel grid plots.
+type RunSet struct {
+	// Name labels the run set, or is empty for "Run set".
+	Name string
+
+	// Entity and Project are where the runs are, or empty for the
+	// report's entity and project.
+	Entity  string
+	Project string
+
+	// RunIDs are the IDs of the runs, or nil for all of the project's runs.
+	RunIDs []string
+}
+
+func (g PanelGrid) spec(report *Report) map[string]any {
+	runSets := make([]map[string]any, 0, len(g.RunSets))
+	for _, runSet := range g.RunSets {
+		runSets = append(runSets, runSet.spec(report))
+	}
+
+	panels := make([]map[string]any, 0, len(g.Panels))
+	for i, panel := range g.Panels {
+		viewType, config := panel.spec()
+		panels = append(panels, map[string]any{
+			"__id__":   utils.ShortID(9),
+			"viewType": viewType,
+			"config":   config,
+			"layout":   panelLayout(i),
+		})
+	}
+
+	return map[string]any{
+		"type":     "panel-grid",
+		"children": textChildren(""),
+		"metadata": map[string]any{
+			"openViz":    true,
+			"openRunSet": 0,
+			"name":       "unused-name",
+			"runSets":    runSets,
+			"panelBankSectionConfig": map[string]any{
+				"name":   "Report Panels",
+				"isOpen": false,
+				"type":   "grid",
+				"sorted": 0,
+				"panels": panels,
+			},
+			"customRunColors": map[string]any{},
+		},
+	}
+}
+
+// Panels are laid out in rows of panelsPerRow, each panelWidth by
+// panelHeight units of the grid's 24 columns.
+const (
+	panelsPerRow = 3
+	panelWidth   = 8
+	panelHeight  = 6
+)
+
+func panelLayout(i int) map[string]any {
+	return map[string]any{
+		"x": (i % panelsPerRow) * panelWidth,
+		"y": (i / panelsPerRow) * panelHeight,
+		"w": panelWidth,
+		"h": panelHeight,
+	}
+}
+
+func (s RunSet) spec(report *Report) map[string]any {
+	name := s.Name
+	if name == "" {
+		name = "Run set"
+	}
+	entity := s.Entity
+	if entity == "" {
+		entity = report.Entity
+	}
+	project := s.Project
+	if project == "" {
+		project = report.Project
+	}
+
+	filters := []map[string]any{}
+	if s.RunIDs != nil {
+		filters = append(filters, map[string]any{
+			"key":      map[string]any{"section": "run", "name": "name"},
+			"op":       "IN",
+			"value":    s.RunIDs,
+			"disabled": false,
+		})
+	}
+
+	return map[string]any{
+		"id":      utils.ShortID(9),
+		"name":    name,
+		"enabled": true,
+		"project": map[string]any{"entityName": entity, "name": project},
+		"search":  map[string]any{"query": ""},
+		"filters": map[string]any{
+			"op": "OR",
+			"filters": []map[string]any{
+				{"op": "AND", "filters": filters},
+			},
+		},
+		"grouping": []any{},
+		"sort": map[string]any{
+			"keys": []map[string]any{{
+				"key":       map[string]any{"section": "run", "name": "createdAt"},
+				"ascending": false,
+			}},
+		},
+		"selections": map[string]any{
+			"root":   1,
+			"bounds": []any{},
+			"tree":   []any{},
+		},
+		"expandedRowAddresses": []any{},
+	}
+}
+
+// Panel is a chart in a panel grid: a LinePlot, BarPlot or ScalarChart.
+type Panel interface {
+	spec() (viewType string, config map[string]any)
+}
+
+// LinePlot plots metrics over the runs' history.
+type LinePlot struct {
+	Title   string
+	Metrics []string
+
+	// XAxis is the metric to plot against, or empty for "_step".
+	XAxis string
+
+	// Smoothing is the exponential moving average factor from 0 to 1.
+	Smoothing float64
+}
+
+func (p LinePlot) spec() (string, map[string]any) {
+	xAxis := p.XAxis
+	if xAxis == "" {
+		xAxis = "_step"
+	}
+	config := map[string]any{
+		"metrics": p.Metrics,
+		"xAxis":   xAxis,
+	}
+	if p.Title != "" {
+		config["chartTitle"] = p.Title
+	}
+	if p.Smoothing > 0 {
+		config["smoothingType"] = "exponential"
+		config["smoothingWeight"] = p.Smoothing
+	}
+	return "Run History Line Plot", config
+}
+
+// BarPlot compares the runs' summary values of metrics.
+type BarPlot struct {
+	Title   string
+	Metrics []string
+}
+
+func (p BarPlot) spec() (string, map[string]any) {
+	config := map[string]any{"metrics": p.Metrics}
+	if p.Title != "" {
+		config["chartTitle"] = p.Title
+	}
+	return "Bar Chart", config
+}
+
+// ScalarChart shows one number: a metric's summary value aggregated over
+// the runs.
+type ScalarChart struct {
+	Title  string
+	Metric string
+}
+
+func (p ScalarChart) spec() (string, map[string]any) {
+	config := map[string]any{"metrics": []string{p.Metric}}
+	if p.Title != "" {
+		config["chartTitle"] = p.Title
+	}
+	return "Scalar Chart", config
+}
+
+// reportSpecVersion is the version of the report format that is written.
+const reportSpecVersion = 5
+
+// spec returns the report's contents in the format the UI reads.
+func (r *Report) spec() map[string]any {
+	blocks := make([]map[string]any, 0, len(r.Blocks))
+	for _, block := range r.Blocks {
+		blocks = append(blocks, block.spec(r))
+	}
+
+	return map[string]any{
+		"version":           reportSpecVersion,
+		"panelSettings":     map[string]any{},
+		"blocks":            blocks,
+		"width":             "readable",
+		"authors":           []any{},
+		"discussionThreads": []any{},
+		"ref":               map[string]any{},
+	}
+}
+
+// CreatedReport is a report that was saved on the server.
+type CreatedReport struct {
+	ID string
+
+	// URL is where to view the report, or empty if the Client wasn't
+	// created with the server's URL.
+	URL string
+}
+
+// CreateReport saves a new report.
+func (c *Client) CreateReport(ctx context.Context, report *Report) (*CreatedReport, error) {
+	if report.Entity == "" || report.Project == "" {
+		return nil, errors.New("publicapi: a report needs an entity and a project")
+	}
+	if report.Title == "" {
+		return nil, errors.New("publicapi: a report needs a title")
+	}
+
+	spec, err := json.Marshal(report.spec())
+	if err != nil {
+		return nil, fmt.Errorf("publicapi: invalid report: %v", err)
+	}
+
+	viewType := "runs"
+	if report.Draft {
+		viewType = "runs/draft"
+	}
+	data, err := gql.UpsertView(ctx, c.graphql,
+		report.Entity,
+		report.Project,
+		utils.ShortID(12),
+		report.Title,
+		&report.Description,
+		viewType,
+		string(spec),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("publicapi: UpsertView failed: %v", err)
+	}
+	if data.UpsertView == nil || data.UpsertView.View == nil {
+		return nil, errors.New("publicapi: server returned no report")
+	}
+
+	created := &CreatedReport{ID: data.UpsertView.View.Id}
+	if c.appURL != "" {
+		created.URL = fmt.Sprintf("%s/%s/%s/reports/%s--%s",
+			c.appURL, report.Entity, report.Project,
+			reportSlug(report.Title), created.ID)
+	}
+	return created, nil
+}
+
+var nonSlugChars = regexp.MustCompile(`[^a-zA-Z0-9]+`)
+
+// reportSlug returns the form of a report's title in its URL.
+func reportSlug(title string) string {
+	return strings.Trim(nonSlugChars.ReplaceAllString(title, "-"), "-")
+}
@@ -0,0 +1,127 @@
+package publicapi_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/segmentio-encoding/json"
+	"github.com/wandb/wandb/core/internal/gqlmock"
+	"github.com/wandb/wandb/core/pkg/publicapi"
+)
+
+func nightlyReport() *publicapi.Report {
+	return &publicapi.Report{
+		Entity:  "my-team",
+		Project: "my-project",
+		Title:   "Nightly summary: 2024/03/05",
+		Blocks: []publicapi.Block{
+			publicapi.Heading{Text: "Loss"},
+			publicapi.PanelGrid{
+				RunSets: []publicapi.RunSet{{RunIDs: []string{"run1", "run2"}}},
+				Panels: []publicapi.Panel{
+					publicapi.LinePlot{Metrics: []string{"loss"}},
+					publicapi.BarPlot{Metrics: []string{"accuracy"}},
+					publicapi.ScalarChart{Metric: "accuracy"},
+					publicapi.LinePlot{Metrics: []string{"lr"}, XAxis: "epoch"},
+				},
+			},
+		},
+		Draft: true,
+	}
+}
+
+func TestCreateReport_Spec(t *testing.T) {
+	client := gqlmock.NewMockClient()
+	client.StubMatchOnce(
+		gqlmock.WithOpName("UpsertView"),
+		`{"upsertView": {"view": {"id": "VmlldzoxMjM="}}}`,
+	)
+
+	report, err := publicapi.NewClient(client).CreateReport(
+		context.Background(), nightlyReport())
+
+	require.NoError(t, err)
+	assert.Equal(t, "VmlldzoxMjM=", report.ID)
+	assert.Empty(t, report.URL)
+
+	variables := requestVariables(t, client.AllRequests()[0])
+	assert.Equal(t, "runs/draft", variables["viewType"])
+	assert.Equal(t, "Nightly summary: 2024/03/05", variables["displayName"])
+
+	var spec struct {
+		Blocks []struct {
+			Type     string `json:"type"`
+			Metadata struct {
+				RunSets []struct {
+					Project map[string]string `json:"project"`
+					Filters struct {
+						Filters []struct {
+							Filters []struct {
+								Value []string `json:"value"`
+							} `json:"filters"`
+						} `json:"filters"`
+					} `json:"filters"`
+				} `json:"runSets"`
+				PanelBankSectionConfig struct {
+					Panels []struct {
+						ViewType string         `json:"viewType"`
+						Config   map[string]any `json:"config"`
+						Layout   map[string]int `json:"layout"`
+					} `json:"panels"`
+				} `json:"panelBankSectionConfig"`
+			} `json:"metadata"`
+		} `json:"blocks"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(variables["spec"].(string)), &spec))
+	require.Len(t, spec.Blocks, 2)
+	assert.Equal(t, "heading", spec.Blocks[0].Type)
+	grid := spec.Blocks[1].Metadata
+	assert.Equal(t,
+		map[string]string{"entityName": "my-team", "name": "my-project"},
+		grid.RunSets[0].Project)
+	assert.Equal(t,
+		[]string{"run1", "run2"},
+		grid.RunSets[0].Filters.Filters[0].Filters[0].Value)
+	panels := grid.PanelBankSectionConfig.Panels
+	require.Len(t, panels, 4)
+	assert.Equal(t, "Run History Line Plot", panels[0].ViewType)
+	assert.Equal(t, "Scalar Chart", panels[2].ViewType)
+	assert.Equal(t, "epoch", panels[3].Config["xAxis"])
+	assert.Equal(t, map[string]int{"x": 0, "y": 6, "w": 8, "h": 6}, panels[3].Layout)
+}
+
+func TestCreateReport_URL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			_, apiKey, _ := r.BasicAuth()
+			assert.Equal(t, "test-key", apiKey)
+			_, _ = io.Copy(io.Discard, r.Body)
+			_, _ = w.Write([]byte(
+				`{"data": {"upsertView": {"view": {"id": "VmlldzoxMjM="}}}}`))
+		}))
+	defer server.Close()
+	client, err := publicapi.New(server.URL, "test-key")
+	require.NoError(t, err)
+
+	report, err := client.CreateReport(context.Background(), nightlyReport())
+
+	require.NoError(t, err)
+	assert.Equal(t,
+		server.URL+"/my-team/my-project/reports/Nightly-summary-2024-03-05--VmlldzoxMjM=",
+		report.URL)
+}
+
+func TestCreateReport_NeedsTitle(t *testing.T) {
+	report := nightlyReport()
+	report.Title = ""
+
+	_, err := publicapi.NewClient(gqlmock.NewMockClient()).CreateReport(
+		context.Background(), report)
+
+	assert.ErrorContains(t, err, "needs a title")
+}
@@ -0,0 +1,273 @@
+package publicapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/wandb/segmentio-encoding/json"
+	"github.com/wandb/wandb/core/internal/gql"
+)
+
+// DefaultPageSize is the default number of items to request at a time.
+const DefaultPageSize = 50
+
+// Run is a run on the server.
+type Run struct {
+	// Entity and Project are where the run is.
+	Entity  string
+	Project string
+
+	// ID is the run's ID, as in its URL.
+	ID string
+
+	// DisplayName is the run's name in the UI.
+	DisplayName string
+
+	// State is the run's state, e.g. "running" or "finished".
+	State string
+
+	// Config is the run's config.
+	Config map[string]any
+
+	// Summary is the run's summary.
+	Summary map[string]any
+
+	Tags    []string
+	Group   string
+	JobType string
+	User    string
+
+	CreatedAt   time.Time
+	HeartbeatAt time.Time
+}
+
+// Path returns the path of the run, for requesting its history.
+func (r *Run) Path() RunPath {
+	return RunPath{Entity: r.Entity, Project: r.Project, RunID: r.ID}
+}
+
+// newRun converts a run in a GraphQL response.
+func newRun(n *gql.RunFields, entity, project string) (*Run, error) {
+	run := &Run{
+		Entity:      entity,
+		Project:     project,
+		ID:          n.Name,
+		DisplayName: stringValue(n.DisplayName),
+		State:       stringValue(n.State),
+		Config:      make(map[string]any),
+		Summary:     make(map[string]any),
+		Tags:        n.Tags,
+		Group:       stringValue(n.Group),
+		JobType:     stringValue(n.JobType),
+		CreatedAt:   parseTime(n.CreatedAt),
+		HeartbeatAt: parseTime(n.HeartbeatAt),
+	}
+	if n.User != nil {
+		run.User = stringValue(n.User.Username)
+	}
+
+	if n.Config != nil && *n.Config != "" {
+		var config map[string]any
+		if err := json.Unmarshal([]byte(*n.Config), &config); err != nil {
+			return nil, fmt.Errorf("publicapi: invalid config for run %s: %v", n.Name, err)
+		}
+		for key, item := range config {
+			// Values are stored as {"value": ..., "desc": ...}.
+			if item, ok := item.(map[string]any); ok {
+				run.Config[key] = item["value"]
+			}
+		}
+	}
+
+	if n.SummaryMetrics != nil && *n.SummaryMetrics != "" {
+		if err := json.Unmarshal([]byte(*n.SummaryMetrics), &run.Summary); err != nil {
+			return nil, fmt.Errorf("publicapi: invalid summary for run %s: %v", n.Name, err)
+		}
+	}
+
+	return run, nil
+}
+
+// parseTime parses a timestamp from the server, which may not have a
+// time zone, in which case it's in UTC.
+func parseTime(value string) time.Time {
+	if t, err := time.Parse(time.RFC3339Nano, value); err == nil {
+		return t
+	}
+	if t, err := time.Parse("2006-01-02T15:04:05.999999999", value); err == nil {
+		return t
+	}
+	return time.Time{}
+}
+
+// stringValue returns the string a field points to, or "" if it's null.
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// ErrRunNotFound is returned if the server has no such run.
+var ErrRunNotFound = errors.New("publicapi: run not found")
+
+// Run returns a run.
+func (c *Client) Run(ctx context.Context, path RunPath) (*Run, error) {
+	data, err := gql.Run(ctx, c.graphql, path.Entity, path.Project, path.RunID)
+	if err != nil {
+		return nil, fmt.Errorf("publicapi: Run failed: %v", err)
+	}
+	if data.Project == nil || data.Project.Run == nil {
+		return nil, ErrRunNotFound
+	}
+
+	return newRun(&data.Project.Run.RunFields, path.Entity, path.Project)
+}
+
+// RunsOption configures which runs a RunIterator returns.
+type RunsOption func(*RunIterator)
+
+// WithFilter returns only the runs that match the filter.
+func WithFilter(filter Filter) RunsOption {
+	return func(it *RunIterator) {
+		it.filter = filter
+	}
+}
+
+// WithOrder sorts runs by a property, such as "+created_at" or
+// "-summary_metrics.accuracy".
+//
+// Runs are sorted from newest to oldest by default.
+func WithOrder(order string) RunsOption {
+	return func(it *RunIterator) {
+		it.order = order
+	}
+}
+
+// WithRunsPageSize sets the number of runs to request at a time.
+func WithRunsPageSize(size int) RunsOption {
+	return func(it *RunIterator) {
+		if size > 0 {
+			it.pageSize = size
+		}
+	}
+}
+
+// RunIterator returns a project's runs, requesting them a page at a time.
+type RunIterator struct {
+	client   *Client
+	entity   string
+	project  string
+	filter   Filter
+	order    string
+	pageSize int
+
+	// page is the rest of the last page of runs.
+	page []*Run
+
+	// cursor is where the next page starts.
+	cursor string
+
+	// done is true once the last page has been fetched.
+	done bool
+}
+
+// Runs returns an iterator over a project's runs.
+func (c *Client) Runs(entity, project string, opts ...RunsOption) *RunIterator {
+	it := &RunIterator{
+		client:   c,
+		entity:   entity,
+		project:  project,
+		order:    "-created_at",
+		pageSize: DefaultPageSize,
+	}
+	for _, opt := range opts {
+		opt(it)
+	}
+	return it
+}
+
+// Next returns the next run.
+//
+// It returns io.EOF after the last run.
+func (it *RunIterator) Next(ctx context.Context) (*Run, error) {
+	for len(it.page) == 0 {
+		if it.done {
+			return nil, io.EOF
+		}
+		if err := it.fetchPage(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	run := it.page[0]
+	it.page = it.page[1:]
+	return run, nil
+}
+
+// All returns the remaining runs.
+func (it *RunIterator) All(ctx context.Context) ([]*Run, error) {
+	var runs []*Run
+	for {
+		run, err := it.Next(ctx)
+		if errors.Is(err, io.EOF) {
+			return runs, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		runs = append(runs, run)
+	}
+}
+
+func (it *RunIterator) fetchPage(ctx context.Context) error {
+	var filters *string
+	if it.filter != nil {
+		filtersJSON, err := json.Marshal(it.filter)
+		if err != nil {
+			return fmt.Errorf("publicapi: invalid filter: %v", err)
+		}
+		filtersString := string(filtersJSON)
+		filters = &filtersString
+	}
+	var cursor *string
+	if it.cursor != "" {
+		after := it.cursor
+		cursor = &after
+	}
+	order := it.order
+
+	data, err := gql.Runs(ctx, it.client.graphql,
+		it.entity,
+		it.project,
+		filters,
+		&order,
+		cursor,
+		it.pageSize,
+	)
+	if err != nil {
+		return fmt.Errorf("publicapi: Runs failed: %v", err)
+	}
+	if data.Project == nil || data.Project.Runs == nil {
+		return fmt.Errorf(
+			"publicapi: project %s/%s not found", it.entity, it.project)
+	}
+
+	for _, edge := range data.Project.Runs.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		run, err := newRun(&edge.Node.RunFields, it.entity, it.project)
+		if err != nil {
+			return err
+		}
+		it.page = append(it.page, run)
+	}
+
+	it.cursor = stringValue(data.Project.Runs.PageInfo.EndCursor)
+	it.done = !data.Project.Runs.PageInfo.HasNextPage
+	return nil
+}
@@ -0,0 +1,94 @@
+package publicapi_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/gqlmock"
+	"github.com/wandb/wandb/core/pkg/publicapi"
+)
+
+func TestRuns_Pages(t *testing.T) {
+	client := gqlmock.NewMockClient()
+	client.StubMatchOnce(
+		gqlmock.WithOpName("Runs"),
+		`{"project": {"runs": {
+			"pageInfo": {"hasNextPage": true, "endCursor": "c1"},
+			"edges": [{"node": {
+				"name": "run1",
+				"state": "finished",
+				"config": "{\"lr\": {\"value\": 0.1, \"desc\": null}}",
+				"summaryMetrics": "{\"accuracy\": 0.95}",
+				"user": {"username": "me"},
+				"createdAt": "2024-03-05T12:34:56"
+			}}]
+		}}}`,
+	)
+	client.StubMatchOnce(
+		gqlmock.WithOpName("Runs"),
+		`{"project": {"runs": {
+			"pageInfo": {"hasNextPage": false},
+			"edges": [{"node": {"name": "run2"}}]
+		}}}`,
+	)
+	api := publicapi.NewClient(client)
+
+	runs, err := api.Runs("e", "p",
+		publicapi.WithFilter(publicapi.SummaryKey("accuracy").Gt(0.9)),
+		publicapi.WithRunsPageSize(1),
+	).All(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, runs, 2)
+	assert.Equal(t, "run1", runs[0].ID)
+	assert.Equal(t, 0.1, runs[0].Config["lr"])
+	assert.Equal(t, 0.95, runs[0].Summary["accuracy"])
+	assert.Equal(t, "me", runs[0].User)
+	assert.Equal(t,
+		time.Date(2024, 3, 5, 12, 34, 56, 0, time.UTC),
+		runs[0].CreatedAt)
+	assert.Equal(t,
+		publicapi.RunPath{Entity: "e", Project: "p", RunID: "run2"},
+		runs[1].Path())
+
+	requests := client.AllRequests()
+	require.Len(t, requests, 2)
+	variables := requestVariables(t, requests[1])
+	assert.Equal(t, `{"summary_metrics.accuracy":{"$gt":0.9}}`, variables["filters"])
+	assert.Equal(t, "c1", variables["cursor"])
+	assert.EqualValues(t, 1, variables["perPage"])
+}
+
+func TestRun_NotFound(t *testing.T) {
+	client := gqlmock.NewMockClient()
+	client.StubAnyOnce(`{"project": {"run": null}}`)
+
+	_, err := publicapi.NewClient(client).Run(
+		context.Background(), publicapi.RunPath{})
+
+	assert.ErrorIs(t, err, publicapi.ErrRunNotFound)
+}
+
+func TestProjects(t *testing.T) {
+	client := gqlmock.NewMockClient()
+	client.StubMatchOnce(
+		gqlmock.WithOpName("Projects"),
+		`{"models": {
+			"pageInfo": {"hasNextPage": false},
+			"edges": [
+				{"node": {"name": "p1", "entityName": "e"}},
+				{"node": {"name": "p2", "entityName": "e"}}
+			]
+		}}`,
+	)
+
+	projects, err := publicapi.NewClient(client).Projects(context.Background(), "e")
+
+	require.NoError(t, err)
+	require.Len(t, projects, 2)
+	assert.Equal(t, "p2", projects[1].Name)
+	assert.Equal(t, "e", projects[1].Entity)
+}
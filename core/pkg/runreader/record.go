@@ -0,0 +1,262 @@
+package runreader
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/wandb/wandb/core/pkg/service"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ErrNoValue is returned when decoding a key that has no value.
+var ErrNoValue = errors.New("runreader: no value")
+
+// Record is a record of a run's data.
+//
+// Exactly one of its accessors for the kinds of data returns a non-nil
+// value, matching Kind.
+type Record struct {
+	proto *service.Record
+	kind  Kind
+
+	history *History
+	summary *Summary
+	config  *Config
+	console *Console
+	stats   *Stats
+}
+
+// History is a step of the run's logged metrics.
+type History struct {
+	Step int64
+
+	// Values are the metrics logged at the step.
+	//
+	// These include internal values such as "_timestamp" and "_runtime".
+	Values Values
+}
+
+// Timestamp returns when the step was logged, if known.
+func (h *History) Timestamp() (time.Time, bool) {
+	seconds, ok := h.Values.Float("_timestamp")
+	if !ok {
+		return time.Time{}, false
+	}
+	return time.Unix(0, int64(seconds*1e9)), true
+}
+
+// Summary is a change to the run's summary.
+type Summary struct {
+	// Update are the values that were set.
+	Update Values
+
+	// Remove are the keys that were removed.
+	Remove []string
+}
+
+// Config is a change to the run's config.
+//
+// The run's initial config is also returned as a Config.
+type Config struct {
+	// Update are the values that were set.
+	Update Values
+
+	// Remove are the keys that were removed.
+	Remove []string
+}
+
+// Console is a line of the run's console output.
+type Console struct {
+	Line string
+
+	// Stderr is whether the line was written to stderr.
+	Stderr bool
+
+	// Source is the process that wrote the line, or empty for the user
+	// process.
+	Source string
+
+	Timestamp time.Time
+}
+
+// Stats is a sample of system metrics, such as "cpu" or "gpu.0.memory".
+type Stats struct {
+	Timestamp time.Time
+	Values    Values
+}
+
+// Values are JSON-encoded values by key.
+//
+// Nested keys are joined by ".".
+type Values map[string]string
+
+// Keys returns the keys in sorted order.
+func (v Values) Keys() []string {
+	keys := make([]string, 0, len(v))
+	for key := range v {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// JSON returns the JSON encoding of a value.
+func (v Values) JSON(key string) (string, bool) {
+	value, ok := v[key]
+	return value, ok
+}
+
+// Float returns a value if it is a number.
+func (v Values) Float(key string) (float64, bool) {
+	var value float64
+	if v.Decode(key, &value) != nil {
+		return 0, false
+	}
+	return value, true
+}
+
+// String returns a value if it is a string.
+func (v Values) String(key string) (string, bool) {
+	var value string
+	if v.Decode(key, &value) != nil {
+		return "", false
+	}
+	return value, true
+}
+
+// Decode unmarshals a value into dst as with json.Unmarshal.
+//
+// It returns ErrNoValue if there is no value for the key.
+func (v Values) Decode(key string, dst any) error {
+	value, ok := v[key]
+	if !ok {
+		return ErrNoValue
+	}
+	return json.Unmarshal([]byte(value), dst)
+}
+
+// Kind returns the kind of data in the record.
+func (r *Record) Kind() Kind { return r.kind }
+
+// History returns the record's history step, or nil if it has none.
+func (r *Record) History() *History { return r.history }
+
+// Summary returns the record's summary change, or nil if it has none.
+func (r *Record) Summary() *Summary { return r.summary }
+
+// Config returns the record's config change, or nil if it has none.
+func (r *Record) Config() *Config { return r.config }
+
+// Console returns the record's console line, or nil if it has none.
+func (r *Record) Console() *Console { return r.console }
+
+// Stats returns the record's system metrics, or nil if it has none.
+func (r *Record) Stats() *Stats { return r.stats }
+
+// Proto returns the underlying protobuf record.
+func (r *Record) Proto() *service.Record { return r.proto }
+
+// newRecord converts a protobuf record, or returns nil if it isn't of a
+// supported kind.
+func newRecord(proto *service.Record) *Record {
+	record := &Record{proto: proto}
+
+	switch x := proto.RecordType.(type) {
+	case *service.Record_History:
+		record.kind = KindHistory
+		record.history = &History{
+			Step:   x.History.GetStep().GetNum(),
+			Values: make(Values),
+		}
+		for _, item := range x.History.GetItem() {
+			key := joinKey(item.GetKey(), item.GetNestedKey())
+			record.history.Values[key] = item.GetValueJson()
+		}
+
+	case *service.Record_Summary:
+		record.kind = KindSummary
+		record.summary = &Summary{Update: make(Values)}
+		for _, item := range x.Summary.GetUpdate() {
+			key := joinKey(item.GetKey(), item.GetNestedKey())
+			record.summary.Update[key] = item.GetValueJson()
+		}
+		for _, item := range x.Summary.GetRemove() {
+			record.summary.Remove = append(record.summary.Remove,
+				joinKey(item.GetKey(), item.GetNestedKey()))
+		}
+
+	case *service.Record_Config:
+		record.kind = KindConfig
+		record.config = newConfig(x.Config)
+
+	case *service.Record_Run:
+		if x.Run.GetConfig() == nil {
+			return nil
+		}
+		record.kind = KindConfig
+		record.config = newConfig(x.Run.GetConfig())
+
+	case *service.Record_OutputRaw:
+		record.kind = KindConsole
+		record.console = &Console{
+			Line:      x.OutputRaw.GetLine(),
+			Stderr:    x.OutputRaw.GetOutputType() == service.OutputRawRecord_STDERR,
+			Source:    x.OutputRaw.GetSource(),
+			Timestamp: toTime(x.OutputRaw.GetTimestamp()),
+		}
+
+	case *service.Record_Output:
+		record.kind = KindConsole
+		record.console = &Console{
+			Line:      x.Output.GetLine(),
+			Stderr:    x.Output.GetOutputType() == service.OutputRecord_STDERR,
+			Timestamp: toTime(x.Output.GetTimestamp()),
+		}
+
+	case *service.Record_Stats:
+		record.kind = KindStats
+		record.stats = &Stats{
+			Timestamp: toTime(x.Stats.GetTimestamp()),
+			Values:    make(Values),
+		}
+		for _, item := range x.Stats.GetItem() {
+			record.stats.Values[item.GetKey()] = item.GetValueJson()
+		}
+
+	default:
+		return nil
+	}
+
+	return record
+}
+
+func newConfig(proto *service.ConfigRecord) *Config {
+	config := &Config{Update: make(Values)}
+	for _, item := range proto.GetUpdate() {
+		key := joinKey(item.GetKey(), item.GetNestedKey())
+		config.Update[key] = item.GetValueJson()
+	}
+	for _, item := range proto.GetRemove() {
+		config.Remove = append(config.Remove,
+			joinKey(item.GetKey(), item.GetNestedKey()))
+	}
+	return config
+}
+
+// joinKey returns the key of an item, joining nested keys by ".".
+func joinKey(key string, nestedKey []string) string {
+	if len(nestedKey) > 0 {
+		return strings.Join(nestedKey, ".")
+	}
+	return key
+}
+
+func toTime(timestamp *timestamppb.Timestamp) time.Time {
+	if timestamp == nil {
+		return time.Time{}
+	}
+	return timestamp.AsTime()
+}
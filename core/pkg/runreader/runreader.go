@@ -0,0 +1,208 @@
+// Package runreader reads the history, summary, config, console output
+// and system metrics of a run from its .wandb file.
+//
+// It is a supported API for tools that analyze local runs, and doesn't
+// require importing wandb-core's internal packages:
+//
+//	reader, err := runreader.Open("run-abc123.wandb",
+//		runreader.WithKinds(runreader.KindHistory),
+//		runreader.WithMinStep(100))
+//	if err != nil { ... }
+//	defer reader.Close()
+//
+//	for {
+//		record, err := reader.Next()
+//		if errors.Is(err, io.EOF) {
+//			break
+//		}
+//		if err != nil { ... }
+//		loss, ok := record.History().Values.Float("loss")
+//		...
+//	}
+package runreader
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"os"
+
+	"github.com/wandb/wandb/core/pkg/server"
+)
+
+// Kind is the kind of data in a record.
+type Kind int
+
+const (
+	// KindHistory is a step of the run's logged metrics.
+	KindHistory Kind = iota
+
+	// KindSummary is a change to the run's summary.
+	KindSummary
+
+	// KindConfig is a change to the run's config.
+	KindConfig
+
+	// KindConsole is a line of the run's console output.
+	KindConsole
+
+	// KindStats is a sample of system metrics.
+	KindStats
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindHistory:
+		return "history"
+	case KindSummary:
+		return "summary"
+	case KindConfig:
+		return "config"
+	case KindConsole:
+		return "console"
+	case KindStats:
+		return "stats"
+	default:
+		return "unknown"
+	}
+}
+
+// Reader reads the records in a .wandb file.
+type Reader struct {
+	store *server.Store
+
+	// kinds is the set of kinds to return, or nil for all.
+	kinds map[Kind]bool
+
+	minStep int64
+	maxStep int64
+}
+
+// Option configures a Reader.
+type Option func(*Reader)
+
+// WithKinds makes the Reader return only records of the given kinds.
+func WithKinds(kinds ...Kind) Option {
+	return func(r *Reader) {
+		r.kinds = make(map[Kind]bool)
+		for _, kind := range kinds {
+			r.kinds[kind] = true
+		}
+	}
+}
+
+// WithMinStep skips history records before the step.
+//
+// Records of other kinds are not affected.
+func WithMinStep(step int64) Option {
+	return func(r *Reader) {
+		r.minStep = step
+	}
+}
+
+// WithMaxStep skips history records after the step.
+//
+// Records of other kinds are not affected.
+func WithMaxStep(step int64) Option {
+	return func(r *Reader) {
+		r.maxStep = step
+	}
+}
+
+// Open opens a .wandb file for reading.
+//
+// If only history records are requested with a minimum step and the
+// file has a step index, reading starts at that step instead of at the
+// beginning of the file.
+func Open(path string, opts ...Option) (*Reader, error) {
+	r := &Reader{
+		store:   server.NewStore(context.Background(), path),
+		minStep: math.MinInt64,
+		maxStep: math.MaxInt64,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	if err := r.store.Open(os.O_RDONLY); err != nil {
+		return nil, err
+	}
+
+	if r.onlyHistory() && r.minStep > 0 {
+		err := r.store.SeekStep(r.minStep)
+		if err != nil &&
+			!errors.Is(err, io.EOF) &&
+			!errors.Is(err, server.ErrNoIndex) {
+			_ = r.store.Close()
+			return nil, err
+		}
+	}
+
+	return r, nil
+}
+
+// Close closes the file.
+func (r *Reader) Close() error {
+	return r.store.Close()
+}
+
+// Next returns the next record of a requested kind.
+//
+// It returns io.EOF at the end of the file. Records of other kinds, such
+// as telemetry or file uploads, are skipped.
+func (r *Reader) Next() (*Record, error) {
+	for {
+		proto, err := r.store.Read()
+		if err != nil {
+			return nil, err
+		}
+
+		record := newRecord(proto)
+		if record == nil || !r.wants(record) {
+			continue
+		}
+		return record, nil
+	}
+}
+
+// ReadAll passes each record of a requested kind in a .wandb file to
+// onRecord, in order.
+func ReadAll(
+	path string,
+	onRecord func(*Record),
+	opts ...Option,
+) error {
+	reader, err := Open(path, opts...)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	for {
+		record, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		onRecord(record)
+	}
+}
+
+func (r *Reader) onlyHistory() bool {
+	return len(r.kinds) == 1 && r.kinds[KindHistory]
+}
+
+func (r *Reader) wants(record *Record) bool {
+	if r.kinds != nil && !r.kinds[record.Kind()] {
+		return false
+	}
+
+	if history := record.History(); history != nil {
+		return history.Step >= r.minStep && history.Step <= r.maxStep
+	}
+
+	return true
+}
@@ -0,0 +1,171 @@
+package runreader_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/pkg/runreader"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+func writeRun(t *testing.T, indexed bool, records ...*service.Record) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "run.wandb")
+	store := server.NewStore(context.Background(), path)
+	if indexed {
+		store.EnableIndex()
+	}
+	require.NoError(t, store.Open(os.O_WRONLY))
+	for _, record := range records {
+		require.NoError(t, store.Write(record))
+	}
+	require.NoError(t, store.Close())
+
+	return path
+}
+
+func historyRecord(step int64, items ...*service.HistoryItem) *service.Record {
+	return &service.Record{
+		RecordType: &service.Record_History{
+			History: &service.HistoryRecord{
+				Step: &service.HistoryStep{Num: step},
+				Item: items,
+			},
+		},
+	}
+}
+
+func TestReadsKinds(t *testing.T) {
+	path := writeRun(t, false,
+		&service.Record{RecordType: &service.Record_Run{
+			Run: &service.RunRecord{
+				Config: &service.ConfigRecord{
+					Update: []*service.ConfigItem{{Key: "lr", ValueJson: "0.1"}},
+				},
+			},
+		}},
+		historyRecord(0,
+			&service.HistoryItem{Key: "loss", ValueJson: "0.5"},
+			&service.HistoryItem{
+				NestedKey: []string{"eval", "acc"},
+				ValueJson: "0.9",
+			}),
+		&service.Record{RecordType: &service.Record_Telemetry{
+			Telemetry: &service.TelemetryRecord{},
+		}},
+		&service.Record{RecordType: &service.Record_OutputRaw{
+			OutputRaw: &service.OutputRawRecord{
+				OutputType: service.OutputRawRecord_STDERR,
+				Line:       "warning\n",
+			},
+		}},
+		&service.Record{RecordType: &service.Record_Stats{
+			Stats: &service.StatsRecord{
+				Item: []*service.StatsItem{{Key: "cpu", ValueJson: "12.5"}},
+			},
+		}},
+		&service.Record{RecordType: &service.Record_Summary{
+			Summary: &service.SummaryRecord{
+				Update: []*service.SummaryItem{{Key: "best", ValueJson: `"ok"`}},
+				Remove: []*service.SummaryItem{{Key: "old"}},
+			},
+		}},
+	)
+
+	var records []*runreader.Record
+	err := runreader.ReadAll(path, func(record *runreader.Record) {
+		records = append(records, record)
+	})
+
+	require.NoError(t, err)
+	require.Len(t, records, 5)
+
+	lr, _ := records[0].Config().Update.Float("lr")
+	assert.Equal(t, 0.1, lr)
+
+	history := records[1].History()
+	assert.Equal(t, []string{"eval.acc", "loss"}, history.Values.Keys())
+	acc, _ := history.Values.Float("eval.acc")
+	assert.Equal(t, 0.9, acc)
+
+	assert.Equal(t, runreader.KindConsole, records[2].Kind())
+	assert.True(t, records[2].Console().Stderr)
+	assert.Equal(t, "warning\n", records[2].Console().Line)
+
+	cpu, _ := records[3].Stats().Values.Float("cpu")
+	assert.Equal(t, 12.5, cpu)
+
+	best, _ := records[4].Summary().Update.String("best")
+	assert.Equal(t, "ok", best)
+	assert.Equal(t, []string{"old"}, records[4].Summary().Remove)
+}
+
+func TestValues(t *testing.T) {
+	values := runreader.Values{"loss": "0.5", "name": `"x"`}
+
+	_, ok := values.Float("name")
+	assert.False(t, ok)
+	_, ok = values.Float("missing")
+	assert.False(t, ok)
+	assert.ErrorIs(t, values.Decode("missing", new(any)), runreader.ErrNoValue)
+}
+
+func TestStepRange(t *testing.T) {
+	for _, indexed := range []bool{false, true} {
+		var records []*service.Record
+		for step := int64(0); step < 10; step++ {
+			records = append(records, historyRecord(step,
+				&service.HistoryItem{Key: "loss", ValueJson: "1"}))
+		}
+		path := writeRun(t, indexed, records...)
+
+		reader, err := runreader.Open(path,
+			runreader.WithKinds(runreader.KindHistory),
+			runreader.WithMinStep(4),
+			runreader.WithMaxStep(6))
+		require.NoError(t, err)
+
+		var steps []int64
+		for {
+			record, err := reader.Next()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			require.NoError(t, err)
+			steps = append(steps, record.History().Step)
+		}
+		require.NoError(t, reader.Close())
+
+		assert.Equal(t, []int64{4, 5, 6}, steps, "indexed: %v", indexed)
+	}
+}
+
+func TestStepRangeKeepsOtherKinds(t *testing.T) {
+	path := writeRun(t, true,
+		historyRecord(0),
+		&service.Record{RecordType: &service.Record_Config{
+			Config: &service.ConfigRecord{},
+		}},
+		historyRecord(1),
+	)
+
+	var kinds []runreader.Kind
+	err := runreader.ReadAll(path,
+		func(record *runreader.Record) {
+			kinds = append(kinds, record.Kind())
+		},
+		runreader.WithMinStep(1))
+
+	require.NoError(t, err)
+	assert.Equal(t,
+		[]runreader.Kind{runreader.KindConfig, runreader.KindHistory},
+		kinds)
+}
@@ -3,6 +3,7 @@ package server
 import (
 	"bufio"
 	"context"
+	"crypto/subtle"
 	"encoding/binary"
 	"errors"
 	"fmt"
@@ -47,35 +48,76 @@ type Connection struct {
 	// outChan is the channel for outgoing messages
 	outChan chan *service.ServerResponse
 
-	// stream is the stream for the connection, each connection has a single stream
-	// however, a stream can have multiple connections
+	// stream is the stream most recently started or attached to on the
+	// connection, which handles records that don't name a stream
 	stream *Stream
 
+	// streams are the streams started or attached to on the connection by
+	// ID; a client may host many runs over one connection, and a stream
+	// can have multiple connections
+	streams map[string]*Stream
+
+	// forwarders pass records to each of the connection's streams, so
+	// that a stream that is over its budget doesn't hold up the others
+	forwarders map[*Stream]*recordForwarder
+
 	// closed indicates if the outChan is closed
 	closed *atomic.Bool
 
+	// authToken is the token the client must present before making any
+	// other requests, or empty if the client doesn't need to authenticate
+	authToken string
+
+	// authenticated indicates if the client has presented the auth token
+	authenticated *atomic.Bool
+
+	// rejected indicates if the client failed to authenticate
+	rejected *atomic.Bool
+
 	// sentryClient is the client used to report errors to sentry.io
 	sentryClient *sentry_ext.Client
 }
 
+// connectionCount is the number of connections accepted so far.
+var connectionCount atomic.Int64
+
+// connectionID returns a unique ID for a new connection.
+//
+// TCP connections are identified by the client's address. All clients of
+// a named pipe have the same address, so they're numbered instead.
+func connectionID(conn net.Conn) string {
+	n := connectionCount.Add(1)
+	if _, ok := conn.RemoteAddr().(*net.TCPAddr); ok {
+		return conn.RemoteAddr().String()
+	}
+	return fmt.Sprintf("%s#%d", conn.RemoteAddr(), n)
+}
+
 // NewConnection creates a new connection
 func NewConnection(
 	ctx context.Context,
 	cancel context.CancelFunc,
 	conn net.Conn,
+	authToken string,
 	sentryClient *sentry_ext.Client,
 ) *Connection {
 
 	nc := &Connection{
-		ctx:          ctx,
-		cancel:       cancel,
-		conn:         conn,
-		id:           conn.RemoteAddr().String(), // TODO: check if this is properly unique
-		inChan:       make(chan *service.ServerRequest, BufferSize),
-		outChan:      make(chan *service.ServerResponse, BufferSize),
-		closed:       &atomic.Bool{},
-		sentryClient: sentryClient,
+		ctx:           ctx,
+		cancel:        cancel,
+		conn:          conn,
+		id:            connectionID(conn),
+		inChan:        make(chan *service.ServerRequest, BufferSize),
+		outChan:       make(chan *service.ServerResponse, BufferSize),
+		streams:       make(map[string]*Stream),
+		forwarders:    make(map[*Stream]*recordForwarder),
+		closed:        &atomic.Bool{},
+		authToken:     authToken,
+		authenticated: &atomic.Bool{},
+		rejected:      &atomic.Bool{},
+		sentryClient:  sentryClient,
 	}
+	nc.authenticated.Store(authToken == "")
 	return nc
 }
 
@@ -154,7 +196,15 @@ func (nc *Connection) readConnection() {
 		}
 	}
 	if scanner.Err() != nil && !errors.Is(scanner.Err(), net.ErrClosed) {
-		panic(scanner.Err())
+		// Don't let unauthenticated clients crash the server.
+		if !nc.authenticated.Load() {
+			slog.Error(
+				"connection: invalid data from unauthenticated client",
+				"err", scanner.Err(),
+				"id", nc.id)
+		} else {
+			panic(scanner.Err())
+		}
 	}
 	close(nc.inChan)
 }
@@ -164,28 +214,32 @@ func (nc *Connection) readConnection() {
 // the client is responsible for reading and parsing the messages
 func (nc *Connection) handleServerResponse() {
 	slog.Debug("starting handleServerResponse", "id", nc.id)
+	// The header and message are marshaled into one buffer that is reused
+	// for each message, so that responses don't allocate.
+	var buf []byte
 	for msg := range nc.outChan {
-		out, err := proto.Marshal(msg)
+		var err error
+		buf = append(buf[:0], 'W', 0, 0, 0, 0)
+		buf, err = proto.MarshalOptions{}.MarshalAppend(buf, msg)
 		if err != nil {
 			slog.Error("error marshalling msg", "err", err, "id", nc.id)
 			return
 		}
+		binary.LittleEndian.PutUint32(
+			buf[1:wbHeaderLength],
+			uint32(len(buf)-wbHeaderLength),
+		)
 
-		writer := bufio.NewWriter(nc.conn)
-		header := Header{Magic: byte('W'), DataLength: uint32(len(out))}
-		if err = binary.Write(writer, binary.LittleEndian, &header); err != nil {
-			slog.Error("error writing header", "err", err, "id", nc.id)
-			return
-		}
-		if _, err = writer.Write(out); err != nil {
+		if _, err = nc.conn.Write(buf); err != nil {
 			slog.Error("error writing msg", "err", err, "id", nc.id)
 			return
 		}
+	}
 
-		if err = writer.Flush(); err != nil {
-			slog.Error("error flushing writer", "err", err, "id", nc.id)
-			return
-		}
+	// Disconnect clients that failed to authenticate once they've been
+	// told why.
+	if nc.rejected.Load() {
+		nc.Close()
 	}
 	slog.Debug("finished handleServerResponse", "id", nc.id)
 }
@@ -195,6 +249,11 @@ func (nc *Connection) handleServerResponse() {
 func (nc *Connection) handleServerRequest() {
 	slog.Debug("starting handleServerRequest", "id", nc.id)
 	for msg := range nc.inChan {
+		if !nc.authenticated.Load() {
+			nc.authenticated.Store(nc.handleAuthenticate(msg))
+			continue
+		}
+
 		slog.Debug("handling server request", "msg", msg, "id", nc.id)
 		switch x := msg.ServerRequestType.(type) {
 		case *service.ServerRequest_InformInit:
@@ -211,6 +270,8 @@ func (nc *Connection) handleServerRequest() {
 			nc.handleInformFinish(x.InformFinish)
 		case *service.ServerRequest_InformTeardown:
 			nc.handleInformTeardown(x.InformTeardown)
+		case *service.ServerRequest_Authenticate:
+			slog.Debug("already authenticated", "id", nc.id)
 		case nil:
 			slog.Error("ServerRequestType is nil", "id", nc.id)
 			panic("ServerRequestType is nil")
@@ -219,18 +280,74 @@ func (nc *Connection) handleServerRequest() {
 			panic(fmt.Sprintf("ServerRequestType is unknown, %T", x))
 		}
 	}
+	nc.stopForwarders()
 	if !nc.closed.Swap(true) {
 		close(nc.outChan)
 	}
 	slog.Debug("finished handleServerRequest", "id", nc.id)
 }
 
+// handleAuthenticate checks the first message on a connection that must
+// be authenticated, and returns whether the client presented the right
+// token.
+//
+// If not, the client is sent an error and disconnected, and all its
+// requests are ignored.
+func (nc *Connection) handleAuthenticate(msg *service.ServerRequest) bool {
+	if nc.rejected.Load() {
+		return false
+	}
+
+	token := msg.GetAuthenticate().GetToken()
+	if subtle.ConstantTimeCompare([]byte(token), []byte(nc.authToken)) == 1 {
+		slog.Debug("connection authenticated", "id", nc.id)
+		return true
+	}
+
+	var message string
+	if msg.GetAuthenticate() == nil {
+		message = "connection: client did not authenticate"
+	} else {
+		message = "connection: invalid auth token"
+	}
+	slog.Error(message, "id", nc.id)
+
+	nc.rejected.Store(true)
+	nc.Respond(&service.ServerResponse{
+		ServerResponseType: &service.ServerResponse_AuthenticateResponse{
+			AuthenticateResponse: &service.ServerAuthenticateResponse{
+				ErrorMessage: message,
+			},
+		},
+	})
+
+	// Stop reading so that the response is written and the connection
+	// closed once the pending requests are discarded.
+	if conn, ok := nc.conn.(interface{ CloseRead() error }); ok {
+		_ = conn.CloseRead()
+	} else {
+		nc.Close()
+	}
+	return false
+}
+
 // handleInformInit is called when the client sends an InformInit message
 // to the server, to start a new stream
 func (nc *Connection) handleInformInit(msg *service.ServerInformInitRequest) {
 	settings := settings.From(msg.GetSettings())
+	settings.DeriveRunID()
 
 	err := settings.EnsureAPIKey()
+	if err != nil && settings.IsDeferAuth() {
+		// Log the run locally; it's uploaded later by `wandb-core flush`.
+		slog.Warn(
+			"connection: no API key, logging run offline",
+			"err", err,
+			"id", nc.id,
+		)
+		settings.SetOffline()
+		err = nil
+	}
 	if err != nil {
 		slog.Error(
 			"connection: couldn't get API key",
@@ -246,6 +363,7 @@ func (nc *Connection) handleInformInit(msg *service.ServerInformInitRequest) {
 	nc.stream = NewStream(settings, streamId, nc.sentryClient)
 	nc.stream.AddResponders(ResponderEntry{nc, nc.id})
 	nc.stream.Start()
+	nc.streams[streamId] = nc.stream
 	slog.Info("connection init completed", "streamId", streamId, "id", nc.id)
 
 	if err := streamMux.AddStream(streamId, nc.stream); err != nil {
@@ -259,17 +377,24 @@ func (nc *Connection) handleInformInit(msg *service.ServerInformInitRequest) {
 // TODO: probably can remove this, we should be able to update the settings
 // using the regular InformRecord messages
 func (nc *Connection) handleInformStart(msg *service.ServerInformStartRequest) {
+	stream := nc.streamFor(msg.GetXInfo().GetStreamId())
+	if stream == nil {
+		slog.Error("handleInformStart: stream not found", "id", nc.id)
+		return
+	}
+
 	// todo: if we keep this and end up updating the settings here
 	//       we should update the stream logger to use the new settings as well
-	nc.stream.settings = settings.From(msg.GetSettings())
+	stream.settings = settings.From(msg.GetSettings())
 
 	// update sentry tags
 	// add attrs from settings:
-	nc.stream.logger.SetTags(observability.Tags{
-		"run_url": nc.stream.settings.GetRunURL(),
+	stream.logger.SetTags(observability.Tags{
+		"run_url": stream.settings.GetRunURL(),
 	})
 	// TODO: remove this once we have a better observability setup
-	nc.stream.logger.CaptureInfo("wandb-core", nil)
+	nc.sentryClient.CaptureUsage(
+		sentry_ext.CategoryUsage, "wandb-core", stream.logger.GetTags())
 }
 
 // handleInformAttach is called when the client sends an InformAttach message
@@ -284,6 +409,7 @@ func (nc *Connection) handleInformAttach(msg *service.ServerInformAttachRequest)
 	if err != nil {
 		slog.Error("handleInformAttach: stream not found", "streamId", streamId, "id", nc.id)
 	} else {
+		nc.streams[streamId] = nc.stream
 		nc.stream.AddResponders(ResponderEntry{nc, nc.id})
 		// TODO: we should redo this attach logic, so that the stream handles
 		//       the attach logic
@@ -306,7 +432,8 @@ func (nc *Connection) handleInformAttach(msg *service.ServerInformAttachRequest)
 func (nc *Connection) handleInformRecord(msg *service.Record) {
 	streamId := msg.GetXInfo().GetStreamId()
 	slog.Debug("handle record received", "streamId", streamId, "id", nc.id)
-	if nc.stream == nil {
+	stream := nc.streamFor(streamId)
+	if stream == nil {
 		slog.Error("handleInformRecord: stream not found", "streamId", streamId, "id", nc.id)
 	} else {
 		// add connection id to control message
@@ -317,7 +444,47 @@ func (nc *Connection) handleInformRecord(msg *service.Record) {
 		} else {
 			msg.Control = &service.Control{ConnectionId: nc.id}
 		}
-		nc.stream.HandleRecord(msg)
+		nc.forwarderFor(stream).Forward(msg)
+	}
+}
+
+// streamFor returns the connection's stream with the given ID, or nil.
+//
+// Records that don't name a stream go to the most recent stream, for
+// clients that host a single run per connection. Records naming a stream
+// that wasn't started or attached to on this connection, or that already
+// finished, are not routed anywhere: sending them to another stream
+// would write them into the wrong run.
+func (nc *Connection) streamFor(streamId string) *Stream {
+	if streamId == "" {
+		return nc.stream
+	}
+	return nc.streams[streamId]
+}
+
+// forwarderFor returns the forwarder for a stream, starting it if needed.
+func (nc *Connection) forwarderFor(stream *Stream) *recordForwarder {
+	forwarder, ok := nc.forwarders[stream]
+	if !ok {
+		forwarder = newRecordForwarder(stream)
+		nc.forwarders[stream] = forwarder
+	}
+	return forwarder
+}
+
+// stopForwarder passes on a stream's remaining records and stops its
+// forwarder.
+func (nc *Connection) stopForwarder(stream *Stream) {
+	if forwarder, ok := nc.forwarders[stream]; ok {
+		forwarder.Stop()
+		delete(nc.forwarders, stream)
+	}
+}
+
+// stopForwarders passes on all remaining records and stops all forwarders.
+func (nc *Connection) stopForwarders() {
+	for stream := range nc.forwarders {
+		nc.stopForwarder(stream)
 	}
 }
 
@@ -326,6 +493,14 @@ func (nc *Connection) handleInformRecord(msg *service.Record) {
 func (nc *Connection) handleInformFinish(msg *service.ServerInformFinishRequest) {
 	streamId := msg.XInfo.StreamId
 	slog.Info("handle finish received", "streamId", streamId, "id", nc.id)
+	if stream, ok := nc.streams[streamId]; ok {
+		// Records sent before the finish message still belong to the run.
+		nc.stopForwarder(stream)
+		delete(nc.streams, streamId)
+		if nc.stream == stream {
+			nc.stream = nil
+		}
+	}
 	if stream, err := streamMux.RemoveStream(streamId); err != nil {
 		slog.Error("handleInformFinish:", "err", err, "streamId", streamId, "id", nc.id)
 	} else {
@@ -338,8 +513,51 @@ func (nc *Connection) handleInformFinish(msg *service.ServerInformFinishRequest)
 // all streams
 func (nc *Connection) handleInformTeardown(teardown *service.ServerInformTeardownRequest) {
 	slog.Debug("handle teardown received", "id", nc.id)
+	nc.stopForwarders()
 	// cancel the context to signal the server to shutdown
 	// this will trigger all the connections to close
 	nc.cancel()
 	streamMux.FinishAndCloseAllStreams(teardown.ExitCode)
 }
+
+// recordForwarder passes a connection's records to one of its streams.
+//
+// Stream.HandleRecord blocks while the stream is over its budget. Many
+// runs can share a connection, so each stream gets its own goroutine and
+// queue: a throttled run only stops its own records, until its queue
+// fills up, while records for the other runs keep flowing.
+type recordForwarder struct {
+	stream  *Stream
+	records chan *service.Record
+	done    chan struct{}
+}
+
+func newRecordForwarder(stream *Stream) *recordForwarder {
+	forwarder := &recordForwarder{
+		stream:  stream,
+		records: make(chan *service.Record, BufferSize),
+		done:    make(chan struct{}),
+	}
+
+	go func() {
+		defer close(forwarder.done)
+		for record := range forwarder.records {
+			forwarder.stream.HandleRecord(record)
+		}
+	}()
+
+	return forwarder
+}
+
+// Forward queues a record for the stream.
+func (f *recordForwarder) Forward(record *service.Record) {
+	f.records <- record
+}
+
+// Stop waits for the queued records to be passed to the stream.
+//
+// Forward must not be called after Stop.
+func (f *recordForwarder) Stop() {
+	close(f.records)
+	<-f.done
+}
@@ -1,6 +1,8 @@
 package server
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
@@ -8,6 +10,7 @@ import (
 
 	git "github.com/go-git/go-git/v5"
 	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
 )
 
 func runCommand(command []string, dir, outFile string) error {
@@ -81,3 +84,82 @@ func (g *Git) SavePatch(ref, output string) error {
 	}
 	return nil
 }
+
+// Info returns the repository's checked out commit and branch, its
+// "origin" remote or else its first remote, and whether tracked files
+// have uncommitted changes.
+func (g *Git) Info() (*service.GitRepoRecord, error) {
+	repo, err := git.PlainOpen(g.path)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &service.GitRepoRecord{}
+
+	head, err := repo.Head()
+	if err != nil {
+		return nil, err
+	}
+	info.Commit = head.Hash().String()
+	if head.Name().IsBranch() {
+		info.Branch = head.Name().Short()
+	}
+
+	remotes, err := repo.Remotes()
+	if err == nil {
+		for _, remote := range remotes {
+			urls := remote.Config().URLs
+			if len(urls) == 0 {
+				continue
+			}
+			if info.RemoteUrl == "" || remote.Config().Name == "origin" {
+				info.RemoteUrl = urls[0]
+			}
+		}
+	}
+
+	// go-git's status is much slower than git's on large repositories.
+	status, err := runCommandWithOutput(
+		[]string{"git", "status", "--porcelain", "--untracked-files=no"},
+		g.path,
+	)
+	if err != nil {
+		g.logger.Error("git: failed to get status", "error", err)
+	} else {
+		info.Dirty = len(bytes.TrimSpace(status)) > 0
+	}
+
+	return info, nil
+}
+
+// UntrackedPatch returns a patch that adds the untracked files that
+// .gitignore doesn't exclude, or nil if there are none.
+func (g *Git) UntrackedPatch() ([]byte, error) {
+	cmd := exec.Command("git", "ls-files", "--others", "--exclude-standard", "-z")
+	cmd.Dir = g.path
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+
+	var patch bytes.Buffer
+	for _, file := range strings.Split(string(output), "\x00") {
+		if file == "" {
+			continue
+		}
+
+		cmd := exec.Command(
+			"git", "diff", "--no-index", "--binary", "--", os.DevNull, file)
+		cmd.Dir = g.path
+		cmd.Stdout = &patch
+
+		// With --no-index, the exit code is 1 if the files differ.
+		var exitErr *exec.ExitError
+		if err := cmd.Run(); err != nil &&
+			!(errors.As(err, &exitErr) && exitErr.ExitCode() == 1) {
+			return nil, fmt.Errorf("git diff %s: %v", file, err)
+		}
+	}
+
+	return patch.Bytes(), nil
+}
@@ -115,3 +115,54 @@ func TestSavePatch(t *testing.T) {
 	}
 	assert.Contains(t, string(patch), "+test content")
 }
+
+func TestInfo(t *testing.T) {
+	repoPath, cleanup, err := setupTestRepo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cleanup()
+
+	logger := observability.NewNoOpLogger()
+	git := server.NewGit(repoPath, logger)
+	info, err := git.Info()
+	assert.NoError(t, err)
+	assert.Len(t, info.Commit, 40)
+	assert.Equal(t, "master", info.Branch)
+	assert.False(t, info.Dirty)
+
+	err = os.WriteFile(filepath.Join(repoPath, "temp.txt"), []byte("changed"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	info, err = git.Info()
+	assert.NoError(t, err)
+	assert.True(t, info.Dirty)
+}
+
+func TestUntrackedPatch(t *testing.T) {
+	repoPath, cleanup, err := setupTestRepo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cleanup()
+
+	files := map[string]string{
+		".gitignore":  "ignored.txt\n",
+		"new.txt":     "new content\n",
+		"ignored.txt": "ignored content\n",
+	}
+	for name, content := range files {
+		err := os.WriteFile(filepath.Join(repoPath, name), []byte(content), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	logger := observability.NewNoOpLogger()
+	git := server.NewGit(repoPath, logger)
+	patch, err := git.UntrackedPatch()
+	assert.NoError(t, err)
+	assert.Contains(t, string(patch), "+new content")
+	assert.NotContains(t, string(patch), "ignored content")
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/wandb/segmentio-encoding/json"
 	"github.com/wandb/wandb/core/pkg/monitor"
@@ -14,17 +16,27 @@ import (
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/wandb/wandb/core/internal/envsnapshot"
 	"github.com/wandb/wandb/core/internal/filetransfer"
+	"github.com/wandb/wandb/core/internal/gql"
+	"github.com/wandb/wandb/core/internal/keylimits"
 	"github.com/wandb/wandb/core/internal/mailbox"
+	"github.com/wandb/wandb/core/internal/rundescriptor"
 	"github.com/wandb/wandb/core/internal/runfiles"
 	"github.com/wandb/wandb/core/internal/runhistory"
+	"github.com/wandb/wandb/core/internal/runmedia"
 	"github.com/wandb/wandb/core/internal/runmetric"
 	"github.com/wandb/wandb/core/internal/runsummary"
+	"github.com/wandb/wandb/core/internal/scrub"
+	"github.com/wandb/wandb/core/internal/settings"
 	"github.com/wandb/wandb/core/internal/tensorboard"
 	"github.com/wandb/wandb/core/internal/timer"
 	"github.com/wandb/wandb/core/internal/version"
+	"github.com/wandb/wandb/core/internal/watchdog"
+	"github.com/wandb/wandb/core/pkg/artifacts"
 	"github.com/wandb/wandb/core/pkg/observability"
 	"github.com/wandb/wandb/core/pkg/service"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -49,6 +61,15 @@ type HandlerParams struct {
 	TBHandler         *tensorboard.TBHandler
 	SystemMonitor     *monitor.SystemMonitor
 	TerminalPrinter   *observability.Printer
+	HistoryAggregator *runhistory.Aggregator
+	Tracer            *observability.Tracer
+
+	// MediaRetention limits the media files kept on disk, or is nil.
+	MediaRetention *runmedia.Retention
+
+	// LogLevel is the level of the stream's debug log, or nil if it
+	// can't be changed.
+	LogLevel *slog.LevelVar
 }
 
 // Handler is the handler for a stream it handles the incoming messages, processes them
@@ -85,6 +106,14 @@ type Handler struct {
 	// runHistory is the current active history entry being updated
 	runHistory *runhistory.RunHistory
 
+	// nodeStep is the step given by this node for the current history
+	// entry in shared mode, if any
+	nodeStep *service.HistoryStep
+
+	// lastNodeStep is the step of the last history entry this node
+	// flushed in shared mode, if any
+	lastNodeStep *service.HistoryStep
+
 	// runHistorySampler tracks samples of all metrics in the run's history.
 	//
 	// This is used to display the sparkline in the terminal at the end of
@@ -112,10 +141,44 @@ type Handler struct {
 	// fileTransferStats reports file upload/download statistics
 	fileTransferStats filetransfer.FileTransferStats
 
+	// historyAggregator rate-limits history rows before they are written,
+	// or is nil
+	historyAggregator *runhistory.Aggregator
+
+	// mediaRetention limits the media files kept on disk, or is nil
+	mediaRetention *runmedia.Retention
+
 	// terminalPrinter gathers terminal messages to send back to the user process
 	terminalPrinter *observability.Printer
 
 	mailbox *mailbox.Mailbox
+
+	// tracer records spans for handling records, or is nil
+	tracer *observability.Tracer
+
+	// span is the span of the record being handled
+	span trace.Span
+
+	// logLevel is the level of the stream's debug log, or nil
+	logLevel *slog.LevelVar
+
+	// consoleOff is whether console output records are dropped
+	consoleOff bool
+
+	// watchdog checks history against the user's rules, or is nil
+	watchdog *watchdog.Watchdog
+
+	// watchdogStop is whether a watchdog rule asked to stop the run
+	watchdogStop bool
+
+	// configLimiter and summaryLimiter drop config and summary items that
+	// are too large for the server
+	configLimiter  *keylimits.Limiter
+	summaryLimiter *keylimits.Limiter
+
+	// scrubber redacts secrets from config, console output and metadata,
+	// or is nil
+	scrubber *scrub.Scrubber
 }
 
 // NewHandler creates a new handler
@@ -125,6 +188,10 @@ func NewHandler(
 ) *Handler {
 	return &Handler{
 		ctx:                   ctx,
+		watchdog:              newWatchdog(params.Settings, params.Logger),
+		configLimiter:         newKeyLimiter(params.Settings),
+		summaryLimiter:        newKeyLimiter(params.Settings),
+		scrubber:              newScrubber(params.Logger),
 		runTimer:              timer.New(),
 		terminalPrinter:       params.TerminalPrinter,
 		logger:                params.Logger,
@@ -140,6 +207,10 @@ func NewHandler(
 		runfilesUploaderOrNil: params.RunfilesUploader,
 		tbHandler:             params.TBHandler,
 		systemMonitor:         params.SystemMonitor,
+		historyAggregator:     params.HistoryAggregator,
+		tracer:                params.Tracer,
+		logLevel:              params.LogLevel,
+		mediaRetention:        params.MediaRetention,
 	}
 }
 
@@ -175,6 +246,9 @@ func (h *Handler) fwdRecord(record *service.Record) {
 	if record == nil {
 		return
 	}
+	if h.span != nil {
+		h.tracer.Forward(record, h.span)
+	}
 	h.fwdChan <- record
 }
 
@@ -196,6 +270,12 @@ func (h *Handler) fwdRecordWithControl(record *service.Record, controlOptions ..
 
 //gocyclo:ignore
 func (h *Handler) handleRecord(record *service.Record) {
+	h.span = h.tracer.StartRecord(record, "handler")
+	defer func() {
+		h.span.End()
+		h.span = nil
+	}()
+
 	switch x := record.RecordType.(type) {
 	case *service.Record_Alert:
 		h.handleAlert(record)
@@ -215,6 +295,8 @@ func (h *Handler) handleRecord(record *service.Record) {
 		h.handleHeader(record)
 	case *service.Record_History:
 		h.handleHistory(x.History)
+	case *service.Record_HistoryBatch:
+		h.handleHistoryBatch(x.HistoryBatch)
 	case *service.Record_LinkArtifact:
 		h.handleLinkArtifact(record)
 	case *service.Record_Metric:
@@ -320,6 +402,8 @@ func (h *Handler) handleRequest(record *service.Record) {
 		h.handleRequestSenderRead(record)
 	case *service.Request_JobInput:
 		h.handleRequestJobInput(record)
+	case *service.Request_UpdateSettings:
+		h.handleRequestUpdateSettings(x.UpdateSettings)
 	case nil:
 		h.logger.CaptureFatalAndPanic(
 			errors.New("handler: handleRequest: request type is nil"))
@@ -381,6 +465,7 @@ func (h *Handler) handleStepMetric(key string) {
 			fmt.Errorf("error adding metric to map: %v", err))
 		return
 	}
+	h.metricsChanged()
 
 	stepRecord := &service.Record{
 		RecordType: &service.Record_Metric{
@@ -403,6 +488,7 @@ func (h *Handler) handleMetric(record *service.Record, metric *service.MetricRec
 				fmt.Errorf("error adding metric to map: %v", err))
 			return
 		}
+		h.metricsChanged()
 		h.fwdRecord(record)
 	case metric.GetName() != "":
 		if _, err := runmetric.AddMetric(metric, metric.GetName(), &h.metricHandler.DefinedMetrics); err != nil {
@@ -410,6 +496,7 @@ func (h *Handler) handleMetric(record *service.Record, metric *service.MetricRec
 				fmt.Errorf("error adding metric to map: %v", err))
 			return
 		}
+		h.metricsChanged()
 		h.handleStepMetric(metric.GetStepMetric())
 		h.fwdRecord(record)
 	case metric.GetStepMetric() != "":
@@ -421,6 +508,13 @@ func (h *Handler) handleMetric(record *service.Record, metric *service.MetricRec
 	}
 }
 
+// metricsChanged is called after the defined metrics change.
+func (h *Handler) metricsChanged() {
+	if h.runSummary != nil {
+		h.runSummary.MetricsChanged()
+	}
+}
+
 func (h *Handler) handleRequestDefer(record *service.Record, request *service.DeferRequest) {
 	switch request.State {
 	case service.DeferRequest_BEGIN:
@@ -445,6 +539,9 @@ func (h *Handler) handleRequestDefer(record *service.Record, request *service.De
 				},
 			},
 		)
+		if h.historyAggregator != nil {
+			h.fwdHistory(h.historyAggregator.Flush())
+		}
 	case service.DeferRequest_FLUSH_TB:
 	case service.DeferRequest_FLUSH_SUM:
 	case service.DeferRequest_FLUSH_DEBOUNCER:
@@ -479,6 +576,14 @@ func (h *Handler) handleRequestDefer(record *service.Record, request *service.De
 }
 
 func (h *Handler) handleRequestStopStatus(record *service.Record) {
+	if h.watchdogStop {
+		h.respond(record, &service.Response{
+			ResponseType: &service.Response_StopStatusResponse{
+				StopStatusResponse: &service.StopStatusResponse{RunShouldStop: true},
+			},
+		})
+		return
+	}
 	h.fwdRecord(record)
 }
 
@@ -587,12 +692,20 @@ func (h *Handler) handleRequestRunStart(record *service.Record, request *service
 	startTime := run.StartTime.AsTime()
 	h.runTimer.Start(&startTime)
 
+	if !h.settings.GetDisableGit().GetValue() {
+		h.captureGitState(run)
+	}
+
 	if h.runRecord, ok = proto.Clone(run).(*service.RunRecord); !ok {
 		h.logger.CaptureFatalAndPanic(
 			errors.New("handleRunStart: failed to clone run"))
 	}
 	h.fwdRecord(record)
 
+	if h.settings.GetXRunDescriptor().GetValue() {
+		h.writeRunDescriptor(run)
+	}
+
 	// start the system monitor
 	if !h.settings.GetXDisableStats().GetValue() {
 		h.systemMonitor.Do()
@@ -604,15 +717,22 @@ func (h *Handler) handleRequestRunStart(record *service.Record, request *service
 		h.handlePatchSave()
 	}
 
+	if tools := h.settings.GetXEnvSnapshot().GetValue(); len(tools) > 0 {
+		h.handleEnvSnapshot(run, tools)
+	}
+
+	// sync a TensorBoard log directory given in the settings, for programs
+	// that don't use the Python integration
+	if dir := h.settings.GetSyncTensorboardDir().GetValue(); dir != "" {
+		h.handleTBrecord(&service.TBRecord{LogDir: dir, RootDir: dir, Save: true})
+	}
+
 	// NOTE: once this request arrives in the sender,
 	// the latter will start its filestream and uploader
 	// initialize the run metadata from settings
 	var git *service.GitRepoRecord
 	if run.GetGit().GetRemoteUrl() != "" || run.GetGit().GetCommit() != "" {
-		git = &service.GitRepoRecord{
-			RemoteUrl: run.GetGit().GetRemoteUrl(),
-			Commit:    run.GetGit().GetCommit(),
-		}
+		git = proto.Clone(run.GetGit()).(*service.GitRepoRecord)
 	}
 
 	metadata := &service.MetadataRequest{
@@ -645,6 +765,48 @@ func (h *Handler) handleRequestRunStart(record *service.Record, request *service
 	h.respond(record, &service.Response{})
 }
 
+// writeRunDescriptor stores a portable descriptor of the run in the run
+// directory and prints it for the user to relay.
+//
+// It's called on run start, after the run upsert, so that the run has
+// its final entity and project and the descriptor has its URL.
+func (h *Handler) writeRunDescriptor(run *service.RunRecord) {
+	var runURL string
+	if !h.settings.GetXOffline().GetValue() {
+		runURL = rundescriptor.RunURL(h.settings.GetBaseUrl().GetValue(), run)
+	}
+
+	descriptor, err := rundescriptor.New(run, runURL)
+	if err != nil {
+		h.logger.CaptureError(fmt.Errorf("handler: %v", err))
+		return
+	}
+	data, err := descriptor.Encode()
+	if err != nil {
+		h.logger.CaptureError(fmt.Errorf("handler: %v", err))
+		return
+	}
+
+	path, err := descriptor.Write(
+		h.settings.GetSyncDir().GetValue(),
+		h.settings.GetXRunDescriptorQr().GetValue(),
+	)
+	if err != nil {
+		h.logger.CaptureError(fmt.Errorf("handler: %v", err))
+		if path == "" {
+			return
+		}
+	}
+
+	h.terminalPrinter.Writef(
+		"Run descriptor for %s (fingerprint %s) written to %s:\n%s",
+		descriptor.RunID,
+		descriptor.ShortFingerprint(),
+		path,
+		data,
+	)
+}
+
 func (h *Handler) handleRequestPythonPackages(_ *service.Record, request *service.PythonPackagesRequest) {
 	// write all requirements to a file
 	// send the file as a Files record
@@ -737,9 +899,13 @@ func (h *Handler) handlePatchSave() {
 
 	filesDirPath := h.settings.GetFilesDir().GetValue()
 	file := filepath.Join(filesDirPath, DiffFileName)
-	if err := git.SavePatch("HEAD", file); err != nil {
+	err := git.SavePatch("HEAD", file)
+	if h.settings.GetXGitPatchUntracked().GetValue() {
+		err = h.appendUntrackedPatch(git, file, err)
+	}
+	if err != nil {
 		h.logger.Error("error generating diff", "error", err)
-	} else {
+	} else if h.isPatchWithinLimit(file) {
 		files = append(files, &service.FilesItem{Path: DiffFileName, Type: service.FilesItem_WANDB})
 	}
 
@@ -750,7 +916,7 @@ func (h *Handler) handlePatchSave() {
 		file = filepath.Join(filesDirPath, diffFileName)
 		if err := git.SavePatch("@{u}", file); err != nil {
 			h.logger.Error("error generating diff", "error", err)
-		} else {
+		} else if h.isPatchWithinLimit(file) {
 			files = append(files, &service.FilesItem{Path: diffFileName, Type: service.FilesItem_WANDB})
 		}
 	}
@@ -769,6 +935,132 @@ func (h *Handler) handlePatchSave() {
 	h.handleFiles(record)
 }
 
+// handleEnvSnapshot saves the packages installed by package managers
+// along with the changes since the previous run in the project.
+func (h *Handler) handleEnvSnapshot(run *service.RunRecord, tools []string) {
+	dir := filepath.Join(h.settings.GetFilesDir().GetValue(), envsnapshot.DirName)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		h.logger.Error("handler: failed to create environment directory", "error", err)
+		return
+	}
+
+	snapshotter := &envsnapshot.Snapshotter{Python: h.settings.GetXExecutable().GetValue()}
+	history := envsnapshot.NewHistory(artifacts.UserCacheDir())
+
+	var files []*service.FilesItem
+	save := func(name string, data []byte) {
+		if err := os.WriteFile(filepath.Join(dir, name), data, 0644); err != nil {
+			h.logger.Error("handler: failed to save environment snapshot", "error", err)
+			return
+		}
+		files = append(files, &service.FilesItem{
+			Path: filepath.Join(envsnapshot.DirName, name),
+			Type: service.FilesItem_WANDB,
+		})
+	}
+
+	for _, name := range tools {
+		tool := envsnapshot.Tool(name)
+		data, err := snapshotter.Take(h.ctx, tool)
+		if errors.Is(err, envsnapshot.ErrUnavailable) {
+			h.logger.Debug("handler: skipping environment snapshot", "tool", tool)
+			continue
+		}
+		if err != nil {
+			h.logger.Warn("handler: failed to snapshot environment", "error", err)
+			continue
+		}
+
+		save(tool.FileName(), data)
+		if previous, ok := history.Previous(run.Entity, run.Project, tool); ok {
+			if diff := envsnapshot.Diff(previous, data); diff != nil {
+				save(tool.DiffFileName(), diff)
+			}
+		}
+		if err := history.Record(run.Entity, run.Project, tool, data); err != nil {
+			h.logger.Warn("handler: failed to record environment snapshot", "error", err)
+		}
+	}
+
+	if len(files) == 0 {
+		return
+	}
+	h.handleFiles(&service.Record{
+		RecordType: &service.Record_Files{
+			Files: &service.FilesRecord{Files: files},
+		},
+	})
+}
+
+// captureGitState fills in the run's git information that the client
+// didn't provide, such as for clients other than the Python SDK.
+func (h *Handler) captureGitState(run *service.RunRecord) {
+	info, err := NewGit(h.settings.GetRootDir().GetValue(), h.logger).Info()
+	if err != nil {
+		h.logger.Debug("handler: not capturing git state", "error", err)
+		return
+	}
+
+	if run.Git == nil {
+		run.Git = &service.GitRepoRecord{}
+	}
+	if run.Git.Commit == "" {
+		run.Git.Commit = info.Commit
+	}
+	if run.Git.RemoteUrl == "" {
+		run.Git.RemoteUrl = info.RemoteUrl
+	}
+	run.Git.Branch = info.Branch
+	run.Git.Dirty = info.Dirty
+}
+
+// appendUntrackedPatch adds untracked files to the patch of uncommitted
+// changes at the path.
+//
+// patchErr is the error from saving the patch of tracked files, which is
+// expected if only untracked files changed.
+func (h *Handler) appendUntrackedPatch(git *Git, path string, patchErr error) error {
+	untracked, err := git.UntrackedPatch()
+	if err != nil {
+		h.logger.Error("error generating diff of untracked files", "error", err)
+		return patchErr
+	}
+	if len(untracked) == 0 {
+		return patchErr
+	}
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = f.Write(untracked)
+	return err
+}
+
+// isPatchWithinLimit returns whether a patch file is small enough to
+// upload, deleting it otherwise.
+func (h *Handler) isPatchWithinLimit(path string) bool {
+	maxBytes := h.settings.GetXGitPatchMaxBytes().GetValue()
+	if maxBytes <= 0 {
+		return true
+	}
+
+	info, err := os.Stat(path)
+	if err != nil || info.Size() <= maxBytes {
+		return true
+	}
+
+	h.logger.Warn(
+		"handler: not uploading git patch larger than the limit",
+		"path", path,
+		"size", info.Size(),
+		"limit", maxBytes,
+	)
+	_ = os.Remove(path)
+	return false
+}
+
 func (h *Handler) handleMetadata(request *service.MetadataRequest) {
 	// TODO: Sending metadata as a request for now, eventually this should be turned into
 	//  a record and stored in the transaction log
@@ -776,6 +1068,10 @@ func (h *Handler) handleMetadata(request *service.MetadataRequest) {
 		return
 	}
 
+	if h.scrubber != nil {
+		h.scrubber.Message(scrub.SourceMetadata, request)
+	}
+
 	mo := protojson.MarshalOptions{
 		Indent: "  ",
 		// EmitUnpopulated: true,
@@ -843,18 +1139,65 @@ func (h *Handler) handleSystemMetrics(record *service.Record) {
 }
 
 func (h *Handler) handleOutput(record *service.Record) {
+	if h.consoleOff {
+		return
+	}
+	if h.scrubber != nil {
+		output := record.GetOutput()
+		output.Line = h.scrubber.Text(scrub.SourceConsole, output.GetLine())
+	}
 	h.fwdRecord(record)
 }
 
+// handleOutputRaw forwards a chunk of console output.
+//
+// Secrets are scrubbed chunk by chunk, so one split across two chunks
+// is not redacted.
 func (h *Handler) handleOutputRaw(record *service.Record) {
+	if h.consoleOff {
+		return
+	}
+	if h.scrubber != nil {
+		output := record.GetOutputRaw()
+		output.Line = h.scrubber.Text(scrub.SourceConsole, output.GetLine())
+	}
 	h.fwdRecord(record)
 }
 
+// handleRequestUpdateSettings applies settings that can change while the
+// run is in progress.
+func (h *Handler) handleRequestUpdateSettings(request *service.UpdateSettingsRequest) {
+	if seconds := request.GetStatsSampleRateSeconds(); seconds > 0 {
+		h.systemMonitor.SetSamplingInterval(
+			time.Duration(seconds * float64(time.Second)))
+	}
+
+	if console := request.GetConsole(); console != "" {
+		consoleOff := console == "off"
+		if consoleOff != h.consoleOff {
+			h.logger.Info("handler: changing console capture", "console", console)
+		}
+		h.consoleOff = consoleOff
+	}
+
+	if logLevel := request.GetLogLevel(); logLevel != "" && h.logLevel != nil {
+		var level slog.Level
+		if err := level.UnmarshalText([]byte(logLevel)); err != nil {
+			h.logger.Warn("handler: ignoring invalid log level", "level", logLevel)
+		} else {
+			h.logger.Info("handler: changing log level", "level", level)
+			h.logLevel.Set(level)
+		}
+	}
+}
+
 func (h *Handler) handlePreempting(record *service.Record) {
 	h.fwdRecord(record)
 }
 
 func (h *Handler) handleRun(record *service.Record) {
+	h.scrubConfig(record.GetRun().GetConfig())
+	h.limitConfig(record.GetRun().GetConfig())
 	h.fwdRecordWithControl(record,
 		func(control *service.Control) {
 			control.AlwaysSend = true
@@ -863,9 +1206,35 @@ func (h *Handler) handleRun(record *service.Record) {
 }
 
 func (h *Handler) handleConfig(record *service.Record) {
+	h.scrubConfig(record.GetConfig())
+	h.limitConfig(record.GetConfig())
 	h.fwdRecord(record)
 }
 
+// scrubConfig redacts secrets from the items of a config update.
+func (h *Handler) scrubConfig(config *service.ConfigRecord) {
+	if h.scrubber == nil {
+		return
+	}
+	for _, item := range config.GetUpdate() {
+		item.ValueJson = h.scrubber.JSON(
+			scrub.SourceConfig, keylimits.Path(item), item.GetValueJson())
+	}
+}
+
+// limitConfig drops the items of a config update that exceed the key
+// limits.
+func (h *Handler) limitConfig(config *service.ConfigRecord) {
+	for _, item := range config.GetRemove() {
+		h.configLimiter.Remove(keylimits.Path(item))
+	}
+	update, violations := keylimits.Filter(h.configLimiter, config.GetUpdate())
+	if len(violations) > 0 {
+		config.Update = update
+		h.warnKeyLimits("config", violations)
+	}
+}
+
 func (h *Handler) handleAlert(record *service.Record) {
 	h.fwdRecord(record)
 }
@@ -875,6 +1244,8 @@ func (h *Handler) handleExit(record *service.Record, exit *service.RunExitRecord
 	h.runTimer.Pause()
 	exit.Runtime = int32(h.runTimer.Elapsed().Seconds())
 
+	h.reportRedactions()
+
 	if !h.settings.GetXSync().GetValue() {
 		summaryRecord := &service.Record{
 			RecordType: &service.Record_Summary{
@@ -900,6 +1271,22 @@ func (h *Handler) handleFiles(record *service.Record) {
 	if record.GetFiles() == nil {
 		return
 	}
+
+	if h.mediaRetention != nil {
+		files, dropped := h.mediaRetention.Filter(record.GetFiles())
+		if dropped {
+			h.fwdRecord(&service.Record{
+				RecordType: &service.Record_Telemetry{
+					Telemetry: h.mediaRetention.Telemetry(),
+				},
+			})
+		}
+		if files == nil {
+			return
+		}
+		record.RecordType = &service.Record_Files{Files: files}
+	}
+
 	h.fwdRecord(record)
 }
 
@@ -959,6 +1346,7 @@ func (h *Handler) handleRequestInternalMessages(record *service.Record) {
 			InternalMessagesResponse: &service.InternalMessagesResponse{
 				Messages: &service.InternalMessages{
 					Warning: messages,
+					Error:   h.terminalPrinter.ReadErrors(),
 				},
 			},
 		},
@@ -998,6 +1386,15 @@ func (h *Handler) handleSummary(record *service.Record, summary *service.Summary
 		})
 	}
 
+	for _, item := range summary.GetRemove() {
+		h.summaryLimiter.Remove(keylimits.Path(item))
+	}
+	update, violations := keylimits.Filter(h.summaryLimiter, summary.GetUpdate())
+	if len(violations) > 0 {
+		summary.Update = update
+		h.warnKeyLimits("summary", violations)
+	}
+
 	h.runSummary.ApplyChangeRecord(
 		summary,
 		func(err error) {
@@ -1057,6 +1454,15 @@ func (h *Handler) handleHistory(history *service.HistoryRecord) {
 			Key:       "_client_id",
 			ValueJson: fmt.Sprintf(`"%s"`, h.clientID),
 		})
+
+		// The node rank lets the UI group the metrics of each node when
+		// several nodes of a distributed job log to the run.
+		if rank, ok := settings.From(h.settings).GetNodeRank(); ok {
+			history.Item = append(history.Item, &service.HistoryItem{
+				Key:       "_node_rank",
+				ValueJson: fmt.Sprintf("%d", rank),
+			})
+		}
 	} else {
 		history.Item = append(history.Item, &service.HistoryItem{
 			Key:       "_step",
@@ -1081,12 +1487,17 @@ func (h *Handler) handleHistory(history *service.HistoryRecord) {
 
 	h.runHistorySampler.SampleNext(history)
 
-	record := &service.Record{
-		RecordType: &service.Record_History{
-			History: history,
-		},
+	if h.watchdog != nil {
+		for _, trigger := range h.watchdog.Observe(history) {
+			h.handleWatchdogTrigger(trigger)
+		}
+	}
+
+	if h.historyAggregator != nil {
+		h.fwdHistory(h.historyAggregator.Add(history))
+	} else {
+		h.fwdHistory([]*service.HistoryRecord{history})
 	}
-	h.fwdRecord(record)
 
 	// TODO add an option to disable summary (this could be quite expensive)
 	if h.runSummary == nil {
@@ -1103,7 +1514,7 @@ func (h *Handler) handleHistory(history *service.HistoryRecord) {
 		summary = append(summary, summaryItem)
 	}
 
-	record = &service.Record{
+	record := &service.Record{
 		RecordType: &service.Record_Summary{
 			Summary: &service.SummaryRecord{
 				Update: summary,
@@ -1113,6 +1524,173 @@ func (h *Handler) handleHistory(history *service.HistoryRecord) {
 	h.handleSummary(record, record.GetSummary())
 }
 
+// newKeyLimiter returns a limiter for the config or summary using the
+// limits in the settings.
+func newKeyLimiter(settings *service.Settings) *keylimits.Limiter {
+	limits := keylimits.DefaultLimits
+	if maxKeys := settings.GetXMaxConfigSummaryKeys(); maxKeys != nil {
+		limits.MaxKeys = int(maxKeys.GetValue())
+	}
+	if maxDepth := settings.GetXMaxConfigSummaryDepth(); maxDepth != nil {
+		limits.MaxDepth = int(maxDepth.GetValue())
+	}
+	if maxBytes := settings.GetXMaxConfigSummaryValueBytes(); maxBytes != nil {
+		limits.MaxValueBytes = int(maxBytes.GetValue())
+	}
+	return keylimits.New(limits)
+}
+
+// warnKeyLimits reports config or summary items dropped for exceeding
+// the key limits.
+func (h *Handler) warnKeyLimits(kind string, violations []*keylimits.Violation) {
+	for _, violation := range violations {
+		h.logger.Warn(
+			"handler: dropped item over the key limits",
+			"kind", kind,
+			"key", violation.Key,
+			"reason", violation.Reason,
+			"limit", violation.Limit,
+		)
+		h.terminalPrinter.
+			AtMostEvery(time.Minute).
+			Writef("Dropped %s key %q: %s (limit %d).",
+				kind, violation.Key, violation.Reason, violation.Limit)
+	}
+
+	h.fwdRecord(&service.Record{
+		RecordType: &service.Record_Telemetry{
+			Telemetry: &service.TelemetryRecord{
+				ConfigItemsDropped:  int32(h.configLimiter.Dropped()),
+				SummaryItemsDropped: int32(h.summaryLimiter.Dropped()),
+			},
+		},
+	})
+}
+
+// newScrubber returns the scrubber configured in the environment, or nil
+// if scrubbing is off.
+//
+// If the configuration is invalid, the built-in rules are used so that
+// a typo doesn't turn scrubbing off.
+func newScrubber(logger *observability.CoreLogger) *scrub.Scrubber {
+	scrubber, err := scrub.FromEnv()
+	if err == nil {
+		return scrubber
+	}
+
+	logger.CaptureError(fmt.Errorf("handler: %v; using the built-in rules", err))
+	scrubber, _ = scrub.New(scrub.DefaultPatterns, scrub.DefaultKeys)
+	return scrubber
+}
+
+// reportRedactions logs how many secrets were scrubbed from the run.
+func (h *Handler) reportRedactions() {
+	if h.scrubber == nil {
+		return
+	}
+
+	redactions := h.scrubber.Redactions()
+	total := 0
+	for _, n := range redactions {
+		total += n
+	}
+	if total == 0 {
+		return
+	}
+
+	h.logger.Info(
+		"handler: redacted secrets",
+		"config", redactions[scrub.SourceConfig],
+		"console", redactions[scrub.SourceConsole],
+		"metadata", redactions[scrub.SourceMetadata],
+	)
+	h.terminalPrinter.Writef(
+		"Redacted %d secrets (config: %d, console: %d, metadata: %d).",
+		total,
+		redactions[scrub.SourceConfig],
+		redactions[scrub.SourceConsole],
+		redactions[scrub.SourceMetadata],
+	)
+}
+
+// newWatchdog returns a watchdog for the rules in the settings, or nil if
+// there are none.
+func newWatchdog(
+	settings *service.Settings,
+	logger *observability.CoreLogger,
+) *watchdog.Watchdog {
+	var rules []*watchdog.Rule
+	for _, text := range settings.GetXWatchdogRules().GetValue() {
+		rule, err := watchdog.ParseRule(text)
+		if err != nil {
+			logger.CaptureError(fmt.Errorf("handler: %v", err))
+			continue
+		}
+		rules = append(rules, rule)
+	}
+
+	if len(rules) == 0 {
+		return nil
+	}
+	return watchdog.New(rules)
+}
+
+// handleWatchdogTrigger runs the actions of a watchdog rule that triggered.
+func (h *Handler) handleWatchdogTrigger(trigger watchdog.Trigger) {
+	h.logger.Warn(
+		"handler: watchdog rule triggered",
+		"rule", trigger.Rule.Text,
+		"value", trigger.Value,
+	)
+	h.terminalPrinter.Writef(
+		"Watchdog rule %q triggered with %s=%v",
+		trigger.Rule.Text,
+		trigger.Rule.Metric,
+		trigger.Value,
+	)
+
+	for _, action := range trigger.Rule.Actions {
+		switch action.Kind {
+		case watchdog.ActionAlert:
+			h.handleAlert(&service.Record{
+				RecordType: &service.Record_Alert{
+					Alert: &service.AlertRecord{
+						Title: fmt.Sprintf("Watchdog: %s", trigger.Rule.Text),
+						Text: fmt.Sprintf(
+							"%s was %v.", trigger.Rule.Metric, trigger.Value),
+						Level: string(gql.AlertSeverityError),
+					},
+				},
+			})
+		case watchdog.ActionStop:
+			h.watchdogStop = true
+		case watchdog.ActionTerminate:
+			pid := int(h.settings.GetXStatsPid().GetValue())
+			if err := watchdog.Terminate(pid); err != nil {
+				h.logger.CaptureError(fmt.Errorf("handler: %v", err))
+			}
+		case watchdog.ActionExec:
+			go func() {
+				runID := h.settings.GetRunId().GetValue()
+				if err := watchdog.Exec(h.ctx, action, trigger, runID); err != nil {
+					h.logger.Error("handler: watchdog action failed", "error", err)
+				}
+			}()
+		}
+	}
+}
+
+// fwdHistory forwards history rows to the writer.
+func (h *Handler) fwdHistory(rows []*service.HistoryRecord) {
+	for _, history := range rows {
+		h.fwdRecord(&service.Record{
+			RecordType: &service.Record_History{
+				History: history,
+			},
+		})
+	}
+}
+
 func (h *Handler) handleRequestNetworkStatus(record *service.Record) {
 	h.fwdRecord(record)
 }
@@ -1132,6 +1710,29 @@ func (h *Handler) handleRequestPartialHistory(_ *service.Record, request *servic
 	}
 }
 
+// handleHistoryBatch handles a columnar batch of history rows.
+//
+// Each row is handled as a partial history request for its step that
+// flushes, so that batches interleave correctly with history logged in
+// other ways. Batching saves the cost of sending and decoding a record
+// per step.
+func (h *Handler) handleHistoryBatch(batch *service.HistoryBatchRecord) {
+	rows, err := runhistory.ExpandBatch(batch)
+	if err != nil {
+		h.logger.CaptureError(fmt.Errorf("handler: invalid history batch: %v", err))
+		h.terminalPrinter.Write("Failed to process a batch of history, skipping it.")
+		return
+	}
+
+	for _, row := range rows {
+		h.handleRequestPartialHistory(nil, &service.PartialHistoryRequest{
+			Item:   row.GetItem(),
+			Step:   row.GetStep(),
+			Action: &service.HistoryAction{Flush: true},
+		})
+	}
+}
+
 // The main entry point for partial history records in the shared mode.
 //
 // This collects partial history records until a full history record is
@@ -1142,6 +1743,25 @@ func (h *Handler) handlePartialHistoryAsync(request *service.PartialHistoryReque
 	if h.runHistory == nil {
 		h.runHistory = runhistory.New()
 	}
+
+	// Nodes with a rank may number their own steps. The server still
+	// assigns the run's step, but a node's steps must be increasing so that
+	// its metrics can be plotted against them.
+	if _, ok := settings.From(h.settings).GetNodeRank(); ok && request.GetStep() != nil {
+		step := request.GetStep().GetNum()
+		if last := h.lastNodeStep; last != nil && step < last.GetNum() {
+			h.logger.Warn("handlePartialHistoryAsync: ignoring history record",
+				"step", step, "last", last.GetNum())
+			h.terminalPrinter.Writef(
+				"Node steps must be monotonically increasing, received history record"+
+					" for a step (%d) that is less than the last step (%d) of this node;"+
+					" this data will be ignored.",
+				step, last.GetNum())
+			return
+		}
+		h.nodeStep = request.GetStep()
+	}
+
 	// Append the history items from the request to the current history record.
 	h.runHistory.ApplyChangeRecord(request.GetItem(),
 		func(err error) {
@@ -1159,6 +1779,14 @@ func (h *Handler) handlePartialHistoryAsync(request *service.PartialHistoryReque
 				"Failed to process history record, skipping syncing.")
 			return
 		}
+		if h.nodeStep != nil {
+			items = append(items, &service.HistoryItem{
+				Key:       "_node_step",
+				ValueJson: fmt.Sprintf("%d", h.nodeStep.GetNum()),
+			})
+			h.lastNodeStep = h.nodeStep
+			h.nodeStep = nil
+		}
 		h.handleHistory(&service.HistoryRecord{
 			Item: items,
 		})
@@ -0,0 +1,293 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/wandb/wandb/core/internal/filelock"
+	"github.com/wandb/wandb/core/internal/processlib"
+	"github.com/wandb/wandb/core/internal/sentry_ext"
+	"github.com/wandb/wandb/core/internal/settings"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+	"github.com/wandb/wandb/core/pkg/utils"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// The outbox tracks runs whose data may not have reached the server.
+//
+// Every record of a run is written to its transaction log before it is
+// sent, so the log already has everything needed to re-send the run. The
+// outbox only marks which logs to re-send: a marker file is created next
+// to the log when the run starts, and deleted once the run has finished
+// uploading. A marker left behind by a process that no longer exists
+// means that process crashed, and the run is re-sent from its log.
+//
+// Runs logged without an API key because of the _defer_auth setting are
+// also put in the outbox, with a deferred marker. They are only sent by an
+// explicit `wandb-core flush`, never automatically by later runs, since
+// whoever runs the flush chooses the account to upload them to.
+
+// outboxMarker is the contents of an outbox marker file.
+type outboxMarker struct {
+	// The process sending the run.
+	PID      int    `json:"pid"`
+	Hostname string `json:"hostname"`
+
+	// Whether the run was logged without an API key.
+	Deferred bool `json:"deferred,omitempty"`
+}
+
+// OutboxMarkerPath returns the path of the outbox marker of a log.
+func OutboxMarkerPath(syncFile string) string {
+	return syncFile + ".pending"
+}
+
+// outboxLockPath returns the path of the file locked while a run is
+// re-sent from its log.
+func outboxLockPath(syncFile string) string {
+	return syncFile + ".lock"
+}
+
+// ErrRunClaimed is returned by ResendRun if another process is already
+// re-sending the run.
+var ErrRunClaimed = errors.New("outbox: run is being re-sent by another process")
+
+// markPending records that a run's data is being sent.
+func markPending(syncFile string) error {
+	return writeMarker(syncFile, false)
+}
+
+// markDeferred records that a run's data is to be sent once there are
+// credentials for it.
+func markDeferred(syncFile string) error {
+	return writeMarker(syncFile, true)
+}
+
+func writeMarker(syncFile string, deferred bool) error {
+	hostname, _ := os.Hostname()
+	data, err := json.Marshal(outboxMarker{
+		PID:      os.Getpid(),
+		Hostname: hostname,
+		Deferred: deferred,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(OutboxMarkerPath(syncFile), data, 0644); err != nil {
+		return fmt.Errorf("outbox: failed to write marker: %v", err)
+	}
+	return nil
+}
+
+// markSent records that a run's data has been sent.
+func markSent(syncFile string) error {
+	err := os.Remove(OutboxMarkerPath(syncFile))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("outbox: failed to remove marker: %v", err)
+	}
+	return nil
+}
+
+// isAbandoned returns whether the process that wrote the marker has
+// exited without sending the run.
+//
+// Markers from other machines are never considered abandoned, since we
+// can't check whether their process is running.
+func (m outboxMarker) isAbandoned() bool {
+	hostname, _ := os.Hostname()
+	return m.Hostname == hostname && !processlib.IsRunning(m.PID)
+}
+
+// IsDeferredRun returns whether a run in the outbox was logged without an
+// API key.
+func IsDeferredRun(syncFile string) bool {
+	data, err := os.ReadFile(OutboxMarkerPath(syncFile))
+	if err != nil {
+		return false
+	}
+	var marker outboxMarker
+	return json.Unmarshal(data, &marker) == nil && marker.Deferred
+}
+
+// PendingRuns returns the transaction logs of the runs in a wandb
+// directory that may not have been fully sent to the server.
+//
+// Unless all is true, only runs whose sending process has exited are
+// included, excluding runs that are still in progress.
+func PendingRuns(wandbDir string, all bool) ([]string, error) {
+	markers, err := filepath.Glob(
+		filepath.Join(wandbDir, "*run-*", "run-*.wandb.pending"))
+	if err != nil {
+		return nil, err
+	}
+
+	var runs []string
+	for _, path := range markers {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			continue
+		}
+
+		// An unreadable marker was cut short by a crash.
+		var marker outboxMarker
+		if json.Unmarshal(data, &marker) == nil && !all && !marker.isAbandoned() {
+			continue
+		}
+
+		runs = append(runs, strings.TrimSuffix(path, ".pending"))
+	}
+	return runs, nil
+}
+
+// outboxResponder receives the result of re-sending a run.
+type outboxResponder struct {
+	result chan *service.SyncResponse
+}
+
+func (r *outboxResponder) Respond(response *service.ServerResponse) {
+	syncResponse := response.GetResultCommunicate().GetResponse().GetSyncResponse()
+	if syncResponse != nil {
+		r.result <- syncResponse
+	}
+}
+
+// ResendRun uploads a run from its transaction log, as `wandb sync` does,
+// and then removes it from the outbox if all its data was uploaded. It
+// returns the run's URL.
+//
+// The settings provide the server address and credentials. Lines the
+// server acknowledged on an earlier attempt are not sent again, and the
+// rest are sent with their original offsets; see filestream.AckLog.
+func ResendRun(
+	baseSettings *service.Settings,
+	syncFile string,
+	sentryClient *sentry_ext.Client,
+) (string, error) {
+	// Claim the run so that other processes don't re-send it too. The lock
+	// is released if this process crashes, leaving the run in the outbox.
+	lock, err := filelock.TryLock(outboxLockPath(syncFile))
+	if errors.Is(err, filelock.ErrLocked) {
+		return "", ErrRunClaimed
+	}
+	if err != nil {
+		return "", fmt.Errorf("outbox: failed to claim %s: %v", syncFile, err)
+	}
+	defer func() { _ = lock.Unlock() }()
+
+	// Another process may have sent the run since it was found pending.
+	if _, err := os.Stat(OutboxMarkerPath(syncFile)); errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	}
+
+	if err := markPending(syncFile); err != nil {
+		return "", err
+	}
+
+	syncDir := filepath.Dir(syncFile)
+	streamID := utils.ShortID(8)
+
+	runSettings := proto.Clone(baseSettings).(*service.Settings)
+	runSettings.RunId = wrapperspb.String(streamID)
+	runSettings.SyncFile = wrapperspb.String(syncFile)
+	runSettings.SyncDir = wrapperspb.String(syncDir)
+	runSettings.FilesDir = wrapperspb.String(filepath.Join(syncDir, "files"))
+	runSettings.LogDir = wrapperspb.String(filepath.Join(syncDir, "logs"))
+	runSettings.LogInternal = wrapperspb.String(
+		filepath.Join(syncDir, "logs", "debug-core-resend.log"))
+	runSettings.Resume = wrapperspb.String("allow")
+	runSettings.XSync = wrapperspb.Bool(true)
+	runSettings.XOffline = nil
+
+	stream := NewStream(settings.From(runSettings), streamID, sentryClient)
+	responder := &outboxResponder{result: make(chan *service.SyncResponse, 1)}
+	stream.AddResponders(ResponderEntry{responder, streamID})
+	stream.Start()
+
+	stream.HandleRecord(&service.Record{
+		RecordType: &service.Record_Request{
+			Request: &service.Request{
+				RequestType: &service.Request_Sync{
+					Sync: &service.SyncRequest{FinalOffset: -1},
+				},
+			},
+		},
+		Control: &service.Control{ConnectionId: streamID, ReqResp: true},
+	})
+
+	// The stream's context is canceled once it's done, including if it
+	// stops without responding.
+	var result *service.SyncResponse
+	select {
+	case result = <-responder.result:
+	case <-stream.ctx.Done():
+	}
+	stream.Close()
+
+	if result == nil {
+		// The response may have been sent as the stream finished.
+		select {
+		case result = <-responder.result:
+		default:
+			return "", fmt.Errorf(
+				"outbox: failed to re-send %s: stopped without a result", syncFile)
+		}
+	}
+
+	if message := result.GetError().GetMessage(); message != "" {
+		return "", fmt.Errorf("outbox: failed to re-send %s: %s", syncFile, message)
+	}
+	if !stream.sender.uploaded {
+		return "", fmt.Errorf(
+			"outbox: failed to re-send %s: not all data was uploaded", syncFile)
+	}
+	return result.GetUrl(), markSent(syncFile)
+}
+
+// resentDirs are the wandb directories whose abandoned runs this process
+// has already started re-sending.
+var resentDirs sync.Map
+
+// resendAbandonedRuns re-sends the runs left in the outbox of a wandb
+// directory by processes that crashed.
+//
+// This happens at most once per directory per process, in the background.
+func resendAbandonedRuns(
+	logger *observability.CoreLogger,
+	baseSettings *settings.Settings,
+	wandbDir string,
+	sentryClient *sentry_ext.Client,
+) {
+	if _, loaded := resentDirs.LoadOrStore(wandbDir, true); loaded {
+		return
+	}
+
+	runs, err := PendingRuns(wandbDir, false)
+	if err != nil {
+		logger.CaptureError(
+			fmt.Errorf("outbox: failed to find pending runs: %v", err))
+		return
+	}
+
+	for _, syncFile := range runs {
+		if IsDeferredRun(syncFile) {
+			continue
+		}
+
+		logger.Info("outbox: re-sending run", "path", syncFile)
+		_, err := ResendRun(baseSettings.Proto, syncFile, sentryClient)
+		switch {
+		case errors.Is(err, ErrRunClaimed):
+			logger.Info("outbox: run is already being re-sent", "path", syncFile)
+		case err != nil:
+			logger.CaptureError(err)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package server_test
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/filelock"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// writeOutboxMarker creates a run directory with an outbox marker that
+// has the given contents, returning the path of the run's log.
+func writeOutboxMarker(t *testing.T, wandbDir, runID, contents string) string {
+	t.Helper()
+	runDir := filepath.Join(wandbDir, "run-20240101_000000-"+runID)
+	require.NoError(t, os.MkdirAll(runDir, 0755))
+
+	syncFile := filepath.Join(runDir, "run-"+runID+".wandb")
+	require.NoError(t, os.WriteFile(syncFile, nil, 0644))
+	require.NoError(t,
+		os.WriteFile(server.OutboxMarkerPath(syncFile), []byte(contents), 0644))
+	return syncFile
+}
+
+// exitedPID returns the PID of a process that has exited.
+func exitedPID(t *testing.T) int {
+	t.Helper()
+	cmd := exec.Command(os.Args[0], "-test.run=^$")
+	require.NoError(t, cmd.Run())
+	return cmd.Process.Pid
+}
+
+func TestPendingRuns(t *testing.T) {
+	hostname, err := os.Hostname()
+	require.NoError(t, err)
+	wandbDir := t.TempDir()
+
+	crashed := writeOutboxMarker(t, wandbDir, "crashed",
+		fmt.Sprintf(`{"pid": %d, "hostname": %q}`, exitedPID(t), hostname))
+	running := writeOutboxMarker(t, wandbDir, "running",
+		fmt.Sprintf(`{"pid": %d, "hostname": %q}`, os.Getpid(), hostname))
+	remote := writeOutboxMarker(t, wandbDir, "remote",
+		`{"pid": 1, "hostname": "some-other-machine"}`)
+	truncated := writeOutboxMarker(t, wandbDir, "truncated", `{"pid": 12`)
+
+	pending, err := server.PendingRuns(wandbDir, false)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{crashed, truncated}, pending)
+
+	all, err := server.PendingRuns(wandbDir, true)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{crashed, running, remote, truncated}, all)
+}
+
+func TestPendingRuns_NoWandbDir(t *testing.T) {
+	pending, err := server.PendingRuns(filepath.Join(t.TempDir(), "wandb"), false)
+
+	assert.NoError(t, err)
+	assert.Empty(t, pending)
+}
+
+func TestIsDeferredRun(t *testing.T) {
+	wandbDir := t.TempDir()
+
+	deferred := writeOutboxMarker(t, wandbDir, "deferred",
+		`{"pid": 1, "hostname": "host", "deferred": true}`)
+	pending := writeOutboxMarker(t, wandbDir, "pending",
+		`{"pid": 1, "hostname": "host"}`)
+	truncated := writeOutboxMarker(t, wandbDir, "truncated", `{"pid": 1, "defer`)
+
+	assert.True(t, server.IsDeferredRun(deferred))
+	assert.False(t, server.IsDeferredRun(pending))
+	assert.False(t, server.IsDeferredRun(truncated))
+	assert.False(t, server.IsDeferredRun(filepath.Join(wandbDir, "missing.wandb")))
+}
+
+func TestResendRun_ClaimedByAnotherProcess(t *testing.T) {
+	syncFile := writeOutboxMarker(t, t.TempDir(), "crashed",
+		`{"pid": 1, "hostname": "host"}`)
+	lock, err := filelock.TryLock(syncFile + ".lock")
+	require.NoError(t, err)
+	defer func() { _ = lock.Unlock() }()
+
+	_, err = server.ResendRun(&service.Settings{}, syncFile, nil)
+
+	assert.ErrorIs(t, err, server.ErrRunClaimed)
+	assert.FileExists(t, server.OutboxMarkerPath(syncFile))
+}
+
+func TestResendRun_AlreadySent(t *testing.T) {
+	syncFile := writeOutboxMarker(t, t.TempDir(), "sent",
+		`{"pid": 1, "hostname": "host"}`)
+	require.NoError(t, os.Remove(server.OutboxMarkerPath(syncFile)))
+
+	url, err := server.ResendRun(&service.Settings{}, syncFile, nil)
+
+	assert.NoError(t, err)
+	assert.Empty(t, url)
+	assert.NoFileExists(t, server.OutboxMarkerPath(syncFile))
+}
@@ -0,0 +1,15 @@
+//go:build !windows
+
+package server
+
+import (
+	"errors"
+	"net"
+)
+
+// listenPipe listens on a Windows named pipe.
+//
+// Other systems don't have named pipes.
+func listenPipe(string) (net.Listener, error) {
+	return nil, errors.New("server: named pipes are only supported on Windows")
+}
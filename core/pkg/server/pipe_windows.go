@@ -0,0 +1,23 @@
+//go:build windows
+
+package server
+
+import (
+	"net"
+
+	"github.com/Microsoft/go-winio"
+)
+
+// pipeSecurityDescriptor only lets the user that created the pipe connect
+// to it, as the port file is only readable by its owner. The DACL is
+// protected so that it doesn't inherit more permissive entries.
+const pipeSecurityDescriptor = "D:P(A;;GA;;;OW)"
+
+// listenPipe listens on a Windows named pipe.
+func listenPipe(name string) (net.Listener, error) {
+	return winio.ListenPipe(PipePath(name), &winio.PipeConfig{
+		SecurityDescriptor: pipeSecurityDescriptor,
+		InputBufferSize:    messageSize,
+		OutputBufferSize:   messageSize,
+	})
+}
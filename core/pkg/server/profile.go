@@ -0,0 +1,82 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"os"
+	"path/filepath"
+	runtimepprof "runtime/pprof"
+	"time"
+)
+
+// ServeProfiling serves the Go runtime's profiles at /debug/pprof/ on a
+// localhost port, returning the address it listens on.
+//
+// This is opt-in and separate from ServeStatus since profiles reveal more
+// about the process and collecting some of them slows it down.
+func ServeProfiling(port int) (net.Addr, error) {
+	listener, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", port))
+	if err != nil {
+		return nil, fmt.Errorf("server: failed to serve profiles: %v", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	go func() {
+		_ = http.Serve(listener, mux)
+	}()
+	return listener.Addr(), nil
+}
+
+// WriteProfiles writes snapshots of the heap and goroutines to files in
+// a directory, returning their paths.
+//
+// The heap profile is in the pprof format; the goroutines are written as
+// stack traces, which are readable without tools.
+func WriteProfiles(dir string) ([]string, error) {
+	prefix := fmt.Sprintf(
+		"core-%s-%d",
+		time.Now().Format("20060102_150405"),
+		os.Getpid(),
+	)
+
+	var paths []string
+	var errs []error
+	for _, snapshot := range []struct {
+		profile string
+		suffix  string
+		debug   int
+	}{
+		{"heap", "heap.pprof", 0},
+		{"goroutine", "goroutines.txt", 2},
+	} {
+		path := filepath.Join(dir, prefix+"-"+snapshot.suffix)
+		if err := writeProfile(path, snapshot.profile, snapshot.debug); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		paths = append(paths, path)
+	}
+
+	return paths, errors.Join(errs...)
+}
+
+func writeProfile(path, profile string, debug int) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("server: failed to write %s profile: %v", profile, err)
+	}
+	defer file.Close()
+
+	if err := runtimepprof.Lookup(profile).WriteTo(file, debug); err != nil {
+		return fmt.Errorf("server: failed to write %s profile: %v", profile, err)
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package server_test
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/pkg/server"
+)
+
+func TestServeProfiling(t *testing.T) {
+	addr, err := server.ServeProfiling(0)
+	require.NoError(t, err)
+
+	resp, err := http.Get(fmt.Sprintf("http://%s/debug/pprof/goroutine?debug=1", addr))
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestWriteProfiles(t *testing.T) {
+	paths, err := server.WriteProfiles(t.TempDir())
+
+	require.NoError(t, err)
+	require.Len(t, paths, 2)
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		assert.Greater(t, info.Size(), int64(0))
+	}
+}
@@ -0,0 +1,270 @@
+package server
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+	"google.golang.org/protobuf/proto"
+)
+
+// DefaultMaxBufferedRecordBytes is the default size of the records a
+// stream keeps in memory while they wait to be sent.
+const DefaultMaxBufferedRecordBytes = 128 << 20
+
+// How long to wait before reading spilled records again after failing to.
+const spillReadRetryDelay = time.Second
+
+// RecordSpill limits the memory used by records waiting to be sent by
+// writing the excess to a file.
+//
+// When the network is slower than the rate at which a run logs data,
+// records accumulate between the writer and the sender. Without a limit,
+// this either uses enough memory to get the training process killed or,
+// once the stream's queues are full, makes the client wait. This is the
+// only place records are written to disk for this: the filestream keeps
+// a bounded amount of history in memory and otherwise makes the sender
+// wait, so that the backlog collects here.
+//
+// Records are relayed in order: once some records are in the file, new
+// records are added after them, and records are read back as earlier ones
+// are sent.
+type RecordSpill struct {
+	// MaxBytes is the size of records to keep in memory.
+	MaxBytes int64
+
+	// Dir is the directory in which to create the file, or empty for the
+	// system's temporary directory.
+	Dir string
+
+	Logger *observability.CoreLogger
+
+	// memory is the records to relay next, and memoryBytes their size.
+	memory      []queuedRecord
+	memoryBytes int64
+
+	// file holds the records that don't fit in memory, or is nil.
+	file *os.File
+
+	// readOffset and writeOffset are the positions in the file of the
+	// next record to read and the end of the last record written.
+	readOffset  int64
+	writeOffset int64
+
+	// fileRecords is the number of unread records in the file.
+	fileRecords int
+
+	// failed is whether writing to the file failed, after which all
+	// records are kept in memory.
+	failed bool
+
+	// tail is the records added after writing to the file failed while
+	// it still had records, which are relayed after those.
+	tail []queuedRecord
+
+	// readFailing is whether the last attempt to read the file failed.
+	readFailing bool
+}
+
+// Relay returns a channel of the records from in, in the same order.
+//
+// Records are accepted from in as soon as they arrive. The returned
+// channel is closed after in is closed and every record has been relayed.
+func (s *RecordSpill) Relay(in <-chan *service.Record) <-chan *service.Record {
+	out := make(chan *service.Record)
+
+	go func() {
+		defer close(out)
+		defer s.close()
+
+		for in != nil || len(s.memory) > 0 || s.fileRecords > 0 {
+			// Records that can't be read stay in the file until they can,
+			// since the records after them must not be sent first.
+			var retry <-chan time.Time
+			if len(s.memory) == 0 && !s.read() {
+				retry = time.After(spillReadRetryDelay)
+			}
+
+			var next queuedRecord
+			var outOrNil chan<- *service.Record
+			if len(s.memory) > 0 {
+				next = s.memory[0]
+				outOrNil = out
+			}
+
+			select {
+			case record, ok := <-in:
+				if !ok {
+					in = nil
+					continue
+				}
+				s.add(record)
+
+			case outOrNil <- next.record:
+				s.memory[0] = queuedRecord{}
+				s.memory = s.memory[1:]
+				s.memoryBytes -= next.size
+
+			case <-retry:
+			}
+		}
+	}()
+
+	return out
+}
+
+// add queues a record after all other records.
+func (s *RecordSpill) add(record *service.Record) {
+	size := int64(proto.Size(record))
+
+	// Records go into memory only if none are waiting in the file, so that
+	// they stay in order. At least one record is kept so that records
+	// larger than the limit are still sent.
+	if s.fileRecords == 0 &&
+		(s.failed || s.memoryBytes+size <= s.MaxBytes || len(s.memory) == 0) {
+		s.memory = append(s.memory, queuedRecord{record: record, size: size})
+		s.memoryBytes += size
+		return
+	}
+
+	if !s.failed {
+		err := s.write(record)
+		if err == nil {
+			return
+		}
+
+		s.Logger.CaptureError(
+			fmt.Errorf("stream: failed to spill records to disk: %v", err))
+		s.failed = true
+	}
+
+	s.tail = append(s.tail, queuedRecord{record: record, size: size})
+}
+
+// write appends a record to the file, creating it if necessary.
+func (s *RecordSpill) write(record *service.Record) error {
+	data, err := proto.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	if s.file == nil {
+		file, err := os.CreateTemp(s.Dir, "wandb-records-*.bin")
+		if err != nil {
+			return err
+		}
+		s.file = file
+		s.Logger.Warn(
+			"stream: data is logged faster than it can be uploaded,"+
+				" buffering it on disk",
+			"path", file.Name())
+	}
+
+	buf := binary.AppendUvarint(nil, uint64(len(data)))
+	buf = append(buf, data...)
+	n, err := s.file.WriteAt(buf, s.writeOffset)
+	s.writeOffset += int64(n)
+	if err != nil {
+		return err
+	}
+	s.fileRecords++
+	return nil
+}
+
+// read moves records from the file into memory while they fit.
+//
+// It returns false if reading failed, in which case the unread records
+// are left in the file.
+func (s *RecordSpill) read() bool {
+	if s.fileRecords == 0 {
+		return true
+	}
+
+	reader := bufio.NewReader(io.NewSectionReader(
+		s.file, s.readOffset, s.writeOffset-s.readOffset))
+	for s.fileRecords > 0 &&
+		(s.memoryBytes < s.MaxBytes || len(s.memory) == 0) {
+		record, n, err := readRecord(reader)
+		if err != nil {
+			// Report a failure once rather than on every retry.
+			if !s.readFailing {
+				s.Logger.CaptureError(
+					fmt.Errorf(
+						"stream: failed to read spilled records, will retry: %v",
+						err),
+					"path", s.file.Name(),
+					"records", s.fileRecords)
+			}
+			s.readFailing = true
+			return false
+		}
+
+		s.readFailing = false
+		s.readOffset += n
+		s.fileRecords--
+		size := int64(proto.Size(record))
+		s.memory = append(s.memory, queuedRecord{record: record, size: size})
+		s.memoryBytes += size
+	}
+
+	if s.fileRecords > 0 {
+		return true
+	}
+
+	// Records added after writing failed come after those in the file.
+	for _, queued := range s.tail {
+		s.memory = append(s.memory, queued)
+		s.memoryBytes += queued.size
+	}
+	s.tail = nil
+
+	// Reuse the file from the start once it's been read.
+	s.readOffset = 0
+	s.writeOffset = 0
+	if err := s.file.Truncate(0); err != nil {
+		s.Logger.CaptureError(
+			fmt.Errorf("stream: failed to truncate spill file: %v", err))
+	}
+	return true
+}
+
+// readRecord reads a length-prefixed record, returning it and the number
+// of bytes read.
+func readRecord(reader *bufio.Reader) (*service.Record, int64, error) {
+	size, err := binary.ReadUvarint(reader)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	data := make([]byte, size)
+	if _, err := io.ReadFull(reader, data); err != nil {
+		return nil, 0, err
+	}
+
+	record := &service.Record{}
+	if err := proto.Unmarshal(data, record); err != nil {
+		return nil, 0, err
+	}
+
+	prefix := int64(len(binary.AppendUvarint(nil, size)))
+	return record, prefix + int64(size), nil
+}
+
+// close deletes the file.
+func (s *RecordSpill) close() {
+	if s.file == nil {
+		return
+	}
+
+	_ = s.file.Close()
+	if err := os.Remove(s.file.Name()); err != nil {
+		s.Logger.CaptureError(
+			fmt.Errorf("stream: failed to delete record spill file: %v", err))
+	}
+	s.file = nil
+}
@@ -0,0 +1,133 @@
+package server_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+func sendRecords(t *testing.T, in chan<- *service.Record, n int) {
+	t.Helper()
+	for i := 0; i < n; i++ {
+		select {
+		case in <- &service.Record{Num: int64(i)}:
+		case <-time.After(time.Second):
+			t.Fatalf("record %d was not accepted", i)
+		}
+	}
+}
+
+func receiveRecords(t *testing.T, out <-chan *service.Record) []int64 {
+	t.Helper()
+	var nums []int64
+	for {
+		select {
+		case record, ok := <-out:
+			if !ok {
+				return nums
+			}
+			nums = append(nums, record.Num)
+		case <-time.After(time.Second):
+			t.Fatal("the output channel was not closed")
+		}
+	}
+}
+
+func TestRecordSpill_SpillsAndKeepsOrder(t *testing.T) {
+	dir := t.TempDir()
+	spill := &server.RecordSpill{
+		MaxBytes: 10,
+		Dir:      dir,
+		Logger:   observability.NewNoOpLogger(),
+	}
+	in := make(chan *service.Record)
+	out := spill.Relay(in)
+
+	// Nothing reads the output, so the records must be buffered.
+	sendRecords(t, in, 100)
+	close(in)
+
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, files, 1)
+
+	nums := receiveRecords(t, out)
+	require.Len(t, nums, 100)
+	for i, num := range nums {
+		assert.EqualValues(t, i, num)
+	}
+
+	files, err = os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, files)
+}
+
+func TestRecordSpill_NoFileUnderLimit(t *testing.T) {
+	dir := t.TempDir()
+	spill := &server.RecordSpill{
+		MaxBytes: 1 << 20,
+		Dir:      dir,
+		Logger:   observability.NewNoOpLogger(),
+	}
+	in := make(chan *service.Record)
+	out := spill.Relay(in)
+
+	sendRecords(t, in, 10)
+	close(in)
+
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, files)
+	assert.Len(t, receiveRecords(t, out), 10)
+}
+
+func TestRecordSpill_KeepsRecordsItFailsToRead(t *testing.T) {
+	dir := t.TempDir()
+	spill := &server.RecordSpill{
+		MaxBytes: 10,
+		Dir:      dir,
+		Logger:   observability.NewNoOpLogger(),
+	}
+	in := make(chan *service.Record)
+	out := spill.Relay(in)
+	sendRecords(t, in, 100)
+	close(in)
+
+	// Receiving a record means all records have been added.
+	nums := []int64{(<-out).Num}
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	path := filepath.Join(dir, files[0].Name())
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	// Make the spilled records unreadable until the file is restored.
+	require.NoError(t, os.Truncate(path, 0))
+	for done := false; !done; {
+		select {
+		case record, ok := <-out:
+			require.True(t, ok, "the output channel was closed")
+			nums = append(nums, record.Num)
+		case <-time.After(100 * time.Millisecond):
+			done = true
+		}
+	}
+	require.Less(t, len(nums), 100)
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+
+	for record := range out {
+		nums = append(nums, record.Num)
+	}
+	require.Len(t, nums, 100)
+	for i, num := range nums {
+		assert.EqualValues(t, i, num)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync/atomic"
 
 	"github.com/Khan/genqlient/graphql"
 	"google.golang.org/protobuf/proto"
@@ -17,6 +18,7 @@ import (
 	"github.com/wandb/wandb/core/internal/clients"
 	"github.com/wandb/wandb/core/internal/corelib"
 	"github.com/wandb/wandb/core/internal/debounce"
+	"github.com/wandb/wandb/core/internal/errorcode"
 	fs "github.com/wandb/wandb/core/internal/filestream"
 	"github.com/wandb/wandb/core/internal/filetransfer"
 	"github.com/wandb/wandb/core/internal/gql"
@@ -25,6 +27,7 @@ import (
 	"github.com/wandb/wandb/core/internal/runconfig"
 	"github.com/wandb/wandb/core/internal/runconsolelogs"
 	"github.com/wandb/wandb/core/internal/runfiles"
+	"github.com/wandb/wandb/core/internal/runhistory"
 	"github.com/wandb/wandb/core/internal/runmetric"
 	"github.com/wandb/wandb/core/internal/runresume"
 	"github.com/wandb/wandb/core/internal/runsummary"
@@ -62,6 +65,12 @@ type SenderParams struct {
 	OutChan             chan *service.Result
 	FwdChan             chan *service.Record
 	OutputFileName      *paths.RelativePath
+	HistoryAggregator   *runhistory.Aggregator
+	Tracer              *observability.Tracer
+
+	// StopRequested is set when the server asks to stop the run through
+	// the filestream, or is nil.
+	StopRequested *atomic.Bool
 }
 
 // Sender is the sender for a stream it handles the incoming messages and sends to the server
@@ -135,6 +144,14 @@ type Sender struct {
 	// Keep track of exit record to pass to file stream when the time comes
 	exitRecord *service.Record
 
+	// fileStreamStarted is whether the run started uploading to the
+	// file stream
+	fileStreamStarted bool
+
+	// uploaded is whether the run finished and the file stream uploaded
+	// all its data
+	uploaded bool
+
 	// syncService is the sync service syncing offline runs
 	syncService *SyncService
 
@@ -153,6 +170,16 @@ type Sender struct {
 
 	// consoleLogsSender uploads captured console output.
 	consoleLogsSender *runconsolelogs.Sender
+
+	// historyAggregator rate-limits history rows before they are uploaded,
+	// or is nil
+	historyAggregator *runhistory.Aggregator
+
+	// tracer records spans for sending records, or is nil
+	tracer *observability.Tracer
+
+	// stopRequested is set when the server asks to stop the run, or is nil
+	stopRequested *atomic.Bool
 }
 
 // NewSender creates a new Sender with the given settings
@@ -189,6 +216,9 @@ func NewSender(
 		runSummary:          params.RunSummary,
 		outChan:             params.OutChan,
 		fwdChan:             params.FwdChan,
+		historyAggregator:   params.HistoryAggregator,
+		tracer:              params.Tracer,
+		stopRequested:       params.StopRequested,
 		configDebouncer: debounce.NewDebouncer(
 			configDebouncerRateLimit,
 			configDebouncerBurstSize,
@@ -315,6 +345,9 @@ func (s *Sender) SendRecord(record *service.Record) {
 //
 //gocyclo:ignore
 func (s *Sender) sendRecord(record *service.Record) {
+	span := s.tracer.StartRecord(record, "sender")
+	defer span.End()
+
 	switch x := record.RecordType.(type) {
 	case *service.Record_Header:
 		// no-op
@@ -433,6 +466,7 @@ func (s *Sender) sendRequestRunStart(_ *service.RunStartRequest) {
 			s.RunRecord.GetRunId(),
 			s.resumeState.GetFileStreamOffset(),
 		)
+		s.fileStreamStarted = true
 	}
 }
 
@@ -487,6 +521,7 @@ func (s *Sender) sendJobFlush() {
 	saver := artifacts.NewArtifactSaver(
 		s.ctx, s.logger, s.graphqlClient, s.fileTransferManager, artifact, 0, "",
 	)
+	saver.ManifestV2 = s.settings.GetXArtifactManifestV2().GetValue()
 	if _, err = saver.Save(s.fwdChan); err != nil {
 		s.logger.Error(
 			"sender: sendDefer: failed to save job artifact", "error", err,
@@ -497,6 +532,10 @@ func (s *Sender) sendJobFlush() {
 func (s *Sender) sendRequestDefer(request *service.DeferRequest) {
 	switch request.State {
 	case service.DeferRequest_BEGIN:
+		// Don't hold up the run's finish until the next upload window.
+		if s.fileTransferManager != nil {
+			s.fileTransferManager.IgnoreUploadWindow()
+		}
 		request.State++
 		s.fwdRequestDefer(request)
 	case service.DeferRequest_FLUSH_RUN:
@@ -506,6 +545,9 @@ func (s *Sender) sendRequestDefer(request *service.DeferRequest) {
 		request.State++
 		s.fwdRequestDefer(request)
 	case service.DeferRequest_FLUSH_PARTIAL_HISTORY:
+		if s.historyAggregator != nil {
+			s.streamHistory(s.historyAggregator.Flush())
+		}
 		request.State++
 		s.fwdRequestDefer(request)
 	case service.DeferRequest_FLUSH_TB:
@@ -558,6 +600,10 @@ func (s *Sender) sendRequestDefer(request *service.DeferRequest) {
 					fmt.Errorf("sender: no exit code on finish"))
 				s.fileStream.FinishWithoutExit()
 			}
+
+			s.uploaded = s.fileStreamStarted &&
+				s.exitRecord != nil &&
+				s.fileStream.Err() == nil
 		}
 		request.State++
 		s.fwdRequestDefer(request)
@@ -655,12 +701,71 @@ func (s *Sender) serializeConfig(format runconfig.Format) (string, error) {
 	return string(serializedConfig), nil
 }
 
+// checkForkFrom validates the fork_from setting and records the branch
+// point in the run's config, which tells the server to fork the run.
+func (s *Sender) checkForkFrom(record *service.Record) error {
+	forkFrom := s.settings.GetForkFrom()
+	if forkFrom == nil {
+		return nil
+	}
+
+	var message string
+	switch {
+	case s.settings.GetResume().GetValue() != "":
+		message = "Multiple resume options specified." +
+			" Please specify only one of `fork_from` or `resume`."
+	case forkFrom.GetRun() == "":
+		message = "`fork_from` must specify the run to fork from."
+	case forkFrom.GetMetric() != "_step":
+		message = fmt.Sprintf(
+			"Forking is only supported at a `_step`, not at %q.",
+			forkFrom.GetMetric())
+	}
+
+	if message != "" {
+		s.respond(record,
+			&service.RunUpdateResult{
+				Error: &service.ErrorInfo{
+					Message: message,
+					Code:    service.ErrorInfo_USAGE,
+				},
+			})
+		return errors.New(message)
+	}
+
+	s.runConfig.AddBranchPoint(forkFrom.GetRun(), forkFrom.GetValue())
+	return nil
+}
+
+// setupFork makes the forked run continue after its branch point.
+//
+// The server copies the parent run's history up to the branch point,
+// so new history lines are appended after the copied ones.
+func (s *Sender) setupFork(historyLineCount *int) {
+	s.RunRecord.Forked = true
+	s.RunRecord.StartingStep = int64(s.settings.GetForkFrom().GetValue()) + 1
+
+	if s.resumeState == nil {
+		s.resumeState = runresume.NewResumeState(s.logger, runresume.None)
+	}
+	if historyLineCount != nil {
+		s.resumeState.AddOffset(fs.HistoryChunk, *historyLineCount)
+	}
+}
+
 func (s *Sender) checkAndUpdateResumeState(record *service.Record) error {
 	if s.graphqlClient == nil {
 		return nil
 	}
 	resume := runresume.ResumeMode(s.settings.GetResume().GetValue())
 
+	// A run ID derived from an idempotency key is reused when a job is
+	// retried, in which case the run should be resumed rather than
+	// overwritten.
+	if resume == runresume.None && s.settings.GetIdempotencyKey().GetValue() != "" {
+		resume = runresume.Allow
+	}
+
 	// There was no resume status set, so we don't need to do anything
 	if resume == runresume.None {
 		return nil
@@ -672,13 +777,11 @@ func (s *Sender) checkAndUpdateResumeState(record *service.Record) error {
 	// If we couldn't get the resume status, we should fail if resume is set
 	data, err := gql.RunResumeStatus(s.ctx, s.graphqlClient, &run.Project, utils.NilIfZero(run.Entity), run.RunId)
 	if err != nil {
-		err = fmt.Errorf("failed to get run resume status: %s", err)
+		err = fmt.Errorf("failed to get run resume status: %w", err)
 		s.logger.Error("sender: checkAndUpdateResumeState", "error", err)
 		result := &service.RunUpdateResult{
-			Error: &service.ErrorInfo{
-				Message: err.Error(),
-				Code:    service.ErrorInfo_COMMUNICATION,
-			}}
+			Error: errorcode.Info(err, service.ErrorInfo_COMMUNICATION),
+		}
 		s.respond(record, result)
 		return err
 	}
@@ -730,6 +833,13 @@ func (s *Sender) sendRun(record *service.Record, run *service.RunRecord) {
 					errors.New("sender: sendRun: failed to clone RunRecord"))
 			}
 
+			if err := s.checkForkFrom(record); err != nil {
+				s.logger.Error(
+					"sender: sendRun: failed to checkForkFrom",
+					"error", err)
+				return
+			}
+
 			if err := s.checkAndUpdateResumeState(record); err != nil {
 				s.logger.Error(
 					"sender: sendRun: failed to checkAndUpdateResumeState",
@@ -796,7 +906,7 @@ func (s *Sender) sendRun(record *service.Record, run *service.RunRecord) {
 			nil,                              // summaryMetrics
 		)
 		if err != nil {
-			err = fmt.Errorf("failed to upsert bucket: %s", err)
+			err = fmt.Errorf("failed to upsert bucket: %w", err)
 			s.logger.Error("sender: sendRun:", "error", err)
 			// TODO(run update): handle error communication back to the client
 			fmt.Println("ERROR: failed to upsert bucket", err.Error())
@@ -805,10 +915,7 @@ func (s *Sender) sendRun(record *service.Record, run *service.RunRecord) {
 			if record.GetControl().GetReqResp() || record.GetControl().GetMailboxSlot() != "" {
 				s.respond(record,
 					&service.RunUpdateResult{
-						Error: &service.ErrorInfo{
-							Message: err.Error(),
-							Code:    service.ErrorInfo_COMMUNICATION,
-						},
+						Error: errorcode.Info(err, service.ErrorInfo_COMMUNICATION),
 					},
 				)
 			}
@@ -824,6 +931,10 @@ func (s *Sender) sendRun(record *service.Record, run *service.RunRecord) {
 		s.RunRecord.Project = project.GetName()
 		s.RunRecord.Entity = entity.GetName()
 		s.RunRecord.SweepId = utils.ZeroIfNil(bucket.GetSweepName())
+
+		if !runRecordIsSet && s.settings.GetForkFrom() != nil {
+			s.setupFork(bucket.GetHistoryLineCount())
+		}
 	}
 
 	if record.GetControl().GetReqResp() || record.GetControl().GetMailboxSlot() != "" {
@@ -841,11 +952,22 @@ func (s *Sender) sendRun(record *service.Record, run *service.RunRecord) {
 // sendHistory sends a history record to the file stream,
 // which will then send it to the server
 func (s *Sender) sendHistory(record *service.HistoryRecord) {
+	if s.historyAggregator != nil {
+		s.streamHistory(s.historyAggregator.Add(record))
+	} else {
+		s.streamHistory([]*service.HistoryRecord{record})
+	}
+}
+
+// streamHistory uploads history rows through the filestream.
+func (s *Sender) streamHistory(rows []*service.HistoryRecord) {
 	if s.fileStream == nil {
 		return
 	}
 
-	s.fileStream.StreamUpdate(&fs.HistoryUpdate{Record: record})
+	for _, record := range rows {
+		s.fileStream.StreamUpdate(&fs.HistoryUpdate{Record: record})
+	}
 }
 
 func (s *Sender) streamSummary() {
@@ -1029,8 +1151,16 @@ func (s *Sender) sendAlert(_ *service.Record, alert *service.AlertRecord) {
 		s.logger.CaptureFatalAndPanic(
 			errors.New("sender: sendAlert: RunRecord not set"))
 	}
-	// TODO: handle invalid alert levels
-	severity := gql.AlertSeverity(alert.Level)
+	severity := gql.AlertSeverity(strings.ToUpper(alert.Level))
+	switch severity {
+	case gql.AlertSeverityInfo, gql.AlertSeverityWarn, gql.AlertSeverityError:
+	default:
+		s.logger.Warn(
+			"sender: sendAlert: invalid alert level, using INFO",
+			"level", alert.Level,
+		)
+		severity = gql.AlertSeverityInfo
+	}
 
 	data, err := gql.NotifyScriptableRunAlert(
 		s.ctx,
@@ -1113,6 +1243,7 @@ func (s *Sender) sendArtifact(_ *service.Record, msg *service.ArtifactRecord) {
 	saver := artifacts.NewArtifactSaver(
 		s.ctx, s.logger, s.graphqlClient, s.fileTransferManager, msg, 0, "",
 	)
+	saver.ManifestV2 = s.settings.GetXArtifactManifestV2().GetValue()
 	artifactID, err := saver.Save(s.fwdChan)
 	if err != nil {
 		err = fmt.Errorf("sender: sendArtifact: failed to log artifact ID: %s; error: %s", artifactID, err)
@@ -1126,6 +1257,7 @@ func (s *Sender) sendRequestLogArtifact(record *service.Record, msg *service.Log
 	saver := artifacts.NewArtifactSaver(
 		s.ctx, s.logger, s.graphqlClient, s.fileTransferManager, msg.Artifact, msg.HistoryStep, msg.StagingDir,
 	)
+	saver.ManifestV2 = s.settings.GetXArtifactManifestV2().GetValue()
 	artifactID, err := saver.Save(s.fwdChan)
 	if err != nil {
 		response.ErrorMessage = err.Error()
@@ -1154,6 +1286,7 @@ func (s *Sender) sendRequestDownloadArtifact(record *service.Record, msg *servic
 		msg.AllowMissingReferences,
 		msg.SkipCache,
 		msg.PathPrefix,
+		msg.PathGlobs,
 	).Download(); err != nil {
 		s.logger.CaptureError(
 			fmt.Errorf("sender: failed to download artifact: %v", err))
@@ -1176,13 +1309,7 @@ func (s *Sender) sendRequestSync(record *service.Record, request *service.SyncRe
 		WithSyncServiceOverwrite(request.GetOverwrite()),
 		WithSyncServiceSkip(request.GetSkip()),
 		WithSyncServiceFlushCallback(func(err error) {
-			var errorInfo *service.ErrorInfo
-			if err != nil {
-				errorInfo = &service.ErrorInfo{
-					Message: err.Error(),
-					Code:    service.ErrorInfo_UNKNOWN,
-				}
-			}
+			errorInfo := errorcode.Info(err, service.ErrorInfo_UNKNOWN)
 
 			var url string
 			if s.RunRecord != nil {
@@ -1222,6 +1349,19 @@ func (s *Sender) sendRequestSync(record *service.Record, request *service.SyncRe
 }
 
 func (s *Sender) sendRequestStopStatus(record *service.Record, _ *service.StopStatusRequest) {
+	// The filestream may have already told us to stop.
+	if s.stopRequested != nil && s.stopRequested.Load() {
+		s.respond(record,
+			&service.Response{
+				ResponseType: &service.Response_StopStatusResponse{
+					StopStatusResponse: &service.StopStatusResponse{
+						RunShouldStop: true,
+					},
+				},
+			},
+		)
+		return
+	}
 
 	// TODO: unify everywhere to use settings
 	entity := s.RunRecord.GetEntity()
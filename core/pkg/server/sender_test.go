@@ -2,6 +2,7 @@ package server_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/Khan/genqlient/graphql"
@@ -44,18 +45,39 @@ const validCreateArtifactResponse = `{
 	}
 }`
 
-func makeSender(client graphql.Client, recordChan chan *service.Record, resultChan chan *service.Result) *server.Sender {
-	ctx, cancel := context.WithCancel(context.Background())
-	logger := observability.NewNoOpLogger()
-	settings := wbsettings.From(&service.Settings{
+func makeSender(
+	t *testing.T,
+	client graphql.Client,
+	recordChan chan *service.Record,
+	resultChan chan *service.Result,
+) *server.Sender {
+	return makeSenderWithSettings(t, client, recordChan, resultChan, &service.Settings{
 		RunId: &wrapperspb.StringValue{Value: "run1"},
 	})
+}
+
+func makeSenderWithSettings(
+	t *testing.T,
+	client graphql.Client,
+	recordChan chan *service.Record,
+	resultChan chan *service.Result,
+	settingsProto *service.Settings,
+) *server.Sender {
+	// Keep the console log out of the working directory.
+	if settingsProto.FilesDir == nil {
+		settingsProto.FilesDir = wrapperspb.String(t.TempDir())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	logger := observability.NewNoOpLogger()
+	settings := wbsettings.From(settingsProto)
 	backend := server.NewBackend(logger, settings)
 	fileStream := server.NewFileStream(
-		backend, logger, observability.NewPrinter(), settings, nil)
+		backend, logger, observability.NewPrinter(), settings, nil, nil, nil)
 	fileTransferManager := server.NewFileTransferManager(
 		filetransfer.NewFileTransferStats(),
 		logger,
+		observability.NewPrinter(),
 		settings,
 	)
 	runfilesUploader := server.NewRunfilesUploader(
@@ -66,6 +88,7 @@ func makeSender(client graphql.Client, recordChan chan *service.Record, resultCh
 		fileTransferManager,
 		watchertest.NewFakeWatcher(),
 		client,
+		nil,
 	)
 	sender := server.NewSender(
 		ctx,
@@ -94,7 +117,7 @@ func TestSendRun(t *testing.T) {
 		validUpsertBucketResponse,
 	)
 	outChan := make(chan *service.Result, 1)
-	sender := makeSender(mockGQL, make(chan *service.Record, 1), outChan)
+	sender := makeSender(t, mockGQL, make(chan *service.Record, 1), outChan)
 
 	run := &service.Record{
 		RecordType: &service.Record_Run{
@@ -128,11 +151,142 @@ func TestSendRun(t *testing.T) {
 		requests[0])
 }
 
+// containsMatcher matches strings containing a substring.
+type containsMatcher struct{ substr string }
+
+func (m containsMatcher) Matches(x any) bool {
+	s, ok := x.(string)
+	return ok && strings.Contains(s, m.substr)
+}
+
+func (m containsMatcher) String() string {
+	return "contains " + m.substr
+}
+
+func TestSendRun_ForkFrom(t *testing.T) {
+	mockGQL := gqlmock.NewMockClient()
+	mockGQL.StubMatchOnce(
+		gqlmock.WithOpName("UpsertBucket"),
+		`{"upsertBucket": {"bucket": {
+			"historyLineCount": 11,
+			"project": {"name": "project", "entity": {"name": "entity"}}
+		}}}`,
+	)
+	outChan := make(chan *service.Result, 1)
+	sender := makeSenderWithSettings(
+		t,
+		mockGQL,
+		make(chan *service.Record, 1),
+		outChan,
+		&service.Settings{
+			RunId: wrapperspb.String("child"),
+			ForkFrom: &service.RunMoment{
+				Run:    "parent",
+				Metric: "_step",
+				Value:  10,
+			},
+		},
+	)
+
+	sender.SendRecord(&service.Record{
+		RecordType: &service.Record_Run{Run: &service.RunRecord{RunId: "child"}},
+		Control:    &service.Control{MailboxSlot: "junk"},
+	})
+	result := <-outChan
+
+	run := result.GetRunResult().GetRun()
+	assert.True(t, run.GetForked())
+	assert.EqualValues(t, 11, run.GetStartingStep())
+	requests := mockGQL.AllRequests()
+	assert.Len(t, requests, 1)
+	gqlmock.AssertRequest(t,
+		gqlmock.WithVariables(
+			gqlmock.GQLVar("config", containsMatcher{
+				`"branch_point":{"run_id":"parent","step":10}`,
+			}),
+		),
+		requests[0])
+}
+
+func TestSendRun_IdempotencyKeyResumes(t *testing.T) {
+	mockGQL := gqlmock.NewMockClient()
+	mockGQL.StubMatchOnce(
+		gqlmock.WithOpName("RunResumeStatus"),
+		`{"model": {"bucket": {
+			"historyLineCount": 5,
+			"eventsLineCount": 0,
+			"logLineCount": 0,
+			"historyTail": "[\"{\\\"_step\\\":4}\"]",
+			"config": "{}",
+			"summaryMetrics": "{}",
+			"wandbConfig": "{\"t\": {}}"
+		}}}`,
+	)
+	mockGQL.StubMatchOnce(
+		gqlmock.WithOpName("UpsertBucket"),
+		validUpsertBucketResponse,
+	)
+	outChan := make(chan *service.Result, 1)
+	sender := makeSenderWithSettings(
+		t,
+		mockGQL,
+		make(chan *service.Record, 1),
+		outChan,
+		&service.Settings{
+			RunId:          wrapperspb.String("retried"),
+			FilesDir:       wrapperspb.String(t.TempDir()),
+			IdempotencyKey: wrapperspb.String("slurm-1234"),
+		},
+	)
+
+	sender.SendRecord(&service.Record{
+		RecordType: &service.Record_Run{Run: &service.RunRecord{RunId: "retried"}},
+		Control:    &service.Control{MailboxSlot: "junk"},
+	})
+	result := <-outChan
+
+	run := result.GetRunResult().GetRun()
+	assert.Nil(t, result.GetRunResult().GetError())
+	assert.True(t, run.GetResumed())
+	assert.EqualValues(t, 5, run.GetStartingStep())
+	assert.True(t, mockGQL.AllStubsUsed())
+}
+
+func TestSendRun_ForkFromInvalidMetric(t *testing.T) {
+	mockGQL := gqlmock.NewMockClient()
+	outChan := make(chan *service.Result, 1)
+	sender := makeSenderWithSettings(
+		t,
+		mockGQL,
+		make(chan *service.Record, 1),
+		outChan,
+		&service.Settings{
+			RunId: wrapperspb.String("child"),
+			ForkFrom: &service.RunMoment{
+				Run:    "parent",
+				Metric: "loss",
+				Value:  0.5,
+			},
+		},
+	)
+
+	sender.SendRecord(&service.Record{
+		RecordType: &service.Record_Run{Run: &service.RunRecord{RunId: "child"}},
+		Control:    &service.Control{MailboxSlot: "junk"},
+	})
+	result := <-outChan
+
+	assert.Equal(t,
+		service.ErrorInfo_USAGE,
+		result.GetRunResult().GetError().GetCode())
+	assert.Empty(t, mockGQL.AllRequests())
+}
+
 // Verify that arguments are properly passed through to graphql
 func TestSendLinkArtifact(t *testing.T) {
 	mockGQL := gqlmock.NewMockClient()
 	outChan := make(chan *service.Result, 1)
-	sender := makeSender(mockGQL, make(chan *service.Record, 1), outChan)
+	sender := makeSender(t, mockGQL, make(chan *service.Record, 1), outChan)
 
 	// 1. When both clientId and serverId are sent, serverId is used
 	linkArtifact := &service.Record{
@@ -239,7 +393,7 @@ func TestSendLinkArtifact(t *testing.T) {
 
 func TestSendUseArtifact(t *testing.T) {
 	mockGQL := gqlmock.NewMockClient()
-	sender := makeSender(mockGQL, make(chan *service.Record, 1), make(chan *service.Result, 1))
+	sender := makeSender(t, mockGQL, make(chan *service.Record, 1), make(chan *service.Result, 1))
 
 	useArtifact := &service.Record{
 		RecordType: &service.Record_UseArtifact{
@@ -288,7 +442,7 @@ func TestSendArtifact(t *testing.T) {
 		gqlmock.WithOpName("CreateArtifact"),
 		validCreateArtifactResponse,
 	)
-	sender := makeSender(mockGQL, make(chan *service.Record, 1), make(chan *service.Result, 1))
+	sender := makeSender(t, mockGQL, make(chan *service.Record, 1), make(chan *service.Result, 1))
 
 	// 1. When both clientId and serverId are sent, serverId is used
 	artifact := &service.Record{
@@ -328,3 +482,36 @@ func TestSendArtifact(t *testing.T) {
 		),
 		requests[0])
 }
+
+func TestSendAlert_InvalidLevel(t *testing.T) {
+	mockGQL := gqlmock.NewMockClient()
+	sender := makeSender(t, mockGQL, make(chan *service.Record, 1), make(chan *service.Result, 1))
+	sender.RunRecord = &service.RunRecord{
+		Entity:  "testEntity",
+		Project: "testProject",
+		RunId:   "run1",
+	}
+
+	mockGQL.StubMatchOnce(
+		gqlmock.WithOpName("NotifyScriptableRunAlert"),
+		`{"notifyScriptableRunAlert": {"success": true}}`,
+	)
+	sender.SendRecord(&service.Record{
+		RecordType: &service.Record_Alert{
+			Alert: &service.AlertRecord{
+				Title:        "loss diverged",
+				Level:        "critical",
+				WaitDuration: 60000,
+			},
+		},
+	})
+
+	requests := mockGQL.AllRequests()
+	assert.Len(t, requests, 1)
+	gqlmock.AssertRequest(t,
+		gqlmock.WithVariables(
+			gqlmock.GQLVar("title", gomock.Eq("loss diverged")),
+			gqlmock.GQLVar("severity", gomock.Eq("INFO")),
+		),
+		requests[0])
+}
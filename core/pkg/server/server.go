@@ -2,6 +2,8 @@ package server
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"log/slog"
@@ -26,6 +28,23 @@ type ServerParams struct {
 	PortFilename    string
 	ParentPid       int
 	SentryClient    *sentry_ext.Client
+
+	// If set, the server listens on the Windows named pipe with this name
+	// instead of on ListenIPAddress. See PipePath.
+	PipeName string
+
+	// If set, the server listens on a Unix domain socket at this path
+	// instead of on ListenIPAddress.
+	//
+	// The socket file is removed when the server closes.
+	UnixSocket string
+
+	// Whether clients must authenticate before using the server.
+	//
+	// If set, a random token is written to the port file, and each
+	// connection must start with a ServerAuthenticateRequest containing
+	// it. This stops other users on the same machine from connecting.
+	RequireAuth bool
 }
 
 // Server is the core server
@@ -49,6 +68,10 @@ type Server struct {
 
 	// parentPid is the parent pid to watch and exit if it goes away
 	parentPid int
+
+	// authToken is the token clients must present, or empty if clients
+	// don't need to authenticate
+	authToken string
 }
 
 // NewServer creates a new server
@@ -61,7 +84,16 @@ func NewServer(
 	}
 	ctx, cancel := context.WithCancel(ctx)
 
-	listener, err := net.Listen("tcp", params.ListenIPAddress)
+	var listener net.Listener
+	var err error
+	switch {
+	case params.PipeName != "":
+		listener, err = listenPipe(params.PipeName)
+	case params.UnixSocket != "":
+		listener, err = net.Listen("unix", params.UnixSocket)
+	default:
+		listener, err = net.Listen("tcp", params.ListenIPAddress)
+	}
 	if err != nil {
 		cancel()
 		return nil, err
@@ -76,8 +108,17 @@ func NewServer(
 		sentryClient: params.SentryClient,
 	}
 
-	port := s.listener.Addr().(*net.TCPAddr).Port
-	if err := writePortFile(params.PortFilename, port); err != nil {
+	if params.RequireAuth {
+		token, err := newAuthToken()
+		if err != nil {
+			cancel()
+			_ = listener.Close()
+			return nil, err
+		}
+		s.authToken = token
+	}
+
+	if err := writePortFile(params.PortFilename, s.listener.Addr(), s.authToken); err != nil {
 		slog.Error("failed to write port file", "error", err)
 		return nil, err
 	}
@@ -148,7 +189,7 @@ func (s *Server) serve() {
 		} else {
 			s.wg.Add(1)
 			go func() {
-				nc := NewConnection(s.ctx, s.cancel, conn, s.sentryClient)
+				nc := NewConnection(s.ctx, s.cancel, conn, s.authToken, s.sentryClient)
 				nc.HandleConnection()
 				s.wg.Done()
 			}()
@@ -162,28 +203,104 @@ func (s *Server) Wait() {
 	slog.Info("server is shutting down")
 }
 
+// Shutdown stops accepting connections and finishes all runs, waiting up
+// to the timeout for their data to be flushed.
+//
+// The runs are marked as finished with the exit code. Their remaining
+// history, files and artifacts are uploaded and their transaction logs
+// closed, as when the client process tears down the service.
+//
+// Returns an error if the runs didn't finish before the timeout, in which
+// case Close blocks until they do.
+func (s *Server) Shutdown(exitCode int32, timeout time.Duration) error {
+	slog.Info("server: shutting down", "timeout", timeout)
+
+	// Close waits for the runs to finish too.
+	finished := make(chan struct{})
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		streamMux.FinishAndCloseAllStreams(exitCode)
+		close(finished)
+	}()
+
+	// Stop accepting connections and close the existing ones.
+	s.cancel()
+	if err := s.listener.Close(); err != nil && !errors.Is(err, net.ErrClosed) {
+		slog.Error("server: failed to close listener", "error", err)
+	}
+
+	select {
+	case <-finished:
+		slog.Info("server: all runs finished")
+		return nil
+	case <-time.After(timeout):
+		return fmt.Errorf(
+			"server: runs did not finish within %v, some data may be lost",
+			timeout)
+	}
+}
+
 // Close closes the server
 func (s *Server) Close() {
-	if err := s.listener.Close(); err != nil {
+	if err := s.listener.Close(); err != nil && !errors.Is(err, net.ErrClosed) {
 		slog.Error("failed to Close listener", "error", err)
 	}
 	s.wg.Wait()
 	slog.Info("server is closed")
 }
 
-func writePortFile(portFile string, port int) error {
+// newAuthToken returns a random token for clients to authenticate with.
+func newAuthToken() (string, error) {
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		return "", fmt.Errorf("server: failed to generate auth token: %v", err)
+	}
+	return hex.EncodeToString(token), nil
+}
+
+// PipePath returns the path of the Windows named pipe with the given name.
+func PipePath(name string) string {
+	return `\\.\pipe\` + name
+}
+
+// writePortFile writes the address and auth token for clients to connect
+// with.
+//
+// For TCP, the address is written as "sock=PORT", for Unix domain sockets
+// as "unix=PATH", and for named pipes as "pipe=PATH".
+//
+// The file is only readable by the current user, since the token grants
+// access to the server.
+func writePortFile(portFile string, addr net.Addr, authToken string) error {
 	tempFile := fmt.Sprintf("%s.tmp", portFile)
-	f, err := os.Create(tempFile)
+	f, err := os.OpenFile(tempFile, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0600)
 	if err != nil {
 		err = fmt.Errorf("fail create temp file: %w", err)
 		return err
 	}
 
-	if _, err = f.WriteString(fmt.Sprintf("sock=%d\n", port)); err != nil {
+	var addrLine string
+	switch addr := addr.(type) {
+	case *net.TCPAddr:
+		addrLine = fmt.Sprintf("sock=%d\n", addr.Port)
+	case *net.UnixAddr:
+		addrLine = fmt.Sprintf("unix=%s\n", addr.Name)
+	default:
+		addrLine = fmt.Sprintf("pipe=%s\n", addr.String())
+	}
+	if _, err = f.WriteString(addrLine); err != nil {
 		err = fmt.Errorf("fail write port: %w", err)
 		return err
 	}
 
+	if authToken != "" {
+		if _, err = f.WriteString(fmt.Sprintf("token=%s\n", authToken)); err != nil {
+			err = fmt.Errorf("fail write token: %w", err)
+			return err
+		}
+	}
+
 	if _, err = f.WriteString("EOF"); err != nil {
 		err = fmt.Errorf("fail write EOF: %w", err)
 		return err
@@ -0,0 +1,295 @@
+package server_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"net"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// sendServerRequest writes a framed request to the connection.
+func sendServerRequest(t *testing.T, conn net.Conn, req *service.ServerRequest) {
+	t.Helper()
+	data, err := proto.Marshal(req)
+	require.NoError(t, err)
+	header := server.Header{Magic: byte('W'), DataLength: uint32(len(data))}
+	require.NoError(t, binary.Write(conn, binary.LittleEndian, &header))
+	_, err = conn.Write(data)
+	require.NoError(t, err)
+}
+
+// readServerResponses reads responses until the connection is closed.
+func readServerResponses(t *testing.T, conn net.Conn) []*service.ServerResponse {
+	t.Helper()
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	scanner := bufio.NewScanner(conn)
+	scanner.Split(server.ScanWBRecords)
+
+	var responses []*service.ServerResponse
+	for scanner.Scan() {
+		response := &service.ServerResponse{}
+		require.NoError(t, proto.Unmarshal(scanner.Bytes(), response))
+		responses = append(responses, response)
+	}
+	require.NoError(t, scanner.Err())
+	return responses
+}
+
+var teardownRequest = &service.ServerRequest{
+	ServerRequestType: &service.ServerRequest_InformTeardown{
+		InformTeardown: &service.ServerInformTeardownRequest{},
+	},
+}
+
+func TestShutdown_StopsAcceptingConnections(t *testing.T) {
+	portFile := filepath.Join(t.TempDir(), "port")
+	srv, err := server.NewServer(context.Background(), &server.ServerParams{
+		ListenIPAddress: "127.0.0.1:0",
+		PortFilename:    portFile,
+	})
+	require.NoError(t, err)
+	srv.Start()
+	contents, err := os.ReadFile(portFile)
+	require.NoError(t, err)
+	port := regexp.MustCompile(`sock=(\d+)`).FindSubmatch(contents)[1]
+
+	err = srv.Shutdown(0, time.Second)
+	srv.Wait()
+	srv.Close()
+
+	assert.NoError(t, err)
+	_, err = net.Dial("tcp", "127.0.0.1:"+string(port))
+	assert.Error(t, err)
+}
+
+func TestRequireAuth(t *testing.T) {
+	portFile := filepath.Join(t.TempDir(), "port")
+	srv, err := server.NewServer(context.Background(), &server.ServerParams{
+		ListenIPAddress: "127.0.0.1:0",
+		PortFilename:    portFile,
+		RequireAuth:     true,
+	})
+	require.NoError(t, err)
+	srv.Start()
+	defer srv.Close()
+	contents, err := os.ReadFile(portFile)
+	require.NoError(t, err)
+	port := regexp.MustCompile(`sock=(\d+)`).FindSubmatch(contents)[1]
+	token := regexp.MustCompile(`token=(\w+)`).FindSubmatch(contents)[1]
+	addr := "127.0.0.1:" + string(port)
+
+	t.Run("rejects unauthenticated client", func(t *testing.T) {
+		conn, err := net.Dial("tcp", addr)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		sendServerRequest(t, conn, teardownRequest)
+		responses := readServerResponses(t, conn)
+
+		require.Len(t, responses, 1)
+		assert.Equal(t,
+			"connection: client did not authenticate",
+			responses[0].GetAuthenticateResponse().GetErrorMessage())
+	})
+
+	t.Run("rejects wrong token", func(t *testing.T) {
+		conn, err := net.Dial("tcp", addr)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		sendServerRequest(t, conn, &service.ServerRequest{
+			ServerRequestType: &service.ServerRequest_Authenticate{
+				Authenticate: &service.ServerAuthenticateRequest{Token: "wrong"},
+			},
+		})
+		sendServerRequest(t, conn, teardownRequest)
+		responses := readServerResponses(t, conn)
+
+		require.Len(t, responses, 1)
+		assert.Equal(t,
+			"connection: invalid auth token",
+			responses[0].GetAuthenticateResponse().GetErrorMessage())
+	})
+
+	t.Run("accepts token from port file", func(t *testing.T) {
+		conn, err := net.Dial("tcp", addr)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		sendServerRequest(t, conn, &service.ServerRequest{
+			ServerRequestType: &service.ServerRequest_Authenticate{
+				Authenticate: &service.ServerAuthenticateRequest{
+					Token: string(token),
+				},
+			},
+		})
+		sendServerRequest(t, conn, teardownRequest)
+
+		// The teardown request shuts down the server.
+		srv.Wait()
+	})
+}
+
+func TestPortFile_OnlyReadableByOwner(t *testing.T) {
+	portFile := filepath.Join(t.TempDir(), "port")
+	srv, err := server.NewServer(context.Background(), &server.ServerParams{
+		ListenIPAddress: "127.0.0.1:0",
+		PortFilename:    portFile,
+		RequireAuth:     true,
+	})
+	require.NoError(t, err)
+	defer srv.Close()
+
+	info, err := os.Stat(portFile)
+	require.NoError(t, err)
+	if runtime.GOOS != "windows" {
+		assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	}
+}
+
+func TestPipeName_RequiresWindows(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("named pipes are supported on Windows")
+	}
+
+	_, err := server.NewServer(context.Background(), &server.ServerParams{
+		PipeName:     "wandb-core-test",
+		PortFilename: filepath.Join(t.TempDir(), "port"),
+	})
+
+	assert.ErrorContains(t, err, "only supported on Windows")
+}
+
+func TestUnixSocket(t *testing.T) {
+	dir := t.TempDir()
+	socket := filepath.Join(dir, "core.sock")
+	portFile := filepath.Join(dir, "port")
+	srv, err := server.NewServer(context.Background(), &server.ServerParams{
+		UnixSocket:   socket,
+		PortFilename: portFile,
+	})
+	require.NoError(t, err)
+	srv.Start()
+
+	contents, err := os.ReadFile(portFile)
+	require.NoError(t, err)
+	assert.Contains(t, string(contents), "unix="+socket+"\n")
+
+	conn, err := net.Dial("unix", socket)
+	require.NoError(t, err)
+	defer conn.Close()
+	sendServerRequest(t, conn, teardownRequest)
+
+	// The teardown request shuts down the server.
+	srv.Wait()
+	srv.Close()
+	assert.NoFileExists(t, socket)
+}
+
+func TestConnection_RoutesRecordsByStream(t *testing.T) {
+	portFile := filepath.Join(t.TempDir(), "port")
+	srv, err := server.NewServer(context.Background(), &server.ServerParams{
+		ListenIPAddress: "127.0.0.1:0",
+		PortFilename:    portFile,
+	})
+	require.NoError(t, err)
+	srv.Start()
+	defer srv.Close()
+	contents, err := os.ReadFile(portFile)
+	require.NoError(t, err)
+	port := regexp.MustCompile(`sock=(\d+)`).FindSubmatch(contents)[1]
+	conn, err := net.Dial("tcp", "127.0.0.1:"+string(port))
+	require.NoError(t, err)
+	defer conn.Close()
+	defer func() {
+		sendServerRequest(t, conn, teardownRequest)
+		srv.Wait()
+	}()
+
+	// Two runs share the connection; only the first logs a summary.
+	for _, id := range []string{"run-a", "run-b"} {
+		dir := t.TempDir()
+		sendServerRequest(t, conn, &service.ServerRequest{
+			ServerRequestType: &service.ServerRequest_InformInit{
+				InformInit: &service.ServerInformInitRequest{
+					Settings: &service.Settings{
+						ApiKey:      wrapperspb.String("test-key"),
+						RunId:       wrapperspb.String(id),
+						XOffline:    wrapperspb.Bool(true),
+						SyncFile:    wrapperspb.String(filepath.Join(dir, "run.wandb")),
+						FilesDir:    wrapperspb.String(filepath.Join(dir, "files")),
+						LogDir:      wrapperspb.String(dir),
+						LogInternal: wrapperspb.String(filepath.Join(dir, "debug.log")),
+					},
+					XInfo: &service.XRecordInfo{StreamId: id},
+				},
+			},
+		})
+	}
+	// A record for a stream that isn't on the connection goes nowhere.
+	for id, key := range map[string]string{"run-a": "loss", "run-c": "acc"} {
+		sendServerRequest(t, conn, &service.ServerRequest{
+			ServerRequestType: &service.ServerRequest_RecordPublish{
+				RecordPublish: &service.Record{
+					RecordType: &service.Record_Summary{
+						Summary: &service.SummaryRecord{
+							Update: []*service.SummaryItem{{Key: key, ValueJson: "1"}},
+						},
+					},
+					XInfo: &service.XRecordInfo{StreamId: id},
+				},
+			},
+		})
+	}
+	for _, id := range []string{"run-a", "run-b"} {
+		sendServerRequest(t, conn, &service.ServerRequest{
+			ServerRequestType: &service.ServerRequest_RecordCommunicate{
+				RecordCommunicate: &service.Record{
+					RecordType: &service.Record_Request{
+						Request: &service.Request{
+							RequestType: &service.Request_GetSummary{
+								GetSummary: &service.GetSummaryRequest{},
+							},
+						},
+					},
+					Control: &service.Control{MailboxSlot: id},
+					XInfo:   &service.XRecordInfo{StreamId: id},
+				},
+			},
+		})
+	}
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	scanner := bufio.NewScanner(conn)
+	scanner.Split(server.ScanWBRecords)
+	summaries := make(map[string][]string)
+	for len(summaries) < 2 && scanner.Scan() {
+		response := &service.ServerResponse{}
+		require.NoError(t, proto.Unmarshal(scanner.Bytes(), response))
+		result := response.GetResultCommunicate()
+		var keys []string
+		for _, item := range result.GetResponse().GetGetSummaryResponse().GetItem() {
+			if item.GetKey() != "" {
+				keys = append(keys, item.GetKey())
+			}
+		}
+		summaries[result.GetControl().GetMailboxSlot()] = keys
+	}
+	require.NoError(t, scanner.Err())
+
+	assert.Equal(t, []string{"loss"}, summaries["run-a"])
+	assert.Empty(t, summaries["run-b"])
+}
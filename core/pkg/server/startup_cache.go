@@ -0,0 +1,54 @@
+package server
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+
+	"github.com/wandb/wandb/core/internal/settings"
+)
+
+// startupCache remembers what a server said when a stream started, for
+// the rest of the process.
+//
+// Only the first stream for a server and user waits for the server; later
+// streams, including those re-sending or syncing runs, reuse its answer.
+// Failures are remembered too, so that an unreachable server delays only
+// the first stream.
+type startupCache[T any] struct {
+	mu      sync.Mutex
+	entries map[string]*startupCacheEntry[T]
+}
+
+type startupCacheEntry[T any] struct {
+	once  sync.Once
+	value T
+	err   error
+}
+
+// Get returns the cached result for the key, calling fetch if there is
+// none.
+//
+// Concurrent calls for the same key wait for a single fetch.
+func (c *startupCache[T]) Get(key string, fetch func() (T, error)) (T, error) {
+	c.mu.Lock()
+	if c.entries == nil {
+		c.entries = make(map[string]*startupCacheEntry[T])
+	}
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &startupCacheEntry[T]{}
+		c.entries[key] = entry
+	}
+	c.mu.Unlock()
+
+	entry.once.Do(func() { entry.value, entry.err = fetch() })
+	return entry.value, entry.err
+}
+
+// serverCacheKey identifies the server and credentials in the settings.
+func serverCacheKey(settings *settings.Settings) string {
+	credentials := sha256.Sum256([]byte(settings.GetAPIKey()))
+	return settings.Proto.GetBaseUrl().GetValue() + " " +
+		hex.EncodeToString(credentials[:])
+}
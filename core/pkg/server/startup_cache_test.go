@@ -0,0 +1,55 @@
+package server
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStartupCache_FetchesOncePerKey(t *testing.T) {
+	cache := &startupCache[int]{}
+	var mu sync.Mutex
+	fetches := map[string]int{}
+	fetch := func(key string) func() (int, error) {
+		return func() (int, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			fetches[key]++
+			return len(key), nil
+		}
+	}
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			value, err := cache.Get("a", fetch("a"))
+			assert.NoError(t, err)
+			assert.Equal(t, 1, value)
+		}()
+	}
+	wg.Wait()
+	value, _ := cache.Get("bb", fetch("bb"))
+
+	assert.Equal(t, 2, value)
+	assert.Equal(t, map[string]int{"a": 1, "bb": 1}, fetches)
+}
+
+func TestStartupCache_RemembersErrors(t *testing.T) {
+	cache := &startupCache[int]{}
+	calls := 0
+	fetch := func() (int, error) {
+		calls++
+		return 0, errors.New("unreachable")
+	}
+
+	_, err1 := cache.Get("a", fetch)
+	_, err2 := cache.Get("a", fetch)
+
+	assert.Error(t, err1)
+	assert.Equal(t, err1, err2)
+	assert.Equal(t, 1, calls)
+}
@@ -0,0 +1,47 @@
+package server
+
+import (
+	"expvar"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"runtime"
+
+	"github.com/shirou/gopsutil/v4/process"
+)
+
+func init() {
+	expvar.Publish("goroutines", expvar.Func(func() any {
+		return runtime.NumGoroutine()
+	}))
+	expvar.Publish("rss_bytes", expvar.Func(func() any {
+		proc := process.Process{Pid: int32(os.Getpid())}
+		info, err := proc.MemoryInfo()
+		if err != nil {
+			return nil
+		}
+		return info.RSS
+	}))
+}
+
+// ServeStatus serves the process's expvars at /debug/vars on a localhost
+// port, returning the address it listens on.
+//
+// The variables include the memory statistics of the Go runtime, the
+// process's RSS, its goroutine count and the queue depths of its streams,
+// for monitoring long-running services. Nothing else is served, so that
+// the port doesn't expose profiling endpoints.
+func ServeStatus(port int) (net.Addr, error) {
+	listener, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", port))
+	if err != nil {
+		return nil, fmt.Errorf("server: failed to serve status: %v", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/debug/vars", expvar.Handler())
+	go func() {
+		_ = http.Serve(listener, mux)
+	}()
+	return listener.Addr(), nil
+}
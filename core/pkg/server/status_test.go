@@ -0,0 +1,39 @@
+package server_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/pkg/server"
+)
+
+func TestServeStatus(t *testing.T) {
+	addr, err := server.ServeStatus(0)
+	require.NoError(t, err)
+
+	resp, err := http.Get(fmt.Sprintf("http://%s/debug/vars", addr))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	var vars map[string]any
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&vars))
+
+	assert.Contains(t, vars, "streams")
+	assert.Contains(t, vars, "memstats")
+	assert.Greater(t, vars["goroutines"], 0.0)
+	assert.Greater(t, vars["rss_bytes"], 0.0)
+}
+
+func TestServeStatus_NoProfiling(t *testing.T) {
+	addr, err := server.ServeStatus(0)
+	require.NoError(t, err)
+
+	resp, err := http.Get(fmt.Sprintf("http://%s/debug/pprof/", addr))
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
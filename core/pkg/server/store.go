@@ -6,8 +6,11 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"os"
 
+	"github.com/klauspost/compress/zstd"
+	"github.com/wandb/wandb/core/internal/recordcrypt"
 	"github.com/wandb/wandb/core/pkg/leveldb"
 	"github.com/wandb/wandb/core/pkg/service"
 	"google.golang.org/protobuf/proto"
@@ -24,6 +27,16 @@ const (
 	headerMagic = 0xBEE1
 	// headerVersion is the version of the header.
 	headerVersion = 0
+	// headerFlagZstd is set in the version of a log whose records are
+	// compressed with zstd.
+	//
+	// Readers that don't support compression reject such logs because of
+	// their unknown version. In encrypted logs, each record is compressed
+	// before it's encrypted, since encrypted data doesn't compress.
+	headerFlagZstd = 0x80
+	// headerFlagEncrypted is set in the version of a log whose records are
+	// encrypted with recordcrypt.
+	headerFlagEncrypted = 0x40
 )
 
 // headerIdent returns the header identifier.
@@ -59,7 +72,19 @@ func (o *HeaderOptions) UnmarshalBinary(r io.Reader) error {
 
 // Valid checks if the header is valid based on a reference header.
 func (o *HeaderOptions) Valid() bool {
-	return o.IDENT == headerIdent() && o.Magic == headerMagic && o.Version == headerVersion
+	return o.IDENT == headerIdent() &&
+		o.Magic == headerMagic &&
+		o.Version&^(headerFlagZstd|headerFlagEncrypted) == headerVersion
+}
+
+// IsZstd returns whether the log's records are compressed with zstd.
+func (o *HeaderOptions) IsZstd() bool {
+	return o.Version&headerFlagZstd != 0
+}
+
+// IsEncrypted returns whether the log's records are encrypted.
+func (o *HeaderOptions) IsEncrypted() bool {
+	return o.Version&headerFlagEncrypted != 0
 }
 
 // Store is the persistent store for a stream
@@ -78,8 +103,50 @@ type Store struct {
 
 	// db is the underlying database
 	db *os.File
+
+	// logStart is the offset of the record log in the file, after the
+	// header.
+	logStart int64
+
+	// indexed is whether to write a sidecar index when writing.
+	indexed bool
+
+	// indexWriter writes the sidecar index, if enabled.
+	indexWriter *indexWriter
+
+	// index is the sidecar index, loaded when first seeking by step.
+	index []IndexEntry
+
+	// zstd is whether to compress the log when writing.
+	zstd bool
+
+	// encoder compresses the log, if it's compressed and being written.
+	encoder *zstd.Encoder
+
+	// decoder decompresses the log, if it's compressed and being read.
+	decoder *zstd.Decoder
+
+	// cipher encrypts or decrypts the log's records, if it's encrypted.
+	cipher *recordcrypt.Cipher
+
+	// zstdRecords is whether records are compressed one at a time instead
+	// of the whole log, as in compressed logs that are encrypted.
+	zstdRecords bool
+
+	// buf is reused to marshal records, so that writing doesn't allocate.
+	buf []byte
+
+	// sealBuf is reused to encrypt records.
+	sealBuf []byte
+
+	// zstdBuf is reused to compress records.
+	zstdBuf []byte
 }
 
+// maxReusedBufferSize is the largest buffer the store keeps between
+// records, so that one large record doesn't hold on to memory.
+const maxReusedBufferSize = 1 << 20
+
 // NewStore creates a new store
 func NewStore(ctx context.Context, fileName string) *Store {
 	return &Store{ctx: ctx, name: fileName}
@@ -94,7 +161,6 @@ func (sr *Store) Open(flag int) error {
 			return fmt.Errorf("store: failed to open file: %v", err)
 		}
 		sr.db = f
-		sr.reader = leveldb.NewReaderExt(f, leveldb.CRCAlgoIEEE)
 		header := NewHeader()
 		if err := header.UnmarshalBinary(sr.db); err != nil {
 			return fmt.Errorf("store: failed to read header: %v", err)
@@ -102,6 +168,44 @@ func (sr *Store) Open(flag int) error {
 		if !header.Valid() {
 			return errors.New("store: invalid header")
 		}
+		sr.logStart = int64(binary.Size(header))
+
+		if header.IsEncrypted() && sr.cipher == nil {
+			cipher, err := recordcrypt.FromEnv()
+			if err != nil {
+				return fmt.Errorf("store: failed to load encryption key: %v", err)
+			}
+			if cipher == nil {
+				return fmt.Errorf(
+					"store: log is encrypted, but neither %s nor %s is set",
+					recordcrypt.KeyEnv, recordcrypt.KeyCommandEnv)
+			}
+			sr.cipher = cipher
+		}
+
+		if header.IsZstd() && header.IsEncrypted() {
+			decoder, err := zstd.NewReader(nil)
+			if err != nil {
+				return fmt.Errorf("store: failed to create decoder: %v", err)
+			}
+			sr.decoder = decoder
+			sr.zstdRecords = true
+		} else if header.IsZstd() {
+			decoder, err := zstd.NewReader(f)
+			if err != nil {
+				return fmt.Errorf("store: failed to create decoder: %v", err)
+			}
+			sr.decoder = decoder
+			sr.reader = leveldb.NewReaderExt(decoder, leveldb.CRCAlgoIEEE)
+			return nil
+		}
+
+		// Offsets in the log are relative to its start, so that records
+		// can be found by seeking.
+		sr.reader = leveldb.NewReaderExt(
+			io.NewSectionReader(f, sr.logStart, math.MaxInt64-sr.logStart),
+			leveldb.CRCAlgoIEEE,
+		)
 		return nil
 	case os.O_WRONLY:
 		f, err := os.Create(sr.name)
@@ -109,11 +213,45 @@ func (sr *Store) Open(flag int) error {
 			return fmt.Errorf("store: failed to open file: %v", err)
 		}
 		sr.db = f
-		sr.writer = leveldb.NewWriterExt(f, leveldb.CRCAlgoIEEE)
 		header := NewHeader()
+		if sr.zstd {
+			header.Version |= headerFlagZstd
+		}
+		if sr.cipher != nil {
+			header.Version |= headerFlagEncrypted
+		}
 		if err := header.MarshalBinary(sr.db); err != nil {
 			return fmt.Errorf("store: failed to write header: %v", err)
 		}
+		sr.logStart = int64(binary.Size(header))
+
+		switch {
+		case sr.zstd && sr.cipher != nil:
+			encoder, err := zstd.NewWriter(nil)
+			if err != nil {
+				return fmt.Errorf("store: failed to create encoder: %v", err)
+			}
+			sr.encoder = encoder
+			sr.zstdRecords = true
+			sr.writer = leveldb.NewWriterExt(f, leveldb.CRCAlgoIEEE)
+		case sr.zstd:
+			encoder, err := zstd.NewWriter(f)
+			if err != nil {
+				return fmt.Errorf("store: failed to create encoder: %v", err)
+			}
+			sr.encoder = encoder
+			sr.writer = leveldb.NewWriterExt(encoder, leveldb.CRCAlgoIEEE)
+		default:
+			sr.writer = leveldb.NewWriterExt(f, leveldb.CRCAlgoIEEE)
+		}
+
+		if sr.indexed {
+			indexWriter, err := createIndex(IndexPath(sr.name))
+			if err != nil {
+				return err
+			}
+			sr.indexWriter = indexWriter
+		}
 		return nil
 	default:
 		// TODO: generalize this?
@@ -121,10 +259,49 @@ func (sr *Store) Open(flag int) error {
 	}
 }
 
+// EnableIndex makes the store write a sidecar index of history, stats
+// and console records, used by SeekStep.
+//
+// It must be called before opening the store for writing.
+func (sr *Store) EnableIndex() {
+	sr.indexed = true
+}
+
+// EnableZstd makes the store compress the log with zstd.
+//
+// Compressed logs are typically several times smaller, but cannot be
+// read by older clients and don't support seeking, so they're written
+// without an index. It must be called before opening the store for
+// writing.
+func (sr *Store) EnableZstd() {
+	sr.zstd = true
+	sr.indexed = false
+}
+
+// EnableEncryption makes the store encrypt each record with the cipher.
+//
+// Each record is bound to its offset in the log, so records can't be
+// reordered without failing to decrypt. If the log is also compressed,
+// records are compressed one at a time before being encrypted.
+//
+// The sidecar index, if any, is not encrypted; it holds only the kinds,
+// steps and offsets of records. It must be called before opening the store for
+// writing. Readers get the key from the environment.
+func (sr *Store) EnableEncryption(cipher *recordcrypt.Cipher) {
+	sr.cipher = cipher
+}
+
 // Close closes the store
 func (sr *Store) Close() error {
 	errs := []error{}
 
+	if sr.indexWriter != nil {
+		if err := sr.indexWriter.Close(); err != nil {
+			errs = append(errs, err)
+		}
+		sr.indexWriter = nil
+	}
+
 	if sr.writer != nil {
 		err := sr.writer.Close()
 		if err != nil {
@@ -132,6 +309,18 @@ func (sr *Store) Close() error {
 		}
 	}
 
+	if sr.encoder != nil {
+		if err := sr.encoder.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("store: failed closing encoder: %v", err))
+		}
+		sr.encoder = nil
+	}
+
+	if sr.decoder != nil {
+		sr.decoder.Close()
+		sr.decoder = nil
+	}
+
 	db := sr.db
 	sr.db = nil
 
@@ -148,17 +337,52 @@ func (sr *Store) Write(msg *service.Record) error {
 	if err != nil {
 		return fmt.Errorf("store: can't get next record: %v", err)
 	}
-	out, err := proto.Marshal(msg)
+	out, err := proto.MarshalOptions{}.MarshalAppend(sr.buf[:0], msg)
 	if err != nil {
 		return fmt.Errorf("store: can't marshal proto: %v", err)
 	}
+	if cap(out) <= maxReusedBufferSize {
+		sr.buf = out
+	}
+
+	offset, err := sr.writer.LastRecordOffset()
+	if err != nil {
+		return fmt.Errorf("store: can't get record offset: %v", err)
+	}
+	offset -= sr.logStart
+
+	if sr.zstdRecords {
+		out = sr.encoder.EncodeAll(out, sr.zstdBuf[:0])
+		if cap(out) <= maxReusedBufferSize {
+			sr.zstdBuf = out
+		}
+	}
+
+	if sr.cipher != nil {
+		out = sr.cipher.Seal(sr.sealBuf[:0], out, recordAdditionalData(offset))
+		if cap(out) <= maxReusedBufferSize {
+			sr.sealBuf = out
+		}
+	}
 
 	if _, err = writer.Write(out); err != nil {
 		return fmt.Errorf("store: can't write proto: %v", err)
 	}
+
+	if sr.indexWriter != nil {
+		if err := sr.indexWriter.add(msg, offset); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// recordAdditionalData returns the data a record at the offset in the log
+// is bound to when it's encrypted.
+func recordAdditionalData(offset int64) []byte {
+	return binary.LittleEndian.AppendUint64(nil, uint64(offset))
+}
+
 func (sr *Store) WriteDirectlyToDB(data []byte) (int, error) {
 	// this is for testing purposes only
 	return sr.db.Write(data)
@@ -187,6 +411,22 @@ func (sr *Store) Read() (*service.Record, error) {
 		sr.reader.Recover()
 		return nil, fmt.Errorf("store: error reading: %v", err)
 	}
+	if sr.cipher != nil {
+		offset, err := sr.reader.LastRecordOffset()
+		if err != nil {
+			return nil, fmt.Errorf("store: can't get record offset: %v", err)
+		}
+		buf, err = sr.cipher.Open(nil, buf, recordAdditionalData(offset))
+		if err != nil {
+			return nil, fmt.Errorf("store: failed to decrypt: %v", err)
+		}
+	}
+	if sr.zstdRecords {
+		buf, err = sr.decoder.DecodeAll(buf, nil)
+		if err != nil {
+			return nil, fmt.Errorf("store: failed to decompress: %v", err)
+		}
+	}
 	msg := &service.Record{}
 	if err = proto.Unmarshal(buf, msg); err != nil {
 		return nil, fmt.Errorf("store: failed to unmarshal: %v", err)
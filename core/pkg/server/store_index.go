@@ -0,0 +1,185 @@
+package server
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// ErrNoIndex is returned when seeking in a store without an index.
+var ErrNoIndex = errors.New("store: no index")
+
+// IndexKind is the type of an indexed record.
+type IndexKind uint8
+
+const (
+	IndexHistory IndexKind = iota + 1
+	IndexStats
+	IndexConsole
+)
+
+// IndexEntry is the location of a record in a transaction log.
+type IndexEntry struct {
+	Kind IndexKind
+
+	// Step is the step of a history record, or the step of the last
+	// history record before other records.
+	Step int64
+
+	// Offset is the position of the record in the log, suitable for
+	// Store.SeekRecord.
+	Offset int64
+}
+
+// indexIdent identifies an index file.
+var indexIdent = [4]byte{':', 'W', 'B', 'X'}
+
+// indexVersion is the version of the index file format.
+const indexVersion = 0
+
+// IndexPath returns the path of the sidecar index of a transaction log.
+func IndexPath(storePath string) string {
+	return storePath + ".idx"
+}
+
+// indexKindOf returns the index kind of a record, or 0 if it's not indexed.
+func indexKindOf(record *service.Record) IndexKind {
+	switch record.GetRecordType().(type) {
+	case *service.Record_History:
+		return IndexHistory
+	case *service.Record_Stats:
+		return IndexStats
+	case *service.Record_OutputRaw, *service.Record_Output:
+		return IndexConsole
+	default:
+		return 0
+	}
+}
+
+// indexWriter appends entries to a sidecar index.
+type indexWriter struct {
+	file *os.File
+	buf  *bufio.Writer
+
+	// step is the step of the last history record.
+	step int64
+}
+
+func createIndex(path string) (*indexWriter, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("store: failed to create index: %v", err)
+	}
+
+	w := &indexWriter{file: f, buf: bufio.NewWriter(f)}
+	if _, err := w.buf.Write(append(indexIdent[:], indexVersion)); err != nil {
+		f.Close()
+		return nil, fmt.Errorf("store: failed to write index header: %v", err)
+	}
+	return w, nil
+}
+
+// add indexes a record written at the offset.
+func (w *indexWriter) add(record *service.Record, offset int64) error {
+	kind := indexKindOf(record)
+	if kind == 0 {
+		return nil
+	}
+	if kind == IndexHistory {
+		w.step = record.GetHistory().GetStep().GetNum()
+	}
+
+	entry := IndexEntry{Kind: kind, Step: w.step, Offset: offset}
+	if err := binary.Write(w.buf, binary.LittleEndian, entry); err != nil {
+		return fmt.Errorf("store: failed to write index entry: %v", err)
+	}
+	return nil
+}
+
+func (w *indexWriter) Close() error {
+	if err := w.buf.Flush(); err != nil {
+		w.file.Close()
+		return fmt.Errorf("store: failed to flush index: %v", err)
+	}
+	return w.file.Close()
+}
+
+// ReadIndex reads the sidecar index of a transaction log.
+//
+// Returns ErrNoIndex if the log has no index. An entry cut short at the
+// end of the file, such as if the process writing it crashed, is ignored.
+func ReadIndex(storePath string) ([]IndexEntry, error) {
+	f, err := os.Open(IndexPath(storePath))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNoIndex
+	}
+	if err != nil {
+		return nil, fmt.Errorf("store: failed to open index: %v", err)
+	}
+	defer f.Close()
+	r := bufio.NewReader(f)
+
+	header := make([]byte, len(indexIdent)+1)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, fmt.Errorf("store: failed to read index header: %v", err)
+	}
+	if [4]byte(header[:4]) != indexIdent || header[4] != indexVersion {
+		return nil, errors.New("store: invalid index header")
+	}
+
+	var entries []IndexEntry
+	for {
+		var entry IndexEntry
+		err := binary.Read(r, binary.LittleEndian, &entry)
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return entries, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("store: failed to read index: %v", err)
+		}
+		entries = append(entries, entry)
+	}
+}
+
+// SeekStep positions the store so that the next Read returns the first
+// indexed record whose step is at least step.
+//
+// The store must have been opened for reading, and the log must have been
+// written with an index, or ErrNoIndex is returned. Returns io.EOF if no
+// record has a large enough step.
+func (sr *Store) SeekStep(step int64) error {
+	if sr.index == nil {
+		index, err := ReadIndex(sr.name)
+		if err != nil {
+			return err
+		}
+		sr.index = index
+	}
+
+	i := sort.Search(len(sr.index), func(i int) bool {
+		return sr.index[i].Step >= step
+	})
+	if i == len(sr.index) {
+		return io.EOF
+	}
+
+	return sr.SeekRecord(sr.index[i].Offset)
+}
+
+// SeekRecord positions the store so that the next Read returns the record
+// at the offset, as given by an IndexEntry.
+func (sr *Store) SeekRecord(offset int64) error {
+	if sr.reader == nil {
+		return errors.New("store: not open for reading")
+	}
+	if err := sr.reader.SeekRecord(offset); err != nil {
+		return fmt.Errorf("store: failed to seek: %v", err)
+	}
+	return nil
+}
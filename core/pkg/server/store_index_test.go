@@ -0,0 +1,106 @@
+package server_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// writeIndexedStore writes a log with a history record per step and a
+// stats record after every tenth step.
+func writeIndexedStore(t *testing.T, steps int) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "run.wandb")
+	store := server.NewStore(context.Background(), path)
+	store.EnableIndex()
+	require.NoError(t, store.Open(os.O_WRONLY))
+
+	// Large records span several blocks of the log.
+	padding := strings.Repeat("x", 1000)
+	for step := 0; step < steps; step++ {
+		require.NoError(t, store.Write(&service.Record{
+			RecordType: &service.Record_History{
+				History: &service.HistoryRecord{
+					Step: &service.HistoryStep{Num: int64(step)},
+					Item: []*service.HistoryItem{
+						{Key: "text", ValueJson: `"` + padding + `"`},
+					},
+				},
+			},
+		}))
+		if step%10 == 0 {
+			require.NoError(t, store.Write(&service.Record{
+				RecordType: &service.Record_Stats{Stats: &service.StatsRecord{}},
+			}))
+		}
+		require.NoError(t, store.Write(&service.Record{
+			RecordType: &service.Record_Config{Config: &service.ConfigRecord{}},
+		}))
+	}
+
+	require.NoError(t, store.Close())
+	return path
+}
+
+func TestReadIndex(t *testing.T) {
+	path := writeIndexedStore(t, 20)
+
+	entries, err := server.ReadIndex(path)
+
+	require.NoError(t, err)
+	require.Len(t, entries, 22)
+	assert.Equal(t, server.IndexHistory, entries[0].Kind)
+	assert.Equal(t, server.IndexStats, entries[1].Kind)
+	assert.EqualValues(t, 0, entries[1].Step)
+	assert.EqualValues(t, 10, entries[12].Step)
+	assert.Equal(t, server.IndexStats, entries[12].Kind)
+}
+
+func TestReadIndex_IgnoresTruncatedEntry(t *testing.T) {
+	path := writeIndexedStore(t, 3)
+	info, err := os.Stat(server.IndexPath(path))
+	require.NoError(t, err)
+	require.NoError(t, os.Truncate(server.IndexPath(path), info.Size()-1))
+
+	entries, err := server.ReadIndex(path)
+
+	require.NoError(t, err)
+	assert.Len(t, entries, 3)
+}
+
+func TestSeekStep(t *testing.T) {
+	path := writeIndexedStore(t, 200)
+	store := server.NewStore(context.Background(), path)
+	require.NoError(t, store.Open(os.O_RDONLY))
+	defer store.Close()
+
+	require.NoError(t, store.SeekStep(150))
+	record, err := store.Read()
+
+	require.NoError(t, err)
+	assert.EqualValues(t, 150, record.GetHistory().GetStep().GetNum())
+	assert.ErrorIs(t, store.SeekStep(1000), io.EOF)
+}
+
+func TestSeekStep_NoIndex(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "run.wandb")
+	store := server.NewStore(context.Background(), path)
+	require.NoError(t, store.Open(os.O_WRONLY))
+	require.NoError(t, store.Close())
+
+	store = server.NewStore(context.Background(), path)
+	require.NoError(t, store.Open(os.O_RDONLY))
+	defer store.Close()
+
+	assert.ErrorIs(t, store.SeekStep(0), server.ErrNoIndex)
+	_, err := os.Stat(server.IndexPath(path))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
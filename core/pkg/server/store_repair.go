@@ -0,0 +1,126 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// RepairReport describes what was recovered from a damaged log.
+type RepairReport struct {
+	// Records is the number of records recovered.
+	Records int
+
+	// Errors are the problems found while reading the log, each of which
+	// lost one or more records.
+	Errors []error
+
+	// Truncated is whether the damage extends to the end of the log, as
+	// happens if the process writing it crashed.
+	Truncated bool
+}
+
+// Damaged returns whether any records were lost.
+func (r RepairReport) Damaged() bool {
+	return len(r.Errors) > 0
+}
+
+// maxRepairErrors is the number of errors after which Repair gives up,
+// in case the reader stops making progress.
+const maxRepairErrors = 100000
+
+// Repair copies the readable records of the log at src to a new log at
+// dst, skipping over damaged parts of src.
+//
+// Uncompressed logs are recovered past any damage, since their records
+// are stored in fixed-size blocks that can be read independently. For
+// compressed logs, only the records before the first damage are recovered.
+// The new log is compressed and indexed like the original.
+func Repair(src, dst string) (RepairReport, error) {
+	report := RepairReport{}
+
+	reader := NewStore(context.Background(), src)
+	if err := reader.Open(os.O_RDONLY); err != nil {
+		return report, err
+	}
+	defer reader.Close()
+
+	writer := NewStore(context.Background(), dst)
+	if reader.cipher != nil {
+		writer.EnableEncryption(reader.cipher)
+	}
+	if reader.decoder != nil {
+		writer.EnableZstd()
+	} else if _, err := os.Stat(IndexPath(src)); err == nil {
+		writer.EnableIndex()
+	}
+	if err := writer.Open(os.O_WRONLY); err != nil {
+		return report, err
+	}
+
+	for {
+		record, err := reader.Read()
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			report.Errors = append(report.Errors, err)
+			report.Truncated = true
+
+			// Decompression can't continue after damaged data.
+			if reader.decoder != nil || len(report.Errors) >= maxRepairErrors {
+				break
+			}
+			continue
+		}
+		report.Truncated = false
+
+		if err := writer.Write(record); err != nil {
+			writer.Close()
+			return report, err
+		}
+		report.Records++
+	}
+
+	return report, writer.Close()
+}
+
+// RepairInPlace repairs the log at path, keeping the original file next
+// to it with a ".corrupt" suffix if any records were lost.
+//
+// The log is left unchanged if it's not damaged.
+func RepairInPlace(path string) (RepairReport, error) {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return RepairReport{}, err
+	}
+	tmp.Close()
+	defer func() {
+		_ = os.Remove(tmp.Name())
+		_ = os.Remove(IndexPath(tmp.Name()))
+	}()
+
+	report, err := Repair(path, tmp.Name())
+	if err != nil || !report.Damaged() {
+		return report, err
+	}
+
+	if err := os.Rename(path, path+".corrupt"); err != nil {
+		return report, fmt.Errorf("store: failed to keep original: %v", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return report, fmt.Errorf("store: failed to replace log: %v", err)
+	}
+	if _, err := os.Stat(IndexPath(tmp.Name())); err == nil {
+		if err := os.Rename(IndexPath(tmp.Name()), IndexPath(path)); err != nil {
+			return report, fmt.Errorf("store: failed to replace index: %v", err)
+		}
+	}
+
+	return report, nil
+}
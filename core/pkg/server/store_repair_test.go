@@ -0,0 +1,99 @@
+package server_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+func readAllRecords(t *testing.T, path string) []*service.Record {
+	t.Helper()
+	store := server.NewStore(context.Background(), path)
+	require.NoError(t, store.Open(os.O_RDONLY))
+	defer store.Close()
+
+	var records []*service.Record
+	for {
+		record, err := store.Read()
+		if err != nil {
+			return records
+		}
+		records = append(records, record)
+	}
+}
+
+func TestRepair_TruncatedLog(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "run.wandb")
+	writeNumberedRecords(t, path, 100, false)
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.NoError(t, os.Truncate(path, info.Size()-5))
+
+	report, err := server.Repair(path, filepath.Join(dir, "repaired.wandb"))
+
+	require.NoError(t, err)
+	assert.Equal(t, 99, report.Records)
+	assert.True(t, report.Damaged())
+	assert.True(t, report.Truncated)
+	assert.Len(t, readAllRecords(t, filepath.Join(dir, "repaired.wandb")), 99)
+}
+
+func TestRepair_RecoversPastDamage(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "run.wandb")
+	// Enough records to fill several 32KiB blocks.
+	writeNumberedRecords(t, path, 10000, false)
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	copy(data[1000:], make([]byte, 100))
+	require.NoError(t, os.WriteFile(path, data, 0600))
+
+	report, err := server.Repair(path, filepath.Join(dir, "repaired.wandb"))
+
+	require.NoError(t, err)
+	assert.True(t, report.Damaged())
+	assert.False(t, report.Truncated)
+	assert.Greater(t, report.Records, 8000)
+	assert.Less(t, report.Records, 10000)
+	records := readAllRecords(t, filepath.Join(dir, "repaired.wandb"))
+	assert.EqualValues(t, 9999, records[len(records)-1].GetNum())
+}
+
+func TestRepairInPlace(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "run.wandb")
+	writeNumberedRecords(t, path, 10, false)
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.NoError(t, os.Truncate(path, info.Size()-5))
+
+	report, err := server.RepairInPlace(path)
+
+	require.NoError(t, err)
+	assert.Equal(t, 9, report.Records)
+	assert.Len(t, readAllRecords(t, path), 9)
+	assert.FileExists(t, path+".corrupt")
+	assert.FileExists(t, server.IndexPath(path))
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, files, 3)
+}
+
+func TestRepairInPlace_UndamagedLog(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "run.wandb")
+	writeNumberedRecords(t, path, 10, true)
+
+	report, err := server.RepairInPlace(path)
+
+	require.NoError(t, err)
+	assert.False(t, report.Damaged())
+	assert.Equal(t, 10, report.Records)
+	assert.NoFileExists(t, path+".corrupt")
+}
@@ -2,11 +2,16 @@ package server_test
 
 import (
 	"context"
+	"encoding/base64"
 	"io"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/recordcrypt"
 	"github.com/wandb/wandb/core/pkg/server"
 	"github.com/wandb/wandb/core/pkg/service"
 )
@@ -230,3 +235,148 @@ func TestReadFromClosedStore(t *testing.T) {
 	_, err = store.Read()
 	assert.Error(t, err, "can't read record")
 }
+
+// writeNumberedRecords writes a log of n records, optionally compressed,
+// and returns its size.
+func writeNumberedRecords(t *testing.T, path string, n int, compress bool) int64 {
+	t.Helper()
+	store := server.NewStore(context.Background(), path)
+	store.EnableIndex()
+	if compress {
+		store.EnableZstd()
+	}
+	assert.NoError(t, store.Open(os.O_WRONLY))
+	for i := 0; i < n; i++ {
+		err := store.Write(&service.Record{Num: int64(i), Uuid: "compressible-uuid"})
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, store.Close())
+
+	info, err := os.Stat(path)
+	assert.NoError(t, err)
+	return info.Size()
+}
+
+func TestZstdStore(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "run.wandb")
+
+	size := writeNumberedRecords(t, path, 1000, true)
+	uncompressedSize := writeNumberedRecords(t,
+		filepath.Join(dir, "uncompressed.wandb"), 1000, false)
+
+	assert.Less(t, size*2, uncompressedSize)
+	_, err := os.Stat(server.IndexPath(path))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	store := server.NewStore(context.Background(), path)
+	assert.NoError(t, store.Open(os.O_RDONLY))
+	defer store.Close()
+	for i := 0; i < 1000; i++ {
+		record, err := store.Read()
+		assert.NoError(t, err)
+		assert.EqualValues(t, i, record.GetNum())
+	}
+	_, err = store.Read()
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestZstdHeader(t *testing.T) {
+	header := server.NewHeader()
+	assert.False(t, header.IsZstd())
+
+	header.Version |= 0x80
+
+	assert.True(t, header.Valid())
+	assert.True(t, header.IsZstd())
+}
+
+func TestEncryptedStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "run.wandb")
+	t.Setenv(recordcrypt.KeyEnv,
+		base64.StdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef")))
+
+	cipher, err := recordcrypt.FromEnv()
+	require.NoError(t, err)
+	store := server.NewStore(context.Background(), path)
+	store.EnableEncryption(cipher)
+	require.NoError(t, store.Open(os.O_WRONLY))
+	require.NoError(t, store.Write(&service.Record{Uuid: "secret-uuid"}))
+	require.NoError(t, store.Close())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "secret-uuid")
+
+	store = server.NewStore(context.Background(), path)
+	require.NoError(t, store.Open(os.O_RDONLY))
+	record, err := store.Read()
+	assert.NoError(t, err)
+	assert.Equal(t, "secret-uuid", record.GetUuid())
+	_ = store.Close()
+
+	t.Setenv(recordcrypt.KeyEnv, "")
+	store = server.NewStore(context.Background(), path)
+	assert.ErrorContains(t, store.Open(os.O_RDONLY),
+		"neither WANDB_STORE_ENCRYPTION_KEY nor WANDB_STORE_ENCRYPTION_KEY_COMMAND")
+}
+
+// writeEncryptedRecords writes an encrypted log of n records, optionally
+// compressed, and returns its size.
+func writeEncryptedRecords(t *testing.T, path string, n int, compress bool) int64 {
+	t.Helper()
+	cipher, err := recordcrypt.New([]byte("0123456789abcdef"))
+	require.NoError(t, err)
+
+	store := server.NewStore(context.Background(), path)
+	if compress {
+		store.EnableZstd()
+	}
+	store.EnableEncryption(cipher)
+	require.NoError(t, store.Open(os.O_WRONLY))
+	for i := 0; i < n; i++ {
+		err := store.Write(&service.Record{
+			Num:  int64(i),
+			Uuid: strings.Repeat("compressible-uuid", 10),
+		})
+		require.NoError(t, err)
+	}
+	require.NoError(t, store.Close())
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	return info.Size()
+}
+
+func TestEncryptedZstdStore(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "run.wandb")
+	t.Setenv(recordcrypt.KeyEnv,
+		base64.StdEncoding.EncodeToString([]byte("0123456789abcdef")))
+
+	size := writeEncryptedRecords(t, path, 100, true)
+	uncompressedSize := writeEncryptedRecords(t,
+		filepath.Join(dir, "uncompressed.wandb"), 100, false)
+
+	assert.Less(t, size*2, uncompressedSize)
+	store := server.NewStore(context.Background(), path)
+	require.NoError(t, store.Open(os.O_RDONLY))
+	defer store.Close()
+	for i := 0; i < 100; i++ {
+		record, err := store.Read()
+		require.NoError(t, err)
+		assert.EqualValues(t, i, record.GetNum())
+	}
+	_, err := store.Read()
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestEncryptedHeader(t *testing.T) {
+	header := server.NewHeader()
+	assert.False(t, header.IsEncrypted())
+
+	header.Version |= 0x40
+
+	assert.True(t, header.Valid())
+	assert.True(t, header.IsEncrypted())
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/wandb/wandb/core/internal/mailbox"
 	"github.com/wandb/wandb/core/internal/paths"
 	"github.com/wandb/wandb/core/internal/runfiles"
+	"github.com/wandb/wandb/core/internal/runhistory"
 	"github.com/wandb/wandb/core/internal/runmetric"
 	"github.com/wandb/wandb/core/internal/runsummary"
 	"github.com/wandb/wandb/core/internal/sentry_ext"
@@ -28,6 +29,7 @@ import (
 	"github.com/wandb/wandb/core/pkg/observability"
 	"github.com/wandb/wandb/core/pkg/service"
 	"github.com/wandb/wandb/core/pkg/utils"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
@@ -65,8 +67,15 @@ type Stream struct {
 	// sender is the sender for the stream
 	sender *Sender
 
+	// recordSpill keeps the records waiting to be sent within a memory
+	// limit
+	recordSpill *RecordSpill
+
 	// inChan is the channel for incoming messages
-	inChan chan *service.Record
+	inChan chan queuedRecord
+
+	// budget limits the incoming messages waiting to be handled
+	budget *StreamBudget
 
 	// loopBackChan is the channel for internal loopback messages
 	loopBackChan chan *service.Record
@@ -82,9 +91,19 @@ type Stream struct {
 
 	// sentryClient is the client used to report errors to sentry.io
 	sentryClient *sentry_ext.Client
+
+	// tracer exports spans of the stream's work, or is nil
+	tracer *observability.Tracer
+
+	// logLevel is the level of the stream's debug log
+	logLevel *slog.LevelVar
 }
 
-func streamLogger(settings *settings.Settings, sentryClient *sentry_ext.Client) *observability.CoreLogger {
+func streamLogger(
+	settings *settings.Settings,
+	sentryClient *sentry_ext.Client,
+	level *slog.LevelVar,
+) *observability.CoreLogger {
 	// TODO: when we add session concept re-do this to use user provided path
 	targetPath := filepath.Join(settings.GetLogDir(), "debug-core.log")
 	if path := defaultLoggerPath.Load(); path != nil {
@@ -108,10 +127,13 @@ func streamLogger(settings *settings.Settings, sentryClient *sentry_ext.Client)
 	}
 	writer := io.MultiWriter(writers...)
 
-	// TODO: add a log level to the settings
-	level := slog.LevelInfo
+	level.Set(slog.LevelInfo)
 	if os.Getenv("WANDB_CORE_DEBUG") != "" {
-		level = slog.LevelDebug
+		level.Set(slog.LevelDebug)
+	}
+	var logLevelErr error
+	if logLevel := settings.GetCoreLogLevel(); logLevel != "" {
+		logLevelErr = level.UnmarshalText([]byte(logLevel))
 	}
 
 	opts := &slog.HandlerOptions{
@@ -133,6 +155,9 @@ func streamLogger(settings *settings.Settings, sentryClient *sentry_ext.Client)
 	)
 	logger.Info("using version", "core version", version.Version)
 	logger.Info("created symlink", "path", targetPath)
+	if logLevelErr != nil {
+		logger.Warn("stream: invalid log level", "error", logLevelErr)
+	}
 
 	tags := observability.Tags{
 		"run_id":   settings.GetRunID(),
@@ -151,13 +176,23 @@ func streamLogger(settings *settings.Settings, sentryClient *sentry_ext.Client)
 // NewStream creates a new stream with the given settings and responders.
 func NewStream(settings *settings.Settings, _ string, sentryClient *sentry_ext.Client) *Stream {
 	ctx, cancel := context.WithCancel(context.Background())
+	maxQueuedRecords := settings.GetStreamMaxQueuedRecords()
+	if maxQueuedRecords <= 0 {
+		maxQueuedRecords = BufferSize
+	}
+	logLevel := &slog.LevelVar{}
 	s := &Stream{
-		ctx:          ctx,
-		cancel:       cancel,
-		logger:       streamLogger(settings, sentryClient),
-		wg:           sync.WaitGroup{},
-		settings:     settings,
-		inChan:       make(chan *service.Record, BufferSize),
+		ctx:      ctx,
+		cancel:   cancel,
+		logger:   streamLogger(settings, sentryClient, logLevel),
+		logLevel: logLevel,
+		wg:       sync.WaitGroup{},
+		settings: settings,
+		inChan:   make(chan queuedRecord, maxQueuedRecords),
+		budget: NewStreamBudget(
+			maxQueuedRecords,
+			settings.GetStreamMaxQueuedBytes(),
+		),
 		loopBackChan: make(chan *service.Record, BufferSize),
 		outChan:      make(chan *service.ServerResponse, BufferSize),
 		closed:       &atomic.Bool{},
@@ -175,6 +210,8 @@ func NewStream(settings *settings.Settings, _ string, sentryClient *sentry_ext.C
 		hostname = ""
 	}
 
+	s.tracer = NewTracer(s.ctx, s.logger, settings)
+
 	// TODO: replace this with a logger that can be read by the user
 	peeker := &observability.Peeker{}
 	terminalPrinter := observability.NewPrinter()
@@ -192,18 +229,35 @@ func NewStream(settings *settings.Settings, _ string, sentryClient *sentry_ext.C
 	var fileStreamOrNil filestream.FileStream
 	var fileTransferManagerOrNil filetransfer.FileTransferManager
 	var runfilesUploaderOrNil runfiles.Uploader
+	mediaRetention := NewMediaRetention(s.logger, settings)
+	stopRequested := &atomic.Bool{}
 	if backendOrNil != nil {
-		graphqlClientOrNil = NewGraphQLClient(backendOrNil, settings, peeker)
+		graphqlClientOrNil = NewGraphQLClient(backendOrNil, s.logger, settings, peeker)
+		ApplyOrgDefaults(s.ctx, s.logger, settings, graphqlClientOrNil)
+		NegotiateServerFeatures(
+			s.ctx,
+			s.logger,
+			terminalPrinter,
+			settings,
+			graphqlClientOrNil,
+		)
 		fileStreamOrNil = NewFileStream(
 			backendOrNil,
 			s.logger,
 			terminalPrinter,
 			settings,
 			peeker,
+			s.tracer,
+			func(control filestream.RunControl) {
+				if control.Stop {
+					stopRequested.Store(true)
+				}
+			},
 		)
 		fileTransferManagerOrNil = NewFileTransferManager(
 			fileTransferStats,
 			s.logger,
+			terminalPrinter,
 			settings,
 		)
 		runfilesUploaderOrNil = NewRunfilesUploader(
@@ -214,12 +268,27 @@ func NewStream(settings *settings.Settings, _ string, sentryClient *sentry_ext.C
 			fileTransferManagerOrNil,
 			fileWatcher,
 			graphqlClientOrNil,
+			mediaRetention,
 		)
 	}
 
 	mailbox := mailbox.NewMailbox()
 	metricHandler := runmetric.NewMetricHandler()
 
+	// History is aggregated either before it's written to the transaction
+	// log, or only before it's uploaded if the raw history should be kept.
+	var handlerAggregator, senderAggregator *runhistory.Aggregator
+	if settings.IsHistoryAggregationKeepRaw() {
+		senderAggregator = NewHistoryAggregator(s.logger, settings)
+	} else {
+		handlerAggregator = NewHistoryAggregator(s.logger, settings)
+	}
+
+	runSummary := runsummary.New(runsummary.Params{
+		MetricHandler:  metricHandler,
+		SkipAggregates: settings.IsServerSideSummary(),
+	})
+
 	s.handler = NewHandler(s.ctx,
 		HandlerParams{
 			Logger:            s.logger,
@@ -230,10 +299,14 @@ func NewStream(settings *settings.Settings, _ string, sentryClient *sentry_ext.C
 			RunfilesUploader:  runfilesUploaderOrNil,
 			TBHandler:         tbHandler,
 			FileTransferStats: fileTransferStats,
-			RunSummary:        runsummary.New(runsummary.Params{MetricHandler: metricHandler}),
+			RunSummary:        runSummary,
 			MetricHandler:     metricHandler,
 			Mailbox:           mailbox,
 			TerminalPrinter:   terminalPrinter,
+			HistoryAggregator: handlerAggregator,
+			MediaRetention:    mediaRetention,
+			Tracer:            s.tracer,
+			LogLevel:          s.logLevel,
 		},
 	)
 
@@ -242,6 +315,7 @@ func NewStream(settings *settings.Settings, _ string, sentryClient *sentry_ext.C
 			Logger:   s.logger,
 			Settings: s.settings.Proto,
 			FwdChan:  make(chan *service.Record, BufferSize),
+			Tracer:   s.tracer,
 		},
 	)
 
@@ -278,9 +352,21 @@ func NewStream(settings *settings.Settings, _ string, sentryClient *sentry_ext.C
 			OutChan:             make(chan *service.Result, BufferSize),
 			Mailbox:             mailbox,
 			OutputFileName:      outputFile,
+			HistoryAggregator:   senderAggregator,
+			Tracer:              s.tracer,
+			StopRequested:       stopRequested,
 		},
 	)
 
+	s.recordSpill = &RecordSpill{
+		MaxBytes: DefaultMaxBufferedRecordBytes,
+		Dir:      settings.Proto.GetTmpDir().GetValue(),
+		Logger:   s.logger,
+	}
+	if maxBytes := settings.GetStreamMaxBufferedBytes(); maxBytes > 0 {
+		s.recordSpill.MaxBytes = maxBytes
+	}
+
 	s.dispatcher = NewDispatcher(s.logger)
 
 	s.logger.Info("created new stream", "id", s.settings.GetRunID())
@@ -301,15 +387,20 @@ func (s *Stream) Start() {
 	s.wg.Add(1)
 	go func() {
 		wg := sync.WaitGroup{}
-		for _, ch := range []chan *service.Record{s.inChan, s.loopBackChan} {
-			wg.Add(1)
-			go func(ch chan *service.Record) {
-				for record := range ch {
-					fwdChan <- record
-				}
-				wg.Done()
-			}(ch)
-		}
+		wg.Add(2)
+		go func() {
+			for queued := range s.inChan {
+				s.budget.Release(queued.size)
+				fwdChan <- queued.record
+			}
+			wg.Done()
+		}()
+		go func() {
+			for record := range s.loopBackChan {
+				fwdChan <- record
+			}
+			wg.Done()
+		}()
 		wg.Wait()
 		close(fwdChan)
 		s.wg.Done()
@@ -329,10 +420,11 @@ func (s *Stream) Start() {
 		s.wg.Done()
 	}()
 
-	// send the data to the server
+	// send the data to the server, buffering it on disk if it's logged
+	// faster than it can be sent
 	s.wg.Add(1)
 	go func() {
-		s.sender.Do(s.writer.fwdChan)
+		s.sender.Do(s.recordSpill.Relay(s.writer.fwdChan))
 		s.wg.Done()
 	}()
 
@@ -354,6 +446,31 @@ func (s *Stream) Start() {
 		s.wg.Done()
 	}()
 	s.logger.Debug("starting stream", "id", s.settings.GetRunID())
+
+	// Journal the run in the outbox until all its data is sent, and re-send
+	// any runs that earlier processes didn't finish sending.
+	if syncFile := s.settings.GetSyncFile(); syncFile != "" &&
+		!s.settings.IsOffline() && !s.settings.IsSync() {
+		if err := markPending(syncFile); err != nil {
+			s.logger.CaptureError(err)
+		}
+
+		go resendAbandonedRuns(
+			s.logger,
+			s.settings,
+			filepath.Dir(filepath.Dir(syncFile)),
+			s.sentryClient,
+		)
+	}
+
+	// Runs logged without credentials wait in the outbox to be flushed.
+	if syncFile := s.settings.GetSyncFile(); syncFile != "" &&
+		s.settings.IsOffline() && s.settings.IsDeferAuth() &&
+		s.settings.GetAPIKey() == "" {
+		if err := markDeferred(syncFile); err != nil {
+			s.logger.CaptureError(err)
+		}
+	}
 }
 
 // HandleRecord handles the given record by sending it to the stream's handler.
@@ -364,7 +481,45 @@ func (s *Stream) HandleRecord(rec *service.Record) {
 		s.logger.Error("context done, not handling record", "record", rec)
 		return
 	}
-	s.inChan <- rec
+
+	// Computing the size walks the whole record, so it's done only once.
+	size := int64(proto.Size(rec))
+	if s.budget.Acquire(size) {
+		// Only log the first time to avoid flooding the log.
+		if usage := s.budget.Usage(); usage.Throttles == 1 {
+			s.logger.Warn(
+				"stream: records arriving faster than they can be processed,"+
+					" making the client wait",
+				"max_queued_records", usage.MaxQueuedRecords,
+				"max_queued_bytes", usage.MaxQueuedBytes,
+			)
+		}
+	}
+	s.inChan <- queuedRecord{record: rec, size: size}
+}
+
+// queuedRecord is a record from a client waiting to be handled.
+type queuedRecord struct {
+	record *service.Record
+
+	// size is the record's size in the stream's budget.
+	size int64
+}
+
+// Usage returns the stream's resource use.
+func (s *Stream) Usage() StreamUsage {
+	return s.budget.Usage()
+}
+
+func (s *Stream) logStreamUsage() {
+	usage := s.Usage()
+	s.logger.Info(
+		"stream: resource use",
+		"peak_queued_records", usage.PeakQueuedRecords,
+		"peak_queued_bytes", usage.PeakQueuedBytes,
+		"throttles", usage.Throttles,
+		"throttle_time", usage.ThrottleTime,
+	)
 }
 
 // Close Gracefully wait for handler, writer, sender, dispatcher to shut down cleanly
@@ -372,11 +527,34 @@ func (s *Stream) HandleRecord(rec *service.Record) {
 func (s *Stream) Close() {
 	// wait for the context to be canceled in the defer state machine in the sender
 	<-s.ctx.Done()
+	s.budget.Close()
+	s.logStreamUsage()
 	if !s.closed.Swap(true) {
 		close(s.loopBackChan)
 		close(s.inChan)
 	}
 	s.wg.Wait()
+
+	if s.tracer != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), tracerShutdownTimeout)
+		if err := s.tracer.Shutdown(ctx); err != nil {
+			s.logger.Warn("stream: failed to export traces", "error", err)
+		}
+		cancel()
+	}
+
+	// Runs that weren't fully uploaded stay in the outbox, so that
+	// `wandb-core flush` or a later run re-sends them from the log.
+	if syncFile := s.settings.GetSyncFile(); syncFile != "" &&
+		!s.settings.IsOffline() && !s.settings.IsSync() {
+		if !s.sender.uploaded {
+			s.logger.Warn(
+				"stream: run was not fully uploaded, leaving it in the outbox",
+				"path", syncFile)
+		} else if err := markSent(syncFile); err != nil {
+			s.logger.CaptureError(err)
+		}
+	}
 }
 
 // Respond Handle internal responses like from the finish and close path
@@ -0,0 +1,122 @@
+package server
+
+import (
+	"sync"
+	"time"
+)
+
+// StreamUsage is a snapshot of a stream's resource use.
+type StreamUsage struct {
+	// Records waiting to be processed, and their total size in bytes.
+	QueuedRecords int
+	QueuedBytes   int64
+
+	// The largest values of QueuedRecords and QueuedBytes so far.
+	PeakQueuedRecords int
+	PeakQueuedBytes   int64
+
+	// The stream's limits; zero means no limit.
+	MaxQueuedRecords int
+	MaxQueuedBytes   int64
+
+	// How many times, and for how long in total, the client had to wait
+	// because the stream was over its limits.
+	Throttles    int
+	ThrottleTime time.Duration
+}
+
+// StreamBudget limits the records a stream can have waiting to be
+// processed.
+//
+// When a stream is over budget, only that stream's clients are made to
+// wait, so that a run producing data faster than it can be processed
+// doesn't use up memory that other runs in the same process need.
+type StreamBudget struct {
+	mu   sync.Mutex
+	cond *sync.Cond
+
+	usage  StreamUsage
+	closed bool
+}
+
+// NewStreamBudget returns a budget allowing up to maxRecords records and
+// maxBytes bytes to be queued. Non-positive limits mean no limit.
+func NewStreamBudget(maxRecords int, maxBytes int64) *StreamBudget {
+	budget := &StreamBudget{
+		usage: StreamUsage{
+			MaxQueuedRecords: max(0, maxRecords),
+			MaxQueuedBytes:   max(0, maxBytes),
+		},
+	}
+	budget.cond = sync.NewCond(&budget.mu)
+	return budget
+}
+
+// isFull returns whether adding a record of the size would exceed the
+// budget.
+//
+// A record larger than the byte limit is allowed if nothing is queued,
+// since otherwise it could never be accepted.
+func (b *StreamBudget) isFull(size int64) bool {
+	usage := &b.usage
+
+	if usage.MaxQueuedRecords > 0 && usage.QueuedRecords >= usage.MaxQueuedRecords {
+		return true
+	}
+
+	return usage.MaxQueuedBytes > 0 &&
+		usage.QueuedRecords > 0 &&
+		usage.QueuedBytes+size > usage.MaxQueuedBytes
+}
+
+// Acquire adds a record of the given size to the queue, first waiting
+// until there's room for it.
+//
+// Returns whether it had to wait.
+func (b *StreamBudget) Acquire(size int64) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	throttled := false
+	if !b.closed && b.isFull(size) {
+		throttled = true
+		start := time.Now()
+		for !b.closed && b.isFull(size) {
+			b.cond.Wait()
+		}
+		b.usage.Throttles++
+		b.usage.ThrottleTime += time.Since(start)
+	}
+
+	b.usage.QueuedRecords++
+	b.usage.QueuedBytes += size
+	b.usage.PeakQueuedRecords = max(b.usage.PeakQueuedRecords, b.usage.QueuedRecords)
+	b.usage.PeakQueuedBytes = max(b.usage.PeakQueuedBytes, b.usage.QueuedBytes)
+	return throttled
+}
+
+// Release removes a record of the given size from the queue.
+func (b *StreamBudget) Release(size int64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.usage.QueuedRecords--
+	b.usage.QueuedBytes -= size
+	b.cond.Broadcast()
+}
+
+// Close stops the budget from making anyone wait.
+func (b *StreamBudget) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+	b.cond.Broadcast()
+}
+
+// Usage returns the current resource use.
+func (b *StreamBudget) Usage() StreamUsage {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.usage
+}
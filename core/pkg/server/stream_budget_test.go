@@ -0,0 +1,80 @@
+package server_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wandb/wandb/core/pkg/server"
+)
+
+// acquireAsync calls Acquire on a goroutine and returns a channel that's
+// closed once it returns.
+func acquireAsync(budget *server.StreamBudget, size int64) <-chan struct{} {
+	done := make(chan struct{})
+	go func() {
+		budget.Acquire(size)
+		close(done)
+	}()
+	return done
+}
+
+func assertBlocked(t *testing.T, done <-chan struct{}) {
+	t.Helper()
+	select {
+	case <-done:
+		t.Fatal("expected Acquire to wait")
+	case <-time.After(20 * time.Millisecond):
+	}
+}
+
+func assertUnblocked(t *testing.T, done <-chan struct{}) {
+	t.Helper()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("expected Acquire to return")
+	}
+}
+
+func TestStreamBudget_MaxRecords(t *testing.T) {
+	budget := server.NewStreamBudget(2, 0)
+	assert.False(t, budget.Acquire(1))
+	assert.False(t, budget.Acquire(1))
+
+	done := acquireAsync(budget, 1)
+	assertBlocked(t, done)
+	budget.Release(1)
+	assertUnblocked(t, done)
+
+	usage := budget.Usage()
+	assert.Equal(t, 2, usage.QueuedRecords)
+	assert.Equal(t, 2, usage.PeakQueuedRecords)
+	assert.Equal(t, 1, usage.Throttles)
+	assert.Positive(t, usage.ThrottleTime)
+}
+
+func TestStreamBudget_MaxBytes(t *testing.T) {
+	budget := server.NewStreamBudget(0, 100)
+	budget.Acquire(500) // allowed when nothing is queued
+
+	done := acquireAsync(budget, 10)
+	assertBlocked(t, done)
+	budget.Release(500)
+	assertUnblocked(t, done)
+
+	usage := budget.Usage()
+	assert.EqualValues(t, 10, usage.QueuedBytes)
+	assert.EqualValues(t, 500, usage.PeakQueuedBytes)
+}
+
+func TestStreamBudget_Close(t *testing.T) {
+	budget := server.NewStreamBudget(1, 0)
+	budget.Acquire(1)
+
+	done := acquireAsync(budget, 1)
+	assertBlocked(t, done)
+	budget.Close()
+
+	assertUnblocked(t, done)
+}
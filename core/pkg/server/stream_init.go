@@ -4,10 +4,12 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"maps"
 	"net/http"
 	"net/url"
+	"os"
 	"time"
 
 	"github.com/Khan/genqlient/graphql"
@@ -16,12 +18,21 @@ import (
 	"github.com/wandb/wandb/core/internal/clients"
 	"github.com/wandb/wandb/core/internal/filestream"
 	"github.com/wandb/wandb/core/internal/filetransfer"
+	"github.com/wandb/wandb/core/internal/gqlcache"
+	"github.com/wandb/wandb/core/internal/orgsettings"
+	"github.com/wandb/wandb/core/internal/paths"
 	"github.com/wandb/wandb/core/internal/runfiles"
+	"github.com/wandb/wandb/core/internal/runhistory"
+	"github.com/wandb/wandb/core/internal/runmedia"
+	"github.com/wandb/wandb/core/internal/servercaps"
 	"github.com/wandb/wandb/core/internal/settings"
 	"github.com/wandb/wandb/core/internal/waiting"
 	"github.com/wandb/wandb/core/internal/watcher"
 	"github.com/wandb/wandb/core/pkg/observability"
+	"go.opentelemetry.io/otel/attribute"
+	"golang.org/x/net/http/httpproxy"
 	"golang.org/x/time/rate"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 // NewBackend returns a Backend or nil if we're offline.
@@ -38,11 +49,29 @@ func NewBackend(
 		logger.CaptureFatalAndPanic(
 			fmt.Errorf("sender: failed to parse base URL: %v", err))
 	}
-	return api.New(api.BackendOptions{
+	opts := api.BackendOptions{
 		BaseURL: baseURL,
 		Logger:  logger.Logger,
 		APIKey:  settings.GetAPIKey(),
-	})
+	}
+	if signer := api.NewExecSigner(settings.GetRequestSigningCommand()); signer != nil {
+		opts.Signer = signer
+	}
+
+	breakerParams := api.CircuitBreakerParams{
+		Threshold: api.DefaultCircuitBreakerThreshold,
+		Cooldown:  api.DefaultCircuitBreakerCooldown,
+		Logger:    logger.Logger,
+	}
+	if threshold := settings.Proto.GetXApiCircuitBreakerThreshold(); threshold != nil {
+		breakerParams.Threshold = int(threshold.GetValue())
+	}
+	if cooldown := settings.Proto.GetXApiCircuitBreakerCooldownSeconds(); cooldown != nil {
+		breakerParams.Cooldown = clients.SecondsToDuration(cooldown.GetValue())
+	}
+	opts.CircuitBreaker = api.NewCircuitBreaker(breakerParams)
+
+	return api.New(opts)
 }
 
 // ProxyFn returns a function that returns a proxy URL for a given hhtp.Request.
@@ -54,33 +83,117 @@ func NewBackend(
 // This is useful if the user only want to proxy traffic to W&B, but not other traffic,
 // as the standard environment proxy settings would potentially proxy all traffic.
 //
-// The custom proxy URLs are passed as arguments to the function.
+// The custom proxy URLs are passed as arguments to the function, and may
+// be HTTP, HTTPS or SOCKS5 ("socks5://host:port") proxies.
+//
+// Requests to hosts in noProxy, a comma-separated list of hosts, domains and
+// CIDR ranges, are not proxied. If noProxy is empty, the NO_PROXY environment
+// variable is used for both custom and environment proxies.
 //
 // The default environment proxy settings are read from the environment variables
 // HTTP_PROXY, HTTPS_PROXY, and NO_PROXY.
-func ProxyFn(httpProxy string, httpsProxy string) func(req *http.Request) (*url.URL, error) {
-	return func(req *http.Request) (*url.URL, error) {
-		if req.URL.Scheme == "http" && httpProxy != "" {
-			proxyURLParsed, err := url.Parse(httpProxy)
-			if err != nil {
-				return nil, err
-			}
-			return proxyURLParsed, nil
-		} else if req.URL.Scheme == "https" && httpsProxy != "" {
-			proxyURLParsed, err := url.Parse(httpsProxy)
-			if err != nil {
-				return nil, err
-			}
-			return proxyURLParsed, nil
+func ProxyFn(
+	httpProxy string,
+	httpsProxy string,
+	noProxy string,
+) func(req *http.Request) (*url.URL, error) {
+	for _, proxy := range []string{httpProxy, httpsProxy} {
+		if err := validateProxyURL(proxy); err != nil {
+			return func(*http.Request) (*url.URL, error) { return nil, err }
 		}
+	}
+
+	config := httpproxy.FromEnvironment()
+	if httpProxy != "" {
+		config.HTTPProxy = httpProxy
+	}
+	if httpsProxy != "" {
+		config.HTTPSProxy = httpsProxy
+	}
+	if noProxy != "" {
+		config.NoProxy = noProxy
+	}
 
-		// Fall back to the default environment proxy settings
-		return http.ProxyFromEnvironment(req)
+	proxyFunc := config.ProxyFunc()
+	return func(req *http.Request) (*url.URL, error) {
+		return proxyFunc(req.URL)
 	}
 }
 
+// validateProxyURL returns an error if a custom proxy URL is set but
+// is not a supported proxy.
+func validateProxyURL(proxy string) error {
+	if proxy == "" {
+		return nil
+	}
+
+	proxyURL, err := url.Parse(proxy)
+	if err != nil {
+		return err
+	}
+
+	switch proxyURL.Scheme {
+	case "http", "https", "socks5", "socks5h":
+		return nil
+	default:
+		return fmt.Errorf("unsupported proxy scheme %q", proxyURL.Scheme)
+	}
+}
+
+// proxyFnFromSettings returns a ProxyFn for the proxy settings.
+func proxyFnFromSettings(
+	settings *settings.Settings,
+) func(req *http.Request) (*url.URL, error) {
+	return ProxyFn(
+		settings.GetHTTPProxy(),
+		settings.GetHTTPSProxy(),
+		settings.GetNoProxy(),
+	)
+}
+
+// tlsConfigFromSettings returns the TLS configuration for the certificate
+// settings, or nil to use the default configuration.
+//
+// Errors are logged, and the default configuration is used.
+func tlsConfigFromSettings(
+	logger *observability.CoreLogger,
+	settings *settings.Settings,
+) *tls.Config {
+	config, err := api.NewTLSConfig(
+		settings.GetCACertFile(),
+		settings.GetClientCertFile(),
+		settings.GetClientKeyFile(),
+	)
+	if err != nil {
+		logger.CaptureError(
+			fmt.Errorf("stream: invalid TLS settings: %v", err))
+		return nil
+	}
+	return config
+}
+
+// graphQLResponseCache holds the cached GraphQL responses of all streams.
+var graphQLResponseCache = gqlcache.NewCache(gqlcache.DefaultMaxEntries)
+
+// cachedGraphQLOperations are the GraphQL queries whose responses may be
+// reused if the _graphql_cache_ttl_seconds setting is set.
+//
+// Their results change rarely, if ever: the server's and user's info,
+// organization defaults, and the IDs and manifests of artifacts.
+var cachedGraphQLOperations = []string{
+	"ServerInfo",
+	"Viewer",
+	"EntityDefaultSettings",
+	"ViewerDefaultSettings",
+	"ClientIDMapping",
+	"ArtifactManifest",
+	"ServerInfoFields",
+	"ServerCapabilities",
+}
+
 func NewGraphQLClient(
 	backend *api.Backend,
+	logger *observability.CoreLogger,
 	settings *settings.Settings,
 	peeker *observability.Peeker,
 ) graphql.Client {
@@ -98,7 +211,8 @@ func NewGraphQLClient(
 		NonRetryTimeout: api.DefaultNonRetryTimeout,
 		ExtraHeaders:    graphqlHeaders,
 		NetworkPeeker:   peeker,
-		Proxy:           ProxyFn(settings.GetHTTPProxy(), settings.GetHTTPSProxy()),
+		Proxy:           proxyFnFromSettings(settings),
+		TLSConfig:       tlsConfigFromSettings(logger, settings),
 	}
 	if retryMax := settings.Proto.GetXGraphqlRetryMax(); retryMax != nil {
 		opts.RetryMax = int(retryMax.GetValue())
@@ -116,7 +230,25 @@ func NewGraphQLClient(
 	httpClient := backend.NewClient(opts)
 	endpoint := fmt.Sprintf("%s/graphql", settings.Proto.GetBaseUrl().GetValue())
 
-	return graphql.NewClient(endpoint, httpClient)
+	var client graphql.Client
+	if settings.IsGraphQLPersistedQueries() {
+		client = gqlcache.NewPersistedQueryClient(endpoint, httpClient)
+	} else {
+		client = graphql.NewClient(endpoint, httpClient)
+	}
+
+	if ttl := settings.GetGraphQLCacheTTL(); ttl > 0 {
+		// Streams with the same server and credentials share responses.
+		client = gqlcache.NewCachingClient(gqlcache.CachingClientParams{
+			Client:     client,
+			Cache:      graphQLResponseCache,
+			TTL:        ttl,
+			Namespace:  serverCacheKey(settings),
+			Operations: cachedGraphQLOperations,
+		})
+	}
+
+	return client
 }
 
 func NewFileStream(
@@ -125,6 +257,8 @@ func NewFileStream(
 	printer *observability.Printer,
 	settings *settings.Settings,
 	peeker api.Peeker,
+	tracer *observability.Tracer,
+	onRunControl func(filestream.RunControl),
 ) filestream.FileStream {
 	fileStreamHeaders := map[string]string{}
 	maps.Copy(fileStreamHeaders, settings.Proto.GetXExtraHttpHeaders().GetValue())
@@ -140,7 +274,8 @@ func NewFileStream(
 		NonRetryTimeout: filestream.DefaultNonRetryTimeout,
 		ExtraHeaders:    fileStreamHeaders,
 		NetworkPeeker:   peeker,
-		Proxy:           ProxyFn(settings.GetHTTPProxy(), settings.GetHTTPSProxy()),
+		Proxy:           proxyFnFromSettings(settings),
+		TLSConfig:       tlsConfigFromSettings(logger, settings),
 	}
 	if retryMax := settings.Proto.GetXFileStreamRetryMax(); retryMax != nil {
 		opts.RetryMax = int(retryMax.GetValue())
@@ -163,33 +298,162 @@ func NewFileStream(
 		Printer:           printer,
 		ApiClient:         fileStreamRetryClient,
 		TransmitRateLimit: rate.NewLimiter(rate.Every(15*time.Second), 1),
+		Tracer:            tracer,
+		OnRunControl:      onRunControl,
+	}
+	if syncFile := settings.GetSyncFile(); syncFile != "" {
+		params.AckLogPath = filestream.AckLogPath(syncFile)
+	}
+
+	switch transport := settings.GetFileStreamTransport(); transport {
+	case "", "http":
+	case "grpc":
+		// The stream goes through the same client as HTTP requests, so it
+		// uses the same headers, proxy, TLS settings and rate limit.
+		params.OpenStream = filestream.NewGRPCStreamOpener(
+			fileStreamRetryClient.(api.StreamClient))
+		params.StreamTransmitRateLimit = rate.NewLimiter(rate.Every(time.Second), 1)
+	default:
+		logger.CaptureWarn(
+			"sender: unknown filestream transport, using HTTP",
+			"transport", transport)
 	}
 
 	return filestream.NewFileStream(params)
 }
 
+// NewHistoryAggregator returns an aggregator for history rows, or nil if
+// history is not rate limited.
+func NewHistoryAggregator(
+	logger *observability.CoreLogger,
+	settings *settings.Settings,
+) *runhistory.Aggregator {
+	policies := make(map[string]runhistory.AggregationPolicy)
+	for glob, name := range settings.GetHistoryAggregationPolicies() {
+		policy, err := runhistory.ParseAggregationPolicy(name)
+		if err != nil {
+			logger.CaptureWarn(
+				"stream: ignoring history aggregation policy",
+				"glob", glob, "error", err)
+			continue
+		}
+		policies[glob] = policy
+	}
+
+	return runhistory.NewAggregator(runhistory.AggregatorParams{
+		MaxRate:  settings.GetHistoryAggregationMaxRate(),
+		Policies: policies,
+	})
+}
+
+// orgDefaultsTimeout limits how long fetching the organization's default
+// settings can delay starting a run.
+const orgDefaultsTimeout = 5 * time.Second
+
+// orgDefaultsCache holds the default settings fetched for each server,
+// user and entity.
+var orgDefaultsCache startupCache[map[string]any]
+
+// ApplyOrgDefaults fetches the default settings of the run's organization
+// and applies those that are not already set.
+//
+// The defaults are fetched once per process; see startupCache.
+func ApplyOrgDefaults(
+	ctx context.Context,
+	logger *observability.CoreLogger,
+	settings *settings.Settings,
+	graphqlClient graphql.Client,
+) {
+	if settings.IsDisableOrgDefaults() {
+		return
+	}
+
+	key := serverCacheKey(settings) + " " + settings.GetEntity()
+	defaults, err := orgDefaultsCache.Get(key, func() (map[string]any, error) {
+		ctx, cancel := context.WithTimeout(ctx, orgDefaultsTimeout)
+		defer cancel()
+		return orgsettings.Fetch(ctx, graphqlClient, settings.GetEntity())
+	})
+	if err != nil {
+		// Older servers don't support organization defaults.
+		logger.Info("stream: could not fetch org defaults", "error", err)
+		return
+	}
+
+	applied, err := orgsettings.Merge(settings.Proto, defaults)
+	if err != nil {
+		logger.CaptureWarn("stream: invalid org defaults", "error", err)
+	}
+	if len(applied) > 0 {
+		logger.Info("stream: applied org defaults", "settings", applied)
+	}
+}
+
+// serverCapabilitiesTimeout limits how long asking the server what it
+// supports can delay starting a run.
+const serverCapabilitiesTimeout = 5 * time.Second
+
+// serverCapabilitiesCache holds the capabilities fetched for each server
+// and user.
+var serverCapabilitiesCache startupCache[*servercaps.Capabilities]
+
+// NegotiateServerFeatures asks the server what it supports and turns off
+// the features in the settings that it doesn't, telling the user why.
+//
+// The server is asked once per process; see startupCache. If it can't be
+// asked, all features are left on.
+func NegotiateServerFeatures(
+	ctx context.Context,
+	logger *observability.CoreLogger,
+	printer *observability.Printer,
+	settings *settings.Settings,
+	graphqlClient graphql.Client,
+) {
+	caps, err := serverCapabilitiesCache.Get(serverCacheKey(settings),
+		func() (*servercaps.Capabilities, error) {
+			ctx, cancel := context.WithTimeout(ctx, serverCapabilitiesTimeout)
+			defer cancel()
+			return servercaps.Fetch(ctx, graphqlClient)
+		})
+	if err != nil {
+		logger.Info("stream: could not fetch server capabilities", "error", err)
+		return
+	}
+	logger.Info("stream: fetched server capabilities", "version", caps.Version)
+
+	unsupported := func(feature servercaps.Feature, consequence string) {
+		logger.Warn(
+			"stream: server does not support feature",
+			"feature", feature.Name,
+			"version", caps.Version,
+		)
+		printer.Writef("%s does not support %s; %s.",
+			caps.Describe(), feature.Name, consequence)
+	}
+
+	if settings.IsServerSideSummary() &&
+		!caps.Supports(servercaps.ServerSideSummary) {
+		unsupported(servercaps.ServerSideSummary, "computing summaries locally")
+		settings.Proto.XServerSideSummary = wrapperspb.Bool(false)
+	}
+
+	if settings.Proto.GetXShared().GetValue() &&
+		!caps.Supports(servercaps.SharedRuns) {
+		unsupported(servercaps.SharedRuns,
+			"processes logging to the same run may overwrite each other's data")
+	}
+}
+
 func NewFileTransferManager(
 	fileTransferStats filetransfer.FileTransferStats,
 	logger *observability.CoreLogger,
+	printer *observability.Printer,
 	settings *settings.Settings,
 ) filetransfer.FileTransferManager {
-	fileTransferRetryClient := retryablehttp.NewClient()
-	fileTransferRetryClient.Logger = logger
-	fileTransferRetryClient.CheckRetry = filetransfer.FileTransferRetryPolicy
-	fileTransferRetryClient.RetryMax = filetransfer.DefaultRetryMax
-	fileTransferRetryClient.RetryWaitMin = filetransfer.DefaultRetryWaitMin
-	fileTransferRetryClient.RetryWaitMax = filetransfer.DefaultRetryWaitMax
-	fileTransferRetryClient.HTTPClient.Timeout = filetransfer.DefaultNonRetryTimeout
-	fileTransferRetryClient.Backoff = clients.ExponentialBackoffWithJitter
-	fileTransfers := filetransfer.NewFileTransfers(
-		fileTransferRetryClient,
-		logger,
-		fileTransferStats,
-	)
-
 	// Set the Proxy function on the HTTP client.
 	transport := &http.Transport{
-		Proxy: ProxyFn(settings.GetHTTPProxy(), settings.GetHTTPSProxy()),
+		Proxy:           proxyFnFromSettings(settings),
+		TLSClientConfig: tlsConfigFromSettings(logger, settings),
 	}
 	// Set the "Proxy-Authorization" header for the CONNECT requests
 	// to the proxy server if the header is present in the extra headers.
@@ -198,6 +462,108 @@ func NewFileTransferManager(
 			"Proxy-Authorization": []string{header},
 		}
 	}
+
+	maxConcurrency := filetransfer.DefaultConcurrencyLimit
+	if limit := settings.Proto.GetXFileTransferMaxConcurrency(); limit != nil {
+		maxConcurrency = int(limit.GetValue())
+	}
+	concurrency := filetransfer.NewAdaptiveConcurrency(maxConcurrency)
+	configureFileTransferTransport(transport, settings, maxConcurrency)
+
+	fileTransfers := filetransfer.NewFileTransfers(
+		newFileTransferRetryClient(logger, settings, transport, concurrency),
+		logger,
+		fileTransferStats,
+		filetransfer.NewBandwidthLimiter(settings.GetUploadBandwidthLimit()),
+	)
+
+	s3Config := s3ConfigFromSettings(settings)
+	s3Transport := transport.Clone()
+	if s3Config.InsecureTLS {
+		if s3Transport.TLSClientConfig == nil {
+			s3Transport.TLSClientConfig = &tls.Config{}
+		}
+		s3Transport.TLSClientConfig.InsecureSkipVerify = true
+	}
+	fileTransfers.S3 = filetransfer.NewS3FileTransfer(
+		newFileTransferRetryClient(logger, settings, s3Transport, concurrency),
+		logger,
+		s3Config,
+	)
+	fileTransfers.GCS = filetransfer.NewGCSFileTransfer(
+		newFileTransferRetryClient(logger, settings, transport, concurrency),
+		logger,
+		gcsConfigFromSettings(settings),
+	)
+
+	uploadWindow, err := filetransfer.ParseUploadWindow(settings.GetLargeUploadWindow())
+	if err != nil {
+		logger.CaptureError(err)
+	}
+
+	return filetransfer.NewFileTransferManager(
+		filetransfer.WithLogger(logger),
+		filetransfer.WithPrinter(printer),
+		filetransfer.WithSettings(settings.Proto),
+		filetransfer.WithFileTransfers(fileTransfers),
+		filetransfer.WithFileTransferStats(fileTransferStats),
+		filetransfer.WithUploadWindow(uploadWindow),
+		filetransfer.WithConcurrency(concurrency),
+	)
+}
+
+// configureFileTransferTransport applies the connection pool and HTTP/2
+// settings to the transport of file transfers.
+//
+// By default, as many idle connections to each host are kept as files may
+// be transferred at once, so that connections aren't reopened for every
+// file of a large artifact.
+func configureFileTransferTransport(
+	transport *http.Transport,
+	settings *settings.Settings,
+	maxConcurrency int,
+) {
+	transport.MaxIdleConnsPerHost = maxConcurrency
+	if n := settings.Proto.GetXFileTransferMaxIdleConns(); n != nil {
+		transport.MaxIdleConns = int(n.GetValue())
+	}
+	if n := settings.Proto.GetXFileTransferMaxIdleConnsPerHost(); n != nil {
+		transport.MaxIdleConnsPerHost = int(n.GetValue())
+	}
+	if n := settings.Proto.GetXFileTransferMaxConnsPerHost(); n != nil {
+		transport.MaxConnsPerHost = int(n.GetValue())
+	}
+
+	// Go only tries HTTP/2 by default if the TLS config isn't customized.
+	if http2 := settings.Proto.GetXFileTransferHttp2(); http2 != nil {
+		if http2.GetValue() {
+			transport.ForceAttemptHTTP2 = true
+		} else {
+			// A non-nil, empty map disables HTTP/2.
+			transport.TLSNextProto = map[string]func(string, *tls.Conn) http.RoundTripper{}
+		}
+	}
+}
+
+// newFileTransferRetryClient returns the HTTP client for file transfers
+// with the retry settings.
+//
+// Responses asking to slow down lower the concurrency limit.
+func newFileTransferRetryClient(
+	logger *observability.CoreLogger,
+	settings *settings.Settings,
+	transport *http.Transport,
+	concurrency *filetransfer.AdaptiveConcurrency,
+) *retryablehttp.Client {
+	fileTransferRetryClient := retryablehttp.NewClient()
+	fileTransferRetryClient.Logger = logger
+	fileTransferRetryClient.CheckRetry = concurrency.RetryPolicy(
+		filetransfer.FileTransferRetryPolicy)
+	fileTransferRetryClient.RetryMax = filetransfer.DefaultRetryMax
+	fileTransferRetryClient.RetryWaitMin = filetransfer.DefaultRetryWaitMin
+	fileTransferRetryClient.RetryWaitMax = filetransfer.DefaultRetryWaitMax
+	fileTransferRetryClient.HTTPClient.Timeout = filetransfer.DefaultNonRetryTimeout
+	fileTransferRetryClient.Backoff = clients.ExponentialBackoffWithJitter
 	fileTransferRetryClient.HTTPClient.Transport = transport
 
 	if retryMax := settings.Proto.GetXFileTransferRetryMax(); retryMax != nil {
@@ -212,15 +578,49 @@ func NewFileTransferManager(
 	if timeout := settings.Proto.GetXFileTransferTimeoutSeconds(); timeout != nil {
 		fileTransferRetryClient.HTTPClient.Timeout = clients.SecondsToDuration(timeout.GetValue())
 	}
+	return fileTransferRetryClient
+}
 
-	return filetransfer.NewFileTransferManager(
-		filetransfer.WithLogger(logger),
-		filetransfer.WithSettings(settings.Proto),
-		filetransfer.WithFileTransfers(fileTransfers),
-		filetransfer.WithFileTransferStats(fileTransferStats),
-	)
+// s3ConfigFromSettings returns the configuration for s3:// references.
+//
+// The settings take precedence over the AWS environment variables.
+func s3ConfigFromSettings(settings *settings.Settings) filetransfer.S3Config {
+	config := filetransfer.S3ConfigFromEnv()
+	if endpoint := settings.GetS3EndpointURL(); endpoint != "" {
+		config.EndpointURL = endpoint
+	}
+	config.PathStyle = settings.IsS3PathStyle()
+	config.InsecureTLS = settings.IsS3InsecureTLS()
+	return config
+}
+
+// gcsConfigFromSettings returns the configuration for gs:// references.
+func gcsConfigFromSettings(settings *settings.Settings) filetransfer.GCSConfig {
+	config := filetransfer.GCSConfigFromEnv()
+	config.Audience = settings.GetGCSAudience()
+	config.Scopes = settings.GetGCSScopes()
+	config.ImpersonateServiceAccount = settings.GetGCSImpersonateServiceAccount()
+	return config
+}
+
+// NewMediaRetention returns the limits on media files kept on disk, or
+// nil if there are none.
+func NewMediaRetention(
+	logger *observability.CoreLogger,
+	settings *settings.Settings,
+) *runmedia.Retention {
+	return runmedia.NewRetention(runmedia.RetentionParams{
+		KeepLast:    int(settings.Proto.GetXMediaKeepLast().GetValue()),
+		SampleSteps: int(settings.Proto.GetXMediaSampleSteps().GetValue()),
+		FilesDir:    settings.GetFilesDir(),
+		Logger:      logger,
+	})
 }
 
+// NewRunfilesUploader returns the uploader of the run's files.
+//
+// If mediaRetention is not nil, it is told about every uploaded file so
+// that it can delete rotated out media.
 func NewRunfilesUploader(
 	ctx context.Context,
 	logger *observability.CoreLogger,
@@ -229,8 +629,9 @@ func NewRunfilesUploader(
 	fileTransfer filetransfer.FileTransferManager,
 	fileWatcher watcher.Watcher,
 	graphQL graphql.Client,
+	mediaRetention *runmedia.Retention,
 ) runfiles.Uploader {
-	return runfiles.NewUploader(runfiles.UploaderParams{
+	params := runfiles.UploaderParams{
 		Ctx:          ctx,
 		Logger:       logger,
 		Settings:     settings,
@@ -239,5 +640,42 @@ func NewRunfilesUploader(
 		GraphQL:      graphQL,
 		FileWatcher:  fileWatcher,
 		BatchDelay:   waiting.NewDelay(50 * time.Millisecond),
-	})
+	}
+	if mediaRetention != nil {
+		params.OnUploaded = func(path paths.RelativePath) {
+			mediaRetention.Uploaded(string(path))
+		}
+	}
+	return runfiles.NewUploader(params)
+}
+
+// tracerShutdownTimeout is how long to wait for a stream's remaining
+// spans to be exported when it closes.
+const tracerShutdownTimeout = 5 * time.Second
+
+// NewTracer returns a tracer exporting the stream's spans, or nil if
+// tracing is off.
+//
+// Tracing is on if the x_otel_endpoint setting is set, or if the standard
+// OTEL_TRACES_EXPORTER environment variable is "otlp", in which case the
+// exporter is configured by the other OTEL_EXPORTER_OTLP_* variables.
+func NewTracer(
+	ctx context.Context,
+	logger *observability.CoreLogger,
+	settings *settings.Settings,
+) *observability.Tracer {
+	endpoint := settings.GetOTelEndpoint()
+	if endpoint == "" && os.Getenv("OTEL_TRACES_EXPORTER") != "otlp" {
+		return nil
+	}
+
+	tracer, err := observability.NewOTLPTracer(ctx, endpoint,
+		attribute.String("wandb.run_id", settings.GetRunID()),
+		attribute.String("wandb.project", settings.GetProject()),
+	)
+	if err != nil {
+		logger.CaptureError(fmt.Errorf("stream: failed to set up tracing: %v", err))
+		return nil
+	}
+	return tracer
 }
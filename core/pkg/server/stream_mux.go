@@ -1,6 +1,7 @@
 package server
 
 import (
+	"expvar"
 	"fmt"
 	"log/slog"
 	"sync"
@@ -76,5 +77,24 @@ func (sm *StreamMux) FinishAndCloseAllStreams(exitCode int32) {
 	slog.Debug("all streams were closed")
 }
 
+// Usage returns the resource use of each stream, by stream ID.
+func (sm *StreamMux) Usage() map[string]StreamUsage {
+	sm.mutex.RLock()
+	defer sm.mutex.RUnlock()
+
+	usage := make(map[string]StreamUsage, len(sm.mux))
+	for streamId, stream := range sm.mux {
+		usage[streamId] = stream.Usage()
+	}
+	return usage
+}
+
 // StreamMux is a global stream mux
 var streamMux = NewStreamMux()
+
+func init() {
+	// Available at /debug/vars on any HTTP server using http.DefaultServeMux.
+	expvar.Publish("streams", expvar.Func(func() any {
+		return streamMux.Usage()
+	}))
+}
@@ -0,0 +1,68 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/wandb/wandb/core/internal/hpcjob"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// Preempt prepares all runs in progress for their HPC job being preempted.
+//
+// It returns the time the runs have to finish, which is the shortest of
+// the timeout and the runs' preemption grace periods.
+func (s *Server) Preempt(timeout time.Duration) time.Duration {
+	return streamMux.Preempt(timeout)
+}
+
+// Preempt prepares all streams for their job being preempted.
+func (sm *StreamMux) Preempt(timeout time.Duration) time.Duration {
+	sm.mutex.RLock()
+	defer sm.mutex.RUnlock()
+
+	for _, stream := range sm.mux {
+		timeout = min(timeout, stream.Preempt(timeout))
+	}
+	return timeout
+}
+
+// Preempt prepares the stream's run for its job being preempted.
+//
+// The run is marked as preempting, so that the server doesn't consider it
+// crashed, and the run's preemption hook is started. The hook is killed
+// when the run's grace period ends.
+//
+// It returns the time the run has to finish.
+func (s *Stream) Preempt(timeout time.Duration) time.Duration {
+	if s.settings.IsSync() {
+		return timeout
+	}
+
+	if grace := s.settings.GetPreemptionGrace(); grace > 0 {
+		timeout = min(timeout, grace)
+	}
+	s.logger.Info("stream: job preempted", "timeout", timeout)
+
+	s.HandleRecord(&service.Record{
+		RecordType: &service.Record_Preempting{
+			Preempting: &service.RunPreemptingRecord{},
+		},
+	})
+
+	if hook := s.settings.GetPreemptionHook(); len(hook) > 0 {
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+			err := hpcjob.RunPreemptionHook(ctx, hook, s.settings.GetRunID())
+			if err != nil {
+				s.logger.CaptureError(err)
+			}
+		}()
+	}
+
+	return timeout
+}
@@ -0,0 +1,94 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/wandb/wandb/core/internal/settings"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// Names in settings files of the settings that can change while a run is
+// in progress.
+const (
+	reloadableStatsSampleRate = "_stats_sample_rate_seconds"
+	reloadableConsole         = "console"
+	reloadableCoreLogLevel    = "_core_log_level"
+)
+
+// ReloadSettings re-reads the settings files of all runs in progress and
+// applies the settings that can change without restarting a run.
+//
+// The settings are the system metrics sampling interval, console capture
+// and the log level. Other settings in the files are ignored.
+func (s *Server) ReloadSettings() {
+	streamMux.ReloadSettings()
+}
+
+// ReloadSettings re-reads the settings files of all streams.
+func (sm *StreamMux) ReloadSettings() {
+	sm.mutex.RLock()
+	defer sm.mutex.RUnlock()
+
+	for _, stream := range sm.mux {
+		stream.ReloadSettings()
+	}
+}
+
+// ReloadSettings re-reads the stream's settings files and applies the
+// settings that can change while its run is in progress.
+func (s *Stream) ReloadSettings() {
+	update, err := SettingsUpdateFromFiles(s.settings.GetSettingsFiles())
+	if err != nil {
+		s.logger.Warn("stream: failed to reload settings", "error", err)
+		return
+	}
+	s.logger.Info("stream: reloading settings", "update", update)
+
+	s.HandleRecord(&service.Record{
+		RecordType: &service.Record_Request{
+			Request: &service.Request{
+				RequestType: &service.Request_UpdateSettings{
+					UpdateSettings: update,
+				},
+			},
+		},
+		Control: &service.Control{Local: true},
+	})
+}
+
+// SettingsUpdateFromFiles returns the settings in settings files that can
+// change while a run is in progress.
+//
+// Files that don't exist are skipped, and settings in later files
+// override those in earlier ones.
+func SettingsUpdateFromFiles(paths []string) (*service.UpdateSettingsRequest, error) {
+	update := &service.UpdateSettingsRequest{}
+	for _, path := range paths {
+		values, err := settings.ReadSettingsFile(path)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if value, ok := values[reloadableStatsSampleRate]; ok {
+			seconds, err := strconv.ParseFloat(value, 64)
+			if err != nil || seconds <= 0 {
+				return nil, fmt.Errorf(
+					"invalid %s in %s: %q", reloadableStatsSampleRate, path, value)
+			}
+			update.StatsSampleRateSeconds = seconds
+		}
+		if value, ok := values[reloadableConsole]; ok {
+			update.Console = value
+		}
+		if value, ok := values[reloadableCoreLogLevel]; ok {
+			update.LogLevel = value
+		}
+	}
+	return update, nil
+}
@@ -0,0 +1,50 @@
+package server_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+	"google.golang.org/protobuf/proto"
+)
+
+func writeSettingsFile(t *testing.T, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "settings")
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0o644))
+	return path
+}
+
+func TestSettingsUpdateFromFiles(t *testing.T) {
+	system := writeSettingsFile(t, `[default]
+_stats_sample_rate_seconds = 10
+console = off
+project = ignored
+`)
+	workspace := writeSettingsFile(t, `[default]
+console = wrap
+_core_log_level = debug
+`)
+	missing := filepath.Join(t.TempDir(), "missing")
+
+	update, err := server.SettingsUpdateFromFiles([]string{system, missing, workspace})
+
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(&service.UpdateSettingsRequest{
+		StatsSampleRateSeconds: 10,
+		Console:                "wrap",
+		LogLevel:               "debug",
+	}, update))
+}
+
+func TestSettingsUpdateFromFiles_InvalidSampleRate(t *testing.T) {
+	path := writeSettingsFile(t, "[default]\n_stats_sample_rate_seconds = soon\n")
+
+	_, err := server.SettingsUpdateFromFiles([]string{path})
+
+	assert.ErrorContains(t, err, "_stats_sample_rate_seconds")
+}
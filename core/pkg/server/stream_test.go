@@ -13,6 +13,7 @@ func TestProxyFn(t *testing.T) {
 		name          string
 		httpProxy     string
 		httpsProxy    string
+		noProxy       string
 		requestURL    string
 		envProxy      map[string]string
 		expectedProxy string
@@ -54,6 +55,37 @@ func TestProxyFn(t *testing.T) {
 			expectedProxy: "http://custom-proxy:8080",
 			expectedError: false,
 		},
+		{
+			name:          "Custom SOCKS5 proxy",
+			httpsProxy:    "socks5://custom-proxy:1080",
+			requestURL:    "https://example.com",
+			expectedProxy: "socks5://custom-proxy:1080",
+			expectedError: false,
+		},
+		{
+			name:          "Custom proxy skipped for host in no_proxy",
+			httpsProxy:    "http://custom-proxy:8443",
+			noProxy:       "internal.example.com,10.0.0.0/8",
+			requestURL:    "https://storage.internal.example.com",
+			expectedProxy: "",
+			expectedError: false,
+		},
+		{
+			name:          "Custom proxy used for host not in no_proxy",
+			httpsProxy:    "http://custom-proxy:8443",
+			noProxy:       "internal.example.com",
+			requestURL:    "https://example.com",
+			expectedProxy: "http://custom-proxy:8443",
+			expectedError: false,
+		},
+		{
+			name:          "Custom proxy skipped for host in NO_PROXY",
+			httpProxy:     "http://custom-proxy:8080",
+			requestURL:    "http://10.1.2.3",
+			envProxy:      map[string]string{"NO_PROXY": "10.0.0.0/8"},
+			expectedProxy: "",
+			expectedError: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -69,7 +101,7 @@ func TestProxyFn(t *testing.T) {
 				t.Fatalf("http.NewRequest failed: %v", err)
 			}
 
-			proxyFn := server.ProxyFn(tt.httpProxy, tt.httpsProxy)
+			proxyFn := server.ProxyFn(tt.httpProxy, tt.httpsProxy, tt.noProxy)
 			proxyURL, err := proxyFn(req)
 
 			if tt.expectedError {
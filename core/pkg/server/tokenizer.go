@@ -1,10 +1,8 @@
 package server
 
 import (
-	"bytes"
 	"encoding/binary"
 	"errors"
-	"fmt"
 	"math"
 )
 
@@ -22,13 +20,11 @@ func ScanWBRecords(data []byte, _ bool) (int, []byte, error) {
 		return 0, nil, nil
 	}
 
-	var header Header
-	if err := binary.Read(
-		bytes.NewReader(data),
-		binary.LittleEndian,
-		&header,
-	); err != nil {
-		return 0, nil, fmt.Errorf("failed to read header: %v", err)
+	// The header is decoded by hand rather than with binary.Read, which
+	// allocates and uses reflection, since this runs for every message.
+	header := Header{
+		Magic:      data[0],
+		DataLength: binary.LittleEndian.Uint32(data[1:wbHeaderLength]),
 	}
 
 	if header.Magic != uint8('W') {
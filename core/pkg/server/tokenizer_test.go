@@ -0,0 +1,37 @@
+package server_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wandb/wandb/core/pkg/server"
+)
+
+func TestScanWBRecords(t *testing.T) {
+	data := []byte{'W', 3, 0, 0, 0, 'a', 'b', 'c', 'W'}
+
+	advance, token, err := server.ScanWBRecords(data, false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 8, advance)
+	assert.Equal(t, []byte("abc"), token)
+}
+
+func TestScanWBRecords_Incomplete(t *testing.T) {
+	for _, data := range [][]byte{
+		{'W', 3, 0},
+		{'W', 3, 0, 0, 0, 'a'},
+	} {
+		advance, token, err := server.ScanWBRecords(data, false)
+
+		assert.NoError(t, err)
+		assert.Zero(t, advance)
+		assert.Nil(t, token)
+	}
+}
+
+func TestScanWBRecords_InvalidMagic(t *testing.T) {
+	_, _, err := server.ScanWBRecords([]byte{'X', 0, 0, 0, 0}, false)
+
+	assert.ErrorContains(t, err, "invalid magic byte")
+}
@@ -6,8 +6,10 @@ import (
 	"os"
 	"sync"
 
+	"github.com/wandb/wandb/core/internal/recordcrypt"
 	"github.com/wandb/wandb/core/pkg/observability"
 	"github.com/wandb/wandb/core/pkg/service"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type WriterOption func(*Writer)
@@ -28,6 +30,7 @@ type WriterParams struct {
 	Logger   *observability.CoreLogger
 	Settings *service.Settings
 	FwdChan  chan *service.Record
+	Tracer   *observability.Tracer
 }
 
 // Writer is responsible for writing messages to the append-only log.
@@ -58,6 +61,12 @@ type Writer struct {
 
 	// wg is the wait group for the writer
 	wg sync.WaitGroup
+
+	// tracer records spans for writing records, or is nil
+	tracer *observability.Tracer
+
+	// span is the span of the record being written
+	span trace.Span
 }
 
 // NewWriter returns a new Writer
@@ -68,6 +77,7 @@ func NewWriter(ctx context.Context, params WriterParams) *Writer {
 		logger:   params.Logger,
 		settings: params.Settings,
 		fwdChan:  params.FwdChan,
+		tracer:   params.Tracer,
 	}
 	return w
 }
@@ -82,6 +92,27 @@ func (w *Writer) startStore() {
 
 	var err error
 	w.store = NewStore(w.ctx, w.settings.GetSyncFile().GetValue())
+	if w.settings.GetXStoreIndex().GetValue() {
+		w.store.EnableIndex()
+	}
+	switch compression := w.settings.GetXStoreCompression().GetValue(); compression {
+	case "":
+	case "zstd":
+		w.store.EnableZstd()
+	default:
+		w.logger.CaptureWarn(
+			"writer: startStore: unknown compression, not compressing",
+			"compression", compression)
+	}
+	cipher, err := recordcrypt.FromEnv()
+	if err != nil {
+		// Don't fall back to writing the run's data unencrypted.
+		w.logger.CaptureFatalAndPanic(
+			fmt.Errorf("writer: startStore: error loading encryption key: %v", err))
+	}
+	if cipher != nil {
+		w.store.EnableEncryption(cipher)
+	}
 	err = w.store.Open(os.O_WRONLY)
 	if err != nil {
 		w.logger.CaptureFatalAndPanic(
@@ -138,14 +169,22 @@ func (w *Writer) Close() {
 // We ensure that the messages are written to the log
 // before they are sent to the server.
 func (w *Writer) writeRecord(record *service.Record) {
+	w.span = w.tracer.StartRecord(record, "writer")
+	defer func() {
+		w.span.End()
+		w.span = nil
+	}()
+
 	switch record.RecordType.(type) {
 	case *service.Record_Request:
 		w.fwdRecord(record)
 	case nil:
 		w.logger.Error("writer: writeRecord: nil record type")
 	default:
-		w.fwdRecord(record)
+		// Store the record first, since that sets its number, after which
+		// the sender may read it concurrently.
 		w.storeRecord(record)
+		w.fwdRecord(record)
 	}
 }
 
@@ -164,5 +203,8 @@ func (w *Writer) fwdRecord(record *service.Record) {
 	if w.settings.GetXOffline().GetValue() && !record.GetControl().GetAlwaysSend() {
 		return
 	}
+	if w.span != nil {
+		w.tracer.Forward(record, w.span)
+	}
 	w.fwdChan <- record
 }
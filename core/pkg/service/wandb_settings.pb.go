@@ -510,6 +510,168 @@ type Settings struct {
 	HttpProxy *wrapperspb.StringValue `protobuf:"bytes,168,opt,name=http_proxy,json=httpProxy,proto3" json:"http_proxy,omitempty"`
 	// The custom proxy servers for https requests to W&B.
 	HttpsProxy *wrapperspb.StringValue `protobuf:"bytes,169,opt,name=https_proxy,json=httpsProxy,proto3" json:"https_proxy,omitempty"`
+	// DCGM profiling field IDs to sample on NVIDIA GPUs, e.g. "1003" for
+	// DCGM_FI_PROF_SM_OCCUPANCY. Field names are accepted as well.
+	XStatsDcgmProfilingFields *ListStringValue `protobuf:"bytes,172,opt,name=_stats_dcgm_profiling_fields,json=StatsDcgmProfilingFields,proto3" json:"_stats_dcgm_profiling_fields,omitempty"`
+	// The interval at which the DCGM profiling fields are sampled.
+	XStatsDcgmProfilingIntervalSeconds *wrapperspb.DoubleValue `protobuf:"bytes,173,opt,name=_stats_dcgm_profiling_interval_seconds,json=StatsDcgmProfilingIntervalSeconds,proto3" json:"_stats_dcgm_profiling_interval_seconds,omitempty"`
+	// Command that signs requests to W&B, as the executable followed by its
+	// arguments. See core/internal/api/signing.go for the protocol.
+	XRequestSigningCommand *ListStringValue `protobuf:"bytes,174,opt,name=_request_signing_command,json=RequestSigningCommand,proto3" json:"_request_signing_command,omitempty"`
+	// Whether to print a run descriptor at init and store it in the run
+	// directory, for relaying results across an air gap.
+	XRunDescriptor *wrapperspb.BoolValue `protobuf:"bytes,175,opt,name=_run_descriptor,json=RunDescriptor,proto3" json:"_run_descriptor,omitempty"`
+	// Whether to also store the run descriptor as a QR code image.
+	XRunDescriptorQr *wrapperspb.BoolValue `protobuf:"bytes,176,opt,name=_run_descriptor_qr,json=RunDescriptorQr,proto3" json:"_run_descriptor_qr,omitempty"`
+	// Whether to also save console output as JSON lines tagged with the
+	// stream and source process of each line.
+	XConsoleStructured *wrapperspb.BoolValue `protobuf:"bytes,177,opt,name=_console_structured,json=ConsoleStructured,proto3" json:"_console_structured,omitempty"`
+	// Whether to collapse identical consecutive console lines into a
+	// "repeated N times" marker.
+	XConsoleDedupeLines *wrapperspb.BoolValue `protobuf:"bytes,178,opt,name=_console_dedupe_lines,json=ConsoleDedupeLines,proto3" json:"_console_dedupe_lines,omitempty"`
+	// Maximum bytes of console logs to upload per second; unlimited if unset.
+	XConsoleUploadBytesPerSecond *wrapperspb.Int32Value `protobuf:"bytes,179,opt,name=_console_upload_bytes_per_second,json=ConsoleUploadBytesPerSecond,proto3" json:"_console_upload_bytes_per_second,omitempty"`
+	// Number of most recent media files to keep on disk per key; unlimited if unset.
+	XMediaKeepLast *wrapperspb.Int32Value `protobuf:"bytes,181,opt,name=_media_keep_last,json=MediaKeepLast,proto3" json:"_media_keep_last,omitempty"`
+	// Only keep media logged at steps that are a multiple of this.
+	XMediaSampleSteps *wrapperspb.Int32Value `protobuf:"bytes,182,opt,name=_media_sample_steps,json=MediaSampleSteps,proto3" json:"_media_sample_steps,omitempty"`
+	// Aggregation policy by metric name glob: "subsample", "mean" or "reservoir".
+	XHistoryAggregation *MapStringKeyStringValue `protobuf:"bytes,183,opt,name=_history_aggregation,json=HistoryAggregation,proto3" json:"_history_aggregation,omitempty"`
+	// Maximum history rows per second to upload; faster logging is aggregated.
+	XHistoryAggregationMaxRate *wrapperspb.DoubleValue `protobuf:"bytes,184,opt,name=_history_aggregation_max_rate,json=HistoryAggregationMaxRate,proto3" json:"_history_aggregation_max_rate,omitempty"`
+	// Whether to keep unaggregated history in the transaction log.
+	XHistoryAggregationKeepRaw *wrapperspb.BoolValue `protobuf:"bytes,185,opt,name=_history_aggregation_keep_raw,json=HistoryAggregationKeepRaw,proto3" json:"_history_aggregation_keep_raw,omitempty"`
+	// Whether to skip fetching default settings set by the organization.
+	XDisableOrgDefaults *wrapperspb.BoolValue `protobuf:"bytes,186,opt,name=_disable_org_defaults,json=DisableOrgDefaults,proto3" json:"_disable_org_defaults,omitempty"`
+	// Whether to write a sidecar index of the transaction log for seeking by step.
+	XStoreIndex *wrapperspb.BoolValue `protobuf:"bytes,187,opt,name=_store_index,json=StoreIndex,proto3" json:"_store_index,omitempty"`
+	// How to compress the transaction log: empty for none, or "zstd".
+	XStoreCompression *wrapperspb.StringValue `protobuf:"bytes,188,opt,name=_store_compression,json=StoreCompression,proto3" json:"_store_compression,omitempty"`
+	// Comma-separated hosts, domains and CIDR ranges to reach without the
+	// custom proxies, like the NO_PROXY environment variable.
+	NoProxy *wrapperspb.StringValue `protobuf:"bytes,189,opt,name=no_proxy,json=noProxy,proto3" json:"no_proxy,omitempty"`
+	// PEM file with root certificates to trust for W&B traffic, in addition to
+	// the system's.
+	CaCertFile *wrapperspb.StringValue `protobuf:"bytes,190,opt,name=ca_cert_file,json=caCertFile,proto3" json:"ca_cert_file,omitempty"`
+	// PEM file with a client certificate for mutual TLS with the W&B server.
+	ClientCertFile *wrapperspb.StringValue `protobuf:"bytes,191,opt,name=client_cert_file,json=clientCertFile,proto3" json:"client_cert_file,omitempty"`
+	// PEM file with the private key of client_cert_file.
+	ClientKeyFile *wrapperspb.StringValue `protobuf:"bytes,192,opt,name=client_key_file,json=clientKeyFile,proto3" json:"client_key_file,omitempty"`
+	// Consecutive failed requests to the W&B server after which requests are
+	// paused for _api_circuit_breaker_cooldown_seconds. Zero disables this.
+	XApiCircuitBreakerThreshold *wrapperspb.Int32Value `protobuf:"bytes,193,opt,name=_api_circuit_breaker_threshold,json=ApiCircuitBreakerThreshold,proto3" json:"_api_circuit_breaker_threshold,omitempty"`
+	// How long to pause requests after _api_circuit_breaker_threshold failures.
+	XApiCircuitBreakerCooldownSeconds *wrapperspb.DoubleValue `protobuf:"bytes,194,opt,name=_api_circuit_breaker_cooldown_seconds,json=ApiCircuitBreakerCooldownSeconds,proto3" json:"_api_circuit_breaker_cooldown_seconds,omitempty"`
+	// Maximum number of records a run can have waiting to be processed before
+	// the client is made to wait. Limits the effect of one run on others that
+	// share the same wandb-core service.
+	XStreamMaxQueuedRecords *wrapperspb.Int32Value `protobuf:"bytes,195,opt,name=_stream_max_queued_records,json=StreamMaxQueuedRecords,proto3" json:"_stream_max_queued_records,omitempty"`
+	// Maximum size in MB of the records a run can have waiting to be processed
+	// before the client is made to wait. Zero means no limit.
+	XStreamMaxQueuedMb *wrapperspb.Int32Value `protobuf:"bytes,196,opt,name=_stream_max_queued_mb,json=StreamMaxQueuedMb,proto3" json:"_stream_max_queued_mb,omitempty"`
+	// Directory of TensorBoard tfevents files to log to the run and save with it,
+	// for programs that write them without the Python integration.
+	SyncTensorboardDir *wrapperspb.StringValue `protobuf:"bytes,197,opt,name=sync_tensorboard_dir,json=syncTensorboardDir,proto3" json:"sync_tensorboard_dir,omitempty"`
+	// OTLP/HTTP endpoint, like "http://localhost:4318", to export traces of how the
+	// core processes the run's records to.
+	XOtelEndpoint *wrapperspb.StringValue `protobuf:"bytes,198,opt,name=x_otel_endpoint,json=xOtelEndpoint,proto3" json:"x_otel_endpoint,omitempty"`
+	// How long to reuse the responses of GraphQL queries whose results rarely change,
+	// such as the viewer and server info. Responses are not cached if unset or 0.
+	XGraphqlCacheTtlSeconds *wrapperspb.DoubleValue `protobuf:"bytes,199,opt,name=_graphql_cache_ttl_seconds,json=GraphqlCacheTtlSeconds,proto3" json:"_graphql_cache_ttl_seconds,omitempty"`
+	// Whether to send hashes of GraphQL queries in place of their text, as in
+	// automatic persisted queries, falling back to the text if the server needs it.
+	XGraphqlPersistedQueries *wrapperspb.BoolValue `protobuf:"bytes,201,opt,name=_graphql_persisted_queries,json=GraphqlPersistedQueries,proto3" json:"_graphql_persisted_queries,omitempty"`
+	// Level of the wandb-core debug log: "debug", "info", "warn" or "error".
+	// It can be changed while a run is in progress by sending wandb-core SIGHUP.
+	XCoreLogLevel *wrapperspb.StringValue `protobuf:"bytes,202,opt,name=_core_log_level,json=CoreLogLevel,proto3" json:"_core_log_level,omitempty"`
+	// Whether to log runs locally when there is no API key, deferring
+	// authentication until they are uploaded with `wandb-core flush`.
+	XDeferAuth *wrapperspb.BoolValue `protobuf:"bytes,203,opt,name=_defer_auth,json=DeferAuth,proto3" json:"_defer_auth,omitempty"`
+	// The maximum total upload rate of files in bytes per second, or 0 for
+	// no limit.
+	XUploadBandwidthLimit *wrapperspb.Int64Value `protobuf:"bytes,204,opt,name=_upload_bandwidth_limit,json=UploadBandwidthLimit,proto3" json:"_upload_bandwidth_limit,omitempty"`
+	// The daily period in which files of 64 MiB or more are uploaded, as
+	// local times like "00:00-06:00". Such uploads wait until the period
+	// starts; other files upload at any time.
+	XLargeUploadWindow *wrapperspb.StringValue `protobuf:"bytes,205,opt,name=_large_upload_window,json=LargeUploadWindow,proto3" json:"_large_upload_window,omitempty"`
+	// Whether the patch of uncommitted changes saved with code includes
+	// untracked files that .gitignore does not exclude.
+	XGitPatchUntracked *wrapperspb.BoolValue `protobuf:"bytes,206,opt,name=_git_patch_untracked,json=GitPatchUntracked,proto3" json:"_git_patch_untracked,omitempty"`
+	// The size of the largest git patch to upload with a run; larger patches
+	// are not uploaded. 0 means no limit.
+	XGitPatchMaxBytes *wrapperspb.Int64Value `protobuf:"bytes,207,opt,name=_git_patch_max_bytes,json=GitPatchMaxBytes,proto3" json:"_git_patch_max_bytes,omitempty"`
+	// Package managers whose installed packages to save with the run, such as
+	// "pip", "conda" or "apt".
+	XEnvSnapshot *ListStringValue `protobuf:"bytes,208,opt,name=_env_snapshot,json=EnvSnapshot,proto3" json:"_env_snapshot,omitempty"`
+	// Rules that act on the run when a metric diverges, such as
+	// "loss is nan for 3 -> alert; stop".
+	XWatchdogRules *ListStringValue `protobuf:"bytes,209,opt,name=_watchdog_rules,json=WatchdogRules,proto3" json:"_watchdog_rules,omitempty"`
+	// Rank of this node when several nodes log to the same run in shared mode.
+	// Namespaces the node's system metrics and console output.
+	XNodeRank *wrapperspb.Int32Value `protobuf:"bytes,210,opt,name=_node_rank,json=NodeRank,proto3" json:"_node_rank,omitempty"`
+	// Key identifying a job across retries; the run ID is derived from it so that
+	// a retried job resumes its run instead of creating a new one.
+	IdempotencyKey *wrapperspb.StringValue `protobuf:"bytes,211,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// Command to run when the job scheduler preempts the run, such as one that
+	// saves a checkpoint.
+	XPreemptionHook *wrapperspb.StringValue `protobuf:"bytes,212,opt,name=_preemption_hook,json=PreemptionHook,proto3" json:"_preemption_hook,omitempty"`
+	// Seconds wandb-core has to save the run when the job scheduler preempts it,
+	// including running the preemption hook.
+	XPreemptionGraceSeconds *wrapperspb.DoubleValue `protobuf:"bytes,213,opt,name=_preemption_grace_seconds,json=PreemptionGraceSeconds,proto3" json:"_preemption_grace_seconds,omitempty"`
+	// Whether to leave summary aggregates of defined metrics (min, max, mean)
+	// to the server instead of computing them in wandb-core.
+	XServerSideSummary *wrapperspb.BoolValue `protobuf:"bytes,215,opt,name=_server_side_summary,json=ServerSideSummary,proto3" json:"_server_side_summary,omitempty"`
+	// The most keys a run's config or summary may have; new keys beyond it
+	// are dropped with a warning. Zero or less disables the limit.
+	XMaxConfigSummaryKeys *wrapperspb.Int32Value `protobuf:"bytes,216,opt,name=_max_config_summary_keys,json=MaxConfigSummaryKeys,proto3" json:"_max_config_summary_keys,omitempty"`
+	// The deepest nesting allowed in a config or summary value, counting the
+	// levels of its key.
+	XMaxConfigSummaryDepth *wrapperspb.Int32Value `protobuf:"bytes,217,opt,name=_max_config_summary_depth,json=MaxConfigSummaryDepth,proto3" json:"_max_config_summary_depth,omitempty"`
+	// The largest config or summary value allowed, in bytes of JSON.
+	XMaxConfigSummaryValueBytes *wrapperspb.Int32Value `protobuf:"bytes,218,opt,name=_max_config_summary_value_bytes,json=MaxConfigSummaryValueBytes,proto3" json:"_max_config_summary_value_bytes,omitempty"`
+	// The S3-compatible service to download s3:// references from, such as
+	// a MinIO or Ceph server, instead of AWS.
+	XS3EndpointUrl *wrapperspb.StringValue `protobuf:"bytes,219,opt,name=_s3_endpoint_url,json=S3EndpointUrl,proto3" json:"_s3_endpoint_url,omitempty"`
+	// Whether to put the bucket of s3:// references in the URL path, as in
+	// "https://host/bucket/key", rather than in the host name. Most
+	// S3-compatible services need this.
+	XS3PathStyle *wrapperspb.BoolValue `protobuf:"bytes,220,opt,name=_s3_path_style,json=S3PathStyle,proto3" json:"_s3_path_style,omitempty"`
+	// Whether to skip verifying the TLS certificate of the S3 endpoint, for
+	// servers with self-signed certificates.
+	XS3InsecureTls *wrapperspb.BoolValue `protobuf:"bytes,221,opt,name=_s3_insecure_tls,json=S3InsecureTls,proto3" json:"_s3_insecure_tls,omitempty"`
+	// The audience of the workload identity pool provider that tokens for gs://
+	// references are exchanged with, overriding the one in an external_account
+	// credentials file.
+	XGcsAudience *wrapperspb.StringValue `protobuf:"bytes,222,opt,name=_gcs_audience,json=GcsAudience,proto3" json:"_gcs_audience,omitempty"`
+	// The OAuth scopes of the tokens for gs:// references.
+	XGcsScopes *ListStringValue `protobuf:"bytes,223,opt,name=_gcs_scopes,json=GcsScopes,proto3" json:"_gcs_scopes,omitempty"`
+	// The email of a service account to impersonate to access gs:// references.
+	XGcsImpersonateServiceAccount *wrapperspb.StringValue `protobuf:"bytes,224,opt,name=_gcs_impersonate_service_account,json=GcsImpersonateServiceAccount,proto3" json:"_gcs_impersonate_service_account,omitempty"`
+	// The most files to upload or download at once. Fewer are transferred while
+	// storage asks for requests to slow down.
+	XFileTransferMaxConcurrency *wrapperspb.Int32Value `protobuf:"bytes,225,opt,name=_file_transfer_max_concurrency,json=FileTransferMaxConcurrency,proto3" json:"_file_transfer_max_concurrency,omitempty"`
+	// The most idle connections to keep open for file transfers, or 0 for no
+	// limit.
+	XFileTransferMaxIdleConns *wrapperspb.Int32Value `protobuf:"bytes,226,opt,name=_file_transfer_max_idle_conns,json=FileTransferMaxIdleConns,proto3" json:"_file_transfer_max_idle_conns,omitempty"`
+	// The most idle connections to keep open to each storage host. Defaults to
+	// _file_transfer_max_concurrency.
+	XFileTransferMaxIdleConnsPerHost *wrapperspb.Int32Value `protobuf:"bytes,227,opt,name=_file_transfer_max_idle_conns_per_host,json=FileTransferMaxIdleConnsPerHost,proto3" json:"_file_transfer_max_idle_conns_per_host,omitempty"`
+	// The most connections to open to each storage host, or 0 for no limit.
+	XFileTransferMaxConnsPerHost *wrapperspb.Int32Value `protobuf:"bytes,228,opt,name=_file_transfer_max_conns_per_host,json=FileTransferMaxConnsPerHost,proto3" json:"_file_transfer_max_conns_per_host,omitempty"`
+	// Whether to use HTTP/2 for file transfers when storage supports it. If
+	// not set, HTTP/2 is only used without custom TLS settings.
+	XFileTransferHttp2 *wrapperspb.BoolValue `protobuf:"bytes,229,opt,name=_file_transfer_http2,json=FileTransferHttp2,proto3" json:"_file_transfer_http2,omitempty"`
+	// Whether to save artifacts with at least 100,000 entries with the indexed
+	// version 2 manifest format, which the server must support.
+	XArtifactManifestV2 *wrapperspb.BoolValue `protobuf:"bytes,230,opt,name=_artifact_manifest_v2,json=ArtifactManifestV2,proto3" json:"_artifact_manifest_v2,omitempty"`
+	// Maximum size in MB of the records waiting in memory to be sent to W&B. The
+	// rest are written to a file in the run's tmp directory until they can be
+	// sent, instead of making the client wait.
+	XStreamMaxBufferedMb *wrapperspb.Int32Value `protobuf:"bytes,231,opt,name=_stream_max_buffered_mb,json=StreamMaxBufferedMb,proto3" json:"_stream_max_buffered_mb,omitempty"`
+	// Transport for filestream uploads: "http" (default) or "grpc", which
+	// streams uploads over a single gRPC call if the server accepts it and falls
+	// back to "http" otherwise. Streaming requires an https base URL.
+	XFileStreamTransport *wrapperspb.StringValue `protobuf:"bytes,232,opt,name=_file_stream_transport,json=FileStreamTransport,proto3" json:"_file_stream_transport,omitempty"`
 	// Custom proxy servers for the requests to W&B.
 	//
 	// The key is the protocol, e.g. "http", "https", "socks5".
@@ -1719,6 +1881,412 @@ func (x *Settings) GetHttpsProxy() *wrapperspb.StringValue {
 	return nil
 }
 
+func (x *Settings) GetXStatsDcgmProfilingFields() *ListStringValue {
+	if x != nil {
+		return x.XStatsDcgmProfilingFields
+	}
+	return nil
+}
+
+func (x *Settings) GetXStatsDcgmProfilingIntervalSeconds() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.XStatsDcgmProfilingIntervalSeconds
+	}
+	return nil
+}
+
+func (x *Settings) GetXRequestSigningCommand() *ListStringValue {
+	if x != nil {
+		return x.XRequestSigningCommand
+	}
+	return nil
+}
+
+func (x *Settings) GetXRunDescriptor() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.XRunDescriptor
+	}
+	return nil
+}
+
+func (x *Settings) GetXRunDescriptorQr() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.XRunDescriptorQr
+	}
+	return nil
+}
+
+func (x *Settings) GetXConsoleStructured() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.XConsoleStructured
+	}
+	return nil
+}
+
+func (x *Settings) GetXConsoleDedupeLines() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.XConsoleDedupeLines
+	}
+	return nil
+}
+
+func (x *Settings) GetXConsoleUploadBytesPerSecond() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.XConsoleUploadBytesPerSecond
+	}
+	return nil
+}
+
+func (x *Settings) GetXMediaKeepLast() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.XMediaKeepLast
+	}
+	return nil
+}
+
+func (x *Settings) GetXMediaSampleSteps() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.XMediaSampleSteps
+	}
+	return nil
+}
+
+func (x *Settings) GetXHistoryAggregation() *MapStringKeyStringValue {
+	if x != nil {
+		return x.XHistoryAggregation
+	}
+	return nil
+}
+
+func (x *Settings) GetXHistoryAggregationMaxRate() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.XHistoryAggregationMaxRate
+	}
+	return nil
+}
+
+func (x *Settings) GetXHistoryAggregationKeepRaw() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.XHistoryAggregationKeepRaw
+	}
+	return nil
+}
+
+func (x *Settings) GetXDisableOrgDefaults() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.XDisableOrgDefaults
+	}
+	return nil
+}
+
+func (x *Settings) GetXStoreIndex() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.XStoreIndex
+	}
+	return nil
+}
+
+func (x *Settings) GetXStoreCompression() *wrapperspb.StringValue {
+	if x != nil {
+		return x.XStoreCompression
+	}
+	return nil
+}
+
+func (x *Settings) GetNoProxy() *wrapperspb.StringValue {
+	if x != nil {
+		return x.NoProxy
+	}
+	return nil
+}
+
+func (x *Settings) GetCaCertFile() *wrapperspb.StringValue {
+	if x != nil {
+		return x.CaCertFile
+	}
+	return nil
+}
+
+func (x *Settings) GetClientCertFile() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ClientCertFile
+	}
+	return nil
+}
+
+func (x *Settings) GetClientKeyFile() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ClientKeyFile
+	}
+	return nil
+}
+
+func (x *Settings) GetXApiCircuitBreakerThreshold() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.XApiCircuitBreakerThreshold
+	}
+	return nil
+}
+
+func (x *Settings) GetXApiCircuitBreakerCooldownSeconds() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.XApiCircuitBreakerCooldownSeconds
+	}
+	return nil
+}
+
+func (x *Settings) GetXStreamMaxQueuedRecords() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.XStreamMaxQueuedRecords
+	}
+	return nil
+}
+
+func (x *Settings) GetXStreamMaxQueuedMb() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.XStreamMaxQueuedMb
+	}
+	return nil
+}
+
+func (x *Settings) GetSyncTensorboardDir() *wrapperspb.StringValue {
+	if x != nil {
+		return x.SyncTensorboardDir
+	}
+	return nil
+}
+
+func (x *Settings) GetXOtelEndpoint() *wrapperspb.StringValue {
+	if x != nil {
+		return x.XOtelEndpoint
+	}
+	return nil
+}
+
+func (x *Settings) GetXGraphqlCacheTtlSeconds() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.XGraphqlCacheTtlSeconds
+	}
+	return nil
+}
+
+func (x *Settings) GetXGraphqlPersistedQueries() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.XGraphqlPersistedQueries
+	}
+	return nil
+}
+
+func (x *Settings) GetXCoreLogLevel() *wrapperspb.StringValue {
+	if x != nil {
+		return x.XCoreLogLevel
+	}
+	return nil
+}
+
+func (x *Settings) GetXDeferAuth() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.XDeferAuth
+	}
+	return nil
+}
+
+func (x *Settings) GetXUploadBandwidthLimit() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.XUploadBandwidthLimit
+	}
+	return nil
+}
+
+func (x *Settings) GetXLargeUploadWindow() *wrapperspb.StringValue {
+	if x != nil {
+		return x.XLargeUploadWindow
+	}
+	return nil
+}
+
+func (x *Settings) GetXGitPatchUntracked() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.XGitPatchUntracked
+	}
+	return nil
+}
+
+func (x *Settings) GetXGitPatchMaxBytes() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.XGitPatchMaxBytes
+	}
+	return nil
+}
+
+func (x *Settings) GetXEnvSnapshot() *ListStringValue {
+	if x != nil {
+		return x.XEnvSnapshot
+	}
+	return nil
+}
+
+func (x *Settings) GetXWatchdogRules() *ListStringValue {
+	if x != nil {
+		return x.XWatchdogRules
+	}
+	return nil
+}
+
+func (x *Settings) GetXNodeRank() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.XNodeRank
+	}
+	return nil
+}
+
+func (x *Settings) GetIdempotencyKey() *wrapperspb.StringValue {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return nil
+}
+
+func (x *Settings) GetXPreemptionHook() *wrapperspb.StringValue {
+	if x != nil {
+		return x.XPreemptionHook
+	}
+	return nil
+}
+
+func (x *Settings) GetXPreemptionGraceSeconds() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.XPreemptionGraceSeconds
+	}
+	return nil
+}
+
+func (x *Settings) GetXServerSideSummary() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.XServerSideSummary
+	}
+	return nil
+}
+
+func (x *Settings) GetXMaxConfigSummaryKeys() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.XMaxConfigSummaryKeys
+	}
+	return nil
+}
+
+func (x *Settings) GetXMaxConfigSummaryDepth() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.XMaxConfigSummaryDepth
+	}
+	return nil
+}
+
+func (x *Settings) GetXMaxConfigSummaryValueBytes() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.XMaxConfigSummaryValueBytes
+	}
+	return nil
+}
+
+func (x *Settings) GetXS3EndpointUrl() *wrapperspb.StringValue {
+	if x != nil {
+		return x.XS3EndpointUrl
+	}
+	return nil
+}
+
+func (x *Settings) GetXS3PathStyle() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.XS3PathStyle
+	}
+	return nil
+}
+
+func (x *Settings) GetXS3InsecureTls() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.XS3InsecureTls
+	}
+	return nil
+}
+
+func (x *Settings) GetXGcsAudience() *wrapperspb.StringValue {
+	if x != nil {
+		return x.XGcsAudience
+	}
+	return nil
+}
+
+func (x *Settings) GetXGcsScopes() *ListStringValue {
+	if x != nil {
+		return x.XGcsScopes
+	}
+	return nil
+}
+
+func (x *Settings) GetXGcsImpersonateServiceAccount() *wrapperspb.StringValue {
+	if x != nil {
+		return x.XGcsImpersonateServiceAccount
+	}
+	return nil
+}
+
+func (x *Settings) GetXFileTransferMaxConcurrency() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.XFileTransferMaxConcurrency
+	}
+	return nil
+}
+
+func (x *Settings) GetXFileTransferMaxIdleConns() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.XFileTransferMaxIdleConns
+	}
+	return nil
+}
+
+func (x *Settings) GetXFileTransferMaxIdleConnsPerHost() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.XFileTransferMaxIdleConnsPerHost
+	}
+	return nil
+}
+
+func (x *Settings) GetXFileTransferMaxConnsPerHost() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.XFileTransferMaxConnsPerHost
+	}
+	return nil
+}
+
+func (x *Settings) GetXFileTransferHttp2() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.XFileTransferHttp2
+	}
+	return nil
+}
+
+func (x *Settings) GetXArtifactManifestV2() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.XArtifactManifestV2
+	}
+	return nil
+}
+
+func (x *Settings) GetXStreamMaxBufferedMb() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.XStreamMaxBufferedMb
+	}
+	return nil
+}
+
+func (x *Settings) GetXFileStreamTransport() *wrapperspb.StringValue {
+	if x != nil {
+		return x.XFileStreamTransport
+	}
+	return nil
+}
+
 func (x *Settings) GetXProxies() *MapStringKeyStringValue {
 	if x != nil {
 		return x.XProxies
@@ -1776,7 +2344,7 @@ var file_wandb_proto_wandb_settings_proto_rawDesc = []byte{
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x72, 0x75, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76,
 	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
 	0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x22, 0xcb, 0x58, 0x0a, 0x08, 0x53, 0x65,
+	0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x22, 0xdd, 0x7d, 0x0a, 0x08, 0x53, 0x65,
 	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x35, 0x0a, 0x07, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65,
 	0x79, 0x18, 0x37, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
@@ -2480,12 +3048,310 @@ var file_wandb_proto_wandb_settings_proto_rawDesc = []byte{
 	0x70, 0x72, 0x6f, 0x78, 0x79, 0x18, 0xa9, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
 	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x68, 0x74, 0x74, 0x70,
-	0x73, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x43, 0x0a, 0x08, 0x5f, 0x70, 0x72, 0x6f, 0x78, 0x69,
-	0x65, 0x73, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77, 0x61, 0x6e, 0x64,
+	0x73, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x60, 0x0a, 0x1c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73,
+	0x5f, 0x64, 0x63, 0x67, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x5f,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0xac, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x18,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x44, 0x63, 0x67, 0x6d, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x69,
+	0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x70, 0x0a, 0x26, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x73, 0x5f, 0x64, 0x63, 0x67, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x69, 0x6e,
+	0x67, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0xad, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62,
+	0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x21, 0x53, 0x74, 0x61, 0x74, 0x73, 0x44, 0x63,
+	0x67, 0x6d, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x59, 0x0a, 0x18, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x63,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0xae, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x15,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x43, 0x0a, 0x0f, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x18, 0xaf, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0d, 0x52, 0x75, 0x6e,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x48, 0x0a, 0x12, 0x5f, 0x72,
+	0x75, 0x6e, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x5f, 0x71, 0x72,
+	0x18, 0xb0, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x0f, 0x52, 0x75, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x6f, 0x72, 0x51, 0x72, 0x12, 0x4b, 0x0a, 0x13, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65,
+	0x5f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64, 0x18, 0xb1, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x11,
+	0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x64, 0x12, 0x4e, 0x0a, 0x15, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x5f, 0x64, 0x65,
+	0x64, 0x75, 0x70, 0x65, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0xb2, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x12, 0x43,
+	0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x44, 0x65, 0x64, 0x75, 0x70, 0x65, 0x4c, 0x69, 0x6e, 0x65,
+	0x73, 0x12, 0x63, 0x0a, 0x20, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x5f, 0x75, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0xb3, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49,
+	0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x1b, 0x43, 0x6f, 0x6e, 0x73, 0x6f,
+	0x6c, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x45, 0x0a, 0x10, 0x5f, 0x6d, 0x65, 0x64, 0x69, 0x61,
+	0x5f, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x18, 0xb5, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0d,
+	0x4d, 0x65, 0x64, 0x69, 0x61, 0x4b, 0x65, 0x65, 0x70, 0x4c, 0x61, 0x73, 0x74, 0x12, 0x4b, 0x0a,
+	0x13, 0x5f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x73,
+	0x74, 0x65, 0x70, 0x73, 0x18, 0xb6, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e,
+	0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x10, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x53,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x53, 0x74, 0x65, 0x70, 0x73, 0x12, 0x5a, 0x0a, 0x14, 0x5f, 0x68,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0xb7, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77, 0x61, 0x6e, 0x64,
 	0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x4d, 0x61, 0x70, 0x53, 0x74,
 	0x72, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x07, 0x50, 0x72, 0x6f, 0x78, 0x69, 0x65, 0x73, 0x4a, 0x04, 0x08, 0x0c, 0x10,
-	0x0d, 0x4a, 0x04, 0x08, 0x5e, 0x10, 0x5f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x75, 0x65, 0x52, 0x12, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x41, 0x67, 0x67, 0x72, 0x65,
+	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5f, 0x0a, 0x1d, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x5f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d,
+	0x61, 0x78, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0xb8, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x19, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x61, 0x78, 0x52, 0x61, 0x74, 0x65, 0x12, 0x5d, 0x0a, 0x1d, 0x5f, 0x68, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x5f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6b, 0x65, 0x65, 0x70, 0x5f, 0x72, 0x61, 0x77, 0x18, 0xb9, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x19, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4b,
+	0x65, 0x65, 0x70, 0x52, 0x61, 0x77, 0x12, 0x4e, 0x0a, 0x15, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x6f, 0x72, 0x67, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x18,
+	0xba, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x12, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x72, 0x67, 0x44, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x3d, 0x0a, 0x0c, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0xbb, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x4b, 0x0a, 0x12, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f,
+	0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0xbc, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x10, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x08, 0x6e, 0x6f, 0x5f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x18, 0xbd,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x07, 0x6e, 0x6f, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x3f, 0x0a, 0x0c,
+	0x63, 0x61, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0xbe, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x0a, 0x63, 0x61, 0x43, 0x65, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x47, 0x0a,
+	0x10, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x5f, 0x66, 0x69, 0x6c,
+	0x65, 0x18, 0xbf, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x65,
+	0x72, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x45, 0x0a, 0x0f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0xc0, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0d,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x60, 0x0a,
+	0x1e, 0x5f, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x5f, 0x62, 0x72,
+	0x65, 0x61, 0x6b, 0x65, 0x72, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18,
+	0xc1, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x1a, 0x41, 0x70, 0x69, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42,
+	0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12,
+	0x6e, 0x0a, 0x25, 0x5f, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x5f,
+	0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0xc2, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x20, 0x41,
+	0x70, 0x69, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72,
+	0x43, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12,
+	0x58, 0x0a, 0x1a, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0xc3, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x16, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d, 0x61, 0x78, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x4e, 0x0a, 0x15, 0x5f, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x5f,
+	0x6d, 0x62, 0x18, 0xc4, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33,
+	0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x11, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d, 0x61,
+	0x78, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x4d, 0x62, 0x12, 0x4f, 0x0a, 0x14, 0x73, 0x79, 0x6e,
+	0x63, 0x5f, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x64, 0x69,
+	0x72, 0x18, 0xc5, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x12, 0x73, 0x79, 0x6e, 0x63, 0x54, 0x65, 0x6e, 0x73,
+	0x6f, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x44, 0x69, 0x72, 0x12, 0x45, 0x0a, 0x0f, 0x78, 0x5f,
+	0x6f, 0x74, 0x65, 0x6c, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0xc6, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x0d, 0x78, 0x4f, 0x74, 0x65, 0x6c, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x12, 0x59, 0x0a, 0x1a, 0x5f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x5f, 0x63, 0x61,
+	0x63, 0x68, 0x65, 0x5f, 0x74, 0x74, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
+	0xc7, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x16, 0x47, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x43, 0x61, 0x63,
+	0x68, 0x65, 0x54, 0x74, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x58, 0x0a, 0x1a,
+	0x5f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74,
+	0x65, 0x64, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0xc9, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x17, 0x47,
+	0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x64, 0x51,
+	0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x0f, 0x5f, 0x63, 0x6f, 0x72, 0x65, 0x5f,
+	0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0xca, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c,
+	0x43, 0x6f, 0x72, 0x65, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x3b, 0x0a, 0x0b,
+	0x5f, 0x64, 0x65, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x18, 0xcb, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09,
+	0x44, 0x65, 0x66, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x12, 0x53, 0x0a, 0x17, 0x5f, 0x75, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x5f, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0xcc, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e,
+	0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x14, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x4e,
+	0x0a, 0x14, 0x5f, 0x6c, 0x61, 0x72, 0x67, 0x65, 0x5f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0xcd, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x11, 0x4c, 0x61, 0x72,
+	0x67, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x4c,
+	0x0a, 0x14, 0x5f, 0x67, 0x69, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x75, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x18, 0xce, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x11, 0x47, 0x69, 0x74, 0x50, 0x61,
+	0x74, 0x63, 0x68, 0x55, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x4c, 0x0a, 0x14,
+	0x5f, 0x67, 0x69, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0xcf, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e,
+	0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x10, 0x47, 0x69, 0x74, 0x50, 0x61, 0x74,
+	0x63, 0x68, 0x4d, 0x61, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x0d, 0x5f, 0x65,
+	0x6e, 0x76, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0xd0, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x0b, 0x45, 0x6e, 0x76, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x12, 0x48, 0x0a, 0x0f, 0x5f, 0x77, 0x61, 0x74, 0x63, 0x68, 0x64, 0x6f, 0x67, 0x5f, 0x72, 0x75,
+	0x6c, 0x65, 0x73, 0x18, 0xd1, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x77, 0x61, 0x6e,
+	0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0d, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x64, 0x6f, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x5f, 0x6e,
+	0x6f, 0x64, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0xd2, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x4e, 0x6f,
+	0x64, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x46, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0xd3, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e,
+	0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x47,
+	0x0a, 0x10, 0x5f, 0x70, 0x72, 0x65, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x6f,
+	0x6f, 0x6b, 0x18, 0xd4, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x50, 0x72, 0x65, 0x65, 0x6d, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x48, 0x6f, 0x6f, 0x6b, 0x12, 0x58, 0x0a, 0x19, 0x5f, 0x70, 0x72, 0x65, 0x65,
+	0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x67, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x18, 0xd5, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x16, 0x50, 0x72, 0x65, 0x65, 0x6d,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x61, 0x63, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x12, 0x4c, 0x0a, 0x14, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x64,
+	0x65, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0xd7, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x11, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x53, 0x69, 0x64, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12,
+	0x54, 0x0a, 0x18, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x73,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0xd8, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x14, 0x4d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x56, 0x0a, 0x19, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x65, 0x70,
+	0x74, 0x68, 0x18, 0xd9, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33,
+	0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x15, 0x4d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x61, 0x0a,
+	0x1f, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x73, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0xda, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x1a, 0x4d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x12, 0x46, 0x0a, 0x10, 0x5f, 0x73, 0x33, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0xdb, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0d, 0x53, 0x33, 0x45, 0x6e, 0x64,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x40, 0x0a, 0x0e, 0x5f, 0x73, 0x33, 0x5f,
+	0x70, 0x61, 0x74, 0x68, 0x5f, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x18, 0xdc, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x53,
+	0x33, 0x50, 0x61, 0x74, 0x68, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x12, 0x44, 0x0a, 0x10, 0x5f, 0x73,
+	0x33, 0x5f, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x6c, 0x73, 0x18, 0xdd,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x0d, 0x53, 0x33, 0x49, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x54, 0x6c, 0x73,
+	0x12, 0x41, 0x0a, 0x0d, 0x5f, 0x67, 0x63, 0x73, 0x5f, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0xde, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x47, 0x63, 0x73, 0x41, 0x75, 0x64, 0x69, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x5f, 0x67, 0x63, 0x73, 0x5f, 0x73, 0x63, 0x6f, 0x70,
+	0x65, 0x73, 0x18, 0xdf, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x77, 0x61, 0x6e, 0x64,
+	0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x47, 0x63, 0x73, 0x53,
+	0x63, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x65, 0x0a, 0x20, 0x5f, 0x67, 0x63, 0x73, 0x5f, 0x69, 0x6d,
+	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0xe0, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x1c,
+	0x47, 0x63, 0x73, 0x49, 0x6d, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x74, 0x65, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x60, 0x0a, 0x1e,
+	0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x6d,
+	0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0xe1,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x1a, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x4d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x5d,
+	0x0a, 0x1d, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x64, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x73, 0x18,
+	0xe2, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x18, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x4d, 0x61, 0x78, 0x49, 0x64, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x73, 0x12, 0x6d, 0x0a,
+	0x26, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f,
+	0x6d, 0x61, 0x78, 0x5f, 0x69, 0x64, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x73, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x18, 0xe3, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x1f, 0x46, 0x69, 0x6c,
+	0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4d, 0x61, 0x78, 0x49, 0x64, 0x6c, 0x65,
+	0x43, 0x6f, 0x6e, 0x6e, 0x73, 0x50, 0x65, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x64, 0x0a, 0x21,
+	0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x6d,
+	0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x68, 0x6f, 0x73,
+	0x74, 0x18, 0xe4, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x1b, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x4d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x6e, 0x73, 0x50, 0x65, 0x72, 0x48, 0x6f,
+	0x73, 0x74, 0x12, 0x4c, 0x0a, 0x14, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x5f, 0x68, 0x74, 0x74, 0x70, 0x32, 0x18, 0xe5, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x11, 0x46,
+	0x69, 0x6c, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x48, 0x74, 0x74, 0x70, 0x32,
+	0x12, 0x4e, 0x0a, 0x15, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x5f, 0x6d, 0x61,
+	0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x5f, 0x76, 0x32, 0x18, 0xe6, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x12, 0x41, 0x72,
+	0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x56, 0x32,
+	0x12, 0x52, 0x0a, 0x17, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x6d, 0x61, 0x78, 0x5f,
+	0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x6d, 0x62, 0x18, 0xe7, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x13, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d, 0x61, 0x78, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72,
+	0x65, 0x64, 0x4d, 0x62, 0x12, 0x52, 0x0a, 0x16, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x18, 0xe8,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x13, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x43, 0x0a, 0x08, 0x5f, 0x70, 0x72, 0x6f,
+	0x78, 0x69, 0x65, 0x73, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77, 0x61,
+	0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x4d, 0x61, 0x70,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x50, 0x72, 0x6f, 0x78, 0x69, 0x65, 0x73, 0x4a, 0x04, 0x08,
+	0x0c, 0x10, 0x0d, 0x4a, 0x04, 0x08, 0x5e, 0x10, 0x5f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -2514,6 +3380,7 @@ var file_wandb_proto_wandb_settings_proto_goTypes = []interface{}{
 	(*wrapperspb.BoolValue)(nil),                // 9: google.protobuf.BoolValue
 	(*wrapperspb.DoubleValue)(nil),              // 10: google.protobuf.DoubleValue
 	(*wrapperspb.Int32Value)(nil),               // 11: google.protobuf.Int32Value
+	(*wrapperspb.Int64Value)(nil),               // 12: google.protobuf.Int64Value
 }
 var file_wandb_proto_wandb_settings_proto_depIdxs = []int32{
 	6,   // 0: wandb_internal.MapStringKeyStringValue.value:type_name -> wandb_internal.MapStringKeyStringValue.ValueEntry
@@ -2687,13 +3554,71 @@ var file_wandb_proto_wandb_settings_proto_depIdxs = []int32{
 	9,   // 168: wandb_internal.Settings.console_multipart:type_name -> google.protobuf.BoolValue
 	8,   // 169: wandb_internal.Settings.http_proxy:type_name -> google.protobuf.StringValue
 	8,   // 170: wandb_internal.Settings.https_proxy:type_name -> google.protobuf.StringValue
-	1,   // 171: wandb_internal.Settings._proxies:type_name -> wandb_internal.MapStringKeyStringValue
-	1,   // 172: wandb_internal.MapStringKeyMapStringKeyStringValue.ValueEntry.value:type_name -> wandb_internal.MapStringKeyStringValue
-	173, // [173:173] is the sub-list for method output_type
-	173, // [173:173] is the sub-list for method input_type
-	173, // [173:173] is the sub-list for extension type_name
-	173, // [173:173] is the sub-list for extension extendee
-	0,   // [0:173] is the sub-list for field type_name
+	0,   // 171: wandb_internal.Settings._stats_dcgm_profiling_fields:type_name -> wandb_internal.ListStringValue
+	10,  // 172: wandb_internal.Settings._stats_dcgm_profiling_interval_seconds:type_name -> google.protobuf.DoubleValue
+	0,   // 173: wandb_internal.Settings._request_signing_command:type_name -> wandb_internal.ListStringValue
+	9,   // 174: wandb_internal.Settings._run_descriptor:type_name -> google.protobuf.BoolValue
+	9,   // 175: wandb_internal.Settings._run_descriptor_qr:type_name -> google.protobuf.BoolValue
+	9,   // 176: wandb_internal.Settings._console_structured:type_name -> google.protobuf.BoolValue
+	9,   // 177: wandb_internal.Settings._console_dedupe_lines:type_name -> google.protobuf.BoolValue
+	11,  // 178: wandb_internal.Settings._console_upload_bytes_per_second:type_name -> google.protobuf.Int32Value
+	11,  // 179: wandb_internal.Settings._media_keep_last:type_name -> google.protobuf.Int32Value
+	11,  // 180: wandb_internal.Settings._media_sample_steps:type_name -> google.protobuf.Int32Value
+	1,   // 181: wandb_internal.Settings._history_aggregation:type_name -> wandb_internal.MapStringKeyStringValue
+	10,  // 182: wandb_internal.Settings._history_aggregation_max_rate:type_name -> google.protobuf.DoubleValue
+	9,   // 183: wandb_internal.Settings._history_aggregation_keep_raw:type_name -> google.protobuf.BoolValue
+	9,   // 184: wandb_internal.Settings._disable_org_defaults:type_name -> google.protobuf.BoolValue
+	9,   // 185: wandb_internal.Settings._store_index:type_name -> google.protobuf.BoolValue
+	8,   // 186: wandb_internal.Settings._store_compression:type_name -> google.protobuf.StringValue
+	8,   // 187: wandb_internal.Settings.no_proxy:type_name -> google.protobuf.StringValue
+	8,   // 188: wandb_internal.Settings.ca_cert_file:type_name -> google.protobuf.StringValue
+	8,   // 189: wandb_internal.Settings.client_cert_file:type_name -> google.protobuf.StringValue
+	8,   // 190: wandb_internal.Settings.client_key_file:type_name -> google.protobuf.StringValue
+	11,  // 191: wandb_internal.Settings._api_circuit_breaker_threshold:type_name -> google.protobuf.Int32Value
+	10,  // 192: wandb_internal.Settings._api_circuit_breaker_cooldown_seconds:type_name -> google.protobuf.DoubleValue
+	11,  // 193: wandb_internal.Settings._stream_max_queued_records:type_name -> google.protobuf.Int32Value
+	11,  // 194: wandb_internal.Settings._stream_max_queued_mb:type_name -> google.protobuf.Int32Value
+	8,   // 195: wandb_internal.Settings.sync_tensorboard_dir:type_name -> google.protobuf.StringValue
+	8,   // 196: wandb_internal.Settings.x_otel_endpoint:type_name -> google.protobuf.StringValue
+	10,  // 197: wandb_internal.Settings._graphql_cache_ttl_seconds:type_name -> google.protobuf.DoubleValue
+	9,   // 198: wandb_internal.Settings._graphql_persisted_queries:type_name -> google.protobuf.BoolValue
+	8,   // 199: wandb_internal.Settings._core_log_level:type_name -> google.protobuf.StringValue
+	9,   // 200: wandb_internal.Settings._defer_auth:type_name -> google.protobuf.BoolValue
+	12,  // 201: wandb_internal.Settings._upload_bandwidth_limit:type_name -> google.protobuf.Int64Value
+	8,   // 202: wandb_internal.Settings._large_upload_window:type_name -> google.protobuf.StringValue
+	9,   // 203: wandb_internal.Settings._git_patch_untracked:type_name -> google.protobuf.BoolValue
+	12,  // 204: wandb_internal.Settings._git_patch_max_bytes:type_name -> google.protobuf.Int64Value
+	0,   // 205: wandb_internal.Settings._env_snapshot:type_name -> wandb_internal.ListStringValue
+	0,   // 206: wandb_internal.Settings._watchdog_rules:type_name -> wandb_internal.ListStringValue
+	11,  // 207: wandb_internal.Settings._node_rank:type_name -> google.protobuf.Int32Value
+	8,   // 208: wandb_internal.Settings.idempotency_key:type_name -> google.protobuf.StringValue
+	8,   // 209: wandb_internal.Settings._preemption_hook:type_name -> google.protobuf.StringValue
+	10,  // 210: wandb_internal.Settings._preemption_grace_seconds:type_name -> google.protobuf.DoubleValue
+	9,   // 211: wandb_internal.Settings._server_side_summary:type_name -> google.protobuf.BoolValue
+	11,  // 212: wandb_internal.Settings._max_config_summary_keys:type_name -> google.protobuf.Int32Value
+	11,  // 213: wandb_internal.Settings._max_config_summary_depth:type_name -> google.protobuf.Int32Value
+	11,  // 214: wandb_internal.Settings._max_config_summary_value_bytes:type_name -> google.protobuf.Int32Value
+	8,   // 215: wandb_internal.Settings._s3_endpoint_url:type_name -> google.protobuf.StringValue
+	9,   // 216: wandb_internal.Settings._s3_path_style:type_name -> google.protobuf.BoolValue
+	9,   // 217: wandb_internal.Settings._s3_insecure_tls:type_name -> google.protobuf.BoolValue
+	8,   // 218: wandb_internal.Settings._gcs_audience:type_name -> google.protobuf.StringValue
+	0,   // 219: wandb_internal.Settings._gcs_scopes:type_name -> wandb_internal.ListStringValue
+	8,   // 220: wandb_internal.Settings._gcs_impersonate_service_account:type_name -> google.protobuf.StringValue
+	11,  // 221: wandb_internal.Settings._file_transfer_max_concurrency:type_name -> google.protobuf.Int32Value
+	11,  // 222: wandb_internal.Settings._file_transfer_max_idle_conns:type_name -> google.protobuf.Int32Value
+	11,  // 223: wandb_internal.Settings._file_transfer_max_idle_conns_per_host:type_name -> google.protobuf.Int32Value
+	11,  // 224: wandb_internal.Settings._file_transfer_max_conns_per_host:type_name -> google.protobuf.Int32Value
+	9,   // 225: wandb_internal.Settings._file_transfer_http2:type_name -> google.protobuf.BoolValue
+	9,   // 226: wandb_internal.Settings._artifact_manifest_v2:type_name -> google.protobuf.BoolValue
+	11,  // 227: wandb_internal.Settings._stream_max_buffered_mb:type_name -> google.protobuf.Int32Value
+	8,   // 228: wandb_internal.Settings._file_stream_transport:type_name -> google.protobuf.StringValue
+	1,   // 229: wandb_internal.Settings._proxies:type_name -> wandb_internal.MapStringKeyStringValue
+	1,   // 230: wandb_internal.MapStringKeyMapStringKeyStringValue.ValueEntry.value:type_name -> wandb_internal.MapStringKeyStringValue
+	231, // [231:231] is the sub-list for method output_type
+	231, // [231:231] is the sub-list for method input_type
+	231, // [231:231] is the sub-list for extension type_name
+	231, // [231:231] is the sub-list for extension extendee
+	0,   // [0:231] is the sub-list for field type_name
 }
 
 func init() { file_wandb_proto_wandb_settings_proto_init() }
@@ -33,13 +33,17 @@ type TelemetryRecord struct {
 	CliVersion         string   `protobuf:"bytes,5,opt,name=cli_version,json=cliVersion,proto3" json:"cli_version,omitempty"`
 	HuggingfaceVersion string   `protobuf:"bytes,6,opt,name=huggingface_version,json=huggingfaceVersion,proto3" json:"huggingface_version,omitempty"`
 	// string  framework = 7;
-	Env         *Env         `protobuf:"bytes,8,opt,name=env,proto3" json:"env,omitempty"`
-	Label       *Labels      `protobuf:"bytes,9,opt,name=label,proto3" json:"label,omitempty"`
-	Deprecated  *Deprecated  `protobuf:"bytes,10,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
-	Issues      *Issues      `protobuf:"bytes,11,opt,name=issues,proto3" json:"issues,omitempty"`
-	CoreVersion string       `protobuf:"bytes,12,opt,name=core_version,json=coreVersion,proto3" json:"core_version,omitempty"`
-	Platform    string       `protobuf:"bytes,13,opt,name=platform,proto3" json:"platform,omitempty"`
-	XInfo       *XRecordInfo `protobuf:"bytes,200,opt,name=_info,json=Info,proto3" json:"_info,omitempty"`
+	Env                 *Env         `protobuf:"bytes,8,opt,name=env,proto3" json:"env,omitempty"`
+	Label               *Labels      `protobuf:"bytes,9,opt,name=label,proto3" json:"label,omitempty"`
+	Deprecated          *Deprecated  `protobuf:"bytes,10,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	Issues              *Issues      `protobuf:"bytes,11,opt,name=issues,proto3" json:"issues,omitempty"`
+	CoreVersion         string       `protobuf:"bytes,12,opt,name=core_version,json=coreVersion,proto3" json:"core_version,omitempty"`
+	Platform            string       `protobuf:"bytes,13,opt,name=platform,proto3" json:"platform,omitempty"`
+	MediaSampledOut     int32        `protobuf:"varint,14,opt,name=media_sampled_out,json=mediaSampledOut,proto3" json:"media_sampled_out,omitempty"`             // media files dropped by _media_sample_steps
+	MediaRotated        int32        `protobuf:"varint,15,opt,name=media_rotated,json=mediaRotated,proto3" json:"media_rotated,omitempty"`                        // media files deleted by _media_keep_last
+	ConfigItemsDropped  int32        `protobuf:"varint,16,opt,name=config_items_dropped,json=configItemsDropped,proto3" json:"config_items_dropped,omitempty"`    // config items over the key limits
+	SummaryItemsDropped int32        `protobuf:"varint,17,opt,name=summary_items_dropped,json=summaryItemsDropped,proto3" json:"summary_items_dropped,omitempty"` // summary items over the key limits
+	XInfo               *XRecordInfo `protobuf:"bytes,200,opt,name=_info,json=Info,proto3" json:"_info,omitempty"`
 }
 
 func (x *TelemetryRecord) Reset() {
@@ -158,6 +162,34 @@ func (x *TelemetryRecord) GetPlatform() string {
 	return ""
 }
 
+func (x *TelemetryRecord) GetMediaSampledOut() int32 {
+	if x != nil {
+		return x.MediaSampledOut
+	}
+	return 0
+}
+
+func (x *TelemetryRecord) GetMediaRotated() int32 {
+	if x != nil {
+		return x.MediaRotated
+	}
+	return 0
+}
+
+func (x *TelemetryRecord) GetConfigItemsDropped() int32 {
+	if x != nil {
+		return x.ConfigItemsDropped
+	}
+	return 0
+}
+
+func (x *TelemetryRecord) GetSummaryItemsDropped() int32 {
+	if x != nil {
+		return x.SummaryItemsDropped
+	}
+	return 0
+}
+
 func (x *TelemetryRecord) GetXInfo() *XRecordInfo {
 	if x != nil {
 		return x.XInfo
@@ -2038,7 +2070,7 @@ var file_wandb_proto_wandb_telemetry_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
 	0x6e, 0x61, 0x6c, 0x1a, 0x1c, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x2f, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0xec, 0x04, 0x0a, 0x0f, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x52,
+	0x6f, 0x22, 0xa3, 0x06, 0x0a, 0x0f, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x52,
 	0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x3a, 0x0a, 0x0c, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73,
 	0x5f, 0x69, 0x6e, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x77, 0x61,
 	0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x49, 0x6d, 0x70,
@@ -2073,429 +2105,441 @@ var file_wandb_proto_wandb_telemetry_proto_rawDesc = []byte{
 	0x0a, 0x0c, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0c,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x72, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
 	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x0d, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x31, 0x0a,
-	0x05, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
-	0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x5f,
-	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x49, 0x6e, 0x66, 0x6f,
-	0x22, 0x11, 0x0a, 0x0f, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x22, 0xa6, 0x15, 0x0a, 0x07, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12,
-	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x72, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
-	0x74, 0x6f, 0x72, 0x63, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x6b, 0x65, 0x72, 0x61, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6b, 0x65, 0x72, 0x61, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x74,
-	0x65, 0x6e, 0x73, 0x6f, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0a, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x66,
-	0x61, 0x73, 0x74, 0x61, 0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x66, 0x61, 0x73,
-	0x74, 0x61, 0x69, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6b, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x6b, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x12, 0x18, 0x0a,
-	0x07, 0x78, 0x67, 0x62, 0x6f, 0x6f, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x78, 0x67, 0x62, 0x6f, 0x6f, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x62, 0x6f,
-	0x6f, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x61, 0x74, 0x62, 0x6f,
-	0x6f, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x67, 0x62, 0x6d, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x67, 0x62, 0x6d, 0x12,
-	0x2b, 0x0a, 0x11, 0x70, 0x79, 0x74, 0x6f, 0x72, 0x63, 0x68, 0x5f, 0x6c, 0x69, 0x67, 0x68, 0x74,
-	0x6e, 0x69, 0x6e, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x70, 0x79, 0x74, 0x6f,
-	0x72, 0x63, 0x68, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06,
-	0x69, 0x67, 0x6e, 0x69, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x67,
-	0x6e, 0x69, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72,
-	0x6d, 0x65, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6a, 0x61, 0x78, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x6a, 0x61, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65,
-	0x74, 0x61, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6d, 0x65,
-	0x74, 0x61, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x6e,
-	0x6c, 0x70, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x6e,
-	0x6c, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x6c, 0x75, 0x6f, 0x6e, 0x18,
-	0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x6c, 0x75, 0x6f, 0x6e,
-	0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x65, 0x72, 0x61, 0x73, 0x18, 0x10, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x65, 0x72, 0x61, 0x73, 0x12, 0x1a,
-	0x0a, 0x08, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x79, 0x73, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x08, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x79, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65,
-	0x65, 0x70, 0x63, 0x68, 0x65, 0x6d, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x65,
-	0x65, 0x70, 0x63, 0x68, 0x65, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x65, 0x70, 0x63, 0x74,
-	0x72, 0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x65, 0x70, 0x63, 0x74, 0x72,
-	0x12, 0x18, 0x0a, 0x07, 0x70, 0x79, 0x63, 0x61, 0x72, 0x65, 0x74, 0x18, 0x1c, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x70, 0x79, 0x63, 0x61, 0x72, 0x65, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x79,
-	0x74, 0x6f, 0x72, 0x63, 0x68, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0c, 0x70, 0x79, 0x74, 0x6f, 0x72, 0x63, 0x68, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x10,
-	0x0a, 0x03, 0x72, 0x61, 0x79, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x72, 0x61, 0x79,
-	0x12, 0x2e, 0x0a, 0x12, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x6f, 0x72, 0x6d, 0x65, 0x72, 0x73, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x73, 0x69,
-	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x73,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x6b, 0x6f, 0x72, 0x63, 0x68, 0x18, 0x20, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x06, 0x73, 0x6b, 0x6f, 0x72, 0x63, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x61, 0x63,
-	0x79, 0x18, 0x21, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x70, 0x61, 0x63, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x66, 0x6c, 0x61, 0x73, 0x68, 0x18, 0x22, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66,
-	0x6c, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x70, 0x74, 0x75, 0x6e, 0x61, 0x18, 0x23,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x70, 0x74, 0x75, 0x6e, 0x61, 0x12, 0x18, 0x0a, 0x07,
-	0x72, 0x65, 0x63, 0x62, 0x6f, 0x6c, 0x65, 0x18, 0x24, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72,
-	0x65, 0x63, 0x62, 0x6f, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6d, 0x63, 0x76, 0x18, 0x25,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6d, 0x6d, 0x63, 0x76, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6d,
-	0x64, 0x65, 0x74, 0x18, 0x26, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6d, 0x6d, 0x64, 0x65, 0x74,
-	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x72, 0x63, 0x68, 0x64, 0x72, 0x75, 0x67, 0x18, 0x27, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x6f, 0x72, 0x63, 0x68, 0x64, 0x72, 0x75, 0x67, 0x12, 0x1c,
-	0x0a, 0x09, 0x74, 0x6f, 0x72, 0x63, 0x68, 0x74, 0x65, 0x78, 0x74, 0x18, 0x28, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x09, 0x74, 0x6f, 0x72, 0x63, 0x68, 0x74, 0x65, 0x78, 0x74, 0x12, 0x20, 0x0a, 0x0b,
-	0x74, 0x6f, 0x72, 0x63, 0x68, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x29, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0b, 0x74, 0x6f, 0x72, 0x63, 0x68, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14,
-	0x0a, 0x05, 0x65, 0x6c, 0x65, 0x67, 0x79, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x65,
-	0x6c, 0x65, 0x67, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x72, 0x6f,
-	0x6e, 0x32, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74,
-	0x72, 0x6f, 0x6e, 0x32, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x69, 0x72, 0x18, 0x2c, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x69, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x6c,
-	0x61, 0x78, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x66, 0x6c, 0x61, 0x78, 0x12, 0x12,
-	0x0a, 0x04, 0x73, 0x79, 0x66, 0x74, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x73, 0x79,
-	0x66, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x54, 0x54, 0x53, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x03, 0x54, 0x54, 0x53, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x6e, 0x61, 0x69, 0x18, 0x30, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x05, 0x6d, 0x6f, 0x6e, 0x61, 0x69, 0x12, 0x27, 0x0a, 0x0f, 0x68, 0x75,
-	0x67, 0x67, 0x69, 0x6e, 0x67, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x68, 0x75, 0x62, 0x18, 0x31, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0e, 0x68, 0x75, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x66, 0x61, 0x63, 0x65,
-	0x48, 0x75, 0x62, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x79, 0x64, 0x72, 0x61, 0x18, 0x32, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x05, 0x68, 0x79, 0x64, 0x72, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74,
-	0x61, 0x73, 0x65, 0x74, 0x73, 0x18, 0x33, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x61, 0x74,
-	0x61, 0x73, 0x65, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x61, 0x63, 0x72, 0x65, 0x64, 0x18,
-	0x34, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x61, 0x63, 0x72, 0x65, 0x64, 0x12, 0x16, 0x0a,
-	0x06, 0x6a, 0x6f, 0x62, 0x6c, 0x69, 0x62, 0x18, 0x35, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6a,
-	0x6f, 0x62, 0x6c, 0x69, 0x62, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x73, 0x6b, 0x18, 0x36, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x61, 0x73, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x73, 0x79,
-	0x6e, 0x63, 0x69, 0x6f, 0x18, 0x37, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x73, 0x79, 0x6e,
-	0x63, 0x69, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x64, 0x64, 0x6c, 0x65, 0x6f, 0x63, 0x72,
-	0x18, 0x38, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x70, 0x61, 0x64, 0x64, 0x6c, 0x65, 0x6f, 0x63,
-	0x72, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x70, 0x64, 0x65, 0x74, 0x18, 0x39, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x05, 0x70, 0x70, 0x64, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x64, 0x64, 0x6c,
-	0x65, 0x73, 0x65, 0x67, 0x18, 0x3a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x70, 0x61, 0x64, 0x64,
-	0x6c, 0x65, 0x73, 0x65, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x64, 0x64, 0x6c, 0x65, 0x6e,
-	0x6c, 0x70, 0x18, 0x3b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x70, 0x61, 0x64, 0x64, 0x6c, 0x65,
-	0x6e, 0x6c, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6d, 0x73, 0x65, 0x67, 0x18, 0x3c, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x05, 0x6d, 0x6d, 0x73, 0x65, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6d, 0x6f,
-	0x63, 0x72, 0x18, 0x3d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6d, 0x6d, 0x6f, 0x63, 0x72, 0x12,
-	0x14, 0x0a, 0x05, 0x6d, 0x6d, 0x63, 0x6c, 0x73, 0x18, 0x3e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
-	0x6d, 0x6d, 0x63, 0x6c, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x6d, 0x18, 0x3f, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x61, 0x69,
-	0x72, 0x73, 0x65, 0x71, 0x18, 0x40, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x66, 0x61, 0x69, 0x72,
-	0x73, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x65, 0x70, 0x63, 0x68, 0x65, 0x63, 0x6b,
-	0x73, 0x18, 0x41, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x65, 0x70, 0x63, 0x68, 0x65,
-	0x63, 0x6b, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x18,
-	0x42, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x12,
-	0x1a, 0x0a, 0x08, 0x73, 0x70, 0x61, 0x72, 0x73, 0x65, 0x6d, 0x6c, 0x18, 0x43, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x08, 0x73, 0x70, 0x61, 0x72, 0x73, 0x65, 0x6d, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x61,
-	0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x69, 0x62, 0x18, 0x44, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61,
-	0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x69, 0x62, 0x12, 0x14, 0x0a, 0x05, 0x7a, 0x65, 0x6e, 0x6d, 0x6c,
-	0x18, 0x45, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x7a, 0x65, 0x6e, 0x6d, 0x6c, 0x12, 0x1e, 0x0a,
-	0x0a, 0x63, 0x6f, 0x6c, 0x6f, 0x73, 0x73, 0x61, 0x6c, 0x61, 0x69, 0x18, 0x46, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6f, 0x73, 0x73, 0x61, 0x6c, 0x61, 0x69, 0x12, 0x1e, 0x0a,
-	0x0a, 0x61, 0x63, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x65, 0x18, 0x47, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x6d, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x18, 0x48, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6d,
-	0x65, 0x72, 0x6c, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x61, 0x6e, 0x6f, 0x64, 0x65, 0x74,
-	0x18, 0x49, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6e, 0x61, 0x6e, 0x6f, 0x64, 0x65, 0x74, 0x12,
-	0x3e, 0x0a, 0x1b, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x5f, 0x70, 0x79, 0x74, 0x6f, 0x72, 0x63, 0x68, 0x18, 0x4a,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x19, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x50, 0x79, 0x74, 0x6f, 0x72, 0x63, 0x68, 0x12,
-	0x33, 0x0a, 0x15, 0x73, 0x65, 0x6e, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x73, 0x18, 0x4b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14,
-	0x73, 0x65, 0x6e, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72,
-	0x6d, 0x65, 0x72, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x67, 0x6c, 0x18, 0x4c, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x03, 0x64, 0x67, 0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x6f, 0x72, 0x63, 0x68, 0x5f,
-	0x67, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x4d, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0e, 0x74, 0x6f, 0x72, 0x63, 0x68, 0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12,
-	0x12, 0x0a, 0x04, 0x6a, 0x69, 0x6e, 0x61, 0x18, 0x4e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6a,
-	0x69, 0x6e, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x6b, 0x6f, 0x72, 0x6e, 0x69, 0x61, 0x18, 0x4f, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x06, 0x6b, 0x6f, 0x72, 0x6e, 0x69, 0x61, 0x12, 0x26, 0x0a, 0x0e, 0x61,
-	0x6c, 0x62, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x50, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6b, 0x65, 0x72, 0x61, 0x73, 0x5f, 0x63, 0x76, 0x18,
-	0x51, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6b, 0x65, 0x72, 0x61, 0x73, 0x43, 0x76, 0x12, 0x1a,
-	0x0a, 0x08, 0x6d, 0x6d, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x18, 0x52, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x08, 0x6d, 0x6d, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69,
-	0x66, 0x66, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x53, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x64,
-	0x69, 0x66, 0x66, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x72, 0x6c, 0x18,
-	0x54, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x74, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x72,
-	0x6c, 0x78, 0x18, 0x55, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x74, 0x72, 0x6c, 0x78, 0x12, 0x1c,
-	0x0a, 0x09, 0x6c, 0x61, 0x6e, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x56, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x09, 0x6c, 0x61, 0x6e, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x1f, 0x0a, 0x0b,
-	0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x57, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0a, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x23, 0x0a,
-	0x0d, 0x73, 0x74, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x73, 0x64, 0x6b, 0x18, 0x58,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x53,
-	0x64, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x65, 0x66, 0x65, 0x63, 0x74, 0x18, 0x59, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x65, 0x66, 0x65, 0x63, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
-	0x70, 0x72, 0x65, 0x66, 0x65, 0x63, 0x74, 0x5f, 0x72, 0x61, 0x79, 0x18, 0x5a, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x63, 0x74, 0x52, 0x61, 0x79, 0x12, 0x1a, 0x0a,
-	0x08, 0x70, 0x69, 0x6e, 0x65, 0x63, 0x6f, 0x6e, 0x65, 0x18, 0x5b, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x08, 0x70, 0x69, 0x6e, 0x65, 0x63, 0x6f, 0x6e, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x72,
-	0x6f, 0x6d, 0x61, 0x64, 0x62, 0x18, 0x5c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x68, 0x72,
-	0x6f, 0x6d, 0x61, 0x64, 0x62, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74,
-	0x65, 0x18, 0x5d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74,
-	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x18, 0x5e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x69, 0x18, 0x5f, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x69, 0x12, 0x16, 0x0a, 0x06, 0x63,
-	0x6f, 0x68, 0x65, 0x72, 0x65, 0x18, 0x60, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x6f, 0x68,
-	0x65, 0x72, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6e, 0x74, 0x68, 0x72, 0x6f, 0x70, 0x69, 0x63,
-	0x18, 0x61, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x6e, 0x74, 0x68, 0x72, 0x6f, 0x70, 0x69,
-	0x63, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x65, 0x66, 0x74, 0x18, 0x62, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x04, 0x70, 0x65, 0x66, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x6d,
-	0x18, 0x63, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x6d, 0x12,
-	0x1a, 0x0a, 0x08, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x08, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c,
-	0x61, 0x6e, 0x67, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x65, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6c,
-	0x61, 0x6e, 0x67, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x1d, 0x0a, 0x0a, 0x6b, 0x65, 0x72, 0x61, 0x73,
-	0x5f, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x66, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6b, 0x65, 0x72,
-	0x61, 0x73, 0x43, 0x6f, 0x72, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e,
-	0x69, 0x6e, 0x67, 0x5f, 0x66, 0x61, 0x62, 0x72, 0x69, 0x63, 0x18, 0x67, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x62, 0x72, 0x69,
-	0x63, 0x12, 0x31, 0x0a, 0x14, 0x63, 0x75, 0x72, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x73, 0x18, 0x68, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x13, 0x63, 0x75, 0x72, 0x61, 0x74, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72,
-	0x6d, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x69,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x72, 0x6a, 0x73, 0x6f, 0x6e, 0x22, 0xd3, 0x13, 0x0a,
-	0x07, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x61, 0x74, 0x63,
-	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x77, 0x61, 0x74, 0x63, 0x68, 0x12, 0x16,
-	0x0a, 0x06, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
-	0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x61, 0x76, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x73, 0x61, 0x76, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x66,
-	0x66, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6f, 0x66, 0x66,
-	0x6c, 0x69, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x67, 0x72, 0x70, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x67, 0x72,
-	0x70, 0x63, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x6b, 0x65,
-	0x72, 0x61, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6b, 0x65, 0x72, 0x61, 0x73,
-	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x61, 0x67, 0x65, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x61, 0x67, 0x65, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x31,
-	0x0a, 0x14, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x6e, 0x63, 0x72, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x61, 0x72,
-	0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61,
-	0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x0b, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x18, 0x0a,
-	0x07, 0x70, 0x72, 0x6f, 0x64, 0x69, 0x67, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x70, 0x72, 0x6f, 0x64, 0x69, 0x67, 0x79, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x65, 0x74, 0x5f, 0x69,
-	0x6e, 0x69, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
-	0x73, 0x65, 0x74, 0x49, 0x6e, 0x69, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0b, 0x73,
-	0x65, 0x74, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x09, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x69, 0x74, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x73,
-	0x65, 0x74, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x0f, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0b, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x69, 0x74, 0x54, 0x61, 0x67, 0x73, 0x12,
-	0x26, 0x0a, 0x0f, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x69,
-	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x20, 0x0a, 0x0c, 0x73, 0x65, 0x74, 0x5f, 0x72,
-	0x75, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73,
-	0x65, 0x74, 0x52, 0x75, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x73, 0x65, 0x74,
-	0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0a, 0x73, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x54, 0x61, 0x67, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x73,
-	0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x13,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49,
-	0x74, 0x65, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x18, 0x14, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x06, 0x6c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x12, 0x30, 0x0a, 0x14, 0x74,
-	0x6f, 0x72, 0x63, 0x68, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x72, 0x5f, 0x74, 0x72,
-	0x61, 0x63, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x74, 0x6f, 0x72, 0x63, 0x68,
-	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x72, 0x54, 0x72, 0x61, 0x63, 0x65, 0x12, 0x10, 0x0a,
-	0x03, 0x73, 0x62, 0x33, 0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x73, 0x62, 0x33, 0x12,
-	0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x69, 0x6e, 0x69,
-	0x74, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x18, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0d, 0x69, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x75,
-	0x6e, 0x12, 0x36, 0x0a, 0x17, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x67, 0x62, 0x6d, 0x5f, 0x77, 0x61,
-	0x6e, 0x64, 0x62, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x19, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x15, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x67, 0x62, 0x6d, 0x57, 0x61, 0x6e, 0x64,
-	0x62, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x30, 0x0a, 0x14, 0x6c, 0x69, 0x67,
-	0x68, 0x74, 0x67, 0x62, 0x6d, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72,
-	0x79, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x67, 0x62,
-	0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x36, 0x0a, 0x17, 0x63,
-	0x61, 0x74, 0x62, 0x6f, 0x6f, 0x73, 0x74, 0x5f, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x63, 0x61,
-	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x63, 0x61,
-	0x74, 0x62, 0x6f, 0x6f, 0x73, 0x74, 0x57, 0x61, 0x6e, 0x64, 0x62, 0x43, 0x61, 0x6c, 0x6c, 0x62,
-	0x61, 0x63, 0x6b, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x61, 0x74, 0x62, 0x6f, 0x6f, 0x73, 0x74, 0x5f,
-	0x6c, 0x6f, 0x67, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x1c, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x12, 0x63, 0x61, 0x74, 0x62, 0x6f, 0x6f, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x53, 0x75,
-	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x62,
-	0x6f, 0x61, 0x72, 0x64, 0x5f, 0x6c, 0x6f, 0x67, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
-	0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x4c, 0x6f, 0x67, 0x12, 0x25,
-	0x0a, 0x0e, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x68, 0x6f, 0x6f, 0x6b,
-	0x18, 0x1e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x6f,
-	0x72, 0x48, 0x6f, 0x6f, 0x6b, 0x12, 0x34, 0x0a, 0x16, 0x78, 0x67, 0x62, 0x6f, 0x6f, 0x73, 0x74,
-	0x5f, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18,
-	0x1f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x78, 0x67, 0x62, 0x6f, 0x6f, 0x73, 0x74, 0x57, 0x61,
-	0x6e, 0x64, 0x62, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x3b, 0x0a, 0x1a, 0x78,
-	0x67, 0x62, 0x6f, 0x6f, 0x73, 0x74, 0x5f, 0x6f, 0x6c, 0x64, 0x5f, 0x77, 0x61, 0x6e, 0x64, 0x62,
-	0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x20, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x17, 0x78, 0x67, 0x62, 0x6f, 0x6f, 0x73, 0x74, 0x4f, 0x6c, 0x64, 0x57, 0x61, 0x6e, 0x64, 0x62,
-	0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x74, 0x74, 0x61,
-	0x63, 0x68, 0x18, 0x21, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68,
-	0x12, 0x2b, 0x0a, 0x11, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f,
-	0x70, 0x61, 0x74, 0x63, 0x68, 0x18, 0x22, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x74, 0x65, 0x6e,
-	0x73, 0x6f, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x50, 0x61, 0x74, 0x63, 0x68, 0x12, 0x29, 0x0a,
-	0x10, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x73, 0x79, 0x6e,
-	0x63, 0x18, 0x23, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x62,
-	0x6f, 0x61, 0x72, 0x64, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x22, 0x0a, 0x0d, 0x6b, 0x66, 0x70, 0x5f,
-	0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x6c, 0x6f, 0x67, 0x18, 0x24, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0b, 0x6b, 0x66, 0x70, 0x57, 0x61, 0x6e, 0x64, 0x62, 0x4c, 0x6f, 0x67, 0x12, 0x2e, 0x0a, 0x13,
-	0x6d, 0x61, 0x79, 0x62, 0x65, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72,
-	0x69, 0x74, 0x65, 0x18, 0x25, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x6d, 0x61, 0x79, 0x62, 0x65,
-	0x52, 0x75, 0x6e, 0x4f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x14,
-	0x6b, 0x65, 0x72, 0x61, 0x73, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x6c, 0x6f,
-	0x67, 0x67, 0x65, 0x72, 0x18, 0x26, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x6b, 0x65, 0x72, 0x61,
-	0x73, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x12, 0x34,
-	0x0a, 0x16, 0x6b, 0x65, 0x72, 0x61, 0x73, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x63, 0x68,
-	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x27, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14,
-	0x6b, 0x65, 0x72, 0x61, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x19, 0x6b, 0x65, 0x72, 0x61, 0x73, 0x5f, 0x77, 0x61,
-	0x6e, 0x64, 0x62, 0x5f, 0x65, 0x76, 0x61, 0x6c, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63,
-	0x6b, 0x18, 0x28, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x6b, 0x65, 0x72, 0x61, 0x73, 0x57, 0x61,
-	0x6e, 0x64, 0x62, 0x45, 0x76, 0x61, 0x6c, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12,
-	0x32, 0x0a, 0x15, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f,
-	0x6f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x29, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13,
-	0x66, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x4f, 0x76, 0x65, 0x72, 0x66,
-	0x6c, 0x6f, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x2a, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x04, 0x73, 0x79, 0x6e, 0x63, 0x12, 0x32, 0x0a, 0x15, 0x66, 0x6c, 0x6f, 0x77, 0x5f,
-	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64,
-	0x18, 0x2b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x66, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x74,
-	0x72, 0x6f, 0x6c, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x66,
-	0x6c, 0x6f, 0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x63, 0x75, 0x73, 0x74,
-	0x6f, 0x6d, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x66, 0x6c, 0x6f, 0x77, 0x43, 0x6f,
-	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x12, 0x29, 0x0a, 0x10, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
-	0x2d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x69,
-	0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x6d,
-	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6f, 0x70,
-	0x65, 0x6e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x75, 0x6c, 0x74,
-	0x72, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x5f, 0x79, 0x6f, 0x6c, 0x6f, 0x76, 0x38, 0x18,
-	0x2f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x75, 0x6c, 0x74, 0x72, 0x61, 0x6c, 0x79, 0x74, 0x69,
-	0x63, 0x73, 0x59, 0x6f, 0x6c, 0x6f, 0x76, 0x38, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6d, 0x70, 0x6f,
-	0x72, 0x74, 0x65, 0x72, 0x5f, 0x6d, 0x6c, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x30, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0e, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x4d, 0x6c, 0x66, 0x6c, 0x6f,
-	0x77, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x74, 0x66, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x73, 0x18, 0x31, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x73, 0x79, 0x6e, 0x63, 0x54, 0x66,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x5f,
-	0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x18, 0x32, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x61,
-	0x73, 0x79, 0x6e, 0x63, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6f,
-	0x70, 0x65, 0x6e, 0x61, 0x69, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x67, 0x18, 0x33, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x69, 0x41, 0x75, 0x74, 0x6f, 0x6c,
-	0x6f, 0x67, 0x12, 0x29, 0x0a, 0x10, 0x6c, 0x61, 0x6e, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f,
-	0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x18, 0x34, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x6c, 0x61,
-	0x6e, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x72, 0x12, 0x25, 0x0a,
-	0x0e, 0x63, 0x6f, 0x68, 0x65, 0x72, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x67, 0x18,
-	0x35, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x63, 0x6f, 0x68, 0x65, 0x72, 0x65, 0x41, 0x75, 0x74,
-	0x6f, 0x6c, 0x6f, 0x67, 0x12, 0x2e, 0x0a, 0x13, 0x68, 0x66, 0x5f, 0x70, 0x69, 0x70, 0x65, 0x6c,
-	0x69, 0x6e, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x67, 0x18, 0x36, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x11, 0x68, 0x66, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x41, 0x75, 0x74,
-	0x6f, 0x6c, 0x6f, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x37, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x04, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x13, 0x0a, 0x05, 0x6c, 0x69, 0x62, 0x5f,
-	0x63, 0x18, 0x38, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x69, 0x62, 0x43, 0x12, 0x17, 0x0a,
-	0x07, 0x6c, 0x69, 0x62, 0x5f, 0x63, 0x70, 0x70, 0x18, 0x39, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
-	0x6c, 0x69, 0x62, 0x43, 0x70, 0x70, 0x12, 0x2b, 0x0a, 0x11, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x69,
-	0x5f, 0x66, 0x69, 0x6e, 0x65, 0x74, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x3a, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x10, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x69, 0x46, 0x69, 0x6e, 0x65, 0x74, 0x75, 0x6e,
-	0x69, 0x6e, 0x67, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x69, 0x66, 0x66, 0x75, 0x73, 0x65, 0x72, 0x73,
-	0x5f, 0x61, 0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x67, 0x18, 0x3b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10,
-	0x64, 0x69, 0x66, 0x66, 0x75, 0x73, 0x65, 0x72, 0x73, 0x41, 0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x67,
-	0x12, 0x36, 0x0a, 0x17, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x61,
-	0x62, 0x72, 0x69, 0x63, 0x5f, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x18, 0x3c, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x15, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x62, 0x72,
-	0x69, 0x63, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0c, 0x73, 0x65, 0x74, 0x5f,
-	0x73, 0x74, 0x65, 0x70, 0x5f, 0x6c, 0x6f, 0x67, 0x18, 0x3d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
-	0x73, 0x65, 0x74, 0x53, 0x74, 0x65, 0x70, 0x4c, 0x6f, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65,
-	0x74, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x3e, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0a, 0x73, 0x65, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x6d,
-	0x65, 0x74, 0x72, 0x69, 0x63, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x3f, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0d, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x53, 0x75, 0x6d, 0x6d, 0x61,
-	0x72, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x5f, 0x67, 0x6f, 0x61,
-	0x6c, 0x18, 0x40, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x47,
-	0x6f, 0x61, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x5f, 0x68, 0x69,
-	0x64, 0x64, 0x65, 0x6e, 0x18, 0x41, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6d, 0x65, 0x74, 0x72,
-	0x69, 0x63, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x65, 0x74, 0x72,
-	0x69, 0x63, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x42, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0e, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x53, 0x74, 0x65, 0x70, 0x53, 0x79,
-	0x6e, 0x63, 0x22, 0xa7, 0x03, 0x0a, 0x03, 0x45, 0x6e, 0x76, 0x12, 0x18, 0x0a, 0x07, 0x6a, 0x75,
-	0x70, 0x79, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6a, 0x75, 0x70,
-	0x79, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6b, 0x61, 0x67, 0x67, 0x6c, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6b, 0x61, 0x67, 0x67, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x77,
-	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x6d, 0x31, 0x5f, 0x67, 0x70, 0x75,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6d, 0x31, 0x47, 0x70, 0x75, 0x12, 0x1f, 0x0a,
-	0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x12, 0x1d,
-	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x66, 0x6f, 0x72, 0x6b, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6b, 0x12, 0x29, 0x0a,
-	0x10, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x66, 0x6f, 0x72, 0x6b, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x46, 0x6f,
-	0x72, 0x6b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72,
-	0x74, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6d,
-	0x61, 0x79, 0x62, 0x65, 0x5f, 0x6d, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6d,
-	0x61, 0x79, 0x62, 0x65, 0x4d, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x69,
-	0x75, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x69,
-	0x75, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x65, 0x78, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x03, 0x70, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x61, 0x62, 0x18, 0x0c, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x61, 0x62, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x70,
-	0x79, 0x74, 0x68, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x70, 0x79,
-	0x74, 0x68, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x77, 0x73, 0x5f, 0x6c, 0x61, 0x6d, 0x62,
-	0x64, 0x61, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x77, 0x73, 0x4c, 0x61, 0x6d,
-	0x62, 0x64, 0x61, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x6d, 0x64, 0x5f, 0x67, 0x70, 0x75, 0x18, 0x0f,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x6d, 0x64, 0x47, 0x70, 0x75, 0x22, 0x6d, 0x0a, 0x06,
-	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x73,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x64,
-	0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6f, 0x5f,
-	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65,
-	0x70, 0x6f, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x64, 0x65,
-	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x63, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xa6, 0x05, 0x0a, 0x0a,
-	0x44, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x19, 0x6b, 0x65,
-	0x72, 0x61, 0x73, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x5f, 0x64, 0x61,
-	0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x6b,
-	0x65, 0x72, 0x61, 0x73, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x44, 0x61, 0x74, 0x61,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x09, 0x72, 0x75, 0x6e, 0x5f, 0x5f, 0x6d, 0x6f, 0x64,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x4d, 0x6f, 0x64, 0x65,
-	0x12, 0x28, 0x0a, 0x11, 0x72, 0x75, 0x6e, 0x5f, 0x5f, 0x73, 0x61, 0x76, 0x65, 0x5f, 0x6e, 0x6f,
-	0x5f, 0x61, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x72, 0x75, 0x6e,
-	0x53, 0x61, 0x76, 0x65, 0x4e, 0x6f, 0x41, 0x72, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x09, 0x72, 0x75,
-	0x6e, 0x5f, 0x5f, 0x6a, 0x6f, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72,
-	0x75, 0x6e, 0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6c, 0x6f, 0x74, 0x73, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x70, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0d,
-	0x72, 0x75, 0x6e, 0x5f, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0a, 0x72, 0x75, 0x6e, 0x4c, 0x6f, 0x67, 0x53, 0x79, 0x6e, 0x63, 0x12,
-	0x38, 0x0a, 0x19, 0x69, 0x6e, 0x69, 0x74, 0x5f, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f,
-	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x15, 0x69, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x6e,
-	0x63, 0x6c, 0x75, 0x64, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x38, 0x0a, 0x19, 0x69, 0x6e, 0x69,
-	0x74, 0x5f, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64,
-	0x65, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x69, 0x6e,
-	0x69, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4b,
-	0x65, 0x79, 0x73, 0x12, 0x3a, 0x0a, 0x1a, 0x6b, 0x65, 0x72, 0x61, 0x73, 0x5f, 0x63, 0x61, 0x6c,
-	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x5f, 0x73, 0x61, 0x76, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65,
-	0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x6b, 0x65, 0x72, 0x61, 0x73, 0x43, 0x61,
-	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x61, 0x76, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12,
-	0x29, 0x0a, 0x10, 0x6c, 0x61, 0x6e, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x72, 0x61,
-	0x63, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x6c, 0x61, 0x6e, 0x67, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x12, 0x61, 0x72,
-	0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x5f, 0x5f, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74,
-	0x47, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x3e, 0x0a, 0x1b, 0x61, 0x72, 0x74, 0x69, 0x66,
-	0x61, 0x63, 0x74, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x72, 0x79,
-	0x5f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x19, 0x61, 0x72,
-	0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x65, 0x6e,
-	0x74, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x16, 0x61, 0x70, 0x69, 0x5f, 0x5f,
-	0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x61, 0x70, 0x69, 0x41, 0x72, 0x74, 0x69,
-	0x66, 0x61, 0x63, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x46, 0x0a, 0x20,
-	0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1c, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74,
-	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x22, 0xcf, 0x01, 0x0a, 0x06, 0x49, 0x73, 0x73, 0x75, 0x65, 0x73, 0x12,
-	0x41, 0x0a, 0x1d, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x5f, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1a, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e,
-	0x67, 0x73, 0x12, 0x39, 0x0a, 0x19, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x5f,
-	0x75, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x55,
-	0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x72, 0x67, 0x73, 0x12, 0x47, 0x0a,
-	0x20, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x5f, 0x70, 0x72, 0x65, 0x70, 0x72,
-	0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1d, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
-	0x73, 0x50, 0x72, 0x65, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x57, 0x61,
-	0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x2a, 0x0a,
+	0x11, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x64, 0x5f, 0x6f,
+	0x75, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x53,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x65, 0x64,
+	0x69, 0x61, 0x5f, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0c, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x64, 0x12, 0x30,
+	0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x5f, 0x64,
+	0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x44, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64,
+	0x12, 0x32, 0x0a, 0x15, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x13, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x44, 0x72, 0x6f,
+	0x70, 0x70, 0x65, 0x64, 0x12, 0x31, 0x0a, 0x05, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0xc8, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x5f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x04, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x11, 0x0a, 0x0f, 0x54, 0x65, 0x6c, 0x65, 0x6d,
+	0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xa6, 0x15, 0x0a, 0x07, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x72, 0x63, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x74, 0x6f, 0x72, 0x63, 0x68, 0x12, 0x14, 0x0a, 0x05,
+	0x6b, 0x65, 0x72, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6b, 0x65, 0x72,
+	0x61, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x66, 0x6c, 0x6f, 0x77,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x66, 0x6c,
+	0x6f, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x73, 0x74, 0x61, 0x69, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x66, 0x61, 0x73, 0x74, 0x61, 0x69, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6b,
+	0x6c, 0x65, 0x61, 0x72, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x6b, 0x6c,
+	0x65, 0x61, 0x72, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x78, 0x67, 0x62, 0x6f, 0x6f, 0x73, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x78, 0x67, 0x62, 0x6f, 0x6f, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x61, 0x74, 0x62, 0x6f, 0x6f, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x63, 0x61, 0x74, 0x62, 0x6f, 0x6f, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x67, 0x62, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x67, 0x62, 0x6d, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x79, 0x74, 0x6f, 0x72, 0x63,
+	0x68, 0x5f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x10, 0x70, 0x79, 0x74, 0x6f, 0x72, 0x63, 0x68, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x6e,
+	0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x67, 0x6e, 0x69, 0x74, 0x65, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x67, 0x6e, 0x69, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x73, 0x12,
+	0x10, 0x0a, 0x03, 0x6a, 0x61, 0x78, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x6a, 0x61,
+	0x78, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x1a, 0x0a,
+	0x08, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x6e, 0x6c, 0x70, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x6e, 0x6c, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74,
+	0x6f, 0x67, 0x6c, 0x75, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x75,
+	0x74, 0x6f, 0x67, 0x6c, 0x75, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x6b,
+	0x65, 0x72, 0x61, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x75, 0x74, 0x6f,
+	0x6b, 0x65, 0x72, 0x61, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x79, 0x73,
+	0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x79, 0x73,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x65, 0x70, 0x63, 0x68, 0x65, 0x6d, 0x18, 0x15, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x65, 0x65, 0x70, 0x63, 0x68, 0x65, 0x6d, 0x12, 0x18, 0x0a,
+	0x07, 0x64, 0x65, 0x65, 0x70, 0x63, 0x74, 0x72, 0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x64, 0x65, 0x65, 0x70, 0x63, 0x74, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x79, 0x63, 0x61, 0x72,
+	0x65, 0x74, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x79, 0x63, 0x61, 0x72, 0x65,
+	0x74, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x79, 0x74, 0x6f, 0x72, 0x63, 0x68, 0x76, 0x69, 0x64, 0x65,
+	0x6f, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x70, 0x79, 0x74, 0x6f, 0x72, 0x63, 0x68,
+	0x76, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x61, 0x79, 0x18, 0x1e, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x03, 0x72, 0x61, 0x79, 0x12, 0x2e, 0x0a, 0x12, 0x73, 0x69, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x73, 0x18, 0x1f, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x12, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6b, 0x6f, 0x72, 0x63,
+	0x68, 0x18, 0x20, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x6b, 0x6f, 0x72, 0x63, 0x68, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x70, 0x61, 0x63, 0x79, 0x18, 0x21, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x73, 0x70, 0x61, 0x63, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x73, 0x68, 0x18, 0x22,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x6f,
+	0x70, 0x74, 0x75, 0x6e, 0x61, 0x18, 0x23, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x70, 0x74,
+	0x75, 0x6e, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x62, 0x6f, 0x6c, 0x65, 0x18, 0x24,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x63, 0x62, 0x6f, 0x6c, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6d, 0x6d, 0x63, 0x76, 0x18, 0x25, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6d, 0x6d, 0x63,
+	0x76, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6d, 0x64, 0x65, 0x74, 0x18, 0x26, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x6d, 0x6d, 0x64, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x72, 0x63, 0x68,
+	0x64, 0x72, 0x75, 0x67, 0x18, 0x27, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x6f, 0x72, 0x63,
+	0x68, 0x64, 0x72, 0x75, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x72, 0x63, 0x68, 0x74, 0x65,
+	0x78, 0x74, 0x18, 0x28, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x6f, 0x72, 0x63, 0x68, 0x74,
+	0x65, 0x78, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x6f, 0x72, 0x63, 0x68, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x29, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x74, 0x6f, 0x72, 0x63, 0x68, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6c, 0x65, 0x67, 0x79, 0x18, 0x2a,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x65, 0x6c, 0x65, 0x67, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x64,
+	0x65, 0x74, 0x65, 0x63, 0x74, 0x72, 0x6f, 0x6e, 0x32, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x72, 0x6f, 0x6e, 0x32, 0x12, 0x14, 0x0a, 0x05, 0x66,
+	0x6c, 0x61, 0x69, 0x72, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x69,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x6c, 0x61, 0x78, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x04, 0x66, 0x6c, 0x61, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x79, 0x66, 0x74, 0x18, 0x2e, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x04, 0x73, 0x79, 0x66, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x54, 0x54, 0x53,
+	0x18, 0x2f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x54, 0x54, 0x53, 0x12, 0x14, 0x0a, 0x05, 0x6d,
+	0x6f, 0x6e, 0x61, 0x69, 0x18, 0x30, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6d, 0x6f, 0x6e, 0x61,
+	0x69, 0x12, 0x27, 0x0a, 0x0f, 0x68, 0x75, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x66, 0x61, 0x63, 0x65,
+	0x5f, 0x68, 0x75, 0x62, 0x18, 0x31, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x68, 0x75, 0x67, 0x67,
+	0x69, 0x6e, 0x67, 0x66, 0x61, 0x63, 0x65, 0x48, 0x75, 0x62, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x79,
+	0x64, 0x72, 0x61, 0x18, 0x32, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x68, 0x79, 0x64, 0x72, 0x61,
+	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x18, 0x33, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x61, 0x63, 0x72, 0x65, 0x64, 0x18, 0x34, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x61,
+	0x63, 0x72, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x6c, 0x69, 0x62, 0x18, 0x35,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6a, 0x6f, 0x62, 0x6c, 0x69, 0x62, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x73, 0x6b, 0x18, 0x36, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x61, 0x73, 0x6b,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x69, 0x6f, 0x18, 0x37, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x69, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61,
+	0x64, 0x64, 0x6c, 0x65, 0x6f, 0x63, 0x72, 0x18, 0x38, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x70,
+	0x61, 0x64, 0x64, 0x6c, 0x65, 0x6f, 0x63, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x70, 0x64, 0x65,
+	0x74, 0x18, 0x39, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x70, 0x70, 0x64, 0x65, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x70, 0x61, 0x64, 0x64, 0x6c, 0x65, 0x73, 0x65, 0x67, 0x18, 0x3a, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x70, 0x61, 0x64, 0x64, 0x6c, 0x65, 0x73, 0x65, 0x67, 0x12, 0x1c, 0x0a, 0x09,
+	0x70, 0x61, 0x64, 0x64, 0x6c, 0x65, 0x6e, 0x6c, 0x70, 0x18, 0x3b, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x70, 0x61, 0x64, 0x64, 0x6c, 0x65, 0x6e, 0x6c, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6d,
+	0x73, 0x65, 0x67, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6d, 0x6d, 0x73, 0x65, 0x67,
+	0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6d, 0x6f, 0x63, 0x72, 0x18, 0x3d, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x6d, 0x6d, 0x6f, 0x63, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6d, 0x63, 0x6c, 0x73, 0x18,
+	0x3e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6d, 0x6d, 0x63, 0x6c, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x69, 0x6d, 0x6d, 0x18, 0x3f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x6d,
+	0x12, 0x18, 0x0a, 0x07, 0x66, 0x61, 0x69, 0x72, 0x73, 0x65, 0x71, 0x18, 0x40, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x66, 0x61, 0x69, 0x72, 0x73, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65,
+	0x65, 0x70, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x18, 0x41, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x64, 0x65, 0x65, 0x70, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f,
+	0x6d, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x18, 0x42, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x6f,
+	0x6d, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x70, 0x61, 0x72, 0x73, 0x65,
+	0x6d, 0x6c, 0x18, 0x43, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x70, 0x61, 0x72, 0x73, 0x65,
+	0x6d, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x69, 0x62, 0x18, 0x44,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x69, 0x62, 0x12, 0x14,
+	0x0a, 0x05, 0x7a, 0x65, 0x6e, 0x6d, 0x6c, 0x18, 0x45, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x7a,
+	0x65, 0x6e, 0x6d, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6f, 0x73, 0x73, 0x61, 0x6c,
+	0x61, 0x69, 0x18, 0x46, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6f, 0x73, 0x73,
+	0x61, 0x6c, 0x61, 0x69, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x47, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x6c, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x18, 0x48,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6d, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07,
+	0x6e, 0x61, 0x6e, 0x6f, 0x64, 0x65, 0x74, 0x18, 0x49, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6e,
+	0x61, 0x6e, 0x6f, 0x64, 0x65, 0x74, 0x12, 0x3e, 0x0a, 0x1b, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x5f, 0x70, 0x79,
+	0x74, 0x6f, 0x72, 0x63, 0x68, 0x18, 0x4a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x19, 0x73, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x50,
+	0x79, 0x74, 0x6f, 0x72, 0x63, 0x68, 0x12, 0x33, 0x0a, 0x15, 0x73, 0x65, 0x6e, 0x74, 0x65, 0x6e,
+	0x63, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x73, 0x18,
+	0x4b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x73, 0x65, 0x6e, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x64,
+	0x67, 0x6c, 0x18, 0x4c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x64, 0x67, 0x6c, 0x12, 0x27, 0x0a,
+	0x0f, 0x74, 0x6f, 0x72, 0x63, 0x68, 0x5f, 0x67, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x18, 0x4d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x74, 0x6f, 0x72, 0x63, 0x68, 0x47, 0x65, 0x6f,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x6a, 0x69, 0x6e, 0x61, 0x18, 0x4e,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6a, 0x69, 0x6e, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x6b, 0x6f,
+	0x72, 0x6e, 0x69, 0x61, 0x18, 0x4f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6b, 0x6f, 0x72, 0x6e,
+	0x69, 0x61, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x50, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x61, 0x6c, 0x62, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6b, 0x65,
+	0x72, 0x61, 0x73, 0x5f, 0x63, 0x76, 0x18, 0x51, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6b, 0x65,
+	0x72, 0x61, 0x73, 0x43, 0x76, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x6d, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x18, 0x52, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6d, 0x6d, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x66, 0x66, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x53,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x64, 0x69, 0x66, 0x66, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12,
+	0x10, 0x0a, 0x03, 0x74, 0x72, 0x6c, 0x18, 0x54, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x74, 0x72,
+	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x72, 0x6c, 0x78, 0x18, 0x55, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x04, 0x74, 0x72, 0x6c, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x61, 0x6e, 0x67, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x18, 0x56, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6c, 0x61, 0x6e, 0x67, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x57, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x5f, 0x73, 0x64, 0x6b, 0x18, 0x58, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x73, 0x74, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x64, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x65,
+	0x66, 0x65, 0x63, 0x74, 0x18, 0x59, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x65, 0x66,
+	0x65, 0x63, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x65, 0x63, 0x74, 0x5f, 0x72,
+	0x61, 0x79, 0x18, 0x5a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x63,
+	0x74, 0x52, 0x61, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x69, 0x6e, 0x65, 0x63, 0x6f, 0x6e, 0x65,
+	0x18, 0x5b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x70, 0x69, 0x6e, 0x65, 0x63, 0x6f, 0x6e, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x64, 0x62, 0x18, 0x5c, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x64, 0x62, 0x12, 0x1a, 0x0a, 0x08,
+	0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x18, 0x5d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x6d,
+	0x70, 0x74, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x5e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x70,
+	0x72, 0x6f, 0x6d, 0x70, 0x74, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x70,
+	0x65, 0x6e, 0x61, 0x69, 0x18, 0x5f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x70, 0x65, 0x6e,
+	0x61, 0x69, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x68, 0x65, 0x72, 0x65, 0x18, 0x60, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x63, 0x6f, 0x68, 0x65, 0x72, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6e,
+	0x74, 0x68, 0x72, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x61, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61,
+	0x6e, 0x74, 0x68, 0x72, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x65, 0x66, 0x74,
+	0x18, 0x62, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x70, 0x65, 0x66, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x63, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6f,
+	0x70, 0x74, 0x69, 0x6d, 0x75, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61,
+	0x74, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61,
+	0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x65,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6b, 0x65, 0x72, 0x61, 0x73, 0x5f, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x66, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x6b, 0x65, 0x72, 0x61, 0x73, 0x43, 0x6f, 0x72, 0x65, 0x12, 0x29, 0x0a,
+	0x10, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x61, 0x62, 0x72, 0x69,
+	0x63, 0x18, 0x67, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69,
+	0x6e, 0x67, 0x46, 0x61, 0x62, 0x72, 0x69, 0x63, 0x12, 0x31, 0x0a, 0x14, 0x63, 0x75, 0x72, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x73,
+	0x18, 0x68, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x63, 0x75, 0x72, 0x61, 0x74, 0x65, 0x64, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f,
+	0x72, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x69, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x72, 0x6a,
+	0x73, 0x6f, 0x6e, 0x22, 0xd3, 0x13, 0x0a, 0x07, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x77, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x77, 0x61, 0x74, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x61, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x73, 0x61, 0x76,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6d, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x67, 0x72, 0x70, 0x63, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x04, 0x67, 0x72, 0x70, 0x63, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x12, 0x14, 0x0a, 0x05, 0x6b, 0x65, 0x72, 0x61, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x6b, 0x65, 0x72, 0x61, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x61, 0x67, 0x65, 0x6d,
+	0x61, 0x6b, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x61, 0x67, 0x65,
+	0x6d, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x14, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63,
+	0x74, 0x5f, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x13, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x63,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x66, 0x6c, 0x6f, 0x77, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x66, 0x6c, 0x6f, 0x77, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x69, 0x67, 0x79, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x69, 0x67, 0x79, 0x12, 0x22,
+	0x0a, 0x0d, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x69, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0b, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x69, 0x74,
+	0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x5f, 0x74,
+	0x61, 0x67, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x65, 0x74, 0x49, 0x6e,
+	0x69, 0x74, 0x54, 0x61, 0x67, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x6e,
+	0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0d, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x20,
+	0x0a, 0x0c, 0x73, 0x65, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x20, 0x0a, 0x0c, 0x73, 0x65, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x74, 0x61, 0x67, 0x73,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x54, 0x61,
+	0x67, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x5f, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x65, 0x74,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61,
+	0x75, 0x6e, 0x63, 0x68, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6c, 0x61, 0x75, 0x6e,
+	0x63, 0x68, 0x12, 0x30, 0x0a, 0x14, 0x74, 0x6f, 0x72, 0x63, 0x68, 0x5f, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x72, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x12, 0x74, 0x6f, 0x72, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x72, 0x54,
+	0x72, 0x61, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x62, 0x33, 0x18, 0x16, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x03, 0x73, 0x62, 0x33, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x26, 0x0a, 0x0f, 0x69, 0x6e, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f,
+	0x72, 0x75, 0x6e, 0x18, 0x18, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x6e, 0x69, 0x74, 0x52,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x75, 0x6e, 0x12, 0x36, 0x0a, 0x17, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x67, 0x62, 0x6d, 0x5f, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x62,
+	0x61, 0x63, 0x6b, 0x18, 0x19, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x67, 0x62, 0x6d, 0x57, 0x61, 0x6e, 0x64, 0x62, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x12, 0x30, 0x0a, 0x14, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x67, 0x62, 0x6d, 0x5f, 0x6c, 0x6f, 0x67,
+	0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x67, 0x62, 0x6d, 0x4c, 0x6f, 0x67, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x12, 0x36, 0x0a, 0x17, 0x63, 0x61, 0x74, 0x62, 0x6f, 0x6f, 0x73, 0x74, 0x5f, 0x77,
+	0x61, 0x6e, 0x64, 0x62, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x1b, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x15, 0x63, 0x61, 0x74, 0x62, 0x6f, 0x6f, 0x73, 0x74, 0x57, 0x61, 0x6e,
+	0x64, 0x62, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x61,
+	0x74, 0x62, 0x6f, 0x6f, 0x73, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x63, 0x61, 0x74, 0x62, 0x6f, 0x6f,
+	0x73, 0x74, 0x4c, 0x6f, 0x67, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x27, 0x0a, 0x0f,
+	0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x6c, 0x6f, 0x67, 0x18,
+	0x1d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x4c, 0x6f, 0x67, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74,
+	0x6f, 0x72, 0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x65,
+	0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x6f, 0x72, 0x48, 0x6f, 0x6f, 0x6b, 0x12, 0x34, 0x0a, 0x16,
+	0x78, 0x67, 0x62, 0x6f, 0x6f, 0x73, 0x74, 0x5f, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x63, 0x61,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x78, 0x67,
+	0x62, 0x6f, 0x6f, 0x73, 0x74, 0x57, 0x61, 0x6e, 0x64, 0x62, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x12, 0x3b, 0x0a, 0x1a, 0x78, 0x67, 0x62, 0x6f, 0x6f, 0x73, 0x74, 0x5f, 0x6f, 0x6c,
+	0x64, 0x5f, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x18, 0x20, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x78, 0x67, 0x62, 0x6f, 0x6f, 0x73, 0x74, 0x4f,
+	0x6c, 0x64, 0x57, 0x61, 0x6e, 0x64, 0x62, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x18, 0x21, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x65, 0x6e, 0x73, 0x6f,
+	0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x18, 0x22, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x10, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x50,
+	0x61, 0x74, 0x63, 0x68, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x23, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f,
+	0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x53, 0x79, 0x6e, 0x63, 0x12,
+	0x22, 0x0a, 0x0d, 0x6b, 0x66, 0x70, 0x5f, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x6c, 0x6f, 0x67,
+	0x18, 0x24, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6b, 0x66, 0x70, 0x57, 0x61, 0x6e, 0x64, 0x62,
+	0x4c, 0x6f, 0x67, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x61, 0x79, 0x62, 0x65, 0x5f, 0x72, 0x75, 0x6e,
+	0x5f, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x25, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x11, 0x6d, 0x61, 0x79, 0x62, 0x65, 0x52, 0x75, 0x6e, 0x4f, 0x76, 0x65, 0x72, 0x77, 0x72,
+	0x69, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x6b, 0x65, 0x72, 0x61, 0x73, 0x5f, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x5f, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x18, 0x26, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x12, 0x6b, 0x65, 0x72, 0x61, 0x73, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x4c,
+	0x6f, 0x67, 0x67, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x16, 0x6b, 0x65, 0x72, 0x61, 0x73, 0x5f, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18,
+	0x27, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x6b, 0x65, 0x72, 0x61, 0x73, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x19, 0x6b,
+	0x65, 0x72, 0x61, 0x73, 0x5f, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x65, 0x76, 0x61, 0x6c, 0x5f,
+	0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x28, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16,
+	0x6b, 0x65, 0x72, 0x61, 0x73, 0x57, 0x61, 0x6e, 0x64, 0x62, 0x45, 0x76, 0x61, 0x6c, 0x43, 0x61,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x32, 0x0a, 0x15, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x18,
+	0x29, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x66, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x4f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x79,
+	0x6e, 0x63, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x73, 0x79, 0x6e, 0x63, 0x12, 0x32,
+	0x0a, 0x15, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x64,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x66,
+	0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x11, 0x66, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x43, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x64, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x21, 0x0a,
+	0x0c, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x2e, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x12, 0x2d, 0x0a, 0x12, 0x75, 0x6c, 0x74, 0x72, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x5f,
+	0x79, 0x6f, 0x6c, 0x6f, 0x76, 0x38, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x75, 0x6c,
+	0x74, 0x72, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x59, 0x6f, 0x6c, 0x6f, 0x76, 0x38, 0x12,
+	0x27, 0x0a, 0x0f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x5f, 0x6d, 0x6c, 0x66, 0x6c,
+	0x6f, 0x77, 0x18, 0x30, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x72, 0x4d, 0x6c, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x79, 0x6e, 0x63,
+	0x5f, 0x74, 0x66, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x31, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x73, 0x79, 0x6e, 0x63, 0x54, 0x66, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x18, 0x32,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x55, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x69, 0x5f, 0x61, 0x75, 0x74,
+	0x6f, 0x6c, 0x6f, 0x67, 0x18, 0x33, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6f, 0x70, 0x65, 0x6e,
+	0x61, 0x69, 0x41, 0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x67, 0x12, 0x29, 0x0a, 0x10, 0x6c, 0x61, 0x6e,
+	0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x18, 0x34, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0f, 0x6c, 0x61, 0x6e, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x54, 0x72,
+	0x61, 0x63, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x68, 0x65, 0x72, 0x65, 0x5f, 0x61,
+	0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x67, 0x18, 0x35, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x63, 0x6f,
+	0x68, 0x65, 0x72, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x67, 0x12, 0x2e, 0x0a, 0x13, 0x68,
+	0x66, 0x5f, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x6c,
+	0x6f, 0x67, 0x18, 0x36, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x68, 0x66, 0x50, 0x69, 0x70, 0x65,
+	0x6c, 0x69, 0x6e, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x63,
+	0x6f, 0x72, 0x65, 0x18, 0x37, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x63, 0x6f, 0x72, 0x65, 0x12,
+	0x13, 0x0a, 0x05, 0x6c, 0x69, 0x62, 0x5f, 0x63, 0x18, 0x38, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04,
+	0x6c, 0x69, 0x62, 0x43, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x69, 0x62, 0x5f, 0x63, 0x70, 0x70, 0x18,
+	0x39, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6c, 0x69, 0x62, 0x43, 0x70, 0x70, 0x12, 0x2b, 0x0a,
+	0x11, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x69, 0x5f, 0x66, 0x69, 0x6e, 0x65, 0x74, 0x75, 0x6e, 0x69,
+	0x6e, 0x67, 0x18, 0x3a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x69,
+	0x46, 0x69, 0x6e, 0x65, 0x74, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x69,
+	0x66, 0x66, 0x75, 0x73, 0x65, 0x72, 0x73, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x67, 0x18,
+	0x3b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x64, 0x69, 0x66, 0x66, 0x75, 0x73, 0x65, 0x72, 0x73,
+	0x41, 0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x67, 0x12, 0x36, 0x0a, 0x17, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x61, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x6c, 0x6f, 0x67, 0x67,
+	0x65, 0x72, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e,
+	0x69, 0x6e, 0x67, 0x46, 0x61, 0x62, 0x72, 0x69, 0x63, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x12,
+	0x20, 0x0a, 0x0c, 0x73, 0x65, 0x74, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x6c, 0x6f, 0x67, 0x18,
+	0x3d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x65, 0x74, 0x53, 0x74, 0x65, 0x70, 0x4c, 0x6f,
+	0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x74, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x18, 0x3e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x65, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x5f, 0x73, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x18, 0x3f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x5f, 0x67, 0x6f, 0x61, 0x6c, 0x18, 0x40, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x47, 0x6f, 0x61, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x5f, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x41, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0c, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12,
+	0x28, 0x0a, 0x10, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x73,
+	0x79, 0x6e, 0x63, 0x18, 0x42, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x6d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x53, 0x74, 0x65, 0x70, 0x53, 0x79, 0x6e, 0x63, 0x22, 0xa7, 0x03, 0x0a, 0x03, 0x45, 0x6e,
+	0x76, 0x12, 0x18, 0x0a, 0x07, 0x6a, 0x75, 0x70, 0x79, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x6a, 0x75, 0x70, 0x79, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6b,
+	0x61, 0x67, 0x67, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6b, 0x61, 0x67,
+	0x67, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x12, 0x15, 0x0a,
+	0x06, 0x6d, 0x31, 0x5f, 0x67, 0x70, 0x75, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6d,
+	0x31, 0x47, 0x70, 0x75, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x73, 0x70,
+	0x61, 0x77, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x53, 0x70, 0x61, 0x77, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x66,
+	0x6f, 0x72, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x46, 0x6f, 0x72, 0x6b, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x66, 0x6f,
+	0x72, 0x6b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12,
+	0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x68, 0x72, 0x65,
+	0x61, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x79, 0x62, 0x65, 0x5f, 0x6d, 0x70, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6d, 0x61, 0x79, 0x62, 0x65, 0x4d, 0x70, 0x12, 0x1a, 0x0a,
+	0x08, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x75, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x75, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x65, 0x78,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x70, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6f, 0x6c, 0x61, 0x62, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x61,
+	0x62, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x69, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x61,
+	0x77, 0x73, 0x5f, 0x6c, 0x61, 0x6d, 0x62, 0x64, 0x61, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x61, 0x77, 0x73, 0x4c, 0x61, 0x6d, 0x62, 0x64, 0x61, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x6d,
+	0x64, 0x5f, 0x67, 0x70, 0x75, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x6d, 0x64,
+	0x47, 0x70, 0x75, 0x22, 0x6d, 0x0a, 0x06, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x1f,
+	0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12,
+	0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0xa6, 0x05, 0x0a, 0x0a, 0x44, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65,
+	0x64, 0x12, 0x38, 0x0a, 0x19, 0x6b, 0x65, 0x72, 0x61, 0x73, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x62,
+	0x61, 0x63, 0x6b, 0x5f, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x6b, 0x65, 0x72, 0x61, 0x73, 0x43, 0x61, 0x6c, 0x6c, 0x62,
+	0x61, 0x63, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x09, 0x72,
+	0x75, 0x6e, 0x5f, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x72, 0x75, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x28, 0x0a, 0x11, 0x72, 0x75, 0x6e, 0x5f, 0x5f,
+	0x73, 0x61, 0x76, 0x65, 0x5f, 0x6e, 0x6f, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0d, 0x72, 0x75, 0x6e, 0x53, 0x61, 0x76, 0x65, 0x4e, 0x6f, 0x41, 0x72, 0x67,
+	0x73, 0x12, 0x1a, 0x0a, 0x09, 0x72, 0x75, 0x6e, 0x5f, 0x5f, 0x6a, 0x6f, 0x69, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x70, 0x6c,
+	0x6f, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0d, 0x72, 0x75, 0x6e, 0x5f, 0x5f, 0x6c, 0x6f, 0x67, 0x5f,
+	0x73, 0x79, 0x6e, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x72, 0x75, 0x6e, 0x4c,
+	0x6f, 0x67, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x38, 0x0a, 0x19, 0x69, 0x6e, 0x69, 0x74, 0x5f, 0x5f,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x6b,
+	0x65, 0x79, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x69, 0x6e, 0x69, 0x74, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4b, 0x65, 0x79, 0x73,
+	0x12, 0x38, 0x0a, 0x19, 0x69, 0x6e, 0x69, 0x74, 0x5f, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x5f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x15, 0x69, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x45,
+	0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x3a, 0x0a, 0x1a, 0x6b, 0x65,
+	0x72, 0x61, 0x73, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x5f, 0x73, 0x61,
+	0x76, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16,
+	0x6b, 0x65, 0x72, 0x61, 0x73, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x61, 0x76,
+	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x29, 0x0a, 0x10, 0x6c, 0x61, 0x6e, 0x67, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0f, 0x6c, 0x61, 0x6e, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x63, 0x65,
+	0x72, 0x12, 0x2b, 0x0a, 0x12, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x5f, 0x5f, 0x67,
+	0x65, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x61,
+	0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x47, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x3e,
+	0x0a, 0x1b, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65,
+	0x73, 0x74, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x19, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x6d, 0x61, 0x6e,
+	0x69, 0x66, 0x65, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x33,
+	0x0a, 0x16, 0x61, 0x70, 0x69, 0x5f, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13,
+	0x61, 0x70, 0x69, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x46, 0x0a, 0x20, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x5f,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x5f, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1c, 0x61,
+	0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0xcf, 0x01, 0x0a, 0x06,
+	0x49, 0x73, 0x73, 0x75, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x1d, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x5f, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x77,
+	0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1a, 0x73,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x39, 0x0a, 0x19, 0x73, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x5f, 0x75, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x73, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x55, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x41, 0x72, 0x67, 0x73, 0x12, 0x47, 0x0a, 0x20, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x5f, 0x5f, 0x70, 0x72, 0x65, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f,
+	0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1d,
+	0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x50, 0x72, 0x65, 0x70, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x69, 0x6e, 0x67, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
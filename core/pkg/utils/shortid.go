@@ -3,6 +3,7 @@ package utils
 import (
 	"context"
 	"crypto/rand"
+	"crypto/sha256"
 	"fmt"
 	"log/slog"
 )
@@ -28,3 +29,24 @@ func ShortID(length int) string {
 	}
 	return string(b)
 }
+
+// IdempotentIDLength is the length of run IDs derived from idempotency
+// keys, as in the Python SDK.
+const IdempotentIDLength = 12
+
+// IdempotentID returns an ID of the given length derived from a key.
+//
+// The same key always gives the same ID, so that a job that is retried
+// with the same key gets the same run ID. It must match
+// generate_idempotent_id in the Python SDK, which like this function
+// returns at most 32 characters, one per byte of the key's SHA-256 hash.
+func IdempotentID(key string, length int) string {
+	sum := sha256.Sum256([]byte(key))
+	length = min(length, len(sum))
+
+	b := make([]byte, length)
+	for i := 0; i < length; i++ {
+		b[i] = chars[int(sum[i])%len(chars)]
+	}
+	return string(b)
+}
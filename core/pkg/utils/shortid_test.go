@@ -13,3 +13,22 @@ func TestShortID(t *testing.T) {
 		assert.Equal(t, len(item), 8)
 	})
 }
+
+func TestIdempotentID(t *testing.T) {
+	id := utils.IdempotentID("slurm-1234", 12)
+
+	assert.Len(t, id, 12)
+	assert.Equal(t, id, utils.IdempotentID("slurm-1234", 12))
+	assert.NotEqual(t, id, utils.IdempotentID("slurm-1235", 12))
+}
+
+// The expected IDs are those generate_idempotent_id gives in the Python
+// SDK, which must agree so that retried jobs get the same run either way.
+func TestIdempotentID_MatchesPython(t *testing.T) {
+	assert.Equal(t, "zq12n8r9wtc0", utils.IdempotentID("slurm-1234", 12))
+	assert.Equal(t, "isxsd0mf", utils.IdempotentID("slurm-1234-retry-2", 8))
+	assert.Equal(t, "nys3kp5xby6r", utils.IdempotentID("ジョブ", 12))
+	assert.Equal(t,
+		"zq12n8r9wtc0ea42fntdtwy91b3bpnb9",
+		utils.IdempotentID("slurm-1234", 40))
+}
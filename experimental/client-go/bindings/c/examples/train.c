@@ -4,11 +4,23 @@
 #include <libwandb.h>
 
 
+void on_event(int event, int run, const char *message, void *user_data) {
+    switch (event) {
+    case WANDB_EVENT_RUN_URL:
+        printf("view run at %s\n", message);
+        break;
+    case WANDB_EVENT_ERROR:
+        fprintf(stderr, "wandb error: %s\n", message);
+        break;
+    }
+}
+
 int main(int argc, char **argv) {
     int i;
     int rc;
     wandb_run run;
 
+    wandb_register_callback(on_event, NULL);
     rc = wandb_init(&run);
     assert(rc == 0);
 
@@ -16,6 +28,8 @@ int main(int argc, char **argv) {
         printf("log %d\n", i);
         wandb_log_scaler(&run, "key", i);
     }
+    rc = wandb_log_json(&run, "{\"loss\": 0.5, \"phase\": \"eval\"}");
+    assert(rc == 0);
     wandb_finish(&run);
     return 0;
 }
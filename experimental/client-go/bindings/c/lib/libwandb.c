@@ -11,6 +11,9 @@ void wandb_setup() {
 int wandb_init(wandb_run *run) {
     wandb_setup();
     int n = wandbcoreInit(0, "", "", "", LIB_C);
+    if (n < 0) {
+        return n;
+    }
     run->num = n;
     return 0;
 }
@@ -20,6 +23,15 @@ void wandb_log_scaler(wandb_run *run, const char *key, double value) {
     wandbcoreLogData(run->num, data);
 }
 
+int wandb_log_json(wandb_run *run, const char *json) {
+    return wandbcoreLogJSON(run->num, json);
+}
+
+int wandb_log_artifact(wandb_run *run, const char *name, const char *type,
+                       int count, const char **names, const char **paths) {
+    return wandbcoreLogArtifact(run->num, name, type, count, names, paths);
+}
+
 void wandb_finish(wandb_run *run) {
     int num = run->num;
     wandbcoreFinish(num);
@@ -28,3 +40,11 @@ void wandb_finish(wandb_run *run) {
 void wandb_teardown() {
     wandbcoreTeardown();
 }
+
+int wandb_register_callback(wandb_callback callback, void *user_data) {
+    return wandbcoreRegisterCallback(callback, user_data);
+}
+
+void wandb_unregister_callback(int id) {
+    wandbcoreUnregisterCallback(id);
+}
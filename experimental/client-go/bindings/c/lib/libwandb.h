@@ -4,8 +4,21 @@ struct wandb_run_s {
 
 typedef struct wandb_run_s wandb_run;
 
+#define WANDB_EVENT_RUN_URL 1
+#define WANDB_EVENT_ERROR 2
+#define WANDB_EVENT_UPLOAD_PROGRESS 3
+#define WANDB_EVENT_STOP_REQUESTED 4
+
+typedef void (*wandb_callback)(int event, int run, const char *message,
+                               void *user_data);
+
 int wandb_init(wandb_run *run);
 void wandb_log_scaler(wandb_run *run, const char *key, double value);
+int wandb_log_json(wandb_run *run, const char *json);
+int wandb_log_artifact(wandb_run *run, const char *name, const char *type,
+                       int count, const char **names, const char **paths);
 void wandb_finish(wandb_run *run);
 void wandb_setup();
 void wandb_teardown();
+int wandb_register_callback(wandb_callback callback, void *user_data);
+void wandb_unregister_callback(int id);
@@ -0,0 +1,120 @@
+package core
+
+/*
+#include <stdlib.h>
+
+typedef void (*wandbcore_callback)(int event, int run, const char *message, void *user_data);
+
+#define WANDBCORE_EVENT_RUN_URL 1
+#define WANDBCORE_EVENT_ERROR 2
+#define WANDBCORE_EVENT_UPLOAD_PROGRESS 3
+#define WANDBCORE_EVENT_STOP_REQUESTED 4
+
+// Go can't call C function pointers directly.
+static inline void wandbcore_invoke(
+	wandbcore_callback callback,
+	int event,
+	int run,
+	const char *message,
+	void *user_data
+) {
+	callback(event, run, message, user_data);
+}
+*/
+import "C"
+
+import (
+	"sync"
+	"unsafe"
+)
+
+// Events passed to callbacks.
+const (
+	// The run's page is available; the message is its URL.
+	eventRunURL = C.WANDBCORE_EVENT_RUN_URL
+
+	// Something failed; the message describes the error. The run is 0 if
+	// the error isn't specific to a run.
+	eventError = C.WANDBCORE_EVENT_ERROR
+
+	// Files are being uploaded while a run finishes; the message is JSON
+	// with "uploaded_bytes" and "total_bytes".
+	eventUploadProgress = C.WANDBCORE_EVENT_UPLOAD_PROGRESS
+
+	// W&B asked for the run to stop, such as when it was stopped in the
+	// UI; the message is empty. The application should finish the run.
+	eventStopRequested = C.WANDBCORE_EVENT_STOP_REQUESTED
+)
+
+type callbackEntry struct {
+	callback C.wandbcore_callback
+	userData unsafe.Pointer
+}
+
+// callbacks are the host application's registered callbacks by ID.
+var callbacks = struct {
+	sync.Mutex
+	nextID  int
+	entries map[int]callbackEntry
+}{
+	// arbitrary number to start counting from
+	nextID:  42,
+	entries: make(map[int]callbackEntry),
+}
+
+// wandbcoreRegisterCallback registers a function to be notified of
+// events, and returns an ID for unregistering it.
+//
+// Callbacks may be invoked from any thread, including several at once.
+// The message is only valid during the call. userData is passed to the
+// callback as is.
+//
+//export wandbcoreRegisterCallback
+func wandbcoreRegisterCallback(callback C.wandbcore_callback, userData unsafe.Pointer) int {
+	callbacks.Lock()
+	defer callbacks.Unlock()
+	id := callbacks.nextID
+	callbacks.nextID++
+	callbacks.entries[id] = callbackEntry{callback: callback, userData: userData}
+	return id
+}
+
+//export wandbcoreUnregisterCallback
+func wandbcoreUnregisterCallback(id int) {
+	callbacks.Lock()
+	defer callbacks.Unlock()
+	delete(callbacks.entries, id)
+}
+
+// hasCallbacks returns whether any callbacks are registered.
+func hasCallbacks() bool {
+	callbacks.Lock()
+	defer callbacks.Unlock()
+	return len(callbacks.entries) > 0
+}
+
+// notify invokes all registered callbacks with an event.
+func notify(event int, run int, message string) {
+	callbacks.Lock()
+	entries := make([]callbackEntry, 0, len(callbacks.entries))
+	for _, entry := range callbacks.entries {
+		entries = append(entries, entry)
+	}
+	callbacks.Unlock()
+
+	if len(entries) == 0 {
+		return
+	}
+
+	cMessage := C.CString(message)
+	defer C.free(unsafe.Pointer(cMessage))
+	for _, entry := range entries {
+		C.wandbcore_invoke(
+			entry.callback,
+			C.int(event),
+			C.int(run),
+			cMessage,
+			entry.userData,
+		)
+	}
+}
@@ -21,6 +21,8 @@ func NewPartialData() *PartialData {
 }
 
 func (p *PartialData) Get(num int) MapData {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
 	return p.data[num]
 }
 
@@ -20,7 +20,10 @@ func NewRunKeeper() *RunKeeper {
 	}
 }
 
+// Get returns the run with the handle, or nil if there is none.
 func (k *RunKeeper) Get(num int) *gowandb.Run {
+	k.mutex.Lock()
+	defer k.mutex.Unlock()
 	return k.runs[num]
 }
 
@@ -3,6 +3,10 @@ package core
 /*
 typedef const char cchar_t;
 #define WANDBCORE_DATA_CREATE 0
+#define WANDBCORE_OK 0
+#define WANDBCORE_ERR_BAD_HANDLE -1
+#define WANDBCORE_ERR_BAD_JSON -2
+#define WANDBCORE_ERR_FAILED -3
 typedef enum {
 	LIB_GOLANG, LIB_C, LIB_CPP
 } library_t;
@@ -10,6 +14,11 @@ typedef enum {
 import "C"
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
 	"unsafe"
 
 	"github.com/wandb/wandb/core/pkg/service"
@@ -25,8 +34,14 @@ var wandbSession *gowandb.Session
 var wandbRuns *RunKeeper
 var wandbData *PartialData
 
+// wandbSetupMutex guards creating the session, since runs can be
+// initialized from several threads at once.
+var wandbSetupMutex sync.Mutex
+
 //export wandbcoreSetup
 func wandbcoreSetup() {
+	wandbSetupMutex.Lock()
+	defer wandbSetupMutex.Unlock()
 	if wandbSession != nil {
 		return
 	}
@@ -78,9 +93,18 @@ func wandbcoreInit(configDataNum int, name *C.cchar_t, runID *C.cchar_t, project
 
 	run, err := wandbSession.NewRun(options...)
 	if err != nil {
-		panic(err)
+		notify(eventError, 0, err.Error())
+		return C.WANDBCORE_ERR_FAILED
 	}
 	num := wandbRuns.Add(run)
+	notify(eventRunURL, num, run.URL())
+	go func() {
+		ctx := run.Context()
+		<-ctx.Done()
+		if errors.Is(context.Cause(ctx), gowandb.ErrStopRequested) {
+			notify(eventStopRequested, num, "")
+		}
+	}()
 	return num
 }
 
@@ -136,22 +160,117 @@ func wandbcoreDataAddStrings(num int, cLength C.int, cKeys **C.cchar_t, cStrings
 }
 
 //export wandbcoreLogData
-func wandbcoreLogData(runNum int, dataNum int) {
+func wandbcoreLogData(runNum int, dataNum int) C.int {
 	run := wandbRuns.Get(runNum)
 	data := wandbData.Get(dataNum)
-	run.Log(data)
 	wandbData.Remove(dataNum)
+	if run == nil || data == nil {
+		return C.WANDBCORE_ERR_BAD_HANDLE
+	}
+	run.Log(data)
+	return C.WANDBCORE_OK
+}
+
+// wandbcoreLogJSON logs history from a JSON object mapping keys to values.
+//
+//export wandbcoreLogJSON
+func wandbcoreLogJSON(runNum int, cJSON *C.cchar_t) C.int {
+	run := wandbRuns.Get(runNum)
+	if run == nil {
+		return C.WANDBCORE_ERR_BAD_HANDLE
+	}
+
+	var data map[string]interface{}
+	if err := json.Unmarshal([]byte(C.GoString(cJSON)), &data); err != nil {
+		return C.WANDBCORE_ERR_BAD_JSON
+	}
+	run.Log(data)
+	return C.WANDBCORE_OK
+}
+
+// wandbcoreLogArtifact uploads local files as a new version of an
+// artifact. names are the files' names in the artifact.
+//
+//export wandbcoreLogArtifact
+func wandbcoreLogArtifact(
+	runNum int,
+	name *C.cchar_t,
+	artifactType *C.cchar_t,
+	cLength C.int,
+	cNames **C.cchar_t,
+	cPaths **C.cchar_t,
+) C.int {
+	run := wandbRuns.Get(runNum)
+	if run == nil {
+		return C.WANDBCORE_ERR_BAD_HANDLE
+	}
+
+	names := unsafe.Slice(cNames, cLength)
+	paths := unsafe.Slice(cPaths, cLength)
+	files := make(map[string]string, len(names))
+	for i := range names {
+		files[C.GoString(names[i])] = C.GoString(paths[i])
+	}
+
+	_, err := run.LogArtifact(C.GoString(name), C.GoString(artifactType), files)
+	if err != nil {
+		notify(eventError, runNum, err.Error())
+		return C.WANDBCORE_ERR_FAILED
+	}
+	return C.WANDBCORE_OK
 }
 
 //export wandbcoreFinish
-func wandbcoreFinish(num int) {
+func wandbcoreFinish(num int) C.int {
 	run := wandbRuns.Get(num)
+	if run == nil {
+		return C.WANDBCORE_ERR_BAD_HANDLE
+	}
+
+	if hasCallbacks() {
+		done := make(chan struct{})
+		defer close(done)
+		go reportUploadProgress(num, run, done)
+	}
+
 	run.Finish()
 	wandbRuns.Remove(num)
+	return C.WANDBCORE_OK
+}
+
+// reportUploadProgress notifies callbacks of a finishing run's upload
+// progress every second until done is closed.
+func reportUploadProgress(num int, run *gowandb.Run, done <-chan struct{}) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+		}
+
+		uploaded, total, err := run.UploadProgress()
+		if err != nil {
+			// The run finished and closed its connection.
+			return
+		}
+		message, _ := json.Marshal(map[string]int64{
+			"uploaded_bytes": uploaded,
+			"total_bytes":    total,
+		})
+		notify(eventUploadProgress, num, string(message))
+	}
 }
 
 //export wandbcoreTeardown
 func wandbcoreTeardown() {
+	wandbSetupMutex.Lock()
+	defer wandbSetupMutex.Unlock()
+	if wandbSession == nil {
+		return
+	}
 	wandbSession.Close()
 	wandbSession = nil
 }
@@ -3,10 +3,15 @@ package main
 import (
 	"flag"
 	"fmt"
+	"os"
 	"runtime"
+	"strconv"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/wandb/wandb/experimental/client-go/pkg/gowandb"
+	"github.com/wandb/wandb/experimental/client-go/pkg/opts/runopts"
 	"github.com/wandb/wandb/experimental/client-go/pkg/opts/sessionopts"
 	"github.com/wandb/wandb/experimental/client-go/pkg/settings"
 )
@@ -20,19 +25,43 @@ type BenchOpts struct {
 	offline            *bool
 	numCPUs            *int
 	numWorkers         *int
+	flushEvery         *int
+	histogram          *bool
+	transports         *string
+	profiles           *string
+	report             *string
+	baseline           *string
+	tolerance          *float64
+	soak               *time.Duration
+	sampleEvery        *time.Duration
+	statusURL          *string
+	leakTolerance      *float64
 }
 
 type Bench struct {
 	opts  BenchOpts
 	wandb *gowandb.Session
+
+	// coreArgs are passed to the core service if the benchmark launches it
+	coreArgs []string
+
+	mu        sync.Mutex
+	latencies []time.Duration
+	flushes   []time.Duration
+	finishes  []time.Duration
 }
 
 func NewBench(benchOpts BenchOpts) *Bench {
 	return &Bench{opts: benchOpts}
 }
 
-func (b *Bench) Setup() {
-	opts := []sessionopts.SessionOption{}
+// Setup connects to the core service, launching it to listen on the
+// transport unless connecting to one by port.
+func (b *Bench) Setup(transport string) {
+	opts := []sessionopts.SessionOption{
+		sessionopts.WithCoreArgs(b.coreArgs...),
+		sessionopts.WithTransport(sessionopts.Transport(transport)),
+	}
 	if *b.opts.port != 0 {
 		opts = append(opts, sessionopts.WithCoreAddress(fmt.Sprintf("%s:%d", *b.opts.host, *b.opts.port)))
 	}
@@ -48,36 +77,79 @@ func (b *Bench) Setup() {
 	}
 }
 
-func (b *Bench) RunWorkers() {
+// RunWorkers logs the profile's records from all workers and returns
+// the measurements.
+func (b *Bench) RunWorkers(transport string, profile PayloadProfile) Result {
 	if *b.opts.numCPUs != 0 {
 		runtime.GOMAXPROCS(*b.opts.numCPUs)
 	}
+	b.latencies = nil
+	b.flushes = nil
+	b.finishes = nil
+
+	cpuStart := cpuTime()
+	start := time.Now()
 	var wg sync.WaitGroup
 	for i := 0; i < *b.opts.numWorkers; i++ {
 		wg.Add(1)
 		go func() {
-			b.Worker()
+			b.Worker(profile)
 			wg.Done()
 		}()
 	}
 	wg.Wait()
+
+	return newResult(
+		transport,
+		profile,
+		*b.opts.numWorkers,
+		time.Since(start),
+		b.latencies,
+		b.flushes,
+		b.finishes,
+		cpuTime()-cpuStart,
+	)
 }
 
-func (b *Bench) Worker() {
-	run, err := b.wandb.NewRun()
+func (b *Bench) Worker(profile PayloadProfile) {
+	var runOpts []runopts.RunOption
+	if config := profile.Config(); config != nil {
+		runOpts = append(runOpts, runopts.WithConfig(config))
+	}
+	run, err := b.wandb.NewRun(runOpts...)
 	if err != nil {
 		panic(err)
 	}
 
-	data := make(gowandb.History)
-	for i := 0; i < *b.opts.numHistoryElements; i++ {
-		data[fmt.Sprintf("loss_%d", i)] = float64(100 + i)
-	}
+	data := profile.History()
 
+	latencies := make([]time.Duration, 0, *b.opts.numHistory)
+	var flushes []time.Duration
 	for i := 0; i < *b.opts.numHistory; i++ {
+		start := time.Now()
 		run.Log(data)
+		latencies = append(latencies, time.Since(start))
+
+		if *b.opts.flushEvery > 0 && (i+1)%*b.opts.flushEvery == 0 {
+			// A round trip to the service returns once it has processed
+			// the records logged before it.
+			start := time.Now()
+			if _, _, err := run.UploadProgress(); err != nil {
+				panic(err)
+			}
+			flushes = append(flushes, time.Since(start))
+		}
 	}
+
+	finishStart := time.Now()
 	run.Finish()
+	finish := time.Since(finishStart)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.latencies = append(b.latencies, latencies...)
+	b.flushes = append(b.flushes, flushes...)
+	b.finishes = append(b.finishes, finish)
 }
 
 func (b *Bench) Close() {
@@ -96,11 +168,158 @@ func main() {
 		offline:            flag.Bool("offline", false, "use offline mode"),
 		numCPUs:            flag.Int("numCPUs", 0, "number of cpus"),
 		numWorkers:         flag.Int("numWorkers", 1, "number of parallel workers"),
+		flushEvery: flag.Int("flushEvery", 0,
+			"wait for the service to catch up after this many records and measure how long it takes"),
+		histogram: flag.Bool("histogram", false, "print latency histograms"),
+		transports: flag.String("transports", strings.Join(transports, ","),
+			"comma-separated transports to compare; only tcp with -port"),
+		profiles: flag.String("profiles", "",
+			"comma-separated payload profiles (small, medium, large, text, nested, config, media); defaults to numHistoryElements floats"),
+		report:    flag.String("report", "", "path to write a report to, as CSV if it ends in .csv and JSON otherwise"),
+		baseline:  flag.String("baseline", "", "path to a previous JSON report to compare against"),
+		tolerance: flag.Float64("tolerance", 0.1, "fraction of throughput that may be lost relative to the baseline"),
+		soak: flag.Duration("soak", 0,
+			"log continuously for this long, e.g. 24h, and fail if the core service's resource use keeps growing"),
+		sampleEvery: flag.Duration("sampleEvery", time.Minute, "how often to sample resource use in soak mode"),
+		statusURL: flag.String("statusURL", "",
+			"core service's /debug/vars URL for soak mode; required with -port"),
+		leakTolerance: flag.Float64("leakTolerance", 0.2,
+			"fraction by which resource use may grow during a soak test"),
 	}
 	flag.Parse()
 
+	selectedTransports, err := parseTransports(*benchOpts.transports)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	if *benchOpts.port != 0 {
+		// An existing core service is reached over TCP.
+		if isFlagSet("transports") && *benchOpts.transports != "tcp" {
+			fmt.Fprintln(os.Stderr, "-port only supports the tcp transport")
+			os.Exit(2)
+		}
+		selectedTransports = []string{"tcp"}
+	}
+	profiles, err := parseProfiles(*benchOpts.profiles)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	if len(profiles) == 0 {
+		profiles = []PayloadProfile{{
+			Name:      "custom",
+			NumFloats: *benchOpts.numHistoryElements,
+		}}
+	}
+
+	report := &Report{
+		GOOS:   runtime.GOOS,
+		GOARCH: runtime.GOARCH,
+		NumCPU: runtime.NumCPU(),
+	}
+
+	if *benchOpts.soak > 0 {
+		os.Exit(soak(benchOpts, selectedTransports[0], profiles[0], report))
+	}
+
+	for _, transport := range selectedTransports {
+		b := NewBench(benchOpts)
+		b.Setup(transport)
+		for _, profile := range profiles {
+			report.Results = append(report.Results, b.RunWorkers(transport, profile))
+		}
+		b.Close()
+	}
+
+	report.PrintTable(os.Stdout)
+	if *benchOpts.histogram {
+		report.PrintHistograms(os.Stdout)
+	}
+
+	if *benchOpts.report != "" {
+		if err := report.WriteFile(*benchOpts.report); err != nil {
+			fmt.Fprintf(os.Stderr, "failed to write report: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	if *benchOpts.baseline != "" {
+		baseline, err := ReadReport(*benchOpts.baseline)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "failed to read baseline: %v\n", err)
+			os.Exit(1)
+		}
+		if regressions := report.Regressions(baseline, *benchOpts.tolerance); len(regressions) > 0 {
+			fmt.Fprintln(os.Stderr, "throughput regressions:")
+			for _, regression := range regressions {
+				fmt.Fprintf(os.Stderr, "  %s\n", regression)
+			}
+			os.Exit(1)
+		}
+	}
+}
+
+// isFlagSet returns whether a flag was given on the command line.
+func isFlagSet(name string) bool {
+	set := false
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+	return set
+}
+
+// soak runs a soak test and returns the exit code: 1 if resource use
+// kept growing.
+func soak(
+	benchOpts BenchOpts,
+	transport string,
+	profile PayloadProfile,
+	report *Report,
+) int {
 	b := NewBench(benchOpts)
-	b.Setup()
-	b.RunWorkers()
+
+	statusURL := *benchOpts.statusURL
+	if statusURL == "" {
+		if *benchOpts.port != 0 {
+			fmt.Fprintln(os.Stderr, "-statusURL is required to soak an existing core service")
+			return 2
+		}
+		port, err := freePort()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "failed to find a port for the status endpoint: %v\n", err)
+			return 1
+		}
+		b.coreArgs = []string{"--status-port", strconv.Itoa(port)}
+		statusURL = fmt.Sprintf("http://127.0.0.1:%d/debug/vars", port)
+	}
+
+	b.Setup(transport)
+	report.Soak = b.Soak(profile, *benchOpts.soak, *benchOpts.sampleEvery, statusURL)
 	b.Close()
+
+	exitCode := 0
+	if err := report.Soak.DetectLeaks(*benchOpts.leakTolerance); err != nil {
+		fmt.Fprintf(os.Stderr, "soak test inconclusive: %v\n", err)
+		exitCode = 1
+	}
+	report.Soak.Print(os.Stdout)
+
+	if *benchOpts.report != "" {
+		if err := report.WriteFile(*benchOpts.report); err != nil {
+			fmt.Fprintf(os.Stderr, "failed to write report: %v\n", err)
+			return 1
+		}
+	}
+
+	if len(report.Soak.Leaks) > 0 {
+		fmt.Fprintln(os.Stderr, "resource use kept growing:")
+		for _, leak := range report.Soak.Leaks {
+			fmt.Fprintf(os.Stderr, "  %s\n", leak)
+		}
+		exitCode = 1
+	}
+	return exitCode
 }
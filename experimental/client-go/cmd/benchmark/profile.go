@@ -0,0 +1,158 @@
+package main
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+
+	"github.com/wandb/wandb/experimental/client-go/pkg/gowandb"
+	"github.com/wandb/wandb/experimental/client-go/pkg/runconfig"
+)
+
+// transports are the IPC transports that can be benchmarked, named as
+// sessionopts transports.
+//
+// gRPC and shared memory aren't listed because the core service has no
+// server for them; new transports should be added here as they become
+// available so that they are measured against the same payload profiles.
+var transports = supportedTransports()
+
+// supportedTransports returns the transports the core service can listen
+// on in this OS.
+func supportedTransports() []string {
+	if runtime.GOOS == "windows" {
+		return []string{"tcp", "unix", "pipe"}
+	}
+	return []string{"tcp", "unix"}
+}
+
+// PayloadProfile is a shape of history record to log.
+type PayloadProfile struct {
+	Name string
+
+	// NumFloats is the number of numeric values in a record.
+	NumFloats int
+
+	// NumStrings is the number of string values in a record.
+	NumStrings int
+
+	// StringSize is the length of each string value.
+	StringSize int
+
+	// NumArrays is the number of large array values in a record, which
+	// stand in for media such as images and histograms.
+	NumArrays int
+
+	// ArraySize is the number of numbers in each array value.
+	ArraySize int
+
+	// Depth is how many levels of maps the values are nested in.
+	Depth int
+
+	// NumConfigKeys is the number of values in each run's config.
+	NumConfigKeys int
+}
+
+var payloadProfiles = map[string]PayloadProfile{
+	"small":  {Name: "small", NumFloats: 5},
+	"medium": {Name: "medium", NumFloats: 100},
+	"large":  {Name: "large", NumFloats: 1000},
+	"text":   {Name: "text", NumStrings: 10, StringSize: 1024},
+	"nested": {Name: "nested", NumFloats: 100, Depth: 3},
+	"config": {Name: "config", NumFloats: 5, NumConfigKeys: 1000, Depth: 2},
+	"media":  {Name: "media", NumFloats: 5, NumArrays: 2, ArraySize: 65536},
+}
+
+// nestingFanout is how many maps there are at each level of nesting.
+const nestingFanout = 4
+
+// History returns a history record matching the profile.
+func (p PayloadProfile) History() gowandb.History {
+	data := make(gowandb.History)
+	for i := 0; i < p.NumFloats; i++ {
+		p.nest(data, i)[fmt.Sprintf("loss_%d", i)] = float64(100 + i)
+	}
+	for i := 0; i < p.NumStrings; i++ {
+		p.nest(data, i)[fmt.Sprintf("text_%d", i)] = strings.Repeat("x", p.StringSize)
+	}
+	for i := 0; i < p.NumArrays; i++ {
+		values := make([]float64, p.ArraySize)
+		for j := range values {
+			values[j] = float64(j)
+		}
+		p.nest(data, i)[fmt.Sprintf("media_%d", i)] = values
+	}
+	return data
+}
+
+// Config returns a run config matching the profile, or nil if the
+// profile has no config.
+func (p PayloadProfile) Config() runconfig.Config {
+	if p.NumConfigKeys == 0 {
+		return nil
+	}
+	config := make(runconfig.Config)
+	for i := 0; i < p.NumConfigKeys; i++ {
+		p.nest(config, i)[fmt.Sprintf("param_%d", i)] = float64(i)
+	}
+	return config
+}
+
+// nest returns the map that the i-th value goes in, creating the
+// profile's levels of nested maps under data as needed.
+func (p PayloadProfile) nest(data map[string]interface{}, i int) map[string]interface{} {
+	for level := 0; level < p.Depth; level++ {
+		key := fmt.Sprintf("group_%d", i%nestingFanout)
+		i /= nestingFanout
+
+		child, ok := data[key].(map[string]interface{})
+		if !ok {
+			child = make(map[string]interface{})
+			data[key] = child
+		}
+		data = child
+	}
+	return data
+}
+
+// parseProfiles returns the payload profiles named in a comma-separated list.
+func parseProfiles(names string) ([]PayloadProfile, error) {
+	var profiles []PayloadProfile
+	for _, name := range strings.Split(names, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		profile, ok := payloadProfiles[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown payload profile %q", name)
+		}
+		profiles = append(profiles, profile)
+	}
+	return profiles, nil
+}
+
+// parseTransports validates a comma-separated list of transports.
+func parseTransports(names string) ([]string, error) {
+	var selected []string
+	for _, name := range strings.Split(names, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		supported := false
+		for _, transport := range transports {
+			if transport == name {
+				supported = true
+				break
+			}
+		}
+		if !supported {
+			return nil, fmt.Errorf(
+				"unsupported transport %q (supported: %s)",
+				name, strings.Join(transports, ", "))
+		}
+		selected = append(selected, name)
+	}
+	return selected, nil
+}
@@ -0,0 +1,281 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// Result is the measurement of one transport and payload profile.
+type Result struct {
+	Transport string `json:"transport"`
+	Profile   string `json:"profile"`
+	Workers   int    `json:"workers"`
+	Records   int    `json:"records"`
+
+	// Seconds is the wall time from the first record to the last finish.
+	Seconds float64 `json:"seconds"`
+
+	// RecordsPerSecond is the logging throughput over all workers.
+	RecordsPerSecond float64 `json:"records_per_second"`
+
+	// LatencyP50Micros etc. are percentiles of the time to send a record.
+	LatencyP50Micros float64 `json:"latency_p50_us"`
+	LatencyP95Micros float64 `json:"latency_p95_us"`
+	LatencyP99Micros float64 `json:"latency_p99_us"`
+
+	// LatencyHistogram counts the times to send a record.
+	LatencyHistogram []HistogramBucket `json:"latency_histogram"`
+
+	// Flushes is the number of times the benchmark waited for the service
+	// to process the records logged so far, and FlushP50Micros etc. are
+	// percentiles of how long that took.
+	Flushes        int     `json:"flushes"`
+	FlushP50Micros float64 `json:"flush_p50_us"`
+	FlushP95Micros float64 `json:"flush_p95_us"`
+	FlushP99Micros float64 `json:"flush_p99_us"`
+
+	// FinishSeconds is the mean time for a run to finish, which includes
+	// waiting for the service to process all records.
+	FinishSeconds float64 `json:"finish_seconds"`
+
+	// CPUSeconds is the CPU time used by the benchmark process.
+	CPUSeconds float64 `json:"cpu_seconds"`
+}
+
+// HistogramBucket is the number of measurements that took at most
+// UpperMicros, and more than the previous bucket's bound.
+type HistogramBucket struct {
+	UpperMicros float64 `json:"upper_us"`
+	Count       int     `json:"count"`
+}
+
+// Report is the outcome of a benchmark invocation.
+type Report struct {
+	GOOS    string   `json:"goos"`
+	GOARCH  string   `json:"goarch"`
+	NumCPU  int      `json:"num_cpu"`
+	Results []Result `json:"results"`
+
+	// Soak is the outcome of a soak test, which replaces the results.
+	Soak *SoakResult `json:"soak,omitempty"`
+}
+
+// percentile returns the p-th percentile of sorted durations in microseconds.
+func percentile(sorted []time.Duration, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	i := int(p * float64(len(sorted)-1))
+	return float64(sorted[i]) / float64(time.Microsecond)
+}
+
+// histogram counts sorted durations in buckets whose bounds double,
+// starting at 1 microsecond.
+func histogram(sorted []time.Duration) []HistogramBucket {
+	var buckets []HistogramBucket
+	for _, d := range sorted {
+		micros := float64(d) / float64(time.Microsecond)
+		upper := math.Pow(2, math.Max(0, math.Ceil(math.Log2(micros))))
+		if len(buckets) == 0 || buckets[len(buckets)-1].UpperMicros < upper {
+			buckets = append(buckets, HistogramBucket{UpperMicros: upper})
+		}
+		buckets[len(buckets)-1].Count++
+	}
+	return buckets
+}
+
+func newResult(
+	transport string,
+	profile PayloadProfile,
+	workers int,
+	elapsed time.Duration,
+	latencies []time.Duration,
+	flushes []time.Duration,
+	finishes []time.Duration,
+	cpu time.Duration,
+) Result {
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+	sort.Slice(flushes, func(i, j int) bool { return flushes[i] < flushes[j] })
+
+	var finishTotal time.Duration
+	for _, d := range finishes {
+		finishTotal += d
+	}
+
+	result := Result{
+		Transport:        transport,
+		Profile:          profile.Name,
+		Workers:          workers,
+		Records:          len(latencies),
+		Seconds:          elapsed.Seconds(),
+		LatencyP50Micros: percentile(latencies, 0.50),
+		LatencyP95Micros: percentile(latencies, 0.95),
+		LatencyP99Micros: percentile(latencies, 0.99),
+		LatencyHistogram: histogram(latencies),
+		Flushes:          len(flushes),
+		FlushP50Micros:   percentile(flushes, 0.50),
+		FlushP95Micros:   percentile(flushes, 0.95),
+		FlushP99Micros:   percentile(flushes, 0.99),
+		CPUSeconds:       cpu.Seconds(),
+	}
+	if elapsed > 0 {
+		result.RecordsPerSecond = float64(len(latencies)) / elapsed.Seconds()
+	}
+	if len(finishes) > 0 {
+		result.FinishSeconds = finishTotal.Seconds() / float64(len(finishes))
+	}
+	return result
+}
+
+// PrintTable writes the results as a human-readable table.
+func (r *Report) PrintTable(w io.Writer) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "transport\tprofile\trecords\trecords/s\tp50 us\tp95 us\tp99 us\tflush p50 us\tflush p99 us\tfinish s\tcpu s\t")
+	for _, res := range r.Results {
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%.0f\t%.1f\t%.1f\t%.1f\t%.1f\t%.1f\t%.3f\t%.3f\t\n",
+			res.Transport, res.Profile, res.Records, res.RecordsPerSecond,
+			res.LatencyP50Micros, res.LatencyP95Micros, res.LatencyP99Micros,
+			res.FlushP50Micros, res.FlushP99Micros,
+			res.FinishSeconds, res.CPUSeconds)
+	}
+	tw.Flush()
+}
+
+// histogramWidth is the length of the longest bar PrintHistograms draws.
+const histogramWidth = 50
+
+// PrintHistograms draws each result's latency histogram.
+func (r *Report) PrintHistograms(w io.Writer) {
+	for _, res := range r.Results {
+		fmt.Fprintf(w, "\n%s/%s latency:\n", res.Transport, res.Profile)
+
+		maxCount := 0
+		for _, bucket := range res.LatencyHistogram {
+			maxCount = max(maxCount, bucket.Count)
+		}
+		for _, bucket := range res.LatencyHistogram {
+			bar := strings.Repeat("#", bucket.Count*histogramWidth/maxCount)
+			fmt.Fprintf(w, "  <= %8.0f us %8d %s\n", bucket.UpperMicros, bucket.Count, bar)
+		}
+	}
+}
+
+// csvHeader names the columns written by writeCSV.
+var csvHeader = []string{
+	"transport", "profile", "workers", "records", "seconds",
+	"records_per_second", "latency_p50_us", "latency_p95_us", "latency_p99_us",
+	"flushes", "flush_p50_us", "flush_p95_us", "flush_p99_us",
+	"finish_seconds", "cpu_seconds",
+}
+
+// writeCSV writes one row per result, leaving out the histograms, or one
+// row per sample of a soak test.
+func (r *Report) writeCSV(w io.Writer) error {
+	if r.Soak != nil {
+		return r.Soak.writeCSV(w)
+	}
+
+	formatFloat := func(f float64) string {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, res := range r.Results {
+		err := writer.Write([]string{
+			res.Transport,
+			res.Profile,
+			strconv.Itoa(res.Workers),
+			strconv.Itoa(res.Records),
+			formatFloat(res.Seconds),
+			formatFloat(res.RecordsPerSecond),
+			formatFloat(res.LatencyP50Micros),
+			formatFloat(res.LatencyP95Micros),
+			formatFloat(res.LatencyP99Micros),
+			strconv.Itoa(res.Flushes),
+			formatFloat(res.FlushP50Micros),
+			formatFloat(res.FlushP95Micros),
+			formatFloat(res.FlushP99Micros),
+			formatFloat(res.FinishSeconds),
+			formatFloat(res.CPUSeconds),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// WriteFile saves the report as CSV if the path ends in ".csv", and as
+// JSON otherwise.
+func (r *Report) WriteFile(path string) error {
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		file, err := os.Create(path)
+		if err != nil {
+			return err
+		}
+		if err := r.writeCSV(file); err != nil {
+			file.Close()
+			return err
+		}
+		return file.Close()
+	}
+
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// ReadReport loads a report saved by WriteFile.
+func ReadReport(path string) (*Report, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	report := &Report{}
+	if err := json.Unmarshal(data, report); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// Regressions compares the report against a baseline and describes each
+// result whose throughput dropped by more than the given fraction.
+func (r *Report) Regressions(baseline *Report, tolerance float64) []string {
+	type key struct{ transport, profile string }
+	previous := make(map[key]Result)
+	for _, res := range baseline.Results {
+		previous[key{res.Transport, res.Profile}] = res
+	}
+
+	var regressions []string
+	for _, res := range r.Results {
+		prev, ok := previous[key{res.Transport, res.Profile}]
+		if !ok || prev.RecordsPerSecond == 0 {
+			continue
+		}
+		change := res.RecordsPerSecond/prev.RecordsPerSecond - 1
+		if change < -tolerance {
+			regressions = append(regressions, fmt.Sprintf(
+				"%s/%s: %.0f records/s, down %.1f%% from %.0f",
+				res.Transport, res.Profile, res.RecordsPerSecond,
+				-change*100, prev.RecordsPerSecond))
+		}
+	}
+	return regressions
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"encoding/csv"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+)
+
+func micros(values ...int) []time.Duration {
+	durations := make([]time.Duration, len(values))
+	for i, value := range values {
+		durations[i] = time.Duration(value) * time.Microsecond
+	}
+	return durations
+}
+
+func TestPercentile(t *testing.T) {
+	sorted := micros(1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
+
+	for p, want := range map[float64]float64{0: 1, 0.5: 5, 0.99: 9, 1: 10} {
+		if got := percentile(sorted, p); got != want {
+			t.Errorf("percentile(%v) = %v, want %v", p, got, want)
+		}
+	}
+	if got := percentile(nil, 0.5); got != 0 {
+		t.Errorf("percentile of nothing = %v, want 0", got)
+	}
+}
+
+func TestHistogram(t *testing.T) {
+	sorted := append([]time.Duration{500 * time.Nanosecond}, micros(2, 3, 4, 100)...)
+
+	buckets := histogram(sorted)
+
+	want := []HistogramBucket{
+		{UpperMicros: 1, Count: 1},
+		{UpperMicros: 2, Count: 1},
+		{UpperMicros: 4, Count: 2},
+		{UpperMicros: 128, Count: 1},
+	}
+	if !reflect.DeepEqual(buckets, want) {
+		t.Errorf("histogram = %v, want %v", buckets, want)
+	}
+}
+
+func TestNewResult(t *testing.T) {
+	result := newResult(
+		"unix",
+		payloadProfiles["small"],
+		2,
+		2*time.Second,
+		micros(40, 10, 30, 20),
+		micros(7),
+		[]time.Duration{time.Second, 3 * time.Second},
+		time.Second,
+	)
+
+	if result.Transport != "unix" || result.Profile != "small" || result.Workers != 2 {
+		t.Errorf("result is for %s/%s with %d workers",
+			result.Transport, result.Profile, result.Workers)
+	}
+	if result.Records != 4 || result.RecordsPerSecond != 2 {
+		t.Errorf("got %d records at %v/s, want 4 at 2/s",
+			result.Records, result.RecordsPerSecond)
+	}
+	if result.LatencyP50Micros != 20 || result.LatencyP99Micros != 30 {
+		t.Errorf("latency p50 = %v, p99 = %v, want 20 and 30",
+			result.LatencyP50Micros, result.LatencyP99Micros)
+	}
+	if result.Flushes != 1 || result.FlushP50Micros != 7 {
+		t.Errorf("got %d flushes with p50 %v, want 1 with 7",
+			result.Flushes, result.FlushP50Micros)
+	}
+	if result.FinishSeconds != 2 || result.CPUSeconds != 1 {
+		t.Errorf("finish = %vs, cpu = %vs, want 2 and 1",
+			result.FinishSeconds, result.CPUSeconds)
+	}
+}
+
+func testReport() *Report {
+	return &Report{
+		GOOS:   "linux",
+		GOARCH: "amd64",
+		NumCPU: 8,
+		Results: []Result{
+			{
+				Transport:        "tcp",
+				Profile:          "small",
+				Records:          1000,
+				RecordsPerSecond: 850,
+				LatencyHistogram: []HistogramBucket{{UpperMicros: 64, Count: 1000}},
+			},
+			{Transport: "unix", Profile: "small", Records: 1000, RecordsPerSecond: 950},
+			{Transport: "unix", Profile: "large", Records: 1000, RecordsPerSecond: 10},
+		},
+	}
+}
+
+func TestReport_JSONRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "report.json")
+	report := testReport()
+
+	if err := report.WriteFile(path); err != nil {
+		t.Fatal(err)
+	}
+	read, err := ReadReport(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(read, report) {
+		t.Errorf("read %+v, want %+v", read, report)
+	}
+}
+
+func TestReport_CSV(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "report.csv")
+
+	if err := testReport().WriteFile(path); err != nil {
+		t.Fatal(err)
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	rows, err := csv.NewReader(file).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(rows) != 4 {
+		t.Fatalf("got %d rows, want a header and 3 results", len(rows))
+	}
+	if !slices.Equal(rows[0], csvHeader) {
+		t.Errorf("header = %v", rows[0])
+	}
+	if want := []string{"tcp", "small", "0", "1000", "0", "850"}; !slices.Equal(rows[1][:6], want) {
+		t.Errorf("first result = %v, want it to start with %v", rows[1], want)
+	}
+}
+
+func TestRegressions(t *testing.T) {
+	baseline := &Report{Results: []Result{
+		{Transport: "tcp", Profile: "small", RecordsPerSecond: 1000},
+		{Transport: "unix", Profile: "small", RecordsPerSecond: 1000},
+	}}
+
+	regressions := testReport().Regressions(baseline, 0.1)
+
+	want := []string{"tcp/small: 850 records/s, down 15.0% from 1000"}
+	if !slices.Equal(regressions, want) {
+		t.Errorf("regressions = %q, want %q", regressions, want)
+	}
+}
+
+func TestParseTransports(t *testing.T) {
+	selected, err := parseTransports("tcp, unix,")
+	if err != nil || !slices.Equal(selected, []string{"tcp", "unix"}) {
+		t.Errorf("parseTransports = %v, %v", selected, err)
+	}
+
+	if _, err := parseTransports("grpc"); err == nil {
+		t.Error("expected an error for a transport the core service lacks")
+	}
+}
@@ -0,0 +1,248 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// StatusSample is a measurement of the core service's resource use
+// during a soak test.
+type StatusSample struct {
+	// Seconds is the time since the soak test started.
+	Seconds float64 `json:"seconds"`
+
+	// Records is the number of records logged so far.
+	Records int64 `json:"records"`
+
+	RSSBytes      uint64 `json:"rss_bytes"`
+	Goroutines    int    `json:"goroutines"`
+	QueuedRecords int    `json:"queued_records"`
+}
+
+// SoakResult is the outcome of a soak test.
+type SoakResult struct {
+	Profile string         `json:"profile"`
+	Workers int            `json:"workers"`
+	Seconds float64        `json:"seconds"`
+	Records int64          `json:"records"`
+	Samples []StatusSample `json:"samples"`
+
+	// Leaks describes each resource that kept growing.
+	Leaks []string `json:"leaks"`
+}
+
+// coreStatus is the part of the core service's /debug/vars we sample.
+type coreStatus struct {
+	Goroutines int    `json:"goroutines"`
+	RSSBytes   uint64 `json:"rss_bytes"`
+	Streams    map[string]struct {
+		QueuedRecords int
+	} `json:"streams"`
+}
+
+// freePort returns a localhost TCP port that is not in use.
+func freePort() (int, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return 0, err
+	}
+	defer listener.Close()
+	return listener.Addr().(*net.TCPAddr).Port, nil
+}
+
+// sampleStatus reads the core service's resource use from its status
+// endpoint.
+func sampleStatus(statusURL string) (StatusSample, error) {
+	resp, err := http.Get(statusURL)
+	if err != nil {
+		return StatusSample{}, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return StatusSample{}, fmt.Errorf("status endpoint returned %s", resp.Status)
+	}
+
+	var status coreStatus
+	if err := json.NewDecoder(resp.Body).Decode(&status); err != nil {
+		return StatusSample{}, err
+	}
+
+	sample := StatusSample{
+		RSSBytes:   status.RSSBytes,
+		Goroutines: status.Goroutines,
+	}
+	for _, stream := range status.Streams {
+		sample.QueuedRecords += stream.QueuedRecords
+	}
+	return sample, nil
+}
+
+// Soak logs the profile's records from all workers until the duration
+// elapses, sampling the core service's resource use at each interval.
+//
+// Each worker finishes its run and starts a new one after numHistory
+// records, so that leaks tied to a run's lifetime show up too.
+func (b *Bench) Soak(
+	profile PayloadProfile,
+	duration, interval time.Duration,
+	statusURL string,
+) *SoakResult {
+	var records atomic.Int64
+	stop := make(chan struct{})
+	stopped := func() bool {
+		select {
+		case <-stop:
+			return true
+		default:
+			return false
+		}
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < *b.opts.numWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			data := profile.History()
+			for !stopped() {
+				run, err := b.wandb.NewRun()
+				if err != nil {
+					panic(err)
+				}
+				for i := 0; i < *b.opts.numHistory && !stopped(); i++ {
+					run.Log(data)
+					records.Add(1)
+				}
+				run.Finish()
+			}
+		}()
+	}
+
+	result := &SoakResult{
+		Profile: profile.Name,
+		Workers: *b.opts.numWorkers,
+	}
+	start := time.Now()
+	ticker := time.NewTicker(interval)
+	for time.Since(start) < duration {
+		<-ticker.C
+
+		sample, err := sampleStatus(statusURL)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "failed to sample core status: %v\n", err)
+			continue
+		}
+		sample.Seconds = time.Since(start).Seconds()
+		sample.Records = records.Load()
+		result.Samples = append(result.Samples, sample)
+	}
+	ticker.Stop()
+
+	close(stop)
+	wg.Wait()
+
+	result.Seconds = time.Since(start).Seconds()
+	result.Records = records.Load()
+	return result
+}
+
+// leakCheck is a resource that shouldn't grow during a soak test.
+type leakCheck struct {
+	name string
+	// value extracts the resource from a sample
+	value func(StatusSample) float64
+	// minGrowth is the smallest increase that counts as a leak, so that
+	// noise in small values isn't reported
+	minGrowth float64
+}
+
+var leakChecks = []leakCheck{
+	{"rss_bytes", func(s StatusSample) float64 { return float64(s.RSSBytes) }, 16 << 20},
+	{"goroutines", func(s StatusSample) float64 { return float64(s.Goroutines) }, 10},
+	{"queued_records", func(s StatusSample) float64 { return float64(s.QueuedRecords) }, 1000},
+}
+
+// minLeakSamples is the fewest samples to look for leaks in.
+const minLeakSamples = 6
+
+// DetectLeaks reports each resource whose average over the last third of
+// the samples exceeds that over the first third by more than the given
+// fraction.
+//
+// The first tenth of the samples are skipped, since the service is still
+// warming up.
+func (r *SoakResult) DetectLeaks(tolerance float64) error {
+	samples := r.Samples[len(r.Samples)/10:]
+	if len(samples) < minLeakSamples {
+		return fmt.Errorf(
+			"only %d samples, need at least %d to detect leaks",
+			len(samples), minLeakSamples)
+	}
+
+	third := len(samples) / 3
+	mean := func(samples []StatusSample, value func(StatusSample) float64) float64 {
+		total := 0.0
+		for _, sample := range samples {
+			total += value(sample)
+		}
+		return total / float64(len(samples))
+	}
+
+	r.Leaks = nil
+	for _, check := range leakChecks {
+		before := mean(samples[:third], check.value)
+		after := mean(samples[len(samples)-third:], check.value)
+		if after-before > check.minGrowth && after > before*(1+tolerance) {
+			r.Leaks = append(r.Leaks, fmt.Sprintf(
+				"%s grew from %.0f to %.0f", check.name, before, after))
+		}
+	}
+	return nil
+}
+
+// Print writes a summary of the soak test.
+func (r *SoakResult) Print(w io.Writer) {
+	fmt.Fprintf(w, "soak: %d records of %s from %d workers in %.0fs\n",
+		r.Records, r.Profile, r.Workers, r.Seconds)
+	fmt.Fprintf(w, "%10s %12s %10s %12s %10s\n",
+		"seconds", "records", "rss MiB", "goroutines", "queued")
+	for _, sample := range r.Samples {
+		fmt.Fprintf(w, "%10.0f %12d %10.1f %12d %10d\n",
+			sample.Seconds, sample.Records, float64(sample.RSSBytes)/(1<<20),
+			sample.Goroutines, sample.QueuedRecords)
+	}
+}
+
+// writeCSV writes one row per sample.
+func (r *SoakResult) writeCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	err := writer.Write([]string{
+		"seconds", "records", "rss_bytes", "goroutines", "queued_records",
+	})
+	if err != nil {
+		return err
+	}
+	for _, sample := range r.Samples {
+		err := writer.Write([]string{
+			strconv.FormatFloat(sample.Seconds, 'f', -1, 64),
+			strconv.FormatInt(sample.Records, 10),
+			strconv.FormatUint(sample.RSSBytes, 10),
+			strconv.Itoa(sample.Goroutines),
+			strconv.Itoa(sample.QueuedRecords),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
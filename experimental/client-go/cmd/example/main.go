@@ -4,6 +4,7 @@ import (
 	_ "embed"
 
 	"github.com/wandb/wandb/experimental/client-go/pkg/gowandb"
+	"github.com/wandb/wandb/experimental/client-go/pkg/opts/metricopts"
 	"github.com/wandb/wandb/experimental/client-go/pkg/opts/sessionopts"
 )
 
@@ -26,6 +27,14 @@ func main() {
 	if err != nil {
 		panic(err)
 	}
-	run.Log(gowandb.History{"acc": 1.0})
+	err = run.DefineMetric("acc",
+		metricopts.WithStepMetric("epoch"),
+		metricopts.WithSummary(metricopts.SummaryMax, metricopts.SummaryLast),
+		metricopts.WithGoal(metricopts.GoalMaximize),
+	)
+	if err != nil {
+		panic(err)
+	}
+	run.Log(gowandb.History{"epoch": 1, "acc": 1.0})
 	run.Finish()
 }
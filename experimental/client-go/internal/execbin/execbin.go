@@ -15,24 +15,23 @@ type ForkExecCmd struct {
 }
 
 func ForkExec(filePayload []byte, args []string) (*ForkExecCmd, error) {
-	var err error
 	waitFunc, err := doForkExec(filePayload, args)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
-	return &ForkExecCmd{waitFunc: waitFunc}, err
+	return &ForkExecCmd{waitFunc: waitFunc}, nil
 }
 
 func ForkExecCommand(command string, args []string) (*ForkExecCmd, error) {
 	path, err := exec.LookPath(command)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 	waitFunc, err := runCommand(path, args)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
-	return &ForkExecCmd{waitFunc: waitFunc}, err
+	return &ForkExecCmd{waitFunc: waitFunc}, nil
 }
 
 func waitcmd(waitFunc WaitFunc) error {
@@ -65,16 +64,8 @@ func runCommand(command string, args []string) (WaitFunc, error) {
 	cmd.Env = os.Environ()
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
-	err := cmd.Start()
-	if err != nil {
-		switch e := err.(type) {
-		case *exec.Error:
-			fmt.Println("failed executing:", err)
-		case *exec.ExitError:
-			fmt.Println("command exit rc =", e.ExitCode())
-		default:
-			panic(err)
-		}
+	if err := cmd.Start(); err != nil {
+		return nil, err
 	}
 	return cmd.Wait, nil
 }
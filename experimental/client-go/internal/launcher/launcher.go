@@ -4,7 +4,9 @@ package launcher
 import (
 	"bufio"
 	"errors"
+	"fmt"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -31,74 +33,168 @@ func readLines(path string) ([]string, error) {
 
 type Launcher struct {
 	portFilename string
+
+	// shared is true if the port file is kept for other processes to find
+	// the server, see NewSharedLauncher.
+	shared bool
+
+	// extraArgs are passed to the server in addition to those the
+	// launcher needs.
+	extraArgs []string
+
+	// transport is how the server listens for connections, as one of
+	// the sessionopts transports.
+	transport string
+}
+
+// SetTransport sets how the server listens for connections.
+//
+// It's one of the sessionopts transports; unsupported transports make
+// launching fail.
+func (l *Launcher) SetTransport(transport string) {
+	l.transport = transport
+}
+
+// AddArgs adds command-line arguments to pass to the server.
+func (l *Launcher) AddArgs(args ...string) {
+	l.extraArgs = append(l.extraArgs, args...)
+}
+
+// args returns the server's command-line arguments.
+func (l *Launcher) args() ([]string, error) {
+	listen, err := listenArgs(l.transport)
+	if err != nil {
+		return nil, err
+	}
+	args := append([]string{"--port-filename", l.portFilename}, listen...)
+	return append(args, l.extraArgs...), nil
+}
+
+// socketPath returns a Unix domain socket path unique to this launch.
+func socketPath() string {
+	return filepath.Join(
+		os.TempDir(),
+		fmt.Sprintf("wandb-core-%d-%d.sock", os.Getpid(), time.Now().UnixNano()),
+	)
 }
 
-func (l *Launcher) tryport() (int, error) {
-	lines, err := readLines(l.portFilename)
+// ReadAddress returns the address written to a server's port file.
+func ReadAddress(portFilename string) (string, error) {
+	lines, err := readLines(portFilename)
 	if err != nil {
-		return 0, err
+		return "", err
 	}
 	if len(lines) < 2 {
-		return 0, errors.New("expecting at least 2 lines")
+		return "", errors.New("expecting at least 2 lines")
 	}
 	pair := strings.SplitN(lines[0], "=", 2)
 	if len(pair) != 2 {
-		return 0, errors.New("expecting split into 2")
+		return "", errors.New("expecting split into 2")
 	}
-	if pair[0] != "sock" {
-		return 0, errors.New("expecting sock key")
-	}
-	intVar, err := strconv.Atoi(pair[1])
-	if err != nil {
-		return 0, err
+	switch pair[0] {
+	case "sock":
+		port, err := strconv.Atoi(pair[1])
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("127.0.0.1:%d", port), nil
+	case "unix":
+		return "unix:" + pair[1], nil
+	case "pipe":
+		return pair[1], nil
+	default:
+		return "", errors.New("expecting sock, unix or pipe key")
 	}
-	return intVar, nil
 }
 
-func (l *Launcher) Getport() (int, error) {
-	defer os.Remove(l.portFilename)
+// GetAddress waits for the server to start and returns the address to
+// connect to: a localhost TCP address, a Unix domain socket path prefixed
+// with "unix:", or a named pipe path on Windows.
+func (l *Launcher) GetAddress() (string, error) {
+	if !l.shared {
+		defer os.Remove(l.portFilename)
+	}
 
 	// wait for 30 seconds for port
 	for i := 0; i < 3000; i++ {
-		val, err := l.tryport()
+		val, err := ReadAddress(l.portFilename)
 		if err == nil {
 			return val, err
 		}
 		time.Sleep(10 * time.Millisecond)
 	}
-	return 0, errors.New("prob")
+	return "", errors.New("launcher: timed out waiting for the server's address")
 }
 
-func (l *Launcher) prepTempfile() {
+func (l *Launcher) prepTempfile() error {
+	if l.shared {
+		// Don't read the address of a server that's gone.
+		_ = os.Remove(l.portFilename)
+		return nil
+	}
+
 	file, err := os.CreateTemp("", ".core-portfile")
 	if err != nil {
-		panic(err)
+		return fmt.Errorf("launcher: failed to create port file: %v", err)
 	}
 	file.Close()
 	l.portFilename = file.Name()
+	return nil
 }
 
 func (l *Launcher) LaunchCommand(command string) (*execbin.ForkExecCmd, error) {
-	l.prepTempfile()
-	args := []string{"--port-filename", l.portFilename}
-	cmd, err := execbin.ForkExecCommand(command, args)
+	if err := l.prepTempfile(); err != nil {
+		return nil, err
+	}
+	args, err := l.args()
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
-	return cmd, err
+	return execbin.ForkExecCommand(command, args)
 }
 
 func (l *Launcher) LaunchBinary(filePayload []byte) (*execbin.ForkExecCmd, error) {
-	l.prepTempfile()
-
-	args := []string{"--port-filename", l.portFilename}
-	cmd, err := execbin.ForkExec(filePayload, args)
+	if err := l.prepTempfile(); err != nil {
+		return nil, err
+	}
+	args, err := l.args()
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
-	return cmd, err
+	return execbin.ForkExec(filePayload, args)
 }
 
 func NewLauncher() *Launcher {
 	return &Launcher{}
 }
+
+// NewSharedLauncher returns a launcher whose server writes its port file
+// to the given path and leaves it there, so that other processes can
+// find the server and connect to it.
+func NewSharedLauncher(portFilename string) *Launcher {
+	return &Launcher{portFilename: portFilename, shared: true}
+}
+
+// LockPortFile waits until no other process is launching a server that
+// writes the shared port file, and keeps others from doing so until the
+// returned function is called.
+//
+// The lock is released by the operating system if the process exits, so
+// a process that crashes while launching doesn't block the others. The
+// lock file is left in place, since deleting it would let two processes
+// hold the lock at once.
+func LockPortFile(portFile string) (func(), error) {
+	file, err := os.OpenFile(portFile+".lock", os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("launcher: %v", err)
+	}
+	if err := lockFile(file); err != nil {
+		_ = file.Close()
+		return nil, fmt.Errorf("launcher: failed to lock %s: %v", file.Name(), err)
+	}
+
+	return func() {
+		_ = unlockFile(file)
+		_ = file.Close()
+	}, nil
+}
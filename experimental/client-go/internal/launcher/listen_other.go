@@ -0,0 +1,19 @@
+//go:build !windows
+
+package launcher
+
+import "fmt"
+
+// listenArgs returns the arguments telling the server where to listen.
+//
+// Elsewhere, the server listens on localhost TCP by default.
+func listenArgs(transport string) ([]string, error) {
+	switch transport {
+	case "", "tcp":
+		return nil, nil
+	case "unix":
+		return []string{"--unix-socket", socketPath()}, nil
+	default:
+		return nil, fmt.Errorf("launcher: transport %q is not supported on this platform", transport)
+	}
+}
@@ -0,0 +1,27 @@
+//go:build windows
+
+package launcher
+
+import (
+	"fmt"
+	"os"
+	"time"
+)
+
+// listenArgs returns the arguments telling the server where to listen.
+//
+// On Windows, the server listens on a named pipe unique to this launch
+// by default.
+func listenArgs(transport string) ([]string, error) {
+	switch transport {
+	case "", "pipe":
+		name := fmt.Sprintf("wandb-core-%d-%d", os.Getpid(), time.Now().UnixNano())
+		return []string{"--pipe-name", name}, nil
+	case "tcp":
+		return nil, nil
+	case "unix":
+		return []string{"--unix-socket", socketPath()}, nil
+	default:
+		return nil, fmt.Errorf("launcher: transport %q is not supported", transport)
+	}
+}
@@ -0,0 +1,30 @@
+//go:build windows
+
+package launcher
+
+import (
+	"os"
+
+	"golang.org/x/sys/windows"
+)
+
+// The first byte of the file is locked, which is allowed even if the
+// file is empty.
+
+// lockFile waits for an exclusive lock on the file.
+func lockFile(file *os.File) error {
+	return windows.LockFileEx(
+		windows.Handle(file.Fd()),
+		windows.LOCKFILE_EXCLUSIVE_LOCK,
+		0, 1, 0,
+		&windows.Overlapped{},
+	)
+}
+
+func unlockFile(file *os.File) error {
+	return windows.UnlockFileEx(
+		windows.Handle(file.Fd()),
+		0, 1, 0,
+		&windows.Overlapped{},
+	)
+}
@@ -0,0 +1,113 @@
+package gowandb
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// AlertLevel is the severity of an alert.
+type AlertLevel string
+
+const (
+	AlertInfo  AlertLevel = "INFO"
+	AlertWarn  AlertLevel = "WARN"
+	AlertError AlertLevel = "ERROR"
+)
+
+// DefaultAlertWait is the wait duration used for alerts given none.
+const DefaultAlertWait = time.Minute
+
+// maxAlertsPerMinute is how many alerts a run may send per minute, so that
+// a misbehaving loop can't flood the channels alerts are delivered to.
+const maxAlertsPerMinute = 10
+
+// ErrAlertSuppressed is returned for alerts that are not sent because a
+// similar alert was sent recently.
+var ErrAlertSuppressed = errors.New("gowandb: alert suppressed")
+
+// Alert notifies the run's users by Slack or email, as configured in their
+// settings.
+//
+// Alerts with the same title are sent at most once per wait duration, both
+// by the client and by the server. If wait is zero, DefaultAlertWait is
+// used. This is the equivalent of wandb.alert in Python.
+//
+// It returns ErrAlertSuppressed if the alert was not sent because of the
+// wait duration or because too many alerts were sent recently.
+func (r *Run) Alert(title, text string, level AlertLevel, wait time.Duration) error {
+	if title == "" {
+		return errors.New("gowandb: alert title must not be empty")
+	}
+	switch level {
+	case AlertInfo, AlertWarn, AlertError:
+	case "":
+		level = AlertInfo
+	default:
+		return fmt.Errorf("gowandb: invalid alert level %q", level)
+	}
+	if wait <= 0 {
+		wait = DefaultAlertWait
+	}
+
+	if !r.alerts.allow(title, wait, time.Now()) {
+		return ErrAlertSuppressed
+	}
+
+	record := service.Record{
+		RecordType: &service.Record_Alert{Alert: &service.AlertRecord{
+			Title:        title,
+			Text:         text,
+			Level:        string(level),
+			WaitDuration: wait.Milliseconds(),
+		}},
+		XInfo: &service.XRecordInfo{StreamId: r.settings.GetRunId().GetValue()},
+	}
+	serverRecord := service.ServerRequest{
+		ServerRequestType: &service.ServerRequest_RecordPublish{RecordPublish: &record},
+	}
+	return r.send(&serverRecord)
+}
+
+// alertLimiter decides which of a run's alerts are sent.
+type alertLimiter struct {
+	mu sync.Mutex
+
+	// lastSent is when an alert with each title was last sent
+	lastSent map[string]time.Time
+
+	// recent are the times of the alerts sent in the last minute
+	recent []time.Time
+}
+
+// allow returns whether to send an alert at the given time, recording it
+// as sent if so.
+func (l *alertLimiter) allow(title string, wait time.Duration, now time.Time) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if last, ok := l.lastSent[title]; ok && now.Sub(last) < wait {
+		return false
+	}
+
+	recent := l.recent[:0]
+	for _, sent := range l.recent {
+		if now.Sub(sent) < time.Minute {
+			recent = append(recent, sent)
+		}
+	}
+	l.recent = recent
+	if len(l.recent) >= maxAlertsPerMinute {
+		return false
+	}
+
+	if l.lastSent == nil {
+		l.lastSent = make(map[string]time.Time)
+	}
+	l.lastSent[title] = now
+	l.recent = append(l.recent, now)
+	return true
+}
@@ -0,0 +1,78 @@
+package gowandb
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestAlertLimiter_WaitsPerTitle(t *testing.T) {
+	var limiter alertLimiter
+	now := time.Now()
+
+	if !limiter.allow("loss", time.Minute, now) {
+		t.Fatal("first alert was not allowed")
+	}
+	if limiter.allow("loss", time.Minute, now.Add(30*time.Second)) {
+		t.Error("alert with the same title was allowed within the wait")
+	}
+	if !limiter.allow("acc", time.Minute, now.Add(30*time.Second)) {
+		t.Error("alert with another title was not allowed")
+	}
+	if !limiter.allow("loss", time.Minute, now.Add(time.Minute)) {
+		t.Error("alert was not allowed after the wait")
+	}
+}
+
+func TestAlertLimiter_RateLimit(t *testing.T) {
+	var limiter alertLimiter
+	now := time.Now()
+
+	for i := range maxAlertsPerMinute {
+		if !limiter.allow(string(rune('a'+i)), time.Second, now) {
+			t.Fatalf("alert %d was not allowed", i)
+		}
+	}
+
+	if limiter.allow("z", time.Second, now.Add(59*time.Second)) {
+		t.Error("alert over the limit was allowed")
+	}
+	if !limiter.allow("z", time.Second, now.Add(time.Minute)) {
+		t.Error("alert was not allowed a minute later")
+	}
+}
+
+func TestAlert(t *testing.T) {
+	run, requests := newTestRun(t)
+
+	if err := run.Alert("loss", "loss is NaN", "", 0); err != nil {
+		t.Fatal(err)
+	}
+
+	request := nextRequest(t, requests)
+	alert := request.GetRecordPublish().GetAlert()
+	if alert.GetTitle() != "loss" || alert.GetText() != "loss is NaN" ||
+		alert.GetLevel() != string(AlertInfo) ||
+		alert.GetWaitDuration() != DefaultAlertWait.Milliseconds() {
+		t.Errorf("got %v, want an INFO alert with the default wait", request)
+	}
+	if id := request.GetRecordPublish().GetXInfo().GetStreamId(); id != "run" {
+		t.Errorf("got stream %q, want the run's", id)
+	}
+
+	err := run.Alert("loss", "loss is NaN", AlertError, 0)
+	if !errors.Is(err, ErrAlertSuppressed) {
+		t.Errorf("got %v, want ErrAlertSuppressed", err)
+	}
+}
+
+func TestAlert_InvalidArguments(t *testing.T) {
+	run, _ := newTestRun(t)
+
+	if err := run.Alert("", "text", AlertInfo, 0); err == nil {
+		t.Error("expected an error for an empty title")
+	}
+	if err := run.Alert("title", "text", "CRITICAL", 0); err == nil {
+		t.Error("expected an error for an invalid level")
+	}
+}
@@ -0,0 +1,117 @@
+package gowandb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/wandb/wandb/core/pkg/publicapi"
+	"github.com/wandb/wandb/experimental/client-go/pkg/settings"
+)
+
+// apiClient makes requests to the W&B server directly, for operations
+// that aren't part of a run.
+//
+// Requests are made by core's public API client, in the same way as a
+// run's: with its proxies, certificates and retries.
+type apiClient struct {
+	public *publicapi.Client
+
+	// entity is the user's default entity, fetched when first needed.
+	entity   string
+	entityMu sync.Mutex
+}
+
+// newAPIClient returns a client for the server in the settings.
+//
+// The API key comes from the settings or, if not set there, from the
+// user's .netrc file.
+func newAPIClient(s *settings.SettingsWrap) (*apiClient, error) {
+	public, err := publicapi.NewFromSettings(s.Settings)
+	if err != nil {
+		return nil, fmt.Errorf("gowandb: %v", err)
+	}
+
+	api := &apiClient{
+		public: public,
+		entity: s.GetEntity().GetValue(),
+	}
+	if api.entity == "" {
+		api.entity = os.Getenv("WANDB_ENTITY")
+	}
+	return api, nil
+}
+
+// request makes a GraphQL request, decoding its data into response.
+func (api *apiClient) request(
+	opName, query string,
+	variables map[string]any,
+	response any,
+) error {
+	err := api.public.GraphQL().MakeRequest(
+		context.Background(),
+		&graphql.Request{OpName: opName, Query: query, Variables: variables},
+		&graphql.Response{Data: response},
+	)
+	if err != nil {
+		return fmt.Errorf("gowandb: %s failed: %v", opName, err)
+	}
+	return nil
+}
+
+const viewerQuery = `
+query Viewer {
+	viewer {
+		entity
+	}
+}
+`
+
+// defaultEntity returns the entity to use when none is given.
+func (api *apiClient) defaultEntity() (string, error) {
+	api.entityMu.Lock()
+	defer api.entityMu.Unlock()
+
+	if api.entity != "" {
+		return api.entity, nil
+	}
+
+	var response struct {
+		Viewer *struct {
+			Entity string `json:"entity"`
+		} `json:"viewer"`
+	}
+	if err := api.request("Viewer", viewerQuery, nil, &response); err != nil {
+		return "", err
+	}
+	if response.Viewer == nil || response.Viewer.Entity == "" {
+		return "", errors.New("gowandb: could not determine the default entity")
+	}
+
+	api.entity = response.Viewer.Entity
+	return api.entity, nil
+}
+
+// api returns the session's client for the W&B API.
+func (s *Session) api() (*apiClient, error) {
+	s.apiMu.Lock()
+	defer s.apiMu.Unlock()
+
+	if s.apiClient != nil {
+		return s.apiClient, nil
+	}
+
+	sessionSettings := s.Settings
+	if sessionSettings == nil {
+		sessionSettings = settings.NewSettings()
+	}
+	api, err := newAPIClient(sessionSettings)
+	if err != nil {
+		return nil, err
+	}
+	s.apiClient = api
+	return api, nil
+}
@@ -0,0 +1,76 @@
+package gowandb
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/wandb/wandb/core/pkg/artifacts"
+	"github.com/wandb/wandb/core/pkg/service"
+	"github.com/wandb/wandb/core/pkg/utils"
+)
+
+// LogArtifact uploads files as a new version of an artifact.
+//
+// files maps the name of each file in the artifact to its local path.
+// The new version always gets the "latest" alias in addition to the
+// given aliases. Returns the ID of the new version.
+func (r *Run) LogArtifact(
+	name, artifactType string,
+	files map[string]string,
+	aliases ...string,
+) (string, error) {
+	if name == "" || artifactType == "" {
+		return "", errors.New("gowandb: artifact name and type must not be empty")
+	}
+
+	runID := r.settings.GetRunId().GetValue()
+	builder := artifacts.NewArtifactBuilder(&service.ArtifactRecord{
+		Entity:           r.run.GetEntity(),
+		Project:          r.run.GetProject(),
+		RunId:            runID,
+		Name:             name,
+		Type:             artifactType,
+		Aliases:          append(aliases, "latest"),
+		Finalize:         true,
+		ClientId:         utils.GenerateAlphanumericSequence(128),
+		SequenceClientId: utils.GenerateAlphanumericSequence(128),
+		UserCreated:      true,
+	})
+
+	// Add files in a fixed order so that the manifest is reproducible.
+	names := make([]string, 0, len(files))
+	for fileName := range files {
+		names = append(names, fileName)
+	}
+	sort.Strings(names)
+	for _, fileName := range names {
+		if err := builder.AddFile(files[fileName], fileName); err != nil {
+			return "", fmt.Errorf("gowandb: failed to add %s to artifact: %v", fileName, err)
+		}
+	}
+
+	record := service.Record{
+		RecordType: &service.Record_Request{Request: &service.Request{
+			RequestType: &service.Request_LogArtifact{
+				LogArtifact: &service.LogArtifactRequest{
+					Artifact: builder.GetArtifact(),
+				},
+			},
+		}},
+		XInfo: &service.XRecordInfo{StreamId: runID},
+	}
+	serverRecord := service.ServerRequest{
+		ServerRequestType: &service.ServerRequest_RecordCommunicate{RecordCommunicate: &record},
+	}
+
+	handle := r.mbox.Deliver(&record)
+	if err := r.send(&serverRecord); err != nil {
+		return "", err
+	}
+	response := handle.wait().GetResponse().GetLogArtifactResponse()
+	if response.GetErrorMessage() != "" {
+		return "", fmt.Errorf("gowandb: failed to log artifact: %s", response.GetErrorMessage())
+	}
+	return response.GetArtifactId(), nil
+}
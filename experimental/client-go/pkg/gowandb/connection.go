@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/binary"
 	"fmt"
+	"io"
 
 	"github.com/wandb/wandb/core/pkg/server"
 	"github.com/wandb/wandb/core/pkg/service"
@@ -22,11 +23,15 @@ type Connection struct {
 	// Conn is the connection to the server
 	net.Conn
 	Mbox *Mailbox
+
+	// channel is set if the connection is one run's share of a
+	// connection multiplexed between runs, in which case Conn is nil
+	channel *muxChannel
 }
 
 // NewConnection creates a new connection to the server.
 func NewConnection(ctx context.Context, addr string) (*Connection, error) {
-	conn, err := net.Dial("tcp", addr)
+	conn, err := dial(addr)
 	if err != nil {
 		err = fmt.Errorf("error connecting to server: %w", err)
 		return nil, err
@@ -42,11 +47,26 @@ func NewConnection(ctx context.Context, addr string) (*Connection, error) {
 
 // Send sends a message to the server.
 func (c *Connection) Send(msg proto.Message) error {
+	if c.channel != nil {
+		return c.channel.send(msg)
+	}
+
+	writer := bufio.NewWriterSize(c, 16384)
+	if err := writeMessage(writer, msg); err != nil {
+		return err
+	}
+	if err := writer.Flush(); err != nil {
+		return fmt.Errorf("error flushing writer: %w", err)
+	}
+	return nil
+}
+
+// writeMessage writes a message with its header.
+func writeMessage(writer io.Writer, msg proto.Message) error {
 	data, err := proto.Marshal(msg)
 	if err != nil {
 		return fmt.Errorf("error marshaling message: %w", err)
 	}
-	writer := bufio.NewWriterSize(c, 16384)
 
 	header := server.Header{Magic: byte('W'), DataLength: uint32(len(data))}
 	err = binary.Write(writer, binary.LittleEndian, &header)
@@ -56,16 +76,28 @@ func (c *Connection) Send(msg proto.Message) error {
 	if _, err = writer.Write(data); err != nil {
 		return fmt.Errorf("error writing message: %w", err)
 	}
-	if err = writer.Flush(); err != nil {
-		return fmt.Errorf("error flushing writer: %w", err)
-	}
 	return nil
 }
 
+// Recv passes the results read from the connection to its mailbox until
+// the connection is closed.
+//
+// The results for a multiplexed connection are received by its
+// multiplexer instead, so Recv returns immediately.
 func (c *Connection) Recv() {
-	scanner := bufio.NewScanner(c.Conn)
-	tokenizer := &server.Tokenizer{}
-	scanner.Split(tokenizer.Split)
+	if c.channel != nil {
+		return
+	}
+	receiveResults(c.Conn, func(result *service.Result) {
+		c.Mbox.Respond(result)
+	})
+}
+
+// receiveResults passes the results read from a connection to onResult
+// until the connection is closed.
+func receiveResults(reader io.Reader, onResult func(*service.Result)) {
+	scanner := bufio.NewScanner(reader)
+	scanner.Split(server.ScanWBRecords)
 	for scanner.Scan() {
 		msg := &service.ServerResponse{}
 		err := proto.Unmarshal(scanner.Bytes(), msg)
@@ -74,14 +106,21 @@ func (c *Connection) Recv() {
 		}
 		switch x := msg.ServerResponseType.(type) {
 		case *service.ServerResponse_ResultCommunicate:
-			c.Mbox.Respond(x.ResultCommunicate)
+			onResult(x.ResultCommunicate)
 		default:
 		}
 	}
 }
 
 // Close closes the connection.
+//
+// Closing a multiplexed connection sends what the run queued and leaves
+// the underlying connection open for other runs.
 func (c *Connection) Close() {
+	if c.channel != nil {
+		c.channel.close()
+		return
+	}
 	err := c.Conn.Close()
 	if err != nil {
 		return
@@ -0,0 +1,17 @@
+//go:build !windows
+
+package gowandb
+
+import (
+	"net"
+	"strings"
+)
+
+// dial connects to the server at a localhost TCP address or a Unix domain
+// socket path prefixed with "unix:".
+func dial(addr string) (net.Conn, error) {
+	if path, ok := strings.CutPrefix(addr, "unix:"); ok {
+		return net.Dial("unix", path)
+	}
+	return net.Dial("tcp", addr)
+}
@@ -0,0 +1,22 @@
+//go:build windows
+
+package gowandb
+
+import (
+	"net"
+	"strings"
+
+	"github.com/Microsoft/go-winio"
+)
+
+// dial connects to the server at a localhost TCP address, a Unix domain
+// socket path prefixed with "unix:", or a named pipe path.
+func dial(addr string) (net.Conn, error) {
+	if strings.HasPrefix(addr, `\\.\pipe\`) {
+		return winio.DialPipe(addr, nil)
+	}
+	if path, ok := strings.CutPrefix(addr, "unix:"); ok {
+		return net.Dial("unix", path)
+	}
+	return net.Dial("tcp", addr)
+}
@@ -0,0 +1,103 @@
+package gowandb
+
+import (
+	"errors"
+	"log/slog"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// Kinds of errors that wandb-core reports while uploading a run.
+//
+// Use errors.Is to check which kind an error from Run.Errors is.
+var (
+	ErrCommunication      = errors.New("gowandb: failed to communicate with W&B")
+	ErrAuthentication     = errors.New("gowandb: authentication failed")
+	ErrUsage              = errors.New("gowandb: invalid usage")
+	ErrUnsupported        = errors.New("gowandb: unsupported")
+	ErrQuotaExceeded      = errors.New("gowandb: storage or tracked hours quota exceeded")
+	ErrStorageDenied      = errors.New("gowandb: storage bucket denied access")
+	ErrRateLimited        = errors.New("gowandb: rate limited")
+	ErrServerIncompatible = errors.New("gowandb: server does not support a feature")
+	ErrPermissionDenied   = errors.New("gowandb: permission denied")
+	ErrNotFound           = errors.New("gowandb: entity, project or run not found")
+)
+
+// errorKinds maps wandb-core's error codes to the errors above.
+var errorKinds = map[service.ErrorInfo_ErrorCode]error{
+	service.ErrorInfo_COMMUNICATION:       ErrCommunication,
+	service.ErrorInfo_AUTHENTICATION:      ErrAuthentication,
+	service.ErrorInfo_USAGE:               ErrUsage,
+	service.ErrorInfo_UNSUPPORTED:         ErrUnsupported,
+	service.ErrorInfo_QUOTA_EXCEEDED:      ErrQuotaExceeded,
+	service.ErrorInfo_STORAGE_DENIED:      ErrStorageDenied,
+	service.ErrorInfo_RATE_LIMITED:        ErrRateLimited,
+	service.ErrorInfo_SERVER_INCOMPATIBLE: ErrServerIncompatible,
+	service.ErrorInfo_PERMISSION_DENIED:   ErrPermissionDenied,
+	service.ErrorInfo_NOT_FOUND:           ErrNotFound,
+}
+
+// RunError is an error that wandb-core reported for a run.
+type RunError struct {
+	// Code is the kind of error, or UNKNOWN.
+	Code service.ErrorInfo_ErrorCode
+
+	// Message describes the error.
+	Message string
+}
+
+func (e *RunError) Error() string {
+	return e.Message
+}
+
+// Unwrap returns the error for the kind of error, or nil if it's unknown.
+func (e *RunError) Unwrap() error {
+	return errorKinds[e.Code]
+}
+
+// newRunError converts an error reported by wandb-core.
+func newRunError(info *service.ErrorInfo) *RunError {
+	return &RunError{Code: info.GetCode(), Message: info.GetMessage()}
+}
+
+// Errors returns the errors that happened while uploading the run since
+// the last call, such as uploads rejected by the storage bucket.
+//
+// These errors don't stop the run, but mean some of its data may not have
+// reached W&B. Each error's kind can be checked with errors.Is.
+func (r *Run) Errors() ([]error, error) {
+	record := service.Record{
+		RecordType: &service.Record_Request{Request: &service.Request{
+			RequestType: &service.Request_InternalMessages{
+				InternalMessages: &service.InternalMessagesRequest{},
+			},
+		}},
+		XInfo: &service.XRecordInfo{StreamId: r.settings.GetRunId().GetValue()},
+	}
+	serverRecord := service.ServerRequest{
+		ServerRequestType: &service.ServerRequest_RecordCommunicate{RecordCommunicate: &record},
+	}
+
+	handle := r.mbox.Deliver(&record)
+	if err := r.send(&serverRecord); err != nil {
+		return nil, err
+	}
+	messages := handle.wait().GetResponse().GetInternalMessagesResponse().GetMessages()
+
+	var errs []error
+	for _, info := range messages.GetError() {
+		errs = append(errs, newRunError(info))
+	}
+	return errs, nil
+}
+
+// logErrors logs the errors that Errors has not returned.
+func (r *Run) logErrors() {
+	errs, err := r.Errors()
+	if err != nil {
+		return
+	}
+	for _, err := range errs {
+		slog.Error("error uploading run", "err", err)
+	}
+}
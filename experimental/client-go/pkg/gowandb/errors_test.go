@@ -0,0 +1,48 @@
+package gowandb
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+func TestRunError_Kinds(t *testing.T) {
+	for code, name := range service.ErrorInfo_ErrorCode_name {
+		code := service.ErrorInfo_ErrorCode(code)
+		if code == service.ErrorInfo_UNKNOWN {
+			continue
+		}
+		if _, ok := errorKinds[code]; !ok {
+			t.Errorf("no error for code %s", name)
+		}
+	}
+}
+
+func TestRunError_Is(t *testing.T) {
+	err := fmt.Errorf("wrapped: %w", newRunError(&service.ErrorInfo{
+		Code:    service.ErrorInfo_QUOTA_EXCEEDED,
+		Message: "out of storage",
+	}))
+
+	if !errors.Is(err, ErrQuotaExceeded) {
+		t.Errorf("got %v, want ErrQuotaExceeded", err)
+	}
+	if errors.Is(err, ErrCommunication) {
+		t.Errorf("got %v, want only ErrQuotaExceeded", err)
+	}
+	if err.Error() != "wrapped: out of storage" {
+		t.Errorf("got message %q", err.Error())
+	}
+}
+
+func TestRunError_Unknown(t *testing.T) {
+	err := newRunError(&service.ErrorInfo{Message: "oops"})
+
+	for _, kind := range errorKinds {
+		if errors.Is(err, kind) {
+			t.Errorf("unknown error is %v", kind)
+		}
+	}
+}
@@ -0,0 +1,267 @@
+package gowandb
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/wandb/wandb/experimental/client-go/pkg/settings"
+)
+
+// RunFile is a file saved by a run, such as a checkpoint or a config.
+type RunFile struct {
+	// Name is the file's path in the run's files.
+	Name string
+
+	// Size is the file's size in bytes, or -1 if it's not known yet.
+	Size int64
+
+	// RunPath is the "entity/project/runID" path of the run.
+	RunPath string
+
+	// directURL is where to download the file from, or empty if the file
+	// hasn't been looked up yet.
+	directURL string
+
+	api *apiClient
+
+	// err is why the file can't be downloaded, reported by Download.
+	err error
+}
+
+// Download downloads the file into a directory and returns its local path.
+//
+// The file keeps its path in the run's files, under the directory. An
+// existing file there is replaced once the download completes, and is
+// left as it was if the download fails. This is like wandb.restore in
+// Python.
+func (f *RunFile) Download(dir string) (string, error) {
+	if f.err != nil {
+		return "", f.err
+	}
+
+	if f.directURL == "" {
+		files, err := f.api.runFiles(f.RunPath, []string{f.Name})
+		if err != nil {
+			return "", err
+		}
+		if len(files) == 0 {
+			return "", fmt.Errorf("gowandb: run %s has no file %q", f.RunPath, f.Name)
+		}
+		f.Size, f.directURL = files[0].Size, files[0].directURL
+	}
+	return f.api.downloadFile(dir, f.Name, f.directURL)
+}
+
+// File returns a file saved by the run.
+//
+// This is how to restore a file saved before a run was resumed. The file
+// is looked up when it's downloaded.
+func (r *Run) File(name string) *RunFile {
+	file := &RunFile{Name: name, Size: -1, RunPath: r.path()}
+	file.api, file.err = r.apiClient()
+	return file
+}
+
+// Files returns the files saved by the run whose paths match a pattern.
+//
+// Patterns have the syntax of path.Match, except that a "**" path segment
+// matches any number of directories, as in "checkpoints/**/*.pt". An empty
+// pattern matches all files.
+func (r *Run) Files(pattern string) ([]*RunFile, error) {
+	api, err := r.apiClient()
+	if err != nil {
+		return nil, err
+	}
+	return api.matchingRunFiles(r.path(), pattern)
+}
+
+// RunFile returns a file saved by an earlier run.
+//
+// The run is given as "entity/project/runID" or "project/runID", in which
+// case the entity is the user's default entity.
+func (s *Session) RunFile(run, name string) (*RunFile, error) {
+	api, err := s.api()
+	if err != nil {
+		return nil, err
+	}
+	runPath, err := api.runPath(run)
+	if err != nil {
+		return nil, err
+	}
+	return &RunFile{Name: name, Size: -1, RunPath: runPath, api: api}, nil
+}
+
+// RunFiles returns the files saved by an earlier run whose paths match a
+// pattern.
+//
+// The run is given as in RunFile, and the pattern as in Run.Files.
+func (s *Session) RunFiles(run, pattern string) ([]*RunFile, error) {
+	api, err := s.api()
+	if err != nil {
+		return nil, err
+	}
+	runPath, err := api.runPath(run)
+	if err != nil {
+		return nil, err
+	}
+	return api.matchingRunFiles(runPath, pattern)
+}
+
+// path returns the run's "entity/project/runID" path.
+func (r *Run) path() string {
+	return strings.Join([]string{
+		r.run.GetEntity(),
+		r.run.GetProject(),
+		r.settings.GetRunId().GetValue(),
+	}, "/")
+}
+
+// apiClient returns a client for the W&B API, shared with the run's
+// session if it has one.
+func (r *Run) apiClient() (*apiClient, error) {
+	if r.api != nil {
+		return r.api()
+	}
+	return newAPIClient(&settings.SettingsWrap{Settings: r.settings})
+}
+
+// runPath returns the "entity/project/runID" path of a run.
+func (api *apiClient) runPath(run string) (string, error) {
+	parts := strings.Split(run, "/")
+	if len(parts) == 4 && parts[2] == "runs" {
+		// The path in the run's URL.
+		parts = []string{parts[0], parts[1], parts[3]}
+	}
+	if len(parts) < 2 || len(parts) > 3 || strings.Contains(run, "//") ||
+		strings.HasPrefix(run, "/") || strings.HasSuffix(run, "/") {
+		return "", fmt.Errorf(
+			"gowandb: %q is not of the form [entity/]project/runID", run)
+	}
+
+	if len(parts) == 2 {
+		entity, err := api.defaultEntity()
+		if err != nil {
+			return "", err
+		}
+		parts = append([]string{entity}, parts...)
+	}
+	return strings.Join(parts, "/"), nil
+}
+
+// matchingRunFiles returns a run's files whose paths match a pattern.
+func (api *apiClient) matchingRunFiles(runPath, pattern string) ([]*RunFile, error) {
+	if pattern != "" && !strings.ContainsAny(pattern, `*?[\`) {
+		// Look up the file directly instead of listing them all.
+		return api.runFiles(runPath, []string{pattern})
+	}
+
+	files, err := api.runFiles(runPath, nil)
+	if err != nil || pattern == "" {
+		return files, err
+	}
+	var matches []*RunFile
+	for _, file := range files {
+		if matchGlob(strings.Split(pattern, "/"), strings.Split(file.Name, "/")) {
+			matches = append(matches, file)
+		}
+	}
+	return matches, nil
+}
+
+// runFiles returns a run's files with the names, or all of its files if
+// names is nil.
+func (api *apiClient) runFiles(runPath string, names []string) ([]*RunFile, error) {
+	parts := strings.SplitN(runPath, "/", 3)
+	variables := map[string]any{
+		"entity":  parts[0],
+		"project": parts[1],
+		"run":     parts[2],
+		"perPage": 1000,
+	}
+	if names != nil {
+		variables["names"] = names
+	}
+
+	var files []*RunFile
+	cursor := ""
+	for {
+		var response struct {
+			Project *struct {
+				Run *struct {
+					Files struct {
+						PageInfo struct {
+							HasNextPage bool   `json:"hasNextPage"`
+							EndCursor   string `json:"endCursor"`
+						} `json:"pageInfo"`
+						Edges []struct {
+							Node *struct {
+								Name      string `json:"name"`
+								SizeBytes int64  `json:"sizeBytes"`
+								DirectURL string `json:"directUrl"`
+							} `json:"node"`
+						} `json:"edges"`
+					} `json:"files"`
+				} `json:"run"`
+			} `json:"project"`
+		}
+		if cursor != "" {
+			variables["cursor"] = cursor
+		}
+		err := api.request("RunFiles", runFilesQuery, variables, &response)
+		if err != nil {
+			return nil, err
+		}
+		if response.Project == nil || response.Project.Run == nil {
+			return nil, fmt.Errorf("gowandb: run %s not found", runPath)
+		}
+
+		for _, edge := range response.Project.Run.Files.Edges {
+			// The server returns empty nodes for names that don't exist.
+			if edge.Node == nil || edge.Node.DirectURL == "" {
+				continue
+			}
+			files = append(files, &RunFile{
+				Name:      edge.Node.Name,
+				Size:      edge.Node.SizeBytes,
+				RunPath:   runPath,
+				directURL: edge.Node.DirectURL,
+				api:       api,
+			})
+		}
+
+		pageInfo := response.Project.Run.Files.PageInfo
+		if !pageInfo.HasNextPage {
+			return files, nil
+		}
+		cursor = pageInfo.EndCursor
+	}
+}
+
+const runFilesQuery = `
+query RunFiles(
+	$entity: String!,
+	$project: String!,
+	$run: String!,
+	$names: [String],
+	$cursor: String,
+	$perPage: Int
+) {
+	project(name: $project, entityName: $entity) {
+		run(name: $run) {
+			files(names: $names, after: $cursor, first: $perPage) {
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+				edges {
+					node {
+						name
+						sizeBytes
+						directUrl
+					}
+				}
+			}
+		}
+	}
+}
+`
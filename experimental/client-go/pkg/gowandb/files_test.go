@@ -0,0 +1,111 @@
+package gowandb
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/wandb/wandb/core/pkg/publicapi"
+)
+
+// newTestFileServer returns a server with a run that has saved
+// "ckpt/model.pt", which fails to download if fail is set.
+func newTestFileServer(t *testing.T, fail *bool) *httptest.Server {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/graphql":
+				_ = json.NewEncoder(w).Encode(map[string]any{
+					"data": map[string]any{
+						"project": map[string]any{
+							"run": map[string]any{
+								"files": map[string]any{
+									"pageInfo": map[string]any{"hasNextPage": false},
+									"edges": []any{map[string]any{
+										"node": map[string]any{
+											"name":      "ckpt/model.pt",
+											"sizeBytes": 10,
+											"directUrl": server.URL + "/files/model.pt",
+										},
+									}},
+								},
+							},
+						},
+					},
+				})
+			case "/files/model.pt":
+				if *fail {
+					http.NotFound(w, r)
+					return
+				}
+				_, _ = w.Write([]byte("checkpoint"))
+			default:
+				http.NotFound(w, r)
+			}
+		}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newTestAPIClient(t *testing.T, baseURL string) *apiClient {
+	public, err := publicapi.New(baseURL, "test-key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &apiClient{public: public, entity: "entity"}
+}
+
+func TestRunFileDownload(t *testing.T) {
+	fail := false
+	api := newTestAPIClient(t, newTestFileServer(t, &fail).URL)
+	dir := t.TempDir()
+
+	files, err := api.matchingRunFiles("entity/project/run", "ckpt/*.pt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 || files[0].Size != 10 {
+		t.Fatalf("got files %v, want ckpt/model.pt", files)
+	}
+
+	path, err := files[0].Download(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := filepath.Join(dir, "ckpt", "model.pt"); path != want {
+		t.Errorf("got path %q, want %q", path, want)
+	}
+	if content, _ := os.ReadFile(path); string(content) != "checkpoint" {
+		t.Errorf("got content %q, want %q", content, "checkpoint")
+	}
+}
+
+func TestRunFileDownload_FailureKeepsExistingFile(t *testing.T) {
+	fail := true
+	api := newTestAPIClient(t, newTestFileServer(t, &fail).URL)
+	dir := t.TempDir()
+	path := filepath.Join(dir, "ckpt", "model.pt")
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte("old"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	file := &RunFile{Name: "ckpt/model.pt", RunPath: "entity/project/run", api: api}
+	if _, err := file.Download(dir); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	if content, _ := os.ReadFile(path); string(content) != "old" {
+		t.Errorf("got content %q, want the old file", content)
+	}
+	entries, _ := os.ReadDir(filepath.Dir(path))
+	if len(entries) != 1 {
+		t.Errorf("got %d files, want no temporary files left", len(entries))
+	}
+}
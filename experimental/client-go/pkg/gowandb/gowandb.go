@@ -5,6 +5,9 @@ import (
 	"github.com/wandb/wandb/experimental/client-go/pkg/opts/sessionopts"
 )
 
+// History is data to log to a run's history.
+//
+// Values that are maps are logged as nested keys.
 type History map[string]interface{}
 
 func NewSession(opts ...sessionopts.SessionOption) (*Session, error) {
@@ -12,6 +15,8 @@ func NewSession(opts ...sessionopts.SessionOption) (*Session, error) {
 	for _, opt := range opts {
 		opt(&session.SessionParams)
 	}
-	session.start()
+	if err := session.start(); err != nil {
+		return nil, err
+	}
 	return session, nil
 }
@@ -0,0 +1,176 @@
+package gowandb
+
+import (
+	"log/slog"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/wandb/segmentio-encoding/json"
+
+	"github.com/wandb/wandb/core/pkg/service"
+	"github.com/wandb/wandb/experimental/client-go/pkg/opts/logopts"
+)
+
+// pendingHistory is the data logged for a step that isn't committed yet.
+type pendingHistory struct {
+	// items are the logged values by key, with nested keys joined by "."
+	items map[string]*service.HistoryItem
+
+	// step is the step the data was logged at, or nil if the server's
+	// current step is used
+	step *int64
+}
+
+func newPendingHistory() *pendingHistory {
+	return &pendingHistory{items: make(map[string]*service.HistoryItem)}
+}
+
+// add encodes the values in data, replacing values logged earlier.
+//
+// The values of nested maps are added under their key paths.
+func (h *pendingHistory) add(path []string, data map[string]interface{}) {
+	for key, value := range data {
+		keyPath := append(path[:len(path):len(path)], key)
+
+		switch value := value.(type) {
+		case History:
+			h.add(keyPath, value)
+			continue
+		case map[string]interface{}:
+			h.add(keyPath, value)
+			continue
+		}
+
+		valueJSON, err := json.Marshal(value)
+		if err != nil {
+			slog.Error("gowandb: error encoding history value",
+				"key", strings.Join(keyPath, "."), "err", err)
+			continue
+		}
+
+		item := &service.HistoryItem{ValueJson: string(valueJSON)}
+		if len(keyPath) == 1 {
+			item.Key = key
+		} else {
+			item.NestedKey = keyPath
+		}
+		h.items[strings.Join(keyPath, ".")] = item
+	}
+}
+
+// Log adds data to the run's history.
+//
+// Nested maps are logged under keys joined by ".", so that logging
+// History{"train": History{"loss": 0.5}} logs "train.loss".
+//
+// By default, each call logs a new step. Options can log data at a given
+// step, or combine the data from several calls into one step, which is
+// committed by a later call; this matches wandb.log in Python.
+func (r *Run) Log(data map[string]interface{}, opts ...logopts.LogOption) {
+	params := &logopts.LogParams{}
+	for _, opt := range opts {
+		opt(params)
+	}
+	commit := params.Step == nil
+	if params.Commit != nil {
+		commit = *params.Commit
+	}
+
+	r.historyMu.Lock()
+	defer r.historyMu.Unlock()
+
+	if step := params.Step; step != nil {
+		pending := r.partialHistory.step
+		switch {
+		case pending != nil && *step < *pending:
+			slog.Warn("gowandb: ignoring history for an earlier step",
+				"step", *step, "current", *pending)
+			return
+		case pending == nil || *step > *pending:
+			r.commitHistory()
+			r.partialHistory.step = step
+		}
+	}
+
+	r.partialHistory.add(nil, data)
+	if commit {
+		r.commitHistory()
+	}
+}
+
+// LogPartial adds data to the run's history, committing the step only
+// if commit is true.
+func (r *Run) LogPartial(data map[string]interface{}, commit bool) {
+	r.Log(data, logopts.WithCommit(commit))
+}
+
+// LogPartialCommit commits the data logged for the current step.
+func (r *Run) LogPartialCommit() {
+	r.historyMu.Lock()
+	defer r.historyMu.Unlock()
+	r.commitHistory()
+}
+
+// commitHistory sends the pending history as a step and starts a new one.
+//
+// Steps accumulated by LogScalars are sent first. The caller must hold
+// historyMu. Nothing is sent if no data is pending.
+func (r *Run) commitHistory() {
+	r.sendHistoryBatch()
+
+	pending := r.partialHistory
+	r.partialHistory = newPendingHistory()
+	if len(pending.items) == 0 {
+		return
+	}
+
+	history := &service.PartialHistoryRequest{
+		Action: &service.HistoryAction{Flush: true},
+	}
+	if pending.step != nil {
+		history.Step = &service.HistoryStep{Num: *pending.step}
+	}
+	for _, item := range pending.items {
+		history.Item = append(history.Item, item)
+	}
+	serverRecord := r.historyRequest(history)
+
+	r.connMu.RLock()
+	defer r.connMu.RUnlock()
+	if r.journal != nil {
+		r.journal.addHistory(serverRecord)
+	}
+	_ = r.conn.Send(serverRecord)
+}
+
+// historyRequest returns the request to send a history row to the server.
+func (r *Run) historyRequest(history *service.PartialHistoryRequest) *service.ServerRequest {
+	request := service.Request{
+		RequestType: &service.Request_PartialHistory{PartialHistory: history},
+	}
+	record := service.Record{
+		RecordType: &service.Record_Request{Request: &request},
+		Control:    &service.Control{Local: true},
+		XInfo:      &service.XRecordInfo{StreamId: r.settings.GetRunId().GetValue()},
+	}
+
+	return &service.ServerRequest{
+		ServerRequestType: &service.ServerRequest_RecordPublish{RecordPublish: &record},
+	}
+}
+
+// floatJSON encodes a float the way the SDK encodes history values, which
+// allows NaN and infinities.
+func floatJSON(value float64) string {
+	switch {
+	case math.IsNaN(value):
+		return "NaN"
+	case math.IsInf(value, 1):
+		return "Infinity"
+	case math.IsInf(value, -1):
+		return "-Infinity"
+	default:
+		return strconv.FormatFloat(value, 'g', -1, 64)
+	}
+}
@@ -0,0 +1,187 @@
+package gowandb
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+	"github.com/wandb/wandb/experimental/client-go/pkg/opts/logopts"
+	"github.com/wandb/wandb/experimental/client-go/pkg/opts/runopts"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// newTestCore returns a connection to a fake core service, which sends
+// the requests it receives to the returned channel.
+//
+// The fake service responds to each record that expects a response,
+// starting runs at startingStep.
+func newTestCore(t *testing.T, startingStep int64) (net.Conn, <-chan *service.ServerRequest) {
+	client, core := net.Pipe()
+	t.Cleanup(func() {
+		client.Close()
+		core.Close()
+	})
+
+	requests := make(chan *service.ServerRequest, 100)
+	go func() {
+		scanner := bufio.NewScanner(core)
+		scanner.Split(server.ScanWBRecords)
+		for scanner.Scan() {
+			request := &service.ServerRequest{}
+			if err := proto.Unmarshal(scanner.Bytes(), request); err != nil {
+				return
+			}
+			requests <- request
+
+			record := request.GetRecordCommunicate()
+			if record == nil {
+				continue
+			}
+			result := &service.Result{Control: record.Control}
+			if record.GetRun() != nil {
+				result.ResultType = &service.Result_RunResult{
+					RunResult: &service.RunUpdateResult{
+						Run: &service.RunRecord{StartingStep: startingStep},
+					},
+				}
+			}
+			_ = writeMessage(core, &service.ServerResponse{
+				ServerResponseType: &service.ServerResponse_ResultCommunicate{
+					ResultCommunicate: result,
+				},
+			})
+		}
+	}()
+	return client, requests
+}
+
+// newTestRun returns a run whose requests to the core service are sent
+// to the returned channel.
+func newTestRun(t *testing.T) (*Run, <-chan *service.ServerRequest) {
+	client, requests := newTestCore(t, 0)
+	conn := &Connection{ctx: context.Background(), Conn: client, Mbox: NewMailbox()}
+	settings := &service.Settings{RunId: wrapperspb.String("run")}
+	run := NewRun(context.Background(), settings, conn, &runopts.RunParams{})
+	return run, requests
+}
+
+// nextRequest returns the next request the run sent.
+func nextRequest(t *testing.T, requests <-chan *service.ServerRequest) *service.ServerRequest {
+	t.Helper()
+	select {
+	case request := <-requests:
+		return request
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for a request")
+		return nil
+	}
+}
+
+// nextHistory returns the next history row the run sent.
+func nextHistory(t *testing.T, requests <-chan *service.ServerRequest) *service.PartialHistoryRequest {
+	t.Helper()
+	request := nextRequest(t, requests)
+	history := request.GetRecordPublish().GetRequest().GetPartialHistory()
+	if history == nil {
+		t.Fatalf("got %v, want a history row", request)
+	}
+	return history
+}
+
+// historyItems returns a history row's values by key, with nested keys
+// joined by ".".
+func historyItems(history *service.PartialHistoryRequest) map[string]string {
+	items := make(map[string]string)
+	for _, item := range history.Item {
+		key := item.Key
+		if len(item.NestedKey) > 0 {
+			key = strings.Join(item.NestedKey, ".")
+		}
+		items[key] = item.ValueJson
+	}
+	return items
+}
+
+func TestPendingHistory_NestedKeys(t *testing.T) {
+	pending := newPendingHistory()
+
+	pending.add(nil, map[string]interface{}{
+		"acc": 0.9,
+		"train": History{
+			"loss": 0.5,
+			"eval": map[string]interface{}{"f1": 1},
+		},
+	})
+	pending.add(nil, map[string]interface{}{"acc": 0.8})
+
+	if len(pending.items) != 3 {
+		t.Fatalf("got items %v, want acc, train.loss and train.eval.f1", pending.items)
+	}
+	if acc := pending.items["acc"]; acc.Key != "acc" || acc.ValueJson != "0.8" {
+		t.Errorf("acc = %v, want the last value logged", acc)
+	}
+	f1 := pending.items["train.eval.f1"]
+	if f1.Key != "" || !slices.Equal(f1.NestedKey, []string{"train", "eval", "f1"}) {
+		t.Errorf("train.eval.f1 = %v, want a nested key", f1)
+	}
+}
+
+func TestLog_CommitsEachCall(t *testing.T) {
+	run, requests := newTestRun(t)
+
+	run.Log(map[string]interface{}{"loss": 1})
+	run.Log(map[string]interface{}{"loss": 2})
+
+	for _, want := range []string{"1", "2"} {
+		history := nextHistory(t, requests)
+		if history.Step != nil || !history.GetAction().GetFlush() {
+			t.Errorf("got step %v, want the server's step flushed", history.Step)
+		}
+		if got := historyItems(history)["loss"]; got != want {
+			t.Errorf("loss = %s, want %s", got, want)
+		}
+	}
+}
+
+func TestLog_CombinesUncommittedCalls(t *testing.T) {
+	run, requests := newTestRun(t)
+
+	run.Log(map[string]interface{}{"loss": 1}, logopts.WithCommit(false))
+	run.Log(map[string]interface{}{"acc": 0.5}, logopts.WithCommit(false))
+	run.LogPartialCommit()
+
+	items := historyItems(nextHistory(t, requests))
+	if len(items) != 2 || items["loss"] != "1" || items["acc"] != "0.5" {
+		t.Errorf("got %v, want loss and acc in one step", items)
+	}
+}
+
+func TestLog_Steps(t *testing.T) {
+	run, requests := newTestRun(t)
+
+	run.Log(map[string]interface{}{"loss": 1}, logopts.WithStep(3))
+	run.Log(map[string]interface{}{"acc": 0.5}, logopts.WithStep(3))
+	run.Log(map[string]interface{}{"loss": 9}, logopts.WithStep(2))
+	run.Log(map[string]interface{}{"loss": 2}, logopts.WithStep(4), logopts.WithCommit(true))
+
+	// A step is committed once a later step is logged.
+	first := nextHistory(t, requests)
+	if first.GetStep().GetNum() != 3 {
+		t.Errorf("got step %v, want 3", first.GetStep())
+	}
+	if items := historyItems(first); len(items) != 2 || items["loss"] != "1" {
+		t.Errorf("step 3 = %v, want loss 1 and acc, without the earlier step", items)
+	}
+
+	second := nextHistory(t, requests)
+	if second.GetStep().GetNum() != 4 || historyItems(second)["loss"] != "2" {
+		t.Errorf("got %v, want loss 2 at step 4", second)
+	}
+}
@@ -0,0 +1,193 @@
+package gowandb
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+const (
+	// maxBatchRows is the most steps LogScalars accumulates before sending
+	// them.
+	maxBatchRows = 1000
+
+	// batchInterval is the longest LogScalars holds a step before sending
+	// it.
+	batchInterval = time.Second
+)
+
+// historyBatch is scalar history accumulated in columns.
+type historyBatch struct {
+	steps []int64
+
+	// columns are the batch's metrics by key, in the order they were
+	// first logged
+	columns    map[string]*service.HistoryBatchColumn
+	columnKeys []string
+}
+
+func newHistoryBatch() *historyBatch {
+	return &historyBatch{columns: make(map[string]*service.HistoryBatchColumn)}
+}
+
+// len returns the number of steps in the batch.
+func (b *historyBatch) len() int {
+	return len(b.steps)
+}
+
+// lastStep returns the batch's last step, if it has any.
+func (b *historyBatch) lastStep() (int64, bool) {
+	if len(b.steps) == 0 {
+		return 0, false
+	}
+	return b.steps[len(b.steps)-1], true
+}
+
+// add adds metrics at a step, which must not be before the last step.
+//
+// Metrics at the last step replace the values logged earlier.
+func (b *historyBatch) add(step int64, metrics map[string]float64) {
+	if last, ok := b.lastStep(); !ok || step > last {
+		b.steps = append(b.steps, step)
+		for _, column := range b.columns {
+			column.Value = append(column.Value, 0)
+			column.Missing = append(column.Missing, uint32(len(b.steps)-1))
+		}
+	}
+	row := len(b.steps) - 1
+
+	for key, value := range metrics {
+		column := b.columns[key]
+		if column == nil {
+			column = &service.HistoryBatchColumn{
+				Key:   key,
+				Value: make([]float64, len(b.steps)),
+			}
+			for i := 0; i < row; i++ {
+				column.Missing = append(column.Missing, uint32(i))
+			}
+			b.columns[key] = column
+			b.columnKeys = append(b.columnKeys, key)
+		}
+
+		column.Value[row] = value
+		if n := len(column.Missing); n > 0 && int(column.Missing[n-1]) == row {
+			column.Missing = column.Missing[:n-1]
+		}
+	}
+}
+
+// record returns the batch as a record.
+func (b *historyBatch) record() *service.HistoryBatchRecord {
+	batch := &service.HistoryBatchRecord{Step: b.steps}
+	for _, key := range b.columnKeys {
+		batch.Column = append(batch.Column, b.columns[key])
+	}
+	return batch
+}
+
+// rows returns the batch's steps as separate history requests.
+func (b *historyBatch) rows() []*service.PartialHistoryRequest {
+	rows := make([]*service.PartialHistoryRequest, len(b.steps))
+	for i, step := range b.steps {
+		rows[i] = &service.PartialHistoryRequest{
+			Step:   &service.HistoryStep{Num: step},
+			Action: &service.HistoryAction{Flush: true},
+		}
+	}
+
+	for _, key := range b.columnKeys {
+		column := b.columns[key]
+		missing := make(map[uint32]bool, len(column.Missing))
+		for _, i := range column.Missing {
+			missing[i] = true
+		}
+		for i, value := range column.Value {
+			if missing[uint32(i)] {
+				continue
+			}
+			rows[i].Item = append(rows[i].Item, &service.HistoryItem{
+				Key:       key,
+				ValueJson: floatJSON(value),
+			})
+		}
+	}
+	return rows
+}
+
+// LogScalars logs scalar metrics at a step, like Log with WithStep, but
+// sends many steps together in one compact record.
+//
+// It's meant for metrics logged at a high frequency, such as a loss logged
+// every training step, where sending a record per step costs more than
+// the training. Steps are sent once enough accumulate, a second after the
+// first of them was logged, when other history is logged, or when the run
+// finishes.
+//
+// Logging more metrics at the last step adds them to it; logging at an
+// earlier step is ignored.
+func (r *Run) LogScalars(step int64, metrics map[string]float64) {
+	r.historyMu.Lock()
+	defer r.historyMu.Unlock()
+
+	if pending := r.partialHistory.step; pending != nil && step < *pending {
+		slog.Warn("gowandb: ignoring history for an earlier step",
+			"step", step, "current", *pending)
+		return
+	}
+	if last, ok := r.historyBatch.lastStep(); ok && step < last {
+		slog.Warn("gowandb: ignoring history for an earlier step",
+			"step", step, "current", last)
+		return
+	}
+
+	// Data logged with Log goes first.
+	if len(r.partialHistory.items) > 0 {
+		r.commitHistory()
+	}
+
+	if r.historyBatch.len() == 0 {
+		r.historyBatchTimer = time.AfterFunc(batchInterval, func() {
+			r.historyMu.Lock()
+			defer r.historyMu.Unlock()
+			r.sendHistoryBatch()
+		})
+	}
+
+	r.historyBatch.add(step, metrics)
+	if r.historyBatch.len() >= maxBatchRows {
+		r.sendHistoryBatch()
+	}
+}
+
+// sendHistoryBatch sends the steps accumulated by LogScalars.
+//
+// The caller must hold historyMu. Nothing is sent if there are none.
+func (r *Run) sendHistoryBatch() {
+	batch := r.historyBatch
+	if batch.len() == 0 {
+		return
+	}
+	r.historyBatch = newHistoryBatch()
+	r.historyBatchTimer.Stop()
+
+	record := service.Record{
+		RecordType: &service.Record_HistoryBatch{HistoryBatch: batch.record()},
+		Control:    &service.Control{Local: true},
+		XInfo:      &service.XRecordInfo{StreamId: r.settings.GetRunId().GetValue()},
+	}
+	serverRecord := service.ServerRequest{
+		ServerRequestType: &service.ServerRequest_RecordPublish{RecordPublish: &record},
+	}
+
+	r.connMu.RLock()
+	defer r.connMu.RUnlock()
+	if r.journal != nil {
+		// The journal counts rows, so it keeps the steps separately.
+		for _, row := range batch.rows() {
+			r.journal.addHistory(r.historyRequest(row))
+		}
+	}
+	_ = r.conn.Send(&serverRecord)
+}
@@ -0,0 +1,59 @@
+package gowandb
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestHistoryBatch_Columns(t *testing.T) {
+	batch := newHistoryBatch()
+	batch.add(1, map[string]float64{"loss": 0.5})
+	batch.add(2, map[string]float64{"acc": 0.1})
+	batch.add(2, map[string]float64{"loss": 0.4})
+	batch.add(5, map[string]float64{"acc": math.NaN()})
+
+	record := batch.record()
+	if !slices.Equal(record.Step, []int64{1, 2, 5}) {
+		t.Fatalf("steps = %v", record.Step)
+	}
+	if len(record.Column) != 2 {
+		t.Fatalf("got %d columns, want 2", len(record.Column))
+	}
+
+	loss, acc := record.Column[0], record.Column[1]
+	if loss.Key != "loss" || !slices.Equal(loss.Value[:2], []float64{0.5, 0.4}) ||
+		!slices.Equal(loss.Missing, []uint32{2}) {
+		t.Errorf("loss column = %v", loss)
+	}
+	if acc.Key != "acc" || acc.Value[1] != 0.1 || !math.IsNaN(acc.Value[2]) ||
+		!slices.Equal(acc.Missing, []uint32{0}) {
+		t.Errorf("acc column = %v", acc)
+	}
+}
+
+func TestHistoryBatch_Rows(t *testing.T) {
+	batch := newHistoryBatch()
+	batch.add(1, map[string]float64{"loss": 0.5})
+	batch.add(2, map[string]float64{"acc": math.Inf(1)})
+
+	rows := batch.rows()
+	if len(rows) != 2 {
+		t.Fatalf("got %d rows, want 2", len(rows))
+	}
+	for i, want := range []struct {
+		step  int64
+		key   string
+		value string
+	}{
+		{1, "loss", "0.5"},
+		{2, "acc", "Infinity"},
+	} {
+		row := rows[i]
+		if row.GetStep().GetNum() != want.step || !row.GetAction().GetFlush() ||
+			len(row.Item) != 1 ||
+			row.Item[0].Key != want.key || row.Item[0].ValueJson != want.value {
+			t.Errorf("row %d = %v", i, row)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package gowandb
 
 import (
 	"strings"
+	"sync"
 
 	"github.com/wandb/wandb/core/pkg/service"
 	"github.com/wandb/wandb/core/pkg/utils"
@@ -9,9 +10,13 @@ import (
 
 type MailboxHandle struct {
 	responseChan chan *service.Result
+
+	// record is the record awaiting a response
+	record *service.Record
 }
 
 type Mailbox struct {
+	mu      sync.Mutex
 	handles map[string]*MailboxHandle
 }
 
@@ -22,7 +27,8 @@ func NewMailbox() *Mailbox {
 }
 
 func NewMailboxHandle() *MailboxHandle {
-	mbh := &MailboxHandle{responseChan: make(chan *service.Result)}
+	// Buffered so that responses nobody waits for anymore don't block.
+	mbh := &MailboxHandle{responseChan: make(chan *service.Result, 1)}
 	return mbh
 }
 
@@ -35,7 +41,10 @@ func (mb *Mailbox) Deliver(rec *service.Record) *MailboxHandle {
 	uuid := "core:" + utils.ShortID(12)
 	rec.Control = &service.Control{MailboxSlot: uuid}
 	handle := NewMailboxHandle()
+	handle.record = rec
+	mb.mu.Lock()
 	mb.handles[uuid] = handle
+	mb.mu.Unlock()
 	return handle
 }
 
@@ -44,11 +53,24 @@ func (mb *Mailbox) Respond(result *service.Result) bool {
 	if !strings.HasPrefix(slot, "core:") {
 		return false
 	}
+	mb.mu.Lock()
 	handle, ok := mb.handles[slot]
+	// clean up after thyself?
+	delete(mb.handles, slot)
+	mb.mu.Unlock()
 	if ok {
 		handle.responseChan <- result
-		// clean up after thyself?
-		delete(mb.handles, slot)
 	}
 	return ok
 }
+
+// Pending returns the records that are still awaiting responses.
+func (mb *Mailbox) Pending() []*service.Record {
+	mb.mu.Lock()
+	defer mb.mu.Unlock()
+	records := make([]*service.Record, 0, len(mb.handles))
+	for _, handle := range mb.handles {
+		records = append(records, handle.record)
+	}
+	return records
+}
@@ -2,11 +2,13 @@ package gowandb
 
 import (
 	"context"
+	"sync"
 
 	"github.com/wandb/wandb/core/pkg/service"
 	"github.com/wandb/wandb/core/pkg/utils"
 	"github.com/wandb/wandb/experimental/client-go/pkg/opts/runopts"
 	"github.com/wandb/wandb/experimental/client-go/pkg/settings"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 // Manager is a collection of components that work together to handle incoming
@@ -15,10 +17,22 @@ type Manager struct {
 	ctx context.Context
 
 	// addr is the address of the server
-	addr string
+	addr   string
+	addrMu sync.Mutex
 
 	// settings for all runs
 	settings *settings.SettingsWrap
+
+	// rediscover returns the server's current address, if it can change
+	rediscover func() (string, error)
+
+	// multiplexed is whether runs share one connection to the server
+	multiplexed bool
+
+	// mux is the connection shared by runs if they're multiplexed; it's
+	// created when first needed, and again if it fails
+	mux   *multiplexer
+	muxMu sync.Mutex
 }
 
 // NewManager creates a new manager with the given settings and responders.
@@ -32,20 +46,31 @@ func NewManager(ctx context.Context, baseSettings *settings.SettingsWrap, addr s
 }
 
 func (m *Manager) NewRun(runParams *runopts.RunParams) *Run {
-	conn := m.Connect(m.ctx)
+	conn, err := m.connectRun(nil)
+	if err != nil {
+		panic(err)
+	}
 	// make a copy of the base manager settings
 	runSettings := m.settings.Copy()
-	if runParams.RunID != nil {
+	switch key := runSettings.GetIdempotencyKey().GetValue(); {
+	case runParams.RunID != nil:
 		runSettings.SetRunID(*runParams.RunID)
-	} else if runSettings.RunId == nil {
+	case runSettings.RunId != nil:
+	case key != "":
+		// Retries of a job get the same run, which the core service resumes.
+		runSettings.SetRunID(utils.IdempotentID(key, utils.IdempotentIDLength))
+	default:
 		runSettings.SetRunID(utils.ShortID(8))
 	}
+	if runParams.Resume != nil {
+		runSettings.Resume = wrapperspb.String(string(*runParams.Resume))
+	}
 	run := NewRun(m.ctx, runSettings.Settings, conn, runParams)
 	return run
 }
 
 func (m *Manager) Connect(ctx context.Context) *Connection {
-	conn, err := NewConnection(ctx, m.addr)
+	conn, err := m.TryConnect(ctx)
 	// slog.Info("Connecting to server", "conn", conn.Conn.RemoteAddr().String())
 	if err != nil {
 		panic(err)
@@ -53,7 +78,84 @@ func (m *Manager) Connect(ctx context.Context) *Connection {
 	return conn
 }
 
+// TryConnect is like Connect, but returns an error if the server can't
+// be reached.
+func (m *Manager) TryConnect(ctx context.Context) (*Connection, error) {
+	conn, err := NewConnection(ctx, m.Address())
+	if err != nil && m.rediscover != nil {
+		// The server may have been restarted at a new address.
+		address, rediscoverErr := m.rediscover()
+		if rediscoverErr != nil {
+			return nil, rediscoverErr
+		}
+		m.SetAddress(address)
+		conn, err = NewConnection(ctx, m.Address())
+	}
+	return conn, err
+}
+
+// connectRun returns a connection for a run, whose results are
+// delivered to mbox; a new mailbox is used if mbox is nil.
+//
+// If runs are multiplexed, the connection is the run's share of the
+// connection shared by all runs.
+func (m *Manager) connectRun(mbox *Mailbox) (*Connection, error) {
+	if !m.multiplexed {
+		conn, err := m.TryConnect(m.ctx)
+		if err == nil && mbox != nil {
+			conn.Mbox = mbox
+		}
+		return conn, err
+	}
+
+	m.muxMu.Lock()
+	defer m.muxMu.Unlock()
+	if m.mux == nil || m.mux.failed() {
+		mux, err := newMultiplexer(m.ctx, m.Address())
+		if err != nil && m.rediscover != nil {
+			// The server may have been restarted at a new address.
+			address, rediscoverErr := m.rediscover()
+			if rediscoverErr != nil {
+				return nil, rediscoverErr
+			}
+			m.SetAddress(address)
+			mux, err = newMultiplexer(m.ctx, m.Address())
+		}
+		if err != nil {
+			return nil, err
+		}
+		m.mux = mux
+	}
+	return m.mux.open(mbox)
+}
+
+// closeMultiplexer closes the connection shared by runs, if any.
+func (m *Manager) closeMultiplexer() {
+	m.muxMu.Lock()
+	defer m.muxMu.Unlock()
+	if m.mux != nil {
+		m.mux.close()
+		m.mux = nil
+	}
+}
+
+// Address returns the address of the server.
+func (m *Manager) Address() string {
+	m.addrMu.Lock()
+	defer m.addrMu.Unlock()
+	return m.addr
+}
+
+// SetAddress changes the address of the server, such as after it is
+// restarted.
+func (m *Manager) SetAddress(addr string) {
+	m.addrMu.Lock()
+	defer m.addrMu.Unlock()
+	m.addr = addr
+}
+
 func (m *Manager) Close() {
+	m.closeMultiplexer()
 	conn := m.Connect(m.ctx)
 	serverRecord := service.ServerRequest{
 		ServerRequestType: &service.ServerRequest_InformTeardown{InformTeardown: &service.ServerInformTeardownRequest{}},
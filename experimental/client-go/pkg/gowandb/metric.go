@@ -0,0 +1,109 @@
+package gowandb
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/wandb/wandb/core/pkg/service"
+	"github.com/wandb/wandb/experimental/client-go/pkg/opts/metricopts"
+)
+
+// DefineMetric customizes how a metric is charted and summarized.
+//
+// Names containing "*" define all metrics matching the glob, for example
+// "train/*". This is the equivalent of wandb.define_metric in Python.
+func (r *Run) DefineMetric(name string, opts ...metricopts.MetricOption) error {
+	if name == "" {
+		return errors.New("gowandb: metric name must not be empty")
+	}
+
+	params := &metricopts.MetricParams{}
+	for _, opt := range opts {
+		opt(params)
+	}
+
+	metric, err := newMetricRecord(name, params)
+	if err != nil {
+		return err
+	}
+
+	record := service.Record{
+		RecordType: &service.Record_Metric{Metric: metric},
+		XInfo:      &service.XRecordInfo{StreamId: r.settings.GetRunId().GetValue()},
+	}
+	serverRecord := service.ServerRequest{
+		ServerRequestType: &service.ServerRequest_RecordPublish{RecordPublish: &record},
+	}
+	r.connMu.RLock()
+	defer r.connMu.RUnlock()
+	if r.journal != nil {
+		r.journal.addMetric(&serverRecord)
+	}
+	return r.conn.Send(&serverRecord)
+}
+
+func newMetricRecord(
+	name string,
+	params *metricopts.MetricParams,
+) (*service.MetricRecord, error) {
+	metric := &service.MetricRecord{
+		StepMetric: params.StepMetric,
+		Options: &service.MetricOptions{
+			Hidden:  params.Hidden,
+			Defined: true,
+		},
+	}
+
+	if strings.Contains(name, "*") {
+		metric.GlobName = name
+	} else {
+		metric.Name = name
+	}
+
+	if params.StepSync != nil {
+		metric.Options.StepSync = *params.StepSync
+	} else {
+		metric.Options.StepSync = params.StepMetric != ""
+	}
+
+	if len(params.Summary) > 0 {
+		metric.Summary = &service.MetricSummary{}
+		for _, summary := range params.Summary {
+			switch summary {
+			case metricopts.SummaryMin:
+				metric.Summary.Min = true
+			case metricopts.SummaryMax:
+				metric.Summary.Max = true
+			case metricopts.SummaryMean:
+				metric.Summary.Mean = true
+			case metricopts.SummaryBest:
+				metric.Summary.Best = true
+			case metricopts.SummaryLast:
+				metric.Summary.Last = true
+			case metricopts.SummaryCopy:
+				metric.Summary.Copy = true
+			case metricopts.SummaryNone:
+				metric.Summary.None = true
+			default:
+				return nil, fmt.Errorf("gowandb: unknown metric summary %q", summary)
+			}
+		}
+	}
+
+	switch params.Goal {
+	case "":
+	case metricopts.GoalMinimize:
+		metric.Goal = service.MetricRecord_GOAL_MINIMIZE
+	case metricopts.GoalMaximize:
+		metric.Goal = service.MetricRecord_GOAL_MAXIMIZE
+	default:
+		return nil, fmt.Errorf("gowandb: unknown metric goal %q", params.Goal)
+	}
+
+	if params.Overwrite {
+		metric.XControl = &service.MetricControl{Overwrite: true}
+	}
+
+	return metric, nil
+}
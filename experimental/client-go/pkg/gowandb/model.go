@@ -0,0 +1,412 @@
+package gowandb
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/wandb/wandb/experimental/client-go/pkg/opts/downloadopts"
+)
+
+// ModelRegistryProject is the project that registered models are in,
+// unless a model's path names another project.
+const ModelRegistryProject = "model-registry"
+
+// ModelVersion is a version of a registered model.
+type ModelVersion struct {
+	// ID is the ID of the artifact version linked to the model.
+	ID string
+
+	// Entity, Project and Name identify the registered model.
+	Entity  string
+	Project string
+	Name    string
+
+	// Version is the version's index in the registered model, like "v3".
+	Version string
+
+	// Aliases are the version's aliases in the registered model.
+	//
+	// Stages such as "staging" and "production" are aliases.
+	Aliases []string
+
+	// Digest is a checksum of the version's files.
+	Digest string
+
+	api *apiClient
+}
+
+// HasAlias reports whether the version has the alias.
+func (v *ModelVersion) HasAlias(alias string) bool {
+	return slices.Contains(v.Aliases, alias)
+}
+
+// LinkModel links an artifact version, such as one returned by
+// Run.LogArtifact, to a registered model.
+//
+// The model is given as "name", "project/name" or "entity/project/name";
+// the project defaults to ModelRegistryProject and the entity to the
+// user's default entity. The aliases, such as "production", are moved to
+// the newly linked version.
+func (s *Session) LinkModel(artifactID, model string, aliases ...string) error {
+	api, err := s.api()
+	if err != nil {
+		return err
+	}
+	entity, project, name, err := api.modelPath(model)
+	if err != nil {
+		return err
+	}
+
+	aliasInputs := make([]map[string]string, 0, len(aliases))
+	for _, alias := range aliases {
+		aliasInputs = append(aliasInputs, map[string]string{
+			"alias":                  alias,
+			"artifactCollectionName": name,
+		})
+	}
+
+	var response struct {
+		LinkArtifact *struct {
+			VersionIndex *int `json:"versionIndex"`
+		} `json:"linkArtifact"`
+	}
+	err = api.request("LinkArtifact", linkArtifactMutation,
+		map[string]any{
+			"artifactPortfolioName": name,
+			"entityName":            entity,
+			"projectName":           project,
+			"aliases":               aliasInputs,
+			"artifactId":            artifactID,
+		},
+		&response)
+	if err != nil {
+		return err
+	}
+	if response.LinkArtifact == nil {
+		return fmt.Errorf("gowandb: failed to link %s to %s", artifactID, model)
+	}
+	return nil
+}
+
+// UseModelVersion returns a version of a registered model.
+//
+// The version is given as "model:alias", such as "model:production" or
+// "model:v3", where the model is named as for LinkModel. The alias
+// defaults to "latest".
+func (s *Session) UseModelVersion(version string) (*ModelVersion, error) {
+	api, err := s.api()
+	if err != nil {
+		return nil, err
+	}
+	model, alias, _ := strings.Cut(version, ":")
+	if alias == "" {
+		alias = "latest"
+	}
+	entity, project, name, err := api.modelPath(model)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Project *struct {
+			Artifact *struct {
+				ID           string `json:"id"`
+				VersionIndex *int   `json:"versionIndex"`
+				Digest       string `json:"digest"`
+				Aliases      []struct {
+					Alias              string `json:"alias"`
+					ArtifactCollection *struct {
+						Name string `json:"name"`
+					} `json:"artifactCollection"`
+				} `json:"aliases"`
+			} `json:"artifact"`
+		} `json:"project"`
+	}
+	err = api.request("ArtifactByName", artifactByNameQuery,
+		map[string]any{
+			"entityName":  entity,
+			"projectName": project,
+			"name":        name + ":" + alias,
+		},
+		&response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Project == nil {
+		return nil, fmt.Errorf("gowandb: project %s/%s not found", entity, project)
+	}
+	artifact := response.Project.Artifact
+	if artifact == nil {
+		return nil, fmt.Errorf(
+			"gowandb: model version %s:%s not found in %s/%s",
+			name, alias, entity, project)
+	}
+
+	modelVersion := &ModelVersion{
+		ID:      artifact.ID,
+		Entity:  entity,
+		Project: project,
+		Name:    name,
+		Digest:  artifact.Digest,
+		api:     api,
+	}
+	for _, a := range artifact.Aliases {
+		// The artifact's aliases include those in other collections it's
+		// linked to, such as the one it was logged to.
+		if a.ArtifactCollection == nil || a.ArtifactCollection.Name != name {
+			continue
+		}
+		if isVersionIndex(a.Alias) {
+			modelVersion.Version = a.Alias
+		} else {
+			modelVersion.Aliases = append(modelVersion.Aliases, a.Alias)
+		}
+	}
+	if modelVersion.Version == "" && artifact.VersionIndex != nil {
+		modelVersion.Version = fmt.Sprintf("v%d", *artifact.VersionIndex)
+	}
+	return modelVersion, nil
+}
+
+// Download downloads the version's files into a directory.
+//
+// Options select some of the files to download; by default, all of them
+// are downloaded.
+func (v *ModelVersion) Download(dir string, opts ...downloadopts.DownloadOption) error {
+	params := &downloadopts.DownloadParams{}
+	for _, opt := range opts {
+		opt(params)
+	}
+
+	variables := map[string]any{"id": v.ID, "perPage": 1000}
+	if names, ok := literalPaths(params); ok {
+		// Look up the files directly instead of listing them all.
+		variables["names"] = names
+	}
+
+	cursor := ""
+	for {
+		var response struct {
+			Artifact *struct {
+				Files struct {
+					PageInfo struct {
+						HasNextPage bool   `json:"hasNextPage"`
+						EndCursor   string `json:"endCursor"`
+					} `json:"pageInfo"`
+					Edges []struct {
+						Node struct {
+							Name      string `json:"name"`
+							DirectURL string `json:"directUrl"`
+						} `json:"node"`
+					} `json:"edges"`
+				} `json:"files"`
+			} `json:"artifact"`
+		}
+		if cursor != "" {
+			variables["cursor"] = cursor
+		}
+		err := v.api.request("ArtifactFileURLs", artifactFileURLsQuery, variables, &response)
+		if err != nil {
+			return err
+		}
+		if response.Artifact == nil {
+			return fmt.Errorf("gowandb: artifact %s not found", v.ID)
+		}
+
+		for _, edge := range response.Artifact.Files.Edges {
+			if !matchesDownload(params, edge.Node.Name) {
+				continue
+			}
+			_, err := v.api.downloadFile(dir, edge.Node.Name, edge.Node.DirectURL)
+			if err != nil {
+				return err
+			}
+		}
+
+		pageInfo := response.Artifact.Files.PageInfo
+		if !pageInfo.HasNextPage {
+			return nil
+		}
+		cursor = pageInfo.EndCursor
+	}
+}
+
+// literalPaths returns the paths of the files to download if the options
+// name them exactly.
+func literalPaths(params *downloadopts.DownloadParams) ([]string, bool) {
+	if len(params.Globs) == 0 {
+		return nil, false
+	}
+	for _, glob := range params.Globs {
+		if strings.ContainsAny(glob, `*?[\`) {
+			return nil, false
+		}
+	}
+	return params.Globs, true
+}
+
+// matchesDownload returns whether the options select a file to download.
+func matchesDownload(params *downloadopts.DownloadParams, name string) bool {
+	if prefix := strings.Trim(params.PathPrefix, "/"); prefix != "" {
+		prefix = path.Clean(prefix)
+		if name != prefix && !strings.HasPrefix(name, prefix+"/") {
+			return false
+		}
+	}
+
+	if len(params.Globs) == 0 {
+		return true
+	}
+	for _, glob := range params.Globs {
+		if matchGlob(strings.Split(glob, "/"), strings.Split(name, "/")) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchGlob returns whether the segments of a path match those of a
+// pattern, where a "**" segment matches any number of segments.
+func matchGlob(pattern, name []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for skip := 0; skip <= len(name); skip++ {
+				if matchGlob(pattern[1:], name[skip:]) {
+					return true
+				}
+			}
+			return false
+		}
+
+		if len(name) == 0 {
+			return false
+		}
+		if ok, err := path.Match(pattern[0], name[0]); !ok || err != nil {
+			return false
+		}
+		pattern, name = pattern[1:], name[1:]
+	}
+	return len(name) == 0
+}
+
+// downloadFile downloads a file into a directory, returning its local
+// path.
+func (api *apiClient) downloadFile(dir, name, fileURL string) (string, error) {
+	// File names come from the server, so make sure they stay in dir.
+	localPath := filepath.Join(dir, filepath.FromSlash(path.Clean("/"+name)))
+	if err := os.MkdirAll(filepath.Dir(localPath), 0755); err != nil {
+		return "", fmt.Errorf("gowandb: %v", err)
+	}
+
+	err := api.public.DownloadFile(context.Background(), fileURL, localPath)
+	if err != nil {
+		return "", fmt.Errorf("gowandb: %v", err)
+	}
+	return localPath, nil
+}
+
+// modelPath returns the entity, project and name of a registered model.
+func (api *apiClient) modelPath(model string) (entity, project, name string, err error) {
+	parts := strings.Split(model, "/")
+	switch len(parts) {
+	case 1:
+		name = parts[0]
+	case 2:
+		project, name = parts[0], parts[1]
+	case 3:
+		entity, project, name = parts[0], parts[1], parts[2]
+	}
+	if name == "" || len(parts) > 3 || strings.Contains(model, ":") {
+		return "", "", "", fmt.Errorf(
+			"gowandb: %q is not of the form [[entity/]project/]name", model)
+	}
+
+	if project == "" {
+		project = ModelRegistryProject
+	}
+	if entity == "" {
+		if entity, err = api.defaultEntity(); err != nil {
+			return "", "", "", err
+		}
+	}
+	return entity, project, name, nil
+}
+
+// isVersionIndex reports whether an alias is a version index like "v3".
+func isVersionIndex(alias string) bool {
+	if len(alias) < 2 || alias[0] != 'v' {
+		return false
+	}
+	for _, c := range alias[1:] {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+const linkArtifactMutation = `
+mutation LinkArtifact(
+	$artifactPortfolioName: String!,
+	$entityName: String!,
+	$projectName: String!,
+	$aliases: [ArtifactAliasInput!],
+	$artifactId: ID,
+) {
+	linkArtifact(input: {
+		artifactPortfolioName: $artifactPortfolioName,
+		entityName: $entityName,
+		projectName: $projectName,
+		aliases: $aliases,
+		artifactID: $artifactId,
+	}) {
+		versionIndex
+	}
+}
+`
+
+const artifactByNameQuery = `
+query ArtifactByName(
+	$entityName: String!,
+	$projectName: String!,
+	$name: String!
+) {
+	project(name: $projectName, entityName: $entityName) {
+		artifact(name: $name) {
+			id
+			versionIndex
+			digest
+			aliases {
+				alias
+				artifactCollection {
+					name
+				}
+			}
+		}
+	}
+}
+`
+
+const artifactFileURLsQuery = `
+query ArtifactFileURLs($id: ID!, $names: [String!], $cursor: String, $perPage: Int) {
+	artifact(id: $id) {
+		files(names: $names, after: $cursor, first: $perPage) {
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+			edges {
+				node {
+					name
+					directUrl
+				}
+			}
+		}
+	}
+}
+`
@@ -0,0 +1,211 @@
+package gowandb
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/wandb/wandb/experimental/client-go/pkg/opts/downloadopts"
+)
+
+// graphQLRequest is a request received by a test server.
+type graphQLRequest struct {
+	OpName    string         `json:"operationName"`
+	Variables map[string]any `json:"variables"`
+}
+
+// newTestModelServer returns a server whose GraphQL responses are given
+// by operation name, recording the requests it receives.
+//
+// Other paths serve files with made-up contents.
+func newTestModelServer(
+	t *testing.T,
+	responses map[string]any,
+	requests *[]graphQLRequest,
+) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/graphql" {
+				_, _ = w.Write([]byte("contents of " + r.URL.Path))
+				return
+			}
+			var request graphQLRequest
+			if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			*requests = append(*requests, request)
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"data": responses[request.OpName],
+			})
+		}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestUseModelVersion(t *testing.T) {
+	var requests []graphQLRequest
+	server := newTestModelServer(t, map[string]any{
+		"ArtifactByName": map[string]any{
+			"project": map[string]any{
+				"artifact": map[string]any{
+					"id":           "artifact-id",
+					"versionIndex": 7,
+					"digest":       "abc",
+					"aliases": []any{
+						map[string]any{
+							"alias":              "v3",
+							"artifactCollection": map[string]any{"name": "classifier"},
+						},
+						map[string]any{
+							"alias":              "production",
+							"artifactCollection": map[string]any{"name": "classifier"},
+						},
+						map[string]any{
+							"alias":              "latest",
+							"artifactCollection": map[string]any{"name": "training-run"},
+						},
+					},
+				},
+			},
+		},
+	}, &requests)
+	session := &Session{apiClient: newTestAPIClient(t, server.URL)}
+
+	version, err := session.UseModelVersion("classifier:production")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if version.ID != "artifact-id" || version.Entity != "entity" ||
+		version.Project != ModelRegistryProject || version.Version != "v3" {
+		t.Errorf("got %+v, want v3 of entity/model-registry/classifier", version)
+	}
+	if !slices.Equal(version.Aliases, []string{"production"}) {
+		t.Errorf("got aliases %v, want only the model's aliases", version.Aliases)
+	}
+	if len(requests) != 1 || requests[0].Variables["name"] != "classifier:production" {
+		t.Errorf("got requests %+v", requests)
+	}
+}
+
+func TestUseModelVersion_NotFound(t *testing.T) {
+	var requests []graphQLRequest
+	server := newTestModelServer(t, map[string]any{
+		"ArtifactByName": map[string]any{"project": map[string]any{"artifact": nil}},
+	}, &requests)
+	session := &Session{apiClient: newTestAPIClient(t, server.URL)}
+
+	if _, err := session.UseModelVersion("team/models/classifier"); err == nil {
+		t.Fatal("expected an error")
+	}
+	if len(requests) != 1 || requests[0].Variables["name"] != "classifier:latest" ||
+		requests[0].Variables["entityName"] != "team" ||
+		requests[0].Variables["projectName"] != "models" {
+		t.Errorf("got requests %+v, want the latest version in team/models", requests)
+	}
+}
+
+func TestLinkModel(t *testing.T) {
+	var requests []graphQLRequest
+	server := newTestModelServer(t, map[string]any{
+		"LinkArtifact": map[string]any{"linkArtifact": map[string]any{"versionIndex": 0}},
+	}, &requests)
+	session := &Session{apiClient: newTestAPIClient(t, server.URL)}
+
+	if err := session.LinkModel("artifact-id", "classifier", "staging"); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(requests) != 1 {
+		t.Fatalf("got %d requests, want 1", len(requests))
+	}
+	variables := requests[0].Variables
+	if variables["artifactPortfolioName"] != "classifier" ||
+		variables["projectName"] != ModelRegistryProject ||
+		variables["artifactId"] != "artifact-id" {
+		t.Errorf("got variables %v", variables)
+	}
+	aliases, _ := variables["aliases"].([]any)
+	if len(aliases) != 1 {
+		t.Fatalf("got aliases %v, want staging", variables["aliases"])
+	}
+	if alias := aliases[0].(map[string]any); alias["alias"] != "staging" ||
+		alias["artifactCollectionName"] != "classifier" {
+		t.Errorf("got alias %v, want staging in classifier", alias)
+	}
+}
+
+func TestLinkModel_InvalidPath(t *testing.T) {
+	session := &Session{apiClient: newTestAPIClient(t, "http://localhost")}
+
+	for _, model := range []string{"", "a/b/c/d", "classifier:v1", "team//"} {
+		if err := session.LinkModel("artifact-id", model); err == nil {
+			t.Errorf("expected an error for %q", model)
+		}
+	}
+}
+
+func TestModelVersionDownload(t *testing.T) {
+	var requests []graphQLRequest
+	responses := make(map[string]any)
+	server := newTestModelServer(t, responses, &requests)
+	file := func(name string) map[string]any {
+		return map[string]any{"node": map[string]any{
+			"name":      name,
+			"directUrl": server.URL + "/files/" + name,
+		}}
+	}
+	responses["ArtifactFileURLs"] = map[string]any{
+		"artifact": map[string]any{"files": map[string]any{
+			"pageInfo": map[string]any{"hasNextPage": false},
+			"edges": []any{
+				file("model/weights.pt"),
+				file("model/config.json"),
+				file("README.md"),
+			},
+		}},
+	}
+	version := &ModelVersion{ID: "artifact-id", api: newTestAPIClient(t, server.URL)}
+	dir := t.TempDir()
+
+	err := version.Download(dir,
+		downloadopts.WithPathPrefix("model"),
+		downloadopts.WithGlobs("**/*.pt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entries, _ := os.ReadDir(filepath.Join(dir, "model"))
+	if len(entries) != 1 || entries[0].Name() != "weights.pt" {
+		t.Errorf("downloaded %v, want only model/weights.pt", entries)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "README.md")); err == nil {
+		t.Error("downloaded README.md, which is outside the prefix")
+	}
+}
+
+func TestMatchGlob(t *testing.T) {
+	for _, test := range []struct {
+		glob, name string
+		want       bool
+	}{
+		{"*.pt", "weights.pt", true},
+		{"*.pt", "model/weights.pt", false},
+		{"**/*.pt", "weights.pt", true},
+		{"**/*.pt", "model/v1/weights.pt", true},
+		{"model/**", "model/v1/weights.pt", true},
+		{"model/*", "model/v1/weights.pt", false},
+	} {
+		got := matchesDownload(
+			&downloadopts.DownloadParams{Globs: []string{test.glob}},
+			test.name)
+		if got != test.want {
+			t.Errorf("%q matches %q: got %v, want %v", test.glob, test.name, got, test.want)
+		}
+	}
+}
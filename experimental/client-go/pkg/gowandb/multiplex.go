@@ -0,0 +1,252 @@
+package gowandb
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"net"
+	"sync"
+
+	"github.com/wandb/wandb/core/pkg/service"
+	"google.golang.org/protobuf/proto"
+)
+
+// maxQueuedMessages is how many messages a multiplexed run can have
+// waiting to be sent before sending blocks.
+const maxQueuedMessages = 256
+
+// errConnectionClosed is returned when sending on a multiplexed
+// connection after it or its session is closed.
+var errConnectionClosed = errors.New("gowandb: connection closed")
+
+// multiplexer sends the messages of many runs over one connection to the
+// core service, which routes them to the runs' streams by their IDs.
+//
+// Each run has a channel with its own queue. The runs take turns sending
+// a message at a time, so that a run logging a lot delays the others by
+// at most one message each.
+type multiplexer struct {
+	ctx  context.Context
+	conn net.Conn
+
+	mu sync.Mutex
+
+	// wake is signalled when a message is queued, a queue has room again,
+	// or the connection fails or is closed
+	wake *sync.Cond
+
+	// channels are the runs' channels in the order they take turns
+	channels []*muxChannel
+
+	// next is the index in channels of the run whose turn is next
+	next int
+
+	// err is why the connection can't be used anymore, or nil
+	err error
+}
+
+// muxChannel is one run's share of a multiplexed connection.
+type muxChannel struct {
+	mux  *multiplexer
+	conn *Connection
+
+	// queue is the run's encoded messages waiting to be sent, guarded by
+	// mux.mu
+	queue [][]byte
+
+	// closing is whether the run is done sending; the channel is removed
+	// once its queue is empty
+	closing bool
+}
+
+// newMultiplexer connects to the core service at the address.
+func newMultiplexer(ctx context.Context, addr string) (*multiplexer, error) {
+	conn, err := dial(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &multiplexer{ctx: ctx, conn: conn}
+	m.wake = sync.NewCond(&m.mu)
+	go m.writeMessages()
+	go m.receiveResults()
+	return m, nil
+}
+
+// open returns a new run's connection, whose results are delivered to
+// mbox; a new mailbox is used if mbox is nil.
+func (m *multiplexer) open(mbox *Mailbox) (*Connection, error) {
+	if mbox == nil {
+		mbox = NewMailbox()
+	}
+	conn := &Connection{ctx: m.ctx, Mbox: mbox}
+	conn.channel = &muxChannel{mux: m, conn: conn}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.err != nil {
+		return nil, m.err
+	}
+	m.channels = append(m.channels, conn.channel)
+	return conn, nil
+}
+
+// failed returns whether the connection can't be used anymore.
+func (m *multiplexer) failed() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.err != nil
+}
+
+// close closes the connection, dropping unsent messages.
+func (m *multiplexer) close() {
+	m.fail(errConnectionClosed)
+}
+
+// fail stops using the connection because of err.
+func (m *multiplexer) fail(err error) {
+	m.mu.Lock()
+	if m.err == nil {
+		m.err = err
+	}
+	m.channels = nil
+	m.wake.Broadcast()
+	m.mu.Unlock()
+
+	_ = m.conn.Close()
+}
+
+// send queues a message, waiting while the run's queue is full.
+//
+// The message is encoded right away, since callers may reuse it.
+func (ch *muxChannel) send(msg proto.Message) error {
+	var data bytes.Buffer
+	if err := writeMessage(&data, msg); err != nil {
+		return err
+	}
+
+	m := ch.mux
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for m.err == nil && len(ch.queue) >= maxQueuedMessages {
+		m.wake.Wait()
+	}
+	if m.err != nil {
+		return m.err
+	}
+	if ch.closing {
+		return errConnectionClosed
+	}
+
+	ch.queue = append(ch.queue, data.Bytes())
+	m.wake.Broadcast()
+	return nil
+}
+
+// close removes the run from the connection once its queued messages are
+// sent.
+func (ch *muxChannel) close() {
+	m := ch.mux
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	ch.closing = true
+	if len(ch.queue) == 0 {
+		m.remove(ch)
+	}
+}
+
+// remove removes a channel from the rotation. The caller must hold mu.
+func (m *multiplexer) remove(ch *muxChannel) {
+	for i, other := range m.channels {
+		if other != ch {
+			continue
+		}
+		m.channels = append(m.channels[:i], m.channels[i+1:]...)
+		if m.next > i {
+			m.next--
+		}
+		return
+	}
+}
+
+// nextMessage returns the message of the run whose turn it is, or nil if
+// no messages are queued.
+//
+// The caller must hold mu.
+func (m *multiplexer) nextMessage() []byte {
+	for i := range m.channels {
+		index := (m.next + i) % len(m.channels)
+		ch := m.channels[index]
+		if len(ch.queue) == 0 {
+			continue
+		}
+
+		msg := ch.queue[0]
+		ch.queue[0] = nil
+		ch.queue = ch.queue[1:]
+		m.next = index + 1
+		if ch.closing && len(ch.queue) == 0 {
+			m.remove(ch)
+		}
+
+		// Wake senders waiting for room in the queue.
+		m.wake.Broadcast()
+		return msg
+	}
+	return nil
+}
+
+// writeMessages sends the runs' messages in turn until the connection
+// fails or is closed.
+//
+// Messages are buffered while more are queued, and flushed when all
+// queues are empty.
+func (m *multiplexer) writeMessages() {
+	writer := bufio.NewWriterSize(m.conn, 16384)
+
+	m.mu.Lock()
+	for m.err == nil {
+		msg := m.nextMessage()
+		if msg == nil && writer.Buffered() == 0 {
+			m.wake.Wait()
+			continue
+		}
+		m.mu.Unlock()
+
+		var err error
+		if msg != nil {
+			_, err = writer.Write(msg)
+		} else {
+			err = writer.Flush()
+		}
+
+		if err != nil {
+			m.fail(err)
+		}
+		m.mu.Lock()
+	}
+	m.mu.Unlock()
+}
+
+// receiveResults passes the results read from the connection to the
+// mailbox of the run that is waiting for each.
+func (m *multiplexer) receiveResults() {
+	receiveResults(m.conn, func(result *service.Result) {
+		m.mu.Lock()
+		mailboxes := make([]*Mailbox, 0, len(m.channels))
+		for _, ch := range m.channels {
+			mailboxes = append(mailboxes, ch.conn.Mbox)
+		}
+		m.mu.Unlock()
+
+		for _, mbox := range mailboxes {
+			if mbox.Respond(result) {
+				return
+			}
+		}
+	})
+	m.fail(errors.New("gowandb: core service closed the connection"))
+}
@@ -0,0 +1,133 @@
+package gowandb
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// newTestMultiplexer returns a multiplexer over a connection.
+func newTestMultiplexer(t *testing.T, conn net.Conn) *multiplexer {
+	m := &multiplexer{ctx: context.Background(), conn: conn}
+	m.wake = sync.NewCond(&m.mu)
+	go m.writeMessages()
+	go m.receiveResults()
+	t.Cleanup(m.close)
+	return m
+}
+
+func TestMultiplexer_TakesTurns(t *testing.T) {
+	m := &multiplexer{}
+	m.wake = sync.NewCond(&m.mu)
+	a := &muxChannel{mux: m, queue: [][]byte{[]byte("a1"), []byte("a2"), []byte("a3")}}
+	b := &muxChannel{mux: m, queue: [][]byte{[]byte("b1")}}
+	c := &muxChannel{mux: m, queue: [][]byte{[]byte("c1"), []byte("c2")}, closing: true}
+	m.channels = []*muxChannel{a, b, c}
+
+	var order string
+	m.mu.Lock()
+	for msg := m.nextMessage(); msg != nil; msg = m.nextMessage() {
+		order += string(msg) + " "
+	}
+	m.mu.Unlock()
+
+	if want := "a1 b1 c1 a2 c2 a3 "; order != want {
+		t.Errorf("sent %q, want %q", order, want)
+	}
+	if len(m.channels) != 2 {
+		t.Errorf("got %d channels, want the closed one removed", len(m.channels))
+	}
+}
+
+func TestMultiplexer_RoutesResults(t *testing.T) {
+	client, requests := newTestCore(t, 0)
+	m := newTestMultiplexer(t, client)
+
+	var handles []*MailboxHandle
+	for _, id := range []string{"run-a", "run-b"} {
+		conn, err := m.open(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		record := &service.Record{
+			RecordType: &service.Record_Request{Request: &service.Request{
+				RequestType: &service.Request_GetSummary{
+					GetSummary: &service.GetSummaryRequest{},
+				},
+			}},
+			XInfo: &service.XRecordInfo{StreamId: id},
+		}
+		handles = append(handles, conn.Mbox.Deliver(record))
+		err = conn.Send(&service.ServerRequest{
+			ServerRequestType: &service.ServerRequest_RecordCommunicate{
+				RecordCommunicate: record,
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, id := range []string{"run-a", "run-b"} {
+		request := nextRequest(t, requests)
+		if got := request.GetRecordCommunicate().GetXInfo().GetStreamId(); got != id {
+			t.Errorf("got a request for %q, want %q", got, id)
+		}
+	}
+	for i, handle := range handles {
+		select {
+		case <-handle.responseChan:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("run %d got no result", i)
+		}
+	}
+}
+
+func TestMultiplexer_SendAfterClose(t *testing.T) {
+	client, _ := newTestCore(t, 0)
+	m := newTestMultiplexer(t, client)
+	conn, err := m.open(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conn.Close()
+
+	err = conn.Send(&service.ServerRequest{})
+	if !errors.Is(err, errConnectionClosed) {
+		t.Errorf("got %v, want errConnectionClosed", err)
+	}
+	if m.failed() {
+		t.Error("closing a run's connection closed the shared connection")
+	}
+}
+
+func TestMultiplexer_FailsWhenServiceDisconnects(t *testing.T) {
+	client, core := net.Pipe()
+	m := newTestMultiplexer(t, client)
+	conn, err := m.open(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	core.Close()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for !m.failed() && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if !m.failed() {
+		t.Fatal("multiplexer didn't fail")
+	}
+	if err := conn.Send(&service.ServerRequest{}); err == nil {
+		t.Error("expected an error sending after the service disconnected")
+	}
+	if _, err := m.open(nil); err == nil {
+		t.Error("expected an error opening a connection")
+	}
+}
@@ -0,0 +1,270 @@
+package gowandb
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/wandb/wandb/core/pkg/service"
+	"github.com/wandb/wandb/experimental/client-go/internal/launcher"
+	"github.com/wandb/wandb/experimental/client-go/pkg/opts/runopts"
+	"github.com/wandb/wandb/experimental/client-go/pkg/opts/sessionopts"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	// defaultHeartbeatInterval is how often to check that the core
+	// service is responding, unless configured otherwise.
+	defaultHeartbeatInterval = 5 * time.Second
+
+	// missedHeartbeats is how many checks in a row must fail before the
+	// core service is considered to be down.
+	missedHeartbeats = 3
+
+	// maxJournalRows is how many history rows a run keeps for re-sending.
+	maxJournalRows = 10000
+)
+
+// monitored returns whether the session checks the health of the core
+// service.
+func (s *Session) monitored() bool {
+	return s.OnCoreStatus != nil || s.MaxRestarts > 0
+}
+
+// startMonitor starts checking the health of the core service.
+func (s *Session) startMonitor() {
+	s.stopMonitor = make(chan struct{})
+	s.monitorDone = make(chan struct{})
+	go s.monitor()
+}
+
+// stopMonitoring stops checking the health of the core service, waiting
+// for any restart in progress.
+func (s *Session) stopMonitoring() {
+	if s.stopMonitor == nil {
+		return
+	}
+	s.stopOnce.Do(func() { close(s.stopMonitor) })
+	<-s.monitorDone
+}
+
+// monitor checks that the core service is accepting connections, and
+// restarts it if it isn't and the session is allowed to.
+func (s *Session) monitor() {
+	defer close(s.monitorDone)
+
+	interval := s.HeartbeatInterval
+	if interval <= 0 {
+		interval = defaultHeartbeatInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	missed := 0
+	restarts := 0
+	down := false
+	for {
+		select {
+		case <-s.stopMonitor:
+			return
+		case <-ticker.C:
+		}
+
+		if isAlive(s.manager.Address()) {
+			missed = 0
+			down = false
+			continue
+		}
+
+		missed++
+		if missed < missedHeartbeats || down {
+			continue
+		}
+		down = true
+		s.reportStatus(sessionopts.CoreUnresponsive, nil)
+
+		if !s.restartable || restarts >= s.MaxRestarts {
+			continue
+		}
+		restarts++
+		if err := s.restart(); err != nil {
+			s.reportStatus(sessionopts.CoreRestartFailed, err)
+			continue
+		}
+		missed = 0
+		down = false
+		s.reportStatus(sessionopts.CoreRestarted, nil)
+	}
+}
+
+func (s *Session) reportStatus(status sessionopts.CoreStatus, err error) {
+	if s.OnCoreStatus != nil {
+		s.OnCoreStatus(status, err)
+	}
+}
+
+// restart launches a new core service and re-attaches the session's
+// unfinished runs to it.
+func (s *Session) restart() error {
+	address, err := s.relaunch()
+	if err != nil {
+		return err
+	}
+	s.manager.SetAddress(address)
+
+	var errs []error
+	for _, run := range s.activeRuns() {
+		conn, err := s.manager.connectRun(run.mbox)
+		if err == nil {
+			err = run.reattach(conn)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf(
+				"gowandb: failed to re-attach run %s: %v",
+				run.settings.GetRunId().GetValue(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// relaunch launches a new core service and returns its address.
+//
+// The crashed service's process is not waited for, since it may have
+// exited with an error.
+func (s *Session) relaunch() (string, error) {
+	if s.PortFile != "" {
+		return s.attachShared()
+	}
+	execCmd, address, err := s.launch(launcher.NewLauncher())
+	if err != nil {
+		return "", err
+	}
+	s.execCmd = execCmd
+	return address, nil
+}
+
+// trackRun records that a run is unfinished, so that it is re-attached if
+// the core service is restarted.
+func (s *Session) trackRun(run *Run) {
+	s.runsMu.Lock()
+	defer s.runsMu.Unlock()
+	if s.runs == nil {
+		s.runs = make(map[*Run]struct{})
+	}
+	s.runs[run] = struct{}{}
+	run.onFinish = func() {
+		s.runsMu.Lock()
+		defer s.runsMu.Unlock()
+		delete(s.runs, run)
+	}
+}
+
+func (s *Session) activeRuns() []*Run {
+	s.runsMu.Lock()
+	defer s.runsMu.Unlock()
+	runs := make([]*Run, 0, len(s.runs))
+	for run := range s.runs {
+		runs = append(runs, run)
+	}
+	return runs
+}
+
+// journal keeps the records a run sent to the core service, so that a
+// restarted service can be sent what the crashed one didn't upload.
+type journal struct {
+	mu sync.Mutex
+
+	// metrics are the run's metric definitions, which are always re-sent
+	metrics []*service.ServerRequest
+
+	// history is the most recent history rows, oldest first
+	history []*service.ServerRequest
+
+	// dropped is how many of the oldest rows were discarded to keep the
+	// journal's size bounded
+	dropped int
+}
+
+func (j *journal) addMetric(request *service.ServerRequest) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.metrics = append(j.metrics, request)
+}
+
+func (j *journal) addHistory(request *service.ServerRequest) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if len(j.history) >= maxJournalRows {
+		j.history = j.history[1:]
+		j.dropped++
+	}
+	j.history = append(j.history, request)
+}
+
+// replay returns the records to re-send given how many history rows the
+// server already has.
+func (j *journal) replay(uploadedRows int) []*service.ServerRequest {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	skip := uploadedRows - j.dropped
+	if skip < 0 {
+		slog.Warn("gowandb: history rows were lost when the core service crashed",
+			"rows", -skip)
+		skip = 0
+	}
+	skip = min(skip, len(j.history))
+
+	requests := make([]*service.ServerRequest, 0, len(j.metrics)+len(j.history)-skip)
+	requests = append(requests, j.metrics...)
+	return append(requests, j.history[skip:]...)
+}
+
+// reattach continues the run on a restarted core service.
+//
+// The run is resumed from the server, whose starting step tells how many
+// of the history rows sent to the crashed service were uploaded; the rest
+// are re-sent. Requests that were waiting for a response are sent again.
+func (r *Run) reattach(conn *Connection) error {
+	r.connMu.Lock()
+	defer r.connMu.Unlock()
+
+	if r.finished {
+		conn.Close()
+		return nil
+	}
+
+	pending := r.mbox.Pending()
+	r.conn.Close()
+	r.conn = conn
+	r.receive(conn)
+
+	previous := r.run
+	r.settings.Resume = wrapperspb.String(string(runopts.ResumeAllow))
+	if err := r.init(); err != nil {
+		return err
+	}
+	r.start()
+
+	var replay []*service.ServerRequest
+	if r.journal != nil {
+		uploaded := r.run.GetStartingStep() - previous.GetStartingStep()
+		replay = r.journal.replay(int(uploaded))
+	}
+	for _, record := range pending {
+		replay = append(replay, &service.ServerRequest{
+			ServerRequestType: &service.ServerRequest_RecordCommunicate{
+				RecordCommunicate: record,
+			},
+		})
+	}
+
+	for _, request := range replay {
+		if err := r.conn.Send(request); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package gowandb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/wandb/wandb/core/pkg/service"
+	"github.com/wandb/wandb/experimental/client-go/pkg/opts/logopts"
+)
+
+// journalRow returns a history row request for a step.
+func journalRow(step int64) *service.ServerRequest {
+	run := &Run{settings: &service.Settings{}}
+	return run.historyRequest(&service.PartialHistoryRequest{
+		Step: &service.HistoryStep{Num: step},
+	})
+}
+
+// replayedSteps returns the steps of the history rows in requests.
+func replayedSteps(requests []*service.ServerRequest) []int64 {
+	var steps []int64
+	for _, request := range requests {
+		history := request.GetRecordPublish().GetRequest().GetPartialHistory()
+		if history != nil {
+			steps = append(steps, history.GetStep().GetNum())
+		}
+	}
+	return steps
+}
+
+func TestJournal_ReplaysRowsNotUploaded(t *testing.T) {
+	j := &journal{}
+	metric := &service.ServerRequest{}
+	j.addMetric(metric)
+	for step := range int64(5) {
+		j.addHistory(journalRow(step))
+	}
+
+	replay := j.replay(3)
+
+	if len(replay) != 3 || replay[0] != metric {
+		t.Fatalf("got %d requests, want the metric and 2 rows", len(replay))
+	}
+	if steps := replayedSteps(replay); steps[0] != 3 || steps[1] != 4 {
+		t.Errorf("replayed steps %v, want 3 and 4", steps)
+	}
+}
+
+func TestJournal_NothingUploaded(t *testing.T) {
+	j := &journal{}
+	for step := range int64(2) {
+		j.addHistory(journalRow(step))
+	}
+
+	if steps := replayedSteps(j.replay(0)); len(steps) != 2 {
+		t.Errorf("replayed steps %v, want all of them", steps)
+	}
+	if steps := replayedSteps(j.replay(10)); len(steps) != 0 {
+		t.Errorf("replayed steps %v, want none", steps)
+	}
+}
+
+func TestJournal_DropsOldestRows(t *testing.T) {
+	j := &journal{}
+	for step := range int64(maxJournalRows + 2) {
+		j.addHistory(journalRow(step))
+	}
+
+	// Rows that were dropped before being uploaded are lost.
+	all := replayedSteps(j.replay(0))
+	if len(all) != maxJournalRows || all[0] != 2 {
+		t.Errorf("replayed %d rows from step %d, want %d from step 2",
+			len(all), all[0], maxJournalRows)
+	}
+
+	rest := replayedSteps(j.replay(maxJournalRows))
+	if len(rest) != 2 || rest[0] != maxJournalRows {
+		t.Errorf("replayed steps %v, want the last 2", rest)
+	}
+}
+
+func TestLog_Journaled(t *testing.T) {
+	run, requests := newTestRun(t)
+	run.journal = &journal{}
+
+	run.Log(map[string]interface{}{"loss": 1}, logopts.WithStep(0), logopts.WithCommit(true))
+	nextHistory(t, requests)
+
+	if steps := replayedSteps(run.journal.replay(0)); len(steps) != 1 || steps[0] != 0 {
+		t.Errorf("journaled steps %v, want the logged step", steps)
+	}
+}
+
+func TestReattach_ReplaysJournalAndPending(t *testing.T) {
+	run, _ := newTestRun(t)
+	run.journal = &journal{}
+	for step := range int64(5) {
+		run.journal.addHistory(journalRow(step))
+	}
+	pending := &service.Record{RecordType: &service.Record_Request{
+		Request: &service.Request{RequestType: &service.Request_GetSummary{
+			GetSummary: &service.GetSummaryRequest{},
+		}},
+	}}
+	run.mbox.Deliver(pending)
+
+	// The restarted service had uploaded 3 rows.
+	client, requests := newTestCore(t, 3)
+	conn := &Connection{ctx: context.Background(), Conn: client, Mbox: run.mbox}
+	if err := run.reattach(conn); err != nil {
+		t.Fatal(err)
+	}
+
+	if init := nextRequest(t, requests).GetInformInit(); init.GetSettings().GetResume().GetValue() != "allow" {
+		t.Errorf("got init %v, want the run resumed", init)
+	}
+	var replayed []*service.ServerRequest
+	for len(replayed) < 3 {
+		request := nextRequest(t, requests)
+		switch {
+		case request.GetRecordCommunicate().GetRun() != nil,
+			request.GetInformStart() != nil,
+			request.GetRecordCommunicate().GetRequest().GetRunStart() != nil:
+		default:
+			replayed = append(replayed, request)
+		}
+	}
+	if steps := replayedSteps(replayed); len(steps) != 2 || steps[0] != 3 || steps[1] != 4 {
+		t.Errorf("replayed steps %v, want 3 and 4", steps)
+	}
+	resent := replayed[2].GetRecordCommunicate()
+	if resent.GetControl().GetMailboxSlot() != pending.Control.MailboxSlot {
+		t.Errorf("got %v, want the pending request sent again", replayed[2])
+	}
+}
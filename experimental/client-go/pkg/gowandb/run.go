@@ -2,9 +2,12 @@ package gowandb
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 	"os"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/wandb/segmentio-encoding/json"
 
@@ -12,20 +15,56 @@ import (
 	"github.com/wandb/wandb/core/pkg/utils"
 	"github.com/wandb/wandb/experimental/client-go/pkg/opts/runopts"
 	"github.com/wandb/wandb/experimental/client-go/pkg/runconfig"
+	"google.golang.org/protobuf/proto"
 )
 
 type Settings map[string]interface{}
 
 type Run struct {
 	// ctx is the context for the run
-	ctx            context.Context
-	settings       *service.Settings
-	config         *runconfig.Config
-	conn           *Connection
-	wg             sync.WaitGroup
-	run            *service.RunRecord
-	params         *runopts.RunParams
-	partialHistory History
+	ctx      context.Context
+	settings *service.Settings
+	config   *runconfig.Config
+	conn     *Connection
+	mbox     *Mailbox
+	wg       sync.WaitGroup
+	run      *service.RunRecord
+	params   *runopts.RunParams
+
+	// partialHistory is the data logged for the current step, guarded by
+	// historyMu
+	partialHistory *pendingHistory
+	historyMu      sync.Mutex
+
+	// historyBatch is the steps logged with LogScalars that weren't sent
+	// yet, which historyBatchTimer sends; guarded by historyMu
+	historyBatch      *historyBatch
+	historyBatchTimer *time.Timer
+
+	// connMu is held for writing while the run is re-attached to a
+	// restarted server, and for reading while sending to it.
+	connMu sync.RWMutex
+
+	// finished is true once the run's connection is closed
+	finished bool
+
+	// journal holds what was sent to the server, so that it can be sent
+	// again if the server is restarted; nil if the run isn't journaled
+	journal *journal
+
+	// onFinish is called after the run finishes
+	onFinish func()
+
+	// api returns the session's client for the W&B API; nil if the run
+	// wasn't created by a session
+	api func() (*apiClient, error)
+
+	// alerts limits how often the run sends alerts
+	alerts alertLimiter
+
+	// runCtx is cancelled when the run should stop or has finished
+	runCtx    context.Context
+	cancelRun context.CancelCauseFunc
 }
 
 // NewRun creates a new run with the given settings and responders.
@@ -34,11 +73,14 @@ func NewRun(ctx context.Context, settings *service.Settings, conn *Connection, r
 		ctx:      ctx,
 		settings: settings,
 		conn:     conn,
+		mbox:     conn.Mbox,
 		wg:       sync.WaitGroup{},
 		config:   runParams.Config,
 		params:   runParams,
 	}
-	run.resetPartialHistory()
+	run.runCtx, run.cancelRun = context.WithCancelCause(ctx)
+	run.partialHistory = newPendingHistory()
+	run.historyBatch = newHistoryBatch()
 	return run
 }
 
@@ -51,14 +93,27 @@ func (r *Run) setup() {
 	if err != nil {
 		slog.Error("error creating files dir", "err", err)
 	}
+	r.receive(r.conn)
+}
+
+// receive handles the responses from a connection to the server until it
+// is closed.
+func (r *Run) receive(conn *Connection) {
 	r.wg.Add(1)
 	go func() {
-		r.conn.Recv()
+		conn.Recv()
 		r.wg.Done()
 	}()
 }
 
-func (r *Run) init() {
+// send sends a message to the server the run is attached to.
+func (r *Run) send(msg proto.Message) error {
+	r.connMu.RLock()
+	defer r.connMu.RUnlock()
+	return r.conn.Send(msg)
+}
+
+func (r *Run) init() error {
 	serverRecord := service.ServerRequest{
 		ServerRequestType: &service.ServerRequest_InformInit{InformInit: &service.ServerInformInitRequest{
 			Settings: r.settings,
@@ -67,7 +122,7 @@ func (r *Run) init() {
 	}
 	err := r.conn.Send(&serverRecord)
 	if err != nil {
-		return
+		return err
 	}
 
 	config := &service.ConfigRecord{}
@@ -106,14 +161,68 @@ func (r *Run) init() {
 		ServerRequestType: &service.ServerRequest_RecordCommunicate{RecordCommunicate: &record},
 	}
 
-	handle := r.conn.Mbox.Deliver(&record)
+	handle := r.mbox.Deliver(&record)
 	err = r.conn.Send(&serverRecord)
 	if err != nil {
-		return
+		return err
 	}
 	result := handle.wait()
+	if runError := result.GetRunResult().GetError(); runError != nil {
+		return fmt.Errorf("gowandb: failed to initialize run: %w", newRunError(runError))
+	}
 	r.run = result.GetRunResult().GetRun()
-	utils.PrintHeadFoot(r.run, r.settings, false)
+	if r.run.GetResumed() {
+		r.mergeResumedConfig()
+	}
+	return nil
+}
+
+// mergeResumedConfig adds the config values of a resumed run that were
+// not set when starting it.
+func (r *Run) mergeResumedConfig() {
+	for _, item := range r.run.GetConfig().GetUpdate() {
+		if _, ok := (*r.config)[item.Key]; ok {
+			continue
+		}
+		var value interface{}
+		if err := json.Unmarshal([]byte(item.ValueJson), &value); err != nil {
+			slog.Error("error parsing resumed config", "key", item.Key, "err", err)
+			continue
+		}
+		(*r.config)[item.Key] = value
+	}
+}
+
+// Resumed reports whether the run continues a previous run.
+func (r *Run) Resumed() bool {
+	return r.run.GetResumed()
+}
+
+// StartingStep is the step of the first history row logged by the run.
+//
+// It is 0 unless the run was resumed.
+func (r *Run) StartingStep() int64 {
+	return r.run.GetStartingStep()
+}
+
+// Config returns the run's config, including values from before
+// it was resumed.
+func (r *Run) Config() runconfig.Config {
+	return *r.config
+}
+
+// ResumedSummary returns the summary of the run at the time it was resumed.
+func (r *Run) ResumedSummary() map[string]interface{} {
+	summary := make(map[string]interface{})
+	for _, item := range r.run.GetSummary().GetUpdate() {
+		var value interface{}
+		if err := json.Unmarshal([]byte(item.ValueJson), &value); err != nil {
+			slog.Error("error parsing resumed summary", "key", item.Key, "err", err)
+			continue
+		}
+		summary[item.Key] = value
+	}
+	return summary
 }
 
 func (r *Run) start() {
@@ -128,10 +237,14 @@ func (r *Run) start() {
 		return
 	}
 
+	// Start from the run returned by the server, which has the starting
+	// step of a resumed run.
+	run := r.run
+	if run == nil {
+		run = &service.RunRecord{RunId: r.settings.GetRunId().GetValue()}
+	}
 	request := service.Request{RequestType: &service.Request_RunStart{
-		RunStart: &service.RunStartRequest{Run: &service.RunRecord{
-			RunId: r.settings.GetRunId().GetValue(),
-		}}}}
+		RunStart: &service.RunStartRequest{Run: run}}}
 	record := service.Record{
 		RecordType: &service.Record_Request{Request: &request},
 		Control:    &service.Control{Local: true},
@@ -142,7 +255,7 @@ func (r *Run) start() {
 		ServerRequestType: &service.ServerRequest_RecordCommunicate{RecordCommunicate: &record},
 	}
 
-	handle := r.conn.Mbox.Deliver(&record)
+	handle := r.mbox.Deliver(&record)
 	err = r.conn.Send(&serverRecord)
 	if err != nil {
 		return
@@ -150,60 +263,6 @@ func (r *Run) start() {
 	handle.wait()
 }
 
-func (r *Run) logCommit(data map[string]interface{}) {
-	history := service.PartialHistoryRequest{}
-	for key, value := range data {
-		// strValue := strconv.FormatFloat(value, 'f', -1, 64)
-		data, err := json.Marshal(value)
-		if err != nil {
-			panic(err)
-		}
-		history.Item = append(history.Item, &service.HistoryItem{
-			Key:       key,
-			ValueJson: string(data),
-		})
-	}
-	request := service.Request{
-		RequestType: &service.Request_PartialHistory{PartialHistory: &history},
-	}
-	record := service.Record{
-		RecordType: &service.Record_Request{Request: &request},
-		Control:    &service.Control{Local: true},
-		XInfo:      &service.XRecordInfo{StreamId: r.settings.GetRunId().GetValue()},
-	}
-
-	serverRecord := service.ServerRequest{
-		ServerRequestType: &service.ServerRequest_RecordPublish{RecordPublish: &record},
-	}
-
-	err := r.conn.Send(&serverRecord)
-	if err != nil {
-		return
-	}
-}
-
-func (r *Run) resetPartialHistory() {
-	r.partialHistory = make(map[string]interface{})
-}
-
-func (r *Run) LogPartial(data map[string]interface{}, commit bool) {
-	for k, v := range data {
-		r.partialHistory[k] = v
-	}
-	if commit {
-		r.LogPartialCommit()
-	}
-}
-
-func (r *Run) LogPartialCommit() {
-	r.logCommit(r.partialHistory)
-	r.resetPartialHistory()
-}
-
-func (r *Run) Log(data map[string]interface{}) {
-	r.LogPartial(data, true)
-}
-
 func (r *Run) sendExit() {
 	record := service.Record{
 		RecordType: &service.Record_Exit{
@@ -214,8 +273,8 @@ func (r *Run) sendExit() {
 	serverRecord := service.ServerRequest{
 		ServerRequestType: &service.ServerRequest_RecordCommunicate{RecordCommunicate: &record},
 	}
-	handle := r.conn.Mbox.Deliver(&record)
-	err := r.conn.Send(&serverRecord)
+	handle := r.mbox.Deliver(&record)
+	err := r.send(&serverRecord)
 	if err != nil {
 		return
 	}
@@ -231,12 +290,13 @@ func (r *Run) sendShutdown() {
 				},
 			}},
 		Control: &service.Control{AlwaysSend: true, ReqResp: true},
+		XInfo:   &service.XRecordInfo{StreamId: r.settings.GetRunId().GetValue()},
 	}
 	serverRecord := service.ServerRequest{
 		ServerRequestType: &service.ServerRequest_RecordCommunicate{RecordCommunicate: record},
 	}
-	handle := r.conn.Mbox.Deliver(record)
-	err := r.conn.Send(&serverRecord)
+	handle := r.mbox.Deliver(record)
+	err := r.send(&serverRecord)
 	if err != nil {
 		return
 	}
@@ -249,18 +309,57 @@ func (r *Run) sendInformFinish() {
 			XInfo: &service.XRecordInfo{StreamId: r.settings.GetRunId().GetValue()},
 		}},
 	}
-	err := r.conn.Send(&serverRecord)
+	err := r.send(&serverRecord)
 	if err != nil {
 		return
 	}
 }
 
+// URL returns the address of the run's page.
+func (r *Run) URL() string {
+	appURL := strings.Replace(r.settings.GetBaseUrl().GetValue(), "//api.", "//", 1)
+	return fmt.Sprintf("%v/%v/%v/runs/%v",
+		appURL, r.run.GetEntity(), r.run.GetProject(), r.run.GetRunId())
+}
+
+// UploadProgress returns how many bytes of the run's files have been
+// uploaded so far, out of the total.
+//
+// It can be called while Finish waits for uploads to complete.
+func (r *Run) UploadProgress() (uploaded, total int64, err error) {
+	record := service.Record{
+		RecordType: &service.Record_Request{Request: &service.Request{
+			RequestType: &service.Request_PollExit{PollExit: &service.PollExitRequest{}},
+		}},
+		XInfo: &service.XRecordInfo{StreamId: r.settings.GetRunId().GetValue()},
+	}
+	serverRecord := service.ServerRequest{
+		ServerRequestType: &service.ServerRequest_RecordCommunicate{RecordCommunicate: &record},
+	}
+
+	handle := r.mbox.Deliver(&record)
+	if err := r.send(&serverRecord); err != nil {
+		return 0, 0, err
+	}
+	stats := handle.wait().GetResponse().GetPollExitResponse().GetPusherStats()
+	return stats.GetUploadedBytes(), stats.GetTotalBytes(), nil
+}
+
 func (r *Run) Finish() {
+	r.cancelRun(nil)
+	r.LogPartialCommit()
 	r.sendExit()
+	r.logErrors()
 	r.sendShutdown()
 	r.sendInformFinish()
 
+	r.connMu.Lock()
+	r.finished = true
 	r.conn.Close()
+	r.connMu.Unlock()
 	r.wg.Wait()
 	utils.PrintHeadFoot(r.run, r.settings, true)
+	if r.onFinish != nil {
+		r.onFinish()
+	}
 }
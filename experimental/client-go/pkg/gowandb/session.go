@@ -3,7 +3,9 @@ package gowandb
 import (
 	"context"
 	"fmt"
+	"sync"
 
+	"github.com/wandb/wandb/core/pkg/utils"
 	"github.com/wandb/wandb/experimental/client-go/internal/execbin"
 	"github.com/wandb/wandb/experimental/client-go/internal/launcher"
 	"github.com/wandb/wandb/experimental/client-go/pkg/opts/runopts"
@@ -15,43 +17,140 @@ type Session struct {
 	manager *Manager
 	execCmd *execbin.ForkExecCmd
 
+	// restartable is true if the session can launch the core service
+	restartable bool
+
+	stopMonitor chan struct{}
+	monitorDone chan struct{}
+	stopOnce    sync.Once
+
+	// runs are the session's unfinished runs
+	runs   map[*Run]struct{}
+	runsMu sync.Mutex
+
+	// apiClient makes requests to the W&B server that aren't part of a
+	// run; it's created when first needed.
+	apiClient *apiClient
+	apiMu     sync.Mutex
+
 	// embed settings parameters which are set by sessionopts options
 	sessionopts.SessionParams
 }
 
-func (s *Session) start() {
-	var execCmd *execbin.ForkExecCmd
-	var err error
-
+func (s *Session) start() error {
 	ctx := context.Background()
 	sessionSettings := s.Settings
 	if sessionSettings == nil {
 		sessionSettings = settings.NewSettings()
 	}
 
-	if s.Address == "" {
-		launch := launcher.NewLauncher()
-		if len(s.CoreBinary) != 0 {
-			execCmd, err = launch.LaunchBinary(s.CoreBinary)
-		} else {
-			execCmd, err = launch.LaunchCommand("wandb-core")
-		}
-		if err != nil {
-			panic("error launching")
-		}
-		s.execCmd = execCmd
-
-		port, err := launch.Getport()
-		if err != nil {
-			panic("error getting port")
-		}
-		s.Address = fmt.Sprintf("127.0.0.1:%d", port)
+	var err error
+	switch {
+	case s.Address != "":
+	case s.PortFile != "":
+		s.Address, err = s.attachShared()
+		s.restartable = true
+	default:
+		s.execCmd, s.Address, err = s.launch(launcher.NewLauncher())
+		s.restartable = true
+	}
+	if err != nil {
+		return err
 	}
 
 	s.manager = NewManager(ctx, sessionSettings, s.Address)
+	s.manager.multiplexed = s.Multiplexed
+	if s.PortFile != "" {
+		// Reconnect if the shared service is restarted.
+		s.manager.rediscover = s.attachShared
+	}
+
+	if s.monitored() {
+		s.startMonitor()
+	}
+	return nil
 }
 
+// launch starts a core service and returns its address.
+func (s *Session) launch(
+	launch *launcher.Launcher,
+) (*execbin.ForkExecCmd, string, error) {
+	launch.AddArgs(s.CoreArgs...)
+	launch.SetTransport(string(s.Transport))
+	var execCmd *execbin.ForkExecCmd
+	var err error
+	if len(s.CoreBinary) != 0 {
+		execCmd, err = launch.LaunchBinary(s.CoreBinary)
+	} else {
+		execCmd, err = launch.LaunchCommand("wandb-core")
+	}
+	if err != nil {
+		return nil, "", fmt.Errorf("gowandb: failed to launch core service: %v", err)
+	}
+
+	address, err := launch.GetAddress()
+	if err != nil {
+		return nil, "", fmt.Errorf("gowandb: failed to get core service address: %v", err)
+	}
+	return execCmd, address, nil
+}
+
+// attachShared returns the address of the shared core service, launching
+// it if it isn't running.
+//
+// If several processes find no service at once, one of them launches it
+// while the others wait on a lock, then connect to the service it
+// launched.
+func (s *Session) attachShared() (string, error) {
+	address, err := launcher.ReadAddress(s.PortFile)
+	if err == nil && isAlive(address) {
+		return address, nil
+	}
+
+	unlock, err := launcher.LockPortFile(s.PortFile)
+	if err != nil {
+		return "", fmt.Errorf("gowandb: %v", err)
+	}
+	defer unlock()
+
+	// Another process may have launched the service while we waited.
+	address, err = launcher.ReadAddress(s.PortFile)
+	if err == nil && isAlive(address) {
+		return address, nil
+	}
+
+	_, address, err = s.launch(launcher.NewSharedLauncher(s.PortFile))
+	return address, err
+}
+
+// isAlive returns whether a core service is accepting connections at the
+// address.
+func isAlive(address string) bool {
+	conn, err := dial(address)
+	if err != nil {
+		return false
+	}
+	conn.Close()
+	return true
+}
+
+// Close closes the session.
+//
+// A core service launched by the session is shut down, unless it's
+// shared with other processes. See Teardown.
 func (s *Session) Close() {
+	s.stopMonitoring()
+	if s.PortFile != "" {
+		s.manager.closeMultiplexer()
+		return
+	}
+	s.Teardown()
+}
+
+// Teardown shuts down the core service, even if other processes are
+// using it.
+func (s *Session) Teardown() {
+	s.stopMonitoring()
 	s.manager.Close()
 	if s.execCmd != nil {
 		_ = s.execCmd.Wait()
@@ -65,8 +164,20 @@ func (s *Session) NewRun(opts ...runopts.RunOption) (*Run, error) {
 		opt(runParams)
 	}
 	run := s.manager.NewRun(runParams)
+	run.api = s.api
+	if s.MaxRestarts > 0 {
+		run.journal = &journal{}
+	}
 	run.setup()
-	run.init()
+	if err := run.init(); err != nil {
+		run.sendInformFinish()
+		run.conn.Close()
+		run.wg.Wait()
+		return nil, err
+	}
+	utils.PrintHeadFoot(run.run, run.settings, false)
 	run.start()
+	run.pollStopStatus()
+	s.trackRun(run)
 	return run, nil
 }
@@ -0,0 +1,72 @@
+package gowandb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// stopPollInterval is how often a run asks whether it should stop.
+const stopPollInterval = 15 * time.Second
+
+// ErrStopRequested is the cause of a run's context being cancelled when
+// W&B asks for the run to stop.
+var ErrStopRequested = errors.New("gowandb: run stop requested")
+
+// Context returns a context that is cancelled when W&B asks for the run to
+// stop, such as when it's stopped in the UI or terminated early by its
+// sweep, and when the run finishes.
+//
+// In the first case, context.Cause returns ErrStopRequested. Long-running
+// work should watch the context to stop promptly.
+func (r *Run) Context() context.Context {
+	return r.runCtx
+}
+
+// pollStopStatus asks wandb-core whether the run should stop until the run
+// finishes or is stopped.
+func (r *Run) pollStopStatus() {
+	go func() {
+		ticker := time.NewTicker(stopPollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-r.runCtx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			if r.shouldStop() {
+				r.cancelRun(ErrStopRequested)
+				return
+			}
+		}
+	}()
+}
+
+// shouldStop returns whether wandb-core reports that the run should stop.
+func (r *Run) shouldStop() bool {
+	record := service.Record{
+		RecordType: &service.Record_Request{Request: &service.Request{
+			RequestType: &service.Request_StopStatus{StopStatus: &service.StopStatusRequest{}},
+		}},
+		XInfo: &service.XRecordInfo{StreamId: r.settings.GetRunId().GetValue()},
+	}
+	serverRecord := service.ServerRequest{
+		ServerRequestType: &service.ServerRequest_RecordCommunicate{RecordCommunicate: &record},
+	}
+
+	handle := r.mbox.Deliver(&record)
+	if err := r.send(&serverRecord); err != nil {
+		return false
+	}
+	select {
+	case result := <-handle.responseChan:
+		return result.GetResponse().GetStopStatusResponse().GetRunShouldStop()
+	case <-r.runCtx.Done():
+		return false
+	}
+}
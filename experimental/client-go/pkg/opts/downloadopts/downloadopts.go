@@ -0,0 +1,31 @@
+// sub-package for gowandb artifact download options
+package downloadopts
+
+type DownloadParams struct {
+	PathPrefix string
+	Globs      []string
+}
+
+type DownloadOption func(*DownloadParams)
+
+// WithPathPrefix downloads only the files in a directory of the artifact,
+// such as "checkpoints/epoch-10".
+//
+// A prefix that names a file downloads just that file.
+func WithPathPrefix(prefix string) DownloadOption {
+	return func(p *DownloadParams) {
+		p.PathPrefix = prefix
+	}
+}
+
+// WithGlobs downloads only the files whose paths match one of the patterns.
+//
+// Patterns match whole paths with the syntax of path.Match, except that a
+// "**" path segment matches any number of directories, as in
+// "checkpoints/**/*.pt". Patterns without wildcards name single files,
+// which are looked up directly instead of listing the artifact's files.
+func WithGlobs(patterns ...string) DownloadOption {
+	return func(p *DownloadParams) {
+		p.Globs = append(p.Globs, patterns...)
+	}
+}
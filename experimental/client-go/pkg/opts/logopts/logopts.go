@@ -0,0 +1,32 @@
+// sub-package for gowandb history logging options
+package logopts
+
+type LogParams struct {
+	Step   *int64
+	Commit *bool
+}
+
+type LogOption func(*LogParams)
+
+// WithStep logs the data at the given step instead of the current one.
+//
+// Logging at a later step commits the data logged for the current step.
+// Steps must be increasing: data for an earlier step is dropped.
+//
+// Unless WithCommit is also given, the step isn't committed, so that
+// more data can be logged for it.
+func WithStep(step int64) LogOption {
+	return func(p *LogParams) {
+		p.Step = &step
+	}
+}
+
+// WithCommit sets whether to finish the step after logging the data.
+//
+// Data logged without committing is combined with data from later calls
+// until a step is committed. Defaults to true unless WithStep is given.
+func WithCommit(commit bool) LogOption {
+	return func(p *LogParams) {
+		p.Commit = &commit
+	}
+}
@@ -0,0 +1,80 @@
+// sub-package for gowandb metric options
+package metricopts
+
+// Summary is a way to summarize a metric's values in the run summary.
+type Summary string
+
+const (
+	SummaryMin  Summary = "min"
+	SummaryMax  Summary = "max"
+	SummaryMean Summary = "mean"
+	SummaryBest Summary = "best"
+	SummaryLast Summary = "last"
+	SummaryCopy Summary = "copy"
+	SummaryNone Summary = "none"
+)
+
+// Goal is whether higher or lower values of a metric are better.
+type Goal string
+
+const (
+	GoalMinimize Goal = "minimize"
+	GoalMaximize Goal = "maximize"
+)
+
+type MetricParams struct {
+	StepMetric string
+	StepSync   *bool
+	Hidden     bool
+	Summary    []Summary
+	Goal       Goal
+	Overwrite  bool
+}
+
+type MetricOption func(*MetricParams)
+
+// WithStepMetric sets the metric to use as the x-axis for this metric.
+func WithStepMetric(stepMetric string) MetricOption {
+	return func(p *MetricParams) {
+		p.StepMetric = stepMetric
+	}
+}
+
+// WithStepSync sets whether to fill in the last value of the step metric
+// in rows that log this metric without it.
+//
+// Defaults to true if a step metric is set.
+func WithStepSync(stepSync bool) MetricOption {
+	return func(p *MetricParams) {
+		p.StepSync = &stepSync
+	}
+}
+
+// WithHidden hides the metric from automatic charts.
+func WithHidden() MetricOption {
+	return func(p *MetricParams) {
+		p.Hidden = true
+	}
+}
+
+// WithSummary sets the aggregates of the metric to keep in the summary.
+func WithSummary(summary ...Summary) MetricOption {
+	return func(p *MetricParams) {
+		p.Summary = append(p.Summary, summary...)
+	}
+}
+
+// WithGoal sets the direction of the best value of the metric.
+func WithGoal(goal Goal) MetricOption {
+	return func(p *MetricParams) {
+		p.Goal = goal
+	}
+}
+
+// WithOverwrite replaces any previous definition of the metric instead
+// of merging with it.
+func WithOverwrite() MetricOption {
+	return func(p *MetricParams) {
+		p.Overwrite = true
+	}
+}
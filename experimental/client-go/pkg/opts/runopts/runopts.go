@@ -11,9 +11,24 @@ type RunParams struct {
 	Name      *string
 	RunID     *string
 	Project   *string
+	Resume    *Resume
 	Telemetry *service.TelemetryRecord
 }
 
+// Resume is how to handle a run ID that already exists on the server.
+type Resume string
+
+const (
+	// ResumeAllow resumes the run if it exists and creates it otherwise.
+	ResumeAllow Resume = "allow"
+
+	// ResumeMust resumes the run and fails if it doesn't exist.
+	ResumeMust Resume = "must"
+
+	// ResumeNever creates the run and fails if it already exists.
+	ResumeNever Resume = "never"
+)
+
 type RunOption func(*RunParams)
 
 func WithConfig(config runconfig.Config) RunOption {
@@ -39,3 +54,13 @@ func WithProject(project string) RunOption {
 		p.Project = &project
 	}
 }
+
+// WithResume continues the run with the given ID if it already exists.
+//
+// A resumed run continues logging after its last step, and its previous
+// config and summary are available on the Run.
+func WithResume(resume Resume) RunOption {
+	return func(p *RunParams) {
+		p.Resume = &resume
+	}
+}
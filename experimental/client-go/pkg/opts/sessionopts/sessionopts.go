@@ -2,17 +2,107 @@
 package sessionopts
 
 import (
+	"time"
+
 	"github.com/wandb/wandb/experimental/client-go/pkg/settings"
 )
 
 type SessionParams struct {
 	CoreBinary []byte
 	Address    string
+	PortFile   string
+	CoreArgs   []string
 	Settings   *settings.SettingsWrap
+
+	// MaxRestarts is how many times to relaunch the core service if it
+	// stops responding.
+	MaxRestarts       int
+	HeartbeatInterval time.Duration
+	OnCoreStatus      func(CoreStatus, error)
+
+	// Multiplexed is whether the session's runs share one connection to
+	// the core service.
+	Multiplexed bool
+
+	// Transport is how the session connects to a core service it
+	// launches.
+	Transport Transport
+}
+
+// Transport is a way of connecting to the core service.
+type Transport string
+
+const (
+	// TransportDefault is a named pipe on Windows and localhost TCP
+	// elsewhere.
+	TransportDefault Transport = ""
+
+	// TransportTCP is a localhost TCP connection.
+	TransportTCP Transport = "tcp"
+
+	// TransportUnix is a Unix domain socket.
+	TransportUnix Transport = "unix"
+
+	// TransportPipe is a Windows named pipe.
+	TransportPipe Transport = "pipe"
+)
+
+// CoreStatus is a change in the health of the core service.
+type CoreStatus int
+
+const (
+	// CoreUnresponsive means the core service stopped accepting
+	// connections, probably because it crashed.
+	CoreUnresponsive CoreStatus = iota + 1
+
+	// CoreRestarted means the core service was relaunched and the
+	// session's unfinished runs were re-attached to it.
+	CoreRestarted
+
+	// CoreRestartFailed means the core service could not be relaunched,
+	// or a run could not be re-attached to it.
+	CoreRestartFailed
+)
+
+func (s CoreStatus) String() string {
+	switch s {
+	case CoreUnresponsive:
+		return "unresponsive"
+	case CoreRestarted:
+		return "restarted"
+	case CoreRestartFailed:
+		return "restart failed"
+	default:
+		return "unknown"
+	}
 }
 
 type SessionOption func(*SessionParams)
 
+// WithMultiplexing sends the records of all the session's runs over one
+// connection to the core service, instead of a connection per run.
+//
+// This lets a session host hundreds of concurrent runs, such as a run
+// per evaluation episode, without running out of file descriptors. Runs
+// take turns sending, so that one logging a lot doesn't hold up the
+// others, and each run still finishes on its own.
+func WithMultiplexing() SessionOption {
+	return func(s *SessionParams) {
+		s.Multiplexed = true
+	}
+}
+
+// WithTransport sets how the session connects to a core service it
+// launches.
+//
+// Sessions that connect to a running service use the transport the
+// service was started with.
+func WithTransport(transport Transport) SessionOption {
+	return func(s *SessionParams) {
+		s.Transport = transport
+	}
+}
+
 func WithCoreBinary(coreBinary []byte) SessionOption {
 	return func(s *SessionParams) {
 		s.CoreBinary = coreBinary
@@ -25,8 +115,58 @@ func WithCoreAddress(address string) SessionOption {
 	}
 }
 
+// WithCorePortFile shares a core service between processes.
+//
+// The session connects to the service whose port file is at the given
+// path, launching a new service that writes its port file there if none
+// is running. Closing the session leaves the service running for other
+// processes.
+func WithCorePortFile(path string) SessionOption {
+	return func(s *SessionParams) {
+		s.PortFile = path
+	}
+}
+
+// WithCoreArgs passes command-line arguments to the core service when
+// the session launches it.
+func WithCoreArgs(args ...string) SessionOption {
+	return func(s *SessionParams) {
+		s.CoreArgs = append(s.CoreArgs, args...)
+	}
+}
+
 func WithSettings(baseSettings *settings.SettingsWrap) SessionOption {
 	return func(s *SessionParams) {
 		s.Settings = baseSettings
 	}
 }
+
+// WithAutoRestart relaunches the core service up to maxRestarts times if
+// it stops responding.
+//
+// Unfinished runs are re-attached to the new service, which resumes them
+// and re-sends the history it didn't upload. This has no effect for
+// sessions connecting to a service by address, which they can't launch.
+func WithAutoRestart(maxRestarts int) SessionOption {
+	return func(s *SessionParams) {
+		s.MaxRestarts = maxRestarts
+	}
+}
+
+// WithHeartbeatInterval sets how often to check that the core service is
+// responding, if the session monitors it.
+func WithHeartbeatInterval(interval time.Duration) SessionOption {
+	return func(s *SessionParams) {
+		s.HeartbeatInterval = interval
+	}
+}
+
+// WithCoreStatusCallback monitors the core service, calling the callback
+// when its health changes.
+//
+// The callback is called from a separate goroutine.
+func WithCoreStatusCallback(callback func(CoreStatus, error)) SessionOption {
+	return func(s *SessionParams) {
+		s.OnCoreStatus = callback
+	}
+}
@@ -1,6 +1,7 @@
 import logging
 import os
 import random
+import sys
 import tempfile
 from multiprocessing import Pool
 from unittest.mock import MagicMock
@@ -12,7 +13,11 @@ from wandb.errors import term
 from wandb.sdk.artifacts.artifact import Artifact
 from wandb.sdk.artifacts.artifact_file_cache import ArtifactFileCache
 from wandb.sdk.artifacts.artifact_manifest_entry import ArtifactManifestEntry
-from wandb.sdk.artifacts.staging import get_staging_dir
+from wandb.sdk.artifacts.staging import (
+    get_staging_dir,
+    remove_staged_file,
+    stage_file,
+)
 from wandb.sdk.artifacts.storage_handler import StorageHandler
 from wandb.sdk.artifacts.storage_handlers.gcs_handler import GCSHandler
 from wandb.sdk.artifacts.storage_handlers.local_file_handler import LocalFileHandler
@@ -506,6 +511,28 @@ def test_unwritable_staging_dir(monkeypatch):
         _ = get_staging_dir()
 
 
+@pytest.mark.skipif(sys.platform == "win32", reason="no shared staging on Windows")
+def test_staged_files_share_content(monkeypatch, tmp_path):
+    monkeypatch.setenv("WANDB_DATA_DIR", str(tmp_path / "data"))
+    content_dir = tmp_path / "data" / "artifacts" / "staging-content"
+    source = tmp_path / "model.bin"
+    source.write_bytes(b"weights")
+
+    path1, digest1 = stage_file(source)
+    path2, digest2 = stage_file(source)
+
+    assert path1 != path2
+    assert digest1 == digest2 == md5_string("weights")
+    assert os.path.samefile(path1, path2)
+
+    remove_staged_file(path1, digest1)
+    assert not os.path.exists(path1)
+    assert os.path.exists(path2)
+
+    remove_staged_file(path2, digest2)
+    assert not any(f.is_file() for f in content_dir.rglob("*"))
+
+
 def test_invalid_upload_policy():
     path = "foo/bar"
     artifact = wandb.Artifact("test", type="dataset")
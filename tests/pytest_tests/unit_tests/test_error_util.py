@@ -1,4 +1,5 @@
 import pytest
+from wandb import errors
 from wandb.errors import Error
 from wandb.errors.util import ProtobufErrorHandler
 from wandb.proto import wandb_internal_pb2 as pb
@@ -16,6 +17,30 @@ def test_protobuf_error_handler(error, expected):
     assert isinstance(exc, expected)
 
 
+@pytest.mark.parametrize(
+    "code, expected",
+    [
+        (pb.ErrorInfo.COMMUNICATION, errors.CommError),
+        (pb.ErrorInfo.AUTHENTICATION, errors.AuthenticationError),
+        (pb.ErrorInfo.USAGE, errors.UsageError),
+        (pb.ErrorInfo.UNSUPPORTED, errors.UnsupportedError),
+        (pb.ErrorInfo.QUOTA_EXCEEDED, errors.QuotaExceededError),
+        (pb.ErrorInfo.STORAGE_DENIED, errors.StorageDeniedError),
+        (pb.ErrorInfo.RATE_LIMITED, errors.RateLimitedError),
+        (pb.ErrorInfo.SERVER_INCOMPATIBLE, errors.ServerIncompatibleError),
+        (pb.ErrorInfo.PERMISSION_DENIED, errors.PermissionDeniedError),
+        (pb.ErrorInfo.NOT_FOUND, errors.NotFoundError),
+    ],
+)
+def test_protobuf_error_handler_codes(code, expected):
+    exc = ProtobufErrorHandler.to_exception(pb.ErrorInfo(code=code, message="m"))
+    assert type(exc) is expected
+    assert exc.message == "m"
+
+    error = ProtobufErrorHandler.from_exception(exc)
+    assert error.code == code
+
+
 def test_protobuf_error_handler_exception():
     with pytest.raises(ValueError):
         ProtobufErrorHandler.from_exception(Exception(""))  # type: ignore
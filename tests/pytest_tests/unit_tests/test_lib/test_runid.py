@@ -11,3 +11,14 @@ def test_generate_id_is_base36():
 
 def test_generate_id_default_8_chars():
     assert len(runid.generate_id()) == 8
+
+
+def test_generate_idempotent_id_matches_core():
+    # These must agree with IdempotentID in wandb-core.
+    assert runid.generate_idempotent_id("slurm-1234") == "zq12n8r9wtc0"
+    assert runid.generate_idempotent_id("slurm-1234-retry-2", 8) == "isxsd0mf"
+    assert runid.generate_idempotent_id("ジョブ") == "nys3kp5xby6r"
+    assert (
+        runid.generate_idempotent_id("slurm-1234", 40)
+        == "zq12n8r9wtc0ea42fntdtwy91b3bpnb9"
+    )
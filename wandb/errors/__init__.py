@@ -4,6 +4,12 @@ __all__ = [
     "AuthenticationError",
     "UsageError",
     "UnsupportedError",
+    "QuotaExceededError",
+    "StorageDeniedError",
+    "RateLimitedError",
+    "ServerIncompatibleError",
+    "PermissionDeniedError",
+    "NotFoundError",
     "WandbCoreNotAvailableError",
 ]
 
@@ -42,5 +48,29 @@ class UnsupportedError(UsageError):
     """Raised when trying to use a feature that is not supported."""
 
 
+class QuotaExceededError(CommError):
+    """Raised when the entity is out of storage or tracked hours."""
+
+
+class StorageDeniedError(CommError):
+    """Raised when the storage bucket rejects an upload or download."""
+
+
+class RateLimitedError(CommError):
+    """Raised when the server keeps asking to slow down."""
+
+
+class ServerIncompatibleError(UnsupportedError):
+    """Raised when the server is too old for a feature that was used."""
+
+
+class PermissionDeniedError(CommError):
+    """Raised when the user may not write to the entity or project."""
+
+
+class NotFoundError(CommError):
+    """Raised when the entity, project or run doesn't exist."""
+
+
 class WandbCoreNotAvailableError(Error):
     """Raised when wandb core is not available."""
@@ -2,7 +2,19 @@ from typing import Optional
 
 from wandb.proto import wandb_internal_pb2 as pb
 
-from . import AuthenticationError, CommError, Error, UnsupportedError, UsageError
+from . import (
+    AuthenticationError,
+    CommError,
+    Error,
+    NotFoundError,
+    PermissionDeniedError,
+    QuotaExceededError,
+    RateLimitedError,
+    ServerIncompatibleError,
+    StorageDeniedError,
+    UnsupportedError,
+    UsageError,
+)
 
 to_exception_map = {
     pb.ErrorInfo.UNKNOWN: Error,
@@ -10,6 +22,12 @@ to_exception_map = {
     pb.ErrorInfo.AUTHENTICATION: AuthenticationError,
     pb.ErrorInfo.USAGE: UsageError,
     pb.ErrorInfo.UNSUPPORTED: UnsupportedError,
+    pb.ErrorInfo.QUOTA_EXCEEDED: QuotaExceededError,
+    pb.ErrorInfo.STORAGE_DENIED: StorageDeniedError,
+    pb.ErrorInfo.RATE_LIMITED: RateLimitedError,
+    pb.ErrorInfo.SERVER_INCOMPATIBLE: ServerIncompatibleError,
+    pb.ErrorInfo.PERMISSION_DENIED: PermissionDeniedError,
+    pb.ErrorInfo.NOT_FOUND: NotFoundError,
 }
 
 from_exception_map = {v: k for k, v in to_exception_map.items()}
@@ -46,6 +46,7 @@ class Record(google.protobuf.message.Message):
     TELEMETRY_FIELD_NUMBER: builtins.int
     METRIC_FIELD_NUMBER: builtins.int
     OUTPUT_RAW_FIELD_NUMBER: builtins.int
+    HISTORY_BATCH_FIELD_NUMBER: builtins.int
     RUN_FIELD_NUMBER: builtins.int
     EXIT_FIELD_NUMBER: builtins.int
     FINAL_FIELD_NUMBER: builtins.int
@@ -85,6 +86,8 @@ class Record(google.protobuf.message.Message):
     @property
     def output_raw(self) -> global___OutputRawRecord: ...
     @property
+    def history_batch(self) -> global___HistoryBatchRecord: ...
+    @property
     def run(self) -> global___RunRecord:
         """Higher numbers for less frequent data"""
     @property
@@ -125,6 +128,7 @@ class Record(google.protobuf.message.Message):
         telemetry: wandb.proto.wandb_telemetry_pb2.TelemetryRecord | None = ...,
         metric: global___MetricRecord | None = ...,
         output_raw: global___OutputRawRecord | None = ...,
+        history_batch: global___HistoryBatchRecord | None = ...,
         run: global___RunRecord | None = ...,
         exit: global___RunExitRecord | None = ...,
         final: global___FinalRecord | None = ...,
@@ -138,9 +142,9 @@ class Record(google.protobuf.message.Message):
         uuid: builtins.str = ...,
         _info: wandb.proto.wandb_base_pb2._RecordInfo | None = ...,
     ) -> None: ...
-    def HasField(self, field_name: typing_extensions.Literal["_info", b"_info", "alert", b"alert", "artifact", b"artifact", "config", b"config", "control", b"control", "exit", b"exit", "files", b"files", "final", b"final", "footer", b"footer", "header", b"header", "history", b"history", "link_artifact", b"link_artifact", "metric", b"metric", "output", b"output", "output_raw", b"output_raw", "preempting", b"preempting", "record_type", b"record_type", "request", b"request", "run", b"run", "stats", b"stats", "summary", b"summary", "tbrecord", b"tbrecord", "telemetry", b"telemetry", "use_artifact", b"use_artifact"]) -> builtins.bool: ...
-    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "alert", b"alert", "artifact", b"artifact", "config", b"config", "control", b"control", "exit", b"exit", "files", b"files", "final", b"final", "footer", b"footer", "header", b"header", "history", b"history", "link_artifact", b"link_artifact", "metric", b"metric", "num", b"num", "output", b"output", "output_raw", b"output_raw", "preempting", b"preempting", "record_type", b"record_type", "request", b"request", "run", b"run", "stats", b"stats", "summary", b"summary", "tbrecord", b"tbrecord", "telemetry", b"telemetry", "use_artifact", b"use_artifact", "uuid", b"uuid"]) -> None: ...
-    def WhichOneof(self, oneof_group: typing_extensions.Literal["record_type", b"record_type"]) -> typing_extensions.Literal["history", "summary", "output", "config", "files", "stats", "artifact", "tbrecord", "alert", "telemetry", "metric", "output_raw", "run", "exit", "final", "header", "footer", "preempting", "link_artifact", "use_artifact", "request"] | None: ...
+    def HasField(self, field_name: typing_extensions.Literal["_info", b"_info", "alert", b"alert", "artifact", b"artifact", "config", b"config", "control", b"control", "exit", b"exit", "files", b"files", "final", b"final", "footer", b"footer", "header", b"header", "history", b"history", "history_batch", b"history_batch", "link_artifact", b"link_artifact", "metric", b"metric", "output", b"output", "output_raw", b"output_raw", "preempting", b"preempting", "record_type", b"record_type", "request", b"request", "run", b"run", "stats", b"stats", "summary", b"summary", "tbrecord", b"tbrecord", "telemetry", b"telemetry", "use_artifact", b"use_artifact"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "alert", b"alert", "artifact", b"artifact", "config", b"config", "control", b"control", "exit", b"exit", "files", b"files", "final", b"final", "footer", b"footer", "header", b"header", "history", b"history", "history_batch", b"history_batch", "link_artifact", b"link_artifact", "metric", b"metric", "num", b"num", "output", b"output", "output_raw", b"output_raw", "preempting", b"preempting", "record_type", b"record_type", "request", b"request", "run", b"run", "stats", b"stats", "summary", b"summary", "tbrecord", b"tbrecord", "telemetry", b"telemetry", "use_artifact", b"use_artifact", "uuid", b"uuid"]) -> None: ...
+    def WhichOneof(self, oneof_group: typing_extensions.Literal["record_type", b"record_type"]) -> typing_extensions.Literal["history", "summary", "output", "config", "files", "stats", "artifact", "tbrecord", "alert", "telemetry", "metric", "output_raw", "history_batch", "run", "exit", "final", "header", "footer", "preempting", "link_artifact", "use_artifact", "request"] | None: ...
 
 global___Record = Record
 
@@ -439,15 +443,23 @@ class GitRepoRecord(google.protobuf.message.Message):
 
     REMOTE_URL_FIELD_NUMBER: builtins.int
     COMMIT_FIELD_NUMBER: builtins.int
+    BRANCH_FIELD_NUMBER: builtins.int
+    DIRTY_FIELD_NUMBER: builtins.int
     remote_url: builtins.str
     commit: builtins.str
+    branch: builtins.str
+    """The checked out branch, or empty if the HEAD is detached."""
+    dirty: builtins.bool
+    """Whether tracked files have uncommitted changes."""
     def __init__(
         self,
         *,
         remote_url: builtins.str = ...,
         commit: builtins.str = ...,
+        branch: builtins.str = ...,
+        dirty: builtins.bool = ...,
     ) -> None: ...
-    def ClearField(self, field_name: typing_extensions.Literal["commit", b"commit", "remote_url", b"remote_url"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["branch", b"branch", "commit", b"commit", "dirty", b"dirty", "remote_url", b"remote_url"]) -> None: ...
 
 global___GitRepoRecord = GitRepoRecord
 
@@ -487,6 +499,18 @@ class ErrorInfo(google.protobuf.message.Message):
         AUTHENTICATION: ErrorInfo._ErrorCode.ValueType  # 2
         USAGE: ErrorInfo._ErrorCode.ValueType  # 3
         UNSUPPORTED: ErrorInfo._ErrorCode.ValueType  # 4
+        QUOTA_EXCEEDED: ErrorInfo._ErrorCode.ValueType  # 5
+        """The entity is out of storage or tracked hours."""
+        STORAGE_DENIED: ErrorInfo._ErrorCode.ValueType  # 6
+        """The storage bucket rejected an upload or download."""
+        RATE_LIMITED: ErrorInfo._ErrorCode.ValueType  # 7
+        """The server asked to slow down and retries ran out."""
+        SERVER_INCOMPATIBLE: ErrorInfo._ErrorCode.ValueType  # 8
+        """The server is too old for a feature that was used."""
+        PERMISSION_DENIED: ErrorInfo._ErrorCode.ValueType  # 9
+        """The user may not write to the entity or project."""
+        NOT_FOUND: ErrorInfo._ErrorCode.ValueType  # 10
+        """The entity, project or run doesn't exist."""
 
     class ErrorCode(_ErrorCode, metaclass=_ErrorCodeEnumTypeWrapper): ...
     UNKNOWN: ErrorInfo.ErrorCode.ValueType  # 0
@@ -494,6 +518,18 @@ class ErrorInfo(google.protobuf.message.Message):
     AUTHENTICATION: ErrorInfo.ErrorCode.ValueType  # 2
     USAGE: ErrorInfo.ErrorCode.ValueType  # 3
     UNSUPPORTED: ErrorInfo.ErrorCode.ValueType  # 4
+    QUOTA_EXCEEDED: ErrorInfo.ErrorCode.ValueType  # 5
+    """The entity is out of storage or tracked hours."""
+    STORAGE_DENIED: ErrorInfo.ErrorCode.ValueType  # 6
+    """The storage bucket rejected an upload or download."""
+    RATE_LIMITED: ErrorInfo.ErrorCode.ValueType  # 7
+    """The server asked to slow down and retries ran out."""
+    SERVER_INCOMPATIBLE: ErrorInfo.ErrorCode.ValueType  # 8
+    """The server is too old for a feature that was used."""
+    PERMISSION_DENIED: ErrorInfo.ErrorCode.ValueType  # 9
+    """The user may not write to the entity or project."""
+    NOT_FOUND: ErrorInfo.ErrorCode.ValueType  # 10
+    """The entity, project or run doesn't exist."""
 
     MESSAGE_FIELD_NUMBER: builtins.int
     CODE_FIELD_NUMBER: builtins.int
@@ -704,6 +740,73 @@ class HistoryResult(google.protobuf.message.Message):
 
 global___HistoryResult = HistoryResult
 
+@typing_extensions.final
+class HistoryBatchRecord(google.protobuf.message.Message):
+    """
+    HistoryBatchRecord: many steps of history in a columnar layout
+
+    Logging many steps of a few scalar metrics, like a loss logged at a high
+    frequency, as one record per step spends most of the time on per-record
+    overhead. A batch is processed as if each of its rows had been logged as
+    a separate history record.
+    """
+
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    STEP_FIELD_NUMBER: builtins.int
+    COLUMN_FIELD_NUMBER: builtins.int
+    _INFO_FIELD_NUMBER: builtins.int
+    @property
+    def step(self) -> google.protobuf.internal.containers.RepeatedScalarFieldContainer[builtins.int]:
+        """The step of each row, in increasing order."""
+    @property
+    def column(self) -> google.protobuf.internal.containers.RepeatedCompositeFieldContainer[global___HistoryBatchColumn]: ...
+    @property
+    def _info(self) -> wandb.proto.wandb_base_pb2._RecordInfo: ...
+    def __init__(
+        self,
+        *,
+        step: collections.abc.Iterable[builtins.int] | None = ...,
+        column: collections.abc.Iterable[global___HistoryBatchColumn] | None = ...,
+        _info: wandb.proto.wandb_base_pb2._RecordInfo | None = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["_info", b"_info"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "column", b"column", "step", b"step"]) -> None: ...
+
+global___HistoryBatchRecord = HistoryBatchRecord
+
+@typing_extensions.final
+class HistoryBatchColumn(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    KEY_FIELD_NUMBER: builtins.int
+    NESTED_KEY_FIELD_NUMBER: builtins.int
+    VALUE_FIELD_NUMBER: builtins.int
+    MISSING_FIELD_NUMBER: builtins.int
+    key: builtins.str
+    """The metric's name; nested_key is used instead for nested metrics."""
+    @property
+    def nested_key(self) -> google.protobuf.internal.containers.RepeatedScalarFieldContainer[builtins.str]: ...
+    @property
+    def value(self) -> google.protobuf.internal.containers.RepeatedScalarFieldContainer[builtins.float]:
+        """The metric's value at each row."""
+    @property
+    def missing(self) -> google.protobuf.internal.containers.RepeatedScalarFieldContainer[builtins.int]:
+        """Indices of the rows at which the metric wasn't logged, whose values
+        are ignored.
+        """
+    def __init__(
+        self,
+        *,
+        key: builtins.str = ...,
+        nested_key: collections.abc.Iterable[builtins.str] | None = ...,
+        value: collections.abc.Iterable[builtins.float] | None = ...,
+        missing: collections.abc.Iterable[builtins.int] | None = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["key", b"key", "missing", b"missing", "nested_key", b"nested_key", "value", b"value"]) -> None: ...
+
+global___HistoryBatchColumn = HistoryBatchColumn
+
 @typing_extensions.final
 class OutputRecord(google.protobuf.message.Message):
     """
@@ -782,11 +885,16 @@ class OutputRawRecord(google.protobuf.message.Message):
     OUTPUT_TYPE_FIELD_NUMBER: builtins.int
     TIMESTAMP_FIELD_NUMBER: builtins.int
     LINE_FIELD_NUMBER: builtins.int
+    SOURCE_FIELD_NUMBER: builtins.int
     _INFO_FIELD_NUMBER: builtins.int
     output_type: global___OutputRawRecord.OutputType.ValueType
     @property
     def timestamp(self) -> google.protobuf.timestamp_pb2.Timestamp: ...
     line: builtins.str
+    source: builtins.str
+    """The process that produced the output, such as "pid:1234" for a
+    child process. Empty for the user process itself.
+    """
     @property
     def _info(self) -> wandb.proto.wandb_base_pb2._RecordInfo: ...
     def __init__(
@@ -795,10 +903,11 @@ class OutputRawRecord(google.protobuf.message.Message):
         output_type: global___OutputRawRecord.OutputType.ValueType = ...,
         timestamp: google.protobuf.timestamp_pb2.Timestamp | None = ...,
         line: builtins.str = ...,
+        source: builtins.str = ...,
         _info: wandb.proto.wandb_base_pb2._RecordInfo | None = ...,
     ) -> None: ...
     def HasField(self, field_name: typing_extensions.Literal["_info", b"_info", "timestamp", b"timestamp"]) -> builtins.bool: ...
-    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "line", b"line", "output_type", b"output_type", "timestamp", b"timestamp"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "line", b"line", "output_type", b"output_type", "source", b"source", "timestamp", b"timestamp"]) -> None: ...
 
 global___OutputRawRecord = OutputRawRecord
 
@@ -1168,20 +1277,32 @@ class FilesItem(google.protobuf.message.Message):
     PATH_FIELD_NUMBER: builtins.int
     POLICY_FIELD_NUMBER: builtins.int
     TYPE_FIELD_NUMBER: builtins.int
+    EXCLUDE_FIELD_NUMBER: builtins.int
     path: builtins.str
-    """A path or Unix glob relative to the W&B files directory."""
+    """A path or Unix glob relative to the W&B files directory.
+
+    A "**" path segment in a glob matches any number of directories.
+    Files that match a glob later, such as new files, are saved with its
+    policy too.
+    """
     policy: global___FilesItem.PolicyType.ValueType
     """When to upload the file."""
     type: global___FilesItem.FileType.ValueType
     """What kind of file it is."""
+    @property
+    def exclude(self) -> google.protobuf.internal.containers.RepeatedScalarFieldContainer[builtins.str]:
+        """Unix globs relative to the W&B files directory of files to not save,
+        if the path is a glob.
+        """
     def __init__(
         self,
         *,
         path: builtins.str = ...,
         policy: global___FilesItem.PolicyType.ValueType = ...,
         type: global___FilesItem.FileType.ValueType = ...,
+        exclude: collections.abc.Iterable[builtins.str] | None = ...,
     ) -> None: ...
-    def ClearField(self, field_name: typing_extensions.Literal["path", b"path", "policy", b"policy", "type", b"type"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["exclude", b"exclude", "path", b"path", "policy", b"policy", "type", b"type"]) -> None: ...
 
 global___FilesItem = FilesItem
 
@@ -1651,6 +1772,7 @@ class Request(google.protobuf.message.Message):
     GET_SYSTEM_METRICS_FIELD_NUMBER: builtins.int
     SYNC_FIELD_NUMBER: builtins.int
     JOB_INPUT_FIELD_NUMBER: builtins.int
+    UPDATE_SETTINGS_FIELD_NUMBER: builtins.int
     TEST_INJECT_FIELD_NUMBER: builtins.int
     @property
     def stop_status(self) -> global___StopStatusRequest: ...
@@ -1719,6 +1841,8 @@ class Request(google.protobuf.message.Message):
     @property
     def job_input(self) -> global___JobInputRequest: ...
     @property
+    def update_settings(self) -> global___UpdateSettingsRequest: ...
+    @property
     def test_inject(self) -> global___TestInjectRequest: ...
     def __init__(
         self,
@@ -1756,11 +1880,12 @@ class Request(google.protobuf.message.Message):
         get_system_metrics: global___GetSystemMetricsRequest | None = ...,
         sync: global___SyncRequest | None = ...,
         job_input: global___JobInputRequest | None = ...,
+        update_settings: global___UpdateSettingsRequest | None = ...,
         test_inject: global___TestInjectRequest | None = ...,
     ) -> None: ...
-    def HasField(self, field_name: typing_extensions.Literal["attach", b"attach", "cancel", b"cancel", "check_version", b"check_version", "defer", b"defer", "download_artifact", b"download_artifact", "get_summary", b"get_summary", "get_system_metrics", b"get_system_metrics", "internal_messages", b"internal_messages", "job_info", b"job_info", "job_input", b"job_input", "keepalive", b"keepalive", "log_artifact", b"log_artifact", "login", b"login", "metadata", b"metadata", "network_status", b"network_status", "partial_history", b"partial_history", "pause", b"pause", "poll_exit", b"poll_exit", "python_packages", b"python_packages", "request_type", b"request_type", "resume", b"resume", "run_start", b"run_start", "run_status", b"run_status", "sampled_history", b"sampled_history", "sender_mark", b"sender_mark", "sender_read", b"sender_read", "server_info", b"server_info", "shutdown", b"shutdown", "status", b"status", "status_report", b"status_report", "stop_status", b"stop_status", "summary_record", b"summary_record", "sync", b"sync", "telemetry_record", b"telemetry_record", "test_inject", b"test_inject"]) -> builtins.bool: ...
-    def ClearField(self, field_name: typing_extensions.Literal["attach", b"attach", "cancel", b"cancel", "check_version", b"check_version", "defer", b"defer", "download_artifact", b"download_artifact", "get_summary", b"get_summary", "get_system_metrics", b"get_system_metrics", "internal_messages", b"internal_messages", "job_info", b"job_info", "job_input", b"job_input", "keepalive", b"keepalive", "log_artifact", b"log_artifact", "login", b"login", "metadata", b"metadata", "network_status", b"network_status", "partial_history", b"partial_history", "pause", b"pause", "poll_exit", b"poll_exit", "python_packages", b"python_packages", "request_type", b"request_type", "resume", b"resume", "run_start", b"run_start", "run_status", b"run_status", "sampled_history", b"sampled_history", "sender_mark", b"sender_mark", "sender_read", b"sender_read", "server_info", b"server_info", "shutdown", b"shutdown", "status", b"status", "status_report", b"status_report", "stop_status", b"stop_status", "summary_record", b"summary_record", "sync", b"sync", "telemetry_record", b"telemetry_record", "test_inject", b"test_inject"]) -> None: ...
-    def WhichOneof(self, oneof_group: typing_extensions.Literal["request_type", b"request_type"]) -> typing_extensions.Literal["stop_status", "network_status", "defer", "get_summary", "login", "pause", "resume", "poll_exit", "sampled_history", "partial_history", "run_start", "check_version", "log_artifact", "download_artifact", "keepalive", "run_status", "cancel", "metadata", "internal_messages", "python_packages", "shutdown", "attach", "status", "server_info", "sender_mark", "sender_read", "status_report", "summary_record", "telemetry_record", "job_info", "get_system_metrics", "sync", "job_input", "test_inject"] | None: ...
+    def HasField(self, field_name: typing_extensions.Literal["attach", b"attach", "cancel", b"cancel", "check_version", b"check_version", "defer", b"defer", "download_artifact", b"download_artifact", "get_summary", b"get_summary", "get_system_metrics", b"get_system_metrics", "internal_messages", b"internal_messages", "job_info", b"job_info", "job_input", b"job_input", "keepalive", b"keepalive", "log_artifact", b"log_artifact", "login", b"login", "metadata", b"metadata", "network_status", b"network_status", "partial_history", b"partial_history", "pause", b"pause", "poll_exit", b"poll_exit", "python_packages", b"python_packages", "request_type", b"request_type", "resume", b"resume", "run_start", b"run_start", "run_status", b"run_status", "sampled_history", b"sampled_history", "sender_mark", b"sender_mark", "sender_read", b"sender_read", "server_info", b"server_info", "shutdown", b"shutdown", "status", b"status", "status_report", b"status_report", "stop_status", b"stop_status", "summary_record", b"summary_record", "sync", b"sync", "telemetry_record", b"telemetry_record", "test_inject", b"test_inject", "update_settings", b"update_settings"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["attach", b"attach", "cancel", b"cancel", "check_version", b"check_version", "defer", b"defer", "download_artifact", b"download_artifact", "get_summary", b"get_summary", "get_system_metrics", b"get_system_metrics", "internal_messages", b"internal_messages", "job_info", b"job_info", "job_input", b"job_input", "keepalive", b"keepalive", "log_artifact", b"log_artifact", "login", b"login", "metadata", b"metadata", "network_status", b"network_status", "partial_history", b"partial_history", "pause", b"pause", "poll_exit", b"poll_exit", "python_packages", b"python_packages", "request_type", b"request_type", "resume", b"resume", "run_start", b"run_start", "run_status", b"run_status", "sampled_history", b"sampled_history", "sender_mark", b"sender_mark", "sender_read", b"sender_read", "server_info", b"server_info", "shutdown", b"shutdown", "status", b"status", "status_report", b"status_report", "stop_status", b"stop_status", "summary_record", b"summary_record", "sync", b"sync", "telemetry_record", b"telemetry_record", "test_inject", b"test_inject", "update_settings", b"update_settings"]) -> None: ...
+    def WhichOneof(self, oneof_group: typing_extensions.Literal["request_type", b"request_type"]) -> typing_extensions.Literal["stop_status", "network_status", "defer", "get_summary", "login", "pause", "resume", "poll_exit", "sampled_history", "partial_history", "run_start", "check_version", "log_artifact", "download_artifact", "keepalive", "run_status", "cancel", "metadata", "internal_messages", "python_packages", "shutdown", "attach", "status", "server_info", "sender_mark", "sender_read", "status_report", "summary_record", "telemetry_record", "job_info", "get_system_metrics", "sync", "job_input", "update_settings", "test_inject"] | None: ...
 
 global___Request = Request
 
@@ -1870,6 +1995,41 @@ class Response(google.protobuf.message.Message):
 
 global___Response = Response
 
+@typing_extensions.final
+class UpdateSettingsRequest(google.protobuf.message.Message):
+    """
+    UpdateSettingsRequest: change settings of a run in progress
+
+    Unset fields leave the corresponding setting unchanged.
+    """
+
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    STATS_SAMPLE_RATE_SECONDS_FIELD_NUMBER: builtins.int
+    CONSOLE_FIELD_NUMBER: builtins.int
+    LOG_LEVEL_FIELD_NUMBER: builtins.int
+    _INFO_FIELD_NUMBER: builtins.int
+    stats_sample_rate_seconds: builtins.float
+    """Seconds between system metrics samples."""
+    console: builtins.str
+    """Console capture mode; "off" stops recording console output."""
+    log_level: builtins.str
+    """Level of wandb-core's debug log: "debug", "info", "warn" or "error"."""
+    @property
+    def _info(self) -> wandb.proto.wandb_base_pb2._RequestInfo: ...
+    def __init__(
+        self,
+        *,
+        stats_sample_rate_seconds: builtins.float = ...,
+        console: builtins.str = ...,
+        log_level: builtins.str = ...,
+        _info: wandb.proto.wandb_base_pb2._RequestInfo | None = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["_info", b"_info"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "console", b"console", "log_level", b"log_level", "stats_sample_rate_seconds", b"stats_sample_rate_seconds"]) -> None: ...
+
+global___UpdateSettingsRequest = UpdateSettingsRequest
+
 @typing_extensions.final
 class DeferRequest(google.protobuf.message.Message):
     """
@@ -2320,14 +2480,18 @@ class InternalMessages(google.protobuf.message.Message):
     DESCRIPTOR: google.protobuf.descriptor.Descriptor
 
     WARNING_FIELD_NUMBER: builtins.int
+    ERROR_FIELD_NUMBER: builtins.int
     @property
     def warning(self) -> google.protobuf.internal.containers.RepeatedScalarFieldContainer[builtins.str]: ...
+    @property
+    def error(self) -> google.protobuf.internal.containers.RepeatedCompositeFieldContainer[global___ErrorInfo]: ...
     def __init__(
         self,
         *,
         warning: collections.abc.Iterable[builtins.str] | None = ...,
+        error: collections.abc.Iterable[global___ErrorInfo] | None = ...,
     ) -> None: ...
-    def ClearField(self, field_name: typing_extensions.Literal["warning", b"warning"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["error", b"error", "warning", b"warning"]) -> None: ...
 
 global___InternalMessages = InternalMessages
 
@@ -3276,6 +3440,7 @@ class DownloadArtifactRequest(google.protobuf.message.Message):
     ALLOW_MISSING_REFERENCES_FIELD_NUMBER: builtins.int
     SKIP_CACHE_FIELD_NUMBER: builtins.int
     PATH_PREFIX_FIELD_NUMBER: builtins.int
+    PATH_GLOBS_FIELD_NUMBER: builtins.int
     _INFO_FIELD_NUMBER: builtins.int
     artifact_id: builtins.str
     download_root: builtins.str
@@ -3283,6 +3448,11 @@ class DownloadArtifactRequest(google.protobuf.message.Message):
     skip_cache: builtins.bool
     path_prefix: builtins.str
     @property
+    def path_globs(self) -> google.protobuf.internal.containers.RepeatedScalarFieldContainer[builtins.str]:
+        """Patterns of which the paths of the files to download must match one.
+        A "**" path segment matches any number of directories.
+        """
+    @property
     def _info(self) -> wandb.proto.wandb_base_pb2._RequestInfo: ...
     def __init__(
         self,
@@ -3292,10 +3462,11 @@ class DownloadArtifactRequest(google.protobuf.message.Message):
         allow_missing_references: builtins.bool = ...,
         skip_cache: builtins.bool = ...,
         path_prefix: builtins.str = ...,
+        path_globs: collections.abc.Iterable[builtins.str] | None = ...,
         _info: wandb.proto.wandb_base_pb2._RequestInfo | None = ...,
     ) -> None: ...
     def HasField(self, field_name: typing_extensions.Literal["_info", b"_info"]) -> builtins.bool: ...
-    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "allow_missing_references", b"allow_missing_references", "artifact_id", b"artifact_id", "download_root", b"download_root", "path_prefix", b"path_prefix", "skip_cache", b"skip_cache"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "allow_missing_references", b"allow_missing_references", "artifact_id", b"artifact_id", "download_root", b"download_root", "path_globs", b"path_globs", "path_prefix", b"path_prefix", "skip_cache", b"skip_cache"]) -> None: ...
 
 global___DownloadArtifactRequest = DownloadArtifactRequest
 
@@ -3739,6 +3910,138 @@ class GpuAmdInfo(google.protobuf.message.Message):
 
 global___GpuAmdInfo = GpuAmdInfo
 
+@typing_extensions.final
+class ContainerInfo(google.protobuf.message.Message):
+    """The container a run's process is in."""
+
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    RUNTIME_FIELD_NUMBER: builtins.int
+    ID_FIELD_NUMBER: builtins.int
+    IMAGE_FIELD_NUMBER: builtins.int
+    IMAGE_DIGEST_FIELD_NUMBER: builtins.int
+    CPU_LIMIT_FIELD_NUMBER: builtins.int
+    MEMORY_LIMIT_FIELD_NUMBER: builtins.int
+    runtime: builtins.str
+    """The container runtime, like "docker", "containerd" or "podman"."""
+    id: builtins.str
+    """The container's ID."""
+    image: builtins.str
+    """The container's image, if known."""
+    image_digest: builtins.str
+    """The digest of the container's image, like "sha256:...", if known."""
+    cpu_limit: builtins.float
+    """The cgroup's CPU quota in CPUs, or 0 if there is none."""
+    memory_limit: builtins.int
+    """The cgroup's memory limit in bytes, or 0 if there is none."""
+    def __init__(
+        self,
+        *,
+        runtime: builtins.str = ...,
+        id: builtins.str = ...,
+        image: builtins.str = ...,
+        image_digest: builtins.str = ...,
+        cpu_limit: builtins.float = ...,
+        memory_limit: builtins.int = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["cpu_limit", b"cpu_limit", "id", b"id", "image", b"image", "image_digest", b"image_digest", "memory_limit", b"memory_limit", "runtime", b"runtime"]) -> None: ...
+
+global___ContainerInfo = ContainerInfo
+
+@typing_extensions.final
+class KubernetesInfo(google.protobuf.message.Message):
+    """The Kubernetes pod a run's process is in.
+
+    Fields other than the namespace come from the downward API, as the
+    POD_NAME, POD_UID and NODE_NAME environment variables and the files of
+    a volume mounted at /etc/podinfo.
+    """
+
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    @typing_extensions.final
+    class RequestsEntry(google.protobuf.message.Message):
+        DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+        KEY_FIELD_NUMBER: builtins.int
+        VALUE_FIELD_NUMBER: builtins.int
+        key: builtins.str
+        value: builtins.str
+        def __init__(
+            self,
+            *,
+            key: builtins.str = ...,
+            value: builtins.str = ...,
+        ) -> None: ...
+        def ClearField(self, field_name: typing_extensions.Literal["key", b"key", "value", b"value"]) -> None: ...
+
+    @typing_extensions.final
+    class LimitsEntry(google.protobuf.message.Message):
+        DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+        KEY_FIELD_NUMBER: builtins.int
+        VALUE_FIELD_NUMBER: builtins.int
+        key: builtins.str
+        value: builtins.str
+        def __init__(
+            self,
+            *,
+            key: builtins.str = ...,
+            value: builtins.str = ...,
+        ) -> None: ...
+        def ClearField(self, field_name: typing_extensions.Literal["key", b"key", "value", b"value"]) -> None: ...
+
+    @typing_extensions.final
+    class LabelsEntry(google.protobuf.message.Message):
+        DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+        KEY_FIELD_NUMBER: builtins.int
+        VALUE_FIELD_NUMBER: builtins.int
+        key: builtins.str
+        value: builtins.str
+        def __init__(
+            self,
+            *,
+            key: builtins.str = ...,
+            value: builtins.str = ...,
+        ) -> None: ...
+        def ClearField(self, field_name: typing_extensions.Literal["key", b"key", "value", b"value"]) -> None: ...
+
+    POD_NAME_FIELD_NUMBER: builtins.int
+    NAMESPACE_FIELD_NUMBER: builtins.int
+    NODE_NAME_FIELD_NUMBER: builtins.int
+    POD_UID_FIELD_NUMBER: builtins.int
+    REQUESTS_FIELD_NUMBER: builtins.int
+    LIMITS_FIELD_NUMBER: builtins.int
+    LABELS_FIELD_NUMBER: builtins.int
+    pod_name: builtins.str
+    namespace: builtins.str
+    node_name: builtins.str
+    pod_uid: builtins.str
+    @property
+    def requests(self) -> google.protobuf.internal.containers.ScalarMap[builtins.str, builtins.str]:
+        """The container's resource requests and limits by resource, like "cpu"
+        or "memory", in the units of the downward API.
+        """
+    @property
+    def limits(self) -> google.protobuf.internal.containers.ScalarMap[builtins.str, builtins.str]: ...
+    @property
+    def labels(self) -> google.protobuf.internal.containers.ScalarMap[builtins.str, builtins.str]: ...
+    def __init__(
+        self,
+        *,
+        pod_name: builtins.str = ...,
+        namespace: builtins.str = ...,
+        node_name: builtins.str = ...,
+        pod_uid: builtins.str = ...,
+        requests: collections.abc.Mapping[builtins.str, builtins.str] | None = ...,
+        limits: collections.abc.Mapping[builtins.str, builtins.str] | None = ...,
+        labels: collections.abc.Mapping[builtins.str, builtins.str] | None = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["labels", b"labels", "limits", b"limits", "namespace", b"namespace", "node_name", b"node_name", "pod_name", b"pod_name", "pod_uid", b"pod_uid", "requests", b"requests"]) -> None: ...
+
+global___KubernetesInfo = KubernetesInfo
+
 @typing_extensions.final
 class MetadataRequest(google.protobuf.message.Message):
     DESCRIPTOR: google.protobuf.descriptor.Descriptor
@@ -3777,6 +4080,22 @@ class MetadataRequest(google.protobuf.message.Message):
         ) -> None: ...
         def ClearField(self, field_name: typing_extensions.Literal["key", b"key", "value", b"value"]) -> None: ...
 
+    @typing_extensions.final
+    class PbsEntry(google.protobuf.message.Message):
+        DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+        KEY_FIELD_NUMBER: builtins.int
+        VALUE_FIELD_NUMBER: builtins.int
+        key: builtins.str
+        value: builtins.str
+        def __init__(
+            self,
+            *,
+            key: builtins.str = ...,
+            value: builtins.str = ...,
+        ) -> None: ...
+        def ClearField(self, field_name: typing_extensions.Literal["key", b"key", "value", b"value"]) -> None: ...
+
     OS_FIELD_NUMBER: builtins.int
     PYTHON_FIELD_NUMBER: builtins.int
     HEARTBEATAT_FIELD_NUMBER: builtins.int
@@ -3806,6 +4125,9 @@ class MetadataRequest(google.protobuf.message.Message):
     GPU_NVIDIA_FIELD_NUMBER: builtins.int
     GPU_AMD_FIELD_NUMBER: builtins.int
     SLURM_FIELD_NUMBER: builtins.int
+    CONTAINER_FIELD_NUMBER: builtins.int
+    KUBERNETES_FIELD_NUMBER: builtins.int
+    PBS_FIELD_NUMBER: builtins.int
     os: builtins.str
     python: builtins.str
     @property
@@ -3846,6 +4168,12 @@ class MetadataRequest(google.protobuf.message.Message):
     def gpu_amd(self) -> google.protobuf.internal.containers.RepeatedCompositeFieldContainer[global___GpuAmdInfo]: ...
     @property
     def slurm(self) -> google.protobuf.internal.containers.ScalarMap[builtins.str, builtins.str]: ...
+    @property
+    def container(self) -> global___ContainerInfo: ...
+    @property
+    def kubernetes(self) -> global___KubernetesInfo: ...
+    @property
+    def pbs(self) -> google.protobuf.internal.containers.ScalarMap[builtins.str, builtins.str]: ...
     def __init__(
         self,
         *,
@@ -3878,9 +4206,12 @@ class MetadataRequest(google.protobuf.message.Message):
         gpu_nvidia: collections.abc.Iterable[global___GpuNvidiaInfo] | None = ...,
         gpu_amd: collections.abc.Iterable[global___GpuAmdInfo] | None = ...,
         slurm: collections.abc.Mapping[builtins.str, builtins.str] | None = ...,
+        container: global___ContainerInfo | None = ...,
+        kubernetes: global___KubernetesInfo | None = ...,
+        pbs: collections.abc.Mapping[builtins.str, builtins.str] | None = ...,
     ) -> None: ...
-    def HasField(self, field_name: typing_extensions.Literal["cpu", b"cpu", "git", b"git", "gpu_apple", b"gpu_apple", "heartbeatAt", b"heartbeatAt", "memory", b"memory", "startedAt", b"startedAt"]) -> builtins.bool: ...
-    def ClearField(self, field_name: typing_extensions.Literal["args", b"args", "code_path", b"code_path", "code_path_local", b"code_path_local", "colab", b"colab", "cpu", b"cpu", "cpu_count", b"cpu_count", "cpu_count_logical", b"cpu_count_logical", "cuda", b"cuda", "disk", b"disk", "docker", b"docker", "email", b"email", "executable", b"executable", "git", b"git", "gpu_amd", b"gpu_amd", "gpu_apple", b"gpu_apple", "gpu_count", b"gpu_count", "gpu_nvidia", b"gpu_nvidia", "gpu_type", b"gpu_type", "heartbeatAt", b"heartbeatAt", "host", b"host", "memory", b"memory", "os", b"os", "program", b"program", "python", b"python", "root", b"root", "slurm", b"slurm", "startedAt", b"startedAt", "state", b"state", "username", b"username"]) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["container", b"container", "cpu", b"cpu", "git", b"git", "gpu_apple", b"gpu_apple", "heartbeatAt", b"heartbeatAt", "kubernetes", b"kubernetes", "memory", b"memory", "startedAt", b"startedAt"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["args", b"args", "code_path", b"code_path", "code_path_local", b"code_path_local", "colab", b"colab", "container", b"container", "cpu", b"cpu", "cpu_count", b"cpu_count", "cpu_count_logical", b"cpu_count_logical", "cuda", b"cuda", "disk", b"disk", "docker", b"docker", "email", b"email", "executable", b"executable", "git", b"git", "gpu_amd", b"gpu_amd", "gpu_apple", b"gpu_apple", "gpu_count", b"gpu_count", "gpu_nvidia", b"gpu_nvidia", "gpu_type", b"gpu_type", "heartbeatAt", b"heartbeatAt", "host", b"host", "kubernetes", b"kubernetes", "memory", b"memory", "os", b"os", "pbs", b"pbs", "program", b"program", "python", b"python", "root", b"root", "slurm", b"slurm", "startedAt", b"startedAt", "state", b"state", "username", b"username"]) -> None: ...
 
 global___MetadataRequest = MetadataRequest
 
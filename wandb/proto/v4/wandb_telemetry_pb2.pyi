@@ -35,6 +35,10 @@ class TelemetryRecord(google.protobuf.message.Message):
     ISSUES_FIELD_NUMBER: builtins.int
     CORE_VERSION_FIELD_NUMBER: builtins.int
     PLATFORM_FIELD_NUMBER: builtins.int
+    MEDIA_SAMPLED_OUT_FIELD_NUMBER: builtins.int
+    MEDIA_ROTATED_FIELD_NUMBER: builtins.int
+    CONFIG_ITEMS_DROPPED_FIELD_NUMBER: builtins.int
+    SUMMARY_ITEMS_DROPPED_FIELD_NUMBER: builtins.int
     _INFO_FIELD_NUMBER: builtins.int
     @property
     def imports_init(self) -> global___Imports: ...
@@ -56,6 +60,14 @@ class TelemetryRecord(google.protobuf.message.Message):
     def issues(self) -> global___Issues: ...
     core_version: builtins.str
     platform: builtins.str
+    media_sampled_out: builtins.int
+    """media files dropped by _media_sample_steps"""
+    media_rotated: builtins.int
+    """media files deleted by _media_keep_last"""
+    config_items_dropped: builtins.int
+    """config items over the key limits"""
+    summary_items_dropped: builtins.int
+    """summary items over the key limits"""
     @property
     def _info(self) -> wandb.proto.wandb_base_pb2._RecordInfo: ...
     def __init__(
@@ -73,10 +85,14 @@ class TelemetryRecord(google.protobuf.message.Message):
         issues: global___Issues | None = ...,
         core_version: builtins.str = ...,
         platform: builtins.str = ...,
+        media_sampled_out: builtins.int = ...,
+        media_rotated: builtins.int = ...,
+        config_items_dropped: builtins.int = ...,
+        summary_items_dropped: builtins.int = ...,
         _info: wandb.proto.wandb_base_pb2._RecordInfo | None = ...,
     ) -> None: ...
     def HasField(self, field_name: typing_extensions.Literal["_info", b"_info", "deprecated", b"deprecated", "env", b"env", "feature", b"feature", "imports_finish", b"imports_finish", "imports_init", b"imports_init", "issues", b"issues", "label", b"label"]) -> builtins.bool: ...
-    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "cli_version", b"cli_version", "core_version", b"core_version", "deprecated", b"deprecated", "env", b"env", "feature", b"feature", "huggingface_version", b"huggingface_version", "imports_finish", b"imports_finish", "imports_init", b"imports_init", "issues", b"issues", "label", b"label", "platform", b"platform", "python_version", b"python_version"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "cli_version", b"cli_version", "config_items_dropped", b"config_items_dropped", "core_version", b"core_version", "deprecated", b"deprecated", "env", b"env", "feature", b"feature", "huggingface_version", b"huggingface_version", "imports_finish", b"imports_finish", "imports_init", b"imports_init", "issues", b"issues", "label", b"label", "media_rotated", b"media_rotated", "media_sampled_out", b"media_sampled_out", "platform", b"platform", "python_version", b"python_version", "summary_items_dropped", b"summary_items_dropped"]) -> None: ...
 
 global___TelemetryRecord = TelemetryRecord
 
@@ -17,7 +17,7 @@ from wandb.proto import wandb_base_pb2 as wandb_dot_proto_dot_wandb__base__pb2
 from wandb.proto import wandb_telemetry_pb2 as wandb_dot_proto_dot_wandb__telemetry__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n wandb/proto/wandb_internal.proto\x12\x0ewandb_internal\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cwandb/proto/wandb_base.proto\x1a!wandb/proto/wandb_telemetry.proto\"\x9c\t\n\x06Record\x12\x0b\n\x03num\x18\x01 \x01(\x03\x12\x30\n\x07history\x18\x02 \x01(\x0b\x32\x1d.wandb_internal.HistoryRecordH\x00\x12\x30\n\x07summary\x18\x03 \x01(\x0b\x32\x1d.wandb_internal.SummaryRecordH\x00\x12.\n\x06output\x18\x04 \x01(\x0b\x32\x1c.wandb_internal.OutputRecordH\x00\x12.\n\x06\x63onfig\x18\x05 \x01(\x0b\x32\x1c.wandb_internal.ConfigRecordH\x00\x12,\n\x05\x66iles\x18\x06 \x01(\x0b\x32\x1b.wandb_internal.FilesRecordH\x00\x12,\n\x05stats\x18\x07 \x01(\x0b\x32\x1b.wandb_internal.StatsRecordH\x00\x12\x32\n\x08\x61rtifact\x18\x08 \x01(\x0b\x32\x1e.wandb_internal.ArtifactRecordH\x00\x12,\n\x08tbrecord\x18\t \x01(\x0b\x32\x18.wandb_internal.TBRecordH\x00\x12,\n\x05\x61lert\x18\n \x01(\x0b\x32\x1b.wandb_internal.AlertRecordH\x00\x12\x34\n\ttelemetry\x18\x0b \x01(\x0b\x32\x1f.wandb_internal.TelemetryRecordH\x00\x12.\n\x06metric\x18\x0c \x01(\x0b\x32\x1c.wandb_internal.MetricRecordH\x00\x12\x35\n\noutput_raw\x18\r \x01(\x0b\x32\x1f.wandb_internal.OutputRawRecordH\x00\x12(\n\x03run\x18\x11 \x01(\x0b\x32\x19.wandb_internal.RunRecordH\x00\x12-\n\x04\x65xit\x18\x12 \x01(\x0b\x32\x1d.wandb_internal.RunExitRecordH\x00\x12,\n\x05\x66inal\x18\x14 \x01(\x0b\x32\x1b.wandb_internal.FinalRecordH\x00\x12.\n\x06header\x18\x15 \x01(\x0b\x32\x1c.wandb_internal.HeaderRecordH\x00\x12.\n\x06\x66ooter\x18\x16 \x01(\x0b\x32\x1c.wandb_internal.FooterRecordH\x00\x12\x39\n\npreempting\x18\x17 \x01(\x0b\x32#.wandb_internal.RunPreemptingRecordH\x00\x12;\n\rlink_artifact\x18\x18 \x01(\x0b\x32\".wandb_internal.LinkArtifactRecordH\x00\x12\x39\n\x0cuse_artifact\x18\x19 \x01(\x0b\x32!.wandb_internal.UseArtifactRecordH\x00\x12*\n\x07request\x18\x64 \x01(\x0b\x32\x17.wandb_internal.RequestH\x00\x12(\n\x07\x63ontrol\x18\x10 \x01(\x0b\x32\x17.wandb_internal.Control\x12\x0c\n\x04uuid\x18\x13 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfoB\r\n\x0brecord_type\"\xa8\x01\n\x07\x43ontrol\x12\x10\n\x08req_resp\x18\x01 \x01(\x08\x12\r\n\x05local\x18\x02 \x01(\x08\x12\x10\n\x08relay_id\x18\x03 \x01(\t\x12\x14\n\x0cmailbox_slot\x18\x04 \x01(\t\x12\x13\n\x0b\x61lways_send\x18\x05 \x01(\x08\x12\x14\n\x0c\x66low_control\x18\x06 \x01(\x08\x12\x12\n\nend_offset\x18\x07 \x01(\x03\x12\x15\n\rconnection_id\x18\x08 \x01(\t\"\xf3\x03\n\x06Result\x12\x35\n\nrun_result\x18\x11 \x01(\x0b\x32\x1f.wandb_internal.RunUpdateResultH\x00\x12\x34\n\x0b\x65xit_result\x18\x12 \x01(\x0b\x32\x1d.wandb_internal.RunExitResultH\x00\x12\x33\n\nlog_result\x18\x14 \x01(\x0b\x32\x1d.wandb_internal.HistoryResultH\x00\x12\x37\n\x0esummary_result\x18\x15 \x01(\x0b\x32\x1d.wandb_internal.SummaryResultH\x00\x12\x35\n\routput_result\x18\x16 \x01(\x0b\x32\x1c.wandb_internal.OutputResultH\x00\x12\x35\n\rconfig_result\x18\x17 \x01(\x0b\x32\x1c.wandb_internal.ConfigResultH\x00\x12,\n\x08response\x18\x64 \x01(\x0b\x32\x18.wandb_internal.ResponseH\x00\x12(\n\x07\x63ontrol\x18\x10 \x01(\x0b\x32\x17.wandb_internal.Control\x12\x0c\n\x04uuid\x18\x18 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._ResultInfoB\r\n\x0bresult_type\":\n\x0b\x46inalRecord\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"b\n\x0bVersionInfo\x12\x10\n\x08producer\x18\x01 \x01(\t\x12\x14\n\x0cmin_consumer\x18\x02 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"n\n\x0cHeaderRecord\x12\x31\n\x0cversion_info\x18\x01 \x01(\x0b\x32\x1b.wandb_internal.VersionInfo\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\";\n\x0c\x46ooterRecord\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\xde\x04\n\tRunRecord\x12\x0e\n\x06run_id\x18\x01 \x01(\t\x12\x0e\n\x06\x65ntity\x18\x02 \x01(\t\x12\x0f\n\x07project\x18\x03 \x01(\t\x12,\n\x06\x63onfig\x18\x04 \x01(\x0b\x32\x1c.wandb_internal.ConfigRecord\x12.\n\x07summary\x18\x05 \x01(\x0b\x32\x1d.wandb_internal.SummaryRecord\x12\x11\n\trun_group\x18\x06 \x01(\t\x12\x10\n\x08job_type\x18\x07 \x01(\t\x12\x14\n\x0c\x64isplay_name\x18\x08 \x01(\t\x12\r\n\x05notes\x18\t \x01(\t\x12\x0c\n\x04tags\x18\n \x03(\t\x12\x30\n\x08settings\x18\x0b \x01(\x0b\x32\x1e.wandb_internal.SettingsRecord\x12\x10\n\x08sweep_id\x18\x0c \x01(\t\x12\x0c\n\x04host\x18\r \x01(\t\x12\x15\n\rstarting_step\x18\x0e \x01(\x03\x12\x12\n\nstorage_id\x18\x10 \x01(\t\x12.\n\nstart_time\x18\x11 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0f\n\x07resumed\x18\x12 \x01(\x08\x12\x32\n\ttelemetry\x18\x13 \x01(\x0b\x32\x1f.wandb_internal.TelemetryRecord\x12\x0f\n\x07runtime\x18\x14 \x01(\x05\x12*\n\x03git\x18\x15 \x01(\x0b\x32\x1d.wandb_internal.GitRepoRecord\x12\x0e\n\x06\x66orked\x18\x16 \x01(\x08\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\";\n\rGitRepoRecord\x12\x1a\n\nremote_url\x18\x01 \x01(\tR\x06remote\x12\x0e\n\x06\x63ommit\x18\x02 \x01(\t\"c\n\x0fRunUpdateResult\x12&\n\x03run\x18\x01 \x01(\x0b\x32\x19.wandb_internal.RunRecord\x12(\n\x05\x65rror\x18\x02 \x01(\x0b\x32\x19.wandb_internal.ErrorInfo\"\xac\x01\n\tErrorInfo\x12\x0f\n\x07message\x18\x01 \x01(\t\x12\x31\n\x04\x63ode\x18\x02 \x01(\x0e\x32#.wandb_internal.ErrorInfo.ErrorCode\"[\n\tErrorCode\x12\x0b\n\x07UNKNOWN\x10\x00\x12\x11\n\rCOMMUNICATION\x10\x01\x12\x12\n\x0e\x41UTHENTICATION\x10\x02\x12\t\n\x05USAGE\x10\x03\x12\x0f\n\x0bUNSUPPORTED\x10\x04\"`\n\rRunExitRecord\x12\x11\n\texit_code\x18\x01 \x01(\x05\x12\x0f\n\x07runtime\x18\x02 \x01(\x05\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x0f\n\rRunExitResult\"B\n\x13RunPreemptingRecord\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x15\n\x13RunPreemptingResult\"i\n\x0eSettingsRecord\x12*\n\x04item\x18\x01 \x03(\x0b\x32\x1c.wandb_internal.SettingsItem\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"/\n\x0cSettingsItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\"\x1a\n\x0bHistoryStep\x12\x0b\n\x03num\x18\x01 \x01(\x03\"\x92\x01\n\rHistoryRecord\x12)\n\x04item\x18\x01 \x03(\x0b\x32\x1b.wandb_internal.HistoryItem\x12)\n\x04step\x18\x02 \x01(\x0b\x32\x1b.wandb_internal.HistoryStep\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"B\n\x0bHistoryItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nnested_key\x18\x02 \x03(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\"\x0f\n\rHistoryResult\"\xdc\x01\n\x0cOutputRecord\x12<\n\x0boutput_type\x18\x01 \x01(\x0e\x32\'.wandb_internal.OutputRecord.OutputType\x12-\n\ttimestamp\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0c\n\x04line\x18\x03 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"$\n\nOutputType\x12\n\n\x06STDERR\x10\x00\x12\n\n\x06STDOUT\x10\x01\"\x0e\n\x0cOutputResult\"\xe2\x01\n\x0fOutputRawRecord\x12?\n\x0boutput_type\x18\x01 \x01(\x0e\x32*.wandb_internal.OutputRawRecord.OutputType\x12-\n\ttimestamp\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0c\n\x04line\x18\x03 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"$\n\nOutputType\x12\n\n\x06STDERR\x10\x00\x12\n\n\x06STDOUT\x10\x01\"\x11\n\x0fOutputRawResult\"\x98\x03\n\x0cMetricRecord\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x11\n\tglob_name\x18\x02 \x01(\t\x12\x13\n\x0bstep_metric\x18\x04 \x01(\t\x12\x19\n\x11step_metric_index\x18\x05 \x01(\x05\x12.\n\x07options\x18\x06 \x01(\x0b\x32\x1d.wandb_internal.MetricOptions\x12.\n\x07summary\x18\x07 \x01(\x0b\x32\x1d.wandb_internal.MetricSummary\x12\x35\n\x04goal\x18\x08 \x01(\x0e\x32\'.wandb_internal.MetricRecord.MetricGoal\x12/\n\x08_control\x18\t \x01(\x0b\x32\x1d.wandb_internal.MetricControl\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"B\n\nMetricGoal\x12\x0e\n\nGOAL_UNSET\x10\x00\x12\x11\n\rGOAL_MINIMIZE\x10\x01\x12\x11\n\rGOAL_MAXIMIZE\x10\x02\"\x0e\n\x0cMetricResult\"C\n\rMetricOptions\x12\x11\n\tstep_sync\x18\x01 \x01(\x08\x12\x0e\n\x06hidden\x18\x02 \x01(\x08\x12\x0f\n\x07\x64\x65\x66ined\x18\x03 \x01(\x08\"\"\n\rMetricControl\x12\x11\n\toverwrite\x18\x01 \x01(\x08\"o\n\rMetricSummary\x12\x0b\n\x03min\x18\x01 \x01(\x08\x12\x0b\n\x03max\x18\x02 \x01(\x08\x12\x0c\n\x04mean\x18\x03 \x01(\x08\x12\x0c\n\x04\x62\x65st\x18\x04 \x01(\x08\x12\x0c\n\x04last\x18\x05 \x01(\x08\x12\x0c\n\x04none\x18\x06 \x01(\x08\x12\x0c\n\x04\x63opy\x18\x07 \x01(\x08\"\x93\x01\n\x0c\x43onfigRecord\x12*\n\x06update\x18\x01 \x03(\x0b\x32\x1a.wandb_internal.ConfigItem\x12*\n\x06remove\x18\x02 \x03(\x0b\x32\x1a.wandb_internal.ConfigItem\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"A\n\nConfigItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nnested_key\x18\x02 \x03(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\"\x0e\n\x0c\x43onfigResult\"\x96\x01\n\rSummaryRecord\x12+\n\x06update\x18\x01 \x03(\x0b\x32\x1b.wandb_internal.SummaryItem\x12+\n\x06remove\x18\x02 \x03(\x0b\x32\x1b.wandb_internal.SummaryItem\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"B\n\x0bSummaryItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nnested_key\x18\x02 \x03(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\"\x0f\n\rSummaryResult\"d\n\x0b\x46ilesRecord\x12(\n\x05\x66iles\x18\x01 \x03(\x0b\x32\x19.wandb_internal.FilesItem\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\xec\x01\n\tFilesItem\x12\x0c\n\x04path\x18\x01 \x01(\t\x12\x34\n\x06policy\x18\x02 \x01(\x0e\x32$.wandb_internal.FilesItem.PolicyType\x12\x30\n\x04type\x18\x03 \x01(\x0e\x32\".wandb_internal.FilesItem.FileType\"(\n\nPolicyType\x12\x07\n\x03NOW\x10\x00\x12\x07\n\x03\x45ND\x10\x01\x12\x08\n\x04LIVE\x10\x02\"9\n\x08\x46ileType\x12\t\n\x05OTHER\x10\x00\x12\t\n\x05WANDB\x10\x01\x12\t\n\x05MEDIA\x10\x02\x12\x0c\n\x08\x41RTIFACT\x10\x03J\x04\x08\x10\x10\x11\"\r\n\x0b\x46ilesResult\"\xe6\x01\n\x0bStatsRecord\x12\x39\n\nstats_type\x18\x01 \x01(\x0e\x32%.wandb_internal.StatsRecord.StatsType\x12-\n\ttimestamp\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\'\n\x04item\x18\x03 \x03(\x0b\x32\x19.wandb_internal.StatsItem\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x17\n\tStatsType\x12\n\n\x06SYSTEM\x10\x00\",\n\tStatsItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\"\xd9\x03\n\x0e\x41rtifactRecord\x12\x0e\n\x06run_id\x18\x01 \x01(\t\x12\x0f\n\x07project\x18\x02 \x01(\t\x12\x0e\n\x06\x65ntity\x18\x03 \x01(\t\x12\x0c\n\x04type\x18\x04 \x01(\t\x12\x0c\n\x04name\x18\x05 \x01(\t\x12\x0e\n\x06\x64igest\x18\x06 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x07 \x01(\t\x12\x10\n\x08metadata\x18\x08 \x01(\t\x12\x14\n\x0cuser_created\x18\t \x01(\x08\x12\x18\n\x10use_after_commit\x18\n \x01(\x08\x12\x0f\n\x07\x61liases\x18\x0b \x03(\t\x12\x32\n\x08manifest\x18\x0c \x01(\x0b\x32 .wandb_internal.ArtifactManifest\x12\x16\n\x0e\x64istributed_id\x18\r \x01(\t\x12\x10\n\x08\x66inalize\x18\x0e \x01(\x08\x12\x11\n\tclient_id\x18\x0f \x01(\t\x12\x1a\n\x12sequence_client_id\x18\x10 \x01(\t\x12\x0f\n\x07\x62\x61se_id\x18\x11 \x01(\t\x12\x1c\n\x14ttl_duration_seconds\x18\x12 \x01(\x03\x12\x19\n\x11incremental_beta1\x18\x64 \x01(\x08\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\xbc\x01\n\x10\x41rtifactManifest\x12\x0f\n\x07version\x18\x01 \x01(\x05\x12\x16\n\x0estorage_policy\x18\x02 \x01(\t\x12\x46\n\x15storage_policy_config\x18\x03 \x03(\x0b\x32\'.wandb_internal.StoragePolicyConfigItem\x12\x37\n\x08\x63ontents\x18\x04 \x03(\x0b\x32%.wandb_internal.ArtifactManifestEntry\"\xcf\x01\n\x15\x41rtifactManifestEntry\x12\x0c\n\x04path\x18\x01 \x01(\t\x12\x0e\n\x06\x64igest\x18\x02 \x01(\t\x12\x0b\n\x03ref\x18\x03 \x01(\t\x12\x0c\n\x04size\x18\x04 \x01(\x03\x12\x10\n\x08mimetype\x18\x05 \x01(\t\x12\x12\n\nlocal_path\x18\x06 \x01(\t\x12\x19\n\x11\x62irth_artifact_id\x18\x07 \x01(\t\x12\x12\n\nskip_cache\x18\x08 \x01(\x08\x12(\n\x05\x65xtra\x18\x10 \x03(\x0b\x32\x19.wandb_internal.ExtraItem\",\n\tExtraItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nvalue_json\x18\x02 \x01(\t\":\n\x17StoragePolicyConfigItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nvalue_json\x18\x02 \x01(\t\"\x10\n\x0e\x41rtifactResult\"\x14\n\x12LinkArtifactResult\"\xcf\x01\n\x12LinkArtifactRecord\x12\x11\n\tclient_id\x18\x01 \x01(\t\x12\x11\n\tserver_id\x18\x02 \x01(\t\x12\x16\n\x0eportfolio_name\x18\x03 \x01(\t\x12\x18\n\x10portfolio_entity\x18\x04 \x01(\t\x12\x19\n\x11portfolio_project\x18\x05 \x01(\t\x12\x19\n\x11portfolio_aliases\x18\x06 \x03(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"h\n\x08TBRecord\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\x12\x0f\n\x07log_dir\x18\x01 \x01(\t\x12\x10\n\x08root_dir\x18\x03 \x01(\t\x12\x0c\n\x04save\x18\x02 \x01(\x08\"\n\n\x08TBResult\"}\n\x0b\x41lertRecord\x12\r\n\x05title\x18\x01 \x01(\t\x12\x0c\n\x04text\x18\x02 \x01(\t\x12\r\n\x05level\x18\x03 \x01(\t\x12\x15\n\rwait_duration\x18\x04 \x01(\x03\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\r\n\x0b\x41lertResult\"\xbe\x0f\n\x07Request\x12\x38\n\x0bstop_status\x18\x01 \x01(\x0b\x32!.wandb_internal.StopStatusRequestH\x00\x12>\n\x0enetwork_status\x18\x02 \x01(\x0b\x32$.wandb_internal.NetworkStatusRequestH\x00\x12-\n\x05\x64\x65\x66\x65r\x18\x03 \x01(\x0b\x32\x1c.wandb_internal.DeferRequestH\x00\x12\x38\n\x0bget_summary\x18\x04 \x01(\x0b\x32!.wandb_internal.GetSummaryRequestH\x00\x12-\n\x05login\x18\x05 \x01(\x0b\x32\x1c.wandb_internal.LoginRequestH\x00\x12-\n\x05pause\x18\x06 \x01(\x0b\x32\x1c.wandb_internal.PauseRequestH\x00\x12/\n\x06resume\x18\x07 \x01(\x0b\x32\x1d.wandb_internal.ResumeRequestH\x00\x12\x34\n\tpoll_exit\x18\x08 \x01(\x0b\x32\x1f.wandb_internal.PollExitRequestH\x00\x12@\n\x0fsampled_history\x18\t \x01(\x0b\x32%.wandb_internal.SampledHistoryRequestH\x00\x12@\n\x0fpartial_history\x18\n \x01(\x0b\x32%.wandb_internal.PartialHistoryRequestH\x00\x12\x34\n\trun_start\x18\x0b \x01(\x0b\x32\x1f.wandb_internal.RunStartRequestH\x00\x12<\n\rcheck_version\x18\x0c \x01(\x0b\x32#.wandb_internal.CheckVersionRequestH\x00\x12:\n\x0clog_artifact\x18\r \x01(\x0b\x32\".wandb_internal.LogArtifactRequestH\x00\x12\x44\n\x11\x64ownload_artifact\x18\x0e \x01(\x0b\x32\'.wandb_internal.DownloadArtifactRequestH\x00\x12\x35\n\tkeepalive\x18\x11 \x01(\x0b\x32 .wandb_internal.KeepaliveRequestH\x00\x12\x36\n\nrun_status\x18\x14 \x01(\x0b\x32 .wandb_internal.RunStatusRequestH\x00\x12/\n\x06\x63\x61ncel\x18\x15 \x01(\x0b\x32\x1d.wandb_internal.CancelRequestH\x00\x12\x33\n\x08metadata\x18\x16 \x01(\x0b\x32\x1f.wandb_internal.MetadataRequestH\x00\x12\x44\n\x11internal_messages\x18\x17 \x01(\x0b\x32\'.wandb_internal.InternalMessagesRequestH\x00\x12@\n\x0fpython_packages\x18\x18 \x01(\x0b\x32%.wandb_internal.PythonPackagesRequestH\x00\x12\x33\n\x08shutdown\x18@ \x01(\x0b\x32\x1f.wandb_internal.ShutdownRequestH\x00\x12/\n\x06\x61ttach\x18\x41 \x01(\x0b\x32\x1d.wandb_internal.AttachRequestH\x00\x12/\n\x06status\x18\x42 \x01(\x0b\x32\x1d.wandb_internal.StatusRequestH\x00\x12\x38\n\x0bserver_info\x18\x43 \x01(\x0b\x32!.wandb_internal.ServerInfoRequestH\x00\x12\x38\n\x0bsender_mark\x18\x44 \x01(\x0b\x32!.wandb_internal.SenderMarkRequestH\x00\x12\x38\n\x0bsender_read\x18\x45 \x01(\x0b\x32!.wandb_internal.SenderReadRequestH\x00\x12<\n\rstatus_report\x18\x46 \x01(\x0b\x32#.wandb_internal.StatusReportRequestH\x00\x12>\n\x0esummary_record\x18G \x01(\x0b\x32$.wandb_internal.SummaryRecordRequestH\x00\x12\x42\n\x10telemetry_record\x18H \x01(\x0b\x32&.wandb_internal.TelemetryRecordRequestH\x00\x12\x32\n\x08job_info\x18I \x01(\x0b\x32\x1e.wandb_internal.JobInfoRequestH\x00\x12\x45\n\x12get_system_metrics\x18J \x01(\x0b\x32\'.wandb_internal.GetSystemMetricsRequestH\x00\x12+\n\x04sync\x18L \x01(\x0b\x32\x1b.wandb_internal.SyncRequestH\x00\x12\x34\n\tjob_input\x18M \x01(\x0b\x32\x1f.wandb_internal.JobInputRequestH\x00\x12\x39\n\x0btest_inject\x18\xe8\x07 \x01(\x0b\x32!.wandb_internal.TestInjectRequestH\x00\x42\x0e\n\x0crequest_typeJ\x04\x08K\x10L\"\xe2\x0b\n\x08Response\x12?\n\x12keepalive_response\x18\x12 \x01(\x0b\x32!.wandb_internal.KeepaliveResponseH\x00\x12\x42\n\x14stop_status_response\x18\x13 \x01(\x0b\x32\".wandb_internal.StopStatusResponseH\x00\x12H\n\x17network_status_response\x18\x14 \x01(\x0b\x32%.wandb_internal.NetworkStatusResponseH\x00\x12\x37\n\x0elogin_response\x18\x18 \x01(\x0b\x32\x1d.wandb_internal.LoginResponseH\x00\x12\x42\n\x14get_summary_response\x18\x19 \x01(\x0b\x32\".wandb_internal.GetSummaryResponseH\x00\x12>\n\x12poll_exit_response\x18\x1a \x01(\x0b\x32 .wandb_internal.PollExitResponseH\x00\x12J\n\x18sampled_history_response\x18\x1b \x01(\x0b\x32&.wandb_internal.SampledHistoryResponseH\x00\x12>\n\x12run_start_response\x18\x1c \x01(\x0b\x32 .wandb_internal.RunStartResponseH\x00\x12\x46\n\x16\x63heck_version_response\x18\x1d \x01(\x0b\x32$.wandb_internal.CheckVersionResponseH\x00\x12\x44\n\x15log_artifact_response\x18\x1e \x01(\x0b\x32#.wandb_internal.LogArtifactResponseH\x00\x12N\n\x1a\x64ownload_artifact_response\x18\x1f \x01(\x0b\x32(.wandb_internal.DownloadArtifactResponseH\x00\x12@\n\x13run_status_response\x18# \x01(\x0b\x32!.wandb_internal.RunStatusResponseH\x00\x12\x39\n\x0f\x63\x61ncel_response\x18$ \x01(\x0b\x32\x1e.wandb_internal.CancelResponseH\x00\x12N\n\x1ainternal_messages_response\x18% \x01(\x0b\x32(.wandb_internal.InternalMessagesResponseH\x00\x12=\n\x11shutdown_response\x18@ \x01(\x0b\x32 .wandb_internal.ShutdownResponseH\x00\x12\x39\n\x0f\x61ttach_response\x18\x41 \x01(\x0b\x32\x1e.wandb_internal.AttachResponseH\x00\x12\x39\n\x0fstatus_response\x18\x42 \x01(\x0b\x32\x1e.wandb_internal.StatusResponseH\x00\x12\x42\n\x14server_info_response\x18\x43 \x01(\x0b\x32\".wandb_internal.ServerInfoResponseH\x00\x12<\n\x11job_info_response\x18\x44 \x01(\x0b\x32\x1f.wandb_internal.JobInfoResponseH\x00\x12O\n\x1bget_system_metrics_response\x18\x45 \x01(\x0b\x32(.wandb_internal.GetSystemMetricsResponseH\x00\x12\x35\n\rsync_response\x18\x46 \x01(\x0b\x32\x1c.wandb_internal.SyncResponseH\x00\x12\x43\n\x14test_inject_response\x18\xe8\x07 \x01(\x0b\x32\".wandb_internal.TestInjectResponseH\x00\x42\x0f\n\rresponse_type\"\xc0\x02\n\x0c\x44\x65\x66\x65rRequest\x12\x36\n\x05state\x18\x01 \x01(\x0e\x32\'.wandb_internal.DeferRequest.DeferState\"\xf7\x01\n\nDeferState\x12\t\n\x05\x42\x45GIN\x10\x00\x12\r\n\tFLUSH_RUN\x10\x01\x12\x0f\n\x0b\x46LUSH_STATS\x10\x02\x12\x19\n\x15\x46LUSH_PARTIAL_HISTORY\x10\x03\x12\x0c\n\x08\x46LUSH_TB\x10\x04\x12\r\n\tFLUSH_SUM\x10\x05\x12\x13\n\x0f\x46LUSH_DEBOUNCER\x10\x06\x12\x10\n\x0c\x46LUSH_OUTPUT\x10\x07\x12\r\n\tFLUSH_JOB\x10\x08\x12\r\n\tFLUSH_DIR\x10\t\x12\x0c\n\x08\x46LUSH_FP\x10\n\x12\x0b\n\x07JOIN_FP\x10\x0b\x12\x0c\n\x08\x46LUSH_FS\x10\x0c\x12\x0f\n\x0b\x46LUSH_FINAL\x10\r\x12\x07\n\x03\x45ND\x10\x0e\"<\n\x0cPauseRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x0f\n\rPauseResponse\"=\n\rResumeRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x10\n\x0eResumeResponse\"M\n\x0cLoginRequest\x12\x0f\n\x07\x61pi_key\x18\x01 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"&\n\rLoginResponse\x12\x15\n\ractive_entity\x18\x01 \x01(\t\"A\n\x11GetSummaryRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"?\n\x12GetSummaryResponse\x12)\n\x04item\x18\x01 \x03(\x0b\x32\x1b.wandb_internal.SummaryItem\"G\n\x17GetSystemMetricsRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"R\n\x12SystemMetricSample\x12-\n\ttimestamp\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\r\n\x05value\x18\x02 \x01(\x02\"I\n\x13SystemMetricsBuffer\x12\x32\n\x06record\x18\x01 \x03(\x0b\x32\".wandb_internal.SystemMetricSample\"\xca\x01\n\x18GetSystemMetricsResponse\x12S\n\x0esystem_metrics\x18\x01 \x03(\x0b\x32;.wandb_internal.GetSystemMetricsResponse.SystemMetricsEntry\x1aY\n\x12SystemMetricsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x32\n\x05value\x18\x02 \x01(\x0b\x32#.wandb_internal.SystemMetricsBuffer:\x02\x38\x01\"=\n\rStatusRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\")\n\x0eStatusResponse\x12\x17\n\x0frun_should_stop\x18\x01 \x01(\x08\"A\n\x11StopStatusRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"-\n\x12StopStatusResponse\x12\x17\n\x0frun_should_stop\x18\x01 \x01(\x08\"D\n\x14NetworkStatusRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"P\n\x15NetworkStatusResponse\x12\x37\n\x11network_responses\x18\x01 \x03(\x0b\x32\x1c.wandb_internal.HttpResponse\"D\n\x0cHttpResponse\x12\x18\n\x10http_status_code\x18\x01 \x01(\x05\x12\x1a\n\x12http_response_text\x18\x02 \x01(\t\"G\n\x17InternalMessagesRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"N\n\x18InternalMessagesResponse\x12\x32\n\x08messages\x18\x01 \x01(\x0b\x32 .wandb_internal.InternalMessages\"#\n\x10InternalMessages\x12\x0f\n\x07warning\x18\x01 \x03(\t\"?\n\x0fPollExitRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\xbc\x01\n\x10PollExitResponse\x12\x0c\n\x04\x64one\x18\x01 \x01(\x08\x12\x32\n\x0b\x65xit_result\x18\x02 \x01(\x0b\x32\x1d.wandb_internal.RunExitResult\x12\x35\n\x0cpusher_stats\x18\x03 \x01(\x0b\x32\x1f.wandb_internal.FilePusherStats\x12/\n\x0b\x66ile_counts\x18\x04 \x01(\x0b\x32\x1a.wandb_internal.FileCounts\"@\n\rSyncOverwrite\x12\x0e\n\x06run_id\x18\x01 \x01(\t\x12\x0e\n\x06\x65ntity\x18\x02 \x01(\t\x12\x0f\n\x07project\x18\x03 \x01(\t\"\x1e\n\x08SyncSkip\x12\x12\n\noutput_raw\x18\x01 \x01(\x08\"\x13\n\x11SenderMarkRequest\"\x93\x01\n\x0bSyncRequest\x12\x14\n\x0cstart_offset\x18\x01 \x01(\x03\x12\x14\n\x0c\x66inal_offset\x18\x02 \x01(\x03\x12\x30\n\toverwrite\x18\x03 \x01(\x0b\x32\x1d.wandb_internal.SyncOverwrite\x12&\n\x04skip\x18\x04 \x01(\x0b\x32\x18.wandb_internal.SyncSkip\"E\n\x0cSyncResponse\x12\x0b\n\x03url\x18\x01 \x01(\t\x12(\n\x05\x65rror\x18\x02 \x01(\x0b\x32\x19.wandb_internal.ErrorInfo\"?\n\x11SenderReadRequest\x12\x14\n\x0cstart_offset\x18\x01 \x01(\x03\x12\x14\n\x0c\x66inal_offset\x18\x02 \x01(\x03\"m\n\x13StatusReportRequest\x12\x12\n\nrecord_num\x18\x01 \x01(\x03\x12\x13\n\x0bsent_offset\x18\x02 \x01(\x03\x12-\n\tsync_time\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"F\n\x14SummaryRecordRequest\x12.\n\x07summary\x18\x01 \x01(\x0b\x32\x1d.wandb_internal.SummaryRecord\"L\n\x16TelemetryRecordRequest\x12\x32\n\ttelemetry\x18\x01 \x01(\x0b\x32\x1f.wandb_internal.TelemetryRecord\"A\n\x11ServerInfoRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"|\n\x12ServerInfoResponse\x12-\n\nlocal_info\x18\x01 \x01(\x0b\x32\x19.wandb_internal.LocalInfo\x12\x37\n\x0fserver_messages\x18\x02 \x01(\x0b\x32\x1e.wandb_internal.ServerMessages\"=\n\x0eServerMessages\x12+\n\x04item\x18\x01 \x03(\x0b\x32\x1d.wandb_internal.ServerMessage\"e\n\rServerMessage\x12\x12\n\nplain_text\x18\x01 \x01(\t\x12\x10\n\x08utf_text\x18\x02 \x01(\t\x12\x11\n\thtml_text\x18\x03 \x01(\t\x12\x0c\n\x04type\x18\x04 \x01(\t\x12\r\n\x05level\x18\x05 \x01(\x05\"c\n\nFileCounts\x12\x13\n\x0bwandb_count\x18\x01 \x01(\x05\x12\x13\n\x0bmedia_count\x18\x02 \x01(\x05\x12\x16\n\x0e\x61rtifact_count\x18\x03 \x01(\x05\x12\x13\n\x0bother_count\x18\x04 \x01(\x05\"U\n\x0f\x46ilePusherStats\x12\x16\n\x0euploaded_bytes\x18\x01 \x01(\x03\x12\x13\n\x0btotal_bytes\x18\x02 \x01(\x03\x12\x15\n\rdeduped_bytes\x18\x03 \x01(\x03\"\x1e\n\rFilesUploaded\x12\r\n\x05\x66iles\x18\x01 \x03(\t\"\xf4\x01\n\x17\x46ileTransferInfoRequest\x12\x42\n\x04type\x18\x01 \x01(\x0e\x32\x34.wandb_internal.FileTransferInfoRequest.TransferType\x12\x0c\n\x04path\x18\x02 \x01(\t\x12\x0b\n\x03url\x18\x03 \x01(\t\x12\x0c\n\x04size\x18\x04 \x01(\x03\x12\x11\n\tprocessed\x18\x05 \x01(\x03\x12/\n\x0b\x66ile_counts\x18\x06 \x01(\x0b\x32\x1a.wandb_internal.FileCounts\"(\n\x0cTransferType\x12\n\n\x06Upload\x10\x00\x12\x0c\n\x08\x44ownload\x10\x01\"1\n\tLocalInfo\x12\x0f\n\x07version\x18\x01 \x01(\t\x12\x13\n\x0bout_of_date\x18\x02 \x01(\x08\"?\n\x0fShutdownRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x12\n\x10ShutdownResponse\"P\n\rAttachRequest\x12\x11\n\tattach_id\x18\x14 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"b\n\x0e\x41ttachResponse\x12&\n\x03run\x18\x01 \x01(\x0b\x32\x19.wandb_internal.RunRecord\x12(\n\x05\x65rror\x18\x02 \x01(\x0b\x32\x19.wandb_internal.ErrorInfo\"\xd5\x02\n\x11TestInjectRequest\x12\x13\n\x0bhandler_exc\x18\x01 \x01(\x08\x12\x14\n\x0chandler_exit\x18\x02 \x01(\x08\x12\x15\n\rhandler_abort\x18\x03 \x01(\x08\x12\x12\n\nsender_exc\x18\x04 \x01(\x08\x12\x13\n\x0bsender_exit\x18\x05 \x01(\x08\x12\x14\n\x0csender_abort\x18\x06 \x01(\x08\x12\x0f\n\x07req_exc\x18\x07 \x01(\x08\x12\x10\n\x08req_exit\x18\x08 \x01(\x08\x12\x11\n\treq_abort\x18\t \x01(\x08\x12\x10\n\x08resp_exc\x18\n \x01(\x08\x12\x11\n\tresp_exit\x18\x0b \x01(\x08\x12\x12\n\nresp_abort\x18\x0c \x01(\x08\x12\x10\n\x08msg_drop\x18\r \x01(\x08\x12\x10\n\x08msg_hang\x18\x0e \x01(\x08\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x14\n\x12TestInjectResponse\"\x1e\n\rHistoryAction\x12\r\n\x05\x66lush\x18\x01 \x01(\x08\"\xca\x01\n\x15PartialHistoryRequest\x12)\n\x04item\x18\x01 \x03(\x0b\x32\x1b.wandb_internal.HistoryItem\x12)\n\x04step\x18\x02 \x01(\x0b\x32\x1b.wandb_internal.HistoryStep\x12-\n\x06\x61\x63tion\x18\x03 \x01(\x0b\x32\x1d.wandb_internal.HistoryAction\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x18\n\x16PartialHistoryResponse\"E\n\x15SampledHistoryRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"_\n\x12SampledHistoryItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nnested_key\x18\x02 \x03(\t\x12\x14\n\x0cvalues_float\x18\x03 \x03(\x02\x12\x12\n\nvalues_int\x18\x04 \x03(\x03\"J\n\x16SampledHistoryResponse\x12\x30\n\x04item\x18\x01 \x03(\x0b\x32\".wandb_internal.SampledHistoryItem\"@\n\x10RunStatusRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"x\n\x11RunStatusResponse\x12\x18\n\x10sync_items_total\x18\x01 \x01(\x03\x12\x1a\n\x12sync_items_pending\x18\x02 \x01(\x03\x12-\n\tsync_time\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"g\n\x0fRunStartRequest\x12&\n\x03run\x18\x01 \x01(\x0b\x32\x19.wandb_internal.RunRecord\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x12\n\x10RunStartResponse\"\\\n\x13\x43heckVersionRequest\x12\x17\n\x0f\x63urrent_version\x18\x01 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"]\n\x14\x43heckVersionResponse\x12\x17\n\x0fupgrade_message\x18\x01 \x01(\t\x12\x14\n\x0cyank_message\x18\x02 \x01(\t\x12\x16\n\x0e\x64\x65lete_message\x18\x03 \x01(\t\">\n\x0eJobInfoRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"6\n\x0fJobInfoResponse\x12\x12\n\nsequenceId\x18\x01 \x01(\t\x12\x0f\n\x07version\x18\x02 \x01(\t\"\x9f\x01\n\x12LogArtifactRequest\x12\x30\n\x08\x61rtifact\x18\x01 \x01(\x0b\x32\x1e.wandb_internal.ArtifactRecord\x12\x14\n\x0chistory_step\x18\x02 \x01(\x03\x12\x13\n\x0bstaging_dir\x18\x03 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"A\n\x13LogArtifactResponse\x12\x13\n\x0b\x61rtifact_id\x18\x01 \x01(\t\x12\x15\n\rerror_message\x18\x02 \x01(\t\"\xbe\x01\n\x17\x44ownloadArtifactRequest\x12\x13\n\x0b\x61rtifact_id\x18\x01 \x01(\t\x12\x15\n\rdownload_root\x18\x02 \x01(\t\x12 \n\x18\x61llow_missing_references\x18\x04 \x01(\x08\x12\x12\n\nskip_cache\x18\x05 \x01(\x08\x12\x13\n\x0bpath_prefix\x18\x06 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"1\n\x18\x44ownloadArtifactResponse\x12\x15\n\rerror_message\x18\x01 \x01(\t\"@\n\x10KeepaliveRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x13\n\x11KeepaliveResponse\"q\n\x0c\x41rtifactInfo\x12\x10\n\x08\x61rtifact\x18\x01 \x01(\t\x12\x12\n\nentrypoint\x18\x02 \x03(\t\x12\x10\n\x08notebook\x18\x03 \x01(\x08\x12\x15\n\rbuild_context\x18\x04 \x01(\t\x12\x12\n\ndockerfile\x18\x05 \x01(\t\")\n\x07GitInfo\x12\x0e\n\x06remote\x18\x01 \x01(\t\x12\x0e\n\x06\x63ommit\x18\x02 \x01(\t\"\x87\x01\n\tGitSource\x12)\n\x08git_info\x18\x01 \x01(\x0b\x32\x17.wandb_internal.GitInfo\x12\x12\n\nentrypoint\x18\x02 \x03(\t\x12\x10\n\x08notebook\x18\x03 \x01(\x08\x12\x15\n\rbuild_context\x18\x04 \x01(\t\x12\x12\n\ndockerfile\x18\x05 \x01(\t\"\x1c\n\x0bImageSource\x12\r\n\x05image\x18\x01 \x01(\t\"\x8c\x01\n\x06Source\x12&\n\x03git\x18\x01 \x01(\x0b\x32\x19.wandb_internal.GitSource\x12.\n\x08\x61rtifact\x18\x02 \x01(\x0b\x32\x1c.wandb_internal.ArtifactInfo\x12*\n\x05image\x18\x03 \x01(\x0b\x32\x1b.wandb_internal.ImageSource\"k\n\tJobSource\x12\x10\n\x08_version\x18\x01 \x01(\t\x12\x13\n\x0bsource_type\x18\x02 \x01(\t\x12&\n\x06source\x18\x03 \x01(\x0b\x32\x16.wandb_internal.Source\x12\x0f\n\x07runtime\x18\x04 \x01(\t\"V\n\x12PartialJobArtifact\x12\x10\n\x08job_name\x18\x01 \x01(\t\x12.\n\x0bsource_info\x18\x02 \x01(\x0b\x32\x19.wandb_internal.JobSource\"\x9d\x01\n\x11UseArtifactRecord\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04type\x18\x02 \x01(\t\x12\x0c\n\x04name\x18\x03 \x01(\t\x12\x33\n\x07partial\x18\x04 \x01(\x0b\x32\".wandb_internal.PartialJobArtifact\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x13\n\x11UseArtifactResult\"R\n\rCancelRequest\x12\x13\n\x0b\x63\x61ncel_slot\x18\x01 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x10\n\x0e\x43\x61ncelResponse\"\'\n\x08\x44iskInfo\x12\r\n\x05total\x18\x01 \x01(\x04\x12\x0c\n\x04used\x18\x02 \x01(\x04\"\x1b\n\nMemoryInfo\x12\r\n\x05total\x18\x01 \x01(\x04\"/\n\x07\x43puInfo\x12\r\n\x05\x63ount\x18\x01 \x01(\r\x12\x15\n\rcount_logical\x18\x02 \x01(\r\">\n\x0cGpuAppleInfo\x12\x0f\n\x07gpuType\x18\x01 \x01(\t\x12\x0e\n\x06vendor\x18\x02 \x01(\t\x12\r\n\x05\x63ores\x18\x03 \x01(\r\"3\n\rGpuNvidiaInfo\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x14\n\x0cmemory_total\x18\x02 \x01(\x04\"\x89\x02\n\nGpuAmdInfo\x12\n\n\x02id\x18\x01 \x01(\t\x12\x11\n\tunique_id\x18\x02 \x01(\t\x12\x15\n\rvbios_version\x18\x03 \x01(\t\x12\x19\n\x11performance_level\x18\x04 \x01(\t\x12\x15\n\rgpu_overdrive\x18\x05 \x01(\t\x12\x1c\n\x14gpu_memory_overdrive\x18\x06 \x01(\t\x12\x11\n\tmax_power\x18\x07 \x01(\t\x12\x0e\n\x06series\x18\x08 \x01(\t\x12\r\n\x05model\x18\t \x01(\t\x12\x0e\n\x06vendor\x18\n \x01(\t\x12\x0b\n\x03sku\x18\x0b \x01(\t\x12\x12\n\nsclk_range\x18\x0c \x01(\t\x12\x12\n\nmclk_range\x18\r \x01(\t\"\x96\x08\n\x0fMetadataRequest\x12\n\n\x02os\x18\x01 \x01(\t\x12\x0e\n\x06python\x18\x02 \x01(\t\x12/\n\x0bheartbeatAt\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12-\n\tstartedAt\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0e\n\x06\x64ocker\x18\x05 \x01(\t\x12\x0c\n\x04\x63uda\x18\x06 \x01(\t\x12\x0c\n\x04\x61rgs\x18\x07 \x03(\t\x12\r\n\x05state\x18\x08 \x01(\t\x12\x0f\n\x07program\x18\t \x01(\t\x12\x1b\n\tcode_path\x18\n \x01(\tR\x08\x63odePath\x12*\n\x03git\x18\x0b \x01(\x0b\x32\x1d.wandb_internal.GitRepoRecord\x12\r\n\x05\x65mail\x18\x0c \x01(\t\x12\x0c\n\x04root\x18\r \x01(\t\x12\x0c\n\x04host\x18\x0e \x01(\t\x12\x10\n\x08username\x18\x0f \x01(\t\x12\x12\n\nexecutable\x18\x10 \x01(\t\x12&\n\x0f\x63ode_path_local\x18\x11 \x01(\tR\rcodePathLocal\x12\r\n\x05\x63olab\x18\x12 \x01(\t\x12\x1c\n\tcpu_count\x18\x13 \x01(\rR\tcpu_count\x12,\n\x11\x63pu_count_logical\x18\x14 \x01(\rR\x11\x63pu_count_logical\x12\x15\n\x08gpu_type\x18\x15 \x01(\tR\x03gpu\x12\x1c\n\tgpu_count\x18\x16 \x01(\rR\tgpu_count\x12\x37\n\x04\x64isk\x18\x17 \x03(\x0b\x32).wandb_internal.MetadataRequest.DiskEntry\x12*\n\x06memory\x18\x18 \x01(\x0b\x32\x1a.wandb_internal.MemoryInfo\x12$\n\x03\x63pu\x18\x19 \x01(\x0b\x32\x17.wandb_internal.CpuInfo\x12\x39\n\tgpu_apple\x18\x1a \x01(\x0b\x32\x1c.wandb_internal.GpuAppleInfoR\x08gpuapple\x12=\n\ngpu_nvidia\x18\x1b \x03(\x0b\x32\x1d.wandb_internal.GpuNvidiaInfoR\ngpu_nvidia\x12\x34\n\x07gpu_amd\x18\x1c \x03(\x0b\x32\x1a.wandb_internal.GpuAmdInfoR\x07gpu_amd\x12\x39\n\x05slurm\x18\x1d \x03(\x0b\x32*.wandb_internal.MetadataRequest.SlurmEntry\x1a\x45\n\tDiskEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\'\n\x05value\x18\x02 \x01(\x0b\x32\x18.wandb_internal.DiskInfo:\x02\x38\x01\x1a,\n\nSlurmEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\x8d\x01\n\x15PythonPackagesRequest\x12\x44\n\x07package\x18\x01 \x03(\x0b\x32\x33.wandb_internal.PythonPackagesRequest.PythonPackage\x1a.\n\rPythonPackage\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0f\n\x07version\x18\x02 \x01(\t\"\x1c\n\x0cJobInputPath\x12\x0c\n\x04path\x18\x01 \x03(\t\"\xd6\x01\n\x0eJobInputSource\x12\x44\n\nrun_config\x18\x01 \x01(\x0b\x32..wandb_internal.JobInputSource.RunConfigSourceH\x00\x12?\n\x04\x66ile\x18\x02 \x01(\x0b\x32/.wandb_internal.JobInputSource.ConfigFileSourceH\x00\x1a\x11\n\x0fRunConfigSource\x1a \n\x10\x43onfigFileSource\x12\x0c\n\x04path\x18\x01 \x01(\tB\x08\n\x06source\"\xb1\x01\n\x0fJobInputRequest\x12\x34\n\x0cinput_source\x18\x01 \x01(\x0b\x32\x1e.wandb_internal.JobInputSource\x12\x33\n\rinclude_paths\x18\x02 \x03(\x0b\x32\x1c.wandb_internal.JobInputPath\x12\x33\n\rexclude_paths\x18\x03 \x03(\x0b\x32\x1c.wandb_internal.JobInputPathb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n wandb/proto/wandb_internal.proto\x12\x0ewandb_internal\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cwandb/proto/wandb_base.proto\x1a!wandb/proto/wandb_telemetry.proto\"\xd9\t\n\x06Record\x12\x0b\n\x03num\x18\x01 \x01(\x03\x12\x30\n\x07history\x18\x02 \x01(\x0b\x32\x1d.wandb_internal.HistoryRecordH\x00\x12\x30\n\x07summary\x18\x03 \x01(\x0b\x32\x1d.wandb_internal.SummaryRecordH\x00\x12.\n\x06output\x18\x04 \x01(\x0b\x32\x1c.wandb_internal.OutputRecordH\x00\x12.\n\x06\x63onfig\x18\x05 \x01(\x0b\x32\x1c.wandb_internal.ConfigRecordH\x00\x12,\n\x05\x66iles\x18\x06 \x01(\x0b\x32\x1b.wandb_internal.FilesRecordH\x00\x12,\n\x05stats\x18\x07 \x01(\x0b\x32\x1b.wandb_internal.StatsRecordH\x00\x12\x32\n\x08\x61rtifact\x18\x08 \x01(\x0b\x32\x1e.wandb_internal.ArtifactRecordH\x00\x12,\n\x08tbrecord\x18\t \x01(\x0b\x32\x18.wandb_internal.TBRecordH\x00\x12,\n\x05\x61lert\x18\n \x01(\x0b\x32\x1b.wandb_internal.AlertRecordH\x00\x12\x34\n\ttelemetry\x18\x0b \x01(\x0b\x32\x1f.wandb_internal.TelemetryRecordH\x00\x12.\n\x06metric\x18\x0c \x01(\x0b\x32\x1c.wandb_internal.MetricRecordH\x00\x12\x35\n\noutput_raw\x18\r \x01(\x0b\x32\x1f.wandb_internal.OutputRawRecordH\x00\x12;\n\rhistory_batch\x18\x0e \x01(\x0b\x32\".wandb_internal.HistoryBatchRecordH\x00\x12(\n\x03run\x18\x11 \x01(\x0b\x32\x19.wandb_internal.RunRecordH\x00\x12-\n\x04\x65xit\x18\x12 \x01(\x0b\x32\x1d.wandb_internal.RunExitRecordH\x00\x12,\n\x05\x66inal\x18\x14 \x01(\x0b\x32\x1b.wandb_internal.FinalRecordH\x00\x12.\n\x06header\x18\x15 \x01(\x0b\x32\x1c.wandb_internal.HeaderRecordH\x00\x12.\n\x06\x66ooter\x18\x16 \x01(\x0b\x32\x1c.wandb_internal.FooterRecordH\x00\x12\x39\n\npreempting\x18\x17 \x01(\x0b\x32#.wandb_internal.RunPreemptingRecordH\x00\x12;\n\rlink_artifact\x18\x18 \x01(\x0b\x32\".wandb_internal.LinkArtifactRecordH\x00\x12\x39\n\x0cuse_artifact\x18\x19 \x01(\x0b\x32!.wandb_internal.UseArtifactRecordH\x00\x12*\n\x07request\x18\x64 \x01(\x0b\x32\x17.wandb_internal.RequestH\x00\x12(\n\x07\x63ontrol\x18\x10 \x01(\x0b\x32\x17.wandb_internal.Control\x12\x0c\n\x04uuid\x18\x13 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfoB\r\n\x0brecord_type\"\xa8\x01\n\x07\x43ontrol\x12\x10\n\x08req_resp\x18\x01 \x01(\x08\x12\r\n\x05local\x18\x02 \x01(\x08\x12\x10\n\x08relay_id\x18\x03 \x01(\t\x12\x14\n\x0cmailbox_slot\x18\x04 \x01(\t\x12\x13\n\x0b\x61lways_send\x18\x05 \x01(\x08\x12\x14\n\x0c\x66low_control\x18\x06 \x01(\x08\x12\x12\n\nend_offset\x18\x07 \x01(\x03\x12\x15\n\rconnection_id\x18\x08 \x01(\t\"\xf3\x03\n\x06Result\x12\x35\n\nrun_result\x18\x11 \x01(\x0b\x32\x1f.wandb_internal.RunUpdateResultH\x00\x12\x34\n\x0b\x65xit_result\x18\x12 \x01(\x0b\x32\x1d.wandb_internal.RunExitResultH\x00\x12\x33\n\nlog_result\x18\x14 \x01(\x0b\x32\x1d.wandb_internal.HistoryResultH\x00\x12\x37\n\x0esummary_result\x18\x15 \x01(\x0b\x32\x1d.wandb_internal.SummaryResultH\x00\x12\x35\n\routput_result\x18\x16 \x01(\x0b\x32\x1c.wandb_internal.OutputResultH\x00\x12\x35\n\rconfig_result\x18\x17 \x01(\x0b\x32\x1c.wandb_internal.ConfigResultH\x00\x12,\n\x08response\x18\x64 \x01(\x0b\x32\x18.wandb_internal.ResponseH\x00\x12(\n\x07\x63ontrol\x18\x10 \x01(\x0b\x32\x17.wandb_internal.Control\x12\x0c\n\x04uuid\x18\x18 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._ResultInfoB\r\n\x0bresult_type\":\n\x0b\x46inalRecord\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"b\n\x0bVersionInfo\x12\x10\n\x08producer\x18\x01 \x01(\t\x12\x14\n\x0cmin_consumer\x18\x02 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"n\n\x0cHeaderRecord\x12\x31\n\x0cversion_info\x18\x01 \x01(\x0b\x32\x1b.wandb_internal.VersionInfo\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\";\n\x0c\x46ooterRecord\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\xde\x04\n\tRunRecord\x12\x0e\n\x06run_id\x18\x01 \x01(\t\x12\x0e\n\x06\x65ntity\x18\x02 \x01(\t\x12\x0f\n\x07project\x18\x03 \x01(\t\x12,\n\x06\x63onfig\x18\x04 \x01(\x0b\x32\x1c.wandb_internal.ConfigRecord\x12.\n\x07summary\x18\x05 \x01(\x0b\x32\x1d.wandb_internal.SummaryRecord\x12\x11\n\trun_group\x18\x06 \x01(\t\x12\x10\n\x08job_type\x18\x07 \x01(\t\x12\x14\n\x0c\x64isplay_name\x18\x08 \x01(\t\x12\r\n\x05notes\x18\t \x01(\t\x12\x0c\n\x04tags\x18\n \x03(\t\x12\x30\n\x08settings\x18\x0b \x01(\x0b\x32\x1e.wandb_internal.SettingsRecord\x12\x10\n\x08sweep_id\x18\x0c \x01(\t\x12\x0c\n\x04host\x18\r \x01(\t\x12\x15\n\rstarting_step\x18\x0e \x01(\x03\x12\x12\n\nstorage_id\x18\x10 \x01(\t\x12.\n\nstart_time\x18\x11 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0f\n\x07resumed\x18\x12 \x01(\x08\x12\x32\n\ttelemetry\x18\x13 \x01(\x0b\x32\x1f.wandb_internal.TelemetryRecord\x12\x0f\n\x07runtime\x18\x14 \x01(\x05\x12*\n\x03git\x18\x15 \x01(\x0b\x32\x1d.wandb_internal.GitRepoRecord\x12\x0e\n\x06\x66orked\x18\x16 \x01(\x08\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"Z\n\rGitRepoRecord\x12\x1a\n\nremote_url\x18\x01 \x01(\tR\x06remote\x12\x0e\n\x06\x63ommit\x18\x02 \x01(\t\x12\x0e\n\x06\x62ranch\x18\x03 \x01(\t\x12\r\n\x05\x64irty\x18\x04 \x01(\x08\"c\n\x0fRunUpdateResult\x12&\n\x03run\x18\x01 \x01(\x0b\x32\x19.wandb_internal.RunRecord\x12(\n\x05\x65rror\x18\x02 \x01(\x0b\x32\x19.wandb_internal.ErrorInfo\"\xa6\x02\n\tErrorInfo\x12\x0f\n\x07message\x18\x01 \x01(\t\x12\x31\n\x04\x63ode\x18\x02 \x01(\x0e\x32#.wandb_internal.ErrorInfo.ErrorCode\"\xd4\x01\n\tErrorCode\x12\x0b\n\x07UNKNOWN\x10\x00\x12\x11\n\rCOMMUNICATION\x10\x01\x12\x12\n\x0e\x41UTHENTICATION\x10\x02\x12\t\n\x05USAGE\x10\x03\x12\x0f\n\x0bUNSUPPORTED\x10\x04\x12\x12\n\x0eQUOTA_EXCEEDED\x10\x05\x12\x12\n\x0eSTORAGE_DENIED\x10\x06\x12\x10\n\x0cRATE_LIMITED\x10\x07\x12\x17\n\x13SERVER_INCOMPATIBLE\x10\x08\x12\x15\n\x11PERMISSION_DENIED\x10\t\x12\r\n\tNOT_FOUND\x10\n\"`\n\rRunExitRecord\x12\x11\n\texit_code\x18\x01 \x01(\x05\x12\x0f\n\x07runtime\x18\x02 \x01(\x05\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x0f\n\rRunExitResult\"B\n\x13RunPreemptingRecord\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x15\n\x13RunPreemptingResult\"i\n\x0eSettingsRecord\x12*\n\x04item\x18\x01 \x03(\x0b\x32\x1c.wandb_internal.SettingsItem\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"/\n\x0cSettingsItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\"\x1a\n\x0bHistoryStep\x12\x0b\n\x03num\x18\x01 \x01(\x03\"\x92\x01\n\rHistoryRecord\x12)\n\x04item\x18\x01 \x03(\x0b\x32\x1b.wandb_internal.HistoryItem\x12)\n\x04step\x18\x02 \x01(\x0b\x32\x1b.wandb_internal.HistoryStep\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"B\n\x0bHistoryItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nnested_key\x18\x02 \x03(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\"\x0f\n\rHistoryResult\"\x83\x01\n\x12HistoryBatchRecord\x12\x0c\n\x04step\x18\x01 \x03(\x03\x12\x32\n\x06\x63olumn\x18\x02 \x03(\x0b\x32\".wandb_internal.HistoryBatchColumn\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"U\n\x12HistoryBatchColumn\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nnested_key\x18\x02 \x03(\t\x12\r\n\x05value\x18\x03 \x03(\x01\x12\x0f\n\x07missing\x18\x04 \x03(\r\"\xdc\x01\n\x0cOutputRecord\x12<\n\x0boutput_type\x18\x01 \x01(\x0e\x32\'.wandb_internal.OutputRecord.OutputType\x12-\n\ttimestamp\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0c\n\x04line\x18\x03 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"$\n\nOutputType\x12\n\n\x06STDERR\x10\x00\x12\n\n\x06STDOUT\x10\x01\"\x0e\n\x0cOutputResult\"\xf2\x01\n\x0fOutputRawRecord\x12?\n\x0boutput_type\x18\x01 \x01(\x0e\x32*.wandb_internal.OutputRawRecord.OutputType\x12-\n\ttimestamp\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0c\n\x04line\x18\x03 \x01(\t\x12\x0e\n\x06source\x18\x04 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"$\n\nOutputType\x12\n\n\x06STDERR\x10\x00\x12\n\n\x06STDOUT\x10\x01\"\x11\n\x0fOutputRawResult\"\x98\x03\n\x0cMetricRecord\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x11\n\tglob_name\x18\x02 \x01(\t\x12\x13\n\x0bstep_metric\x18\x04 \x01(\t\x12\x19\n\x11step_metric_index\x18\x05 \x01(\x05\x12.\n\x07options\x18\x06 \x01(\x0b\x32\x1d.wandb_internal.MetricOptions\x12.\n\x07summary\x18\x07 \x01(\x0b\x32\x1d.wandb_internal.MetricSummary\x12\x35\n\x04goal\x18\x08 \x01(\x0e\x32\'.wandb_internal.MetricRecord.MetricGoal\x12/\n\x08_control\x18\t \x01(\x0b\x32\x1d.wandb_internal.MetricControl\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"B\n\nMetricGoal\x12\x0e\n\nGOAL_UNSET\x10\x00\x12\x11\n\rGOAL_MINIMIZE\x10\x01\x12\x11\n\rGOAL_MAXIMIZE\x10\x02\"\x0e\n\x0cMetricResult\"C\n\rMetricOptions\x12\x11\n\tstep_sync\x18\x01 \x01(\x08\x12\x0e\n\x06hidden\x18\x02 \x01(\x08\x12\x0f\n\x07\x64\x65\x66ined\x18\x03 \x01(\x08\"\"\n\rMetricControl\x12\x11\n\toverwrite\x18\x01 \x01(\x08\"o\n\rMetricSummary\x12\x0b\n\x03min\x18\x01 \x01(\x08\x12\x0b\n\x03max\x18\x02 \x01(\x08\x12\x0c\n\x04mean\x18\x03 \x01(\x08\x12\x0c\n\x04\x62\x65st\x18\x04 \x01(\x08\x12\x0c\n\x04last\x18\x05 \x01(\x08\x12\x0c\n\x04none\x18\x06 \x01(\x08\x12\x0c\n\x04\x63opy\x18\x07 \x01(\x08\"\x93\x01\n\x0c\x43onfigRecord\x12*\n\x06update\x18\x01 \x03(\x0b\x32\x1a.wandb_internal.ConfigItem\x12*\n\x06remove\x18\x02 \x03(\x0b\x32\x1a.wandb_internal.ConfigItem\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"A\n\nConfigItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nnested_key\x18\x02 \x03(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\"\x0e\n\x0c\x43onfigResult\"\x96\x01\n\rSummaryRecord\x12+\n\x06update\x18\x01 \x03(\x0b\x32\x1b.wandb_internal.SummaryItem\x12+\n\x06remove\x18\x02 \x03(\x0b\x32\x1b.wandb_internal.SummaryItem\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"B\n\x0bSummaryItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nnested_key\x18\x02 \x03(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\"\x0f\n\rSummaryResult\"d\n\x0b\x46ilesRecord\x12(\n\x05\x66iles\x18\x01 \x03(\x0b\x32\x19.wandb_internal.FilesItem\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\xfd\x01\n\tFilesItem\x12\x0c\n\x04path\x18\x01 \x01(\t\x12\x34\n\x06policy\x18\x02 \x01(\x0e\x32$.wandb_internal.FilesItem.PolicyType\x12\x30\n\x04type\x18\x03 \x01(\x0e\x32\".wandb_internal.FilesItem.FileType\x12\x0f\n\x07\x65xclude\x18\x04 \x03(\t\"(\n\nPolicyType\x12\x07\n\x03NOW\x10\x00\x12\x07\n\x03\x45ND\x10\x01\x12\x08\n\x04LIVE\x10\x02\"9\n\x08\x46ileType\x12\t\n\x05OTHER\x10\x00\x12\t\n\x05WANDB\x10\x01\x12\t\n\x05MEDIA\x10\x02\x12\x0c\n\x08\x41RTIFACT\x10\x03J\x04\x08\x10\x10\x11\"\r\n\x0b\x46ilesResult\"\xe6\x01\n\x0bStatsRecord\x12\x39\n\nstats_type\x18\x01 \x01(\x0e\x32%.wandb_internal.StatsRecord.StatsType\x12-\n\ttimestamp\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\'\n\x04item\x18\x03 \x03(\x0b\x32\x19.wandb_internal.StatsItem\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x17\n\tStatsType\x12\n\n\x06SYSTEM\x10\x00\",\n\tStatsItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\"\xd9\x03\n\x0e\x41rtifactRecord\x12\x0e\n\x06run_id\x18\x01 \x01(\t\x12\x0f\n\x07project\x18\x02 \x01(\t\x12\x0e\n\x06\x65ntity\x18\x03 \x01(\t\x12\x0c\n\x04type\x18\x04 \x01(\t\x12\x0c\n\x04name\x18\x05 \x01(\t\x12\x0e\n\x06\x64igest\x18\x06 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x07 \x01(\t\x12\x10\n\x08metadata\x18\x08 \x01(\t\x12\x14\n\x0cuser_created\x18\t \x01(\x08\x12\x18\n\x10use_after_commit\x18\n \x01(\x08\x12\x0f\n\x07\x61liases\x18\x0b \x03(\t\x12\x32\n\x08manifest\x18\x0c \x01(\x0b\x32 .wandb_internal.ArtifactManifest\x12\x16\n\x0e\x64istributed_id\x18\r \x01(\t\x12\x10\n\x08\x66inalize\x18\x0e \x01(\x08\x12\x11\n\tclient_id\x18\x0f \x01(\t\x12\x1a\n\x12sequence_client_id\x18\x10 \x01(\t\x12\x0f\n\x07\x62\x61se_id\x18\x11 \x01(\t\x12\x1c\n\x14ttl_duration_seconds\x18\x12 \x01(\x03\x12\x19\n\x11incremental_beta1\x18\x64 \x01(\x08\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\xbc\x01\n\x10\x41rtifactManifest\x12\x0f\n\x07version\x18\x01 \x01(\x05\x12\x16\n\x0estorage_policy\x18\x02 \x01(\t\x12\x46\n\x15storage_policy_config\x18\x03 \x03(\x0b\x32\'.wandb_internal.StoragePolicyConfigItem\x12\x37\n\x08\x63ontents\x18\x04 \x03(\x0b\x32%.wandb_internal.ArtifactManifestEntry\"\xcf\x01\n\x15\x41rtifactManifestEntry\x12\x0c\n\x04path\x18\x01 \x01(\t\x12\x0e\n\x06\x64igest\x18\x02 \x01(\t\x12\x0b\n\x03ref\x18\x03 \x01(\t\x12\x0c\n\x04size\x18\x04 \x01(\x03\x12\x10\n\x08mimetype\x18\x05 \x01(\t\x12\x12\n\nlocal_path\x18\x06 \x01(\t\x12\x19\n\x11\x62irth_artifact_id\x18\x07 \x01(\t\x12\x12\n\nskip_cache\x18\x08 \x01(\x08\x12(\n\x05\x65xtra\x18\x10 \x03(\x0b\x32\x19.wandb_internal.ExtraItem\",\n\tExtraItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nvalue_json\x18\x02 \x01(\t\":\n\x17StoragePolicyConfigItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nvalue_json\x18\x02 \x01(\t\"\x10\n\x0e\x41rtifactResult\"\x14\n\x12LinkArtifactResult\"\xcf\x01\n\x12LinkArtifactRecord\x12\x11\n\tclient_id\x18\x01 \x01(\t\x12\x11\n\tserver_id\x18\x02 \x01(\t\x12\x16\n\x0eportfolio_name\x18\x03 \x01(\t\x12\x18\n\x10portfolio_entity\x18\x04 \x01(\t\x12\x19\n\x11portfolio_project\x18\x05 \x01(\t\x12\x19\n\x11portfolio_aliases\x18\x06 \x03(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"h\n\x08TBRecord\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\x12\x0f\n\x07log_dir\x18\x01 \x01(\t\x12\x10\n\x08root_dir\x18\x03 \x01(\t\x12\x0c\n\x04save\x18\x02 \x01(\x08\"\n\n\x08TBResult\"}\n\x0b\x41lertRecord\x12\r\n\x05title\x18\x01 \x01(\t\x12\x0c\n\x04text\x18\x02 \x01(\t\x12\r\n\x05level\x18\x03 \x01(\t\x12\x15\n\rwait_duration\x18\x04 \x01(\x03\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\r\n\x0b\x41lertResult\"\x80\x10\n\x07Request\x12\x38\n\x0bstop_status\x18\x01 \x01(\x0b\x32!.wandb_internal.StopStatusRequestH\x00\x12>\n\x0enetwork_status\x18\x02 \x01(\x0b\x32$.wandb_internal.NetworkStatusRequestH\x00\x12-\n\x05\x64\x65\x66\x65r\x18\x03 \x01(\x0b\x32\x1c.wandb_internal.DeferRequestH\x00\x12\x38\n\x0bget_summary\x18\x04 \x01(\x0b\x32!.wandb_internal.GetSummaryRequestH\x00\x12-\n\x05login\x18\x05 \x01(\x0b\x32\x1c.wandb_internal.LoginRequestH\x00\x12-\n\x05pause\x18\x06 \x01(\x0b\x32\x1c.wandb_internal.PauseRequestH\x00\x12/\n\x06resume\x18\x07 \x01(\x0b\x32\x1d.wandb_internal.ResumeRequestH\x00\x12\x34\n\tpoll_exit\x18\x08 \x01(\x0b\x32\x1f.wandb_internal.PollExitRequestH\x00\x12@\n\x0fsampled_history\x18\t \x01(\x0b\x32%.wandb_internal.SampledHistoryRequestH\x00\x12@\n\x0fpartial_history\x18\n \x01(\x0b\x32%.wandb_internal.PartialHistoryRequestH\x00\x12\x34\n\trun_start\x18\x0b \x01(\x0b\x32\x1f.wandb_internal.RunStartRequestH\x00\x12<\n\rcheck_version\x18\x0c \x01(\x0b\x32#.wandb_internal.CheckVersionRequestH\x00\x12:\n\x0clog_artifact\x18\r \x01(\x0b\x32\".wandb_internal.LogArtifactRequestH\x00\x12\x44\n\x11\x64ownload_artifact\x18\x0e \x01(\x0b\x32\'.wandb_internal.DownloadArtifactRequestH\x00\x12\x35\n\tkeepalive\x18\x11 \x01(\x0b\x32 .wandb_internal.KeepaliveRequestH\x00\x12\x36\n\nrun_status\x18\x14 \x01(\x0b\x32 .wandb_internal.RunStatusRequestH\x00\x12/\n\x06\x63\x61ncel\x18\x15 \x01(\x0b\x32\x1d.wandb_internal.CancelRequestH\x00\x12\x33\n\x08metadata\x18\x16 \x01(\x0b\x32\x1f.wandb_internal.MetadataRequestH\x00\x12\x44\n\x11internal_messages\x18\x17 \x01(\x0b\x32\'.wandb_internal.InternalMessagesRequestH\x00\x12@\n\x0fpython_packages\x18\x18 \x01(\x0b\x32%.wandb_internal.PythonPackagesRequestH\x00\x12\x33\n\x08shutdown\x18@ \x01(\x0b\x32\x1f.wandb_internal.ShutdownRequestH\x00\x12/\n\x06\x61ttach\x18\x41 \x01(\x0b\x32\x1d.wandb_internal.AttachRequestH\x00\x12/\n\x06status\x18\x42 \x01(\x0b\x32\x1d.wandb_internal.StatusRequestH\x00\x12\x38\n\x0bserver_info\x18\x43 \x01(\x0b\x32!.wandb_internal.ServerInfoRequestH\x00\x12\x38\n\x0bsender_mark\x18\x44 \x01(\x0b\x32!.wandb_internal.SenderMarkRequestH\x00\x12\x38\n\x0bsender_read\x18\x45 \x01(\x0b\x32!.wandb_internal.SenderReadRequestH\x00\x12<\n\rstatus_report\x18\x46 \x01(\x0b\x32#.wandb_internal.StatusReportRequestH\x00\x12>\n\x0esummary_record\x18G \x01(\x0b\x32$.wandb_internal.SummaryRecordRequestH\x00\x12\x42\n\x10telemetry_record\x18H \x01(\x0b\x32&.wandb_internal.TelemetryRecordRequestH\x00\x12\x32\n\x08job_info\x18I \x01(\x0b\x32\x1e.wandb_internal.JobInfoRequestH\x00\x12\x45\n\x12get_system_metrics\x18J \x01(\x0b\x32\'.wandb_internal.GetSystemMetricsRequestH\x00\x12+\n\x04sync\x18L \x01(\x0b\x32\x1b.wandb_internal.SyncRequestH\x00\x12\x34\n\tjob_input\x18M \x01(\x0b\x32\x1f.wandb_internal.JobInputRequestH\x00\x12@\n\x0fupdate_settings\x18N \x01(\x0b\x32%.wandb_internal.UpdateSettingsRequestH\x00\x12\x39\n\x0btest_inject\x18\xe8\x07 \x01(\x0b\x32!.wandb_internal.TestInjectRequestH\x00\x42\x0e\n\x0crequest_typeJ\x04\x08K\x10L\"\xe2\x0b\n\x08Response\x12?\n\x12keepalive_response\x18\x12 \x01(\x0b\x32!.wandb_internal.KeepaliveResponseH\x00\x12\x42\n\x14stop_status_response\x18\x13 \x01(\x0b\x32\".wandb_internal.StopStatusResponseH\x00\x12H\n\x17network_status_response\x18\x14 \x01(\x0b\x32%.wandb_internal.NetworkStatusResponseH\x00\x12\x37\n\x0elogin_response\x18\x18 \x01(\x0b\x32\x1d.wandb_internal.LoginResponseH\x00\x12\x42\n\x14get_summary_response\x18\x19 \x01(\x0b\x32\".wandb_internal.GetSummaryResponseH\x00\x12>\n\x12poll_exit_response\x18\x1a \x01(\x0b\x32 .wandb_internal.PollExitResponseH\x00\x12J\n\x18sampled_history_response\x18\x1b \x01(\x0b\x32&.wandb_internal.SampledHistoryResponseH\x00\x12>\n\x12run_start_response\x18\x1c \x01(\x0b\x32 .wandb_internal.RunStartResponseH\x00\x12\x46\n\x16\x63heck_version_response\x18\x1d \x01(\x0b\x32$.wandb_internal.CheckVersionResponseH\x00\x12\x44\n\x15log_artifact_response\x18\x1e \x01(\x0b\x32#.wandb_internal.LogArtifactResponseH\x00\x12N\n\x1a\x64ownload_artifact_response\x18\x1f \x01(\x0b\x32(.wandb_internal.DownloadArtifactResponseH\x00\x12@\n\x13run_status_response\x18# \x01(\x0b\x32!.wandb_internal.RunStatusResponseH\x00\x12\x39\n\x0f\x63\x61ncel_response\x18$ \x01(\x0b\x32\x1e.wandb_internal.CancelResponseH\x00\x12N\n\x1ainternal_messages_response\x18% \x01(\x0b\x32(.wandb_internal.InternalMessagesResponseH\x00\x12=\n\x11shutdown_response\x18@ \x01(\x0b\x32 .wandb_internal.ShutdownResponseH\x00\x12\x39\n\x0f\x61ttach_response\x18\x41 \x01(\x0b\x32\x1e.wandb_internal.AttachResponseH\x00\x12\x39\n\x0fstatus_response\x18\x42 \x01(\x0b\x32\x1e.wandb_internal.StatusResponseH\x00\x12\x42\n\x14server_info_response\x18\x43 \x01(\x0b\x32\".wandb_internal.ServerInfoResponseH\x00\x12<\n\x11job_info_response\x18\x44 \x01(\x0b\x32\x1f.wandb_internal.JobInfoResponseH\x00\x12O\n\x1bget_system_metrics_response\x18\x45 \x01(\x0b\x32(.wandb_internal.GetSystemMetricsResponseH\x00\x12\x35\n\rsync_response\x18\x46 \x01(\x0b\x32\x1c.wandb_internal.SyncResponseH\x00\x12\x43\n\x14test_inject_response\x18\xe8\x07 \x01(\x0b\x32\".wandb_internal.TestInjectResponseH\x00\x42\x0f\n\rresponse_type\"\x8c\x01\n\x15UpdateSettingsRequest\x12!\n\x19stats_sample_rate_seconds\x18\x01 \x01(\x01\x12\x0f\n\x07\x63onsole\x18\x02 \x01(\t\x12\x11\n\tlog_level\x18\x03 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\xc0\x02\n\x0c\x44\x65\x66\x65rRequest\x12\x36\n\x05state\x18\x01 \x01(\x0e\x32\'.wandb_internal.DeferRequest.DeferState\"\xf7\x01\n\nDeferState\x12\t\n\x05\x42\x45GIN\x10\x00\x12\r\n\tFLUSH_RUN\x10\x01\x12\x0f\n\x0b\x46LUSH_STATS\x10\x02\x12\x19\n\x15\x46LUSH_PARTIAL_HISTORY\x10\x03\x12\x0c\n\x08\x46LUSH_TB\x10\x04\x12\r\n\tFLUSH_SUM\x10\x05\x12\x13\n\x0f\x46LUSH_DEBOUNCER\x10\x06\x12\x10\n\x0c\x46LUSH_OUTPUT\x10\x07\x12\r\n\tFLUSH_JOB\x10\x08\x12\r\n\tFLUSH_DIR\x10\t\x12\x0c\n\x08\x46LUSH_FP\x10\n\x12\x0b\n\x07JOIN_FP\x10\x0b\x12\x0c\n\x08\x46LUSH_FS\x10\x0c\x12\x0f\n\x0b\x46LUSH_FINAL\x10\r\x12\x07\n\x03\x45ND\x10\x0e\"<\n\x0cPauseRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x0f\n\rPauseResponse\"=\n\rResumeRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x10\n\x0eResumeResponse\"M\n\x0cLoginRequest\x12\x0f\n\x07\x61pi_key\x18\x01 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"&\n\rLoginResponse\x12\x15\n\ractive_entity\x18\x01 \x01(\t\"A\n\x11GetSummaryRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"?\n\x12GetSummaryResponse\x12)\n\x04item\x18\x01 \x03(\x0b\x32\x1b.wandb_internal.SummaryItem\"G\n\x17GetSystemMetricsRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"R\n\x12SystemMetricSample\x12-\n\ttimestamp\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\r\n\x05value\x18\x02 \x01(\x02\"I\n\x13SystemMetricsBuffer\x12\x32\n\x06record\x18\x01 \x03(\x0b\x32\".wandb_internal.SystemMetricSample\"\xca\x01\n\x18GetSystemMetricsResponse\x12S\n\x0esystem_metrics\x18\x01 \x03(\x0b\x32;.wandb_internal.GetSystemMetricsResponse.SystemMetricsEntry\x1aY\n\x12SystemMetricsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x32\n\x05value\x18\x02 \x01(\x0b\x32#.wandb_internal.SystemMetricsBuffer:\x02\x38\x01\"=\n\rStatusRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\")\n\x0eStatusResponse\x12\x17\n\x0frun_should_stop\x18\x01 \x01(\x08\"A\n\x11StopStatusRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"-\n\x12StopStatusResponse\x12\x17\n\x0frun_should_stop\x18\x01 \x01(\x08\"D\n\x14NetworkStatusRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"P\n\x15NetworkStatusResponse\x12\x37\n\x11network_responses\x18\x01 \x03(\x0b\x32\x1c.wandb_internal.HttpResponse\"D\n\x0cHttpResponse\x12\x18\n\x10http_status_code\x18\x01 \x01(\x05\x12\x1a\n\x12http_response_text\x18\x02 \x01(\t\"G\n\x17InternalMessagesRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"N\n\x18InternalMessagesResponse\x12\x32\n\x08messages\x18\x01 \x01(\x0b\x32 .wandb_internal.InternalMessages\"M\n\x10InternalMessages\x12\x0f\n\x07warning\x18\x01 \x03(\t\x12(\n\x05\x65rror\x18\x02 \x03(\x0b\x32\x19.wandb_internal.ErrorInfo\"?\n\x0fPollExitRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\xbc\x01\n\x10PollExitResponse\x12\x0c\n\x04\x64one\x18\x01 \x01(\x08\x12\x32\n\x0b\x65xit_result\x18\x02 \x01(\x0b\x32\x1d.wandb_internal.RunExitResult\x12\x35\n\x0cpusher_stats\x18\x03 \x01(\x0b\x32\x1f.wandb_internal.FilePusherStats\x12/\n\x0b\x66ile_counts\x18\x04 \x01(\x0b\x32\x1a.wandb_internal.FileCounts\"@\n\rSyncOverwrite\x12\x0e\n\x06run_id\x18\x01 \x01(\t\x12\x0e\n\x06\x65ntity\x18\x02 \x01(\t\x12\x0f\n\x07project\x18\x03 \x01(\t\"\x1e\n\x08SyncSkip\x12\x12\n\noutput_raw\x18\x01 \x01(\x08\"\x13\n\x11SenderMarkRequest\"\x93\x01\n\x0bSyncRequest\x12\x14\n\x0cstart_offset\x18\x01 \x01(\x03\x12\x14\n\x0c\x66inal_offset\x18\x02 \x01(\x03\x12\x30\n\toverwrite\x18\x03 \x01(\x0b\x32\x1d.wandb_internal.SyncOverwrite\x12&\n\x04skip\x18\x04 \x01(\x0b\x32\x18.wandb_internal.SyncSkip\"E\n\x0cSyncResponse\x12\x0b\n\x03url\x18\x01 \x01(\t\x12(\n\x05\x65rror\x18\x02 \x01(\x0b\x32\x19.wandb_internal.ErrorInfo\"?\n\x11SenderReadRequest\x12\x14\n\x0cstart_offset\x18\x01 \x01(\x03\x12\x14\n\x0c\x66inal_offset\x18\x02 \x01(\x03\"m\n\x13StatusReportRequest\x12\x12\n\nrecord_num\x18\x01 \x01(\x03\x12\x13\n\x0bsent_offset\x18\x02 \x01(\x03\x12-\n\tsync_time\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"F\n\x14SummaryRecordRequest\x12.\n\x07summary\x18\x01 \x01(\x0b\x32\x1d.wandb_internal.SummaryRecord\"L\n\x16TelemetryRecordRequest\x12\x32\n\ttelemetry\x18\x01 \x01(\x0b\x32\x1f.wandb_internal.TelemetryRecord\"A\n\x11ServerInfoRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"|\n\x12ServerInfoResponse\x12-\n\nlocal_info\x18\x01 \x01(\x0b\x32\x19.wandb_internal.LocalInfo\x12\x37\n\x0fserver_messages\x18\x02 \x01(\x0b\x32\x1e.wandb_internal.ServerMessages\"=\n\x0eServerMessages\x12+\n\x04item\x18\x01 \x03(\x0b\x32\x1d.wandb_internal.ServerMessage\"e\n\rServerMessage\x12\x12\n\nplain_text\x18\x01 \x01(\t\x12\x10\n\x08utf_text\x18\x02 \x01(\t\x12\x11\n\thtml_text\x18\x03 \x01(\t\x12\x0c\n\x04type\x18\x04 \x01(\t\x12\r\n\x05level\x18\x05 \x01(\x05\"c\n\nFileCounts\x12\x13\n\x0bwandb_count\x18\x01 \x01(\x05\x12\x13\n\x0bmedia_count\x18\x02 \x01(\x05\x12\x16\n\x0e\x61rtifact_count\x18\x03 \x01(\x05\x12\x13\n\x0bother_count\x18\x04 \x01(\x05\"U\n\x0f\x46ilePusherStats\x12\x16\n\x0euploaded_bytes\x18\x01 \x01(\x03\x12\x13\n\x0btotal_bytes\x18\x02 \x01(\x03\x12\x15\n\rdeduped_bytes\x18\x03 \x01(\x03\"\x1e\n\rFilesUploaded\x12\r\n\x05\x66iles\x18\x01 \x03(\t\"\xf4\x01\n\x17\x46ileTransferInfoRequest\x12\x42\n\x04type\x18\x01 \x01(\x0e\x32\x34.wandb_internal.FileTransferInfoRequest.TransferType\x12\x0c\n\x04path\x18\x02 \x01(\t\x12\x0b\n\x03url\x18\x03 \x01(\t\x12\x0c\n\x04size\x18\x04 \x01(\x03\x12\x11\n\tprocessed\x18\x05 \x01(\x03\x12/\n\x0b\x66ile_counts\x18\x06 \x01(\x0b\x32\x1a.wandb_internal.FileCounts\"(\n\x0cTransferType\x12\n\n\x06Upload\x10\x00\x12\x0c\n\x08\x44ownload\x10\x01\"1\n\tLocalInfo\x12\x0f\n\x07version\x18\x01 \x01(\t\x12\x13\n\x0bout_of_date\x18\x02 \x01(\x08\"?\n\x0fShutdownRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x12\n\x10ShutdownResponse\"P\n\rAttachRequest\x12\x11\n\tattach_id\x18\x14 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"b\n\x0e\x41ttachResponse\x12&\n\x03run\x18\x01 \x01(\x0b\x32\x19.wandb_internal.RunRecord\x12(\n\x05\x65rror\x18\x02 \x01(\x0b\x32\x19.wandb_internal.ErrorInfo\"\xd5\x02\n\x11TestInjectRequest\x12\x13\n\x0bhandler_exc\x18\x01 \x01(\x08\x12\x14\n\x0chandler_exit\x18\x02 \x01(\x08\x12\x15\n\rhandler_abort\x18\x03 \x01(\x08\x12\x12\n\nsender_exc\x18\x04 \x01(\x08\x12\x13\n\x0bsender_exit\x18\x05 \x01(\x08\x12\x14\n\x0csender_abort\x18\x06 \x01(\x08\x12\x0f\n\x07req_exc\x18\x07 \x01(\x08\x12\x10\n\x08req_exit\x18\x08 \x01(\x08\x12\x11\n\treq_abort\x18\t \x01(\x08\x12\x10\n\x08resp_exc\x18\n \x01(\x08\x12\x11\n\tresp_exit\x18\x0b \x01(\x08\x12\x12\n\nresp_abort\x18\x0c \x01(\x08\x12\x10\n\x08msg_drop\x18\r \x01(\x08\x12\x10\n\x08msg_hang\x18\x0e \x01(\x08\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x14\n\x12TestInjectResponse\"\x1e\n\rHistoryAction\x12\r\n\x05\x66lush\x18\x01 \x01(\x08\"\xca\x01\n\x15PartialHistoryRequest\x12)\n\x04item\x18\x01 \x03(\x0b\x32\x1b.wandb_internal.HistoryItem\x12)\n\x04step\x18\x02 \x01(\x0b\x32\x1b.wandb_internal.HistoryStep\x12-\n\x06\x61\x63tion\x18\x03 \x01(\x0b\x32\x1d.wandb_internal.HistoryAction\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x18\n\x16PartialHistoryResponse\"E\n\x15SampledHistoryRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"_\n\x12SampledHistoryItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nnested_key\x18\x02 \x03(\t\x12\x14\n\x0cvalues_float\x18\x03 \x03(\x02\x12\x12\n\nvalues_int\x18\x04 \x03(\x03\"J\n\x16SampledHistoryResponse\x12\x30\n\x04item\x18\x01 \x03(\x0b\x32\".wandb_internal.SampledHistoryItem\"@\n\x10RunStatusRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"x\n\x11RunStatusResponse\x12\x18\n\x10sync_items_total\x18\x01 \x01(\x03\x12\x1a\n\x12sync_items_pending\x18\x02 \x01(\x03\x12-\n\tsync_time\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"g\n\x0fRunStartRequest\x12&\n\x03run\x18\x01 \x01(\x0b\x32\x19.wandb_internal.RunRecord\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x12\n\x10RunStartResponse\"\\\n\x13\x43heckVersionRequest\x12\x17\n\x0f\x63urrent_version\x18\x01 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"]\n\x14\x43heckVersionResponse\x12\x17\n\x0fupgrade_message\x18\x01 \x01(\t\x12\x14\n\x0cyank_message\x18\x02 \x01(\t\x12\x16\n\x0e\x64\x65lete_message\x18\x03 \x01(\t\">\n\x0eJobInfoRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"6\n\x0fJobInfoResponse\x12\x12\n\nsequenceId\x18\x01 \x01(\t\x12\x0f\n\x07version\x18\x02 \x01(\t\"\x9f\x01\n\x12LogArtifactRequest\x12\x30\n\x08\x61rtifact\x18\x01 \x01(\x0b\x32\x1e.wandb_internal.ArtifactRecord\x12\x14\n\x0chistory_step\x18\x02 \x01(\x03\x12\x13\n\x0bstaging_dir\x18\x03 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"A\n\x13LogArtifactResponse\x12\x13\n\x0b\x61rtifact_id\x18\x01 \x01(\t\x12\x15\n\rerror_message\x18\x02 \x01(\t\"\xd2\x01\n\x17\x44ownloadArtifactRequest\x12\x13\n\x0b\x61rtifact_id\x18\x01 \x01(\t\x12\x15\n\rdownload_root\x18\x02 \x01(\t\x12 \n\x18\x61llow_missing_references\x18\x04 \x01(\x08\x12\x12\n\nskip_cache\x18\x05 \x01(\x08\x12\x13\n\x0bpath_prefix\x18\x06 \x01(\t\x12\x12\n\npath_globs\x18\x07 \x03(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"1\n\x18\x44ownloadArtifactResponse\x12\x15\n\rerror_message\x18\x01 \x01(\t\"@\n\x10KeepaliveRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x13\n\x11KeepaliveResponse\"q\n\x0c\x41rtifactInfo\x12\x10\n\x08\x61rtifact\x18\x01 \x01(\t\x12\x12\n\nentrypoint\x18\x02 \x03(\t\x12\x10\n\x08notebook\x18\x03 \x01(\x08\x12\x15\n\rbuild_context\x18\x04 \x01(\t\x12\x12\n\ndockerfile\x18\x05 \x01(\t\")\n\x07GitInfo\x12\x0e\n\x06remote\x18\x01 \x01(\t\x12\x0e\n\x06\x63ommit\x18\x02 \x01(\t\"\x87\x01\n\tGitSource\x12)\n\x08git_info\x18\x01 \x01(\x0b\x32\x17.wandb_internal.GitInfo\x12\x12\n\nentrypoint\x18\x02 \x03(\t\x12\x10\n\x08notebook\x18\x03 \x01(\x08\x12\x15\n\rbuild_context\x18\x04 \x01(\t\x12\x12\n\ndockerfile\x18\x05 \x01(\t\"\x1c\n\x0bImageSource\x12\r\n\x05image\x18\x01 \x01(\t\"\x8c\x01\n\x06Source\x12&\n\x03git\x18\x01 \x01(\x0b\x32\x19.wandb_internal.GitSource\x12.\n\x08\x61rtifact\x18\x02 \x01(\x0b\x32\x1c.wandb_internal.ArtifactInfo\x12*\n\x05image\x18\x03 \x01(\x0b\x32\x1b.wandb_internal.ImageSource\"k\n\tJobSource\x12\x10\n\x08_version\x18\x01 \x01(\t\x12\x13\n\x0bsource_type\x18\x02 \x01(\t\x12&\n\x06source\x18\x03 \x01(\x0b\x32\x16.wandb_internal.Source\x12\x0f\n\x07runtime\x18\x04 \x01(\t\"V\n\x12PartialJobArtifact\x12\x10\n\x08job_name\x18\x01 \x01(\t\x12.\n\x0bsource_info\x18\x02 \x01(\x0b\x32\x19.wandb_internal.JobSource\"\x9d\x01\n\x11UseArtifactRecord\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04type\x18\x02 \x01(\t\x12\x0c\n\x04name\x18\x03 \x01(\t\x12\x33\n\x07partial\x18\x04 \x01(\x0b\x32\".wandb_internal.PartialJobArtifact\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x13\n\x11UseArtifactResult\"R\n\rCancelRequest\x12\x13\n\x0b\x63\x61ncel_slot\x18\x01 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x10\n\x0e\x43\x61ncelResponse\"\'\n\x08\x44iskInfo\x12\r\n\x05total\x18\x01 \x01(\x04\x12\x0c\n\x04used\x18\x02 \x01(\x04\"\x1b\n\nMemoryInfo\x12\r\n\x05total\x18\x01 \x01(\x04\"/\n\x07\x43puInfo\x12\r\n\x05\x63ount\x18\x01 \x01(\r\x12\x15\n\rcount_logical\x18\x02 \x01(\r\">\n\x0cGpuAppleInfo\x12\x0f\n\x07gpuType\x18\x01 \x01(\t\x12\x0e\n\x06vendor\x18\x02 \x01(\t\x12\r\n\x05\x63ores\x18\x03 \x01(\r\"3\n\rGpuNvidiaInfo\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x14\n\x0cmemory_total\x18\x02 \x01(\x04\"\x89\x02\n\nGpuAmdInfo\x12\n\n\x02id\x18\x01 \x01(\t\x12\x11\n\tunique_id\x18\x02 \x01(\t\x12\x15\n\rvbios_version\x18\x03 \x01(\t\x12\x19\n\x11performance_level\x18\x04 \x01(\t\x12\x15\n\rgpu_overdrive\x18\x05 \x01(\t\x12\x1c\n\x14gpu_memory_overdrive\x18\x06 \x01(\t\x12\x11\n\tmax_power\x18\x07 \x01(\t\x12\x0e\n\x06series\x18\x08 \x01(\t\x12\r\n\x05model\x18\t \x01(\t\x12\x0e\n\x06vendor\x18\n \x01(\t\x12\x0b\n\x03sku\x18\x0b \x01(\t\x12\x12\n\nsclk_range\x18\x0c \x01(\t\x12\x12\n\nmclk_range\x18\r \x01(\t\"z\n\rContainerInfo\x12\x0f\n\x07runtime\x18\x01 \x01(\t\x12\n\n\x02id\x18\x02 \x01(\t\x12\r\n\x05image\x18\x03 \x01(\t\x12\x14\n\x0cimage_digest\x18\x04 \x01(\t\x12\x11\n\tcpu_limit\x18\x05 \x01(\x01\x12\x14\n\x0cmemory_limit\x18\x06 \x01(\x04\"\xa0\x03\n\x0eKubernetesInfo\x12\x10\n\x08pod_name\x18\x01 \x01(\t\x12\x11\n\tnamespace\x18\x02 \x01(\t\x12\x11\n\tnode_name\x18\x03 \x01(\t\x12\x0f\n\x07pod_uid\x18\x04 \x01(\t\x12>\n\x08requests\x18\x05 \x03(\x0b\x32,.wandb_internal.KubernetesInfo.RequestsEntry\x12:\n\x06limits\x18\x06 \x03(\x0b\x32*.wandb_internal.KubernetesInfo.LimitsEntry\x12:\n\x06labels\x18\x07 \x03(\x0b\x32*.wandb_internal.KubernetesInfo.LabelsEntry\x1a/\n\rRequestsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\x1a-\n\x0bLimitsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\x1a-\n\x0bLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\xdf\t\n\x0fMetadataRequest\x12\n\n\x02os\x18\x01 \x01(\t\x12\x0e\n\x06python\x18\x02 \x01(\t\x12/\n\x0bheartbeatAt\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12-\n\tstartedAt\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0e\n\x06\x64ocker\x18\x05 \x01(\t\x12\x0c\n\x04\x63uda\x18\x06 \x01(\t\x12\x0c\n\x04\x61rgs\x18\x07 \x03(\t\x12\r\n\x05state\x18\x08 \x01(\t\x12\x0f\n\x07program\x18\t \x01(\t\x12\x1b\n\tcode_path\x18\n \x01(\tR\x08\x63odePath\x12*\n\x03git\x18\x0b \x01(\x0b\x32\x1d.wandb_internal.GitRepoRecord\x12\r\n\x05\x65mail\x18\x0c \x01(\t\x12\x0c\n\x04root\x18\r \x01(\t\x12\x0c\n\x04host\x18\x0e \x01(\t\x12\x10\n\x08username\x18\x0f \x01(\t\x12\x12\n\nexecutable\x18\x10 \x01(\t\x12&\n\x0f\x63ode_path_local\x18\x11 \x01(\tR\rcodePathLocal\x12\r\n\x05\x63olab\x18\x12 \x01(\t\x12\x1c\n\tcpu_count\x18\x13 \x01(\rR\tcpu_count\x12,\n\x11\x63pu_count_logical\x18\x14 \x01(\rR\x11\x63pu_count_logical\x12\x15\n\x08gpu_type\x18\x15 \x01(\tR\x03gpu\x12\x1c\n\tgpu_count\x18\x16 \x01(\rR\tgpu_count\x12\x37\n\x04\x64isk\x18\x17 \x03(\x0b\x32).wandb_internal.MetadataRequest.DiskEntry\x12*\n\x06memory\x18\x18 \x01(\x0b\x32\x1a.wandb_internal.MemoryInfo\x12$\n\x03\x63pu\x18\x19 \x01(\x0b\x32\x17.wandb_internal.CpuInfo\x12\x39\n\tgpu_apple\x18\x1a \x01(\x0b\x32\x1c.wandb_internal.GpuAppleInfoR\x08gpuapple\x12=\n\ngpu_nvidia\x18\x1b \x03(\x0b\x32\x1d.wandb_internal.GpuNvidiaInfoR\ngpu_nvidia\x12\x34\n\x07gpu_amd\x18\x1c \x03(\x0b\x32\x1a.wandb_internal.GpuAmdInfoR\x07gpu_amd\x12\x39\n\x05slurm\x18\x1d \x03(\x0b\x32*.wandb_internal.MetadataRequest.SlurmEntry\x12\x30\n\tcontainer\x18\x1e \x01(\x0b\x32\x1d.wandb_internal.ContainerInfo\x12\x32\n\nkubernetes\x18\x1f \x01(\x0b\x32\x1e.wandb_internal.KubernetesInfo\x12\x35\n\x03pbs\x18  \x03(\x0b\x32(.wandb_internal.MetadataRequest.PbsEntry\x1a\x45\n\tDiskEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\'\n\x05value\x18\x02 \x01(\x0b\x32\x18.wandb_internal.DiskInfo:\x02\x38\x01\x1a,\n\nSlurmEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\x1a*\n\x08PbsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\x8d\x01\n\x15PythonPackagesRequest\x12\x44\n\x07package\x18\x01 \x03(\x0b\x32\x33.wandb_internal.PythonPackagesRequest.PythonPackage\x1a.\n\rPythonPackage\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0f\n\x07version\x18\x02 \x01(\t\"\x1c\n\x0cJobInputPath\x12\x0c\n\x04path\x18\x01 \x03(\t\"\xd6\x01\n\x0eJobInputSource\x12\x44\n\nrun_config\x18\x01 \x01(\x0b\x32..wandb_internal.JobInputSource.RunConfigSourceH\x00\x12?\n\x04\x66ile\x18\x02 \x01(\x0b\x32/.wandb_internal.JobInputSource.ConfigFileSourceH\x00\x1a\x11\n\x0fRunConfigSource\x1a \n\x10\x43onfigFileSource\x12\x0c\n\x04path\x18\x01 \x01(\tB\x08\n\x06source\"\xb1\x01\n\x0fJobInputRequest\x12\x34\n\x0cinput_source\x18\x01 \x01(\x0b\x32\x1e.wandb_internal.JobInputSource\x12\x33\n\rinclude_paths\x18\x02 \x03(\x0b\x32\x1c.wandb_internal.JobInputPath\x12\x33\n\rexclude_paths\x18\x03 \x03(\x0b\x32\x1c.wandb_internal.JobInputPathb\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -26,330 +26,356 @@ if not _descriptor._USE_C_DESCRIPTORS:
   DESCRIPTOR._loaded_options = None
   _globals['_GETSYSTEMMETRICSRESPONSE_SYSTEMMETRICSENTRY']._loaded_options = None
   _globals['_GETSYSTEMMETRICSRESPONSE_SYSTEMMETRICSENTRY']._serialized_options = b'8\001'
+  _globals['_KUBERNETESINFO_REQUESTSENTRY']._loaded_options = None
+  _globals['_KUBERNETESINFO_REQUESTSENTRY']._serialized_options = b'8\001'
+  _globals['_KUBERNETESINFO_LIMITSENTRY']._loaded_options = None
+  _globals['_KUBERNETESINFO_LIMITSENTRY']._serialized_options = b'8\001'
+  _globals['_KUBERNETESINFO_LABELSENTRY']._loaded_options = None
+  _globals['_KUBERNETESINFO_LABELSENTRY']._serialized_options = b'8\001'
   _globals['_METADATAREQUEST_DISKENTRY']._loaded_options = None
   _globals['_METADATAREQUEST_DISKENTRY']._serialized_options = b'8\001'
   _globals['_METADATAREQUEST_SLURMENTRY']._loaded_options = None
   _globals['_METADATAREQUEST_SLURMENTRY']._serialized_options = b'8\001'
+  _globals['_METADATAREQUEST_PBSENTRY']._loaded_options = None
+  _globals['_METADATAREQUEST_PBSENTRY']._serialized_options = b'8\001'
   _globals['_RECORD']._serialized_start=151
-  _globals['_RECORD']._serialized_end=1331
-  _globals['_CONTROL']._serialized_start=1334
-  _globals['_CONTROL']._serialized_end=1502
-  _globals['_RESULT']._serialized_start=1505
-  _globals['_RESULT']._serialized_end=2004
-  _globals['_FINALRECORD']._serialized_start=2006
-  _globals['_FINALRECORD']._serialized_end=2064
-  _globals['_VERSIONINFO']._serialized_start=2066
-  _globals['_VERSIONINFO']._serialized_end=2164
-  _globals['_HEADERRECORD']._serialized_start=2166
-  _globals['_HEADERRECORD']._serialized_end=2276
-  _globals['_FOOTERRECORD']._serialized_start=2278
-  _globals['_FOOTERRECORD']._serialized_end=2337
-  _globals['_RUNRECORD']._serialized_start=2340
-  _globals['_RUNRECORD']._serialized_end=2946
-  _globals['_GITREPORECORD']._serialized_start=2948
-  _globals['_GITREPORECORD']._serialized_end=3007
-  _globals['_RUNUPDATERESULT']._serialized_start=3009
-  _globals['_RUNUPDATERESULT']._serialized_end=3108
-  _globals['_ERRORINFO']._serialized_start=3111
-  _globals['_ERRORINFO']._serialized_end=3283
-  _globals['_ERRORINFO_ERRORCODE']._serialized_start=3192
-  _globals['_ERRORINFO_ERRORCODE']._serialized_end=3283
-  _globals['_RUNEXITRECORD']._serialized_start=3285
-  _globals['_RUNEXITRECORD']._serialized_end=3381
-  _globals['_RUNEXITRESULT']._serialized_start=3383
-  _globals['_RUNEXITRESULT']._serialized_end=3398
-  _globals['_RUNPREEMPTINGRECORD']._serialized_start=3400
-  _globals['_RUNPREEMPTINGRECORD']._serialized_end=3466
-  _globals['_RUNPREEMPTINGRESULT']._serialized_start=3468
-  _globals['_RUNPREEMPTINGRESULT']._serialized_end=3489
-  _globals['_SETTINGSRECORD']._serialized_start=3491
-  _globals['_SETTINGSRECORD']._serialized_end=3596
-  _globals['_SETTINGSITEM']._serialized_start=3598
-  _globals['_SETTINGSITEM']._serialized_end=3645
-  _globals['_HISTORYSTEP']._serialized_start=3647
-  _globals['_HISTORYSTEP']._serialized_end=3673
-  _globals['_HISTORYRECORD']._serialized_start=3676
-  _globals['_HISTORYRECORD']._serialized_end=3822
-  _globals['_HISTORYITEM']._serialized_start=3824
-  _globals['_HISTORYITEM']._serialized_end=3890
-  _globals['_HISTORYRESULT']._serialized_start=3892
-  _globals['_HISTORYRESULT']._serialized_end=3907
-  _globals['_OUTPUTRECORD']._serialized_start=3910
-  _globals['_OUTPUTRECORD']._serialized_end=4130
-  _globals['_OUTPUTRECORD_OUTPUTTYPE']._serialized_start=4094
-  _globals['_OUTPUTRECORD_OUTPUTTYPE']._serialized_end=4130
-  _globals['_OUTPUTRESULT']._serialized_start=4132
-  _globals['_OUTPUTRESULT']._serialized_end=4146
-  _globals['_OUTPUTRAWRECORD']._serialized_start=4149
-  _globals['_OUTPUTRAWRECORD']._serialized_end=4375
-  _globals['_OUTPUTRAWRECORD_OUTPUTTYPE']._serialized_start=4094
-  _globals['_OUTPUTRAWRECORD_OUTPUTTYPE']._serialized_end=4130
-  _globals['_OUTPUTRAWRESULT']._serialized_start=4377
-  _globals['_OUTPUTRAWRESULT']._serialized_end=4394
-  _globals['_METRICRECORD']._serialized_start=4397
-  _globals['_METRICRECORD']._serialized_end=4805
-  _globals['_METRICRECORD_METRICGOAL']._serialized_start=4739
-  _globals['_METRICRECORD_METRICGOAL']._serialized_end=4805
-  _globals['_METRICRESULT']._serialized_start=4807
-  _globals['_METRICRESULT']._serialized_end=4821
-  _globals['_METRICOPTIONS']._serialized_start=4823
-  _globals['_METRICOPTIONS']._serialized_end=4890
-  _globals['_METRICCONTROL']._serialized_start=4892
-  _globals['_METRICCONTROL']._serialized_end=4926
-  _globals['_METRICSUMMARY']._serialized_start=4928
-  _globals['_METRICSUMMARY']._serialized_end=5039
-  _globals['_CONFIGRECORD']._serialized_start=5042
-  _globals['_CONFIGRECORD']._serialized_end=5189
-  _globals['_CONFIGITEM']._serialized_start=5191
-  _globals['_CONFIGITEM']._serialized_end=5256
-  _globals['_CONFIGRESULT']._serialized_start=5258
-  _globals['_CONFIGRESULT']._serialized_end=5272
-  _globals['_SUMMARYRECORD']._serialized_start=5275
-  _globals['_SUMMARYRECORD']._serialized_end=5425
-  _globals['_SUMMARYITEM']._serialized_start=5427
-  _globals['_SUMMARYITEM']._serialized_end=5493
-  _globals['_SUMMARYRESULT']._serialized_start=5495
-  _globals['_SUMMARYRESULT']._serialized_end=5510
-  _globals['_FILESRECORD']._serialized_start=5512
-  _globals['_FILESRECORD']._serialized_end=5612
-  _globals['_FILESITEM']._serialized_start=5615
-  _globals['_FILESITEM']._serialized_end=5851
-  _globals['_FILESITEM_POLICYTYPE']._serialized_start=5746
-  _globals['_FILESITEM_POLICYTYPE']._serialized_end=5786
-  _globals['_FILESITEM_FILETYPE']._serialized_start=5788
-  _globals['_FILESITEM_FILETYPE']._serialized_end=5845
-  _globals['_FILESRESULT']._serialized_start=5853
-  _globals['_FILESRESULT']._serialized_end=5866
-  _globals['_STATSRECORD']._serialized_start=5869
-  _globals['_STATSRECORD']._serialized_end=6099
-  _globals['_STATSRECORD_STATSTYPE']._serialized_start=6076
-  _globals['_STATSRECORD_STATSTYPE']._serialized_end=6099
-  _globals['_STATSITEM']._serialized_start=6101
-  _globals['_STATSITEM']._serialized_end=6145
-  _globals['_ARTIFACTRECORD']._serialized_start=6148
-  _globals['_ARTIFACTRECORD']._serialized_end=6621
-  _globals['_ARTIFACTMANIFEST']._serialized_start=6624
-  _globals['_ARTIFACTMANIFEST']._serialized_end=6812
-  _globals['_ARTIFACTMANIFESTENTRY']._serialized_start=6815
-  _globals['_ARTIFACTMANIFESTENTRY']._serialized_end=7022
-  _globals['_EXTRAITEM']._serialized_start=7024
-  _globals['_EXTRAITEM']._serialized_end=7068
-  _globals['_STORAGEPOLICYCONFIGITEM']._serialized_start=7070
-  _globals['_STORAGEPOLICYCONFIGITEM']._serialized_end=7128
-  _globals['_ARTIFACTRESULT']._serialized_start=7130
-  _globals['_ARTIFACTRESULT']._serialized_end=7146
-  _globals['_LINKARTIFACTRESULT']._serialized_start=7148
-  _globals['_LINKARTIFACTRESULT']._serialized_end=7168
-  _globals['_LINKARTIFACTRECORD']._serialized_start=7171
-  _globals['_LINKARTIFACTRECORD']._serialized_end=7378
-  _globals['_TBRECORD']._serialized_start=7380
-  _globals['_TBRECORD']._serialized_end=7484
-  _globals['_TBRESULT']._serialized_start=7486
-  _globals['_TBRESULT']._serialized_end=7496
-  _globals['_ALERTRECORD']._serialized_start=7498
-  _globals['_ALERTRECORD']._serialized_end=7623
-  _globals['_ALERTRESULT']._serialized_start=7625
-  _globals['_ALERTRESULT']._serialized_end=7638
-  _globals['_REQUEST']._serialized_start=7641
-  _globals['_REQUEST']._serialized_end=9623
-  _globals['_RESPONSE']._serialized_start=9626
-  _globals['_RESPONSE']._serialized_end=11132
-  _globals['_DEFERREQUEST']._serialized_start=11135
-  _globals['_DEFERREQUEST']._serialized_end=11455
-  _globals['_DEFERREQUEST_DEFERSTATE']._serialized_start=11208
-  _globals['_DEFERREQUEST_DEFERSTATE']._serialized_end=11455
-  _globals['_PAUSEREQUEST']._serialized_start=11457
-  _globals['_PAUSEREQUEST']._serialized_end=11517
-  _globals['_PAUSERESPONSE']._serialized_start=11519
-  _globals['_PAUSERESPONSE']._serialized_end=11534
-  _globals['_RESUMEREQUEST']._serialized_start=11536
-  _globals['_RESUMEREQUEST']._serialized_end=11597
-  _globals['_RESUMERESPONSE']._serialized_start=11599
-  _globals['_RESUMERESPONSE']._serialized_end=11615
-  _globals['_LOGINREQUEST']._serialized_start=11617
-  _globals['_LOGINREQUEST']._serialized_end=11694
-  _globals['_LOGINRESPONSE']._serialized_start=11696
-  _globals['_LOGINRESPONSE']._serialized_end=11734
-  _globals['_GETSUMMARYREQUEST']._serialized_start=11736
-  _globals['_GETSUMMARYREQUEST']._serialized_end=11801
-  _globals['_GETSUMMARYRESPONSE']._serialized_start=11803
-  _globals['_GETSUMMARYRESPONSE']._serialized_end=11866
-  _globals['_GETSYSTEMMETRICSREQUEST']._serialized_start=11868
-  _globals['_GETSYSTEMMETRICSREQUEST']._serialized_end=11939
-  _globals['_SYSTEMMETRICSAMPLE']._serialized_start=11941
-  _globals['_SYSTEMMETRICSAMPLE']._serialized_end=12023
-  _globals['_SYSTEMMETRICSBUFFER']._serialized_start=12025
-  _globals['_SYSTEMMETRICSBUFFER']._serialized_end=12098
-  _globals['_GETSYSTEMMETRICSRESPONSE']._serialized_start=12101
-  _globals['_GETSYSTEMMETRICSRESPONSE']._serialized_end=12303
-  _globals['_GETSYSTEMMETRICSRESPONSE_SYSTEMMETRICSENTRY']._serialized_start=12214
-  _globals['_GETSYSTEMMETRICSRESPONSE_SYSTEMMETRICSENTRY']._serialized_end=12303
-  _globals['_STATUSREQUEST']._serialized_start=12305
-  _globals['_STATUSREQUEST']._serialized_end=12366
-  _globals['_STATUSRESPONSE']._serialized_start=12368
-  _globals['_STATUSRESPONSE']._serialized_end=12409
-  _globals['_STOPSTATUSREQUEST']._serialized_start=12411
-  _globals['_STOPSTATUSREQUEST']._serialized_end=12476
-  _globals['_STOPSTATUSRESPONSE']._serialized_start=12478
-  _globals['_STOPSTATUSRESPONSE']._serialized_end=12523
-  _globals['_NETWORKSTATUSREQUEST']._serialized_start=12525
-  _globals['_NETWORKSTATUSREQUEST']._serialized_end=12593
-  _globals['_NETWORKSTATUSRESPONSE']._serialized_start=12595
-  _globals['_NETWORKSTATUSRESPONSE']._serialized_end=12675
-  _globals['_HTTPRESPONSE']._serialized_start=12677
-  _globals['_HTTPRESPONSE']._serialized_end=12745
-  _globals['_INTERNALMESSAGESREQUEST']._serialized_start=12747
-  _globals['_INTERNALMESSAGESREQUEST']._serialized_end=12818
-  _globals['_INTERNALMESSAGESRESPONSE']._serialized_start=12820
-  _globals['_INTERNALMESSAGESRESPONSE']._serialized_end=12898
-  _globals['_INTERNALMESSAGES']._serialized_start=12900
-  _globals['_INTERNALMESSAGES']._serialized_end=12935
-  _globals['_POLLEXITREQUEST']._serialized_start=12937
-  _globals['_POLLEXITREQUEST']._serialized_end=13000
-  _globals['_POLLEXITRESPONSE']._serialized_start=13003
-  _globals['_POLLEXITRESPONSE']._serialized_end=13191
-  _globals['_SYNCOVERWRITE']._serialized_start=13193
-  _globals['_SYNCOVERWRITE']._serialized_end=13257
-  _globals['_SYNCSKIP']._serialized_start=13259
-  _globals['_SYNCSKIP']._serialized_end=13289
-  _globals['_SENDERMARKREQUEST']._serialized_start=13291
-  _globals['_SENDERMARKREQUEST']._serialized_end=13310
-  _globals['_SYNCREQUEST']._serialized_start=13313
-  _globals['_SYNCREQUEST']._serialized_end=13460
-  _globals['_SYNCRESPONSE']._serialized_start=13462
-  _globals['_SYNCRESPONSE']._serialized_end=13531
-  _globals['_SENDERREADREQUEST']._serialized_start=13533
-  _globals['_SENDERREADREQUEST']._serialized_end=13596
-  _globals['_STATUSREPORTREQUEST']._serialized_start=13598
-  _globals['_STATUSREPORTREQUEST']._serialized_end=13707
-  _globals['_SUMMARYRECORDREQUEST']._serialized_start=13709
-  _globals['_SUMMARYRECORDREQUEST']._serialized_end=13779
-  _globals['_TELEMETRYRECORDREQUEST']._serialized_start=13781
-  _globals['_TELEMETRYRECORDREQUEST']._serialized_end=13857
-  _globals['_SERVERINFOREQUEST']._serialized_start=13859
-  _globals['_SERVERINFOREQUEST']._serialized_end=13924
-  _globals['_SERVERINFORESPONSE']._serialized_start=13926
-  _globals['_SERVERINFORESPONSE']._serialized_end=14050
-  _globals['_SERVERMESSAGES']._serialized_start=14052
-  _globals['_SERVERMESSAGES']._serialized_end=14113
-  _globals['_SERVERMESSAGE']._serialized_start=14115
-  _globals['_SERVERMESSAGE']._serialized_end=14216
-  _globals['_FILECOUNTS']._serialized_start=14218
-  _globals['_FILECOUNTS']._serialized_end=14317
-  _globals['_FILEPUSHERSTATS']._serialized_start=14319
-  _globals['_FILEPUSHERSTATS']._serialized_end=14404
-  _globals['_FILESUPLOADED']._serialized_start=14406
-  _globals['_FILESUPLOADED']._serialized_end=14436
-  _globals['_FILETRANSFERINFOREQUEST']._serialized_start=14439
-  _globals['_FILETRANSFERINFOREQUEST']._serialized_end=14683
-  _globals['_FILETRANSFERINFOREQUEST_TRANSFERTYPE']._serialized_start=14643
-  _globals['_FILETRANSFERINFOREQUEST_TRANSFERTYPE']._serialized_end=14683
-  _globals['_LOCALINFO']._serialized_start=14685
-  _globals['_LOCALINFO']._serialized_end=14734
-  _globals['_SHUTDOWNREQUEST']._serialized_start=14736
-  _globals['_SHUTDOWNREQUEST']._serialized_end=14799
-  _globals['_SHUTDOWNRESPONSE']._serialized_start=14801
-  _globals['_SHUTDOWNRESPONSE']._serialized_end=14819
-  _globals['_ATTACHREQUEST']._serialized_start=14821
-  _globals['_ATTACHREQUEST']._serialized_end=14901
-  _globals['_ATTACHRESPONSE']._serialized_start=14903
-  _globals['_ATTACHRESPONSE']._serialized_end=15001
-  _globals['_TESTINJECTREQUEST']._serialized_start=15004
-  _globals['_TESTINJECTREQUEST']._serialized_end=15345
-  _globals['_TESTINJECTRESPONSE']._serialized_start=15347
-  _globals['_TESTINJECTRESPONSE']._serialized_end=15367
-  _globals['_HISTORYACTION']._serialized_start=15369
-  _globals['_HISTORYACTION']._serialized_end=15399
-  _globals['_PARTIALHISTORYREQUEST']._serialized_start=15402
-  _globals['_PARTIALHISTORYREQUEST']._serialized_end=15604
-  _globals['_PARTIALHISTORYRESPONSE']._serialized_start=15606
-  _globals['_PARTIALHISTORYRESPONSE']._serialized_end=15630
-  _globals['_SAMPLEDHISTORYREQUEST']._serialized_start=15632
-  _globals['_SAMPLEDHISTORYREQUEST']._serialized_end=15701
-  _globals['_SAMPLEDHISTORYITEM']._serialized_start=15703
-  _globals['_SAMPLEDHISTORYITEM']._serialized_end=15798
-  _globals['_SAMPLEDHISTORYRESPONSE']._serialized_start=15800
-  _globals['_SAMPLEDHISTORYRESPONSE']._serialized_end=15874
-  _globals['_RUNSTATUSREQUEST']._serialized_start=15876
-  _globals['_RUNSTATUSREQUEST']._serialized_end=15940
-  _globals['_RUNSTATUSRESPONSE']._serialized_start=15942
-  _globals['_RUNSTATUSRESPONSE']._serialized_end=16062
-  _globals['_RUNSTARTREQUEST']._serialized_start=16064
-  _globals['_RUNSTARTREQUEST']._serialized_end=16167
-  _globals['_RUNSTARTRESPONSE']._serialized_start=16169
-  _globals['_RUNSTARTRESPONSE']._serialized_end=16187
-  _globals['_CHECKVERSIONREQUEST']._serialized_start=16189
-  _globals['_CHECKVERSIONREQUEST']._serialized_end=16281
-  _globals['_CHECKVERSIONRESPONSE']._serialized_start=16283
-  _globals['_CHECKVERSIONRESPONSE']._serialized_end=16376
-  _globals['_JOBINFOREQUEST']._serialized_start=16378
-  _globals['_JOBINFOREQUEST']._serialized_end=16440
-  _globals['_JOBINFORESPONSE']._serialized_start=16442
-  _globals['_JOBINFORESPONSE']._serialized_end=16496
-  _globals['_LOGARTIFACTREQUEST']._serialized_start=16499
-  _globals['_LOGARTIFACTREQUEST']._serialized_end=16658
-  _globals['_LOGARTIFACTRESPONSE']._serialized_start=16660
-  _globals['_LOGARTIFACTRESPONSE']._serialized_end=16725
-  _globals['_DOWNLOADARTIFACTREQUEST']._serialized_start=16728
-  _globals['_DOWNLOADARTIFACTREQUEST']._serialized_end=16918
-  _globals['_DOWNLOADARTIFACTRESPONSE']._serialized_start=16920
-  _globals['_DOWNLOADARTIFACTRESPONSE']._serialized_end=16969
-  _globals['_KEEPALIVEREQUEST']._serialized_start=16971
-  _globals['_KEEPALIVEREQUEST']._serialized_end=17035
-  _globals['_KEEPALIVERESPONSE']._serialized_start=17037
-  _globals['_KEEPALIVERESPONSE']._serialized_end=17056
-  _globals['_ARTIFACTINFO']._serialized_start=17058
-  _globals['_ARTIFACTINFO']._serialized_end=17171
-  _globals['_GITINFO']._serialized_start=17173
-  _globals['_GITINFO']._serialized_end=17214
-  _globals['_GITSOURCE']._serialized_start=17217
-  _globals['_GITSOURCE']._serialized_end=17352
-  _globals['_IMAGESOURCE']._serialized_start=17354
-  _globals['_IMAGESOURCE']._serialized_end=17382
-  _globals['_SOURCE']._serialized_start=17385
-  _globals['_SOURCE']._serialized_end=17525
-  _globals['_JOBSOURCE']._serialized_start=17527
-  _globals['_JOBSOURCE']._serialized_end=17634
-  _globals['_PARTIALJOBARTIFACT']._serialized_start=17636
-  _globals['_PARTIALJOBARTIFACT']._serialized_end=17722
-  _globals['_USEARTIFACTRECORD']._serialized_start=17725
-  _globals['_USEARTIFACTRECORD']._serialized_end=17882
-  _globals['_USEARTIFACTRESULT']._serialized_start=17884
-  _globals['_USEARTIFACTRESULT']._serialized_end=17903
-  _globals['_CANCELREQUEST']._serialized_start=17905
-  _globals['_CANCELREQUEST']._serialized_end=17987
-  _globals['_CANCELRESPONSE']._serialized_start=17989
-  _globals['_CANCELRESPONSE']._serialized_end=18005
-  _globals['_DISKINFO']._serialized_start=18007
-  _globals['_DISKINFO']._serialized_end=18046
-  _globals['_MEMORYINFO']._serialized_start=18048
-  _globals['_MEMORYINFO']._serialized_end=18075
-  _globals['_CPUINFO']._serialized_start=18077
-  _globals['_CPUINFO']._serialized_end=18124
-  _globals['_GPUAPPLEINFO']._serialized_start=18126
-  _globals['_GPUAPPLEINFO']._serialized_end=18188
-  _globals['_GPUNVIDIAINFO']._serialized_start=18190
-  _globals['_GPUNVIDIAINFO']._serialized_end=18241
-  _globals['_GPUAMDINFO']._serialized_start=18244
-  _globals['_GPUAMDINFO']._serialized_end=18509
-  _globals['_METADATAREQUEST']._serialized_start=18512
-  _globals['_METADATAREQUEST']._serialized_end=19558
-  _globals['_METADATAREQUEST_DISKENTRY']._serialized_start=19443
-  _globals['_METADATAREQUEST_DISKENTRY']._serialized_end=19512
-  _globals['_METADATAREQUEST_SLURMENTRY']._serialized_start=19514
-  _globals['_METADATAREQUEST_SLURMENTRY']._serialized_end=19558
-  _globals['_PYTHONPACKAGESREQUEST']._serialized_start=19561
-  _globals['_PYTHONPACKAGESREQUEST']._serialized_end=19702
-  _globals['_PYTHONPACKAGESREQUEST_PYTHONPACKAGE']._serialized_start=19656
-  _globals['_PYTHONPACKAGESREQUEST_PYTHONPACKAGE']._serialized_end=19702
-  _globals['_JOBINPUTPATH']._serialized_start=19704
-  _globals['_JOBINPUTPATH']._serialized_end=19732
-  _globals['_JOBINPUTSOURCE']._serialized_start=19735
-  _globals['_JOBINPUTSOURCE']._serialized_end=19949
-  _globals['_JOBINPUTSOURCE_RUNCONFIGSOURCE']._serialized_start=19888
-  _globals['_JOBINPUTSOURCE_RUNCONFIGSOURCE']._serialized_end=19905
-  _globals['_JOBINPUTSOURCE_CONFIGFILESOURCE']._serialized_start=19907
-  _globals['_JOBINPUTSOURCE_CONFIGFILESOURCE']._serialized_end=19939
-  _globals['_JOBINPUTREQUEST']._serialized_start=19952
-  _globals['_JOBINPUTREQUEST']._serialized_end=20129
+  _globals['_RECORD']._serialized_end=1392
+  _globals['_CONTROL']._serialized_start=1395
+  _globals['_CONTROL']._serialized_end=1563
+  _globals['_RESULT']._serialized_start=1566
+  _globals['_RESULT']._serialized_end=2065
+  _globals['_FINALRECORD']._serialized_start=2067
+  _globals['_FINALRECORD']._serialized_end=2125
+  _globals['_VERSIONINFO']._serialized_start=2127
+  _globals['_VERSIONINFO']._serialized_end=2225
+  _globals['_HEADERRECORD']._serialized_start=2227
+  _globals['_HEADERRECORD']._serialized_end=2337
+  _globals['_FOOTERRECORD']._serialized_start=2339
+  _globals['_FOOTERRECORD']._serialized_end=2398
+  _globals['_RUNRECORD']._serialized_start=2401
+  _globals['_RUNRECORD']._serialized_end=3007
+  _globals['_GITREPORECORD']._serialized_start=3009
+  _globals['_GITREPORECORD']._serialized_end=3099
+  _globals['_RUNUPDATERESULT']._serialized_start=3101
+  _globals['_RUNUPDATERESULT']._serialized_end=3200
+  _globals['_ERRORINFO']._serialized_start=3203
+  _globals['_ERRORINFO']._serialized_end=3497
+  _globals['_ERRORINFO_ERRORCODE']._serialized_start=3285
+  _globals['_ERRORINFO_ERRORCODE']._serialized_end=3497
+  _globals['_RUNEXITRECORD']._serialized_start=3499
+  _globals['_RUNEXITRECORD']._serialized_end=3595
+  _globals['_RUNEXITRESULT']._serialized_start=3597
+  _globals['_RUNEXITRESULT']._serialized_end=3612
+  _globals['_RUNPREEMPTINGRECORD']._serialized_start=3614
+  _globals['_RUNPREEMPTINGRECORD']._serialized_end=3680
+  _globals['_RUNPREEMPTINGRESULT']._serialized_start=3682
+  _globals['_RUNPREEMPTINGRESULT']._serialized_end=3703
+  _globals['_SETTINGSRECORD']._serialized_start=3705
+  _globals['_SETTINGSRECORD']._serialized_end=3810
+  _globals['_SETTINGSITEM']._serialized_start=3812
+  _globals['_SETTINGSITEM']._serialized_end=3859
+  _globals['_HISTORYSTEP']._serialized_start=3861
+  _globals['_HISTORYSTEP']._serialized_end=3887
+  _globals['_HISTORYRECORD']._serialized_start=3890
+  _globals['_HISTORYRECORD']._serialized_end=4036
+  _globals['_HISTORYITEM']._serialized_start=4038
+  _globals['_HISTORYITEM']._serialized_end=4104
+  _globals['_HISTORYRESULT']._serialized_start=4106
+  _globals['_HISTORYRESULT']._serialized_end=4121
+  _globals['_HISTORYBATCHRECORD']._serialized_start=4124
+  _globals['_HISTORYBATCHRECORD']._serialized_end=4255
+  _globals['_HISTORYBATCHCOLUMN']._serialized_start=4257
+  _globals['_HISTORYBATCHCOLUMN']._serialized_end=4342
+  _globals['_OUTPUTRECORD']._serialized_start=4345
+  _globals['_OUTPUTRECORD']._serialized_end=4565
+  _globals['_OUTPUTRECORD_OUTPUTTYPE']._serialized_start=4529
+  _globals['_OUTPUTRECORD_OUTPUTTYPE']._serialized_end=4565
+  _globals['_OUTPUTRESULT']._serialized_start=4567
+  _globals['_OUTPUTRESULT']._serialized_end=4581
+  _globals['_OUTPUTRAWRECORD']._serialized_start=4584
+  _globals['_OUTPUTRAWRECORD']._serialized_end=4826
+  _globals['_OUTPUTRAWRECORD_OUTPUTTYPE']._serialized_start=4529
+  _globals['_OUTPUTRAWRECORD_OUTPUTTYPE']._serialized_end=4565
+  _globals['_OUTPUTRAWRESULT']._serialized_start=4828
+  _globals['_OUTPUTRAWRESULT']._serialized_end=4845
+  _globals['_METRICRECORD']._serialized_start=4848
+  _globals['_METRICRECORD']._serialized_end=5256
+  _globals['_METRICRECORD_METRICGOAL']._serialized_start=5190
+  _globals['_METRICRECORD_METRICGOAL']._serialized_end=5256
+  _globals['_METRICRESULT']._serialized_start=5258
+  _globals['_METRICRESULT']._serialized_end=5272
+  _globals['_METRICOPTIONS']._serialized_start=5274
+  _globals['_METRICOPTIONS']._serialized_end=5341
+  _globals['_METRICCONTROL']._serialized_start=5343
+  _globals['_METRICCONTROL']._serialized_end=5377
+  _globals['_METRICSUMMARY']._serialized_start=5379
+  _globals['_METRICSUMMARY']._serialized_end=5490
+  _globals['_CONFIGRECORD']._serialized_start=5493
+  _globals['_CONFIGRECORD']._serialized_end=5640
+  _globals['_CONFIGITEM']._serialized_start=5642
+  _globals['_CONFIGITEM']._serialized_end=5707
+  _globals['_CONFIGRESULT']._serialized_start=5709
+  _globals['_CONFIGRESULT']._serialized_end=5723
+  _globals['_SUMMARYRECORD']._serialized_start=5726
+  _globals['_SUMMARYRECORD']._serialized_end=5876
+  _globals['_SUMMARYITEM']._serialized_start=5878
+  _globals['_SUMMARYITEM']._serialized_end=5944
+  _globals['_SUMMARYRESULT']._serialized_start=5946
+  _globals['_SUMMARYRESULT']._serialized_end=5961
+  _globals['_FILESRECORD']._serialized_start=5963
+  _globals['_FILESRECORD']._serialized_end=6063
+  _globals['_FILESITEM']._serialized_start=6066
+  _globals['_FILESITEM']._serialized_end=6319
+  _globals['_FILESITEM_POLICYTYPE']._serialized_start=6214
+  _globals['_FILESITEM_POLICYTYPE']._serialized_end=6254
+  _globals['_FILESITEM_FILETYPE']._serialized_start=6256
+  _globals['_FILESITEM_FILETYPE']._serialized_end=6313
+  _globals['_FILESRESULT']._serialized_start=6321
+  _globals['_FILESRESULT']._serialized_end=6334
+  _globals['_STATSRECORD']._serialized_start=6337
+  _globals['_STATSRECORD']._serialized_end=6567
+  _globals['_STATSRECORD_STATSTYPE']._serialized_start=6544
+  _globals['_STATSRECORD_STATSTYPE']._serialized_end=6567
+  _globals['_STATSITEM']._serialized_start=6569
+  _globals['_STATSITEM']._serialized_end=6613
+  _globals['_ARTIFACTRECORD']._serialized_start=6616
+  _globals['_ARTIFACTRECORD']._serialized_end=7089
+  _globals['_ARTIFACTMANIFEST']._serialized_start=7092
+  _globals['_ARTIFACTMANIFEST']._serialized_end=7280
+  _globals['_ARTIFACTMANIFESTENTRY']._serialized_start=7283
+  _globals['_ARTIFACTMANIFESTENTRY']._serialized_end=7490
+  _globals['_EXTRAITEM']._serialized_start=7492
+  _globals['_EXTRAITEM']._serialized_end=7536
+  _globals['_STORAGEPOLICYCONFIGITEM']._serialized_start=7538
+  _globals['_STORAGEPOLICYCONFIGITEM']._serialized_end=7596
+  _globals['_ARTIFACTRESULT']._serialized_start=7598
+  _globals['_ARTIFACTRESULT']._serialized_end=7614
+  _globals['_LINKARTIFACTRESULT']._serialized_start=7616
+  _globals['_LINKARTIFACTRESULT']._serialized_end=7636
+  _globals['_LINKARTIFACTRECORD']._serialized_start=7639
+  _globals['_LINKARTIFACTRECORD']._serialized_end=7846
+  _globals['_TBRECORD']._serialized_start=7848
+  _globals['_TBRECORD']._serialized_end=7952
+  _globals['_TBRESULT']._serialized_start=7954
+  _globals['_TBRESULT']._serialized_end=7964
+  _globals['_ALERTRECORD']._serialized_start=7966
+  _globals['_ALERTRECORD']._serialized_end=8091
+  _globals['_ALERTRESULT']._serialized_start=8093
+  _globals['_ALERTRESULT']._serialized_end=8106
+  _globals['_REQUEST']._serialized_start=8109
+  _globals['_REQUEST']._serialized_end=10157
+  _globals['_RESPONSE']._serialized_start=10160
+  _globals['_RESPONSE']._serialized_end=11666
+  _globals['_UPDATESETTINGSREQUEST']._serialized_start=11669
+  _globals['_UPDATESETTINGSREQUEST']._serialized_end=11809
+  _globals['_DEFERREQUEST']._serialized_start=11812
+  _globals['_DEFERREQUEST']._serialized_end=12132
+  _globals['_DEFERREQUEST_DEFERSTATE']._serialized_start=11885
+  _globals['_DEFERREQUEST_DEFERSTATE']._serialized_end=12132
+  _globals['_PAUSEREQUEST']._serialized_start=12134
+  _globals['_PAUSEREQUEST']._serialized_end=12194
+  _globals['_PAUSERESPONSE']._serialized_start=12196
+  _globals['_PAUSERESPONSE']._serialized_end=12211
+  _globals['_RESUMEREQUEST']._serialized_start=12213
+  _globals['_RESUMEREQUEST']._serialized_end=12274
+  _globals['_RESUMERESPONSE']._serialized_start=12276
+  _globals['_RESUMERESPONSE']._serialized_end=12292
+  _globals['_LOGINREQUEST']._serialized_start=12294
+  _globals['_LOGINREQUEST']._serialized_end=12371
+  _globals['_LOGINRESPONSE']._serialized_start=12373
+  _globals['_LOGINRESPONSE']._serialized_end=12411
+  _globals['_GETSUMMARYREQUEST']._serialized_start=12413
+  _globals['_GETSUMMARYREQUEST']._serialized_end=12478
+  _globals['_GETSUMMARYRESPONSE']._serialized_start=12480
+  _globals['_GETSUMMARYRESPONSE']._serialized_end=12543
+  _globals['_GETSYSTEMMETRICSREQUEST']._serialized_start=12545
+  _globals['_GETSYSTEMMETRICSREQUEST']._serialized_end=12616
+  _globals['_SYSTEMMETRICSAMPLE']._serialized_start=12618
+  _globals['_SYSTEMMETRICSAMPLE']._serialized_end=12700
+  _globals['_SYSTEMMETRICSBUFFER']._serialized_start=12702
+  _globals['_SYSTEMMETRICSBUFFER']._serialized_end=12775
+  _globals['_GETSYSTEMMETRICSRESPONSE']._serialized_start=12778
+  _globals['_GETSYSTEMMETRICSRESPONSE']._serialized_end=12980
+  _globals['_GETSYSTEMMETRICSRESPONSE_SYSTEMMETRICSENTRY']._serialized_start=12891
+  _globals['_GETSYSTEMMETRICSRESPONSE_SYSTEMMETRICSENTRY']._serialized_end=12980
+  _globals['_STATUSREQUEST']._serialized_start=12982
+  _globals['_STATUSREQUEST']._serialized_end=13043
+  _globals['_STATUSRESPONSE']._serialized_start=13045
+  _globals['_STATUSRESPONSE']._serialized_end=13086
+  _globals['_STOPSTATUSREQUEST']._serialized_start=13088
+  _globals['_STOPSTATUSREQUEST']._serialized_end=13153
+  _globals['_STOPSTATUSRESPONSE']._serialized_start=13155
+  _globals['_STOPSTATUSRESPONSE']._serialized_end=13200
+  _globals['_NETWORKSTATUSREQUEST']._serialized_start=13202
+  _globals['_NETWORKSTATUSREQUEST']._serialized_end=13270
+  _globals['_NETWORKSTATUSRESPONSE']._serialized_start=13272
+  _globals['_NETWORKSTATUSRESPONSE']._serialized_end=13352
+  _globals['_HTTPRESPONSE']._serialized_start=13354
+  _globals['_HTTPRESPONSE']._serialized_end=13422
+  _globals['_INTERNALMESSAGESREQUEST']._serialized_start=13424
+  _globals['_INTERNALMESSAGESREQUEST']._serialized_end=13495
+  _globals['_INTERNALMESSAGESRESPONSE']._serialized_start=13497
+  _globals['_INTERNALMESSAGESRESPONSE']._serialized_end=13575
+  _globals['_INTERNALMESSAGES']._serialized_start=13577
+  _globals['_INTERNALMESSAGES']._serialized_end=13654
+  _globals['_POLLEXITREQUEST']._serialized_start=13656
+  _globals['_POLLEXITREQUEST']._serialized_end=13719
+  _globals['_POLLEXITRESPONSE']._serialized_start=13722
+  _globals['_POLLEXITRESPONSE']._serialized_end=13910
+  _globals['_SYNCOVERWRITE']._serialized_start=13912
+  _globals['_SYNCOVERWRITE']._serialized_end=13976
+  _globals['_SYNCSKIP']._serialized_start=13978
+  _globals['_SYNCSKIP']._serialized_end=14008
+  _globals['_SENDERMARKREQUEST']._serialized_start=14010
+  _globals['_SENDERMARKREQUEST']._serialized_end=14029
+  _globals['_SYNCREQUEST']._serialized_start=14032
+  _globals['_SYNCREQUEST']._serialized_end=14179
+  _globals['_SYNCRESPONSE']._serialized_start=14181
+  _globals['_SYNCRESPONSE']._serialized_end=14250
+  _globals['_SENDERREADREQUEST']._serialized_start=14252
+  _globals['_SENDERREADREQUEST']._serialized_end=14315
+  _globals['_STATUSREPORTREQUEST']._serialized_start=14317
+  _globals['_STATUSREPORTREQUEST']._serialized_end=14426
+  _globals['_SUMMARYRECORDREQUEST']._serialized_start=14428
+  _globals['_SUMMARYRECORDREQUEST']._serialized_end=14498
+  _globals['_TELEMETRYRECORDREQUEST']._serialized_start=14500
+  _globals['_TELEMETRYRECORDREQUEST']._serialized_end=14576
+  _globals['_SERVERINFOREQUEST']._serialized_start=14578
+  _globals['_SERVERINFOREQUEST']._serialized_end=14643
+  _globals['_SERVERINFORESPONSE']._serialized_start=14645
+  _globals['_SERVERINFORESPONSE']._serialized_end=14769
+  _globals['_SERVERMESSAGES']._serialized_start=14771
+  _globals['_SERVERMESSAGES']._serialized_end=14832
+  _globals['_SERVERMESSAGE']._serialized_start=14834
+  _globals['_SERVERMESSAGE']._serialized_end=14935
+  _globals['_FILECOUNTS']._serialized_start=14937
+  _globals['_FILECOUNTS']._serialized_end=15036
+  _globals['_FILEPUSHERSTATS']._serialized_start=15038
+  _globals['_FILEPUSHERSTATS']._serialized_end=15123
+  _globals['_FILESUPLOADED']._serialized_start=15125
+  _globals['_FILESUPLOADED']._serialized_end=15155
+  _globals['_FILETRANSFERINFOREQUEST']._serialized_start=15158
+  _globals['_FILETRANSFERINFOREQUEST']._serialized_end=15402
+  _globals['_FILETRANSFERINFOREQUEST_TRANSFERTYPE']._serialized_start=15362
+  _globals['_FILETRANSFERINFOREQUEST_TRANSFERTYPE']._serialized_end=15402
+  _globals['_LOCALINFO']._serialized_start=15404
+  _globals['_LOCALINFO']._serialized_end=15453
+  _globals['_SHUTDOWNREQUEST']._serialized_start=15455
+  _globals['_SHUTDOWNREQUEST']._serialized_end=15518
+  _globals['_SHUTDOWNRESPONSE']._serialized_start=15520
+  _globals['_SHUTDOWNRESPONSE']._serialized_end=15538
+  _globals['_ATTACHREQUEST']._serialized_start=15540
+  _globals['_ATTACHREQUEST']._serialized_end=15620
+  _globals['_ATTACHRESPONSE']._serialized_start=15622
+  _globals['_ATTACHRESPONSE']._serialized_end=15720
+  _globals['_TESTINJECTREQUEST']._serialized_start=15723
+  _globals['_TESTINJECTREQUEST']._serialized_end=16064
+  _globals['_TESTINJECTRESPONSE']._serialized_start=16066
+  _globals['_TESTINJECTRESPONSE']._serialized_end=16086
+  _globals['_HISTORYACTION']._serialized_start=16088
+  _globals['_HISTORYACTION']._serialized_end=16118
+  _globals['_PARTIALHISTORYREQUEST']._serialized_start=16121
+  _globals['_PARTIALHISTORYREQUEST']._serialized_end=16323
+  _globals['_PARTIALHISTORYRESPONSE']._serialized_start=16325
+  _globals['_PARTIALHISTORYRESPONSE']._serialized_end=16349
+  _globals['_SAMPLEDHISTORYREQUEST']._serialized_start=16351
+  _globals['_SAMPLEDHISTORYREQUEST']._serialized_end=16420
+  _globals['_SAMPLEDHISTORYITEM']._serialized_start=16422
+  _globals['_SAMPLEDHISTORYITEM']._serialized_end=16517
+  _globals['_SAMPLEDHISTORYRESPONSE']._serialized_start=16519
+  _globals['_SAMPLEDHISTORYRESPONSE']._serialized_end=16593
+  _globals['_RUNSTATUSREQUEST']._serialized_start=16595
+  _globals['_RUNSTATUSREQUEST']._serialized_end=16659
+  _globals['_RUNSTATUSRESPONSE']._serialized_start=16661
+  _globals['_RUNSTATUSRESPONSE']._serialized_end=16781
+  _globals['_RUNSTARTREQUEST']._serialized_start=16783
+  _globals['_RUNSTARTREQUEST']._serialized_end=16886
+  _globals['_RUNSTARTRESPONSE']._serialized_start=16888
+  _globals['_RUNSTARTRESPONSE']._serialized_end=16906
+  _globals['_CHECKVERSIONREQUEST']._serialized_start=16908
+  _globals['_CHECKVERSIONREQUEST']._serialized_end=17000
+  _globals['_CHECKVERSIONRESPONSE']._serialized_start=17002
+  _globals['_CHECKVERSIONRESPONSE']._serialized_end=17095
+  _globals['_JOBINFOREQUEST']._serialized_start=17097
+  _globals['_JOBINFOREQUEST']._serialized_end=17159
+  _globals['_JOBINFORESPONSE']._serialized_start=17161
+  _globals['_JOBINFORESPONSE']._serialized_end=17215
+  _globals['_LOGARTIFACTREQUEST']._serialized_start=17218
+  _globals['_LOGARTIFACTREQUEST']._serialized_end=17377
+  _globals['_LOGARTIFACTRESPONSE']._serialized_start=17379
+  _globals['_LOGARTIFACTRESPONSE']._serialized_end=17444
+  _globals['_DOWNLOADARTIFACTREQUEST']._serialized_start=17447
+  _globals['_DOWNLOADARTIFACTREQUEST']._serialized_end=17657
+  _globals['_DOWNLOADARTIFACTRESPONSE']._serialized_start=17659
+  _globals['_DOWNLOADARTIFACTRESPONSE']._serialized_end=17708
+  _globals['_KEEPALIVEREQUEST']._serialized_start=17710
+  _globals['_KEEPALIVEREQUEST']._serialized_end=17774
+  _globals['_KEEPALIVERESPONSE']._serialized_start=17776
+  _globals['_KEEPALIVERESPONSE']._serialized_end=17795
+  _globals['_ARTIFACTINFO']._serialized_start=17797
+  _globals['_ARTIFACTINFO']._serialized_end=17910
+  _globals['_GITINFO']._serialized_start=17912
+  _globals['_GITINFO']._serialized_end=17953
+  _globals['_GITSOURCE']._serialized_start=17956
+  _globals['_GITSOURCE']._serialized_end=18091
+  _globals['_IMAGESOURCE']._serialized_start=18093
+  _globals['_IMAGESOURCE']._serialized_end=18121
+  _globals['_SOURCE']._serialized_start=18124
+  _globals['_SOURCE']._serialized_end=18264
+  _globals['_JOBSOURCE']._serialized_start=18266
+  _globals['_JOBSOURCE']._serialized_end=18373
+  _globals['_PARTIALJOBARTIFACT']._serialized_start=18375
+  _globals['_PARTIALJOBARTIFACT']._serialized_end=18461
+  _globals['_USEARTIFACTRECORD']._serialized_start=18464
+  _globals['_USEARTIFACTRECORD']._serialized_end=18621
+  _globals['_USEARTIFACTRESULT']._serialized_start=18623
+  _globals['_USEARTIFACTRESULT']._serialized_end=18642
+  _globals['_CANCELREQUEST']._serialized_start=18644
+  _globals['_CANCELREQUEST']._serialized_end=18726
+  _globals['_CANCELRESPONSE']._serialized_start=18728
+  _globals['_CANCELRESPONSE']._serialized_end=18744
+  _globals['_DISKINFO']._serialized_start=18746
+  _globals['_DISKINFO']._serialized_end=18785
+  _globals['_MEMORYINFO']._serialized_start=18787
+  _globals['_MEMORYINFO']._serialized_end=18814
+  _globals['_CPUINFO']._serialized_start=18816
+  _globals['_CPUINFO']._serialized_end=18863
+  _globals['_GPUAPPLEINFO']._serialized_start=18865
+  _globals['_GPUAPPLEINFO']._serialized_end=18927
+  _globals['_GPUNVIDIAINFO']._serialized_start=18929
+  _globals['_GPUNVIDIAINFO']._serialized_end=18980
+  _globals['_GPUAMDINFO']._serialized_start=18983
+  _globals['_GPUAMDINFO']._serialized_end=19248
+  _globals['_CONTAINERINFO']._serialized_start=19250
+  _globals['_CONTAINERINFO']._serialized_end=19372
+  _globals['_KUBERNETESINFO']._serialized_start=19375
+  _globals['_KUBERNETESINFO']._serialized_end=19791
+  _globals['_KUBERNETESINFO_REQUESTSENTRY']._serialized_start=19650
+  _globals['_KUBERNETESINFO_REQUESTSENTRY']._serialized_end=19697
+  _globals['_KUBERNETESINFO_LIMITSENTRY']._serialized_start=19699
+  _globals['_KUBERNETESINFO_LIMITSENTRY']._serialized_end=19744
+  _globals['_KUBERNETESINFO_LABELSENTRY']._serialized_start=19746
+  _globals['_KUBERNETESINFO_LABELSENTRY']._serialized_end=19791
+  _globals['_METADATAREQUEST']._serialized_start=19794
+  _globals['_METADATAREQUEST']._serialized_end=21041
+  _globals['_METADATAREQUEST_DISKENTRY']._serialized_start=20882
+  _globals['_METADATAREQUEST_DISKENTRY']._serialized_end=20951
+  _globals['_METADATAREQUEST_SLURMENTRY']._serialized_start=20953
+  _globals['_METADATAREQUEST_SLURMENTRY']._serialized_end=20997
+  _globals['_METADATAREQUEST_PBSENTRY']._serialized_start=20999
+  _globals['_METADATAREQUEST_PBSENTRY']._serialized_end=21041
+  _globals['_PYTHONPACKAGESREQUEST']._serialized_start=21044
+  _globals['_PYTHONPACKAGESREQUEST']._serialized_end=21185
+  _globals['_PYTHONPACKAGESREQUEST_PYTHONPACKAGE']._serialized_start=21139
+  _globals['_PYTHONPACKAGESREQUEST_PYTHONPACKAGE']._serialized_end=21185
+  _globals['_JOBINPUTPATH']._serialized_start=21187
+  _globals['_JOBINPUTPATH']._serialized_end=21215
+  _globals['_JOBINPUTSOURCE']._serialized_start=21218
+  _globals['_JOBINPUTSOURCE']._serialized_end=21432
+  _globals['_JOBINPUTSOURCE_RUNCONFIGSOURCE']._serialized_start=21371
+  _globals['_JOBINPUTSOURCE_RUNCONFIGSOURCE']._serialized_end=21388
+  _globals['_JOBINPUTSOURCE_CONFIGFILESOURCE']._serialized_start=21390
+  _globals['_JOBINPUTSOURCE_CONFIGFILESOURCE']._serialized_end=21422
+  _globals['_JOBINPUTREQUEST']._serialized_start=21435
+  _globals['_JOBINPUTREQUEST']._serialized_end=21612
 # @@protoc_insertion_point(module_scope)
@@ -329,6 +329,64 @@ class Settings(google.protobuf.message.Message):
     CONSOLE_MULTIPART_FIELD_NUMBER: builtins.int
     HTTP_PROXY_FIELD_NUMBER: builtins.int
     HTTPS_PROXY_FIELD_NUMBER: builtins.int
+    _STATS_DCGM_PROFILING_FIELDS_FIELD_NUMBER: builtins.int
+    _STATS_DCGM_PROFILING_INTERVAL_SECONDS_FIELD_NUMBER: builtins.int
+    _REQUEST_SIGNING_COMMAND_FIELD_NUMBER: builtins.int
+    _RUN_DESCRIPTOR_FIELD_NUMBER: builtins.int
+    _RUN_DESCRIPTOR_QR_FIELD_NUMBER: builtins.int
+    _CONSOLE_STRUCTURED_FIELD_NUMBER: builtins.int
+    _CONSOLE_DEDUPE_LINES_FIELD_NUMBER: builtins.int
+    _CONSOLE_UPLOAD_BYTES_PER_SECOND_FIELD_NUMBER: builtins.int
+    _MEDIA_KEEP_LAST_FIELD_NUMBER: builtins.int
+    _MEDIA_SAMPLE_STEPS_FIELD_NUMBER: builtins.int
+    _HISTORY_AGGREGATION_FIELD_NUMBER: builtins.int
+    _HISTORY_AGGREGATION_MAX_RATE_FIELD_NUMBER: builtins.int
+    _HISTORY_AGGREGATION_KEEP_RAW_FIELD_NUMBER: builtins.int
+    _DISABLE_ORG_DEFAULTS_FIELD_NUMBER: builtins.int
+    _STORE_INDEX_FIELD_NUMBER: builtins.int
+    _STORE_COMPRESSION_FIELD_NUMBER: builtins.int
+    NO_PROXY_FIELD_NUMBER: builtins.int
+    CA_CERT_FILE_FIELD_NUMBER: builtins.int
+    CLIENT_CERT_FILE_FIELD_NUMBER: builtins.int
+    CLIENT_KEY_FILE_FIELD_NUMBER: builtins.int
+    _API_CIRCUIT_BREAKER_THRESHOLD_FIELD_NUMBER: builtins.int
+    _API_CIRCUIT_BREAKER_COOLDOWN_SECONDS_FIELD_NUMBER: builtins.int
+    _STREAM_MAX_QUEUED_RECORDS_FIELD_NUMBER: builtins.int
+    _STREAM_MAX_QUEUED_MB_FIELD_NUMBER: builtins.int
+    SYNC_TENSORBOARD_DIR_FIELD_NUMBER: builtins.int
+    X_OTEL_ENDPOINT_FIELD_NUMBER: builtins.int
+    _GRAPHQL_CACHE_TTL_SECONDS_FIELD_NUMBER: builtins.int
+    _GRAPHQL_PERSISTED_QUERIES_FIELD_NUMBER: builtins.int
+    _CORE_LOG_LEVEL_FIELD_NUMBER: builtins.int
+    _DEFER_AUTH_FIELD_NUMBER: builtins.int
+    _UPLOAD_BANDWIDTH_LIMIT_FIELD_NUMBER: builtins.int
+    _LARGE_UPLOAD_WINDOW_FIELD_NUMBER: builtins.int
+    _GIT_PATCH_UNTRACKED_FIELD_NUMBER: builtins.int
+    _GIT_PATCH_MAX_BYTES_FIELD_NUMBER: builtins.int
+    _ENV_SNAPSHOT_FIELD_NUMBER: builtins.int
+    _WATCHDOG_RULES_FIELD_NUMBER: builtins.int
+    _NODE_RANK_FIELD_NUMBER: builtins.int
+    IDEMPOTENCY_KEY_FIELD_NUMBER: builtins.int
+    _PREEMPTION_HOOK_FIELD_NUMBER: builtins.int
+    _PREEMPTION_GRACE_SECONDS_FIELD_NUMBER: builtins.int
+    _SERVER_SIDE_SUMMARY_FIELD_NUMBER: builtins.int
+    _MAX_CONFIG_SUMMARY_KEYS_FIELD_NUMBER: builtins.int
+    _MAX_CONFIG_SUMMARY_DEPTH_FIELD_NUMBER: builtins.int
+    _MAX_CONFIG_SUMMARY_VALUE_BYTES_FIELD_NUMBER: builtins.int
+    _S3_ENDPOINT_URL_FIELD_NUMBER: builtins.int
+    _S3_PATH_STYLE_FIELD_NUMBER: builtins.int
+    _S3_INSECURE_TLS_FIELD_NUMBER: builtins.int
+    _GCS_AUDIENCE_FIELD_NUMBER: builtins.int
+    _GCS_SCOPES_FIELD_NUMBER: builtins.int
+    _GCS_IMPERSONATE_SERVICE_ACCOUNT_FIELD_NUMBER: builtins.int
+    _FILE_TRANSFER_MAX_CONCURRENCY_FIELD_NUMBER: builtins.int
+    _FILE_TRANSFER_MAX_IDLE_CONNS_FIELD_NUMBER: builtins.int
+    _FILE_TRANSFER_MAX_IDLE_CONNS_PER_HOST_FIELD_NUMBER: builtins.int
+    _FILE_TRANSFER_MAX_CONNS_PER_HOST_FIELD_NUMBER: builtins.int
+    _FILE_TRANSFER_HTTP2_FIELD_NUMBER: builtins.int
+    _ARTIFACT_MANIFEST_V2_FIELD_NUMBER: builtins.int
+    _STREAM_MAX_BUFFERED_MB_FIELD_NUMBER: builtins.int
+    _FILE_STREAM_TRANSPORT_FIELD_NUMBER: builtins.int
     _PROXIES_FIELD_NUMBER: builtins.int
     @property
     def api_key(self) -> google.protobuf.wrappers_pb2.StringValue:
@@ -701,6 +759,310 @@ class Settings(google.protobuf.message.Message):
     def https_proxy(self) -> google.protobuf.wrappers_pb2.StringValue:
         """The custom proxy servers for https requests to W&B."""
 
+    @property
+    def _stats_dcgm_profiling_fields(self) -> global___ListStringValue:
+        """DCGM profiling field IDs to sample on NVIDIA GPUs, e.g. "1003" for
+        DCGM_FI_PROF_SM_OCCUPANCY. Field names are accepted as well.
+        """
+
+    @property
+    def _stats_dcgm_profiling_interval_seconds(self) -> google.protobuf.wrappers_pb2.DoubleValue:
+        """The interval at which the DCGM profiling fields are sampled."""
+
+    @property
+    def _request_signing_command(self) -> global___ListStringValue:
+        """Command that signs requests to W&B, as the executable followed by its
+        arguments. See core/internal/api/signing.go for the protocol.
+        """
+
+    @property
+    def _run_descriptor(self) -> google.protobuf.wrappers_pb2.BoolValue:
+        """Whether to print a run descriptor at init and store it in the run
+        directory, for relaying results across an air gap.
+        """
+
+    @property
+    def _run_descriptor_qr(self) -> google.protobuf.wrappers_pb2.BoolValue:
+        """Whether to also store the run descriptor as a QR code image."""
+
+    @property
+    def _console_structured(self) -> google.protobuf.wrappers_pb2.BoolValue:
+        """Whether to also save console output as JSON lines tagged with the
+        stream and source process of each line.
+        """
+
+    @property
+    def _console_dedupe_lines(self) -> google.protobuf.wrappers_pb2.BoolValue:
+        """Whether to collapse identical consecutive console lines into a
+        "repeated N times" marker.
+        """
+
+    @property
+    def _console_upload_bytes_per_second(self) -> google.protobuf.wrappers_pb2.Int32Value:
+        """Maximum bytes of console logs to upload per second; unlimited if unset."""
+
+    @property
+    def _media_keep_last(self) -> google.protobuf.wrappers_pb2.Int32Value:
+        """Number of most recent media files to keep on disk per key; unlimited if unset."""
+
+    @property
+    def _media_sample_steps(self) -> google.protobuf.wrappers_pb2.Int32Value:
+        """Only keep media logged at steps that are a multiple of this."""
+
+    @property
+    def _history_aggregation(self) -> global___MapStringKeyStringValue:
+        """Aggregation policy by metric name glob: "subsample", "mean" or "reservoir"."""
+
+    @property
+    def _history_aggregation_max_rate(self) -> google.protobuf.wrappers_pb2.DoubleValue:
+        """Maximum history rows per second to upload; faster logging is aggregated."""
+
+    @property
+    def _history_aggregation_keep_raw(self) -> google.protobuf.wrappers_pb2.BoolValue:
+        """Whether to keep unaggregated history in the transaction log."""
+
+    @property
+    def _disable_org_defaults(self) -> google.protobuf.wrappers_pb2.BoolValue:
+        """Whether to skip fetching default settings set by the organization."""
+
+    @property
+    def _store_index(self) -> google.protobuf.wrappers_pb2.BoolValue:
+        """Whether to write a sidecar index of the transaction log for seeking by step."""
+
+    @property
+    def _store_compression(self) -> google.protobuf.wrappers_pb2.StringValue:
+        """How to compress the transaction log: empty for none, or "zstd"."""
+
+    @property
+    def no_proxy(self) -> google.protobuf.wrappers_pb2.StringValue:
+        """Comma-separated hosts, domains and CIDR ranges to reach without the
+        custom proxies, like the NO_PROXY environment variable.
+        """
+
+    @property
+    def ca_cert_file(self) -> google.protobuf.wrappers_pb2.StringValue:
+        """PEM file with root certificates to trust for W&B traffic, in addition to
+        the system's.
+        """
+
+    @property
+    def client_cert_file(self) -> google.protobuf.wrappers_pb2.StringValue:
+        """PEM file with a client certificate for mutual TLS with the W&B server."""
+
+    @property
+    def client_key_file(self) -> google.protobuf.wrappers_pb2.StringValue:
+        """PEM file with the private key of client_cert_file."""
+
+    @property
+    def _api_circuit_breaker_threshold(self) -> google.protobuf.wrappers_pb2.Int32Value:
+        """Consecutive failed requests to the W&B server after which requests are
+        paused for _api_circuit_breaker_cooldown_seconds. Zero disables this.
+        """
+
+    @property
+    def _api_circuit_breaker_cooldown_seconds(self) -> google.protobuf.wrappers_pb2.DoubleValue:
+        """How long to pause requests after _api_circuit_breaker_threshold failures."""
+
+    @property
+    def _stream_max_queued_records(self) -> google.protobuf.wrappers_pb2.Int32Value:
+        """Maximum number of records a run can have waiting to be processed before
+        the client is made to wait. Limits the effect of one run on others that
+        share the same wandb-core service.
+        """
+
+    @property
+    def _stream_max_queued_mb(self) -> google.protobuf.wrappers_pb2.Int32Value:
+        """Maximum size in MB of the records a run can have waiting to be processed
+        before the client is made to wait. Zero means no limit.
+        """
+
+    @property
+    def sync_tensorboard_dir(self) -> google.protobuf.wrappers_pb2.StringValue:
+        """Directory of TensorBoard tfevents files to log to the run and save with it,
+        for programs that write them without the Python integration.
+        """
+
+    @property
+    def x_otel_endpoint(self) -> google.protobuf.wrappers_pb2.StringValue:
+        """OTLP/HTTP endpoint, like "http://localhost:4318", to export traces of how the
+        core processes the run's records to.
+        """
+
+    @property
+    def _graphql_cache_ttl_seconds(self) -> google.protobuf.wrappers_pb2.DoubleValue:
+        """How long to reuse the responses of GraphQL queries whose results rarely change,
+        such as the viewer and server info. Responses are not cached if unset or 0.
+        """
+
+    @property
+    def _graphql_persisted_queries(self) -> google.protobuf.wrappers_pb2.BoolValue:
+        """Whether to send hashes of GraphQL queries in place of their text, as in
+        automatic persisted queries, falling back to the text if the server needs it.
+        """
+
+    @property
+    def _core_log_level(self) -> google.protobuf.wrappers_pb2.StringValue:
+        """Level of the wandb-core debug log: "debug", "info", "warn" or "error".
+        It can be changed while a run is in progress by sending wandb-core SIGHUP.
+        """
+
+    @property
+    def _defer_auth(self) -> google.protobuf.wrappers_pb2.BoolValue:
+        """Whether to log runs locally when there is no API key, deferring
+        authentication until they are uploaded with `wandb-core flush`.
+        """
+
+    @property
+    def _upload_bandwidth_limit(self) -> google.protobuf.wrappers_pb2.Int64Value:
+        """The maximum total upload rate of files in bytes per second, or 0 for
+        no limit.
+        """
+
+    @property
+    def _large_upload_window(self) -> google.protobuf.wrappers_pb2.StringValue:
+        """The daily period in which files of 64 MiB or more are uploaded, as
+        local times like "00:00-06:00". Such uploads wait until the period
+        starts; other files upload at any time.
+        """
+
+    @property
+    def _git_patch_untracked(self) -> google.protobuf.wrappers_pb2.BoolValue:
+        """Whether the patch of uncommitted changes saved with code includes
+        untracked files that .gitignore does not exclude.
+        """
+
+    @property
+    def _git_patch_max_bytes(self) -> google.protobuf.wrappers_pb2.Int64Value:
+        """The size of the largest git patch to upload with a run; larger patches
+        are not uploaded. 0 means no limit.
+        """
+
+    @property
+    def _env_snapshot(self) -> global___ListStringValue:
+        """Package managers whose installed packages to save with the run, such as
+        "pip", "conda" or "apt".
+        """
+
+    @property
+    def _watchdog_rules(self) -> global___ListStringValue:
+        """Rules that act on the run when a metric diverges, such as
+        "loss is nan for 3 -> alert; stop".
+        """
+
+    @property
+    def _node_rank(self) -> google.protobuf.wrappers_pb2.Int32Value:
+        """Rank of this node when several nodes log to the same run in shared mode.
+        Namespaces the node's system metrics and console output.
+        """
+
+    @property
+    def idempotency_key(self) -> google.protobuf.wrappers_pb2.StringValue:
+        """Key identifying a job across retries; the run ID is derived from it so that
+        a retried job resumes its run instead of creating a new one.
+        """
+
+    @property
+    def _preemption_hook(self) -> google.protobuf.wrappers_pb2.StringValue:
+        """Command to run when the job scheduler preempts the run, such as one that
+        saves a checkpoint.
+        """
+
+    @property
+    def _preemption_grace_seconds(self) -> google.protobuf.wrappers_pb2.DoubleValue:
+        """Seconds wandb-core has to save the run when the job scheduler preempts it,
+        including running the preemption hook.
+        """
+
+    @property
+    def _server_side_summary(self) -> google.protobuf.wrappers_pb2.BoolValue:
+        """Whether to leave summary aggregates of defined metrics (min, max, mean)
+        to the server instead of computing them in wandb-core.
+        """
+
+    @property
+    def _max_config_summary_keys(self) -> google.protobuf.wrappers_pb2.Int32Value:
+        """The most keys a run's config or summary may have; new keys beyond it
+        are dropped with a warning. Zero or less disables the limit.
+        """
+
+    @property
+    def _max_config_summary_depth(self) -> google.protobuf.wrappers_pb2.Int32Value:
+        """The deepest nesting allowed in a config or summary value, counting the
+        levels of its key.
+        """
+
+    @property
+    def _max_config_summary_value_bytes(self) -> google.protobuf.wrappers_pb2.Int32Value:
+        """The largest config or summary value allowed, in bytes of JSON."""
+    @property
+    def _s3_endpoint_url(self) -> google.protobuf.wrappers_pb2.StringValue:
+        """The S3-compatible service to download s3:// references from, such as
+        a MinIO or Ceph server, instead of AWS.
+        """
+    @property
+    def _s3_path_style(self) -> google.protobuf.wrappers_pb2.BoolValue:
+        """Whether to put the bucket of s3:// references in the URL path, as in
+        "https://host/bucket/key", rather than in the host name. Most
+        S3-compatible services need this.
+        """
+    @property
+    def _s3_insecure_tls(self) -> google.protobuf.wrappers_pb2.BoolValue:
+        """Whether to skip verifying the TLS certificate of the S3 endpoint, for
+        servers with self-signed certificates.
+        """
+    @property
+    def _gcs_audience(self) -> google.protobuf.wrappers_pb2.StringValue:
+        """The audience of the workload identity pool provider that tokens for gs://
+        references are exchanged with, overriding the one in an external_account
+        credentials file.
+        """
+    @property
+    def _gcs_scopes(self) -> global___ListStringValue:
+        """The OAuth scopes of the tokens for gs:// references."""
+    @property
+    def _gcs_impersonate_service_account(self) -> google.protobuf.wrappers_pb2.StringValue:
+        """The email of a service account to impersonate to access gs:// references."""
+    @property
+    def _file_transfer_max_concurrency(self) -> google.protobuf.wrappers_pb2.Int32Value:
+        """The most files to upload or download at once. Fewer are transferred while
+        storage asks for requests to slow down.
+        """
+    @property
+    def _file_transfer_max_idle_conns(self) -> google.protobuf.wrappers_pb2.Int32Value:
+        """The most idle connections to keep open for file transfers, or 0 for no
+        limit.
+        """
+    @property
+    def _file_transfer_max_idle_conns_per_host(self) -> google.protobuf.wrappers_pb2.Int32Value:
+        """The most idle connections to keep open to each storage host. Defaults to
+        _file_transfer_max_concurrency.
+        """
+    @property
+    def _file_transfer_max_conns_per_host(self) -> google.protobuf.wrappers_pb2.Int32Value:
+        """The most connections to open to each storage host, or 0 for no limit."""
+    @property
+    def _file_transfer_http2(self) -> google.protobuf.wrappers_pb2.BoolValue:
+        """Whether to use HTTP/2 for file transfers when storage supports it. If
+        not set, HTTP/2 is only used without custom TLS settings.
+        """
+    @property
+    def _artifact_manifest_v2(self) -> google.protobuf.wrappers_pb2.BoolValue:
+        """Whether to save artifacts with at least 100,000 entries with the indexed
+        version 2 manifest format, which the server must support.
+        """
+    @property
+    def _stream_max_buffered_mb(self) -> google.protobuf.wrappers_pb2.Int32Value:
+        """Maximum size in MB of the records waiting in memory to be sent to W&B. The
+        rest are written to a file in the run's tmp directory until they can be
+        sent, instead of making the client wait.
+        """
+    @property
+    def _file_stream_transport(self) -> google.protobuf.wrappers_pb2.StringValue:
+        """Transport for filestream uploads: "http" (default) or "grpc", which
+        streams uploads over a single gRPC call if the server accepts it and falls
+        back to "http" otherwise. Streaming requires an https base URL.
+        """
+
     @property
     def _proxies(self) -> global___MapStringKeyStringValue:
         """Custom proxy servers for the requests to W&B.
@@ -880,9 +1242,67 @@ class Settings(google.protobuf.message.Message):
         console_multipart: google.protobuf.wrappers_pb2.BoolValue | None = ...,
         http_proxy: google.protobuf.wrappers_pb2.StringValue | None = ...,
         https_proxy: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _stats_dcgm_profiling_fields: global___ListStringValue | None = ...,
+        _stats_dcgm_profiling_interval_seconds: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
+        _request_signing_command: global___ListStringValue | None = ...,
+        _run_descriptor: google.protobuf.wrappers_pb2.BoolValue | None = ...,
+        _run_descriptor_qr: google.protobuf.wrappers_pb2.BoolValue | None = ...,
+        _console_structured: google.protobuf.wrappers_pb2.BoolValue | None = ...,
+        _console_dedupe_lines: google.protobuf.wrappers_pb2.BoolValue | None = ...,
+        _console_upload_bytes_per_second: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _media_keep_last: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _media_sample_steps: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _history_aggregation: global___MapStringKeyStringValue | None = ...,
+        _history_aggregation_max_rate: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
+        _history_aggregation_keep_raw: google.protobuf.wrappers_pb2.BoolValue | None = ...,
+        _disable_org_defaults: google.protobuf.wrappers_pb2.BoolValue | None = ...,
+        _store_index: google.protobuf.wrappers_pb2.BoolValue | None = ...,
+        _store_compression: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        no_proxy: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        ca_cert_file: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        client_cert_file: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        client_key_file: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _api_circuit_breaker_threshold: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _api_circuit_breaker_cooldown_seconds: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
+        _stream_max_queued_records: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _stream_max_queued_mb: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        sync_tensorboard_dir: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        x_otel_endpoint: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _graphql_cache_ttl_seconds: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
+        _graphql_persisted_queries: google.protobuf.wrappers_pb2.BoolValue | None = ...,
+        _core_log_level: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _defer_auth: google.protobuf.wrappers_pb2.BoolValue | None = ...,
+        _upload_bandwidth_limit: google.protobuf.wrappers_pb2.Int64Value | None = ...,
+        _large_upload_window: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _git_patch_untracked: google.protobuf.wrappers_pb2.BoolValue | None = ...,
+        _git_patch_max_bytes: google.protobuf.wrappers_pb2.Int64Value | None = ...,
+        _env_snapshot: global___ListStringValue | None = ...,
+        _watchdog_rules: global___ListStringValue | None = ...,
+        _node_rank: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        idempotency_key: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _preemption_hook: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _preemption_grace_seconds: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
+        _server_side_summary: google.protobuf.wrappers_pb2.BoolValue | None = ...,
+        _max_config_summary_keys: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _max_config_summary_depth: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _max_config_summary_value_bytes: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _s3_endpoint_url: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _s3_path_style: google.protobuf.wrappers_pb2.BoolValue | None = ...,
+        _s3_insecure_tls: google.protobuf.wrappers_pb2.BoolValue | None = ...,
+        _gcs_audience: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _gcs_scopes: global___ListStringValue | None = ...,
+        _gcs_impersonate_service_account: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _file_transfer_max_concurrency: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _file_transfer_max_idle_conns: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _file_transfer_max_idle_conns_per_host: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _file_transfer_max_conns_per_host: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _file_transfer_http2: google.protobuf.wrappers_pb2.BoolValue | None = ...,
+        _artifact_manifest_v2: google.protobuf.wrappers_pb2.BoolValue | None = ...,
+        _stream_max_buffered_mb: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _file_stream_transport: google.protobuf.wrappers_pb2.StringValue | None = ...,
         _proxies: global___MapStringKeyStringValue | None = ...,
     ) -> None: ...
-    def HasField(self, field_name: typing.Literal["_args", b"_args", "_aws_lambda", b"_aws_lambda", "_cli_only_mode", b"_cli_only_mode", "_code_path_local", b"_code_path_local", "_colab", b"_colab", "_cuda", b"_cuda", "_disable_machine_info", b"_disable_machine_info", "_disable_meta", b"_disable_meta", "_disable_service", b"_disable_service", "_disable_setproctitle", b"_disable_setproctitle", "_disable_stats", b"_disable_stats", "_disable_update_check", b"_disable_update_check", "_disable_viewer", b"_disable_viewer", "_executable", b"_executable", "_extra_http_headers", b"_extra_http_headers", "_file_stream_retry_max", b"_file_stream_retry_max", "_file_stream_retry_wait_max_seconds", b"_file_stream_retry_wait_max_seconds", "_file_stream_retry_wait_min_seconds", b"_file_stream_retry_wait_min_seconds", "_file_stream_timeout_seconds", b"_file_stream_timeout_seconds", "_file_transfer_retry_max", b"_file_transfer_retry_max", "_file_transfer_retry_wait_max_seconds", b"_file_transfer_retry_wait_max_seconds", "_file_transfer_retry_wait_min_seconds", b"_file_transfer_retry_wait_min_seconds", "_file_transfer_timeout_seconds", b"_file_transfer_timeout_seconds", "_flow_control_custom", b"_flow_control_custom", "_flow_control_disabled", b"_flow_control_disabled", "_graphql_retry_max", b"_graphql_retry_max", "_graphql_retry_wait_max_seconds", b"_graphql_retry_wait_max_seconds", "_graphql_retry_wait_min_seconds", b"_graphql_retry_wait_min_seconds", "_graphql_timeout_seconds", b"_graphql_timeout_seconds", "_internal_check_process", b"_internal_check_process", "_internal_queue_timeout", b"_internal_queue_timeout", "_ipython", b"_ipython", "_jupyter", b"_jupyter", "_jupyter_name", b"_jupyter_name", "_jupyter_path", b"_jupyter_path", "_jupyter_root", b"_jupyter_root", "_kaggle", b"_kaggle", "_live_policy_rate_limit", b"_live_policy_rate_limit", "_live_policy_wait_time", b"_live_policy_wait_time", "_log_level", b"_log_level", "_network_buffer", b"_network_buffer", "_noop", b"_noop", "_notebook", b"_notebook", "_offline", b"_offline", "_os", b"_os", "_platform", b"_platform", "_proxies", b"_proxies", "_python", b"_python", "_require_core", b"_require_core", "_runqueue_item_id", b"_runqueue_item_id", "_save_requirements", b"_save_requirements", "_service_transport", b"_service_transport", "_service_wait", b"_service_wait", "_shared", b"_shared", "_start_datetime", b"_start_datetime", "_start_time", b"_start_time", "_stats_buffer_size", b"_stats_buffer_size", "_stats_disk_paths", b"_stats_disk_paths", "_stats_join_assets", b"_stats_join_assets", "_stats_neuron_monitor_config_path", b"_stats_neuron_monitor_config_path", "_stats_open_metrics_endpoints", b"_stats_open_metrics_endpoints", "_stats_open_metrics_filters", b"_stats_open_metrics_filters", "_stats_pid", b"_stats_pid", "_stats_sample_rate_seconds", b"_stats_sample_rate_seconds", "_stats_samples_to_average", b"_stats_samples_to_average", "_sync", b"_sync", "_tmp_code_dir", b"_tmp_code_dir", "_tracelog", b"_tracelog", "_unsaved_keys", b"_unsaved_keys", "_windows", b"_windows", "allow_val_change", b"allow_val_change", "anonymous", b"anonymous", "api_key", b"api_key", "azure_account_url_to_access_key", b"azure_account_url_to_access_key", "base_url", b"base_url", "code_dir", b"code_dir", "colab_url", b"colab_url", "config_paths", b"config_paths", "console", b"console", "console_multipart", b"console_multipart", "credentials_file", b"credentials_file", "deployment", b"deployment", "disable_code", b"disable_code", "disable_git", b"disable_git", "disable_hints", b"disable_hints", "disable_job_creation", b"disable_job_creation", "disabled", b"disabled", "docker", b"docker", "email", b"email", "entity", b"entity", "files_dir", b"files_dir", "force", b"force", "fork_from", b"fork_from", "git_commit", b"git_commit", "git_remote", b"git_remote", "git_remote_url", b"git_remote_url", "git_root", b"git_root", "heartbeat_seconds", b"heartbeat_seconds", "host", b"host", "http_proxy", b"http_proxy", "https_proxy", b"https_proxy", "identity_token_file", b"identity_token_file", "ignore_globs", b"ignore_globs", "init_timeout", b"init_timeout", "is_local", b"is_local", "job_name", b"job_name", "job_source", b"job_source", "label_disable", b"label_disable", "launch", b"launch", "launch_config_path", b"launch_config_path", "log_dir", b"log_dir", "log_internal", b"log_internal", "log_symlink_internal", b"log_symlink_internal", "log_symlink_user", b"log_symlink_user", "log_user", b"log_user", "login_timeout", b"login_timeout", "mode", b"mode", "notebook_name", b"notebook_name", "program", b"program", "program_abspath", b"program_abspath", "program_relpath", b"program_relpath", "project", b"project", "project_url", b"project_url", "quiet", b"quiet", "reinit", b"reinit", "relogin", b"relogin", "resume", b"resume", "resume_fname", b"resume_fname", "resume_from", b"resume_from", "resumed", b"resumed", "root_dir", b"root_dir", "run_group", b"run_group", "run_id", b"run_id", "run_job_type", b"run_job_type", "run_mode", b"run_mode", "run_name", b"run_name", "run_notes", b"run_notes", "run_tags", b"run_tags", "run_url", b"run_url", "sagemaker_disable", b"sagemaker_disable", "save_code", b"save_code", "settings_system", b"settings_system", "settings_workspace", b"settings_workspace", "show_colors", b"show_colors", "show_emoji", b"show_emoji", "show_errors", b"show_errors", "show_info", b"show_info", "show_warnings", b"show_warnings", "silent", b"silent", "start_method", b"start_method", "strict", b"strict", "summary_errors", b"summary_errors", "summary_timeout", b"summary_timeout", "summary_warnings", b"summary_warnings", "sweep_id", b"sweep_id", "sweep_param_path", b"sweep_param_path", "sweep_url", b"sweep_url", "symlink", b"symlink", "sync_dir", b"sync_dir", "sync_file", b"sync_file", "sync_symlink_latest", b"sync_symlink_latest", "system_sample", b"system_sample", "system_sample_seconds", b"system_sample_seconds", "table_raise_on_max_row_limit_exceeded", b"table_raise_on_max_row_limit_exceeded", "timespec", b"timespec", "tmp_dir", b"tmp_dir", "username", b"username", "wandb_dir", b"wandb_dir"]) -> builtins.bool: ...
-    def ClearField(self, field_name: typing.Literal["_args", b"_args", "_aws_lambda", b"_aws_lambda", "_cli_only_mode", b"_cli_only_mode", "_code_path_local", b"_code_path_local", "_colab", b"_colab", "_cuda", b"_cuda", "_disable_machine_info", b"_disable_machine_info", "_disable_meta", b"_disable_meta", "_disable_service", b"_disable_service", "_disable_setproctitle", b"_disable_setproctitle", "_disable_stats", b"_disable_stats", "_disable_update_check", b"_disable_update_check", "_disable_viewer", b"_disable_viewer", "_executable", b"_executable", "_extra_http_headers", b"_extra_http_headers", "_file_stream_retry_max", b"_file_stream_retry_max", "_file_stream_retry_wait_max_seconds", b"_file_stream_retry_wait_max_seconds", "_file_stream_retry_wait_min_seconds", b"_file_stream_retry_wait_min_seconds", "_file_stream_timeout_seconds", b"_file_stream_timeout_seconds", "_file_transfer_retry_max", b"_file_transfer_retry_max", "_file_transfer_retry_wait_max_seconds", b"_file_transfer_retry_wait_max_seconds", "_file_transfer_retry_wait_min_seconds", b"_file_transfer_retry_wait_min_seconds", "_file_transfer_timeout_seconds", b"_file_transfer_timeout_seconds", "_flow_control_custom", b"_flow_control_custom", "_flow_control_disabled", b"_flow_control_disabled", "_graphql_retry_max", b"_graphql_retry_max", "_graphql_retry_wait_max_seconds", b"_graphql_retry_wait_max_seconds", "_graphql_retry_wait_min_seconds", b"_graphql_retry_wait_min_seconds", "_graphql_timeout_seconds", b"_graphql_timeout_seconds", "_internal_check_process", b"_internal_check_process", "_internal_queue_timeout", b"_internal_queue_timeout", "_ipython", b"_ipython", "_jupyter", b"_jupyter", "_jupyter_name", b"_jupyter_name", "_jupyter_path", b"_jupyter_path", "_jupyter_root", b"_jupyter_root", "_kaggle", b"_kaggle", "_live_policy_rate_limit", b"_live_policy_rate_limit", "_live_policy_wait_time", b"_live_policy_wait_time", "_log_level", b"_log_level", "_network_buffer", b"_network_buffer", "_noop", b"_noop", "_notebook", b"_notebook", "_offline", b"_offline", "_os", b"_os", "_platform", b"_platform", "_proxies", b"_proxies", "_python", b"_python", "_require_core", b"_require_core", "_runqueue_item_id", b"_runqueue_item_id", "_save_requirements", b"_save_requirements", "_service_transport", b"_service_transport", "_service_wait", b"_service_wait", "_shared", b"_shared", "_start_datetime", b"_start_datetime", "_start_time", b"_start_time", "_stats_buffer_size", b"_stats_buffer_size", "_stats_disk_paths", b"_stats_disk_paths", "_stats_join_assets", b"_stats_join_assets", "_stats_neuron_monitor_config_path", b"_stats_neuron_monitor_config_path", "_stats_open_metrics_endpoints", b"_stats_open_metrics_endpoints", "_stats_open_metrics_filters", b"_stats_open_metrics_filters", "_stats_pid", b"_stats_pid", "_stats_sample_rate_seconds", b"_stats_sample_rate_seconds", "_stats_samples_to_average", b"_stats_samples_to_average", "_sync", b"_sync", "_tmp_code_dir", b"_tmp_code_dir", "_tracelog", b"_tracelog", "_unsaved_keys", b"_unsaved_keys", "_windows", b"_windows", "allow_val_change", b"allow_val_change", "anonymous", b"anonymous", "api_key", b"api_key", "azure_account_url_to_access_key", b"azure_account_url_to_access_key", "base_url", b"base_url", "code_dir", b"code_dir", "colab_url", b"colab_url", "config_paths", b"config_paths", "console", b"console", "console_multipart", b"console_multipart", "credentials_file", b"credentials_file", "deployment", b"deployment", "disable_code", b"disable_code", "disable_git", b"disable_git", "disable_hints", b"disable_hints", "disable_job_creation", b"disable_job_creation", "disabled", b"disabled", "docker", b"docker", "email", b"email", "entity", b"entity", "files_dir", b"files_dir", "force", b"force", "fork_from", b"fork_from", "git_commit", b"git_commit", "git_remote", b"git_remote", "git_remote_url", b"git_remote_url", "git_root", b"git_root", "heartbeat_seconds", b"heartbeat_seconds", "host", b"host", "http_proxy", b"http_proxy", "https_proxy", b"https_proxy", "identity_token_file", b"identity_token_file", "ignore_globs", b"ignore_globs", "init_timeout", b"init_timeout", "is_local", b"is_local", "job_name", b"job_name", "job_source", b"job_source", "label_disable", b"label_disable", "launch", b"launch", "launch_config_path", b"launch_config_path", "log_dir", b"log_dir", "log_internal", b"log_internal", "log_symlink_internal", b"log_symlink_internal", "log_symlink_user", b"log_symlink_user", "log_user", b"log_user", "login_timeout", b"login_timeout", "mode", b"mode", "notebook_name", b"notebook_name", "program", b"program", "program_abspath", b"program_abspath", "program_relpath", b"program_relpath", "project", b"project", "project_url", b"project_url", "quiet", b"quiet", "reinit", b"reinit", "relogin", b"relogin", "resume", b"resume", "resume_fname", b"resume_fname", "resume_from", b"resume_from", "resumed", b"resumed", "root_dir", b"root_dir", "run_group", b"run_group", "run_id", b"run_id", "run_job_type", b"run_job_type", "run_mode", b"run_mode", "run_name", b"run_name", "run_notes", b"run_notes", "run_tags", b"run_tags", "run_url", b"run_url", "sagemaker_disable", b"sagemaker_disable", "save_code", b"save_code", "settings_system", b"settings_system", "settings_workspace", b"settings_workspace", "show_colors", b"show_colors", "show_emoji", b"show_emoji", "show_errors", b"show_errors", "show_info", b"show_info", "show_warnings", b"show_warnings", "silent", b"silent", "start_method", b"start_method", "strict", b"strict", "summary_errors", b"summary_errors", "summary_timeout", b"summary_timeout", "summary_warnings", b"summary_warnings", "sweep_id", b"sweep_id", "sweep_param_path", b"sweep_param_path", "sweep_url", b"sweep_url", "symlink", b"symlink", "sync_dir", b"sync_dir", "sync_file", b"sync_file", "sync_symlink_latest", b"sync_symlink_latest", "system_sample", b"system_sample", "system_sample_seconds", b"system_sample_seconds", "table_raise_on_max_row_limit_exceeded", b"table_raise_on_max_row_limit_exceeded", "timespec", b"timespec", "tmp_dir", b"tmp_dir", "username", b"username", "wandb_dir", b"wandb_dir"]) -> None: ...
+    def HasField(self, field_name: typing.Literal["_api_circuit_breaker_cooldown_seconds", b"_api_circuit_breaker_cooldown_seconds", "_api_circuit_breaker_threshold", b"_api_circuit_breaker_threshold", "_args", b"_args", "_artifact_manifest_v2", b"_artifact_manifest_v2", "_aws_lambda", b"_aws_lambda", "_cli_only_mode", b"_cli_only_mode", "_code_path_local", b"_code_path_local", "_colab", b"_colab", "_console_dedupe_lines", b"_console_dedupe_lines", "_console_structured", b"_console_structured", "_console_upload_bytes_per_second", b"_console_upload_bytes_per_second", "_core_log_level", b"_core_log_level", "_cuda", b"_cuda", "_defer_auth", b"_defer_auth", "_disable_machine_info", b"_disable_machine_info", "_disable_meta", b"_disable_meta", "_disable_org_defaults", b"_disable_org_defaults", "_disable_service", b"_disable_service", "_disable_setproctitle", b"_disable_setproctitle", "_disable_stats", b"_disable_stats", "_disable_update_check", b"_disable_update_check", "_disable_viewer", b"_disable_viewer", "_env_snapshot", b"_env_snapshot", "_executable", b"_executable", "_extra_http_headers", b"_extra_http_headers", "_file_stream_retry_max", b"_file_stream_retry_max", "_file_stream_retry_wait_max_seconds", b"_file_stream_retry_wait_max_seconds", "_file_stream_retry_wait_min_seconds", b"_file_stream_retry_wait_min_seconds", "_file_stream_timeout_seconds", b"_file_stream_timeout_seconds", "_file_stream_transport", b"_file_stream_transport", "_file_transfer_http2", b"_file_transfer_http2", "_file_transfer_max_concurrency", b"_file_transfer_max_concurrency", "_file_transfer_max_conns_per_host", b"_file_transfer_max_conns_per_host", "_file_transfer_max_idle_conns", b"_file_transfer_max_idle_conns", "_file_transfer_max_idle_conns_per_host", b"_file_transfer_max_idle_conns_per_host", "_file_transfer_retry_max", b"_file_transfer_retry_max", "_file_transfer_retry_wait_max_seconds", b"_file_transfer_retry_wait_max_seconds", "_file_transfer_retry_wait_min_seconds", b"_file_transfer_retry_wait_min_seconds", "_file_transfer_timeout_seconds", b"_file_transfer_timeout_seconds", "_flow_control_custom", b"_flow_control_custom", "_flow_control_disabled", b"_flow_control_disabled", "_gcs_audience", b"_gcs_audience", "_gcs_impersonate_service_account", b"_gcs_impersonate_service_account", "_gcs_scopes", b"_gcs_scopes", "_git_patch_max_bytes", b"_git_patch_max_bytes", "_git_patch_untracked", b"_git_patch_untracked", "_graphql_cache_ttl_seconds", b"_graphql_cache_ttl_seconds", "_graphql_persisted_queries", b"_graphql_persisted_queries", "_graphql_retry_max", b"_graphql_retry_max", "_graphql_retry_wait_max_seconds", b"_graphql_retry_wait_max_seconds", "_graphql_retry_wait_min_seconds", b"_graphql_retry_wait_min_seconds", "_graphql_timeout_seconds", b"_graphql_timeout_seconds", "_history_aggregation", b"_history_aggregation", "_history_aggregation_keep_raw", b"_history_aggregation_keep_raw", "_history_aggregation_max_rate", b"_history_aggregation_max_rate", "_internal_check_process", b"_internal_check_process", "_internal_queue_timeout", b"_internal_queue_timeout", "_ipython", b"_ipython", "_jupyter", b"_jupyter", "_jupyter_name", b"_jupyter_name", "_jupyter_path", b"_jupyter_path", "_jupyter_root", b"_jupyter_root", "_kaggle", b"_kaggle", "_large_upload_window", b"_large_upload_window", "_live_policy_rate_limit", b"_live_policy_rate_limit", "_live_policy_wait_time", b"_live_policy_wait_time", "_log_level", b"_log_level", "_max_config_summary_depth", b"_max_config_summary_depth", "_max_config_summary_keys", b"_max_config_summary_keys", "_max_config_summary_value_bytes", b"_max_config_summary_value_bytes", "_media_keep_last", b"_media_keep_last", "_media_sample_steps", b"_media_sample_steps", "_network_buffer", b"_network_buffer", "_node_rank", b"_node_rank", "_noop", b"_noop", "_notebook", b"_notebook", "_offline", b"_offline", "_os", b"_os", "_platform", b"_platform", "_preemption_grace_seconds", b"_preemption_grace_seconds", "_preemption_hook", b"_preemption_hook", "_proxies", b"_proxies", "_python", b"_python", "_request_signing_command", b"_request_signing_command", "_require_core", b"_require_core", "_run_descriptor", b"_run_descriptor", "_run_descriptor_qr", b"_run_descriptor_qr", "_runqueue_item_id", b"_runqueue_item_id", "_s3_endpoint_url", b"_s3_endpoint_url", "_s3_insecure_tls", b"_s3_insecure_tls", "_s3_path_style", b"_s3_path_style", "_save_requirements", b"_save_requirements", "_server_side_summary", b"_server_side_summary", "_service_transport", b"_service_transport", "_service_wait", b"_service_wait", "_shared", b"_shared", "_start_datetime", b"_start_datetime", "_start_time", b"_start_time", "_stats_buffer_size", b"_stats_buffer_size", "_stats_dcgm_profiling_fields", b"_stats_dcgm_profiling_fields", "_stats_dcgm_profiling_interval_seconds", b"_stats_dcgm_profiling_interval_seconds", "_stats_disk_paths", b"_stats_disk_paths", "_stats_join_assets", b"_stats_join_assets", "_stats_neuron_monitor_config_path", b"_stats_neuron_monitor_config_path", "_stats_open_metrics_endpoints", b"_stats_open_metrics_endpoints", "_stats_open_metrics_filters", b"_stats_open_metrics_filters", "_stats_pid", b"_stats_pid", "_stats_sample_rate_seconds", b"_stats_sample_rate_seconds", "_stats_samples_to_average", b"_stats_samples_to_average", "_store_compression", b"_store_compression", "_store_index", b"_store_index", "_stream_max_buffered_mb", b"_stream_max_buffered_mb", "_stream_max_queued_mb", b"_stream_max_queued_mb", "_stream_max_queued_records", b"_stream_max_queued_records", "_sync", b"_sync", "_tmp_code_dir", b"_tmp_code_dir", "_tracelog", b"_tracelog", "_unsaved_keys", b"_unsaved_keys", "_upload_bandwidth_limit", b"_upload_bandwidth_limit", "_watchdog_rules", b"_watchdog_rules", "_windows", b"_windows", "allow_val_change", b"allow_val_change", "anonymous", b"anonymous", "api_key", b"api_key", "azure_account_url_to_access_key", b"azure_account_url_to_access_key", "base_url", b"base_url", "ca_cert_file", b"ca_cert_file", "client_cert_file", b"client_cert_file", "client_key_file", b"client_key_file", "code_dir", b"code_dir", "colab_url", b"colab_url", "config_paths", b"config_paths", "console", b"console", "console_multipart", b"console_multipart", "credentials_file", b"credentials_file", "deployment", b"deployment", "disable_code", b"disable_code", "disable_git", b"disable_git", "disable_hints", b"disable_hints", "disable_job_creation", b"disable_job_creation", "disabled", b"disabled", "docker", b"docker", "email", b"email", "entity", b"entity", "files_dir", b"files_dir", "force", b"force", "fork_from", b"fork_from", "git_commit", b"git_commit", "git_remote", b"git_remote", "git_remote_url", b"git_remote_url", "git_root", b"git_root", "heartbeat_seconds", b"heartbeat_seconds", "host", b"host", "http_proxy", b"http_proxy", "https_proxy", b"https_proxy", "idempotency_key", b"idempotency_key", "identity_token_file", b"identity_token_file", "ignore_globs", b"ignore_globs", "init_timeout", b"init_timeout", "is_local", b"is_local", "job_name", b"job_name", "job_source", b"job_source", "label_disable", b"label_disable", "launch", b"launch", "launch_config_path", b"launch_config_path", "log_dir", b"log_dir", "log_internal", b"log_internal", "log_symlink_internal", b"log_symlink_internal", "log_symlink_user", b"log_symlink_user", "log_user", b"log_user", "login_timeout", b"login_timeout", "mode", b"mode", "no_proxy", b"no_proxy", "notebook_name", b"notebook_name", "program", b"program", "program_abspath", b"program_abspath", "program_relpath", b"program_relpath", "project", b"project", "project_url", b"project_url", "quiet", b"quiet", "reinit", b"reinit", "relogin", b"relogin", "resume", b"resume", "resume_fname", b"resume_fname", "resume_from", b"resume_from", "resumed", b"resumed", "root_dir", b"root_dir", "run_group", b"run_group", "run_id", b"run_id", "run_job_type", b"run_job_type", "run_mode", b"run_mode", "run_name", b"run_name", "run_notes", b"run_notes", "run_tags", b"run_tags", "run_url", b"run_url", "sagemaker_disable", b"sagemaker_disable", "save_code", b"save_code", "settings_system", b"settings_system", "settings_workspace", b"settings_workspace", "show_colors", b"show_colors", "show_emoji", b"show_emoji", "show_errors", b"show_errors", "show_info", b"show_info", "show_warnings", b"show_warnings", "silent", b"silent", "start_method", b"start_method", "strict", b"strict", "summary_errors", b"summary_errors", "summary_timeout", b"summary_timeout", "summary_warnings", b"summary_warnings", "sweep_id", b"sweep_id", "sweep_param_path", b"sweep_param_path", "sweep_url", b"sweep_url", "symlink", b"symlink", "sync_dir", b"sync_dir", "sync_file", b"sync_file", "sync_symlink_latest", b"sync_symlink_latest", "sync_tensorboard_dir", b"sync_tensorboard_dir", "system_sample", b"system_sample", "system_sample_seconds", b"system_sample_seconds", "table_raise_on_max_row_limit_exceeded", b"table_raise_on_max_row_limit_exceeded", "timespec", b"timespec", "tmp_dir", b"tmp_dir", "username", b"username", "wandb_dir", b"wandb_dir", "x_otel_endpoint", b"x_otel_endpoint"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing.Literal["_api_circuit_breaker_cooldown_seconds", b"_api_circuit_breaker_cooldown_seconds", "_api_circuit_breaker_threshold", b"_api_circuit_breaker_threshold", "_args", b"_args", "_artifact_manifest_v2", b"_artifact_manifest_v2", "_aws_lambda", b"_aws_lambda", "_cli_only_mode", b"_cli_only_mode", "_code_path_local", b"_code_path_local", "_colab", b"_colab", "_console_dedupe_lines", b"_console_dedupe_lines", "_console_structured", b"_console_structured", "_console_upload_bytes_per_second", b"_console_upload_bytes_per_second", "_core_log_level", b"_core_log_level", "_cuda", b"_cuda", "_defer_auth", b"_defer_auth", "_disable_machine_info", b"_disable_machine_info", "_disable_meta", b"_disable_meta", "_disable_org_defaults", b"_disable_org_defaults", "_disable_service", b"_disable_service", "_disable_setproctitle", b"_disable_setproctitle", "_disable_stats", b"_disable_stats", "_disable_update_check", b"_disable_update_check", "_disable_viewer", b"_disable_viewer", "_env_snapshot", b"_env_snapshot", "_executable", b"_executable", "_extra_http_headers", b"_extra_http_headers", "_file_stream_retry_max", b"_file_stream_retry_max", "_file_stream_retry_wait_max_seconds", b"_file_stream_retry_wait_max_seconds", "_file_stream_retry_wait_min_seconds", b"_file_stream_retry_wait_min_seconds", "_file_stream_timeout_seconds", b"_file_stream_timeout_seconds", "_file_stream_transport", b"_file_stream_transport", "_file_transfer_http2", b"_file_transfer_http2", "_file_transfer_max_concurrency", b"_file_transfer_max_concurrency", "_file_transfer_max_conns_per_host", b"_file_transfer_max_conns_per_host", "_file_transfer_max_idle_conns", b"_file_transfer_max_idle_conns", "_file_transfer_max_idle_conns_per_host", b"_file_transfer_max_idle_conns_per_host", "_file_transfer_retry_max", b"_file_transfer_retry_max", "_file_transfer_retry_wait_max_seconds", b"_file_transfer_retry_wait_max_seconds", "_file_transfer_retry_wait_min_seconds", b"_file_transfer_retry_wait_min_seconds", "_file_transfer_timeout_seconds", b"_file_transfer_timeout_seconds", "_flow_control_custom", b"_flow_control_custom", "_flow_control_disabled", b"_flow_control_disabled", "_gcs_audience", b"_gcs_audience", "_gcs_impersonate_service_account", b"_gcs_impersonate_service_account", "_gcs_scopes", b"_gcs_scopes", "_git_patch_max_bytes", b"_git_patch_max_bytes", "_git_patch_untracked", b"_git_patch_untracked", "_graphql_cache_ttl_seconds", b"_graphql_cache_ttl_seconds", "_graphql_persisted_queries", b"_graphql_persisted_queries", "_graphql_retry_max", b"_graphql_retry_max", "_graphql_retry_wait_max_seconds", b"_graphql_retry_wait_max_seconds", "_graphql_retry_wait_min_seconds", b"_graphql_retry_wait_min_seconds", "_graphql_timeout_seconds", b"_graphql_timeout_seconds", "_history_aggregation", b"_history_aggregation", "_history_aggregation_keep_raw", b"_history_aggregation_keep_raw", "_history_aggregation_max_rate", b"_history_aggregation_max_rate", "_internal_check_process", b"_internal_check_process", "_internal_queue_timeout", b"_internal_queue_timeout", "_ipython", b"_ipython", "_jupyter", b"_jupyter", "_jupyter_name", b"_jupyter_name", "_jupyter_path", b"_jupyter_path", "_jupyter_root", b"_jupyter_root", "_kaggle", b"_kaggle", "_large_upload_window", b"_large_upload_window", "_live_policy_rate_limit", b"_live_policy_rate_limit", "_live_policy_wait_time", b"_live_policy_wait_time", "_log_level", b"_log_level", "_max_config_summary_depth", b"_max_config_summary_depth", "_max_config_summary_keys", b"_max_config_summary_keys", "_max_config_summary_value_bytes", b"_max_config_summary_value_bytes", "_media_keep_last", b"_media_keep_last", "_media_sample_steps", b"_media_sample_steps", "_network_buffer", b"_network_buffer", "_node_rank", b"_node_rank", "_noop", b"_noop", "_notebook", b"_notebook", "_offline", b"_offline", "_os", b"_os", "_platform", b"_platform", "_preemption_grace_seconds", b"_preemption_grace_seconds", "_preemption_hook", b"_preemption_hook", "_proxies", b"_proxies", "_python", b"_python", "_request_signing_command", b"_request_signing_command", "_require_core", b"_require_core", "_run_descriptor", b"_run_descriptor", "_run_descriptor_qr", b"_run_descriptor_qr", "_runqueue_item_id", b"_runqueue_item_id", "_s3_endpoint_url", b"_s3_endpoint_url", "_s3_insecure_tls", b"_s3_insecure_tls", "_s3_path_style", b"_s3_path_style", "_save_requirements", b"_save_requirements", "_server_side_summary", b"_server_side_summary", "_service_transport", b"_service_transport", "_service_wait", b"_service_wait", "_shared", b"_shared", "_start_datetime", b"_start_datetime", "_start_time", b"_start_time", "_stats_buffer_size", b"_stats_buffer_size", "_stats_dcgm_profiling_fields", b"_stats_dcgm_profiling_fields", "_stats_dcgm_profiling_interval_seconds", b"_stats_dcgm_profiling_interval_seconds", "_stats_disk_paths", b"_stats_disk_paths", "_stats_join_assets", b"_stats_join_assets", "_stats_neuron_monitor_config_path", b"_stats_neuron_monitor_config_path", "_stats_open_metrics_endpoints", b"_stats_open_metrics_endpoints", "_stats_open_metrics_filters", b"_stats_open_metrics_filters", "_stats_pid", b"_stats_pid", "_stats_sample_rate_seconds", b"_stats_sample_rate_seconds", "_stats_samples_to_average", b"_stats_samples_to_average", "_store_compression", b"_store_compression", "_store_index", b"_store_index", "_stream_max_buffered_mb", b"_stream_max_buffered_mb", "_stream_max_queued_mb", b"_stream_max_queued_mb", "_stream_max_queued_records", b"_stream_max_queued_records", "_sync", b"_sync", "_tmp_code_dir", b"_tmp_code_dir", "_tracelog", b"_tracelog", "_unsaved_keys", b"_unsaved_keys", "_upload_bandwidth_limit", b"_upload_bandwidth_limit", "_watchdog_rules", b"_watchdog_rules", "_windows", b"_windows", "allow_val_change", b"allow_val_change", "anonymous", b"anonymous", "api_key", b"api_key", "azure_account_url_to_access_key", b"azure_account_url_to_access_key", "base_url", b"base_url", "ca_cert_file", b"ca_cert_file", "client_cert_file", b"client_cert_file", "client_key_file", b"client_key_file", "code_dir", b"code_dir", "colab_url", b"colab_url", "config_paths", b"config_paths", "console", b"console", "console_multipart", b"console_multipart", "credentials_file", b"credentials_file", "deployment", b"deployment", "disable_code", b"disable_code", "disable_git", b"disable_git", "disable_hints", b"disable_hints", "disable_job_creation", b"disable_job_creation", "disabled", b"disabled", "docker", b"docker", "email", b"email", "entity", b"entity", "files_dir", b"files_dir", "force", b"force", "fork_from", b"fork_from", "git_commit", b"git_commit", "git_remote", b"git_remote", "git_remote_url", b"git_remote_url", "git_root", b"git_root", "heartbeat_seconds", b"heartbeat_seconds", "host", b"host", "http_proxy", b"http_proxy", "https_proxy", b"https_proxy", "idempotency_key", b"idempotency_key", "identity_token_file", b"identity_token_file", "ignore_globs", b"ignore_globs", "init_timeout", b"init_timeout", "is_local", b"is_local", "job_name", b"job_name", "job_source", b"job_source", "label_disable", b"label_disable", "launch", b"launch", "launch_config_path", b"launch_config_path", "log_dir", b"log_dir", "log_internal", b"log_internal", "log_symlink_internal", b"log_symlink_internal", "log_symlink_user", b"log_symlink_user", "log_user", b"log_user", "login_timeout", b"login_timeout", "mode", b"mode", "no_proxy", b"no_proxy", "notebook_name", b"notebook_name", "program", b"program", "program_abspath", b"program_abspath", "program_relpath", b"program_relpath", "project", b"project", "project_url", b"project_url", "quiet", b"quiet", "reinit", b"reinit", "relogin", b"relogin", "resume", b"resume", "resume_fname", b"resume_fname", "resume_from", b"resume_from", "resumed", b"resumed", "root_dir", b"root_dir", "run_group", b"run_group", "run_id", b"run_id", "run_job_type", b"run_job_type", "run_mode", b"run_mode", "run_name", b"run_name", "run_notes", b"run_notes", "run_tags", b"run_tags", "run_url", b"run_url", "sagemaker_disable", b"sagemaker_disable", "save_code", b"save_code", "settings_system", b"settings_system", "settings_workspace", b"settings_workspace", "show_colors", b"show_colors", "show_emoji", b"show_emoji", "show_errors", b"show_errors", "show_info", b"show_info", "show_warnings", b"show_warnings", "silent", b"silent", "start_method", b"start_method", "strict", b"strict", "summary_errors", b"summary_errors", "summary_timeout", b"summary_timeout", "summary_warnings", b"summary_warnings", "sweep_id", b"sweep_id", "sweep_param_path", b"sweep_param_path", "sweep_url", b"sweep_url", "symlink", b"symlink", "sync_dir", b"sync_dir", "sync_file", b"sync_file", "sync_symlink_latest", b"sync_symlink_latest", "sync_tensorboard_dir", b"sync_tensorboard_dir", "system_sample", b"system_sample", "system_sample_seconds", b"system_sample_seconds", "table_raise_on_max_row_limit_exceeded", b"table_raise_on_max_row_limit_exceeded", "timespec", b"timespec", "tmp_dir", b"tmp_dir", "username", b"username", "wandb_dir", b"wandb_dir", "x_otel_endpoint", b"x_otel_endpoint"]) -> None: ...
 
 global___Settings = Settings
@@ -8,7 +8,6 @@ import multiprocessing.dummy
 import os
 import re
 import shutil
-import stat
 import sys
 import tempfile
 import time
@@ -54,6 +53,9 @@ from wandb.sdk.artifacts.artifact_manifest_entry import ArtifactManifestEntry
 from wandb.sdk.artifacts.artifact_manifests.artifact_manifest_v1 import (
     ArtifactManifestV1,
 )
+from wandb.sdk.artifacts.artifact_manifests.artifact_manifest_v2 import (  # noqa: F401
+    ArtifactManifestV2,
+)
 from wandb.sdk.artifacts.artifact_state import ArtifactState
 from wandb.sdk.artifacts.artifact_ttl import ArtifactTTL
 from wandb.sdk.artifacts.exceptions import (
@@ -61,7 +63,7 @@ from wandb.sdk.artifacts.exceptions import (
     ArtifactNotLoggedError,
     WaitTimeoutError,
 )
-from wandb.sdk.artifacts.staging import get_staging_dir
+from wandb.sdk.artifacts.staging import stage_file
 from wandb.sdk.artifacts.storage_layout import StorageLayout
 from wandb.sdk.artifacts.storage_policies import WANDB_STORAGE_POLICY
 from wandb.sdk.artifacts.storage_policy import StoragePolicy
@@ -1455,12 +1457,7 @@ class Artifact:
             )
         upload_path = path
         if policy == "mutable":
-            with tempfile.NamedTemporaryFile(dir=get_staging_dir(), delete=False) as f:
-                staging_path = f.name
-                shutil.copyfile(path, staging_path)
-                # Set as read-only to prevent changes to the file during upload process
-                os.chmod(staging_path, stat.S_IRUSR)
-                upload_path = staging_path
+            upload_path, digest = stage_file(path, digest)
 
         entry = ArtifactManifestEntry(
             path=name,
@@ -1810,7 +1807,13 @@ class Artifact:
                 cursor = attrs["pageInfo"]["endCursor"]
                 for edge in attrs["edges"]:
                     entry = self.get_entry(edge["node"]["name"])
-                    if require_core and entry.ref is None:
+                    if require_core and (
+                        entry.ref is None
+                        or (
+                            urlparse(entry.ref).scheme in ("s3", "gs")
+                            and entry.digest != entry.ref
+                        )
+                    ):
                         # Handled by core
                         continue
                     entry._download_url = edge["node"]["directUrl"]
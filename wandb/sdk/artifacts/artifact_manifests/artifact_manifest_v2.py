@@ -0,0 +1,36 @@
+"""Artifact manifest v2."""
+
+from typing import Dict, Optional
+
+from wandb.sdk.artifacts.artifact_manifest import ArtifactManifest
+from wandb.sdk.artifacts.artifact_manifests.artifact_manifest_v1 import (
+    ArtifactManifestV1,
+)
+from wandb.sdk.internal.internal_api import Api as InternalApi
+
+
+class ArtifactManifestV2(ArtifactManifest):
+    """Reads version 2 manifests, which wandb-core writes for large artifacts.
+
+    A version 2 manifest has the same contents as a version 1 manifest, plus
+    an index of its directories and of the byte ranges of its entries that lets
+    wandb-core read entries without loading the whole manifest. The index is
+    derived from the entries, so the manifest is read as a version 1 manifest.
+    """
+
+    @classmethod
+    def version(cls) -> int:
+        return 2
+
+    @classmethod
+    def from_manifest_json(  # type: ignore[override]
+        cls, manifest_json: Dict, api: Optional[InternalApi] = None
+    ) -> ArtifactManifestV1:
+        if manifest_json["version"] != cls.version():
+            raise ValueError(
+                "Expected manifest version 2, got {}".format(manifest_json["version"])
+            )
+
+        return ArtifactManifestV1.from_manifest_json(
+            {**manifest_json, "version": ArtifactManifestV1.version()}, api=api
+        )
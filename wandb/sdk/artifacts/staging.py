@@ -3,13 +3,24 @@
 Artifact files are copied to the staging area as soon as they are added to an artifact
 in order to avoid file changes corrupting the artifact. Once the upload is complete, the
 file should be moved to the artifact cache.
+
+Where the OS supports hard links, each file's contents are stored once under their MD5
+hash in the "staging-content" directory next to the staging directory, and each staged
+file is a hard link to that copy. Logging the same file from several runs at once then
+doesn't copy it several times. The shared copy is deleted together with its last link.
 """
 
 import os
+import shutil
+import stat
+import sys
+import tempfile
+from typing import Optional, Tuple
 
 from wandb import env
 from wandb.sdk.lib.filesystem import mkdir_exists_ok
-from wandb.sdk.lib.paths import FilePathStr
+from wandb.sdk.lib.hashutil import B64MD5, b64_to_hex_id, md5_file_b64
+from wandb.sdk.lib.paths import FilePathStr, StrPath
 
 
 def get_staging_dir() -> FilePathStr:
@@ -23,3 +34,110 @@ def get_staging_dir() -> FilePathStr:
         ) from e
 
     return FilePathStr(os.path.abspath(os.path.expanduser(path)))
+
+
+def _content_path(staging_dir: str, digest: B64MD5) -> str:
+    hex_digest = b64_to_hex_id(digest)
+    return os.path.join(
+        os.path.dirname(staging_dir),
+        "staging-content",
+        hex_digest[:2],
+        hex_digest[2:],
+    )
+
+
+def _supports_content_store() -> bool:
+    # Staged files are deleted while they may be open elsewhere, which
+    # Windows doesn't allow for hard links.
+    return hasattr(os, "link") and sys.platform != "win32"
+
+
+def stage_file(
+    path: StrPath, digest: Optional[B64MD5] = None
+) -> Tuple[FilePathStr, B64MD5]:
+    """Copy a file into the staging area.
+
+    Returns the path of the staged file and the MD5 digest of its contents.
+    """
+    staging_dir = get_staging_dir()
+
+    if _supports_content_store():
+        try:
+            return _stage_by_content(staging_dir, path, digest)
+        except OSError:
+            # For example, if the file system doesn't support hard links.
+            pass
+
+    with tempfile.NamedTemporaryFile(dir=staging_dir, delete=False) as f:
+        staging_path = f.name
+    shutil.copyfile(path, staging_path)
+    # Set as read-only to prevent changes to the file during upload process
+    os.chmod(staging_path, stat.S_IRUSR)
+    return FilePathStr(staging_path), digest or md5_file_b64(staging_path)
+
+
+def _stage_by_content(
+    staging_dir: str, path: StrPath, digest: Optional[B64MD5]
+) -> Tuple[FilePathStr, B64MD5]:
+    digest = digest or md5_file_b64(path)
+    content_path = _content_path(staging_dir, digest)
+
+    if os.path.exists(content_path):
+        return _link_new_staging_file(staging_dir, content_path), digest
+
+    with tempfile.NamedTemporaryFile(dir=staging_dir, delete=False) as f:
+        staging_path = f.name
+    shutil.copyfile(path, staging_path)
+    os.chmod(staging_path, stat.S_IRUSR)
+
+    # The file may have changed since it was hashed.
+    staged_digest = md5_file_b64(staging_path)
+    if staged_digest != digest:
+        return FilePathStr(staging_path), staged_digest
+
+    mkdir_exists_ok(os.path.dirname(content_path))
+    try:
+        os.link(staging_path, content_path)
+    except FileExistsError:
+        # Another process staged the same contents first.
+        os.chmod(staging_path, stat.S_IWUSR | stat.S_IRUSR)
+        os.remove(staging_path)
+        return _link_new_staging_file(staging_dir, content_path), digest
+
+    return FilePathStr(staging_path), digest
+
+
+def _link_new_staging_file(staging_dir: str, content_path: str) -> FilePathStr:
+    with tempfile.NamedTemporaryFile(dir=staging_dir, delete=False) as f:
+        staging_path = f.name
+    os.remove(staging_path)
+    os.link(content_path, staging_path)
+    return FilePathStr(staging_path)
+
+
+def remove_staged_file(path: StrPath, digest: B64MD5) -> None:
+    """Delete a staged file after its upload.
+
+    The shared copy of its contents is deleted too if no other staged file
+    links to it.
+    """
+    staging_dir = get_staging_dir()
+    content_path = _content_path(staging_dir, digest)
+
+    try:
+        is_content_link = os.path.samefile(path, content_path)
+    except OSError:
+        is_content_link = False
+
+    if not is_content_link:
+        os.chmod(path, 0o600)
+        os.remove(path)
+        return
+
+    # Don't make the shared copy writable while other runs use it.
+    os.remove(path)
+    try:
+        if os.stat(content_path).st_nlink <= 1:
+            os.remove(content_path)
+    except FileNotFoundError:
+        pass
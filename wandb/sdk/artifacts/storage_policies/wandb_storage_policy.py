@@ -15,7 +15,7 @@ from wandb.sdk.artifacts.artifact_file_cache import (
     ArtifactFileCache,
     get_artifact_file_cache,
 )
-from wandb.sdk.artifacts.staging import get_staging_dir
+from wandb.sdk.artifacts.staging import get_staging_dir, remove_staged_file
 from wandb.sdk.artifacts.storage_handlers.azure_handler import AzureHandler
 from wandb.sdk.artifacts.storage_handlers.gcs_handler import GCSHandler
 from wandb.sdk.artifacts.storage_handlers.http_handler import HTTPHandler
@@ -368,7 +368,6 @@ class WandbStoragePolicy(StoragePolicy):
             if entry.local_path.startswith(staging_dir):
                 # Delete staged files here instead of waiting till
                 # all the files are uploaded
-                os.chmod(entry.local_path, 0o600)
-                os.remove(entry.local_path)
+                remove_staged_file(entry.local_path, B64MD5(entry.digest))
         except OSError as e:
             termwarn(f"Failed to cache {entry.local_path}, ignoring {e}")
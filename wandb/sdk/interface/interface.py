@@ -673,7 +673,7 @@ class InterfaceBase:
     def _publish_output(self, outdata: pb.OutputRecord) -> None:
         raise NotImplementedError
 
-    def publish_output_raw(self, name: str, data: str) -> None:
+    def publish_output_raw(self, name: str, data: str, source: str = "") -> None:
         # from vendor.protobuf import google3.protobuf.timestamp
         # ts = timestamp.Timestamp()
         # ts.GetCurrentTime()
@@ -685,7 +685,7 @@ class InterfaceBase:
         else:
             # TODO(jhr): throw error?
             print("unknown type")
-        o = pb.OutputRawRecord(output_type=otype, line=data)
+        o = pb.OutputRawRecord(output_type=otype, line=data, source=source)
         o.timestamp.GetCurrentTime()
         self._publish_output_raw(o)
 
@@ -709,6 +709,38 @@ class InterfaceBase:
     def _publish_resume(self, resume: pb.ResumeRequest) -> None:
         raise NotImplementedError
 
+    def publish_update_settings(
+        self,
+        stats_sample_rate_seconds: Optional[float] = None,
+        console: Optional[str] = None,
+        log_level: Optional[str] = None,
+    ) -> None:
+        """Changes settings of the run while it is in progress.
+
+        Only wandb-core applies the update. Settings that are None are left
+        unchanged.
+
+        Args:
+            stats_sample_rate_seconds: Seconds between system metrics samples.
+            console: Console capture mode; "off" stops recording console
+                output.
+            log_level: Level of wandb-core's debug log, like "debug".
+        """
+        update_settings = pb.UpdateSettingsRequest()
+        if stats_sample_rate_seconds is not None:
+            update_settings.stats_sample_rate_seconds = stats_sample_rate_seconds
+        if console is not None:
+            update_settings.console = console
+        if log_level is not None:
+            update_settings.log_level = log_level
+        self._publish_update_settings(update_settings)
+
+    @abstractmethod
+    def _publish_update_settings(
+        self, update_settings: pb.UpdateSettingsRequest
+    ) -> None:
+        raise NotImplementedError
+
     def publish_alert(
         self, title: str, text: str, level: str, wait_duration: int
     ) -> None:
@@ -152,6 +152,7 @@ class InterfaceShared(InterfaceBase):
         get_system_metrics: Optional[pb.GetSystemMetricsRequest] = None,
         python_packages: Optional[pb.PythonPackagesRequest] = None,
         job_input: Optional[pb.JobInputRequest] = None,
+        update_settings: Optional[pb.UpdateSettingsRequest] = None,
     ) -> pb.Record:
         request = pb.Request()
         if login:
@@ -214,6 +215,8 @@ class InterfaceShared(InterfaceBase):
             request.python_packages.CopyFrom(python_packages)
         elif job_input:
             request.job_input.CopyFrom(job_input)
+        elif update_settings:
+            request.update_settings.CopyFrom(update_settings)
         else:
             raise Exception("Invalid request")
         record = self._make_record(request=request)
@@ -362,6 +365,12 @@ class InterfaceShared(InterfaceBase):
         rec = self._make_request(resume=resume)
         self._publish(rec)
 
+    def _publish_update_settings(
+        self, update_settings: pb.UpdateSettingsRequest
+    ) -> None:
+        rec = self._make_request(update_settings=update_settings)
+        self._publish(rec)
+
     def _publish_run(self, run: pb.RunRecord) -> None:
         rec = self._make_record(run=run)
         self._publish(rec)
@@ -47,6 +47,8 @@ LEVELDBLOG_HEADER_MAGIC = (
     0xBEE1  # zlib.crc32(bytes("Weights & Biases", 'iso8859-1')) & 0xffff
 )
 LEVELDBLOG_HEADER_VERSION = 0
+# Set in the header version of logs compressed with zstd by wandb-core.
+LEVELDBLOG_HEADER_FLAG_ZSTD = 0x80
 
 try:
     bytes("", "ascii")
@@ -203,6 +205,11 @@ class DataStore:
             raise Exception("Invalid header")
         if magic != LEVELDBLOG_HEADER_MAGIC:
             raise Exception("Invalid header")
+        if version & LEVELDBLOG_HEADER_FLAG_ZSTD:
+            raise Exception(
+                "Transaction log is compressed with zstd, which is only"
+                " supported by wandb-core"
+            )
         if version != LEVELDBLOG_HEADER_VERSION:
             raise Exception("Invalid header")
         self._index += len(header)
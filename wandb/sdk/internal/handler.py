@@ -207,6 +207,10 @@ class HandleManager:
     def handle_request_python_packages(self, record: Record) -> None:
         self._dispatch_record(record)
 
+    def handle_request_update_settings(self, record: Record) -> None:
+        # Changing settings mid-run is only supported by wandb-core.
+        pass
+
     def handle_run(self, record: Record) -> None:
         if self._settings._offline:
             self._run_proto = record.run
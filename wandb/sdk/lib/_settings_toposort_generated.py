@@ -12,17 +12,25 @@ else:
 
 _Setting = Literal[
     "_args",
+    "_artifact_manifest_v2",
     "_aws_lambda",
     "_cli_only_mode",
     "_code_path_local",
     "_colab",
+    "_console_dedupe_lines",
+    "_console_structured",
+    "_console_upload_bytes_per_second",
+    "_core_log_level",
     "_cuda",
+    "_defer_auth",
     "_disable_meta",
+    "_disable_org_defaults",
     "_disable_service",
     "_disable_setproctitle",
     "_disable_stats",
     "_disable_update_check",
     "_disable_viewer",
+    "_env_snapshot",
     "_disable_machine_info",
     "_executable",
     "_extra_http_headers",
@@ -30,16 +38,32 @@ _Setting = Literal[
     "_file_stream_retry_wait_min_seconds",
     "_file_stream_retry_wait_max_seconds",
     "_file_stream_timeout_seconds",
+    "_file_stream_transport",
+    "_file_transfer_http2",
+    "_file_transfer_max_concurrency",
+    "_file_transfer_max_conns_per_host",
+    "_file_transfer_max_idle_conns",
+    "_file_transfer_max_idle_conns_per_host",
     "_file_transfer_retry_max",
     "_file_transfer_retry_wait_min_seconds",
     "_file_transfer_retry_wait_max_seconds",
     "_file_transfer_timeout_seconds",
     "_flow_control_custom",
     "_flow_control_disabled",
+    "_gcs_audience",
+    "_gcs_impersonate_service_account",
+    "_gcs_scopes",
+    "_git_patch_max_bytes",
+    "_git_patch_untracked",
+    "_graphql_cache_ttl_seconds",
+    "_graphql_persisted_queries",
     "_graphql_retry_max",
     "_graphql_retry_wait_min_seconds",
     "_graphql_retry_wait_max_seconds",
     "_graphql_timeout_seconds",
+    "_history_aggregation",
+    "_history_aggregation_keep_raw",
+    "_history_aggregation_max_rate",
     "_internal_check_process",
     "_internal_queue_timeout",
     "_ipython",
@@ -48,21 +72,37 @@ _Setting = Literal[
     "_jupyter_path",
     "_jupyter_root",
     "_kaggle",
+    "_large_upload_window",
     "_live_policy_rate_limit",
     "_live_policy_wait_time",
     "_log_level",
+    "_max_config_summary_depth",
+    "_max_config_summary_keys",
+    "_max_config_summary_value_bytes",
+    "_media_keep_last",
+    "_media_sample_steps",
     "_network_buffer",
+    "_node_rank",
     "_noop",
     "_notebook",
     "_offline",
     "_sync",
     "_os",
     "_platform",
+    "_preemption_grace_seconds",
+    "_preemption_hook",
     "_proxies",
     "_python",
+    "_request_signing_command",
     "_runqueue_item_id",
     "_require_core",
+    "_run_descriptor",
+    "_run_descriptor_qr",
+    "_s3_endpoint_url",
+    "_s3_insecure_tls",
+    "_s3_path_style",
     "_save_requirements",
+    "_server_side_summary",
     "_service_transport",
     "_service_wait",
     "_shared",
@@ -71,21 +111,33 @@ _Setting = Literal[
     "_stats_pid",
     "_stats_sample_rate_seconds",
     "_stats_samples_to_average",
+    "_store_compression",
+    "_store_index",
+    "_stream_max_buffered_mb",
+    "_stream_max_queued_mb",
+    "_stream_max_queued_records",
     "_stats_join_assets",
     "_stats_neuron_monitor_config_path",
     "_stats_open_metrics_endpoints",
     "_stats_open_metrics_filters",
     "_stats_disk_paths",
     "_stats_buffer_size",
+    "_stats_dcgm_profiling_fields",
+    "_stats_dcgm_profiling_interval_seconds",
     "_tmp_code_dir",
     "_tracelog",
     "_unsaved_keys",
+    "_upload_bandwidth_limit",
+    "_watchdog_rules",
     "_windows",
     "allow_val_change",
     "anonymous",
     "api_key",
     "azure_account_url_to_access_key",
     "base_url",
+    "ca_cert_file",
+    "client_cert_file",
+    "client_key_file",
     "code_dir",
     "colab_url",
     "config_paths",
@@ -113,6 +165,7 @@ _Setting = Literal[
     "host",
     "http_proxy",
     "https_proxy",
+    "idempotency_key",
     "identity_token_file",
     "ignore_globs",
     "init_timeout",
@@ -129,6 +182,7 @@ _Setting = Literal[
     "log_user",
     "login_timeout",
     "mode",
+    "no_proxy",
     "notebook_name",
     "program",
     "program_abspath",
@@ -172,6 +226,7 @@ _Setting = Literal[
     "sync_dir",
     "sync_file",
     "sync_symlink_latest",
+    "sync_tensorboard_dir",
     "system_sample",
     "system_sample_seconds",
     "table_raise_on_max_row_limit_exceeded",
@@ -179,6 +234,9 @@ _Setting = Literal[
     "tmp_dir",
     "username",
     "wandb_dir",
+    "x_otel_endpoint",
+    "_api_circuit_breaker_threshold",
+    "_api_circuit_breaker_cooldown_seconds",
 ]
 
 SETTINGS_TOPOLOGICALLY_SORTED: Final[Tuple[_Setting, ...]] = (
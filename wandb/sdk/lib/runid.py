@@ -1,5 +1,6 @@
 """runid util."""
 
+import hashlib
 import secrets
 import string
 
@@ -10,3 +11,14 @@ def generate_id(length: int = 8) -> str:
     # we'll have a ~1% chance of collision.
     alphabet = string.ascii_lowercase + string.digits
     return "".join(secrets.choice(alphabet) for _ in range(length))
+
+
+def generate_idempotent_id(key: str, length: int = 12) -> str:
+    """Generate a base-36 string of `length` digits derived from `key`.
+
+    The same key always gives the same ID. This must match IdempotentID in
+    wandb-core.
+    """
+    alphabet = "abcdefghijklmnopqrstuvwxyz1234567890"
+    digest = hashlib.sha256(key.encode("utf-8")).digest()
+    return "".join(alphabet[b % len(alphabet)] for b in digest[:length])
@@ -170,6 +170,7 @@ class SockClient:
         inform_attach: Optional[spb.ServerInformAttachRequest] = None,
         inform_finish: Optional[spb.ServerInformFinishRequest] = None,
         inform_teardown: Optional[spb.ServerInformTeardownRequest] = None,
+        authenticate: Optional[spb.ServerAuthenticateRequest] = None,
     ) -> spb.ServerResponse:
         self.send(
             inform_init=inform_init,
@@ -177,6 +178,7 @@ class SockClient:
             inform_attach=inform_attach,
             inform_finish=inform_finish,
             inform_teardown=inform_teardown,
+            authenticate=authenticate,
         )
         # TODO: this solution is fragile, but for checking attach
         # it should be relatively stable.
@@ -194,6 +196,7 @@ class SockClient:
         inform_attach: Optional[spb.ServerInformAttachRequest] = None,
         inform_finish: Optional[spb.ServerInformFinishRequest] = None,
         inform_teardown: Optional[spb.ServerInformTeardownRequest] = None,
+        authenticate: Optional[spb.ServerAuthenticateRequest] = None,
     ) -> None:
         server_req = spb.ServerRequest()
         if inform_init:
@@ -206,6 +209,8 @@ class SockClient:
             server_req.inform_finish.CopyFrom(inform_finish)
         elif inform_teardown:
             server_req.inform_teardown.CopyFrom(inform_teardown)
+        elif authenticate:
+            server_req.authenticate.CopyFrom(authenticate)
         else:
             raise Exception("unmatched")
         self.send_server_request(server_req)
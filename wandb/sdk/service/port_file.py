@@ -7,13 +7,16 @@ from typing import Optional
 
 class PortFile:
     _sock_port: Optional[int]
+    _auth_token: Optional[str]
     _valid: bool
 
     SOCK_TOKEN = "sock="
+    AUTH_TOKEN = "token="
     EOF_TOKEN = "EOF"
 
     def __init__(self, sock_port: Optional[int] = None) -> None:
         self._sock_port = sock_port
+        self._auth_token = None
         self._valid = False
 
     def write(self, fname: str) -> None:
@@ -42,12 +45,19 @@ class PortFile:
             for ln in lines:
                 if ln.startswith(self.SOCK_TOKEN):
                     self._sock_port = int(ln[len(self.SOCK_TOKEN) :])
+                elif ln.startswith(self.AUTH_TOKEN):
+                    self._auth_token = ln[len(self.AUTH_TOKEN) :].strip()
             self._valid = True
 
     @property
     def sock_port(self) -> Optional[int]:
         return self._sock_port
 
+    @property
+    def auth_token(self) -> Optional[str]:
+        """The token for authenticating with the service, if it requires one."""
+        return self._auth_token
+
     @property
     def is_valid(self) -> bool:
         return self._valid
@@ -49,6 +49,7 @@ class ServiceStartPortError(Error):
 class _Service:
     _settings: "Settings"
     _sock_port: Optional[int]
+    _auth_token: Optional[str]
     _service_interface: ServiceInterface
     _internal_proc: Optional[subprocess.Popen]
     _startup_debug_enabled: bool
@@ -60,6 +61,7 @@ class _Service:
         self._settings = settings
         self._stub = None
         self._sock_port = None
+        self._auth_token = None
         self._internal_proc = None
         self._startup_debug_enabled = _startup_debug.is_enabled()
 
@@ -124,6 +126,7 @@ class _Service:
                     time.sleep(0.2)
                     continue
                 self._sock_port = pf.sock_port
+                self._auth_token = pf.auth_token
             except Exception as e:
                 # todo: point at the docs. this could be due to a number of reasons,
                 #  for example, being unable to write to the port file etc.
@@ -178,6 +181,9 @@ class _Service:
                 if core_debug(default="False"):
                     service_args.append("--debug")
 
+                # Stop other users on the machine from connecting.
+                service_args.append("--require-auth")
+
                 trace_filename = os.environ.get("_WANDB_TRACE")
                 if trace_filename is not None:
                     service_args.extend(["--trace", trace_filename])
@@ -256,6 +262,10 @@ class _Service:
     def sock_port(self) -> Optional[int]:
         return self._sock_port
 
+    @property
+    def auth_token(self) -> Optional[str]:
+        return self._auth_token
+
     @property
     def service_interface(self) -> ServiceInterface:
         return self._service_interface
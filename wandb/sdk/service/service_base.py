@@ -46,5 +46,5 @@ class ServiceInterface:
         raise NotImplementedError
 
     @abstractmethod
-    def _svc_connect(self, port: int) -> None:
+    def _svc_connect(self, port: int, auth_token: Optional[str] = None) -> None:
         raise NotImplementedError
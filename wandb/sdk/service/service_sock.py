@@ -26,8 +26,11 @@ class ServiceSockInterface(ServiceInterface):
     def _get_sock_client(self) -> SockClient:
         return self._sock_client
 
-    def _svc_connect(self, port: int) -> None:
+    def _svc_connect(self, port: int, auth_token: Optional[str] = None) -> None:
         self._sock_client.connect(port=port)
+        if auth_token:
+            authenticate = spb.ServerAuthenticateRequest(token=auth_token)
+            self._sock_client.send(authenticate=authenticate)
 
     def _svc_inform_init(
         self, settings: "wandb_settings_pb2.Settings", run_id: str
@@ -33,6 +33,7 @@ from .backend.backend import Backend
 from .lib import (
     RunDisabled,
     SummaryDisabled,
+    apikey,
     filesystem,
     ipython,
     module,
@@ -296,6 +297,21 @@ class _WandbInit:
         if init_settings:
             settings.update(init_settings, source=Source.INIT)
 
+        if (
+            settings._defer_auth
+            and not settings._offline
+            and not settings._noop
+            and not settings.anonymous
+            and not apikey.api_key(settings=settings)
+        ):
+            # log the run locally; `wandb-core flush` uploads it later
+            wandb.termwarn(
+                "No API key found, logging the run offline. Upload it later with"
+                " `wandb-core flush`, or `wandb-core flush -anonymous` to upload"
+                " it without an account."
+            )
+            settings.update({"mode": "offline"}, source=Source.INIT)
+
         if not settings._offline and not settings._noop:
             wandb_login._login(
                 anonymous=kwargs.pop("anonymous", None),
@@ -42,9 +42,11 @@ class _ManagerToken:
     _transport: str
     _host: str
     _port: int
+    _auth_token: Optional[str]
 
-    def __init__(self, token: str) -> None:
+    def __init__(self, token: str, auth_token: Optional[str] = None) -> None:
         self._token_str = token
+        self._auth_token = auth_token
         self._parse()
 
     @classmethod
@@ -52,17 +54,27 @@ class _ManagerToken:
         token = os.environ.get(env.SERVICE)
         if not token:
             return None
-        return cls(token=token)
+        return cls(token=token, auth_token=os.environ.get(env.SERVICE_AUTH_TOKEN))
 
     @classmethod
-    def from_params(cls, transport: str, host: str, port: int) -> "_ManagerToken":
+    def from_params(
+        cls,
+        transport: str,
+        host: str,
+        port: int,
+        auth_token: Optional[str] = None,
+    ) -> "_ManagerToken":
         version = cls._version
         pid = os.getpid()
         token = "-".join([version, str(pid), transport, host, str(port)])
-        return cls(token=token)
+        return cls(token=token, auth_token=auth_token)
 
     def set_environment(self) -> None:
         os.environ[env.SERVICE] = self._token_str
+        if self._auth_token:
+            os.environ[env.SERVICE_AUTH_TOKEN] = self._auth_token
+        else:
+            os.environ.pop(env.SERVICE_AUTH_TOKEN, None)
 
     def _parse(self) -> None:
         assert self._token_str
@@ -79,6 +91,7 @@ class _ManagerToken:
 
     def reset_environment(self) -> None:
         os.environ.pop(env.SERVICE, None)
+        os.environ.pop(env.SERVICE_AUTH_TOKEN, None)
 
     @property
     def token(self) -> str:
@@ -100,6 +113,10 @@ class _ManagerToken:
     def port(self) -> int:
         return self._port
 
+    @property
+    def auth_token(self) -> Optional[str]:
+        return self._auth_token
+
 
 class _Manager:
     _token: _ManagerToken
@@ -113,7 +130,7 @@ class _Manager:
         svc_iface = self._get_service_interface()
 
         try:
-            svc_iface._svc_connect(port=port)
+            svc_iface._svc_connect(port=port, auth_token=self._token.auth_token)
         except ConnectionRefusedError as e:
             if not psutil.pid_exists(self._token.pid):
                 message = (
@@ -143,7 +160,12 @@ class _Manager:
             transport = "tcp"
             port = self._service.sock_port
             assert port
-            token = _ManagerToken.from_params(transport=transport, host=host, port=port)
+            token = _ManagerToken.from_params(
+                transport=transport,
+                host=host,
+                port=port,
+                auth_token=self._service.auth_token,
+            )
             token.set_environment()
             self._atexit_setup()
         self._token = token
@@ -317,6 +317,8 @@ class RunStatusChecker:
             internal_messages = result.response.internal_messages_response
             for msg in internal_messages.messages.warning:
                 wandb.termwarn(msg)
+            for error in internal_messages.messages.error:
+                wandb.termerror(error.message)
 
         try:
             self._loop_check_status(
@@ -1535,8 +1537,12 @@ class Run:
         if console_pid != os.getpid():
             return
 
+        # Output from a child process that attached to the run is tagged
+        # with its pid, so that it can be told apart from the user process.
+        source = f"pid:{console_pid}" if console_pid != self._init_pid else ""
+
         if self._backend and self._backend.interface:
-            self._backend.interface.publish_output_raw(name, data)
+            self._backend.interface.publish_output_raw(name, data, source=source)
 
     def _tensorboard_callback(
         self, logdir: str, save: bool = True, root_logdir: str = ""
@@ -2063,6 +2069,12 @@ class Run:
                 for f in globbed_files
             ]
         }
+
+        # wandb-core also saves files written directly into the files
+        # directory later if it's given the glob itself.
+        if self._settings._require_core and is_star_glob:
+            files_dict["files"].append((relative_glob_str, policy))
+
         if self._backend and self._backend.interface:
             self._backend.interface.publish_files(files_dict)
 
@@ -4087,6 +4099,9 @@ class Run:
         for message in internal_messages_response.messages.warning:
             printer.display(message, level="warn")
 
+        for error in internal_messages_response.messages.error:
+            printer.display(error.message, level="error")
+
     @staticmethod
     def _footer_server_messages(
         server_info_response: Optional[ServerInfoResponse] = None,
@@ -51,7 +51,7 @@ from wandb.sdk.wandb_setup import _EarlyLogger
 from .lib import apikey
 from .lib.gitlib import GitRepo
 from .lib.ipython import _get_python_type
-from .lib.runid import generate_id
+from .lib.runid import generate_id, generate_idempotent_id
 
 if sys.version_info >= (3, 8):
     from typing import get_args, get_origin, get_type_hints
@@ -296,14 +296,23 @@ ConsoleValue = {
 class SettingsData:
     """Settings for the W&B SDK."""
 
+    _api_circuit_breaker_cooldown_seconds: float
+    _api_circuit_breaker_threshold: int
     _args: Sequence[str]
+    _artifact_manifest_v2: bool  # save large artifacts with a v2 manifest
     _aws_lambda: bool
     _cli_only_mode: bool  # Avoid running any code specific for runs
     _code_path_local: str
     _colab: bool
+    _console_dedupe_lines: bool  # collapse identical consecutive console lines
+    _console_structured: bool  # save console output as structured JSON lines
+    _console_upload_bytes_per_second: int  # limit on console log upload bandwidth
+    _core_log_level: str  # level of the wandb-core debug log
     # _config_dict: Config
     _cuda: str
+    _defer_auth: bool
     _disable_meta: bool  # Do not collect system metadata
+    _disable_org_defaults: bool
     _disable_service: (
         bool  # Disable wandb-service, spin up internal process the old way
     )
@@ -311,6 +320,7 @@ class SettingsData:
     _disable_stats: bool  # Do not collect system metrics
     _disable_update_check: bool  # Disable version check
     _disable_viewer: bool  # Prevent early viewer query
+    _env_snapshot: Sequence[str]  # package managers to snapshot
     _disable_machine_info: bool  # Disable automatic machine info collection
     _executable: str
     _extra_http_headers: Mapping[str, str]
@@ -319,18 +329,34 @@ class SettingsData:
     _file_stream_retry_wait_min_seconds: float  # min wait time between retries
     _file_stream_retry_wait_max_seconds: float  # max wait time between retries
     _file_stream_timeout_seconds: float  # timeout for individual HTTP requests
+    _file_stream_transport: str  # "http" or "grpc"
     # file transfer retry client configuration
+    _file_transfer_http2: bool
+    _file_transfer_max_concurrency: int  # max files transferred at once
+    _file_transfer_max_conns_per_host: int
+    _file_transfer_max_idle_conns: int
+    _file_transfer_max_idle_conns_per_host: int
     _file_transfer_retry_max: int
     _file_transfer_retry_wait_min_seconds: float
     _file_transfer_retry_wait_max_seconds: float
     _file_transfer_timeout_seconds: float
     _flow_control_custom: bool
     _flow_control_disabled: bool
+    _gcs_audience: str  # workload identity pool provider for gs:// references
+    _gcs_impersonate_service_account: str  # service account for gs:// references
+    _gcs_scopes: Sequence[str]
+    _git_patch_max_bytes: int
+    _git_patch_untracked: bool
+    _graphql_cache_ttl_seconds: float
+    _graphql_persisted_queries: bool
     # graphql retry client configuration
     _graphql_retry_max: int
     _graphql_retry_wait_min_seconds: float
     _graphql_retry_wait_max_seconds: float
     _graphql_timeout_seconds: float
+    _history_aggregation: Mapping[str, str]  # metric glob -> aggregation policy
+    _history_aggregation_keep_raw: bool
+    _history_aggregation_max_rate: float  # max history rows per second
     _internal_check_process: float
     _internal_queue_timeout: float
     _ipython: bool
@@ -339,23 +365,39 @@ class SettingsData:
     _jupyter_path: str
     _jupyter_root: str
     _kaggle: bool
+    _large_upload_window: str
     _live_policy_rate_limit: int
     _live_policy_wait_time: int
     _log_level: int
+    _max_config_summary_depth: int  # max nesting of config and summary values
+    _max_config_summary_keys: int  # max keys in the config or summary
+    _max_config_summary_value_bytes: int  # max JSON size of a config or summary value
+    _media_keep_last: int  # keep last N media files per key
+    _media_sample_steps: int  # keep media every M steps
     _network_buffer: int
+    _node_rank: int  # rank of this node in shared mode
     _noop: bool
     _notebook: bool
     _offline: bool
     _sync: bool
     _os: str
     _platform: str
+    _preemption_grace_seconds: float  # time to save the run on preemption
+    _preemption_hook: str  # command to run on preemption
     _proxies: Mapping[
         str, str
     ]  # custom proxy servers for the requests to W&B [scheme -> url]
     _python: str
+    _request_signing_command: Sequence[str]  # external command used to sign requests
     _runqueue_item_id: str
     _require_core: bool
+    _run_descriptor: bool  # write a portable run descriptor at init
+    _run_descriptor_qr: bool
+    _s3_endpoint_url: str  # S3-compatible service for s3:// references
+    _s3_insecure_tls: bool
+    _s3_path_style: bool  # address S3 buckets in the URL path
     _save_requirements: bool
+    _server_side_summary: bool  # let the server compute min/max/mean summaries
     _service_transport: str
     _service_wait: float
     _shared: bool
@@ -364,6 +406,11 @@ class SettingsData:
     _stats_pid: int  # (internal) base pid for system stats
     _stats_sample_rate_seconds: float
     _stats_samples_to_average: int
+    _store_compression: str  # "" or "zstd"
+    _store_index: bool
+    _stream_max_buffered_mb: int  # memory limit for records waiting to be sent
+    _stream_max_queued_mb: int
+    _stream_max_queued_records: int
     _stats_join_assets: (
         bool  # join metrics from different assets before sending to backend
     )
@@ -377,15 +424,22 @@ class SettingsData:
     _stats_open_metrics_filters: Union[Sequence[str], Mapping[str, Mapping[str, str]]]
     _stats_disk_paths: Sequence[str]  # paths to monitor disk usage
     _stats_buffer_size: int  # number of consolidated samples to buffer before flushing, available in run obj
+    _stats_dcgm_profiling_fields: Sequence[str]  # DCGM profiling field IDs to sample
+    _stats_dcgm_profiling_interval_seconds: float
     _tmp_code_dir: str
     _tracelog: str
     _unsaved_keys: Sequence[str]
+    _upload_bandwidth_limit: int
+    _watchdog_rules: Sequence[str]  # metric watchdog rules
     _windows: bool
     allow_val_change: bool
     anonymous: str
     api_key: str
     azure_account_url_to_access_key: Dict[str, str]
     base_url: str  # The base url for the wandb api
+    ca_cert_file: str
+    client_cert_file: str
+    client_key_file: str
     code_dir: str
     colab_url: str
     config_paths: Sequence[str]
@@ -413,6 +467,7 @@ class SettingsData:
     host: str
     http_proxy: str  # proxy server for the http requests to W&B
     https_proxy: str  # proxy server for the https requests to W&B
+    idempotency_key: str  # derive run_id from this key so retries resume
     identity_token_file: str  # file path to supply a jwt for authentication
     ignore_globs: Tuple[str]
     init_timeout: float
@@ -430,6 +485,7 @@ class SettingsData:
     login_timeout: float
     # magic: Union[str, bool, dict]  # never used in code, deprecated
     mode: str
+    no_proxy: str
     notebook_name: str
     program: str
     program_abspath: str
@@ -474,6 +530,7 @@ class SettingsData:
     sync_dir: str
     sync_file: str
     sync_symlink_latest: str
+    sync_tensorboard_dir: str
     system_sample: int
     system_sample_seconds: int
     table_raise_on_max_row_limit_exceeded: bool
@@ -481,6 +538,7 @@ class SettingsData:
     tmp_dir: str
     username: str
     wandb_dir: str
+    x_otel_endpoint: str
 
 
 class Property:
@@ -626,6 +684,9 @@ class Settings(SettingsData):
         Note that key names must be the same as the class attribute names.
         """
         props: Dict[str, Dict[str, Any]] = dict(
+            _api_circuit_breaker_cooldown_seconds={"preprocessor": float},
+            _api_circuit_breaker_threshold={"preprocessor": int},
+            _artifact_manifest_v2={"preprocessor": _str_as_bool},
             _aws_lambda={
                 "hook": lambda _: is_aws_lambda(),
                 "auto_hook": True,
@@ -638,6 +699,10 @@ class Settings(SettingsData):
                 "hook": lambda _: "google.colab" in sys.modules,
                 "auto_hook": True,
             },
+            _console_dedupe_lines={"value": False, "preprocessor": _str_as_bool},
+            _console_structured={"value": False, "preprocessor": _str_as_bool},
+            _console_upload_bytes_per_second={"preprocessor": int},
+            _defer_auth={"preprocessor": _str_as_bool},
             _disable_machine_info={
                 "value": False,
                 "preprocessor": _str_as_bool,
@@ -647,6 +712,7 @@ class Settings(SettingsData):
                 "preprocessor": _str_as_bool,
                 "hook": lambda x: self._disable_machine_info or x,
             },
+            _disable_org_defaults={"value": False, "preprocessor": _str_as_bool},
             _disable_service={
                 "value": False,
                 "preprocessor": _str_as_bool,
@@ -660,11 +726,18 @@ class Settings(SettingsData):
             },
             _disable_update_check={"preprocessor": _str_as_bool},
             _disable_viewer={"preprocessor": _str_as_bool},
+            _env_snapshot={"preprocessor": _str_as_tuple},
             _extra_http_headers={"preprocessor": _str_as_json},
             _file_stream_retry_max={"preprocessor": int},
             _file_stream_retry_wait_min_seconds={"preprocessor": float},
             _file_stream_retry_wait_max_seconds={"preprocessor": float},
             _file_stream_timeout_seconds={"preprocessor": float},
+            _file_stream_transport={"value": "http"},
+            _file_transfer_http2={"preprocessor": _str_as_bool},
+            _file_transfer_max_concurrency={"preprocessor": int},
+            _file_transfer_max_conns_per_host={"preprocessor": int},
+            _file_transfer_max_idle_conns={"preprocessor": int},
+            _file_transfer_max_idle_conns_per_host={"preprocessor": int},
             _file_transfer_retry_max={"preprocessor": int},
             _file_transfer_retry_wait_min_seconds={"preprocessor": float},
             _file_transfer_retry_wait_max_seconds={"preprocessor": float},
@@ -673,6 +746,11 @@ class Settings(SettingsData):
                 "hook": lambda _: self._network_buffer == 0,
                 "auto_hook": True,
             },
+            _gcs_scopes={"preprocessor": _str_as_tuple},
+            _git_patch_max_bytes={"preprocessor": int},
+            _git_patch_untracked={"preprocessor": _str_as_bool},
+            _graphql_cache_ttl_seconds={"preprocessor": float},
+            _graphql_persisted_queries={"preprocessor": _str_as_bool},
             _flow_control_custom={
                 "hook": lambda _: bool(self._network_buffer),
                 "auto_hook": True,
@@ -681,6 +759,9 @@ class Settings(SettingsData):
             _graphql_retry_wait_min_seconds={"preprocessor": float},
             _graphql_retry_wait_max_seconds={"preprocessor": float},
             _graphql_timeout_seconds={"preprocessor": float},
+            _history_aggregation={"preprocessor": _str_as_json},
+            _history_aggregation_keep_raw={"value": False, "preprocessor": _str_as_bool},
+            _history_aggregation_max_rate={"preprocessor": float},
             _internal_check_process={"value": 8, "preprocessor": float},
             _internal_queue_timeout={"value": 2, "preprocessor": float},
             _ipython={
@@ -693,7 +774,13 @@ class Settings(SettingsData):
             },
             _kaggle={"hook": lambda _: util._is_likely_kaggle(), "auto_hook": True},
             _log_level={"value": logging.DEBUG},
+            _max_config_summary_depth={"preprocessor": int},
+            _max_config_summary_keys={"preprocessor": int},
+            _max_config_summary_value_bytes={"preprocessor": int},
+            _media_keep_last={"preprocessor": int},
+            _media_sample_steps={"preprocessor": int},
             _network_buffer={"preprocessor": int},
+            _node_rank={"preprocessor": int},
             _noop={"hook": lambda _: self.mode == "disabled", "auto_hook": True},
             _notebook={
                 "hook": lambda _: self._ipython
@@ -711,12 +798,19 @@ class Settings(SettingsData):
                 "auto_hook": True,
             },
             _platform={"value": util.get_platform_name()},
+            _preemption_grace_seconds={"preprocessor": float},
             _proxies={
                 # TODO: deprecate and ask the user to use http_proxy and https_proxy instead
                 "preprocessor": _str_as_json,
             },
+            _request_signing_command={"preprocessor": _str_as_json},
             _require_core={"value": False, "preprocessor": _str_as_bool},
+            _run_descriptor={"value": False, "preprocessor": _str_as_bool},
+            _run_descriptor_qr={"value": False, "preprocessor": _str_as_bool},
+            _s3_insecure_tls={"preprocessor": _str_as_bool},
+            _s3_path_style={"preprocessor": _str_as_bool},
             _save_requirements={"value": True, "preprocessor": _str_as_bool},
+            _server_side_summary={"preprocessor": _str_as_bool},
             _service_wait={
                 "value": 30,
                 "preprocessor": float,
@@ -737,6 +831,10 @@ class Settings(SettingsData):
                 "preprocessor": int,
                 "validator": self._validate__stats_samples_to_average,
             },
+            _store_index={"value": False, "preprocessor": _str_as_bool},
+            _stream_max_buffered_mb={"preprocessor": int},
+            _stream_max_queued_mb={"preprocessor": int},
+            _stream_max_queued_records={"preprocessor": int},
             _stats_join_assets={"value": True, "preprocessor": _str_as_bool},
             _stats_neuron_monitor_config_path={
                 "hook": lambda x: self._path_convert(x),
@@ -757,11 +855,15 @@ class Settings(SettingsData):
                 "value": 0,
                 "preprocessor": int,
             },
+            _stats_dcgm_profiling_fields={"preprocessor": _str_as_json},
+            _stats_dcgm_profiling_interval_seconds={"preprocessor": float},
             _sync={"value": False},
             _tmp_code_dir={
                 "value": "code",
                 "hook": lambda x: self._path_convert(self.tmp_dir, x),
             },
+            _upload_bandwidth_limit={"preprocessor": int},
+            _watchdog_rules={"preprocessor": _str_as_tuple},
             _windows={
                 "hook": lambda _: platform.system() == "Windows",
                 "auto_hook": True,
@@ -1900,6 +2002,14 @@ class Settings(SettingsData):
                         f"id {resume_run_id} but id {self.run_id} is set.",
                     )
 
+        # derive the run id from the idempotency key so that retried jobs
+        # get the same run, which wandb-core then resumes
+        if self.run_id is None and self.idempotency_key:
+            self.update(
+                {"run_id": generate_idempotent_id(self.idempotency_key)},
+                source=Source.INIT,
+            )
+
         self.update({"run_id": self.run_id or generate_id()}, source=Source.INIT)
         # persist our run id in case of failure
         # check None for mypy